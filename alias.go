@@ -4,11 +4,13 @@ import (
 	"github.com/okex/okchain-go-sdk/module/auth"
 	"github.com/okex/okchain-go-sdk/module/backend"
 	"github.com/okex/okchain-go-sdk/module/dex"
+	"github.com/okex/okchain-go-sdk/module/governance"
 	"github.com/okex/okchain-go-sdk/module/order"
 	"github.com/okex/okchain-go-sdk/module/staking"
 	"github.com/okex/okchain-go-sdk/module/tendermint"
 	"github.com/okex/okchain-go-sdk/module/token"
 	sdk "github.com/okex/okchain-go-sdk/types"
+	"github.com/okex/okchain-go-sdk/types/tx"
 )
 
 // const
@@ -17,26 +19,80 @@ const (
 	BroadcastAsync = sdk.BroadcastAsync
 	BroadcastBlock = sdk.BroadcastBlock
 
-	// vote for the proposal
-	VoteYes        = "yes"
-	VoteAbstain    = "abstain"
-	VoteNo         = "no"
-	VoteNoWithVeto = "no_with_veto"
+	SerializationJSON  = sdk.SerializationJSON
+	SerializationAmino = sdk.SerializationAmino
+
+	// vote for the proposal, the options taken by Governance().Vote
+	VoteYes        = governance.VoteOptionYes
+	VoteAbstain    = governance.VoteOptionAbstain
+	VoteNo         = governance.VoteOptionNo
+	VoteNoWithVeto = governance.VoteOptionNoWithVeto
 )
 
 var (
 	// NewClientConfig gives an easy way for the callers to set client config
 	NewClientConfig = sdk.NewClientConfig
+	// LoadClientConfigFile loads the json config file with the secret references resolved
+	LoadClientConfigFile = sdk.LoadClientConfigFile
+	// DefaultSecretResolvers and NewVaultSecretResolver resolve the secret references in the config files
+	DefaultSecretResolvers = sdk.DefaultSecretResolvers
+	NewVaultSecretResolver = sdk.NewVaultSecretResolver
+	// NewResponseCollector collects the raw responses of the queries of a client view
+	NewResponseCollector = sdk.NewResponseCollector
+	// NewGaugeSet collects the gauges of the chain health in memory for a Prometheus metrics endpoint
+	NewGaugeSet = sdk.NewGaugeSet
+	// ParseAmount, MustParseAmount, NewAmountFromDec, NewAmountFromInt and NewAmountFromCoins construct the amounts of
+	// the tx methods
+	ParseAmount = sdk.ParseAmount
+	MustParseAmount = sdk.MustParseAmount
+	NewAmountFromDec = sdk.NewAmountFromDec
+	NewAmountFromInt = sdk.NewAmountFromInt
+	NewAmountFromCoins = sdk.NewAmountFromCoins
+	// NewPageRequest gives an easy way for the callers to set the paging params of list queries
+	NewPageRequest = sdk.NewPageRequest
+	// WithFeePayer sets a separate account to pay the fees of a tx
+	WithFeePayer = sdk.WithFeePayer
+	// WithFeeGranter sets the account which granted the fee allowance to the signer of a tx
+	WithFeeGranter = sdk.WithFeeGranter
+	// WithFeePayerSigner sets a separate account to pay the fees of a tx by its signer
+	WithFeePayerSigner = sdk.WithFeePayerSigner
+	// NewKeybaseSigner and NewPrivKeySigner adapt the local keys to the Signer of the txs
+	NewKeybaseSigner = tx.NewKeybaseSigner
+	NewPrivKeySigner = tx.NewPrivKeySigner
+	// UseHotKeybase keeps the private keys unencrypted in memory to skip the decryption on signing
+	UseHotKeybase = tx.UseHotKeybase
+	// UseFileKeybase keeps the keys in a keystore file safe to be shared by multiple processes
+	UseFileKeybase = tx.UseFileKeybase
+	// UseKeychainKeybase keeps the keys in the credential store of the OS, e.g. the macOS Keychain
+	UseKeychainKeybase = tx.UseKeychainKeybase
+	// Latest, Finalized and AtHeight are the consistency options of the queries through Client.WithConsistency
+	Latest    = sdk.Latest
+	Finalized = sdk.Finalized
+	AtHeight  = sdk.AtHeight
 )
 
 // nolint
 type (
 	TxResponse = sdk.TxResponse
+	TxOption = sdk.TxOption
+	Signer = sdk.Signer
+	SignedTx = sdk.SignedTx
+	Serializer = sdk.Serializer
+	Consistency = sdk.Consistency
+	Msg = sdk.Msg
+	PageRequest = sdk.PageRequest
+	PagedResult = sdk.PagedResult
+	Amount = sdk.Amount
 	// auth
 	Account = auth.Account
+	BalanceChange = auth.BalanceChange
 	// staking
 	Validator = staking.Validator
 	DelegatorResp = staking.DelegatorResp
+	Vote = staking.Vote
+	ProxyResp = staking.ProxyResp
+	// governance
+	Proposal = governance.Proposal
 	// token
 	Token = token.Token
 	AccountTokensInfo = token.AccountTokensInfo
@@ -44,7 +100,11 @@ type (
 	TokenPair = dex.TokenPair
 	// order
 	BookRes = order.BookRes
+	BookUpdate = order.BookUpdate
 	OrderDetail = order.OrderDetail
+	OrderItem = order.OrderItem
+	OrderResult = order.OrderResult
+	TxOrders = order.TxOrders
 	// backend
 	Ticker = backend.Ticker
 	MatchResult = backend.MatchResult
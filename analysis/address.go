@@ -0,0 +1,150 @@
+// Package analysis provides the heuristics on the activities of the addresses, which label an address by its roles on
+// the chain by querying the relevant modules, for the explorers and the compliance tooling, the reports on the impact
+// of delisting the token pairs for the governance decisions, and the performance analytics of the validators, i.e.
+// the missed blocks, the voting powers and the jail history, for the monitoring dashboards.
+package analysis
+
+import (
+	"fmt"
+
+	"github.com/okex/okchain-go-sdk/exposed"
+	dextypes "github.com/okex/okchain-go-sdk/module/dex/types"
+	stakingtypes "github.com/okex/okchain-go-sdk/module/staking/types"
+	tokentypes "github.com/okex/okchain-go-sdk/module/token/types"
+	sdk "github.com/okex/okchain-go-sdk/types"
+)
+
+// productsPerPage is the page size to query the token pairs owned by an address
+const productsPerPage = 100
+
+// Label is a role of an address on the chain
+type Label string
+
+// labels of the addresses
+const (
+	// LabelValidatorOperator is the operator of a validator, whose validator address shares the bytes of the address
+	LabelValidatorOperator Label = "validator operator"
+	// LabelDexOperator is the owner of the token pairs listed on the dex
+	LabelDexOperator Label = "dex operator"
+	// LabelTokenIssuer is the owner of the tokens issued
+	LabelTokenIssuer Label = "token issuer"
+	// LabelProxy is the delegator registered as a proxy, which votes with the shares delegated to it
+	LabelProxy Label = "proxy"
+	// LabelRegular is the account with none of the roles above
+	LabelRegular Label = "regular account"
+)
+
+// AddressProfile - structure of the labels of an address with the evidences of its roles
+type AddressProfile struct {
+	Address sdk.AccAddress
+	Labels  []Label
+	// Validator is the validator operated by the address
+	Validator *stakingtypes.Validator
+	// TokenPairs are the token pairs owned by the address
+	TokenPairs []dextypes.TokenPair
+	// Tokens are the tokens issued by the address
+	Tokens []tokentypes.Token
+	// Delegator is the delegation info of the address
+	Delegator stakingtypes.DelegatorResp
+}
+
+// Is tells whether the address has the label
+func (p AddressProfile) Is(label Label) bool {
+	for _, l := range p.Labels {
+		if l == label {
+			return true
+		}
+	}
+	return false
+}
+
+// Classifier - structure of the classifier labeling the addresses by the queries of the modules
+type Classifier struct {
+	staking exposed.StakingQuery
+	dex     exposed.DexQuery
+	token   exposed.TokenQuery
+}
+
+// NewClassifier creates a new instance of Classifier
+func NewClassifier(staking exposed.StakingQuery, dex exposed.DexQuery, token exposed.TokenQuery) *Classifier {
+	return &Classifier{
+		staking: staking,
+		dex:     dex,
+		token:   token,
+	}
+}
+
+// Classify labels the address by its roles on the chain, and LabelRegular if none
+// An address might have multiple roles, e.g. a validator operator issuing a token as well
+func (c *Classifier) Classify(addrStr string) (profile AddressProfile, err error) {
+	addr, err := sdk.AccAddressFromBech32(addrStr)
+	if err != nil {
+		return profile, fmt.Errorf("failed. accAddress %s converted from Bech32 error", addrStr)
+	}
+	profile.Address = addr
+
+	if profile.Validator, err = c.queryValidator(addr); err != nil {
+		return
+	}
+	if profile.Validator != nil {
+		profile.Labels = append(profile.Labels, LabelValidatorOperator)
+	}
+
+	if profile.TokenPairs, err = c.queryTokenPairs(addrStr); err != nil {
+		return
+	}
+	if len(profile.TokenPairs) != 0 {
+		profile.Labels = append(profile.Labels, LabelDexOperator)
+	}
+
+	if profile.Tokens, err = c.token.QueryTokenInfo(addrStr, ""); err != nil {
+		return profile, fmt.Errorf("failed. query tokens issued error: %w", err)
+	}
+	if len(profile.Tokens) != 0 {
+		profile.Labels = append(profile.Labels, LabelTokenIssuer)
+	}
+
+	if profile.Delegator, err = c.staking.QueryDelegator(addrStr); err != nil {
+		return profile, fmt.Errorf("failed. query delegator error: %w", err)
+	}
+	if profile.Delegator.IsProxy {
+		profile.Labels = append(profile.Labels, LabelProxy)
+	}
+
+	if len(profile.Labels) == 0 {
+		profile.Labels = append(profile.Labels, LabelRegular)
+	}
+	return
+}
+
+// queryValidator finds the validator operated by the address in all the validators, which tells the absence from the
+// failure of the query apart
+func (c *Classifier) queryValidator(addr sdk.AccAddress) (*stakingtypes.Validator, error) {
+	vals, err := c.staking.QueryValidators()
+	if err != nil {
+		return nil, fmt.Errorf("failed. query validators error: %w", err)
+	}
+
+	valAddr := sdk.ValAddress(addr)
+	for i := range vals {
+		if vals[i].OperatorAddress.Equals(valAddr) {
+			return &vals[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// queryTokenPairs queries all the pages of the token pairs owned by the address
+func (c *Classifier) queryTokenPairs(addrStr string) (tokenPairs []dextypes.TokenPair, err error) {
+	for page := 1; ; page++ {
+		pagePairs, err := c.dex.QueryProducts(addrStr, page, productsPerPage)
+		if err != nil {
+			return nil, fmt.Errorf("failed. query token pairs owned error: %w", err)
+		}
+
+		tokenPairs = append(tokenPairs, pagePairs...)
+		if len(pagePairs) < productsPerPage {
+			return tokenPairs, nil
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package analysis
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/okex/okchain-go-sdk/exposed"
+	dextypes "github.com/okex/okchain-go-sdk/module/dex/types"
+	stakingtypes "github.com/okex/okchain-go-sdk/module/staking/types"
+	tokentypes "github.com/okex/okchain-go-sdk/module/token/types"
+	"github.com/okex/okchain-go-sdk/testutil"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeChain serves the roles of the addresses from memory
+type fakeChain struct {
+	// the queries out of the classifier are never called
+	exposed.StakingQuery
+	exposed.DexQuery
+	exposed.TokenQuery
+	vals       []stakingtypes.Validator
+	tokenPairs map[string][]dextypes.TokenPair
+	tokens     map[string][]tokentypes.Token
+	proxies    map[string]bool
+	queryErr   error
+}
+
+func (fc *fakeChain) QueryValidators() ([]stakingtypes.Validator, error) {
+	return fc.vals, fc.queryErr
+}
+
+func (fc *fakeChain) QueryProducts(ownerAddr string, page, perPage int) ([]dextypes.TokenPair, error) {
+	tokenPairs := fc.tokenPairs[ownerAddr]
+	start, end := (page-1)*perPage, page*perPage
+	if start > len(tokenPairs) {
+		start = len(tokenPairs)
+	}
+	if end > len(tokenPairs) {
+		end = len(tokenPairs)
+	}
+	return tokenPairs[start:end], nil
+}
+
+func (fc *fakeChain) QueryTokenInfo(ownerAddr, _ string) ([]tokentypes.Token, error) {
+	return fc.tokens[ownerAddr], nil
+}
+
+func (fc *fakeChain) QueryDelegator(delAddrStr string) (stakingtypes.DelegatorResp, error) {
+	return stakingtypes.DelegatorResp{IsProxy: fc.proxies[delAddrStr]}, nil
+}
+
+func TestClassifier_Classify(t *testing.T) {
+	accs := testutil.NewAccounts(t, testutil.FixtureAccountsSeed, 4)
+	operator, issuer, proxy, regular := accs[0].Address, accs[1].Address, accs[2].Address, accs[3].Address
+	fc := &fakeChain{
+		vals:       []stakingtypes.Validator{{OperatorAddress: sdk.ValAddress(operator)}},
+		tokenPairs: map[string][]dextypes.TokenPair{issuer.String(): make([]dextypes.TokenPair, productsPerPage+1)},
+		tokens: map[string][]tokentypes.Token{
+			operator.String(): {{Symbol: "btc-000", Owner: operator}},
+			issuer.String():   {{Symbol: "eth-001", Owner: issuer}},
+		},
+		proxies: map[string]bool{proxy.String(): true},
+	}
+	classifier := NewClassifier(fc, fc, fc)
+
+	profile, err := classifier.Classify(operator.String())
+	require.NoError(t, err)
+	require.Equal(t, []Label{LabelValidatorOperator, LabelTokenIssuer}, profile.Labels)
+	require.True(t, profile.Validator.OperatorAddress.Equals(sdk.ValAddress(operator)))
+	require.True(t, profile.Is(LabelTokenIssuer))
+	require.False(t, profile.Is(LabelRegular))
+
+	// all the pages of the token pairs
+	profile, err = classifier.Classify(issuer.String())
+	require.NoError(t, err)
+	require.Equal(t, []Label{LabelDexOperator, LabelTokenIssuer}, profile.Labels)
+	require.Len(t, profile.TokenPairs, productsPerPage+1)
+	require.Nil(t, profile.Validator)
+
+	profile, err = classifier.Classify(proxy.String())
+	require.NoError(t, err)
+	require.Equal(t, []Label{LabelProxy}, profile.Labels)
+
+	profile, err = classifier.Classify(regular.String())
+	require.NoError(t, err)
+	require.Equal(t, []Label{LabelRegular}, profile.Labels)
+	require.True(t, profile.Address.Equals(regular))
+
+	// the failure of a query isn't taken as the absence of the role
+	fc.queryErr = errors.New("default error")
+	_, err = classifier.Classify(operator.String())
+	require.Error(t, err)
+
+	_, err = classifier.Classify(sdk.ValAddress(operator).String())
+	require.Error(t, err)
+}
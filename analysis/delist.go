@@ -0,0 +1,215 @@
+package analysis
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/okex/okchain-go-sdk/exposed"
+	backendtypes "github.com/okex/okchain-go-sdk/module/backend/types"
+	dextypes "github.com/okex/okchain-go-sdk/module/dex/types"
+	ordertypes "github.com/okex/okchain-go-sdk/module/order/types"
+	sdk "github.com/okex/okchain-go-sdk/types"
+)
+
+// recordsPerPage is the page size to query the recent trades and the open orders
+const recordsPerPage = 200
+
+// BookSide - structure of the depth of a side of the book
+type BookSide struct {
+	Levels int
+	// Quantity is in the base asset, and Notional is in the quote asset by the prices of the levels
+	Quantity sdk.Dec
+	Notional sdk.Dec
+}
+
+// Depositor - structure of an account with the deposits of a token pair, which are returned on delisting
+type Depositor struct {
+	Address  sdk.AccAddress
+	Deposits sdk.DecCoin
+}
+
+// DelistImpact - structure of the report on what delisting a token pair affects
+type DelistImpact struct {
+	Product   string
+	TokenPair dextypes.TokenPair
+	// Bids and Asks are the depth of the open orders, which are all cancelled on delisting
+	Bids BookSide
+	Asks BookSide
+	// Volume24h is the volume of the last 24h in the base asset by the ticker
+	Volume24h sdk.Dec
+	// RecentTrades and RecentVolume are the count and the volume in the base asset of the trades since the time given
+	RecentTrades int
+	RecentVolume sdk.Dec
+	// OpenOrders are the open orders on the token pair of the addresses given, keyed by the addresses with any
+	OpenOrders map[string][]backendtypes.Order
+	// Depositors are the accounts with the deposits of the token pair, i.e. its owner since only the owner deposits
+	Depositors []Depositor
+}
+
+// AffectedAddresses returns the addresses given with the open orders on the token pair, and the depositors
+func (di DelistImpact) AffectedAddresses() (addrStrs []string) {
+	for addrStr := range di.OpenOrders {
+		addrStrs = append(addrStrs, addrStr)
+	}
+	for _, depositor := range di.Depositors {
+		if _, ok := di.OpenOrders[depositor.Address.String()]; !ok {
+			addrStrs = append(addrStrs, depositor.Address.String())
+		}
+	}
+	return
+}
+
+// DelistAnalyzer - structure of the analyzer reporting the impact of delisting the token pairs, which supports the
+// decisions on the delist proposals
+type DelistAnalyzer struct {
+	dex     exposed.DexQuery
+	order   exposed.OrderQuery
+	backend exposed.BackendQuery
+}
+
+// NewDelistAnalyzer creates a new instance of DelistAnalyzer
+func NewDelistAnalyzer(dex exposed.DexQuery, order exposed.OrderQuery, backend exposed.BackendQuery) *DelistAnalyzer {
+	return &DelistAnalyzer{
+		dex:     dex,
+		order:   order,
+		backend: backend,
+	}
+}
+
+// Analyze reports the impact of delisting the token pair of the assets, as given to a delist proposal, with the trades
+// since the time given, 24h before if zero. The chain has no index of the open orders by the token pair, so the open
+// orders are counted by the depth book, and listed for the addresses given only, e.g. the market makers known
+func (da *DelistAnalyzer) Analyze(baseAsset, quoteAsset string, since time.Time, addrStrs ...string) (
+	impact DelistImpact, err error) {
+	impact.Product = fmt.Sprintf("%s_%s", baseAsset, quoteAsset)
+	tokenPair, err := da.queryTokenPair(impact.Product)
+	if err != nil {
+		return
+	}
+	impact.TokenPair = *tokenPair
+	if tokenPair.Deposits.IsPositive() {
+		impact.Depositors = []Depositor{{Address: tokenPair.Owner, Deposits: tokenPair.Deposits}}
+	}
+
+	book, err := da.order.QueryDepthBook(impact.Product)
+	if err != nil {
+		return impact, fmt.Errorf("failed. query depth book error: %w", err)
+	}
+	if impact.Bids, err = newBookSide(book.Bids); err != nil {
+		return
+	}
+	if impact.Asks, err = newBookSide(book.Asks); err != nil {
+		return
+	}
+
+	if err = da.queryVolumes(&impact, since); err != nil {
+		return
+	}
+
+	impact.OpenOrders = make(map[string][]backendtypes.Order)
+	for _, addrStr := range addrStrs {
+		orders, err := da.queryOpenOrders(addrStr, impact.Product)
+		if err != nil {
+			return impact, err
+		}
+		if len(orders) != 0 {
+			impact.OpenOrders[addrStr] = orders
+		}
+	}
+	return
+}
+
+// queryTokenPair finds the token pair of the product in all the token pairs
+func (da *DelistAnalyzer) queryTokenPair(product string) (*dextypes.TokenPair, error) {
+	for page := 1; ; page++ {
+		tokenPairs, err := da.dex.QueryProducts("", page, productsPerPage)
+		if err != nil {
+			return nil, fmt.Errorf("failed. query token pairs error: %w", err)
+		}
+
+		for i := range tokenPairs {
+			if fmt.Sprintf("%s_%s", tokenPairs[i].BaseAssetSymbol, tokenPairs[i].QuoteAssetSymbol) == product {
+				return &tokenPairs[i], nil
+			}
+		}
+		if len(tokenPairs) < productsPerPage {
+			return nil, fmt.Errorf("failed. token pair %s isn't listed", product)
+		}
+	}
+}
+
+func newBookSide(items []ordertypes.BookResItem) (side BookSide, err error) {
+	side.Levels = len(items)
+	side.Quantity, side.Notional = sdk.ZeroDec(), sdk.ZeroDec()
+	for _, item := range items {
+		price, err := sdk.NewDecFromStr(item.Price)
+		if err != nil {
+			return side, fmt.Errorf("failed. parse book price %s error: %w", item.Price, err)
+		}
+		quantity, err := sdk.NewDecFromStr(item.Quantity)
+		if err != nil {
+			return side, fmt.Errorf("failed. parse book quantity %s error: %w", item.Quantity, err)
+		}
+		side.Quantity = side.Quantity.Add(quantity)
+		side.Notional = side.Notional.Add(price.Mul(quantity))
+	}
+	return
+}
+
+// queryVolumes fills the volume of the last 24h by the ticker and the trades since the time given
+func (da *DelistAnalyzer) queryVolumes(impact *DelistImpact, since time.Time) error {
+	tickers, err := da.backend.QueryTickers(impact.Product)
+	if err != nil {
+		return fmt.Errorf("failed. query ticker error: %w", err)
+	}
+	impact.Volume24h = sdk.ZeroDec()
+	for _, ticker := range tickers {
+		if ticker.Product != impact.Product {
+			continue
+		}
+		if impact.Volume24h, err = sdk.NewDecFromStr(ticker.Volume); err != nil {
+			return fmt.Errorf("failed. parse ticker volume %s error: %w", ticker.Volume, err)
+		}
+	}
+
+	impact.RecentVolume = sdk.ZeroDec()
+	now := time.Now()
+	if since.IsZero() {
+		since = now.Add(-24 * time.Hour)
+	}
+	start, end := int(since.Unix()), int(now.Unix())
+	for page := 1; ; page++ {
+		records, err := da.backend.QueryRecentTxRecord(impact.Product, start, end, page, recordsPerPage)
+		if err != nil {
+			return fmt.Errorf("failed. query recent trades error: %w", err)
+		}
+
+		impact.RecentTrades += len(records)
+		for _, record := range records {
+			quantity, err := sdk.NewDecFromStr(strconv.FormatFloat(record.Quantity, 'f', sdk.Precision, 64))
+			if err != nil {
+				return fmt.Errorf("failed. parse trade quantity %v error: %w", record.Quantity, err)
+			}
+			impact.RecentVolume = impact.RecentVolume.Add(quantity)
+		}
+		if len(records) < recordsPerPage {
+			return nil
+		}
+	}
+}
+
+// queryOpenOrders queries all the pages of the open orders of the address on the product
+func (da *DelistAnalyzer) queryOpenOrders(addrStr, product string) (orders []backendtypes.Order, err error) {
+	for page := 1; ; page++ {
+		pageOrders, err := da.backend.QueryOpenOrders(addrStr, product, "", 0, 0, page, recordsPerPage)
+		if err != nil {
+			return nil, fmt.Errorf("failed. query open orders of %s error: %w", addrStr, err)
+		}
+
+		orders = append(orders, pageOrders...)
+		if len(pageOrders) < recordsPerPage {
+			return orders, nil
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package analysis
+
+import (
+	"testing"
+	"time"
+
+	"github.com/okex/okchain-go-sdk/exposed"
+	backendtypes "github.com/okex/okchain-go-sdk/module/backend/types"
+	dextypes "github.com/okex/okchain-go-sdk/module/dex/types"
+	ordertypes "github.com/okex/okchain-go-sdk/module/order/types"
+	"github.com/okex/okchain-go-sdk/testutil"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeMarket serves the market of the token pairs from memory
+type fakeMarket struct {
+	// the queries out of the analyzer are never called
+	exposed.DexQuery
+	exposed.OrderQuery
+	exposed.BackendQuery
+	tokenPairs []dextypes.TokenPair
+	book       ordertypes.BookRes
+	tickers    []backendtypes.Ticker
+	records    []backendtypes.MatchResult
+	openOrders map[string][]backendtypes.Order
+	starts     []int
+}
+
+func (fm *fakeMarket) QueryProducts(_ string, page, perPage int) ([]dextypes.TokenPair, error) {
+	start, end := (page-1)*perPage, page*perPage
+	if start > len(fm.tokenPairs) {
+		start = len(fm.tokenPairs)
+	}
+	if end > len(fm.tokenPairs) {
+		end = len(fm.tokenPairs)
+	}
+	return fm.tokenPairs[start:end], nil
+}
+
+func (fm *fakeMarket) QueryDepthBook(string) (ordertypes.BookRes, error) {
+	return fm.book, nil
+}
+
+func (fm *fakeMarket) QueryTickers(string, ...int) ([]backendtypes.Ticker, error) {
+	return fm.tickers, nil
+}
+
+func (fm *fakeMarket) QueryRecentTxRecord(_ string, start, _, page, perPage int) ([]backendtypes.MatchResult, error) {
+	fm.starts = append(fm.starts, start)
+	from, to := (page-1)*perPage, page*perPage
+	if from > len(fm.records) {
+		from = len(fm.records)
+	}
+	if to > len(fm.records) {
+		to = len(fm.records)
+	}
+	return fm.records[from:to], nil
+}
+
+func (fm *fakeMarket) QueryOpenOrders(addrStr, _, _ string, _, _, _, _ int) ([]backendtypes.Order, error) {
+	return fm.openOrders[addrStr], nil
+}
+
+func TestDelistAnalyzer_Analyze(t *testing.T) {
+	accs := testutil.NewAccounts(t, testutil.FixtureAccountsSeed, 3)
+	owner, maker, other := accs[0].Address, accs[1].Address, accs[2].Address
+	fm := &fakeMarket{
+		tokenPairs: make([]dextypes.TokenPair, productsPerPage),
+		book: ordertypes.BookRes{
+			Asks: []ordertypes.BookResItem{{Price: "2.1", Quantity: "10"}},
+			Bids: []ordertypes.BookResItem{{Price: "1.9", Quantity: "5"}, {Price: "1.8", Quantity: "10"}},
+		},
+		tickers:    []backendtypes.Ticker{{Product: "btc-000_okt", Volume: "1234.5"}},
+		records:    make([]backendtypes.MatchResult, recordsPerPage+1),
+		openOrders: map[string][]backendtypes.Order{maker.String(): {{OrderID: "ID0000000001-1"}}},
+	}
+	// on the second page of the token pairs
+	fm.tokenPairs = append(fm.tokenPairs, dextypes.TokenPair{
+		BaseAssetSymbol:  "btc-000",
+		QuoteAssetSymbol: "okt",
+		Owner:            owner,
+		Deposits:         sdk.NewDecCoinFromDec("okt", sdk.NewDec(100)),
+	})
+	for i := range fm.records {
+		fm.records[i].Quantity = 0.5
+	}
+	analyzer := NewDelistAnalyzer(fm, fm, fm)
+
+	since := time.Unix(1590000000, 0)
+	impact, err := analyzer.Analyze("btc-000", "okt", since, maker.String(), other.String())
+	require.NoError(t, err)
+	require.Equal(t, "btc-000_okt", impact.Product)
+	require.True(t, impact.TokenPair.Owner.Equals(owner))
+
+	require.Equal(t, 2, impact.Bids.Levels)
+	require.Equal(t, "15.00000000", impact.Bids.Quantity.String())
+	require.Equal(t, "27.50000000", impact.Bids.Notional.String())
+	require.Equal(t, "21.00000000", impact.Asks.Notional.String())
+
+	require.Equal(t, "1234.50000000", impact.Volume24h.String())
+	require.Equal(t, recordsPerPage+1, impact.RecentTrades)
+	require.Equal(t, "100.50000000", impact.RecentVolume.String())
+	require.Equal(t, []int{1590000000, 1590000000}, fm.starts)
+
+	require.Len(t, impact.OpenOrders, 1)
+	require.Len(t, impact.OpenOrders[maker.String()], 1)
+	require.Len(t, impact.Depositors, 1)
+	require.Equal(t, []string{maker.String(), owner.String()}, impact.AffectedAddresses())
+
+	_, err = analyzer.Analyze("eth-000", "okt", since)
+	require.Error(t, err)
+}
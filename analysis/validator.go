@@ -0,0 +1,320 @@
+package analysis
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/okex/okchain-go-sdk/exposed"
+	slashingtypes "github.com/okex/okchain-go-sdk/module/slashing/types"
+	stakingtypes "github.com/okex/okchain-go-sdk/module/staking/types"
+	tmtypes "github.com/okex/okchain-go-sdk/module/tendermint/types"
+	sdk "github.com/okex/okchain-go-sdk/types"
+)
+
+// events and attributes of the slashing module in the block results
+const (
+	eventTypeSlash       = "slash"
+	eventTypeMessage     = "message"
+	attributeKeyJailed   = "jailed"
+	attributeKeyReason   = "reason"
+	attributeKeyAction   = "action"
+	attributeKeyModule   = "module"
+	attributeKeySender   = "sender"
+	attributeValueUnjail = "unjail"
+)
+
+// MissedBlocksWindow - structure of the liveness of a validator in the signed blocks window of the slashing module
+type MissedBlocksWindow struct {
+	ValAddress  sdk.ValAddress
+	ConsAddress sdk.ConsAddress
+	Moniker     string
+	Jailed      bool
+	// Blocks is the number of the blocks counted in the window, fewer than the window for a validator started or
+	// unjailed within it
+	Blocks       int64
+	MissedBlocks int64
+	// MaxMissedBlocks is the max number of the blocks missed in the window without being jailed
+	MaxMissedBlocks int64
+	// Uptime is the ratio of the blocks signed in the window
+	Uptime      sdk.Dec
+	JailedUntil time.Time
+	Tombstoned  bool
+}
+
+// MissedBlocksLeft returns the number of the blocks the validator can miss more before being jailed
+func (w MissedBlocksWindow) MissedBlocksLeft() int64 {
+	if left := w.MaxMissedBlocks - w.MissedBlocks; left > 0 {
+		return left
+	}
+	return 0
+}
+
+// VotingPowerSample - structure of the voting powers of the validator set at a height
+type VotingPowerSample struct {
+	Height     int64
+	TotalPower int64
+	// Powers are the voting powers keyed by the bech32 consensus addresses of the validators
+	Powers map[string]int64
+}
+
+// VotingPowerHistory - structure of the voting powers of the validator set over a height range in order of height
+type VotingPowerHistory []VotingPowerSample
+
+// Powers returns the voting powers of a validator by its bech32 consensus address in the history, which are 0 at the
+// heights it's out of the validator set
+func (vph VotingPowerHistory) Powers(consAddrStr string) []int64 {
+	powers := make([]int64, len(vph))
+	for i, sample := range vph {
+		powers[i] = sample.Powers[consAddrStr]
+	}
+	return powers
+}
+
+// JailEventKind is the kind of a jail event of a validator
+type JailEventKind string
+
+// kinds of the jail events
+const (
+	JailEventJailed   JailEventKind = "jailed"
+	JailEventUnjailed JailEventKind = "unjailed"
+)
+
+// JailEvent - structure of a validator jailed or unjailed at a height
+type JailEvent struct {
+	Height int64
+	Kind   JailEventKind
+	// ValAddress and ConsAddress are empty if the validator isn't found in staking, e.g. removed after unbonded
+	ValAddress  sdk.ValAddress
+	ConsAddress sdk.ConsAddress
+	// Reason is the reason of the slash jailing the validator, e.g. missing_signature or double_sign
+	Reason string
+}
+
+// ValidatorAnalyzer - structure of the analyzer of the performance of the validators, which aggregates the queries of
+// the staking, slashing and tendermint modules for the monitoring dashboards
+type ValidatorAnalyzer struct {
+	tm       exposed.TendermintQuery
+	staking  exposed.StakingQuery
+	slashing exposed.SlashingQuery
+}
+
+// NewValidatorAnalyzer creates a new instance of ValidatorAnalyzer
+func NewValidatorAnalyzer(tm exposed.TendermintQuery, staking exposed.StakingQuery,
+	slashing exposed.SlashingQuery) *ValidatorAnalyzer {
+	return &ValidatorAnalyzer{
+		tm:       tm,
+		staking:  staking,
+		slashing: slashing,
+	}
+}
+
+// QueryMissedBlocksWindow reports the missed blocks of a validator in the signed blocks window by its validator address
+func (va *ValidatorAnalyzer) QueryMissedBlocksWindow(valAddrStr string) (window MissedBlocksWindow, err error) {
+	val, err := va.staking.QueryValidator(valAddrStr)
+	if err != nil {
+		return
+	}
+	consAddr, err := consAddressOf(val)
+	if err != nil {
+		return
+	}
+
+	params, err := va.slashing.QuerySlashingParams()
+	if err != nil {
+		return window, fmt.Errorf("failed. query slashing params error: %w", err)
+	}
+	signingInfo, err := va.slashing.QuerySigningInfo(consAddr.String())
+	if err != nil {
+		return
+	}
+	return newMissedBlocksWindow(val, signingInfo, params), nil
+}
+
+// QueryMissedBlocksWindows reports the missed blocks in the signed blocks window of all the validators with the
+// signing info, i.e. the ones ever bonded
+func (va *ValidatorAnalyzer) QueryMissedBlocksWindows() (windows []MissedBlocksWindow, err error) {
+	params, err := va.slashing.QuerySlashingParams()
+	if err != nil {
+		return nil, fmt.Errorf("failed. query slashing params error: %w", err)
+	}
+	signingInfos, err := va.slashing.QuerySigningInfos()
+	if err != nil {
+		return nil, fmt.Errorf("failed. query signing infos error: %w", err)
+	}
+	signingInfoMap := make(map[string]slashingtypes.ValidatorSigningInfo, len(signingInfos))
+	for _, signingInfo := range signingInfos {
+		signingInfoMap[signingInfo.Address.String()] = signingInfo
+	}
+
+	vals, err := va.staking.QueryValidators()
+	if err != nil {
+		return nil, fmt.Errorf("failed. query validators error: %w", err)
+	}
+	for _, val := range vals {
+		consAddr, err := consAddressOf(val)
+		if err != nil {
+			return nil, err
+		}
+		if signingInfo, ok := signingInfoMap[consAddr.String()]; ok {
+			windows = append(windows, newMissedBlocksWindow(val, signingInfo, params))
+		}
+	}
+	return
+}
+
+func newMissedBlocksWindow(val stakingtypes.Validator, signingInfo slashingtypes.ValidatorSigningInfo,
+	params slashingtypes.Params) MissedBlocksWindow {
+	window := MissedBlocksWindow{
+		ValAddress:      val.OperatorAddress,
+		ConsAddress:     signingInfo.Address,
+		Moniker:         val.Description.Moniker,
+		Jailed:          val.Jailed,
+		Blocks:          signingInfo.IndexOffset,
+		MissedBlocks:    signingInfo.MissedBlocksCounter,
+		MaxMissedBlocks: params.MaxMissedBlocks(),
+		Uptime:          sdk.OneDec(),
+		JailedUntil:     signingInfo.JailedUntil,
+		Tombstoned:      signingInfo.Tombstoned,
+	}
+	// the index offset keeps growing over the window
+	if window.Blocks > params.SignedBlocksWindow {
+		window.Blocks = params.SignedBlocksWindow
+	}
+	if window.Blocks > 0 {
+		window.Uptime = sdk.NewDec(window.Blocks - window.MissedBlocks).QuoInt64(window.Blocks)
+	}
+	return window
+}
+
+// QueryVotingPowerHistory samples the voting powers of the validator set from the height to the height every step
+// blocks, where the last height is always sampled
+func (va *ValidatorAnalyzer) QueryVotingPowerHistory(fromHeight, toHeight, step int64) (history VotingPowerHistory,
+	err error) {
+	if err = checkHeightRange(fromHeight, toHeight); err != nil {
+		return
+	}
+	if step <= 0 {
+		return nil, fmt.Errorf("failed. invalid step %d to sample the voting powers", step)
+	}
+
+	for height := fromHeight; ; height += step {
+		if height > toHeight {
+			height = toHeight
+		}
+		valsResult, err := va.tm.QueryValidatorsResult(height)
+		if err != nil {
+			return nil, fmt.Errorf("failed. query validator set at height %d error: %w", height, err)
+		}
+
+		sample := VotingPowerSample{
+			Height: height,
+			Powers: make(map[string]int64, len(valsResult.Validators)),
+		}
+		for _, val := range valsResult.Validators {
+			sample.Powers[sdk.ConsAddress(val.Address).String()] = val.VotingPower
+			sample.TotalPower += val.VotingPower
+		}
+		history = append(history, sample)
+		if height == toHeight {
+			return history, nil
+		}
+	}
+}
+
+// QueryJailHistory lists the validators jailed by the slashes and unjailed by the txs from the height to the height,
+// scanning the block results of each height
+func (va *ValidatorAnalyzer) QueryJailHistory(fromHeight, toHeight int64) (events []JailEvent, err error) {
+	if err = checkHeightRange(fromHeight, toHeight); err != nil {
+		return
+	}
+
+	vals, err := va.staking.QueryValidators()
+	if err != nil {
+		return nil, fmt.Errorf("failed. query validators error: %w", err)
+	}
+	valAddrs, consAddrs := make(map[string]sdk.ValAddress), make(map[string]sdk.ConsAddress)
+	for _, val := range vals {
+		consAddr, err := consAddressOf(val)
+		if err != nil {
+			return nil, err
+		}
+		valAddrs[consAddr.String()], consAddrs[val.OperatorAddress.String()] = val.OperatorAddress, consAddr
+	}
+
+	for height := fromHeight; height <= toHeight; height++ {
+		blockResults, err := va.tm.QueryBlockResults(height)
+		if err != nil {
+			return nil, fmt.Errorf("failed. query block results at height %d error: %w", height, err)
+		}
+
+		for _, event := range blockResults.Results.BeginBlock.Events {
+			consAddrStr := eventAttribute(event, attributeKeyJailed)
+			if event.Type != eventTypeSlash || len(consAddrStr) == 0 {
+				continue
+			}
+			jailEvent := JailEvent{
+				Height:     height,
+				Kind:       JailEventJailed,
+				ValAddress: valAddrs[consAddrStr],
+				Reason:     eventAttribute(event, attributeKeyReason),
+			}
+			jailEvent.ConsAddress, _ = sdk.ConsAddressFromBech32(consAddrStr)
+			events = append(events, jailEvent)
+		}
+
+		for _, txResult := range blockResults.Results.DeliverTx {
+			if txResult.Code != 0 || !hasEventAttribute(txResult.Events, attributeKeyAction, attributeValueUnjail) {
+				continue
+			}
+			for _, event := range txResult.Events {
+				valAddrStr := eventAttribute(event, attributeKeySender)
+				if event.Type != eventTypeMessage || eventAttribute(event, attributeKeyModule) != slashingtypes.ModuleName ||
+					len(valAddrStr) == 0 {
+					continue
+				}
+				jailEvent := JailEvent{
+					Height:      height,
+					Kind:        JailEventUnjailed,
+					ConsAddress: consAddrs[valAddrStr],
+				}
+				jailEvent.ValAddress, _ = sdk.ValAddressFromBech32(valAddrStr)
+				events = append(events, jailEvent)
+			}
+		}
+	}
+	return
+}
+
+func checkHeightRange(fromHeight, toHeight int64) error {
+	if fromHeight <= 0 || toHeight < fromHeight {
+		return fmt.Errorf("failed. invalid height range [%d, %d]", fromHeight, toHeight)
+	}
+	return nil
+}
+
+// consAddressOf returns the consensus address of the validator by its bech32 consensus pubkey
+func consAddressOf(val stakingtypes.Validator) (sdk.ConsAddress, error) {
+	consPubKey, err := sdk.GetConsPubKeyBech32(val.ConsPubKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed. invalid consensus pubkey of validator %s: %w", val.OperatorAddress, err)
+	}
+	return sdk.ConsAddress(consPubKey.Address()), nil
+}
+
+func eventAttribute(event tmtypes.Event, key string) string {
+	for _, attr := range event.Attributes {
+		if string(attr.Key) == key {
+			return string(attr.Value)
+		}
+	}
+	return ""
+}
+
+func hasEventAttribute(events []tmtypes.Event, key, value string) bool {
+	for _, event := range events {
+		if eventAttribute(event, key) == value {
+			return true
+		}
+	}
+	return false
+}
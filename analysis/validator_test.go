@@ -0,0 +1,189 @@
+package analysis
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/okex/okchain-go-sdk/exposed"
+	slashingtypes "github.com/okex/okchain-go-sdk/module/slashing/types"
+	stakingtypes "github.com/okex/okchain-go-sdk/module/staking/types"
+	tmtypes "github.com/okex/okchain-go-sdk/module/tendermint/types"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+)
+
+// fakeValidatorSet serves the validators with the signing infos and the block results from memory
+type fakeValidatorSet struct {
+	// the queries out of the analyzer are never called
+	exposed.TendermintQuery
+	exposed.StakingQuery
+	exposed.SlashingQuery
+	vals         []stakingtypes.Validator
+	signingInfos []slashingtypes.ValidatorSigningInfo
+	// powers are the voting powers of the validators at every height
+	powers       map[int64][]int64
+	blockResults map[int64]tmtypes.BlockResults
+}
+
+func (fvs *fakeValidatorSet) QueryValidators() ([]stakingtypes.Validator, error) {
+	return fvs.vals, nil
+}
+
+func (fvs *fakeValidatorSet) QueryValidator(valAddrStr string) (stakingtypes.Validator, error) {
+	for _, val := range fvs.vals {
+		if val.OperatorAddress.String() == valAddrStr {
+			return val, nil
+		}
+	}
+	return stakingtypes.Validator{}, errors.New("validator not found")
+}
+
+func (fvs *fakeValidatorSet) QuerySlashingParams() (slashingtypes.Params, error) {
+	return slashingtypes.Params{SignedBlocksWindow: 100, MinSignedPerWindow: sdk.MustNewDecFromStr("0.5")}, nil
+}
+
+func (fvs *fakeValidatorSet) QuerySigningInfo(consAddrStr string) (slashingtypes.ValidatorSigningInfo, error) {
+	for _, signingInfo := range fvs.signingInfos {
+		if signingInfo.Address.String() == consAddrStr {
+			return signingInfo, nil
+		}
+	}
+	return slashingtypes.ValidatorSigningInfo{}, errors.New("signing info not found")
+}
+
+func (fvs *fakeValidatorSet) QuerySigningInfos() ([]slashingtypes.ValidatorSigningInfo, error) {
+	return fvs.signingInfos, nil
+}
+
+func (fvs *fakeValidatorSet) QueryValidatorsResult(height int64) (valsResult tmtypes.ResultValidators, err error) {
+	powers, ok := fvs.powers[height]
+	if !ok {
+		return valsResult, errors.New("height not found")
+	}
+	valsResult.BlockHeight = height
+	for i, power := range powers {
+		valsResult.Validators = append(valsResult.Validators, tmtypes.Validator{
+			Address:     fvs.signingInfos[i].Address.Bytes(),
+			VotingPower: power,
+		})
+	}
+	return
+}
+
+func (fvs *fakeValidatorSet) QueryBlockResults(height int64) (tmtypes.BlockResults, error) {
+	return fvs.blockResults[height], nil
+}
+
+func newTestEvent(eventType string, kvs ...string) tmtypes.Event {
+	event := tmtypes.Event{Type: eventType}
+	for i := 0; i < len(kvs); i += 2 {
+		event.Attributes = append(event.Attributes, tmtypes.KVPair{Key: []byte(kvs[i]), Value: []byte(kvs[i+1])})
+	}
+	return event
+}
+
+func newFakeValidatorSet(t *testing.T) *fakeValidatorSet {
+	fvs := &fakeValidatorSet{powers: make(map[int64][]int64)}
+	for i, moniker := range []string{"alice", "bob"} {
+		consPubKey := ed25519.GenPrivKey().PubKey()
+		bechConsPubKey, err := sdk.Bech32ifyConsPub(consPubKey)
+		require.NoError(t, err)
+		fvs.vals = append(fvs.vals, stakingtypes.Validator{
+			OperatorAddress: sdk.ValAddress(consPubKey.Address()),
+			ConsPubKey:      bechConsPubKey,
+			Jailed:          i == 1,
+			Description:     stakingtypes.Description{Moniker: moniker},
+		})
+		fvs.signingInfos = append(fvs.signingInfos, slashingtypes.ValidatorSigningInfo{
+			Address:             sdk.ConsAddress(consPubKey.Address()),
+			IndexOffset:         int64(1000 - 960*i),
+			MissedBlocksCounter: int64(10 * (i + 1)),
+		})
+	}
+	for height := int64(1); height <= 10; height++ {
+		fvs.powers[height] = []int64{height, 10}
+	}
+	return fvs
+}
+
+func TestValidatorAnalyzer_QueryMissedBlocksWindows(t *testing.T) {
+	fvs := newFakeValidatorSet(t)
+	analyzer := NewValidatorAnalyzer(fvs, fvs, fvs)
+
+	windows, err := analyzer.QueryMissedBlocksWindows()
+	require.NoError(t, err)
+	require.Len(t, windows, 2)
+	// the blocks are capped by the window
+	require.Equal(t, "alice", windows[0].Moniker)
+	require.Equal(t, int64(100), windows[0].Blocks)
+	require.Equal(t, int64(50), windows[0].MaxMissedBlocks)
+	require.Equal(t, int64(40), windows[0].MissedBlocksLeft())
+	require.Equal(t, sdk.MustNewDecFromStr("0.9"), windows[0].Uptime)
+	// unjailed within the window
+	require.True(t, windows[1].Jailed)
+	require.Equal(t, int64(40), windows[1].Blocks)
+	require.Equal(t, sdk.MustNewDecFromStr("0.5"), windows[1].Uptime)
+
+	window, err := analyzer.QueryMissedBlocksWindow(fvs.vals[1].OperatorAddress.String())
+	require.NoError(t, err)
+	require.Equal(t, windows[1], window)
+	_, err = analyzer.QueryMissedBlocksWindow(sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address()).String())
+	require.Error(t, err)
+}
+
+func TestValidatorAnalyzer_QueryVotingPowerHistory(t *testing.T) {
+	fvs := newFakeValidatorSet(t)
+	analyzer := NewValidatorAnalyzer(fvs, fvs, fvs)
+
+	history, err := analyzer.QueryVotingPowerHistory(1, 10, 4)
+	require.NoError(t, err)
+	require.Len(t, history, 4)
+	require.Equal(t, []int64{1, 5, 9, 10}, history.Powers(fvs.signingInfos[0].Address.String()))
+	require.Equal(t, []int64{10, 10, 10, 10}, history.Powers(fvs.signingInfos[1].Address.String()))
+	require.Equal(t, int64(20), history[3].TotalPower)
+
+	_, err = analyzer.QueryVotingPowerHistory(1, 11, 5)
+	require.Error(t, err)
+	_, err = analyzer.QueryVotingPowerHistory(5, 1, 1)
+	require.Error(t, err)
+	_, err = analyzer.QueryVotingPowerHistory(1, 5, 0)
+	require.Error(t, err)
+}
+
+func TestValidatorAnalyzer_QueryJailHistory(t *testing.T) {
+	fvs := newFakeValidatorSet(t)
+	consAddr, valAddr := fvs.signingInfos[1].Address, fvs.vals[1].OperatorAddress
+	fvs.blockResults = map[int64]tmtypes.BlockResults{
+		3: {Results: tmtypes.ABCIResponses{BeginBlock: tmtypes.ResponseBeginBlock{Events: []tmtypes.Event{
+			newTestEvent("liveness", "address", consAddr.String(), "missed_blocks", "50"),
+			// slashed without being jailed
+			newTestEvent("slash", "address", consAddr.String(), "reason", "double_sign"),
+			newTestEvent("slash", "address", consAddr.String(), "reason", "missing_signature",
+				"jailed", consAddr.String()),
+		}}}},
+		8: {Results: tmtypes.ABCIResponses{DeliverTx: []tmtypes.ResponseDeliverTx{
+			// the failed unjail tx
+			{Code: 1, Events: []tmtypes.Event{
+				newTestEvent("message", "action", "unjail"),
+				newTestEvent("message", "module", "slashing", "sender", valAddr.String()),
+			}},
+			{Events: []tmtypes.Event{newTestEvent("message", "action", "send")}},
+			{Events: []tmtypes.Event{
+				newTestEvent("message", "action", "unjail"),
+				newTestEvent("message", "module", "slashing", "sender", valAddr.String()),
+			}},
+		}}},
+	}
+	analyzer := NewValidatorAnalyzer(fvs, fvs, fvs)
+
+	events, err := analyzer.QueryJailHistory(1, 10)
+	require.NoError(t, err)
+	require.Equal(t, []JailEvent{
+		{Height: 3, Kind: JailEventJailed, ValAddress: valAddr, ConsAddress: consAddr, Reason: "missing_signature"},
+		{Height: 8, Kind: JailEventUnjailed, ValAddress: valAddr, ConsAddress: consAddr},
+	}, events)
+
+	_, err = analyzer.QueryJailHistory(0, 10)
+	require.Error(t, err)
+}
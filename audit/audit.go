@@ -0,0 +1,272 @@
+// Package audit provides the batch verification of the signatures in the historical blocks, including the signatures
+// of the txs and the commit signatures of the validators, for the auditors validating ranges of the chain at high
+// throughput.
+package audit
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"runtime"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/okex/okchain-go-sdk/exposed"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	"github.com/okex/okchain-go-sdk/utils"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// TxIndex of the failures not of the txs
+const (
+	// CommitIndex is the TxIndex of the failures of the commit signatures
+	CommitIndex = -1
+	// BlockIndex is the TxIndex of the failures of querying the blocks, which aren't audited
+	BlockIndex = -2
+)
+
+// AccountResolver resolves the account number and the sequence of the signer before the block at the height
+type AccountResolver func(signer sdk.AccAddress, height int64) (utils.SignerAccount, error)
+
+// Config - structure of the config of the block auditor
+type Config struct {
+	ChainID string
+	// Workers is the number of the blocks audited and the txs verified concurrently, runtime.NumCPU() by default
+	Workers int
+	// SkipCommit skips the verification of the commit signatures by the validator sets
+	SkipCommit bool
+}
+
+// Failure - structure of a failed verification in a block
+type Failure struct {
+	Height int64
+	// TxIndex is the index of the tx in the block, or CommitIndex and BlockIndex for the failures of the block
+	TxIndex int
+	Err     error
+}
+
+// BlockReport - structure of the result of the audit of a block
+type BlockReport struct {
+	Height     int64
+	Txs        int
+	Signatures int
+	// CommitVerified tells whether the commit of the block is signed by over 2/3 of the voting power
+	CommitVerified bool
+	Failures       []Failure
+	// Err is the error of querying the block, when the block isn't audited
+	Err error
+}
+
+// Summary - structure of the summary of the audit of a range of blocks
+type Summary struct {
+	FromHeight int64
+	ToHeight   int64
+	Blocks     int
+	Txs        int
+	Signatures int
+	// CommitsVerified is the number of the blocks whose commits are verified
+	CommitsVerified int
+	// Failures are the failed verifications and the unaudited blocks in the ascending order of the height
+	Failures []Failure
+	Elapsed  time.Duration
+}
+
+// OK tells whether all the blocks in the range are audited without failure
+func (s Summary) OK() bool {
+	return len(s.Failures) == 0
+}
+
+// Auditor - structure of the batch verifier of the signatures in the blocks
+type Auditor struct {
+	tm       exposed.TendermintQuery
+	resolver AccountResolver
+	config   Config
+}
+
+// NewAuditor creates a new instance of Auditor
+func NewAuditor(tm exposed.TendermintQuery, resolver AccountResolver, config Config) (*Auditor, error) {
+	if len(config.ChainID) == 0 {
+		return nil, errors.New("failed. empty chain ID")
+	}
+	if resolver == nil {
+		return nil, errors.New("failed. nil account resolver")
+	}
+	if config.Workers < 0 {
+		return nil, errors.New("failed. workers must not be negative")
+	}
+	if config.Workers == 0 {
+		config.Workers = runtime.NumCPU()
+	}
+
+	return &Auditor{
+		tm:       tm,
+		resolver: resolver,
+		config:   config,
+	}, nil
+}
+
+// AuditBlock verifies the signatures of all the txs in the block concurrently, and the commit signatures of the block
+// against the validator set at the height
+func (a *Auditor) AuditBlock(height int64) (report BlockReport) {
+	report.Height = height
+	block, err := a.tm.QueryBlock(height)
+	if err != nil {
+		report.Err = fmt.Errorf("failed. query block error: %w", err)
+		return
+	}
+
+	report.Txs = len(block.Txs)
+	for _, stdTx := range block.Txs {
+		report.Signatures += len(stdTx.Signatures)
+	}
+	report.Failures = a.verifyTxs(height, block.Txs)
+
+	if !a.config.SkipCommit {
+		if err = a.verifyCommit(height); err != nil {
+			report.Failures = append(report.Failures, Failure{Height: height, TxIndex: CommitIndex, Err: err})
+		} else {
+			report.CommitVerified = true
+		}
+	}
+	return
+}
+
+// AuditRange audits the blocks in the range [fromHeight, toHeight] concurrently and summarizes the reports
+func (a *Auditor) AuditRange(fromHeight, toHeight int64) (summary Summary, err error) {
+	if fromHeight <= 0 || toHeight < fromHeight {
+		return summary, fmt.Errorf("failed. invalid range of the heights [%d, %d]", fromHeight, toHeight)
+	}
+
+	start := time.Now()
+	heights := make(chan int64)
+	reports := make(chan BlockReport)
+	var wg sync.WaitGroup
+	for i := 0; i < a.config.Workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for height := range heights {
+				reports <- a.AuditBlock(height)
+			}
+		}()
+	}
+	go func() {
+		for height := fromHeight; height <= toHeight; height++ {
+			heights <- height
+		}
+		close(heights)
+		wg.Wait()
+		close(reports)
+	}()
+
+	summary.FromHeight, summary.ToHeight = fromHeight, toHeight
+	for report := range reports {
+		if report.Err != nil {
+			summary.Failures = append(summary.Failures, Failure{Height: report.Height, TxIndex: BlockIndex,
+				Err: report.Err})
+			continue
+		}
+
+		summary.Blocks++
+		summary.Txs += report.Txs
+		summary.Signatures += report.Signatures
+		if report.CommitVerified {
+			summary.CommitsVerified++
+		}
+		summary.Failures = append(summary.Failures, report.Failures...)
+	}
+
+	sort.SliceStable(summary.Failures, func(i, j int) bool {
+		if summary.Failures[i].Height != summary.Failures[j].Height {
+			return summary.Failures[i].Height < summary.Failures[j].Height
+		}
+		return summary.Failures[i].TxIndex < summary.Failures[j].TxIndex
+	})
+	summary.Elapsed = time.Since(start)
+	return
+}
+
+// verifyTxs resolves the accounts of the signers in the order of the txs, and then verifies the signatures
+// concurrently. The sequence of each signer increases by each tx it signs in the block
+func (a *Auditor) verifyTxs(height int64, stdTxs []sdk.StdTx) (failures []Failure) {
+	signerAccs := make([][]utils.SignerAccount, len(stdTxs))
+	resolveErrs := make([]error, len(stdTxs))
+	accounts := make(map[string]utils.SignerAccount)
+	for i, stdTx := range stdTxs {
+		signerAccs[i] = make([]utils.SignerAccount, 0, len(stdTx.Signatures))
+		for _, signer := range utils.GetStdTxSigners(stdTx) {
+			acc, ok := accounts[signer.String()]
+			if !ok {
+				var err error
+				if acc, err = a.resolver(signer, height); err != nil {
+					resolveErrs[i] = fmt.Errorf("failed. resolve the account of the signer %s error: %w", signer, err)
+					break
+				}
+			}
+			signerAccs[i] = append(signerAccs[i], acc)
+			acc.Sequence++
+			accounts[signer.String()] = acc
+		}
+	}
+
+	errs := make([]error, len(stdTxs))
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for i := 0; i < a.config.Workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range indexes {
+				if resolveErrs[index] != nil {
+					errs[index] = resolveErrs[index]
+					continue
+				}
+				errs[index] = utils.VerifyStdTxSignatures(stdTxs[index], a.config.ChainID, signerAccs[index])
+			}
+		}()
+	}
+	for i := range stdTxs {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			failures = append(failures, Failure{Height: height, TxIndex: i, Err: err})
+		}
+	}
+	return
+}
+
+// verifyCommit verifies the commit signatures of the block by the validator set at the height, the same as the light
+// client does
+func (a *Auditor) verifyCommit(height int64) error {
+	commitRes, err := a.tm.QueryCommitResult(height)
+	if err != nil {
+		return fmt.Errorf("failed. query commit error: %w", err)
+	}
+	valsRes, err := a.tm.QueryValidatorsResult(height)
+	if err != nil {
+		return fmt.Errorf("failed. query validators error: %w", err)
+	}
+	if len(valsRes.Validators) == 0 {
+		return fmt.Errorf("failed. empty validator set at height %d", height)
+	}
+
+	vals := make([]*tmtypes.Validator, len(valsRes.Validators))
+	for i, val := range valsRes.Validators {
+		vals[i] = tmtypes.NewValidator(val.PubKey, val.VotingPower)
+	}
+
+	commit := commitRes.Commit
+	if !bytes.Equal(commitRes.Header.Hash(), commit.BlockID.Hash) {
+		return fmt.Errorf("failed. the commit of block %X doesn't match the header %X", commit.BlockID.Hash,
+			commitRes.Header.Hash())
+	}
+	if err = tmtypes.NewValidatorSet(vals).VerifyCommit(a.config.ChainID, commit.BlockID, height, &commit); err != nil {
+		return fmt.Errorf("failed. invalid commit: %w", err)
+	}
+	return nil
+}
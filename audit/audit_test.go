@@ -0,0 +1,150 @@
+package audit
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/okex/okchain-go-sdk/exposed"
+	"github.com/okex/okchain-go-sdk/mocks"
+	"github.com/okex/okchain-go-sdk/module/tendermint/types"
+	token "github.com/okex/okchain-go-sdk/module/token/types"
+	"github.com/okex/okchain-go-sdk/testutil"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	"github.com/okex/okchain-go-sdk/utils"
+	"github.com/stretchr/testify/require"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+const testChainID = "okchain"
+
+// fakeChain serves the blocks, the commits and the validators from memory
+type fakeChain struct {
+	// the queries out of the auditor are never called
+	exposed.TendermintQuery
+	blocks  map[int64]types.Block
+	commits map[int64]types.ResultCommit
+	vals    types.ResultValidators
+}
+
+func (fc *fakeChain) QueryBlock(height int64) (types.Block, error) {
+	block, ok := fc.blocks[height]
+	if !ok {
+		return block, fmt.Errorf("block %d not found", height)
+	}
+	return block, nil
+}
+
+func (fc *fakeChain) QueryCommitResult(height int64) (types.ResultCommit, error) {
+	return fc.commits[height], nil
+}
+
+func (fc *fakeChain) QueryValidatorsResult(height int64) (types.ResultValidators, error) {
+	return fc.vals, nil
+}
+
+func newFakeChain(t *testing.T) *fakeChain {
+	valSet, privVals := tmtypes.RandValidatorSet(4, 10)
+	fc := &fakeChain{
+		blocks:  make(map[int64]types.Block),
+		commits: make(map[int64]types.ResultCommit),
+	}
+	// in the reverse order of the addresses
+	for i := len(valSet.Validators) - 1; i >= 0; i-- {
+		val := valSet.Validators[i]
+		fc.vals.Validators = append(fc.vals.Validators, types.Validator{
+			Address:     val.Address,
+			PubKey:      val.PubKey,
+			VotingPower: val.VotingPower,
+		})
+	}
+
+	for height := int64(10); height <= 11; height++ {
+		header := tmtypes.Header{ChainID: testChainID, Height: height, Time: time.Now()}
+		blockID := tmtypes.BlockID{
+			Hash:        header.Hash(),
+			PartsHeader: tmtypes.PartSetHeader{Total: 1, Hash: header.Hash()},
+		}
+		voteSet := tmtypes.NewVoteSet(testChainID, height, 0, tmtypes.PrecommitType, valSet)
+		commit, err := tmtypes.MakeCommit(blockID, height, 0, voteSet, privVals)
+		require.NoError(t, err)
+		fc.commits[height] = types.ResultCommit{
+			SignedHeader: types.SignedHeader{Header: header, Commit: *commit},
+		}
+	}
+	return fc
+}
+
+func TestAuditor(t *testing.T) {
+	fc := newFakeChain(t)
+	accs := testutil.NewAccounts(t, testutil.FixtureAccountsSeed, 2)
+	fees, err := sdk.ParseDecCoins(mocks.FixtureFees)
+	require.NoError(t, err)
+	fee := sdk.NewStdFee(mocks.FixtureGas, fees)
+	msgs := []sdk.Msg{token.NewMsgTokenSend(accs[0].Address, accs[1].Address, fees)}
+
+	// the sequences increase by the txs of the same signer in a block
+	fc.blocks[10] = types.Block{Data: types.NewData([]sdk.StdTx{
+		accs[0].SignStdTx(t, testChainID, msgs, fee, "", 5),
+		accs[0].SignStdTx(t, testChainID, msgs, fee, "", 6),
+		accs[1].SignStdTx(t, testChainID, msgs, fee, "", 3),
+	})}
+	// signed with a wrong sequence and the commit of another block
+	fc.blocks[11] = types.Block{Data: types.NewData([]sdk.StdTx{
+		accs[1].SignStdTx(t, testChainID, msgs, fee, "", 9),
+		accs[0].SignStdTx(t, testChainID, msgs, fee, "", 5),
+	})}
+	fc.commits[11] = types.ResultCommit{
+		SignedHeader: types.SignedHeader{Header: fc.commits[11].Header, Commit: fc.commits[10].Commit},
+	}
+
+	sequences := map[string]uint64{accs[0].Address.String(): 5, accs[1].Address.String(): 3}
+	resolver := func(signer sdk.AccAddress, height int64) (utils.SignerAccount, error) {
+		for _, acc := range accs {
+			if acc.Address.Equals(signer) {
+				return utils.SignerAccount{AccountNumber: acc.AccountNumber, Sequence: sequences[signer.String()]},
+					nil
+			}
+		}
+		return utils.SignerAccount{}, errors.New("unknown signer")
+	}
+	auditor, err := NewAuditor(fc, resolver, Config{ChainID: testChainID, Workers: 2})
+	require.NoError(t, err)
+
+	report := auditor.AuditBlock(10)
+	require.NoError(t, report.Err)
+	require.Equal(t, 3, report.Txs)
+	require.Equal(t, 3, report.Signatures)
+	require.True(t, report.CommitVerified)
+	require.Empty(t, report.Failures)
+
+	summary, err := auditor.AuditRange(10, 12)
+	require.NoError(t, err)
+	require.False(t, summary.OK())
+	require.Equal(t, 2, summary.Blocks)
+	require.Equal(t, 5, summary.Txs)
+	require.Equal(t, 1, summary.CommitsVerified)
+	require.Len(t, summary.Failures, 3)
+	require.Equal(t, Failure{Height: 11, TxIndex: CommitIndex}, Failure{Height: summary.Failures[0].Height,
+		TxIndex: summary.Failures[0].TxIndex})
+	require.Equal(t, int64(11), summary.Failures[1].Height)
+	require.Equal(t, 0, summary.Failures[1].TxIndex)
+	require.Equal(t, int64(12), summary.Failures[2].Height)
+	require.Equal(t, BlockIndex, summary.Failures[2].TxIndex)
+
+	// the signatures only
+	auditor, err = NewAuditor(fc, resolver, Config{ChainID: testChainID, SkipCommit: true})
+	require.NoError(t, err)
+	summary, err = auditor.AuditRange(10, 10)
+	require.NoError(t, err)
+	require.True(t, summary.OK())
+	require.Zero(t, summary.CommitsVerified)
+
+	_, err = auditor.AuditRange(10, 9)
+	require.Error(t, err)
+	_, err = NewAuditor(fc, nil, Config{ChainID: testChainID})
+	require.Error(t, err)
+	_, err = NewAuditor(fc, resolver, Config{})
+	require.Error(t, err)
+}
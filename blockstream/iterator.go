@@ -0,0 +1,265 @@
+// Package blockstream provides the iterator of the blocks from a height onwards, which catches up the historical
+// blocks by the queries and follows the new blocks by the subscription at the tip. The blocks are fetched ahead of the
+// consumer into a bounded buffer only, so a slow consumer never blows the memory. It's the backbone of the indexers
+// built on gosdk.
+package blockstream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	sdk "github.com/okex/okchain-go-sdk/types"
+	"github.com/okex/okchain-go-sdk/utils"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+)
+
+const (
+	newBlockHeaderQuery = "tm.event='NewBlockHeader'"
+	defaultBuffer       = 16
+	defaultPollInterval = time.Second
+	defaultMaxRetries   = 3
+)
+
+// Chain shows the expected behavior of the node the blocks are fetched from, which the base client implements
+type Chain interface {
+	sdk.ClientSubscription
+	Block(height *int64) (*ctypes.ResultBlock, error)
+	Commit(height *int64) (*ctypes.ResultCommit, error)
+}
+
+// Config - structure of the config of the block iterator
+type Config struct {
+	// Buffer is the max number of the blocks fetched ahead of the consumer, 16 by default. The fetching pauses while
+	// the buffer is full
+	Buffer int
+	// PollInterval is the interval of polling the latest height at the tip without the subscription of the new
+	// blocks, e.g. on a node without the websocket, and the pause before retrying a failed query, 1s by default
+	PollInterval time.Duration
+	// MaxRetries is the max number of the retries of a failed query before the iterator fails, 3 by default
+	MaxRetries int
+}
+
+// ValidateBasic gives a quick validity check for the block iterator config
+func (c Config) ValidateBasic() error {
+	if c.Buffer < 0 || c.PollInterval < 0 || c.MaxRetries < 0 {
+		return errors.New("failed. buffer, poll interval and max retries must not be negative")
+	}
+	return nil
+}
+
+// Iterator - structure of the iterator of the decoded blocks in order of height, e.g.
+//
+//	it, err := cli.Blocks(ctx, fromHeight, blockstream.Config{})
+//	defer it.Close()
+//	for it.Next() {
+//		block := it.Block()
+//	}
+//	err = it.Err()
+type Iterator struct {
+	parent context.Context
+	chain  Chain
+	cdc    sdk.SDKCodec
+	config Config
+	blocks chan utils.DecodedBlock
+	cancel context.CancelFunc
+	done   chan struct{}
+	// err is set before the blocks are closed
+	err     error
+	current utils.DecodedBlock
+}
+
+// NewIterator creates an iterator of the blocks from the height onwards, which runs until the ctx is done or it's
+// closed. The txs of the blocks are decoded by the codec
+func NewIterator(ctx context.Context, chain Chain, cdc sdk.SDKCodec, fromHeight int64, config Config) (*Iterator,
+	error) {
+	if fromHeight <= 0 {
+		return nil, fmt.Errorf("failed. invalid height %d to iterate the blocks from", fromHeight)
+	}
+	if err := config.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if config.Buffer == 0 {
+		config.Buffer = defaultBuffer
+	}
+	if config.PollInterval == 0 {
+		config.PollInterval = defaultPollInterval
+	}
+	if config.MaxRetries == 0 {
+		config.MaxRetries = defaultMaxRetries
+	}
+
+	parent := ctx
+	ctx, cancel := context.WithCancel(ctx)
+	it := &Iterator{
+		parent: parent,
+		chain:  chain,
+		cdc:    cdc,
+		config: config,
+		blocks: make(chan utils.DecodedBlock, config.Buffer),
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+	go it.run(ctx, fromHeight)
+	return it, nil
+}
+
+// Next waits for the next block, and returns false once the iteration stops by an error, the ctx done or Close
+func (it *Iterator) Next() bool {
+	block, ok := <-it.blocks
+	if !ok {
+		return false
+	}
+	it.current = block
+	return true
+}
+
+// Block returns the block got by the latest Next
+func (it *Iterator) Block() utils.DecodedBlock {
+	return it.current
+}
+
+// C returns the channel of the blocks for the consumers selecting on it, which is closed once the iteration stops
+func (it *Iterator) C() <-chan utils.DecodedBlock {
+	return it.blocks
+}
+
+// Err returns the error stopping the iteration, which is only valid after Next returns false or C is closed. It's
+// nil if the iterator is closed, or the error of the ctx if it's done
+func (it *Iterator) Err() error {
+	return it.err
+}
+
+// Close stops the iteration and waits for the fetching to stop
+func (it *Iterator) Close() {
+	it.cancel()
+	<-it.done
+	// drain the blocks buffered
+	for range it.blocks {
+	}
+}
+
+func (it *Iterator) run(ctx context.Context, next int64) {
+	defer close(it.done)
+	defer close(it.blocks)
+	var wake <-chan struct{}
+	// polls the tip once the subscription is unavailable on the node
+	var polling bool
+	for {
+		latest, err := it.latestHeight(ctx)
+		if err != nil {
+			it.stop(ctx, err)
+			return
+		}
+		for ; next <= latest; next++ {
+			block, err := it.fetch(ctx, next)
+			if err != nil {
+				it.stop(ctx, err)
+				return
+			}
+			select {
+			case it.blocks <- block:
+			case <-ctx.Done():
+				it.stop(ctx, nil)
+				return
+			}
+		}
+
+		// at the tip, the new blocks are waited for by the subscription, or polled without it
+		if wake == nil && !polling {
+			if wake = it.subscribe(ctx); wake != nil {
+				// catches up the blocks committed before the subscription first
+				continue
+			}
+			polling = true
+		}
+		var poll <-chan time.Time
+		if polling {
+			poll = time.After(it.config.PollInterval)
+		}
+		select {
+		case <-ctx.Done():
+			it.stop(ctx, nil)
+			return
+		case _, ok := <-wake:
+			if !ok {
+				// subscribed again on the next tip
+				wake = nil
+			}
+		case <-poll:
+		}
+	}
+}
+
+// stop keeps the error stopping the iteration, where the queries interrupted by Close are no error
+func (it *Iterator) stop(ctx context.Context, err error) {
+	switch {
+	case it.parent.Err() != nil:
+		err = it.parent.Err()
+	case ctx.Err() != nil:
+		err = nil
+	}
+	it.err = err
+}
+
+// subscribe subscribes the new blocks, whose events only wake the iterator up to query the blocks, so that a slow
+// consumer never blocks the websocket. It returns nil if the subscription is unavailable
+func (it *Iterator) subscribe(ctx context.Context) <-chan struct{} {
+	subscriber := fmt.Sprintf("gosdk-blockstream-%d", time.Now().UnixNano())
+	events, err := it.chain.Subscribe(ctx, subscriber, newBlockHeaderQuery)
+	if err != nil {
+		return nil
+	}
+
+	wake := make(chan struct{}, 1)
+	go func() {
+		defer close(wake)
+		for range events {
+			select {
+			case wake <- struct{}{}:
+			default:
+			}
+		}
+	}()
+	return wake
+}
+
+func (it *Iterator) latestHeight(ctx context.Context) (height int64, err error) {
+	err = it.retry(ctx, func() error {
+		res, err := it.chain.Commit(nil)
+		if err != nil {
+			return fmt.Errorf("failed. query latest height error: %w", err)
+		}
+		height = res.Height
+		return nil
+	})
+	return
+}
+
+func (it *Iterator) fetch(ctx context.Context, height int64) (block utils.DecodedBlock, err error) {
+	err = it.retry(ctx, func() error {
+		res, err := it.chain.Block(&height)
+		if err != nil {
+			return fmt.Errorf("failed. query block %d error: %w", height, err)
+		}
+		block, err = utils.DecodeResultBlock(it.cdc, res)
+		return err
+	})
+	return
+}
+
+// retry runs the query with the retries paused by the poll interval
+func (it *Iterator) retry(ctx context.Context, query func() error) (err error) {
+	for i := 0; ; i++ {
+		if err = query(); err == nil || i >= it.config.MaxRetries {
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(it.config.PollInterval):
+		}
+	}
+}
@@ -0,0 +1,138 @@
+package blockstream
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	sdk "github.com/okex/okchain-go-sdk/types"
+	"github.com/stretchr/testify/require"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// fakeChain grows by the blocks added, and notifies the subscribers of them
+type fakeChain struct {
+	mtx          sync.Mutex
+	latestHeight int64
+	blockQueries int
+	blockErr     error
+	subscribeErr error
+	events       chan ctypes.ResultEvent
+}
+
+func (fc *fakeChain) addBlock() {
+	fc.mtx.Lock()
+	fc.latestHeight++
+	events := fc.events
+	fc.mtx.Unlock()
+	if events != nil {
+		events <- ctypes.ResultEvent{}
+	}
+}
+
+func (fc *fakeChain) queries() int {
+	fc.mtx.Lock()
+	defer fc.mtx.Unlock()
+	return fc.blockQueries
+}
+
+func (fc *fakeChain) Block(height *int64) (*ctypes.ResultBlock, error) {
+	fc.mtx.Lock()
+	defer fc.mtx.Unlock()
+	fc.blockQueries++
+	if fc.blockErr != nil {
+		return nil, fc.blockErr
+	}
+	if *height > fc.latestHeight {
+		return nil, errors.New("height must be less than or equal to the latest height")
+	}
+	block := tmtypes.MakeBlock(*height, nil, &tmtypes.Commit{}, nil)
+	return &ctypes.ResultBlock{
+		BlockMeta: &tmtypes.BlockMeta{BlockID: tmtypes.BlockID{Hash: block.Hash()}, Header: block.Header},
+		Block:     block,
+	}, nil
+}
+
+func (fc *fakeChain) Commit(*int64) (*ctypes.ResultCommit, error) {
+	fc.mtx.Lock()
+	defer fc.mtx.Unlock()
+	return &ctypes.ResultCommit{
+		SignedHeader: tmtypes.SignedHeader{Header: &tmtypes.Header{Height: fc.latestHeight}},
+	}, nil
+}
+
+func (fc *fakeChain) Subscribe(context.Context, string, string) (<-chan ctypes.ResultEvent, error) {
+	fc.mtx.Lock()
+	defer fc.mtx.Unlock()
+	if fc.subscribeErr != nil {
+		return nil, fc.subscribeErr
+	}
+	fc.events = make(chan ctypes.ResultEvent, 1)
+	return fc.events, nil
+}
+
+func requireNextHeight(t *testing.T, it *Iterator, height int64) {
+	require.True(t, it.Next())
+	require.Equal(t, height, it.Block().Header.Height)
+}
+
+func TestIterator(t *testing.T) {
+	cdc := sdk.NewCodec()
+	chain := &fakeChain{latestHeight: 3}
+	_, err := NewIterator(context.Background(), chain, cdc, 0, Config{})
+	require.Error(t, err)
+	_, err = NewIterator(context.Background(), chain, cdc, 1, Config{Buffer: -1})
+	require.Error(t, err)
+
+	// catches up the blocks behind the tip, then follows the new blocks by the subscription
+	it, err := NewIterator(context.Background(), chain, cdc, 2, Config{PollInterval: time.Hour})
+	require.NoError(t, err)
+	requireNextHeight(t, it, 2)
+	requireNextHeight(t, it, 3)
+	go chain.addBlock()
+	requireNextHeight(t, it, 4)
+	it.Close()
+	require.False(t, it.Next())
+	require.NoError(t, it.Err())
+
+	// polls the tip without the subscription
+	chain.subscribeErr, chain.events = errors.New("websocket unavailable"), nil
+	it, err = NewIterator(context.Background(), chain, cdc, 5, Config{PollInterval: time.Millisecond})
+	require.NoError(t, err)
+	go chain.addBlock()
+	requireNextHeight(t, it, 5)
+	it.Close()
+
+	// the fetching pauses while the buffer is full
+	chain.latestHeight, chain.blockQueries = 100, 0
+	it, err = NewIterator(context.Background(), chain, cdc, 1, Config{Buffer: 2, PollInterval: time.Millisecond})
+	require.NoError(t, err)
+	time.Sleep(20 * time.Millisecond)
+	// the buffer and the block waiting for the room
+	require.Equal(t, 3, chain.queries())
+	for height := int64(1); height <= 100; height++ {
+		requireNextHeight(t, it, height)
+	}
+	it.Close()
+
+	// fails after the retries
+	chain.blockErr, chain.blockQueries = errors.New("block pruned"), 0
+	it, err = NewIterator(context.Background(), chain, cdc, 1, Config{PollInterval: time.Millisecond, MaxRetries: 2})
+	require.NoError(t, err)
+	require.False(t, it.Next())
+	require.True(t, errors.Is(it.Err(), chain.blockErr))
+	require.Equal(t, 3, chain.queries())
+
+	// stops with the ctx
+	chain.blockErr = nil
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	it, err = NewIterator(ctx, chain, cdc, 101, Config{PollInterval: time.Millisecond})
+	require.NoError(t, err)
+	_, ok := <-it.C()
+	require.False(t, ok)
+	require.True(t, errors.Is(it.Err(), context.DeadlineExceeded))
+}
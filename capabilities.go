@@ -0,0 +1,93 @@
+package gosdk
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/okex/okchain-go-sdk/module"
+	"github.com/okex/okchain-go-sdk/module/backend"
+	"github.com/okex/okchain-go-sdk/module/dex"
+	"github.com/okex/okchain-go-sdk/module/distribution"
+	"github.com/okex/okchain-go-sdk/module/governance"
+	"github.com/okex/okchain-go-sdk/module/mint"
+	"github.com/okex/okchain-go-sdk/module/order"
+	"github.com/okex/okchain-go-sdk/module/slashing"
+	"github.com/okex/okchain-go-sdk/module/staking"
+	"github.com/okex/okchain-go-sdk/module/token"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	sdkerrors "github.com/okex/okchain-go-sdk/types/errors"
+)
+
+// probeEndpoint is the query endpoint probed under the route of a module, which no module serves. A node with the
+// module answers it by the error of an unknown endpoint of the module, and a node without by the unknown route
+const probeEndpoint = "capability-probe"
+
+// moduleQueryRoutes are the query routes of the optional modules on the node probed for their availability. The core
+// modules auth and tendermint are always available
+var moduleQueryRoutes = map[string]string{
+	backend.ModuleName:      "backend",
+	dex.ModuleName:          "dex",
+	distribution.ModuleName: "distr",
+	governance.ModuleName:   "gov",
+	mint.ModuleName:         "mint",
+	order.ModuleName:        "order",
+	slashing.ModuleName:     "slashing",
+	staking.ModuleName:      "staking",
+	token.ModuleName:        "token",
+}
+
+// DetectCapabilities probes the node for each optional module registered, e.g. a node built without the dex module,
+// which should be called once the client is created. Then all the queries and the txs of the modules unavailable are
+// refused by sdkerrors.ErrModuleUnavailable at once instead of the opaque errors of the node, in the client and all
+// its copies and views. It fails if the node is unreachable
+func (cli *Client) DetectCapabilities() (sdk.Capabilities, error) {
+	capabilities := sdk.Capabilities{Detected: true}
+	for _, name := range cli.moduleNames {
+		capability := sdk.ModuleCapability{Module: name, Available: true}
+		if route, ok := moduleQueryRoutes[name]; ok {
+			_, err := cli.baseClient.Query(fmt.Sprintf("custom/%s/%s", route, probeEndpoint), nil)
+			var abciErr *sdkerrors.ABCIError
+			switch {
+			case errors.Is(err, sdkerrors.ErrModuleUnavailable):
+				capability.Available, capability.Reason = false, err.Error()
+			case err != nil && !errors.As(err, &abciErr):
+				return sdk.Capabilities{}, fmt.Errorf("failed. probe module %s error: %w", name, err)
+			}
+		}
+		capabilities.Modules = append(capabilities.Modules, capability)
+	}
+
+	// the modules of the client and all its copies and views are rebuilt on their next use, the codec is already
+	// registered and sealed
+	cli.capabilities.set(capabilities)
+	return capabilities, nil
+}
+
+// Capabilities returns the availability of the modules registered on the node, which are all assumed available
+// until DetectCapabilities is called
+func (cli *Client) Capabilities() sdk.Capabilities {
+	if capabilities, _ := cli.capabilities.get(); capabilities.Detected {
+		return capabilities
+	}
+
+	capabilities := sdk.Capabilities{Modules: make([]sdk.ModuleCapability, len(cli.moduleNames))}
+	for i, name := range cli.moduleNames {
+		capabilities.Modules[i] = sdk.ModuleCapability{Module: name, Available: true}
+	}
+	return capabilities
+}
+
+// IsModuleAvailable tells whether the module is registered in the client and available on the node
+func (cli *Client) IsModuleAvailable(moduleName string) bool {
+	return cli.Capabilities().IsAvailable(moduleName)
+}
+
+// moduleBaseClient returns the base client of the module, which refuses everything if the module is unavailable
+func moduleBaseClient(baseClient sdk.BaseClient, moduleName string, capabilities sdk.Capabilities) sdk.BaseClient {
+	for _, capability := range capabilities.Modules {
+		if capability.Module == moduleName && !capability.Available {
+			return module.NewUnavailableBaseClient(baseClient, moduleName, capability.Reason)
+		}
+	}
+	return baseClient
+}
@@ -0,0 +1,101 @@
+package gosdk
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/okex/okchain-go-sdk/mocks"
+	"github.com/okex/okchain-go-sdk/module/dex"
+	"github.com/okex/okchain-go-sdk/module/staking"
+	"github.com/okex/okchain-go-sdk/module/tendermint"
+	"github.com/okex/okchain-go-sdk/module/token"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	sdkerrors "github.com/okex/okchain-go-sdk/types/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestClient_DetectCapabilities(t *testing.T) {
+	config, err := sdk.NewClientConfig("testURL", "okchain", sdk.BroadcastBlock, "0.01okt", 200000,
+		0, "")
+	require.NoError(t, err)
+	backend := mocks.NewConformanceBackend()
+	cli := newClientWithRPC(config, []string{tendermint.ModuleName, staking.ModuleName, dex.ModuleName,
+		token.ModuleName}, backend)
+	copied := cli
+	require.False(t, cli.Capabilities().Detected)
+	require.True(t, cli.IsModuleAvailable(dex.ModuleName))
+
+	// the node is unreachable
+	unreachable := mocks.NewConformanceBackend()
+	unreachable.SetError("custom/staking/capability-probe", nil, errors.New("connection refused"))
+	unreachableCli := newClientWithRPC(config, []string{staking.ModuleName}, unreachable)
+	_, err = unreachableCli.DetectCapabilities()
+	require.Error(t, err)
+
+	// the staking and token modules answer the probes, and the dex module is unknown to the node
+	backend.SetResponse("custom/staking/capability-probe", nil, abci.ResponseQuery{
+		Code: uint32(sdk.CodeUnknownRequest),
+		Log:  "unknown staking query endpoint",
+	})
+	backend.SetResponse("custom/token/capability-probe", nil, abci.ResponseQuery{})
+	capabilities, err := cli.DetectCapabilities()
+	require.NoError(t, err)
+	require.True(t, capabilities.Detected)
+	require.Equal(t, []string{dex.ModuleName}, capabilities.Unavailable())
+	require.True(t, cli.IsModuleAvailable(tendermint.ModuleName))
+	require.True(t, cli.IsModuleAvailable(staking.ModuleName))
+	require.False(t, copied.IsModuleAvailable(dex.ModuleName))
+	require.False(t, cli.IsModuleAvailable("farm"))
+
+	// the dex queries are refused without reaching the node, in the copies and the views as well
+	calls := len(backend.Calls())
+	_, err = cli.Dex().QueryProducts("", 1, 10)
+	require.True(t, errors.Is(err, sdkerrors.ErrModuleUnavailable))
+	_, err = copied.Dex().QueryProducts("", 1, 10)
+	require.True(t, errors.Is(err, sdkerrors.ErrModuleUnavailable))
+	view, err := cli.WithHeight(1024)
+	require.NoError(t, err)
+	_, err = view.Dex().QueryProducts("", 1, 10)
+	require.True(t, errors.Is(err, sdkerrors.ErrModuleUnavailable))
+	require.Len(t, backend.Calls(), calls)
+}
+
+func TestClient_DetectCapabilitiesConcurrently(t *testing.T) {
+	config, err := sdk.NewClientConfig("testURL", "okchain", sdk.BroadcastBlock, "0.01okt", 200000,
+		0, "")
+	require.NoError(t, err)
+	backend := mocks.NewConformanceBackend()
+	backend.SetResponse("custom/staking/capability-probe", nil, abci.ResponseQuery{})
+	cli := newClientWithRPC(config, []string{tendermint.ModuleName, staking.ModuleName, dex.ModuleName}, backend)
+	copied := cli
+	// the view created before the detection follows it as well
+	view, err := cli.WithHeight(1024)
+	require.NoError(t, err)
+
+	// the modules are got from the copies and the views while detected, which is checked by the race detector
+	var wg sync.WaitGroup
+	for _, c := range []Client{copied, view} {
+		wg.Add(1)
+		go func(c Client) {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				assert.NotNil(t, c.Staking())
+				assert.NotNil(t, c.Dex())
+				assert.True(t, c.HasModule(dex.ModuleName))
+			}
+		}(c)
+	}
+	_, err = cli.DetectCapabilities()
+	require.NoError(t, err)
+	wg.Wait()
+
+	calls := len(backend.Calls())
+	_, err = view.Dex().QueryProducts("", 1, 10)
+	require.True(t, errors.Is(err, sdkerrors.ErrModuleUnavailable))
+	_, err = copied.Dex().QueryProducts("", 1, 10)
+	require.True(t, errors.Is(err, sdkerrors.ErrModuleUnavailable))
+	require.Len(t, backend.Calls(), calls)
+}
@@ -0,0 +1,41 @@
+package gosdk
+
+import (
+	"errors"
+	"fmt"
+
+	sdkerrors "github.com/okex/okchain-go-sdk/types/errors"
+)
+
+// DetectChainID queries the chain ID of the node, which should be called once the client is created. With the chain ID
+// unset in the config, the client and its modules are configured to sign the txs by the one of the node. Otherwise it
+// fails fast by sdkerrors.ErrChainIDMismatch if they differ, instead of the opaque signature verification failures of
+// the txs signed against the wrong chain ID at the broadcast
+func (cli *Client) DetectChainID() (string, error) {
+	commit, err := cli.Tendermint().QueryLatestCommitResult()
+	if err != nil {
+		return "", fmt.Errorf("failed. query latest block error: %w", err)
+	}
+
+	return commit.ChainID, cli.applyChainID(commit.ChainID)
+}
+
+// applyChainID configures the client by the chain ID of the node, or checks the one configured against it
+func (cli *Client) applyChainID(nodeChainID string) error {
+	if len(nodeChainID) == 0 {
+		return errors.New("failed. empty chain ID of the node")
+	}
+	if len(cli.config.ChainID) != 0 {
+		if cli.config.ChainID != nodeChainID {
+			return sdkerrors.Wrapf(sdkerrors.ErrChainIDMismatch, "failed. chain ID %s configured differs from %s of the node",
+				cli.config.ChainID, nodeChainID)
+		}
+		return nil
+	}
+
+	cli.config.ChainID = nodeChainID
+	cli.baseClient = cli.baseClient.WithChainID(nodeChainID)
+	// the codec is already registered and sealed
+	cli.modules.reset(cli.baseClient)
+	return nil
+}
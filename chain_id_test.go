@@ -0,0 +1,41 @@
+package gosdk
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/okex/okchain-go-sdk/mocks"
+	"github.com/okex/okchain-go-sdk/module/tendermint"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	sdkerrors "github.com/okex/okchain-go-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_DetectChainID(t *testing.T) {
+	config, err := sdk.NewClientConfig("testURL", "", sdk.BroadcastBlock, "0.01okt", 200000,
+		0, "")
+	require.NoError(t, err)
+	backend := commitBackend{ConformanceBackend: mocks.NewConformanceBackend()}
+
+	// configured by the node
+	cli := newClientWithRPC(config, []string{tendermint.ModuleName}, backend)
+	chainID, err := cli.DetectChainID()
+	require.NoError(t, err)
+	require.Equal(t, "okchain", chainID)
+	require.Equal(t, "okchain", cli.GetConfig().ChainID)
+	require.Equal(t, "okchain", cli.GetClientContext().ChainID())
+
+	// matched
+	_, err = cli.DetectChainID()
+	require.NoError(t, err)
+
+	// mismatched, checked by the warm-up as well
+	config.ChainID = "okchain-testnet"
+	cli = newClientWithRPC(config, []string{tendermint.ModuleName}, backend)
+	_, err = cli.DetectChainID()
+	require.True(t, errors.Is(err, sdkerrors.ErrChainIDMismatch))
+	_, err = cli.Warmup(context.Background())
+	require.True(t, errors.Is(err, sdkerrors.ErrChainIDMismatch))
+	require.Equal(t, "okchain-testnet", cli.GetClientContext().ChainID())
+}
@@ -1,64 +1,134 @@
 package gosdk
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"github.com/okex/okchain-go-sdk/analysis"
+	"github.com/okex/okchain-go-sdk/audit"
+	"github.com/okex/okchain-go-sdk/blockstream"
+	"github.com/okex/okchain-go-sdk/denom"
 	"github.com/okex/okchain-go-sdk/exposed"
+	"github.com/okex/okchain-go-sdk/faucet"
 	"github.com/okex/okchain-go-sdk/module"
 	"github.com/okex/okchain-go-sdk/module/auth"
 	"github.com/okex/okchain-go-sdk/module/backend"
 	"github.com/okex/okchain-go-sdk/module/dex"
 	"github.com/okex/okchain-go-sdk/module/distribution"
 	"github.com/okex/okchain-go-sdk/module/governance"
+	"github.com/okex/okchain-go-sdk/module/mint"
 	"github.com/okex/okchain-go-sdk/module/order"
 	"github.com/okex/okchain-go-sdk/module/slashing"
 	"github.com/okex/okchain-go-sdk/module/staking"
 	"github.com/okex/okchain-go-sdk/module/tendermint"
+	tmtypes "github.com/okex/okchain-go-sdk/module/tendermint/types"
 	"github.com/okex/okchain-go-sdk/module/token"
+	"github.com/okex/okchain-go-sdk/monitor"
+	"github.com/okex/okchain-go-sdk/orderwatch"
+	"github.com/okex/okchain-go-sdk/rewards"
+	"github.com/okex/okchain-go-sdk/scanner"
+	"github.com/okex/okchain-go-sdk/sweep"
+	"github.com/okex/okchain-go-sdk/txmanager"
 	sdk "github.com/okex/okchain-go-sdk/types"
+	sdkerrors "github.com/okex/okchain-go-sdk/types/errors"
+	"github.com/okex/okchain-go-sdk/types/proof"
+	"github.com/okex/okchain-go-sdk/utils"
+	"github.com/okex/okchain-go-sdk/webhook"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	rpcCli "github.com/tendermint/tendermint/rpc/client"
+	"time"
 )
 
 // Client - structure of the main client of okchain gosdk
 type Client struct {
-	config  sdk.ClientConfig
-	cdc     sdk.SDKCodec
-	modules map[string]sdk.Module
+	config      sdk.ClientConfig
+	cdc         sdk.SDKCodec
+	modules     *moduleSet
+	moduleNames []string
+	baseClient  sdk.BaseClient
+	// capabilities are shared by the copies and the views of the client, which are detected by DetectCapabilities
+	capabilities *capabilityState
 }
 
-// NewClient creates a new instance of Client
+var (
+	// allModuleNames are the names of all the modules registered by NewClient
+	allModuleNames = []string{auth.ModuleName, backend.ModuleName, dex.ModuleName, distribution.ModuleName,
+		governance.ModuleName, mint.ModuleName, order.ModuleName, staking.ModuleName, slashing.ModuleName,
+		token.ModuleName, tendermint.ModuleName}
+	// coreModuleNames are the names of the modules always registered, which the accounts and the blocks are queried by
+	coreModuleNames = []string{auth.ModuleName, tendermint.ModuleName}
+)
+
+// NewClient creates a new instance of Client with all the modules
 func NewClient(config sdk.ClientConfig) Client {
+	return newClient(config, allModuleNames)
+}
+
+// NewClientWithModules creates a new instance of Client with only the modules an application needs besides the core
+// modules auth and tendermint, e.g. staking.ModuleName and token.ModuleName, which saves the codec registrations of the
+// others. All the calls of a module not registered are refused by sdkerrors.ErrModuleUnavailable
+// NOTE: this package links all the modules. The constrained builds, e.g. by gomobile, link only the modules imported by
+// the slim client instead
+func NewClientWithModules(config sdk.ClientConfig, moduleNames ...string) (Client, error) {
+	names := append([]string{}, coreModuleNames...)
+	registered := map[string]bool{auth.ModuleName: true, tendermint.ModuleName: true}
+	for _, name := range moduleNames {
+		if _, ok := sdk.GetModuleCreator(name); !ok {
+			return Client{}, fmt.Errorf("failed. unknown module: %s", name)
+		}
+		if registered[name] {
+			continue
+		}
+		registered[name] = true
+		names = append(names, name)
+	}
+
+	return newClient(config, names), nil
+}
+
+func newClient(config sdk.ClientConfig, moduleNames []string) Client {
+	return newClientWithRPC(config, moduleNames, nil)
+}
+
+// newClientWithRPC creates the client on a specific rpc client, or the one by the config if nil
+func newClientWithRPC(config sdk.ClientConfig, moduleNames []string, rpcClient sdk.RPCClient) Client {
 	cdc := sdk.NewCodec()
 	pClient := &Client{
-		config:  config,
-		cdc:     cdc,
-		modules: make(map[string]sdk.Module),
-	}
-	pBaseClient := module.NewBaseClient(cdc, &pClient.config)
-
-	pClient.registerModule(
-		auth.NewAuthClient(pBaseClient),
-		backend.NewBackendClient(pBaseClient),
-		dex.NewDexClient(pBaseClient),
-		distribution.NewDistrClient(pBaseClient),
-		governance.NewGovClient(pBaseClient),
-		order.NewOrderClient(pBaseClient),
-		staking.NewStakingClient(pBaseClient),
-		slashing.NewSlashingClient(pBaseClient),
-		token.NewTokenClient(pBaseClient),
-		tendermint.NewTendermintClient(pBaseClient),
-	)
+		config:       config,
+		cdc:          cdc,
+		moduleNames:  moduleNames,
+		capabilities: &capabilityState{},
+	}
+	var pBaseClient sdk.BaseClient
+	if rpcClient != nil {
+		pBaseClient = module.NewBaseClientWithRPC(cdc, &pClient.config, rpcClient)
+	} else {
+		pBaseClient = module.NewBaseClient(cdc, &pClient.config)
+	}
+	pClient.baseClient = pBaseClient
+	pClient.modules = newModuleSet(pBaseClient, moduleNames, pClient.capabilities)
+
+	pClient.registerCodec()
 
 	return *pClient
 }
 
-func (cli *Client) registerModule(mods ...sdk.Module) {
-	for _, mod := range mods {
-		moduleName := mod.Name()
-		if _, ok := cli.modules[moduleName]; ok {
-			panic(fmt.Sprintf("duplicated module: %s", moduleName))
-		}
+// newModules creates the modules on the base client, where the modules unavailable by the capabilities detected refuse
+// all their queries and txs
+func newModules(baseClient sdk.BaseClient, moduleNames []string, capabilities sdk.Capabilities) []sdk.Module {
+	mods := make([]sdk.Module, len(moduleNames))
+	for i, name := range moduleNames {
+		creator, _ := sdk.GetModuleCreator(name)
+		mods[i] = creator(moduleBaseClient(baseClient, name, capabilities))
+	}
+	return mods
+}
+
+func (cli *Client) registerCodec() {
+	for _, moduleName := range cli.moduleNames {
 		// register codec by each module
+		mod, _ := cli.modules.get(moduleName)
 		mod.RegisterCodec(cli.cdc)
-		cli.modules[moduleName] = mod
 	}
 	sdk.RegisterBasicCodec(cli.cdc)
 	cli.cdc.Seal()
@@ -69,34 +139,515 @@ func (cli *Client) GetConfig() sdk.ClientConfig {
 	return cli.config
 }
 
+// GetClientContext returns the immutable client context shared by the module clients, which carries the chain ID, the
+// sign mode and the fee config
+func (cli *Client) GetClientContext() sdk.ClientContext {
+	return cli.baseClient.GetClientContext()
+}
+
+// BuildAndBroadcast builds a tx with any msgs of the modules and broadcasts it, with the optional settings like
+// sdk.WithFeePayer which the module clients don't take
+func (cli *Client) BuildAndBroadcast(fromName, passWd, memo string, msgs []sdk.Msg, accNum, seqNum uint64,
+	opts ...sdk.TxOption) (sdk.TxResponse, error) {
+	return cli.baseClient.BuildAndBroadcast(fromName, passWd, memo, msgs, accNum, seqNum, opts...)
+}
+
+// BuildStdTx builds a signed tx with any msgs of the modules and the optional settings, without broadcasting it
+func (cli *Client) BuildStdTx(fromName, passWd, memo string, msgs []sdk.Msg, accNum, seqNum uint64,
+	opts ...sdk.TxOption) (sdk.StdTx, error) {
+	return cli.baseClient.BuildStdTx(fromName, passWd, memo, msgs, accNum, seqNum, opts...)
+}
+
+// BuildSignedTx builds a signed tx with any msgs of the modules and returns its encoded bytes and hash without
+// broadcasting, so that it could be queued, inspected or broadcast through a different channel
+func (cli *Client) BuildSignedTx(fromName, passWd, memo string, msgs []sdk.Msg, accNum, seqNum uint64,
+	opts ...sdk.TxOption) (sdk.SignedTx, error) {
+	return cli.baseClient.BuildSignedTx(fromName, passWd, memo, msgs, accNum, seqNum, opts...)
+}
+
+// BuildAndBroadcastWithSigner builds a tx with any msgs of the modules signed by the signer, e.g. a HSM, a KMS or
+// NewPrivKeySigner, instead of the key in the keybase, and broadcasts it
+func (cli *Client) BuildAndBroadcastWithSigner(signer sdk.Signer, memo string, msgs []sdk.Msg, accNum, seqNum uint64,
+	opts ...sdk.TxOption) (sdk.TxResponse, error) {
+	return cli.baseClient.BuildAndBroadcastWithSigner(signer, memo, msgs, accNum, seqNum, opts...)
+}
+
+// BuildSignedTxWithSigner builds a tx with any msgs of the modules signed by the signer and returns its encoded bytes
+// and hash without broadcasting
+func (cli *Client) BuildSignedTxWithSigner(signer sdk.Signer, memo string, msgs []sdk.Msg, accNum, seqNum uint64,
+	opts ...sdk.TxOption) (sdk.SignedTx, error) {
+	return cli.baseClient.BuildSignedTxWithSigner(signer, memo, msgs, accNum, seqNum, opts...)
+}
+
+// BuildSignDoc builds the sign doc of a tx with any msgs of the modules to be signed by the signer, without signing it,
+// whose sign bytes are exactly what will be signed, e.g. for a human or a policy engine to approve the payload before
+// the signature is produced. The tx approved is signed with the same msgs, memo and sequence, and the fee of the doc
+// fixed by sdk.WithFee, so that the fee estimated is not changed by another simulation
+func (cli *Client) BuildSignDoc(signer sdk.Signer, memo string, msgs []sdk.Msg, accNum, seqNum uint64,
+	opts ...sdk.TxOption) (sdk.SignDoc, error) {
+	return cli.baseClient.BuildSignDoc(signer, memo, msgs, accNum, seqNum, opts...)
+}
+
+// BroadcastRawTx broadcasts the pre-signed tx bytes from the external systems, e.g. HSMs or other SDKs, by the mode
+// The broadcast mode in config is used if it's empty
+func (cli *Client) BroadcastRawTx(txBytes []byte, broadcastMode sdk.BroadcastMode) (sdk.TxResponse, error) {
+	return cli.baseClient.BroadcastRawTx(txBytes, broadcastMode)
+}
+
+// NewSerializer creates a serializer of the format, e.g. sdk.SerializationAmino, on the codec of the client, to put
+// the public types like TokenPair and TxResponse on wire or queues
+func (cli *Client) NewSerializer(format string) (sdk.Serializer, error) {
+	return sdk.NewSerializer(format, cli.cdc)
+}
+
+// DecodeStdTx decodes the raw tx bytes, e.g. received from the third parties, into StdTx by the codec of the client,
+// which could be introspected and verified by utils.VerifyStdTxSignatures before relaying it
+func (cli *Client) DecodeStdTx(txBytes []byte) (sdk.StdTx, error) {
+	return utils.DecodeStdTx(cli.cdc, txBytes)
+}
+
+// MarshalMsgJSON encodes the msg into the canonical amino JSON by the codec of the client, which is exactly the msg in
+// the sign bytes of the tx, e.g. for the review UIs and the approval workflows to inspect what will be signed
+func (cli *Client) MarshalMsgJSON(msg sdk.Msg) ([]byte, error) {
+	return utils.MarshalMsgJSON(cli.cdc, msg)
+}
+
+// UnmarshalMsgJSON decodes the amino JSON of a msg into the concrete msg of the modules registered by the codec of the
+// client, e.g. the msg approved by an approval workflow to sign
+func (cli *Client) UnmarshalMsgJSON(bz []byte) (sdk.Msg, error) {
+	return utils.UnmarshalMsgJSON(cli.cdc, bz)
+}
+
+// DecodeBlockJSON decodes the JSON of the /block rpc of tendermint, e.g. fetched by an indexer directly, into the block
+// with its txs decoded by the codec of the client, whose msgs are the concrete types of the modules registered
+func (cli *Client) DecodeBlockJSON(bz []byte) (utils.DecodedBlock, error) {
+	return utils.DecodeBlockJSON(cli.cdc, bz)
+}
+
+// DecodeTxs decodes the raw txs of a block by the codec of the client, keeping the txs undecodable with their errors
+func (cli *Client) DecodeTxs(txs [][]byte) []utils.DecodedTx {
+	return utils.DecodeTxs(cli.cdc, txs)
+}
+
+// Blocks returns the iterator of the blocks from the height onwards with their txs decoded, which catches up the blocks
+// behind the tip by the queries and follows the new blocks by the subscription then. The fetching pauses while the
+// buffer in the config is full, so a slow consumer holds only a bounded number of the blocks in memory
+func (cli *Client) Blocks(ctx context.Context, fromHeight int64, config blockstream.Config) (*blockstream.Iterator,
+	error) {
+	return blockstream.NewIterator(ctx, cli.baseClient, cli.cdc, fromHeight, config)
+}
+
+// WithConsistency returns a view of the client whose module queries all read the state by the consistency option,
+// e.g. sdk.Finalized(3) to trade the freshness for the stability. The height is resolved once when the view is created,
+// so the queries through the same view are a consistent group
+func (cli *Client) WithConsistency(consistency sdk.Consistency) (Client, error) {
+	baseClient, err := cli.baseClient.WithConsistency(consistency)
+	if err != nil {
+		return Client{}, err
+	}
+
+	return cli.newView(baseClient), nil
+}
+
+// newView creates a view of the client with the same config and modules on the base client
+func (cli *Client) newView(baseClient sdk.BaseClient) Client {
+	// the codec is already registered and sealed
+	return Client{
+		config:       cli.config,
+		cdc:          cli.cdc,
+		modules:      newModuleSet(baseClient, cli.moduleNames, cli.capabilities),
+		moduleNames:  cli.moduleNames,
+		baseClient:   baseClient,
+		capabilities: cli.capabilities,
+	}
+}
+
+// WithHeight returns a view of the client whose module queries all read the historical state at the height, e.g. the
+// balances, the validators, the proposals and the token pairs for the accounting and the snapshots
+// The node is required to keep the state at the height instead of pruning it
+func (cli *Client) WithHeight(height int64) (Client, error) {
+	return cli.WithConsistency(sdk.AtHeight(height))
+}
+
+// WithProofVerification returns a view of the client whose store queries request the merkle proofs and verify them
+// against the app hashes of the headers verified by the verifier, e.g. a light client verifier wrapped by
+// proof.NewLiteHeaderVerifier, so that the results from the untrusted public rpc nodes are validated locally
+// NOTE: the custom queries of the modules carry no merkle proofs and are rejected by the view
+func (cli *Client) WithProofVerification(verifier sdk.HeaderVerifier) Client {
+	return cli.newView(cli.baseClient.WithProofVerification(verifier))
+}
+
+// WithResponseRecorder returns a view of the client recording the raw responses of all the module queries by the
+// recorder, exactly as returned by the node along with their heights and proofs, e.g. for the auditors to archive
+func (cli *Client) WithResponseRecorder(recorder sdk.ResponseRecorder) Client {
+	return cli.newView(cli.baseClient.WithResponseRecorder(recorder))
+}
+
+// WithQueryCache returns a view of the client whose module queries are served by the cache within the TTLs of their
+// paths, e.g. the validators, the token info, the params and the proposals rendered by a dashboard on every page view.
+// The cache could be invalidated explicitly, e.g. after a tx changing the state queried, and shared by the views
+func (cli *Client) WithQueryCache(cache *sdk.QueryCache) Client {
+	return cli.newView(cli.baseClient.WithQueryCache(cache))
+}
+
+// WithDryRun returns a view of the client where every tx method of the modules builds and signs the tx, simulates it
+// and returns the result of the simulation instead of broadcasting it, e.g. for the staging environments and the
+// pre-trade risk checks without spending any OKT. The sequences given are taken as a sandbox, which are neither
+// reserved by the sequence manager nor recorded in the signed tx store of the config
+// NOTE: the txs simulated are never committed and the responses carry no heights
+func (cli *Client) WithDryRun() Client {
+	return cli.newView(cli.baseClient.WithDryRun())
+}
+
+// IsDryRun shows whether the txs of the client are simulated instead of broadcast
+func (cli *Client) IsDryRun() bool {
+	return cli.baseClient.IsDryRun()
+}
+
+// WithRawResponses runs the typed queries through a view of the client, and returns the raw responses of them in
+// order, e.g.
+//
+//	raws, err := cli.WithRawResponses(func(view gosdk.Client) (err error) {
+//		acc, err = view.Auth().QueryAccount(addr)
+//		return
+//	})
+func (cli *Client) WithRawResponses(queries func(view Client) error) ([]sdk.RawResponse, error) {
+	collector := sdk.NewResponseCollector()
+	err := queries(cli.WithResponseRecorder(collector))
+	return collector.Responses(), err
+}
+
+// WithTrustedHeader returns a view of the client verifying the store queries of the state committed by the trusted
+// header, whose height and hash are supplied by the user. The view reads the state at the height before the header
+func (cli *Client) WithTrustedHeader(height int64, hash []byte) (Client, error) {
+	verifier, err := proof.NewTrustedHeaderVerifier(cli.baseClient, height, hash)
+	if err != nil {
+		return Client{}, err
+	}
+
+	view, err := cli.WithHeight(height - 1)
+	if err != nil {
+		return Client{}, err
+	}
+	return view.WithProofVerification(verifier), nil
+}
+
+// Status returns the status of the node, i.e. its latest block height and time and whether it's catching up
+func (cli *Client) Status() (tmtypes.ResultStatus, error) {
+	return cli.Tendermint().QueryStatus()
+}
+
+// IsHealthy tells the node has caught up and its latest block is within the max lag, e.g. for the applications to
+// refuse to broadcast against a lagging node and for the failover to switch the nodes. A node failing to report its
+// status is unhealthy
+func (cli *Client) IsHealthy(maxLag time.Duration) bool {
+	status, err := cli.Status()
+	return err == nil && status.IsHealthy(maxLag)
+}
+
+// QueryHeight returns the height of the state that the module queries read, 0 for the latest
+func (cli *Client) QueryHeight() int64 {
+	return cli.baseClient.QueryHeight()
+}
+
+// QueryStore reads the raw value by the data as the key in the store of the module, where the path is the end path of
+// the store query, e.g. "key". The state is read at the height, or at the query height of the view for 0, and the
+// merkle proof of the value is returned as well if the node serves one, e.g. to read the state of the modules not
+// modeled by the sdk yet
+func (cli *Client) QueryStore(storeKey, path string, data []byte, height int64) (sdk.StoreQueryResult, error) {
+	if len(storeKey) == 0 || len(path) == 0 {
+		return sdk.StoreQueryResult{}, errors.New("failed. empty store key or path of the store query")
+	}
+
+	return cli.baseClient.QueryStoreWithProof(data, storeKey, path, height)
+}
+
+// QuerySubspace reads all the raw key-value pairs with the prefix in the store of the module
+func (cli *Client) QuerySubspace(storeKey string, prefix []byte) ([]cmn.KVPair, error) {
+	if len(storeKey) == 0 {
+		return nil, errors.New("failed. empty store key of the subspace query")
+	}
+
+	return cli.baseClient.QuerySubspace(prefix, storeKey)
+}
+
+// NewTxScanner creates a scanner of the historical txs involving the address, which iterates the blocks from a
+// resumable cursor
+func (cli *Client) NewTxScanner(accAddrStr string) (scanner.Scanner, error) {
+	return scanner.NewScanner(cli.Tendermint(), cli.cdc, accAddrStr)
+}
+
+// HasModule tells whether the module of the name is registered in the client
+func (cli *Client) HasModule(moduleName string) bool {
+	_, ok := cli.modules.get(moduleName)
+	return ok
+}
+
+// Module gets the client of the module registered by its name, e.g. to be asserted to the exposed interface of the
+// module, which fails if the module is not registered in the client
+func (cli *Client) Module(moduleName string) (sdk.Module, error) {
+	mod, ok := cli.modules.get(moduleName)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrModuleUnavailable, "failed. module %s is not registered in the client",
+			moduleName)
+	}
+	return mod, nil
+}
+
+// module gets the client of the module by its name, or the one refusing all its calls by ErrModuleUnavailable if the
+// module is not registered in the client
+func (cli *Client) module(moduleName string) sdk.Module {
+	if mod, ok := cli.modules.get(moduleName); ok {
+		return mod
+	}
+
+	// all the modules are linked in this package
+	creator, _ := sdk.GetModuleCreator(moduleName)
+	return creator(module.NewUnavailableBaseClient(cli.baseClient, moduleName, "not registered in the client"))
+}
+
+// ModuleNames returns the names of the modules registered in the client
+func (cli *Client) ModuleNames() []string {
+	return append([]string{}, cli.moduleNames...)
+}
+
+// NewAddressClassifier creates a classifier labeling the addresses by their roles on the chain, e.g. the validator
+// operators, the dex operators, the token issuers and the proxies. The staking, dex and token modules are required
+func (cli *Client) NewAddressClassifier() (*analysis.Classifier, error) {
+	for _, moduleName := range []string{staking.ModuleName, dex.ModuleName, token.ModuleName} {
+		if !cli.HasModule(moduleName) {
+			return nil, fmt.Errorf("failed. module %s is required by the address classifier", moduleName)
+		}
+	}
+
+	return analysis.NewClassifier(cli.Staking(), cli.Dex(), cli.Token()), nil
+}
+
+// NewDelistAnalyzer creates an analyzer reporting the impact of delisting the token pairs, which supports the
+// decisions on the delist proposals. The dex, order and backend modules are required
+func (cli *Client) NewDelistAnalyzer() (*analysis.DelistAnalyzer, error) {
+	for _, moduleName := range []string{dex.ModuleName, order.ModuleName, backend.ModuleName} {
+		if !cli.HasModule(moduleName) {
+			return nil, fmt.Errorf("failed. module %s is required by the delist analyzer", moduleName)
+		}
+	}
+
+	return analysis.NewDelistAnalyzer(cli.Dex(), cli.Order(), cli.Backend()), nil
+}
+
+// NewValidatorAnalyzer creates an analyzer of the performance of the validators, i.e. the missed blocks in the signed
+// blocks window, the voting power history and the jail history. The staking, slashing and tendermint modules are
+// required
+func (cli *Client) NewValidatorAnalyzer() (*analysis.ValidatorAnalyzer, error) {
+	for _, moduleName := range []string{staking.ModuleName, slashing.ModuleName, tendermint.ModuleName} {
+		if !cli.HasModule(moduleName) {
+			return nil, fmt.Errorf("failed. module %s is required by the validator analyzer", moduleName)
+		}
+	}
+
+	return analysis.NewValidatorAnalyzer(cli.Tendermint(), cli.Staking(), cli.Slashing()), nil
+}
+
+// NewSweeper creates a sweeper emptying multiple accounts into one destination with the fees sized to leave nothing
+// behind, e.g. to consolidate the deposit addresses into a treasury wallet. The token module is required
+func (cli *Client) NewSweeper() (*sweep.Sweeper, error) {
+	if !cli.HasModule(token.ModuleName) {
+		return nil, fmt.Errorf("failed. module %s is required by the sweeper", token.ModuleName)
+	}
+
+	return sweep.NewSweeper(cli.Auth(), cli.baseClient), nil
+}
+
+// NewDenomRegistry creates a registry of the denom metadata converting the amounts between the units and formatting
+// them for display, which resolves the unknown tokens by the token queries. The token module is required
+func (cli *Client) NewDenomRegistry() (*denom.Registry, error) {
+	if !cli.HasModule(token.ModuleName) {
+		return nil, fmt.Errorf("failed. module %s is required by the denom registry", token.ModuleName)
+	}
+
+	return denom.NewRegistry(cli.Token()), nil
+}
+
+// NewWebhookDispatcher creates a dispatcher posting the notifications of the triggers in the config on every new block
+// to the webhook endpoints, e.g. webhook.TransferTo or webhook.OrderFilled(cli.Order(), orderIDs...)
+func (cli *Client) NewWebhookDispatcher(config webhook.Config) (*webhook.Dispatcher, error) {
+	return webhook.NewDispatcher(cli.Tendermint(), cli.baseClient, config)
+}
+
+// NewTxManager creates a manager of the txs broadcast, which resubmits the txs never committed with the same sequences
+// and optionally bumped fees, and fills the gaps of the sequences before them
+func (cli *Client) NewTxManager(config txmanager.Config) (*txmanager.Manager, error) {
+	return txmanager.NewManager(cli.Tendermint(), cli.Auth(), cli.baseClient, config)
+}
+
+// NewOrderWatcher creates a watcher of the open orders of an address, which warns of the orders near expiry and
+// optionally cancels and re-places them. The order and backend modules are required
+func (cli *Client) NewOrderWatcher(config orderwatch.Config) (*orderwatch.Watcher, error) {
+	for _, moduleName := range []string{order.ModuleName, backend.ModuleName} {
+		if !cli.HasModule(moduleName) {
+			return nil, fmt.Errorf("failed. module %s is required by the order watcher", moduleName)
+		}
+	}
+
+	return orderwatch.NewWatcher(cli.Tendermint(), cli.Auth(), cli.Backend(), cli.Order(), config)
+}
+
+// NewFaucetClient creates a client of the testnet faucet in the config, which requests the testnet tokens for an
+// address and waits for the funding tx
+func (cli *Client) NewFaucetClient(config faucet.Config) (*faucet.Client, error) {
+	return faucet.NewClient(cli.Tendermint(), cli.Auth(), config)
+}
+
+// NewRewardsScheduler creates a scheduler withdrawing the commissions of the validators operated by the accounts in
+// the config periodically, in batches within the windows. The distribution module is required
+func (cli *Client) NewRewardsScheduler(config rewards.Config) (*rewards.Scheduler, error) {
+	if !cli.HasModule(distribution.ModuleName) {
+		return nil, fmt.Errorf("failed. module %s is required by the rewards scheduler", distribution.ModuleName)
+	}
+
+	return rewards.NewScheduler(cli.Auth(), cli.baseClient, config)
+}
+
+// NewRewardEstimator creates an estimator of the rewards expected for a delegation amount by the inflation, the
+// commissions and the votes of the validators, e.g. for the wallets to show the APRs. The staking, distribution and
+// mint modules are required
+func (cli *Client) NewRewardEstimator() (*rewards.Estimator, error) {
+	for _, moduleName := range []string{staking.ModuleName, distribution.ModuleName, mint.ModuleName} {
+		if !cli.HasModule(moduleName) {
+			return nil, fmt.Errorf("failed. module %s is required by the reward estimator", moduleName)
+		}
+	}
+
+	return rewards.NewEstimator(cli.Staking(), cli.Distribution(), cli.Mint()), nil
+}
+
+// NewParamsMonitor creates a monitor of the params of the staking, governance, dex and order modules registered, which
+// reports the changes made by governance
+func (cli *Client) NewParamsMonitor(config monitor.ParamsConfig) (*monitor.ParamsMonitor, error) {
+	return monitor.NewParamsMonitor(cli.paramsFetchers(), config)
+}
+
+// NewHealthMonitor creates a monitor of the chain health publishing the gauges through the collector, whose peers and
+// mempool are queried by the tendermint rpc of the node URI
+func (cli *Client) NewHealthMonitor(collector sdk.MetricsCollector, config monitor.HealthConfig) (
+	*monitor.HealthMonitor, error) {
+	source := healthSource{
+		TendermintQuery: cli.Tendermint(),
+		HTTP:            module.NewHTTP(cli.config.NodeURI, cli.config.RPCAuth),
+	}
+	return monitor.NewHealthMonitor(source, collector, config)
+}
+
+// healthSource queries the latest block by the tendermint module, and the peers and the mempool by the tendermint rpc
+type healthSource struct {
+	exposed.TendermintQuery
+	*rpcCli.HTTP
+}
+
+// QueryParams gets the typed params of a module registered by its name, i.e. stakingtypes.Params,
+// govtypes.Params, distrtypes.Params, tokentypes.Params, dextypes.Params, ordertypes.Params, minttypes.Params or
+// slashingtypes.Params, so that the applications adapt to the params changed on chain instead of hard-coding them
+func (cli *Client) QueryParams(moduleName string) (interface{}, error) {
+	if !cli.HasModule(moduleName) {
+		return nil, fmt.Errorf("failed. module %s is not registered", moduleName)
+	}
+
+	switch moduleName {
+	case staking.ModuleName:
+		return cli.Staking().QueryStakingParams()
+	case governance.ModuleName:
+		return cli.Governance().QueryGovParams()
+	case distribution.ModuleName:
+		return cli.Distribution().QueryDistrParams()
+	case token.ModuleName:
+		return cli.Token().QueryTokenParams()
+	case dex.ModuleName:
+		return cli.Dex().QueryDexParams()
+	case order.ModuleName:
+		return cli.Order().QueryOrderParams()
+	case mint.ModuleName:
+		return cli.Mint().QueryMintParams()
+	case slashing.ModuleName:
+		return cli.Slashing().QuerySlashingParams()
+	default:
+		return nil, fmt.Errorf("failed. module %s has no params to query", moduleName)
+	}
+}
+
+// paramsFetchers returns the fetchers of the params of the staking, governance, dex and order modules registered
+func (cli *Client) paramsFetchers() map[string]monitor.ParamsFetcher {
+	sources := make(map[string]monitor.ParamsFetcher)
+	for _, name := range []string{staking.ModuleName, governance.ModuleName, dex.ModuleName, order.ModuleName} {
+		if cli.HasModule(name) {
+			name := name
+			sources[name] = func() (interface{}, error) { return cli.QueryParams(name) }
+		}
+	}
+	return sources
+}
+
+// NewBlockAuditor creates an auditor verifying the signatures in the historical blocks in batch, which resolves the
+// accounts of the signers by the state before each block. The node is required to keep the historical state
+func (cli *Client) NewBlockAuditor(config audit.Config) (*audit.Auditor, error) {
+	return audit.NewAuditor(cli.Tendermint(), cli.resolveSignerAccount, config)
+}
+
+// resolveSignerAccount resolves the account number and the sequence of the signer before the block at the height
+func (cli *Client) resolveSignerAccount(signer sdk.AccAddress, height int64) (signerAcc utils.SignerAccount,
+	err error) {
+	// all the sequences start from 0 in the genesis
+	consistency := sdk.Latest()
+	if height > 1 {
+		consistency = sdk.AtHeight(height - 1)
+	}
+	baseClient, err := cli.baseClient.WithConsistency(consistency)
+	if err != nil {
+		return
+	}
+
+	acc, err := auth.NewAuthClient(baseClient).QueryAccount(signer.String())
+	if err != nil {
+		return
+	}
+
+	signerAcc.AccountNumber = acc.GetAccountNumber()
+	if height > 1 {
+		signerAcc.Sequence = acc.GetSequence()
+	}
+	return
+}
+
 // nolint
 func (cli *Client) Auth() exposed.Auth {
-	return cli.modules[auth.ModuleName].(exposed.Auth)
+	return cli.module(auth.ModuleName).(exposed.Auth)
 }
 func (cli *Client) Backend() exposed.Backend {
-	return cli.modules[backend.ModuleName].(exposed.Backend)
+	return cli.module(backend.ModuleName).(exposed.Backend)
 }
 func (cli *Client) Dex() exposed.Dex {
-	return cli.modules[dex.ModuleName].(exposed.Dex)
+	return cli.module(dex.ModuleName).(exposed.Dex)
 }
 func (cli *Client) Distribution() exposed.Distribution {
-	return cli.modules[distribution.ModuleName].(exposed.Distribution)
+	return cli.module(distribution.ModuleName).(exposed.Distribution)
 }
 func (cli *Client) Governance() exposed.Governance {
-	return cli.modules[governance.ModuleName].(exposed.Governance)
+	return cli.module(governance.ModuleName).(exposed.Governance)
+}
+func (cli *Client) Mint() exposed.Mint {
+	return cli.module(mint.ModuleName).(exposed.Mint)
 }
 func (cli *Client) Order() exposed.Order {
-	return cli.modules[order.ModuleName].(exposed.Order)
+	return cli.module(order.ModuleName).(exposed.Order)
 }
 func (cli *Client) Staking() exposed.Staking {
-	return cli.modules[staking.ModuleName].(exposed.Staking)
+	return cli.module(staking.ModuleName).(exposed.Staking)
 }
 func (cli *Client) Slashing() exposed.Slashing {
-	return cli.modules[slashing.ModuleName].(exposed.Slashing)
+	return cli.module(slashing.ModuleName).(exposed.Slashing)
 }
 func (cli *Client) Token() exposed.Token {
-	return cli.modules[token.ModuleName].(exposed.Token)
+	return cli.module(token.ModuleName).(exposed.Token)
 }
 func (cli *Client) Tendermint() exposed.Tendermint {
-	return cli.modules[tendermint.ModuleName].(exposed.Tendermint)
+	return cli.module(tendermint.ModuleName).(exposed.Tendermint)
 }
@@ -0,0 +1,171 @@
+package gosdk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/okex/okchain-go-sdk/mocks"
+	"github.com/okex/okchain-go-sdk/module/auth"
+	"github.com/okex/okchain-go-sdk/module/dex"
+	"github.com/okex/okchain-go-sdk/module/staking"
+	"github.com/okex/okchain-go-sdk/module/tendermint"
+	"github.com/okex/okchain-go-sdk/module/token"
+	tokentypes "github.com/okex/okchain-go-sdk/module/token/types"
+	"github.com/okex/okchain-go-sdk/monitor"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	sdkerrors "github.com/okex/okchain-go-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	cmn "github.com/tendermint/tendermint/libs/common"
+)
+
+func TestNewClientWithModules(t *testing.T) {
+	config, err := sdk.NewClientConfig("testURL", "testChain", sdk.BroadcastBlock, "", 200000,
+		1.1, "0.00000001okt")
+	require.NoError(t, err)
+
+	cli := NewClient(config)
+	require.Len(t, cli.ModuleNames(), len(allModuleNames))
+	require.True(t, cli.HasModule(dex.ModuleName))
+	_, err = cli.NewAddressClassifier()
+	require.NoError(t, err)
+	_, err = cli.NewSweeper()
+	require.NoError(t, err)
+
+	cli, err = NewClientWithModules(config, staking.ModuleName, token.ModuleName, staking.ModuleName, auth.ModuleName)
+	require.NoError(t, err)
+	require.Equal(t, []string{auth.ModuleName, tendermint.ModuleName, staking.ModuleName, token.ModuleName},
+		cli.ModuleNames())
+	require.True(t, cli.HasModule(staking.ModuleName))
+	require.False(t, cli.HasModule(dex.ModuleName))
+	require.NotNil(t, cli.Staking())
+	require.NotNil(t, cli.Auth())
+	// the calls of the modules not registered are refused
+	_, err = cli.Dex().QueryProduct("btc-000_okt")
+	require.True(t, errors.Is(err, sdkerrors.ErrModuleUnavailable))
+	_, err = cli.Module(dex.ModuleName)
+	require.True(t, errors.Is(err, sdkerrors.ErrModuleUnavailable))
+	mod, err := cli.Module(staking.ModuleName)
+	require.NoError(t, err)
+	require.Equal(t, staking.ModuleName, mod.Name())
+	_, err = cli.NewAddressClassifier()
+	require.Error(t, err)
+	_, err = cli.NewSweeper()
+	require.NoError(t, err)
+	_, err = cli.NewDenomRegistry()
+	require.NoError(t, err)
+	cli, err = NewClientWithModules(config, staking.ModuleName)
+	require.NoError(t, err)
+	_, err = cli.NewSweeper()
+	require.Error(t, err)
+	_, err = cli.NewDenomRegistry()
+	require.Error(t, err)
+	_, err = cli.NewParamsMonitor(monitor.ParamsConfig{})
+	require.NoError(t, err)
+	coreCli, err := NewClientWithModules(config)
+	require.NoError(t, err)
+	_, err = coreCli.NewParamsMonitor(monitor.ParamsConfig{})
+	require.Error(t, err)
+
+	// the view keeps the module set
+	view, err := cli.WithConsistency(sdk.Latest())
+	require.NoError(t, err)
+	require.Equal(t, cli.ModuleNames(), view.ModuleNames())
+	require.False(t, view.HasModule(dex.ModuleName))
+
+	view, err = cli.WithHeight(1024)
+	require.NoError(t, err)
+	require.Equal(t, int64(1024), view.QueryHeight())
+	require.Zero(t, cli.QueryHeight())
+	_, err = cli.WithHeight(0)
+	require.Error(t, err)
+
+	// no response recorded of the node unreachable
+	raws, err := cli.WithRawResponses(func(view Client) error {
+		require.Equal(t, cli.ModuleNames(), view.ModuleNames())
+		_, err := view.Staking().QueryValidators()
+		return err
+	})
+	require.Error(t, err)
+	require.Empty(t, raws)
+
+	view, err = cli.WithTrustedHeader(1025, []byte("trusted hash"))
+	require.NoError(t, err)
+	require.Equal(t, int64(1024), view.QueryHeight())
+	require.Equal(t, cli.ModuleNames(), view.ModuleNames())
+	_, err = cli.WithTrustedHeader(1, []byte("trusted hash"))
+	require.Error(t, err)
+	_, err = cli.WithTrustedHeader(1025, nil)
+	require.Error(t, err)
+
+	_, err = NewClientWithModules(config, "unknown")
+	require.Error(t, err)
+}
+
+func TestClient_QueryParams(t *testing.T) {
+	config, err := sdk.NewClientConfig("testURL", "testChain", sdk.BroadcastBlock, "", 200000,
+		1.1, "0.00000001okt")
+	require.NoError(t, err)
+	backend := mocks.NewConformanceBackend()
+	backend.SetResponse(tokentypes.ParamsPath, nil, abci.ResponseQuery{
+		Value: []byte(`{"issue_fee":{"denom":"okt","amount":"20000"}}`),
+	})
+	cli := newClientWithRPC(config, []string{auth.ModuleName, tendermint.ModuleName, token.ModuleName}, backend)
+
+	tokenParams, err := cli.QueryParams(token.ModuleName)
+	require.NoError(t, err)
+	require.IsType(t, tokentypes.Params{}, tokenParams)
+	require.Equal(t, "20000.00000000okt", tokenParams.(tokentypes.Params).FeeIssue.String())
+
+	// not registered
+	_, err = cli.QueryParams(staking.ModuleName)
+	require.Error(t, err)
+	// no params
+	_, err = cli.QueryParams(auth.ModuleName)
+	require.Error(t, err)
+}
+
+func TestClient_QueryStore(t *testing.T) {
+	config, err := sdk.NewClientConfig("testURL", "testChain", sdk.BroadcastBlock, "", 200000,
+		1.1, "0.00000001okt")
+	require.NoError(t, err)
+	backend := mocks.NewConformanceBackend()
+	key, value := []byte{0x01, 0x02}, []byte("raw value")
+	backend.SetResponse("/store/farm/key", key, abci.ResponseQuery{
+		Value:  value,
+		Height: 1024,
+		Proof:  &merkle.Proof{Ops: []merkle.ProofOp{{Type: "iavl:v", Key: key}}},
+	})
+	cli := newClientWithRPC(config, []string{auth.ModuleName, tendermint.ModuleName}, backend)
+
+	res, err := cli.QueryStore("farm", "key", key, 1024)
+	require.NoError(t, err)
+	require.Equal(t, value, res.Value)
+	require.Equal(t, int64(1024), res.Height)
+	require.NotNil(t, res.Proof)
+	calls := backend.Calls()
+	require.Equal(t, int64(1024), calls[len(calls)-1].Height)
+	require.True(t, calls[len(calls)-1].Prove)
+
+	// served at another height
+	_, err = cli.QueryStore("farm", "key", key, 1000)
+	require.Error(t, err)
+	// not found
+	_, err = cli.QueryStore("farm", "key", []byte{0x03}, 0)
+	require.Error(t, err)
+	_, err = cli.QueryStore("", "key", key, 0)
+	require.Error(t, err)
+
+	prefix := []byte{0x01}
+	kvs := []cmn.KVPair{{Key: key, Value: value}}
+	backend.SetResponse("/store/farm/subspace", prefix, abci.ResponseQuery{
+		Value: cli.cdc.MustMarshalBinaryLengthPrefixed(kvs),
+	})
+	resKVs, err := cli.QuerySubspace("farm", prefix)
+	require.NoError(t, err)
+	require.Equal(t, kvs, resKVs)
+
+	_, err = cli.QuerySubspace("", prefix)
+	require.Error(t, err)
+}
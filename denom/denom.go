@@ -0,0 +1,320 @@
+// Package denom provides the registry of the denom metadata, which converts the amounts between the units of a denom
+// and formats them for display, so that the UI layers never hand-roll the decimal handling of the Dec-based amounts.
+//
+// Every amount on okchain is a Dec of the denom with sdk.Precision decimals, so a token is divisible into 10^8 base
+// units at most, e.g. 1okt = 10^8 okt-base. The token module reports no decimals of the tokens, which all share the
+// precision of Dec, while more units could be registered for display, e.g. a "mokt" of exponent 5.
+package denom
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/okex/okchain-go-sdk/exposed"
+	sdk "github.com/okex/okchain-go-sdk/types"
+)
+
+const (
+	// NativeDenom is the denom of the native token of okchain
+	NativeDenom = "okt"
+	// BaseUnitSuffix is appended to a denom to name its base unit, i.e. the smallest amount a Dec could carry
+	BaseUnitSuffix = "-base"
+)
+
+// Unit - structure of a unit of a denom
+type Unit struct {
+	Name string `json:"name"`
+	// Exponent is the power of 10 of the base units in one unit, e.g. 8 of okt since 1okt = 10^8 okt-base
+	Exponent uint32 `json:"exponent"`
+}
+
+// Metadata - structure of the metadata of a denom
+type Metadata struct {
+	// Denom is the symbol of the coins on the chain, whose amounts are in the unit of the same name
+	Denom string `json:"denom"`
+	// Name is the whole name of the token, e.g. "OKT"
+	Name string `json:"name"`
+	// Display is the unit to show the amounts in
+	Display string `json:"display"`
+	Units   []Unit `json:"units"`
+}
+
+// NewMetadata creates the metadata of a denom with the default units, i.e. the denom itself shown and its base unit
+func NewMetadata(denom, name string) Metadata {
+	return Metadata{
+		Denom:   denom,
+		Name:    name,
+		Display: denom,
+		Units: []Unit{
+			{Name: denom, Exponent: sdk.Precision},
+			{Name: BaseUnit(denom), Exponent: 0},
+		},
+	}
+}
+
+// BaseUnit returns the name of the base unit of the denom by default
+func BaseUnit(denom string) string {
+	return denom + BaseUnitSuffix
+}
+
+// Validate checks the units of the metadata. The unit of the denom must carry no more precision than Dec, and the
+// display unit must be one of the units
+func (m Metadata) Validate() error {
+	if len(m.Denom) == 0 {
+		return errors.New("failed. empty denom")
+	}
+
+	var hasDenom, hasDisplay bool
+	names := make(map[string]bool, len(m.Units))
+	for _, unit := range m.Units {
+		if len(unit.Name) == 0 {
+			return fmt.Errorf("failed. empty unit name of denom %s", m.Denom)
+		}
+		if names[unit.Name] {
+			return fmt.Errorf("failed. duplicate unit %s of denom %s", unit.Name, m.Denom)
+		}
+		names[unit.Name] = true
+		if unit.Name == m.Denom {
+			hasDenom = true
+			if unit.Exponent < sdk.Precision {
+				return fmt.Errorf("failed. exponent %d of denom %s is under the precision %d of the amounts",
+					unit.Exponent, m.Denom, sdk.Precision)
+			}
+		}
+		hasDisplay = hasDisplay || unit.Name == m.Display
+	}
+
+	if !hasDenom {
+		return fmt.Errorf("failed. no unit of denom %s itself", m.Denom)
+	}
+	if !hasDisplay {
+		return fmt.Errorf("failed. display unit %s isn't a unit of denom %s", m.Display, m.Denom)
+	}
+	return nil
+}
+
+// unit returns the unit of the name
+func (m Metadata) unit(name string) (Unit, bool) {
+	for _, unit := range m.Units {
+		if unit.Name == name {
+			return unit, true
+		}
+	}
+	return Unit{}, false
+}
+
+// Registry - structure of the registry of the denom metadata, which resolves the tokens unknown by the token queries
+type Registry struct {
+	mtx      sync.RWMutex
+	tokens   exposed.TokenQuery
+	metadata map[string]Metadata
+	// denoms of the unit names
+	units map[string]string
+}
+
+// NewRegistry creates a new instance of Registry with the metadata of okt. The tokens unregistered are resolved by the
+// token queries with the default units, or refused if tokens is nil
+func NewRegistry(tokens exposed.TokenQuery) *Registry {
+	r := &Registry{
+		tokens:   tokens,
+		metadata: make(map[string]Metadata),
+		units:    make(map[string]string),
+	}
+	if err := r.Register(NewMetadata(NativeDenom, "OKT")); err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// Register registers or replaces the metadata of a denom
+func (r *Registry) Register(metadata Metadata) error {
+	if err := metadata.Validate(); err != nil {
+		return err
+	}
+
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	for _, unit := range metadata.Units {
+		if denom, ok := r.units[unit.Name]; ok && denom != metadata.Denom {
+			return fmt.Errorf("failed. unit %s is registered by denom %s already", unit.Name, denom)
+		}
+	}
+
+	if old, ok := r.metadata[metadata.Denom]; ok {
+		for _, unit := range old.Units {
+			delete(r.units, unit.Name)
+		}
+	}
+	metadata.Units = append([]Unit{}, metadata.Units...)
+	for _, unit := range metadata.Units {
+		r.units[unit.Name] = metadata.Denom
+	}
+	r.metadata[metadata.Denom] = metadata
+	return nil
+}
+
+// Metadata returns the metadata of the denom, which is resolved by the token query if it's unregistered
+func (r *Registry) Metadata(denom string) (Metadata, error) {
+	r.mtx.RLock()
+	metadata, ok := r.metadata[denom]
+	r.mtx.RUnlock()
+	if ok {
+		return metadata, nil
+	}
+
+	if r.tokens == nil {
+		return metadata, fmt.Errorf("failed. unknown denom %s", denom)
+	}
+	tokens, err := r.tokens.QueryTokenInfo("", denom)
+	if err != nil {
+		return metadata, err
+	}
+	if len(tokens) == 0 {
+		return metadata, fmt.Errorf("failed. unknown denom %s", denom)
+	}
+
+	metadata = NewMetadata(tokens[0].Symbol, tokens[0].WholeName)
+	if err = r.Register(metadata); err != nil {
+		return metadata, err
+	}
+	return metadata, nil
+}
+
+// Denoms returns the denoms registered in order
+func (r *Registry) Denoms() []string {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+	denoms := make([]string, 0, len(r.metadata))
+	for denom := range r.metadata {
+		denoms = append(denoms, denom)
+	}
+	sort.Strings(denoms)
+	return denoms
+}
+
+// Convert converts the amount between two units of the same denom. It fails if the result carries more precision than
+// Dec, e.g. 0.5 base unit
+func (r *Registry) Convert(amount sdk.Dec, fromUnit, toUnit string) (sdk.Dec, error) {
+	metadata, from, err := r.resolveUnit(fromUnit)
+	if err != nil {
+		return amount, err
+	}
+	to, ok := metadata.unit(toUnit)
+	if !ok {
+		return amount, fmt.Errorf("failed. unit %s isn't a unit of denom %s", toUnit, metadata.Denom)
+	}
+
+	return scale(amount, int64(from.Exponent)-int64(to.Exponent))
+}
+
+// ToDisplay converts the coin into its amount in the display unit of its denom, with the display unit
+func (r *Registry) ToDisplay(coin sdk.DecCoin) (amount sdk.Dec, unit string, err error) {
+	metadata, err := r.Metadata(coin.Denom)
+	if err != nil {
+		return
+	}
+	amount, err = r.Convert(coin.Amount, coin.Denom, metadata.Display)
+	return amount, metadata.Display, err
+}
+
+// Format formats the coin in the display unit of its denom without the trailing zeros, e.g. "10.24 okt"
+func (r *Registry) Format(coin sdk.DecCoin) (string, error) {
+	amount, unit, err := r.ToDisplay(coin)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s %s", FormatDec(amount), unit), nil
+}
+
+// FormatCoins formats the coins in the display units of their denoms, joined by commas
+func (r *Registry) FormatCoins(coins sdk.DecCoins) (string, error) {
+	strs := make([]string, len(coins))
+	for i, coin := range coins {
+		str, err := r.Format(coin)
+		if err != nil {
+			return "", err
+		}
+		strs[i] = str
+	}
+	return strings.Join(strs, ", "), nil
+}
+
+// ParseAmount parses the amount in a unit into the coin of its denom, e.g. "1500000" in okt-base into 0.015okt
+func (r *Registry) ParseAmount(amountStr, unit string) (coin sdk.DecCoin, err error) {
+	metadata, from, err := r.resolveUnit(unit)
+	if err != nil {
+		return
+	}
+
+	amount, ok := new(big.Rat).SetString(strings.TrimSpace(amountStr))
+	if !ok {
+		return coin, fmt.Errorf("failed. invalid amount: %s", amountStr)
+	}
+	if amount.Sign() < 0 {
+		return coin, fmt.Errorf("failed. negative amount: %s", amountStr)
+	}
+
+	// amount in the base unit, which must be an integer
+	amount.Mul(amount, new(big.Rat).SetInt(pow10(int64(from.Exponent))))
+	if !amount.IsInt() {
+		return coin, fmt.Errorf("failed. amount %s %s is under the base unit", amountStr, unit)
+	}
+	denomUnit, _ := metadata.unit(metadata.Denom)
+	dec, err := scale(sdk.NewDecFromBigInt(amount.Num()), -int64(denomUnit.Exponent))
+	if err != nil {
+		return coin, fmt.Errorf("failed. amount %s %s is under the precision of the amounts", amountStr, unit)
+	}
+	return sdk.NewDecCoinFromDec(metadata.Denom, dec), nil
+}
+
+// resolveUnit returns the unit of the name with the metadata of its denom, resolving the denom of the same name by
+// the token query if it's unregistered
+func (r *Registry) resolveUnit(name string) (metadata Metadata, unit Unit, err error) {
+	r.mtx.RLock()
+	denom, ok := r.units[name]
+	r.mtx.RUnlock()
+	if !ok {
+		denom = name
+	}
+
+	if metadata, err = r.Metadata(denom); err != nil {
+		return
+	}
+	if unit, ok = metadata.unit(name); !ok {
+		err = fmt.Errorf("failed. unknown unit %s", name)
+	}
+	return
+}
+
+// FormatDec formats the Dec without the trailing zeros, e.g. "10.24" of 10.24000000
+func FormatDec(d sdk.Dec) string {
+	str := d.String()
+	if strings.Contains(str, ".") {
+		str = strings.TrimRight(strings.TrimRight(str, "0"), ".")
+	}
+	return str
+}
+
+// scale multiplies the amount by 10^exp, failing if the result carries more precision than Dec
+func scale(amount sdk.Dec, exp int64) (sdk.Dec, error) {
+	switch {
+	case exp > 0:
+		return sdk.Dec{Int: new(big.Int).Mul(amount.Int, pow10(exp))}, nil
+	case exp < 0:
+		quo, rem := new(big.Int).QuoRem(amount.Int, pow10(-exp), new(big.Int))
+		if rem.Sign() != 0 {
+			return amount, fmt.Errorf("failed. %s loses precision by %d decimals", amount, -exp)
+		}
+		return sdk.Dec{Int: quo}, nil
+	default:
+		return amount, nil
+	}
+}
+
+func pow10(exp int64) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(exp), nil)
+}
@@ -0,0 +1,114 @@
+package denom
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/okex/okchain-go-sdk/exposed"
+	tokentypes "github.com/okex/okchain-go-sdk/module/token/types"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeTokens serves the tokens from memory and counts the queries
+type fakeTokens struct {
+	exposed.TokenQuery
+	tokens  map[string]tokentypes.Token
+	queries int
+}
+
+func (ft *fakeTokens) QueryTokenInfo(_, symbol string) ([]tokentypes.Token, error) {
+	ft.queries++
+	token, ok := ft.tokens[symbol]
+	if !ok {
+		return nil, errors.New("failed. token doesn't exist")
+	}
+	return []tokentypes.Token{token}, nil
+}
+
+func TestRegistry_Convert(t *testing.T) {
+	registry := NewRegistry(nil)
+	require.Equal(t, []string{NativeDenom}, registry.Denoms())
+
+	amount, err := registry.Convert(sdk.MustNewDecFromStr("10.24"), "okt", "okt-base")
+	require.NoError(t, err)
+	require.Equal(t, "1024000000", FormatDec(amount))
+	amount, err = registry.Convert(sdk.NewDec(1), "okt-base", "okt")
+	require.NoError(t, err)
+	require.Equal(t, "0.00000001", FormatDec(amount))
+	_, err = registry.Convert(sdk.MustNewDecFromStr("0.5"), "okt-base", "okt")
+	require.Error(t, err)
+	_, err = registry.Convert(sdk.NewDec(1), "okt", "btc")
+	require.Error(t, err)
+
+	// more units for display
+	metadata := NewMetadata("okt", "OKT")
+	metadata.Units = append(metadata.Units, Unit{Name: "mokt", Exponent: 5})
+	metadata.Display = "mokt"
+	require.NoError(t, registry.Register(metadata))
+	str, err := registry.Format(sdk.NewDecCoinFromDec("okt", sdk.MustNewDecFromStr("0.015")))
+	require.NoError(t, err)
+	require.Equal(t, "15 mokt", str)
+	amount, err = registry.Convert(sdk.MustNewDecFromStr("2.5"), "mokt", "okt")
+	require.NoError(t, err)
+	require.Equal(t, "0.0025", FormatDec(amount))
+
+	coin, err := registry.ParseAmount("1500000", "okt-base")
+	require.NoError(t, err)
+	require.Equal(t, "0.01500000okt", coin.String())
+	coin, err = registry.ParseAmount("1.5", "mokt")
+	require.NoError(t, err)
+	require.Equal(t, "0.00150000okt", coin.String())
+	_, err = registry.ParseAmount("0.5", "okt-base")
+	require.Error(t, err)
+	_, err = registry.ParseAmount("-1", "okt")
+	require.Error(t, err)
+	_, err = registry.ParseAmount("abc", "okt")
+	require.Error(t, err)
+}
+
+func TestRegistry_Register(t *testing.T) {
+	registry := NewRegistry(nil)
+
+	metadata := NewMetadata("btc-000", "Bitcoin")
+	metadata.Units[0].Exponent = 6
+	require.Error(t, registry.Register(metadata))
+	metadata = NewMetadata("btc-000", "Bitcoin")
+	metadata.Display = "sat"
+	require.Error(t, registry.Register(metadata))
+	metadata = NewMetadata("btc-000", "Bitcoin")
+	metadata.Units = append(metadata.Units, Unit{Name: "okt-base", Exponent: 0})
+	require.Error(t, registry.Register(metadata))
+	metadata.Units = metadata.Units[1:]
+	require.Error(t, registry.Register(metadata))
+	require.NoError(t, registry.Register(NewMetadata("btc-000", "Bitcoin")))
+	require.Equal(t, []string{"btc-000", "okt"}, registry.Denoms())
+}
+
+func TestRegistry_Resolve(t *testing.T) {
+	tokens := &fakeTokens{
+		tokens: map[string]tokentypes.Token{
+			"xxb-781": {Symbol: "xxb-781", WholeName: "XXB"},
+		},
+	}
+	registry := NewRegistry(tokens)
+
+	coins := sdk.NewDecCoins(sdk.NewDecCoinFromDec("okt", sdk.MustNewDecFromStr("10.24")),
+		sdk.NewDecCoinFromDec("xxb-781", sdk.MustNewDecFromStr("1")))
+	str, err := registry.FormatCoins(coins)
+	require.NoError(t, err)
+	require.Equal(t, "10.24 okt, 1 xxb-781", str)
+	require.Equal(t, 1, tokens.queries)
+
+	// the base unit of the token resolved
+	amount, err := registry.Convert(sdk.NewDec(1), "xxb-781", "xxb-781-base")
+	require.NoError(t, err)
+	require.Equal(t, "100000000", FormatDec(amount))
+	metadata, err := registry.Metadata("xxb-781")
+	require.NoError(t, err)
+	require.Equal(t, "XXB", metadata.Name)
+	require.Equal(t, 1, tokens.queries)
+
+	_, err = registry.Format(sdk.NewDecCoinFromDec("btc-000", sdk.NewDec(1)))
+	require.Error(t, err)
+}
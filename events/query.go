@@ -0,0 +1,184 @@
+// Package events provides the builder of the tendermint event queries for the tx searches and the subscriptions, e.g.
+// events.New().MsgAction("delegate").Sender(addr).MinHeight(h).Build(), which validates the keys and the values
+// against the query grammar instead of the fragile strings concatenated.
+package events
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	tmquery "github.com/tendermint/tendermint/libs/pubsub/query"
+)
+
+// keys of the events commonly queried
+const (
+	KeyEvent     = "tm.event"
+	KeyTxHash    = "tx.hash"
+	KeyTxHeight  = "tx.height"
+	KeyMsgAction = "message.action"
+	KeyMsgSender = "message.sender"
+	KeyMsgModule = "message.module"
+	KeyRecipient = "transfer.recipient"
+	KeySender    = "transfer.sender"
+)
+
+// types of the tendermint events subscribed by tm.event
+const (
+	EventTx                  = "Tx"
+	EventNewBlock            = "NewBlock"
+	EventNewBlockHeader      = "NewBlockHeader"
+	EventValidatorSetUpdates = "ValidatorSetUpdates"
+)
+
+// Operator is the operator comparing the value of an event key
+type Operator string
+
+// operators of the conditions
+const (
+	OpEqual          Operator = "="
+	OpLess           Operator = "<"
+	OpLessOrEqual    Operator = "<="
+	OpGreater        Operator = ">"
+	OpGreaterOrEqual Operator = ">="
+	OpContains       Operator = "CONTAINS"
+)
+
+// Query - structure of the builder of a tendermint event query, whose conditions are joined by AND. The first invalid
+// key or value is reported by Build
+type Query struct {
+	conds []string
+	err   error
+}
+
+// New creates a new instance of Query without any condition
+func New() *Query {
+	return &Query{}
+}
+
+// Event adds the condition on the type of the tendermint event, e.g. EventTx, for the subscriptions
+func (q *Query) Event(eventType string) *Query {
+	return q.Equal(KeyEvent, eventType)
+}
+
+// Tx adds the condition matching the txs, for the subscriptions
+func (q *Query) Tx() *Query {
+	return q.Event(EventTx)
+}
+
+// MsgAction adds the condition on the action of a msg in the tx, e.g. "delegate"
+func (q *Query) MsgAction(action string) *Query {
+	return q.Equal(KeyMsgAction, action)
+}
+
+// MsgModule adds the condition on the module of a msg in the tx, e.g. "staking"
+func (q *Query) MsgModule(module string) *Query {
+	return q.Equal(KeyMsgModule, module)
+}
+
+// Sender adds the condition on the sender of a msg in the tx
+func (q *Query) Sender(addrStr string) *Query {
+	return q.Equal(KeyMsgSender, addrStr)
+}
+
+// Recipient adds the condition on the recipient of a transfer in the tx
+func (q *Query) Recipient(addrStr string) *Query {
+	return q.Equal(KeyRecipient, addrStr)
+}
+
+// TxHash adds the condition on the hash of the tx, which is upper-cased as indexed
+func (q *Query) TxHash(hash string) *Query {
+	return q.Equal(KeyTxHash, strings.ToUpper(hash))
+}
+
+// Height adds the condition on the height of the tx
+func (q *Query) Height(height int64) *Query {
+	return q.CompareInt(KeyTxHeight, OpEqual, height)
+}
+
+// MinHeight adds the condition of the txs from the height
+func (q *Query) MinHeight(height int64) *Query {
+	return q.CompareInt(KeyTxHeight, OpGreaterOrEqual, height)
+}
+
+// MaxHeight adds the condition of the txs up to the height
+func (q *Query) MaxHeight(height int64) *Query {
+	return q.CompareInt(KeyTxHeight, OpLessOrEqual, height)
+}
+
+// Equal adds the condition of the key equal to the string value
+func (q *Query) Equal(key, value string) *Query {
+	return q.Compare(key, OpEqual, value)
+}
+
+// Contains adds the condition of the key containing the string value
+func (q *Query) Contains(key, value string) *Query {
+	return q.Compare(key, OpContains, value)
+}
+
+// Compare adds the condition comparing the key with the string value, which is quoted
+func (q *Query) Compare(key string, op Operator, value string) *Query {
+	// the grammar has no escaping of the quotes in the values
+	if strings.ContainsAny(value, `'"`) {
+		return q.fail(fmt.Errorf("failed. value of %s contains the quotes: %s", key, value))
+	}
+	return q.add(key, op, fmt.Sprintf("'%s'", value))
+}
+
+// CompareInt adds the condition comparing the key with the number
+func (q *Query) CompareInt(key string, op Operator, value int64) *Query {
+	if op == OpContains {
+		return q.fail(fmt.Errorf("failed. %s on the number of %s", op, key))
+	}
+	return q.add(key, op, fmt.Sprint(value))
+}
+
+// CompareTime adds the condition comparing the key with the time, e.g. of the key tx.time
+func (q *Query) CompareTime(key string, op Operator, value time.Time) *Query {
+	if op == OpContains {
+		return q.fail(fmt.Errorf("failed. %s on the time of %s", op, key))
+	}
+	return q.add(key, op, "TIME "+value.UTC().Format(time.RFC3339))
+}
+
+func (q *Query) add(key string, op Operator, value string) *Query {
+	if len(key) == 0 || strings.ContainsAny(key, " \t\n\r\\()\"'=><") {
+		return q.fail(fmt.Errorf("failed. invalid event key: %q", key))
+	}
+
+	cond := key + string(op) + value
+	if op == OpContains {
+		cond = fmt.Sprintf("%s %s %s", key, op, value)
+	}
+	q.conds = append(q.conds, cond)
+	return q
+}
+
+func (q *Query) fail(err error) *Query {
+	if q.err == nil {
+		q.err = err
+	}
+	return q
+}
+
+// String returns the query string with the valid conditions only
+func (q *Query) String() string {
+	return strings.Join(q.conds, " AND ")
+}
+
+// Build returns the query string validated by the tendermint query parser
+func (q *Query) Build() (string, error) {
+	if q.err != nil {
+		return "", q.err
+	}
+	if len(q.conds) == 0 {
+		return "", errors.New("failed. empty event query")
+	}
+
+	queryStr := q.String()
+	if _, err := tmquery.New(queryStr); err != nil {
+		return "", fmt.Errorf("failed. invalid event query %s: %w", queryStr, err)
+	}
+	return queryStr, nil
+}
@@ -0,0 +1,51 @@
+package events
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const addr = "okchain1alq9na49n9yycysh889rl90g9nhe58lcv27tfj"
+
+func TestQuery_Build(t *testing.T) {
+	queryStr, err := New().MsgAction("delegate").Sender(addr).MinHeight(1024).Build()
+	require.NoError(t, err)
+	require.Equal(t, "message.action='delegate' AND message.sender='"+addr+"' AND tx.height>=1024", queryStr)
+
+	queryStr, err = New().Tx().Recipient(addr).TxHash("abcd").MaxHeight(2048).Build()
+	require.NoError(t, err)
+	require.Equal(t, "tm.event='Tx' AND transfer.recipient='"+addr+"' AND tx.hash='ABCD' AND tx.height<=2048",
+		queryStr)
+
+	queryStr, err = New().Contains("transfer.amount", "okt").
+		CompareTime("tx.time", OpGreater, time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)).Build()
+	require.NoError(t, err)
+	require.Equal(t, "transfer.amount CONTAINS 'okt' AND tx.time>TIME 2020-01-02T03:04:05Z", queryStr)
+
+	queryStr, err = New().Event(EventNewBlockHeader).Build()
+	require.NoError(t, err)
+	require.Equal(t, "tm.event='NewBlockHeader'", queryStr)
+}
+
+func TestQuery_BuildErrors(t *testing.T) {
+	for _, query := range []*Query{
+		New(),
+		New().MsgAction("it's"),
+		New().Equal("message.action", `"delegate"`),
+		New().Equal("message action", "delegate"),
+		New().Equal("", "delegate"),
+		New().CompareInt(KeyTxHeight, OpContains, 1),
+		New().CompareTime("tx.time", OpContains, time.Now()),
+	} {
+		_, err := query.Build()
+		require.Error(t, err, query.String())
+	}
+
+	// the first error is kept and the valid conditions are still built
+	query := New().MsgAction("it's").Sender(addr)
+	require.Equal(t, "message.sender='"+addr+"'", query.String())
+	_, err := query.Build()
+	require.Contains(t, err.Error(), "message.action")
+}
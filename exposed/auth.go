@@ -1,17 +1,12 @@
 package exposed
 
-import (
-	"github.com/okex/okchain-go-sdk/module/auth/types"
-	sdk "github.com/okex/okchain-go-sdk/types"
-)
+import authexposed "github.com/okex/okchain-go-sdk/module/auth/exposed"
 
 // Auth shows the expected behavior for inner auth client
-type Auth interface {
-	sdk.Module
-	AuthQuery
-}
+type Auth = authexposed.Auth
 
 // AuthQuery shows the expected query behavior for inner auth client
-type AuthQuery interface {
-	QueryAccount(accAddrStr string) (types.Account, error)
-}
+type AuthQuery = authexposed.AuthQuery
+
+// AuthSubscription shows the expected subscription behavior for inner auth client
+type AuthSubscription = authexposed.AuthSubscription
@@ -1,23 +1,9 @@
 package exposed
 
-import (
-	"github.com/okex/okchain-go-sdk/module/backend/types"
-	sdk "github.com/okex/okchain-go-sdk/types"
-)
+import backendexposed "github.com/okex/okchain-go-sdk/module/backend/exposed"
 
 // Backend shows the expected behavior for inner backend client
-type Backend interface {
-	sdk.Module
-	BackendQuery
-}
+type Backend = backendexposed.Backend
 
 // BackendQuery shows the expected query behavior for inner backend client
-type BackendQuery interface {
-	QueryCandles(product string, granularity, size int) ([][]string, error)
-	QueryTickers(product string, count ...int) ([]types.Ticker, error)
-	QueryRecentTxRecord(product string, start, end, page, perPage int) ([]types.MatchResult, error)
-	QueryOpenOrders(addrStr, product, side string, start, end, page, perPage int) ([]types.Order, error)
-	QueryClosedOrders(addrStr, product, side string, start, end, page, perPage int) ([]types.Order, error)
-	QueryDeals(addrStr, product, side string, start, end, page, perPage int) ([]types.Deal, error)
-	QueryTransactions(addrStr string, typeCode, start, end, page, perPage int) ([]types.Transaction, error)
-}
+type BackendQuery = backendexposed.BackendQuery
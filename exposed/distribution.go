@@ -1,18 +1,12 @@
 package exposed
 
-import (
-	sdk "github.com/okex/okchain-go-sdk/types"
-	"github.com/okex/okchain-go-sdk/types/crypto/keys"
-)
+import distributionexposed "github.com/okex/okchain-go-sdk/module/distribution/exposed"
 
 // Distribution shows the expected behavior for inner distribution client
-type Distribution interface {
-	sdk.Module
-	DistrTx
-}
+type Distribution = distributionexposed.Distribution
 
 // DistrTx shows the expected tx behavior for inner distribution client
-type DistrTx interface {
-	SetWithdrawAddr(fromInfo keys.Info, passWd, withdrawAddrStr, memo string, accNum, seqNum uint64) (sdk.TxResponse, error)
-	WithdrawRewards(fromInfo keys.Info, passWd, valAddrStr, memo string, accNum, seqNum uint64) (sdk.TxResponse, error)
-}
+type DistrTx = distributionexposed.DistrTx
+
+// DistrQuery shows the expected query behavior for inner distribution client
+type DistrQuery = distributionexposed.DistrQuery
@@ -1,22 +1,15 @@
 package exposed
 
-import (
-	sdk "github.com/okex/okchain-go-sdk/types"
-	"github.com/okex/okchain-go-sdk/types/crypto/keys"
-)
+import governanceexposed "github.com/okex/okchain-go-sdk/module/governance/exposed"
 
 // Governance shows the expected behavior for inner governance client
-type Governance interface {
-	sdk.Module
-	GovTx
-}
+type Governance = governanceexposed.Governance
 
 // GovTx shows the expected tx behavior for inner governance client
-type GovTx interface {
-	SubmitTextProposal(fromInfo keys.Info, passWd, proposalPath, memo string, accNum, seqNum uint64) (sdk.TxResponse, error)
-	SubmitParamChangeProposal(fromInfo keys.Info, passWd, proposalPath, memo string, accNum, seqNum uint64) (sdk.TxResponse, error)
-	SubmitDelistProposal(fromInfo keys.Info, passWd, proposalPath, memo string, accNum, seqNum uint64) (sdk.TxResponse, error)
-	SubmitCommunityPoolSpendProposal(fromInfo keys.Info, passWd, proposalPath, memo string, accNum, seqNum uint64) (sdk.TxResponse, error)
-	Deposit(fromInfo keys.Info, passWd, depositCoinsStr, memo string, proposalID, accNum, seqNum uint64) (sdk.TxResponse, error)
-	Vote(fromInfo keys.Info, passWd, voteOption, memo string, proposalID, accNum, seqNum uint64) (sdk.TxResponse, error)
-}
+type GovTx = governanceexposed.GovTx
+
+// GovQuery shows the expected query behavior for inner governance client
+type GovQuery = governanceexposed.GovQuery
+
+// GovProposal shows the expected behavior to build the proposals programmatically for inner governance client
+type GovProposal = governanceexposed.GovProposal
@@ -0,0 +1,9 @@
+package exposed
+
+import mintexposed "github.com/okex/okchain-go-sdk/module/mint/exposed"
+
+// Mint shows the expected behavior for inner mint client
+type Mint = mintexposed.Mint
+
+// MintQuery shows the expected query behavior for inner mint client
+type MintQuery = mintexposed.MintQuery
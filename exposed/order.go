@@ -1,27 +1,15 @@
 package exposed
 
-import (
-	"github.com/okex/okchain-go-sdk/module/order/types"
-	sdk "github.com/okex/okchain-go-sdk/types"
-	"github.com/okex/okchain-go-sdk/types/crypto/keys"
-)
+import orderexposed "github.com/okex/okchain-go-sdk/module/order/exposed"
 
 // Order shows the expected behavior for inner order client
-type Order interface {
-	sdk.Module
-	OrderTx
-	OrderQuery
-}
+type Order = orderexposed.Order
 
 // OrderTx shows the expected tx behavior for inner order client
-type OrderTx interface {
-	NewOrders(fromInfo keys.Info, passWd, products, sides, prices, quantities, memo string, accNum, seqNum uint64) (
-		sdk.TxResponse, error)
-	CancelOrders(fromInfo keys.Info, passWd, orderIDs, memo string, accNum, seqNum uint64) (sdk.TxResponse, error)
-}
+type OrderTx = orderexposed.OrderTx
 
 // OrderQuery shows the expected query behavior for inner order client
-type OrderQuery interface {
-	QueryDepthBook(product string) (types.BookRes, error)
-	QueryOrderDetail(orderID string) (types.OrderDetail, error)
-}
+type OrderQuery = orderexposed.OrderQuery
+
+// OrderSubscription shows the expected subscription behavior for inner order client
+type OrderSubscription = orderexposed.OrderSubscription
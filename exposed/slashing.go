@@ -1,17 +1,12 @@
 package exposed
 
-import (
-	sdk "github.com/okex/okchain-go-sdk/types"
-	"github.com/okex/okchain-go-sdk/types/crypto/keys"
-)
+import slashingexposed "github.com/okex/okchain-go-sdk/module/slashing/exposed"
 
 // Slashing shows the expected behavior for inner slashing client
-type Slashing interface {
-	sdk.Module
-	SlashingTx
-}
+type Slashing = slashingexposed.Slashing
 
 // SlashingTx shows the expected tx behavior for inner slashing client
-type SlashingTx interface {
-	Unjail(fromInfo keys.Info, passWd, memo string, accNum, seqNum uint64) (sdk.TxResponse, error)
-}
+type SlashingTx = slashingexposed.SlashingTx
+
+// SlashingQuery shows the expected query behavior for inner slashing client
+type SlashingQuery = slashingexposed.SlashingQuery
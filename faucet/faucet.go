@@ -0,0 +1,253 @@
+// Package faucet provides the client of a testnet faucet, which requests the testnet tokens for an address and waits
+// for the funding tx, so that the CI integration tests and the new users provision their accounts by themselves.
+package faucet
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/okex/okchain-go-sdk/exposed"
+	sdk "github.com/okex/okchain-go-sdk/types"
+)
+
+const (
+	defaultTimeout      = 10 * time.Second
+	defaultWaitTimeout  = time.Minute
+	defaultPollInterval = time.Second
+	// maxErrBodyLen is the max length of the response body kept in the error of a request refused
+	maxErrBodyLen = 256
+)
+
+// ErrRateLimited is returned when the faucet refuses the request by its rate limit, e.g. one request per address a day
+var ErrRateLimited = errors.New("faucet rate limited")
+
+// Config - structure of the config of the faucet client
+type Config struct {
+	// Endpoint is the URL of the faucet, which receives the POST requests of the JSON body
+	// {"address": "okchain1...", "denom": "okt", "amount": "10"} and answers the JSON body {"tx_hash": "..."}
+	Endpoint string
+	// Denom and Amount are left to the faucet if empty
+	Denom  string
+	Amount string
+	// Headers are set on every request, e.g. the API key of the faucet
+	Headers map[string]string
+	// Timeout is the timeout of each request, 10s by default
+	Timeout time.Duration
+	// WaitTimeout is the timeout of waiting for the funding, 1m by default
+	WaitTimeout time.Duration
+	// PollInterval is the interval of polling the funding tx or the balance, 1s by default
+	PollInterval time.Duration
+	// HTTPClient sends the requests, http.DefaultClient by default
+	HTTPClient *http.Client
+}
+
+// ValidateBasic gives a quick validity check for the faucet config
+func (c Config) ValidateBasic() error {
+	if len(c.Endpoint) == 0 {
+		return errors.New("failed. empty faucet endpoint")
+	}
+	if c.Timeout < 0 || c.WaitTimeout < 0 || c.PollInterval < 0 {
+		return errors.New("failed. timeout, wait timeout and poll interval must not be negative")
+	}
+	return nil
+}
+
+// request - structure of the body of the faucet request
+type request struct {
+	Address string `json:"address"`
+	Denom   string `json:"denom,omitempty"`
+	Amount  string `json:"amount,omitempty"`
+}
+
+// response - structure of the body of the faucet response, whose tx hash is under any of the keys seen in the faucets
+type response struct {
+	TxHash string `json:"tx_hash"`
+	Txhash string `json:"txhash"`
+	Hash   string `json:"hash"`
+}
+
+func (r response) txHash() string {
+	for _, hash := range []string{r.TxHash, r.Txhash, r.Hash} {
+		if len(hash) != 0 {
+			return strings.ToUpper(hash)
+		}
+	}
+	return ""
+}
+
+// Funding - structure of the result of a faucet request
+type Funding struct {
+	// TxHash is the hash of the funding tx, empty if the faucet doesn't tell
+	TxHash string
+	// Height is the height of the funding tx, 0 if its hash is unknown
+	Height int64
+	// Coins are the balances of the address once funded
+	Coins sdk.DecCoins
+}
+
+// Client - structure of the faucet client
+type Client struct {
+	tm     exposed.TendermintQuery
+	auth   exposed.AuthQuery
+	config Config
+}
+
+// NewClient creates a new instance of the faucet Client
+func NewClient(tm exposed.TendermintQuery, auth exposed.AuthQuery, config Config) (*Client, error) {
+	if err := config.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if config.Timeout == 0 {
+		config.Timeout = defaultTimeout
+	}
+	if config.WaitTimeout == 0 {
+		config.WaitTimeout = defaultWaitTimeout
+	}
+	if config.PollInterval == 0 {
+		config.PollInterval = defaultPollInterval
+	}
+	if config.HTTPClient == nil {
+		config.HTTPClient = http.DefaultClient
+	}
+
+	return &Client{
+		tm:     tm,
+		auth:   auth,
+		config: config,
+	}, nil
+}
+
+// Request requests the testnet tokens for the address without waiting, and returns the hash of the funding tx if the
+// faucet tells
+func (c *Client) Request(ctx context.Context, accAddrStr string) (txHash string, err error) {
+	if _, err = sdk.AccAddressFromBech32(accAddrStr); err != nil {
+		return "", fmt.Errorf("failed. accAddress %s converted from Bech32 error: %w", accAddrStr, err)
+	}
+	body, err := json.Marshal(request{
+		Address: accAddrStr,
+		Denom:   c.config.Denom,
+		Amount:  c.config.Amount,
+	})
+	if err != nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.config.Timeout)
+	defer cancel()
+	req, err := http.NewRequest(http.MethodPost, c.config.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return "", fmt.Errorf("failed. build request to %s error: %w", c.config.Endpoint, err)
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+	for name, value := range c.config.Headers {
+		req.Header.Set(name, value)
+	}
+
+	resp, err := c.config.HTTPClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed. request faucet %s error: %w", c.config.Endpoint, err)
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return "", fmt.Errorf("failed. read faucet response error: %w", err)
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests:
+		return "", fmt.Errorf("failed. faucet refused %s: %w", accAddrStr, ErrRateLimited)
+	case resp.StatusCode < 200 || resp.StatusCode >= 300:
+		if len(respBody) > maxErrBodyLen {
+			respBody = respBody[:maxErrBodyLen]
+		}
+		return "", fmt.Errorf("failed. faucet refused %s with status %d: %s", accAddrStr, resp.StatusCode,
+			strings.TrimSpace(string(respBody)))
+	}
+
+	// the faucets answering without the JSON body fund the address as well
+	var res response
+	if err := json.Unmarshal(respBody, &res); err != nil {
+		return "", nil
+	}
+	return res.txHash(), nil
+}
+
+// RequestAndWait requests the testnet tokens for the address, and waits until the funding tx is committed, or the
+// balances of the address change if the faucet doesn't tell the tx hash
+func (c *Client) RequestAndWait(ctx context.Context, accAddrStr string) (funding Funding, err error) {
+	// the address never funded has no account on chain
+	before, err := c.queryCoins(accAddrStr)
+	if err != nil {
+		return
+	}
+	if funding.TxHash, err = c.Request(ctx, accAddrStr); err != nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.config.WaitTimeout)
+	defer cancel()
+	for {
+		done, err := c.checkFunding(accAddrStr, before, &funding)
+		if err != nil || done {
+			return funding, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return funding, fmt.Errorf("failed. wait for the funding of %s error: %w", accAddrStr, ctx.Err())
+		case <-time.After(c.config.PollInterval):
+		}
+	}
+}
+
+// checkFunding checks whether the funding tx is committed, or the balances changed without its hash
+func (c *Client) checkFunding(accAddrStr string, before sdk.DecCoins, funding *Funding) (done bool, err error) {
+	if len(funding.TxHash) != 0 {
+		hashBytes, err := hex.DecodeString(funding.TxHash)
+		if err != nil {
+			return false, fmt.Errorf("failed. invalid funding tx hash %s: %w", funding.TxHash, err)
+		}
+		// not found until it's committed
+		res, err := c.tm.QueryTxResult(hashBytes, false)
+		if err != nil {
+			return false, nil
+		}
+		if res.TxResult.Code != 0 {
+			return false, fmt.Errorf("failed. funding tx %s failed with code %d: %s", funding.TxHash,
+				res.TxResult.Code, res.TxResult.Log)
+		}
+		funding.Height = res.Height
+	}
+
+	coins, err := c.queryCoins(accAddrStr)
+	if err != nil {
+		return
+	}
+	if len(funding.TxHash) == 0 && coins.IsEqual(before) {
+		return false, nil
+	}
+	funding.Coins = coins
+	return true, nil
+}
+
+// queryCoins returns the balances of the address, which are empty if the account isn't on chain yet
+func (c *Client) queryCoins(accAddrStr string) (sdk.DecCoins, error) {
+	account, err := c.auth.QueryAccount(accAddrStr)
+	if err != nil {
+		if strings.Contains(err.Error(), "no record on the chain") {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed. query account %s error: %w", accAddrStr, err)
+	}
+	return account.GetCoins(), nil
+}
@@ -0,0 +1,122 @@
+package faucet
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/okex/okchain-go-sdk/exposed"
+	auth "github.com/okex/okchain-go-sdk/module/auth/types"
+	tmtypes "github.com/okex/okchain-go-sdk/module/tendermint/types"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+)
+
+// fakeChain commits the funding tx after a number of polls
+type fakeChain struct {
+	exposed.TendermintQuery
+	mtx          sync.Mutex
+	coins        sdk.DecCoins
+	pollsToFund  int
+	fundingCoins sdk.DecCoins
+	txCode       uint32
+}
+
+func (fc *fakeChain) QueryTxResult([]byte, bool) (tmtypes.ResultTx, error) {
+	fc.mtx.Lock()
+	defer fc.mtx.Unlock()
+	if fc.pollsToFund > 0 {
+		fc.pollsToFund--
+		return tmtypes.ResultTx{}, errors.New("tx not found")
+	}
+	fc.coins = fc.fundingCoins
+	return tmtypes.ResultTx{Height: 1024, TxResult: tmtypes.ResponseDeliverTx{Code: fc.txCode, Log: "out of tokens"}}, nil
+}
+
+func (fc *fakeChain) QueryAccount(string) (auth.Account, error) {
+	fc.mtx.Lock()
+	defer fc.mtx.Unlock()
+	if fc.coins == nil {
+		// funded without the tx hash
+		if fc.pollsToFund == 0 {
+			fc.coins = fc.fundingCoins
+		}
+		fc.pollsToFund--
+		return nil, errors.New("failed. your account has no record on the chain")
+	}
+	return &auth.BaseAccount{Coins: fc.coins}, nil
+}
+
+func TestClient_RequestAndWait(t *testing.T) {
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	var status int
+	var respBody string
+	var reqs []request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "secret", r.Header.Get("X-Api-Key"))
+		var req request
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		reqs = append(reqs, req)
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(respBody))
+	}))
+	defer server.Close()
+
+	coins, err := sdk.ParseDecCoins("10okt")
+	require.NoError(t, err)
+	chain := &fakeChain{pollsToFund: 2, fundingCoins: coins}
+	_, err = NewClient(chain, chain, Config{})
+	require.Error(t, err)
+	faucet, err := NewClient(chain, chain, Config{
+		Endpoint:     server.URL,
+		Denom:        "okt",
+		Headers:      map[string]string{"X-Api-Key": "secret"},
+		PollInterval: time.Millisecond,
+	})
+	require.NoError(t, err)
+
+	// waits for the funding tx
+	status, respBody = http.StatusOK, `{"tx_hash":"0a1b"}`
+	funding, err := faucet.RequestAndWait(context.Background(), addr)
+	require.NoError(t, err)
+	require.Equal(t, Funding{TxHash: "0A1B", Height: 1024, Coins: coins}, funding)
+	require.Equal(t, []request{{Address: addr, Denom: "okt"}}, reqs)
+
+	// waits for the balances to change without the tx hash
+	chain.coins, chain.pollsToFund = nil, 3
+	status, respBody = http.StatusAccepted, "funded"
+	funding, err = faucet.RequestAndWait(context.Background(), addr)
+	require.NoError(t, err)
+	require.Equal(t, Funding{Coins: coins}, funding)
+
+	// the funding tx failed
+	chain.txCode = 5
+	status, respBody = http.StatusOK, `{"txhash":"0a1b"}`
+	_, err = faucet.RequestAndWait(context.Background(), addr)
+	require.Error(t, err)
+
+	// refused by the faucet
+	status, respBody = http.StatusTooManyRequests, "slow down"
+	_, err = faucet.Request(context.Background(), addr)
+	require.True(t, errors.Is(err, ErrRateLimited))
+	status, respBody = http.StatusBadRequest, "invalid address"
+	_, err = faucet.Request(context.Background(), addr)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "invalid address")
+	_, err = faucet.Request(context.Background(), "okchain1invalid")
+	require.Error(t, err)
+
+	// never funded
+	chain.coins, chain.pollsToFund = nil, 1<<20
+	status, respBody = http.StatusOK, ""
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err = faucet.RequestAndWait(ctx, addr)
+	require.True(t, errors.Is(err, context.DeadlineExceeded))
+}
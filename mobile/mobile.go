@@ -0,0 +1,200 @@
+// Package mobile provides the core of key derivation, signing and tx encoding for the mobile wallets, which is able to be
+// bound by `gomobile bind github.com/okex/okchain-go-sdk/mobile`, or built for js/wasm by
+// `GOOS=js GOARCH=wasm go build -tags appengine ./mobile/`.
+// It only takes and returns the types supported by gomobile, holds no keystore and never connects to a node, so that
+// the apps keep the keys in their own secure storages and broadcast the signed txs through their own transports.
+package mobile
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/cosmos/go-bip39"
+	dex "github.com/okex/okchain-go-sdk/module/dex/types"
+	distribution "github.com/okex/okchain-go-sdk/module/distribution/types"
+	governance "github.com/okex/okchain-go-sdk/module/governance/types"
+	order "github.com/okex/okchain-go-sdk/module/order/types"
+	slashing "github.com/okex/okchain-go-sdk/module/slashing/types"
+	staking "github.com/okex/okchain-go-sdk/module/staking/types"
+	token "github.com/okex/okchain-go-sdk/module/token/types"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	"github.com/okex/okchain-go-sdk/types/crypto/keys/hd"
+	"github.com/okex/okchain-go-sdk/types/signing"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+)
+
+// mnemonicEntropySize is the entropy of the mnemonics of 12 words
+const mnemonicEntropySize = 128
+
+// cdc is the codec with the msgs of all the modules registered to decode and encode the txs
+var cdc = newCodec()
+
+func newCodec() sdk.SDKCodec {
+	cdc := sdk.NewCodec()
+	dex.RegisterCodec(cdc)
+	distribution.RegisterCodec(cdc)
+	governance.RegisterCodec(cdc)
+	order.RegisterCodec(cdc)
+	slashing.RegisterCodec(cdc)
+	staking.RegisterCodec(cdc)
+	token.RegisterCodec(cdc)
+	sdk.RegisterBasicCodec(cdc)
+	cdc.Seal()
+	return cdc
+}
+
+// Key - structure of a key derived from the mnemonic
+type Key struct {
+	// PrivKeyHex is the raw private key in hex, which the apps need to keep in the secure storage
+	PrivKeyHex string
+	// PubKeyHex is the compressed public key in hex
+	PubKeyHex string
+	// Address is the bech32 account address
+	Address string
+}
+
+// SignedTx - structure of a signed tx ready to be broadcast
+type SignedTx struct {
+	// TxBytes is the amino encoded tx to broadcast by the rpc broadcast_tx_* of the node
+	TxBytes []byte
+	// TxHash is the hash of the tx in the same format as the tx hash of the broadcast response
+	TxHash string
+	// TxJSON is the amino json of the signed tx, which could be signed further by the other signers
+	TxJSON string
+}
+
+// NewMnemonic creates a random mnemonic of 12 words
+func NewMnemonic() (string, error) {
+	entropy, err := bip39.NewEntropy(mnemonicEntropySize)
+	if err != nil {
+		return "", fmt.Errorf("failed. generate the entropy error: %w", err)
+	}
+	return bip39.NewMnemonic(entropy)
+}
+
+// DeriveKey derives the key on the BIP44 path of the account and the index with the coin type of okchain gosdk config
+func DeriveKey(mnemonic string, account, index int32) (*Key, error) {
+	if account < 0 || index < 0 {
+		return nil, errors.New("failed. account and index must not be negative")
+	}
+
+	seed, err := bip39.NewSeedWithErrorChecking(mnemonic, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed. invalid mnemonic: %w", err)
+	}
+
+	hdPath := hd.NewFundraiserParamsWithCoinType(sdk.GetConfig().GetCoinType(), uint32(account), uint32(index))
+	masterPrivKey, ch := hd.ComputeMastersFromSeed(seed)
+	derivedPrivKey, err := hd.DerivePrivateKeyForPath(masterPrivKey, ch, hdPath.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return newKey(secp256k1.PrivKeySecp256k1(derivedPrivKey)), nil
+}
+
+// KeyFromPrivKey recovers the key from the raw private key in hex
+func KeyFromPrivKey(privKeyHex string) (*Key, error) {
+	privKey, err := parsePrivKey(privKeyHex)
+	if err != nil {
+		return nil, err
+	}
+	return newKey(privKey), nil
+}
+
+// Sign signs the bytes by the private key in hex
+func Sign(privKeyHex string, msg []byte) ([]byte, error) {
+	privKey, err := parsePrivKey(privKeyHex)
+	if err != nil {
+		return nil, err
+	}
+	return privKey.Sign(msg)
+}
+
+// SignTx signs the tx in amino json with the account number and sequence of the signer, and encodes it to broadcast
+// The signature is appended to the existing ones, so that the fee payer or the other signers are able to sign in turn
+func SignTx(privKeyHex, chainID string, accNum, seqNum int64, txJSON string) (*SignedTx, error) {
+	if len(chainID) == 0 {
+		return nil, errors.New("failed. empty chain ID")
+	}
+	if accNum < 0 || seqNum < 0 {
+		return nil, errors.New("failed. account number and sequence must not be negative")
+	}
+
+	privKey, err := parsePrivKey(privKeyHex)
+	if err != nil {
+		return nil, err
+	}
+
+	var stdTx sdk.StdTx
+	if err = cdc.UnmarshalJSON([]byte(txJSON), &stdTx); err != nil {
+		return nil, fmt.Errorf("failed. decode the tx json error: %w", err)
+	}
+	if len(stdTx.Msgs) == 0 {
+		return nil, errors.New("failed. no msg in the tx")
+	}
+
+	sig, err := signing.MakeSignatureWithSigner(signing.NewPrivKeySigner(privKey), sdk.StdSignMsg{
+		ChainID:       chainID,
+		AccountNumber: uint64(accNum),
+		Sequence:      uint64(seqNum),
+		Fee:           stdTx.Fee,
+		Msgs:          stdTx.Msgs,
+		Memo:          stdTx.Memo,
+	})
+	if err != nil {
+		return nil, err
+	}
+	stdTx.Signatures = append(stdTx.Signatures, sig)
+
+	return encodeTx(stdTx)
+}
+
+// EncodeTx encodes the signed tx in amino json to broadcast
+func EncodeTx(txJSON string) (*SignedTx, error) {
+	var stdTx sdk.StdTx
+	if err := cdc.UnmarshalJSON([]byte(txJSON), &stdTx); err != nil {
+		return nil, fmt.Errorf("failed. decode the tx json error: %w", err)
+	}
+	return encodeTx(stdTx)
+}
+
+func encodeTx(stdTx sdk.StdTx) (*SignedTx, error) {
+	signedTx, err := signing.EncodeStdTx(cdc, stdTx)
+	if err != nil {
+		return nil, err
+	}
+	txJSON, err := cdc.MarshalJSON(stdTx)
+	if err != nil {
+		return nil, fmt.Errorf("failed. encode the tx json error: %w", err)
+	}
+
+	return &SignedTx{
+		TxBytes: signedTx.Bytes,
+		TxHash:  signedTx.Hash,
+		TxJSON:  string(txJSON),
+	}, nil
+}
+
+func newKey(privKey secp256k1.PrivKeySecp256k1) *Key {
+	pubKey := privKey.PubKey().(secp256k1.PubKeySecp256k1)
+	return &Key{
+		PrivKeyHex: hex.EncodeToString(privKey[:]),
+		PubKeyHex:  hex.EncodeToString(pubKey[:]),
+		Address:    sdk.AccAddress(pubKey.Address()).String(),
+	}
+}
+
+func parsePrivKey(privKeyHex string) (privKey secp256k1.PrivKeySecp256k1, err error) {
+	privKeyBytes, err := hex.DecodeString(privKeyHex)
+	if err != nil {
+		return privKey, fmt.Errorf("failed. decode the private key hex error: %w", err)
+	}
+	if len(privKeyBytes) != len(privKey) {
+		return privKey, fmt.Errorf("failed. the private key must be %d bytes", len(privKey))
+	}
+
+	copy(privKey[:], privKeyBytes)
+	return
+}
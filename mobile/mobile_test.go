@@ -0,0 +1,79 @@
+package mobile
+
+import (
+	"testing"
+
+	"github.com/okex/okchain-go-sdk/mocks"
+	token "github.com/okex/okchain-go-sdk/module/token/types"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	"github.com/okex/okchain-go-sdk/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeriveKey(t *testing.T) {
+	key, err := DeriveKey(mocks.FixtureMnemonic, 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, mocks.FixtureAddr, key.Address)
+
+	recovered, err := KeyFromPrivKey(key.PrivKeyHex)
+	require.NoError(t, err)
+	require.Equal(t, key, recovered)
+
+	another, err := DeriveKey(mocks.FixtureMnemonic, 0, 1)
+	require.NoError(t, err)
+	require.NotEqual(t, key.Address, another.Address)
+
+	_, err = DeriveKey("invalid mnemonic", 0, 0)
+	require.Error(t, err)
+	_, err = DeriveKey(mocks.FixtureMnemonic, -1, 0)
+	require.Error(t, err)
+	_, err = KeyFromPrivKey("0a0b")
+	require.Error(t, err)
+
+	mnemonic, err := NewMnemonic()
+	require.NoError(t, err)
+	_, err = DeriveKey(mnemonic, 0, 0)
+	require.NoError(t, err)
+}
+
+func TestSignTx(t *testing.T) {
+	key, err := DeriveKey(mocks.FixtureMnemonic, 0, 0)
+	require.NoError(t, err)
+	fromAddr, err := sdk.AccAddressFromBech32(key.Address)
+	require.NoError(t, err)
+	coins, err := sdk.ParseDecCoins("1.024okt")
+	require.NoError(t, err)
+	fees, err := sdk.ParseDecCoins(mocks.FixtureFees)
+	require.NoError(t, err)
+
+	msgs := []sdk.Msg{token.NewMsgTokenSend(fromAddr, fromAddr, coins)}
+	unsignedTx := sdk.NewStdTx(msgs, sdk.NewStdFee(mocks.FixtureGas, fees), nil, "mobile")
+	txJSON, err := cdc.MarshalJSON(unsignedTx)
+	require.NoError(t, err)
+
+	signedTx, err := SignTx(key.PrivKeyHex, mocks.FixtureChainID, 1, 2, string(txJSON))
+	require.NoError(t, err)
+
+	// the same as the tx signed by the keybase
+	expectedTx := mocks.BuildFixtureStdTx(t, msgs, "mobile", 1, 2)
+	expectedBytes, err := cdc.MarshalBinaryLengthPrefixed(expectedTx)
+	require.NoError(t, err)
+	require.Equal(t, expectedBytes, signedTx.TxBytes)
+	require.Equal(t, sdk.NewSignedTx(expectedTx, expectedBytes).Hash, signedTx.TxHash)
+
+	stdTx, err := utils.DecodeStdTx(cdc, signedTx.TxBytes)
+	require.NoError(t, err)
+	require.NoError(t, utils.VerifyStdTxSignatures(stdTx, mocks.FixtureChainID,
+		[]utils.SignerAccount{{AccountNumber: 1, Sequence: 2}}))
+
+	encodedTx, err := EncodeTx(signedTx.TxJSON)
+	require.NoError(t, err)
+	require.Equal(t, signedTx, encodedTx)
+
+	_, err = SignTx(key.PrivKeyHex, "", 1, 2, string(txJSON))
+	require.Error(t, err)
+	_, err = SignTx(key.PrivKeyHex, mocks.FixtureChainID, 1, 2, "{}")
+	require.Error(t, err)
+	_, err = SignTx("", mocks.FixtureChainID, 1, 2, string(txJSON))
+	require.Error(t, err)
+}
@@ -0,0 +1,23 @@
+package mobile
+
+import (
+	"os"
+	"os/exec"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestBuildWasm checks the package keeps building for js/wasm, which breaks once it depends on a keybase, the tendermint
+// db or the rpc client of tendermint. The tag appengine picks the stub of the terminal detection of go-kit in the
+// tendermint libs, which has none for js
+func TestBuildWasm(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping the js/wasm build in short mode")
+	}
+
+	cmd := exec.Command("go", "build", "-tags", "appengine", "-o", os.DevNull, ".")
+	cmd.Env = append(os.Environ(), "GOOS=js", "GOARCH=wasm")
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(out))
+}
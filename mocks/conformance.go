@@ -0,0 +1,232 @@
+package mocks
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	sdk "github.com/okex/okchain-go-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	rpcCli "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+var (
+	_ sdk.RPCClient = (*ConformanceBackend)(nil)
+
+	errUnsupportedByBackend = errors.New("failed. unsupported by the conformance backend")
+)
+
+// QueryTransportFactory builds the transport under the conformance test, which is supposed to route all the ABCI
+// queries to the backend given
+type QueryTransportFactory func(cdc sdk.SDKCodec, backend *ConformanceBackend) sdk.ClientQuery
+
+// ConformanceCall - structure of an ABCI query received by ConformanceBackend
+type ConformanceCall struct {
+	Path   string
+	Data   cmn.HexBytes
+	Height int64
+	Prove  bool
+}
+
+// ConformanceBackend - structure of a canned ABCI backend for the query conformance test
+// It implements sdk.RPCClient so that it could be used as the RPC client of the base client directly
+type ConformanceBackend struct {
+	mtx       sync.Mutex
+	responses map[string]abci.ResponseQuery
+	errs      map[string]error
+	calls     []ConformanceCall
+}
+
+// NewConformanceBackend creates a new instance of ConformanceBackend
+func NewConformanceBackend() *ConformanceBackend {
+	return &ConformanceBackend{
+		responses: make(map[string]abci.ResponseQuery),
+		errs:      make(map[string]error),
+	}
+}
+
+// SetResponse sets the canned ABCI response for the query with a specific path and data
+func (cb *ConformanceBackend) SetResponse(path string, data []byte, resp abci.ResponseQuery) {
+	cb.mtx.Lock()
+	defer cb.mtx.Unlock()
+	cb.responses[conformanceKey(path, data)] = resp
+}
+
+// SetError sets the transport error returned for the query with a specific path and data
+func (cb *ConformanceBackend) SetError(path string, data []byte, err error) {
+	cb.mtx.Lock()
+	defer cb.mtx.Unlock()
+	cb.errs[conformanceKey(path, data)] = err
+}
+
+// Calls returns all the ABCI queries received by the backend in order
+func (cb *ConformanceBackend) Calls() []ConformanceCall {
+	cb.mtx.Lock()
+	defer cb.mtx.Unlock()
+	return append([]ConformanceCall(nil), cb.calls...)
+}
+
+// ABCIQueryWithOptions answers the query with the canned response
+func (cb *ConformanceBackend) ABCIQueryWithOptions(path string, data cmn.HexBytes, opts rpcCli.ABCIQueryOptions) (
+	*ctypes.ResultABCIQuery, error) {
+	cb.mtx.Lock()
+	defer cb.mtx.Unlock()
+	cb.calls = append(cb.calls, ConformanceCall{
+		Path:   path,
+		Data:   data,
+		Height: opts.Height,
+		Prove:  opts.Prove,
+	})
+
+	key := conformanceKey(path, data)
+	if err, ok := cb.errs[key]; ok {
+		return nil, err
+	}
+
+	resp, ok := cb.responses[key]
+	if !ok {
+		return &ctypes.ResultABCIQuery{Response: abci.ResponseQuery{
+			Code: uint32(sdk.CodeUnknownRequest),
+			Log:  fmt.Sprintf("unknown query path: %s", path),
+		}}, nil
+	}
+
+	return &ctypes.ResultABCIQuery{Response: resp}, nil
+}
+
+// ABCIQuery answers the query with the canned response by default options
+func (cb *ConformanceBackend) ABCIQuery(path string, data cmn.HexBytes) (*ctypes.ResultABCIQuery, error) {
+	return cb.ABCIQueryWithOptions(path, data, rpcCli.DefaultABCIQueryOptions)
+}
+
+// nolint
+func (*ConformanceBackend) ABCIInfo() (*ctypes.ResultABCIInfo, error) {
+	return nil, errUnsupportedByBackend
+}
+func (*ConformanceBackend) BroadcastTxCommit(tmtypes.Tx) (*ctypes.ResultBroadcastTxCommit, error) {
+	return nil, errUnsupportedByBackend
+}
+func (*ConformanceBackend) BroadcastTxAsync(tmtypes.Tx) (*ctypes.ResultBroadcastTx, error) {
+	return nil, errUnsupportedByBackend
+}
+func (*ConformanceBackend) BroadcastTxSync(tmtypes.Tx) (*ctypes.ResultBroadcastTx, error) {
+	return nil, errUnsupportedByBackend
+}
+func (*ConformanceBackend) Block(*int64) (*ctypes.ResultBlock, error) {
+	return nil, errUnsupportedByBackend
+}
+func (*ConformanceBackend) BlockResults(*int64) (*ctypes.ResultBlockResults, error) {
+	return nil, errUnsupportedByBackend
+}
+func (*ConformanceBackend) Commit(*int64) (*ctypes.ResultCommit, error) {
+	return nil, errUnsupportedByBackend
+}
+func (*ConformanceBackend) Validators(*int64) (*ctypes.ResultValidators, error) {
+	return nil, errUnsupportedByBackend
+}
+func (*ConformanceBackend) Tx([]byte, bool) (*ctypes.ResultTx, error) {
+	return nil, errUnsupportedByBackend
+}
+func (*ConformanceBackend) TxSearch(string, bool, int, int) (*ctypes.ResultTxSearch, error) {
+	return nil, errUnsupportedByBackend
+}
+
+func conformanceKey(path string, data []byte) string {
+	return fmt.Sprintf("%s/%X", path, data)
+}
+
+// RunQueryConformanceSuite runs the conformance test suite that every transport implementation of sdk.ClientQuery
+// (RPC, gRPC, REST, mock) must pass to stay behaviorally identical to each other
+func RunQueryConformanceSuite(t *testing.T, factory QueryTransportFactory) {
+	t.Run("QueryReturnsValue", func(t *testing.T) {
+		cdc, backend := newConformanceCodec(), NewConformanceBackend()
+		path, key, value := "custom/token/info/btc-000", []byte("default key"), []byte("default value")
+		backend.SetResponse(path, key, abci.ResponseQuery{Value: value})
+
+		res, err := factory(cdc, backend).Query(path, key)
+		require.NoError(t, err)
+		require.Equal(t, value, res)
+
+		calls := backend.Calls()
+		require.Equal(t, 1, len(calls))
+		require.Equal(t, path, calls[0].Path)
+		require.Equal(t, cmn.HexBytes(key), calls[0].Data)
+		require.Equal(t, int64(0), calls[0].Height)
+		require.False(t, calls[0].Prove)
+	})
+
+	t.Run("QueryEmptyValue", func(t *testing.T) {
+		cdc, backend := newConformanceCodec(), NewConformanceBackend()
+		path := "/store/acc/key"
+		backend.SetResponse(path, nil, abci.ResponseQuery{})
+
+		res, err := factory(cdc, backend).Query(path, nil)
+		require.NoError(t, err)
+		require.Equal(t, 0, len(res))
+	})
+
+	t.Run("QueryFailedResponse", func(t *testing.T) {
+		cdc, backend := newConformanceCodec(), NewConformanceBackend()
+		path, errLog := "custom/order/detail/ID0000000001-1", "default error log"
+		backend.SetResponse(path, nil, abci.ResponseQuery{Code: uint32(sdk.CodeInternal), Log: errLog})
+
+		_, err := factory(cdc, backend).Query(path, nil)
+		require.Error(t, err)
+		require.True(t, strings.Contains(err.Error(), errLog))
+	})
+
+	t.Run("QueryUnknownPath", func(t *testing.T) {
+		cdc, backend := newConformanceCodec(), NewConformanceBackend()
+		_, err := factory(cdc, backend).Query("custom/unknown/path", nil)
+		require.Error(t, err)
+	})
+
+	t.Run("QueryTransportError", func(t *testing.T) {
+		cdc, backend := newConformanceCodec(), NewConformanceBackend()
+		path := "custom/dex/products"
+		backend.SetError(path, nil, errors.New("default transport error"))
+
+		_, err := factory(cdc, backend).Query(path, nil)
+		require.Error(t, err)
+	})
+
+	t.Run("QueryStore", func(t *testing.T) {
+		cdc, backend := newConformanceCodec(), NewConformanceBackend()
+		key, value := []byte{0x21, 0x01}, []byte("default store value")
+		backend.SetResponse("/store/staking/key", key, abci.ResponseQuery{Value: value})
+
+		res, err := factory(cdc, backend).QueryStore(key, "staking", "key")
+		require.NoError(t, err)
+		require.Equal(t, value, res)
+	})
+
+	t.Run("QuerySubspace", func(t *testing.T) {
+		cdc, backend := newConformanceCodec(), NewConformanceBackend()
+		subspace := []byte{0x21}
+		kvPairs := []cmn.KVPair{
+			{Key: []byte{0x21, 0x01}, Value: []byte("default value 1")},
+			{Key: []byte{0x21, 0x02}, Value: []byte("default value 2")},
+		}
+		backend.SetResponse("/store/staking/subspace", subspace,
+			abci.ResponseQuery{Value: cdc.MustMarshalBinaryLengthPrefixed(kvPairs)})
+
+		res, err := factory(cdc, backend).QuerySubspace(subspace, "staking")
+		require.NoError(t, err)
+		require.Equal(t, kvPairs, res)
+
+		_, err = factory(cdc, backend).QuerySubspace([]byte{0x52}, "staking")
+		require.Error(t, err)
+	})
+}
+
+func newConformanceCodec() sdk.SDKCodec {
+	cdc := sdk.NewCodec()
+	sdk.RegisterBasicCodec(cdc)
+	return cdc
+}
@@ -0,0 +1,78 @@
+package mocks
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	dex "github.com/okex/okchain-go-sdk/module/dex/types"
+	distribution "github.com/okex/okchain-go-sdk/module/distribution/types"
+	governance "github.com/okex/okchain-go-sdk/module/governance/types"
+	order "github.com/okex/okchain-go-sdk/module/order/types"
+	slashing "github.com/okex/okchain-go-sdk/module/slashing/types"
+	staking "github.com/okex/okchain-go-sdk/module/staking/types"
+	token "github.com/okex/okchain-go-sdk/module/token/types"
+	"github.com/okex/okchain-go-sdk/testutil"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+// const of the tx fixtures
+const (
+	FixtureMnemonic = "dumb thought reward exhibit quick manage force imitate blossom vendor ketchup sniff"
+	FixtureAddr     = "okchain1dcsxvxgj374dv3wt9szflf9nz6342juzzkjnlz"
+	FixtureChainID  = "okchain"
+	FixtureGas      = 200000
+	FixtureFees     = "0.01okt"
+
+	// EnvUpdateGolden is the env to set for rewriting the golden files with the current output
+	EnvUpdateGolden = "GOSDK_UPDATE_GOLDEN"
+)
+
+// NewFixtureCodec creates a codec with the msgs of all the modules registered for the tx fixtures
+func NewFixtureCodec() sdk.SDKCodec {
+	cdc := sdk.NewCodec()
+	dex.RegisterCodec(cdc)
+	distribution.RegisterCodec(cdc)
+	governance.RegisterCodec(cdc)
+	order.RegisterCodec(cdc)
+	slashing.RegisterCodec(cdc)
+	staking.RegisterCodec(cdc)
+	token.RegisterCodec(cdc)
+	sdk.RegisterBasicCodec(cdc)
+	cdc.Seal()
+	return cdc
+}
+
+// BuildFixtureStdTx builds a StdTx signed by the fixture key deterministically, which is useful for golden-file tests
+func BuildFixtureStdTx(t *testing.T, msgs []sdk.Msg, memo string, accNum, seqNum uint64) sdk.StdTx {
+	fees, err := sdk.ParseDecCoins(FixtureFees)
+	require.NoError(t, err)
+
+	account, err := testutil.NewAccount("fixture", FixtureMnemonic)
+	require.NoError(t, err)
+	account.AccountNumber = accNum
+	return account.SignStdTx(t, FixtureChainID, msgs, sdk.NewStdFee(FixtureGas, fees), memo, seqNum)
+}
+
+// RequireGoldenJSON asserts that the indented amino JSON of the object equals to the content of the golden file
+// The golden file will be rewritten with the current output if the env GOSDK_UPDATE_GOLDEN is set
+func RequireGoldenJSON(t *testing.T, cdc sdk.SDKCodec, goldenPath string, o interface{}) {
+	rawJSON, err := cdc.MarshalJSON(o)
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	require.NoError(t, json.Indent(&buf, rawJSON, "", "  "))
+	buf.WriteByte('\n')
+
+	if len(os.Getenv(EnvUpdateGolden)) != 0 {
+		require.NoError(t, os.MkdirAll(filepath.Dir(goldenPath), 0755))
+		require.NoError(t, ioutil.WriteFile(goldenPath, buf.Bytes(), 0644))
+	}
+
+	expected, err := ioutil.ReadFile(goldenPath)
+	require.NoError(t, err)
+	require.Equal(t, string(expected), buf.String())
+}
@@ -0,0 +1,60 @@
+package mocks
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	dex "github.com/okex/okchain-go-sdk/module/dex/types"
+	governance "github.com/okex/okchain-go-sdk/module/governance/types"
+	order "github.com/okex/okchain-go-sdk/module/order/types"
+	staking "github.com/okex/okchain-go-sdk/module/staking/types"
+	token "github.com/okex/okchain-go-sdk/module/token/types"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+const recAddr = "okchain1wux20ku36ntgtxpgm7my9863xy3fqs0xgh66d7"
+
+func TestGoldenTxFixtures(t *testing.T) {
+	fromAddr, err := sdk.AccAddressFromBech32(FixtureAddr)
+	require.NoError(t, err)
+	toAddr, err := sdk.AccAddressFromBech32(recAddr)
+	require.NoError(t, err)
+	coins, err := sdk.ParseDecCoins("10.24okt")
+	require.NoError(t, err)
+	coin, err := sdk.ParseDecCoin("10.24okt")
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name string
+		msg  sdk.Msg
+	}{
+		{"token_send", token.NewMsgTokenSend(fromAddr, toAddr, coins)},
+		{"staking_delegate", staking.NewMsgDelegate(fromAddr, coin)},
+		{"governance_vote", governance.NewMsgVote(fromAddr, 1, governance.VoteOptionYes)},
+		{"dex_list", dex.NewMsgList(fromAddr, "btc-000", "okt", sdk.MustNewDecFromStr("10.24"))},
+		{"order_new", order.NewMsgNewOrders(fromAddr,
+			order.BuildOrderItems([]string{"btc-000_okt"}, []string{"BUY"}, []string{"10.24"}, []string{"1.024"}))},
+	}
+
+	cdc := NewFixtureCodec()
+	for _, tc := range testCases {
+		stdTx := BuildFixtureStdTx(t, []sdk.Msg{tc.msg}, "my memo", 1, 2)
+		require.Equal(t, fromAddr, sdk.AccAddress(stdTx.Signatures[0].PubKey.Address()))
+		RequireGoldenJSON(t, cdc, filepath.Join("testdata", tc.name+".golden"), stdTx)
+	}
+}
+
+func TestMockModuleClient(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cli := NewMockModuleClient(ctrl)
+	expectedRet := []staking.Validator{{Jailed: true}}
+	cli.MockStaking.EXPECT().QueryValidators().Return(expectedRet, nil)
+
+	vals, err := cli.Staking().QueryValidators()
+	require.NoError(t, err)
+	require.Equal(t, expectedRet, vals)
+}
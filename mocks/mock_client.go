@@ -5,6 +5,7 @@ import (
 	"fmt"
 	distribution "github.com/okex/okchain-go-sdk/module/distribution/types"
 	governance "github.com/okex/okchain-go-sdk/module/governance/types"
+	mint "github.com/okex/okchain-go-sdk/module/mint/types"
 
 	"github.com/golang/mock/gomock"
 	"github.com/okex/okchain-go-sdk/exposed"
@@ -94,6 +95,9 @@ func (mc *MockClient) Distribution() exposed.Distribution {
 func (mc *MockClient) Governance() exposed.Governance {
 	return mc.modules[governance.ModuleName].(exposed.Governance)
 }
+func (mc *MockClient) Mint() exposed.Mint {
+	return mc.modules[mint.ModuleName].(exposed.Mint)
+}
 func (mc *MockClient) Order() exposed.Order {
 	return mc.modules[order.ModuleName].(exposed.Order)
 }
@@ -555,3 +559,26 @@ func (mc *MockClient) BuildBackendTickersBytes(symbol, product, timestamp, open,
 	require.NoError(mc.t, err)
 	return bytes
 }
+
+// BuildProposalsBytes generates the proposals bytes with the ids and the text contents for test
+func (mc *MockClient) BuildProposalsBytes(status governance.ProposalStatus, proposalIDs ...uint64) []byte {
+	proposals := make([]governance.Proposal, len(proposalIDs))
+	for i, proposalID := range proposalIDs {
+		proposals[i] = governance.Proposal{
+			Content:    governance.NewTextProposal(fmt.Sprintf("proposal %d", proposalID), "text proposal description"),
+			ProposalID: proposalID,
+			Status:     status,
+			FinalTallyResult: governance.TallyResult{
+				Yes:             sdk.ZeroDec(),
+				Abstain:         sdk.ZeroDec(),
+				No:              sdk.ZeroDec(),
+				NoWithVeto:      sdk.ZeroDec(),
+				TotalPower:      sdk.ZeroDec(),
+				TotalVotedPower: sdk.ZeroDec(),
+			},
+			SubmitTime: time.Unix(0, 0).UTC(),
+		}
+	}
+
+	return mc.cdc.MustMarshalJSON(proposals)
+}
@@ -0,0 +1,2220 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/okex/okchain-go-sdk/exposed (interfaces: Auth,Backend,Dex,Distribution,Governance,Mint,Order,Slashing,Staking,Tendermint,Token)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	gomock "github.com/golang/mock/gomock"
+	types "github.com/okex/okchain-go-sdk/module/auth/types"
+	types0 "github.com/okex/okchain-go-sdk/module/backend/types"
+	types1 "github.com/okex/okchain-go-sdk/module/dex/types"
+	types2 "github.com/okex/okchain-go-sdk/module/distribution/types"
+	types3 "github.com/okex/okchain-go-sdk/module/governance/types"
+	types4 "github.com/okex/okchain-go-sdk/module/mint/types"
+	types5 "github.com/okex/okchain-go-sdk/module/order/types"
+	types6 "github.com/okex/okchain-go-sdk/module/slashing/types"
+	types7 "github.com/okex/okchain-go-sdk/module/staking/types"
+	types8 "github.com/okex/okchain-go-sdk/module/tendermint/types"
+	types9 "github.com/okex/okchain-go-sdk/module/token/types"
+	types10 "github.com/okex/okchain-go-sdk/types"
+	keys "github.com/okex/okchain-go-sdk/types/crypto/keys"
+	reflect "reflect"
+)
+
+// MockAuth is a mock of Auth interface
+type MockAuth struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuthMockRecorder
+}
+
+// MockAuthMockRecorder is the mock recorder for MockAuth
+type MockAuthMockRecorder struct {
+	mock *MockAuth
+}
+
+// NewMockAuth creates a new mock instance
+func NewMockAuth(ctrl *gomock.Controller) *MockAuth {
+	mock := &MockAuth{ctrl: ctrl}
+	mock.recorder = &MockAuthMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockAuth) EXPECT() *MockAuthMockRecorder {
+	return m.recorder
+}
+
+// Name mocks base method
+func (m *MockAuth) Name() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Name")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Name indicates an expected call of Name
+func (mr *MockAuthMockRecorder) Name() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Name", reflect.TypeOf((*MockAuth)(nil).Name))
+}
+
+// QueryAccount mocks base method
+func (m *MockAuth) QueryAccount(arg0 string) (types.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryAccount", arg0)
+	ret0, _ := ret[0].(types.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryAccount indicates an expected call of QueryAccount
+func (mr *MockAuthMockRecorder) QueryAccount(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryAccount", reflect.TypeOf((*MockAuth)(nil).QueryAccount), arg0)
+}
+
+// RegisterCodec mocks base method
+func (m *MockAuth) RegisterCodec(arg0 types10.SDKCodec) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RegisterCodec", arg0)
+}
+
+// RegisterCodec indicates an expected call of RegisterCodec
+func (mr *MockAuthMockRecorder) RegisterCodec(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterCodec", reflect.TypeOf((*MockAuth)(nil).RegisterCodec), arg0)
+}
+
+// WatchBalances mocks base method
+func (m *MockAuth) WatchBalances(arg0 context.Context, arg1 []string) (<-chan types.BalanceChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchBalances", arg0, arg1)
+	ret0, _ := ret[0].(<-chan types.BalanceChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchBalances indicates an expected call of WatchBalances
+func (mr *MockAuthMockRecorder) WatchBalances(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchBalances", reflect.TypeOf((*MockAuth)(nil).WatchBalances), arg0, arg1)
+}
+
+// MockBackend is a mock of Backend interface
+type MockBackend struct {
+	ctrl     *gomock.Controller
+	recorder *MockBackendMockRecorder
+}
+
+// MockBackendMockRecorder is the mock recorder for MockBackend
+type MockBackendMockRecorder struct {
+	mock *MockBackend
+}
+
+// NewMockBackend creates a new mock instance
+func NewMockBackend(ctrl *gomock.Controller) *MockBackend {
+	mock := &MockBackend{ctrl: ctrl}
+	mock.recorder = &MockBackendMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockBackend) EXPECT() *MockBackendMockRecorder {
+	return m.recorder
+}
+
+// Name mocks base method
+func (m *MockBackend) Name() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Name")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Name indicates an expected call of Name
+func (mr *MockBackendMockRecorder) Name() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Name", reflect.TypeOf((*MockBackend)(nil).Name))
+}
+
+// QueryCandles mocks base method
+func (m *MockBackend) QueryCandles(arg0 string, arg1, arg2 int) ([][]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryCandles", arg0, arg1, arg2)
+	ret0, _ := ret[0].([][]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryCandles indicates an expected call of QueryCandles
+func (mr *MockBackendMockRecorder) QueryCandles(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryCandles", reflect.TypeOf((*MockBackend)(nil).QueryCandles), arg0, arg1, arg2)
+}
+
+// QueryClosedOrders mocks base method
+func (m *MockBackend) QueryClosedOrders(arg0, arg1, arg2 string, arg3, arg4, arg5, arg6 int) ([]types0.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryClosedOrders", arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+	ret0, _ := ret[0].([]types0.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryClosedOrders indicates an expected call of QueryClosedOrders
+func (mr *MockBackendMockRecorder) QueryClosedOrders(arg0, arg1, arg2, arg3, arg4, arg5, arg6 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryClosedOrders", reflect.TypeOf((*MockBackend)(nil).QueryClosedOrders), arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+}
+
+// QueryClosedOrdersPaged mocks base method
+func (m *MockBackend) QueryClosedOrdersPaged(arg0, arg1, arg2 string, arg3, arg4 int, arg5 types10.PageRequest) ([]types0.Order, types10.PagedResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryClosedOrdersPaged", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].([]types0.Order)
+	ret1, _ := ret[1].(types10.PagedResult)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// QueryClosedOrdersPaged indicates an expected call of QueryClosedOrdersPaged
+func (mr *MockBackendMockRecorder) QueryClosedOrdersPaged(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryClosedOrdersPaged", reflect.TypeOf((*MockBackend)(nil).QueryClosedOrdersPaged), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// QueryDeals mocks base method
+func (m *MockBackend) QueryDeals(arg0, arg1, arg2 string, arg3, arg4, arg5, arg6 int) ([]types0.Deal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryDeals", arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+	ret0, _ := ret[0].([]types0.Deal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryDeals indicates an expected call of QueryDeals
+func (mr *MockBackendMockRecorder) QueryDeals(arg0, arg1, arg2, arg3, arg4, arg5, arg6 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryDeals", reflect.TypeOf((*MockBackend)(nil).QueryDeals), arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+}
+
+// QueryOpenOrders mocks base method
+func (m *MockBackend) QueryOpenOrders(arg0, arg1, arg2 string, arg3, arg4, arg5, arg6 int) ([]types0.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryOpenOrders", arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+	ret0, _ := ret[0].([]types0.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryOpenOrders indicates an expected call of QueryOpenOrders
+func (mr *MockBackendMockRecorder) QueryOpenOrders(arg0, arg1, arg2, arg3, arg4, arg5, arg6 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryOpenOrders", reflect.TypeOf((*MockBackend)(nil).QueryOpenOrders), arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+}
+
+// QueryOpenOrdersPaged mocks base method
+func (m *MockBackend) QueryOpenOrdersPaged(arg0, arg1, arg2 string, arg3, arg4 int, arg5 types10.PageRequest) ([]types0.Order, types10.PagedResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryOpenOrdersPaged", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].([]types0.Order)
+	ret1, _ := ret[1].(types10.PagedResult)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// QueryOpenOrdersPaged indicates an expected call of QueryOpenOrdersPaged
+func (mr *MockBackendMockRecorder) QueryOpenOrdersPaged(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryOpenOrdersPaged", reflect.TypeOf((*MockBackend)(nil).QueryOpenOrdersPaged), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// QueryRecentTxRecord mocks base method
+func (m *MockBackend) QueryRecentTxRecord(arg0 string, arg1, arg2, arg3, arg4 int) ([]types0.MatchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryRecentTxRecord", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]types0.MatchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryRecentTxRecord indicates an expected call of QueryRecentTxRecord
+func (mr *MockBackendMockRecorder) QueryRecentTxRecord(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryRecentTxRecord", reflect.TypeOf((*MockBackend)(nil).QueryRecentTxRecord), arg0, arg1, arg2, arg3, arg4)
+}
+
+// QueryTickers mocks base method
+func (m *MockBackend) QueryTickers(arg0 string, arg1 ...int) ([]types0.Ticker, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "QueryTickers", varargs...)
+	ret0, _ := ret[0].([]types0.Ticker)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryTickers indicates an expected call of QueryTickers
+func (mr *MockBackendMockRecorder) QueryTickers(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryTickers", reflect.TypeOf((*MockBackend)(nil).QueryTickers), varargs...)
+}
+
+// QueryTransactions mocks base method
+func (m *MockBackend) QueryTransactions(arg0 string, arg1, arg2, arg3, arg4, arg5 int) ([]types0.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryTransactions", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].([]types0.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryTransactions indicates an expected call of QueryTransactions
+func (mr *MockBackendMockRecorder) QueryTransactions(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryTransactions", reflect.TypeOf((*MockBackend)(nil).QueryTransactions), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// RegisterCodec mocks base method
+func (m *MockBackend) RegisterCodec(arg0 types10.SDKCodec) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RegisterCodec", arg0)
+}
+
+// RegisterCodec indicates an expected call of RegisterCodec
+func (mr *MockBackendMockRecorder) RegisterCodec(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterCodec", reflect.TypeOf((*MockBackend)(nil).RegisterCodec), arg0)
+}
+
+// MockDex is a mock of Dex interface
+type MockDex struct {
+	ctrl     *gomock.Controller
+	recorder *MockDexMockRecorder
+}
+
+// MockDexMockRecorder is the mock recorder for MockDex
+type MockDexMockRecorder struct {
+	mock *MockDex
+}
+
+// NewMockDex creates a new mock instance
+func NewMockDex(ctrl *gomock.Controller) *MockDex {
+	mock := &MockDex{ctrl: ctrl}
+	mock.recorder = &MockDexMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockDex) EXPECT() *MockDexMockRecorder {
+	return m.recorder
+}
+
+// Deposit mocks base method
+func (m *MockDex) Deposit(arg0 keys.Info, arg1, arg2 string, arg3 types10.Amount, arg4 string, arg5, arg6 uint64) (types10.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Deposit", arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+	ret0, _ := ret[0].(types10.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Deposit indicates an expected call of Deposit
+func (mr *MockDexMockRecorder) Deposit(arg0, arg1, arg2, arg3, arg4, arg5, arg6 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deposit", reflect.TypeOf((*MockDex)(nil).Deposit), arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+}
+
+// GenerateUnsignedTransferOwnershipTx mocks base method
+func (m *MockDex) GenerateUnsignedTransferOwnershipTx(arg0, arg1, arg2, arg3, arg4 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateUnsignedTransferOwnershipTx", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GenerateUnsignedTransferOwnershipTx indicates an expected call of GenerateUnsignedTransferOwnershipTx
+func (mr *MockDexMockRecorder) GenerateUnsignedTransferOwnershipTx(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateUnsignedTransferOwnershipTx", reflect.TypeOf((*MockDex)(nil).GenerateUnsignedTransferOwnershipTx), arg0, arg1, arg2, arg3, arg4)
+}
+
+// List mocks base method
+func (m *MockDex) List(arg0 keys.Info, arg1, arg2, arg3, arg4, arg5 string, arg6, arg7 uint64) (types10.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7)
+	ret0, _ := ret[0].(types10.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List
+func (mr *MockDexMockRecorder) List(arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockDex)(nil).List), arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7)
+}
+
+// MultiSign mocks base method
+func (m *MockDex) MultiSign(arg0 keys.Info, arg1, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MultiSign", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MultiSign indicates an expected call of MultiSign
+func (mr *MockDexMockRecorder) MultiSign(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MultiSign", reflect.TypeOf((*MockDex)(nil).MultiSign), arg0, arg1, arg2, arg3)
+}
+
+// Name mocks base method
+func (m *MockDex) Name() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Name")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Name indicates an expected call of Name
+func (mr *MockDexMockRecorder) Name() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Name", reflect.TypeOf((*MockDex)(nil).Name))
+}
+
+// QueryDexParams mocks base method
+func (m *MockDex) QueryDexParams() (types1.Params, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryDexParams")
+	ret0, _ := ret[0].(types1.Params)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryDexParams indicates an expected call of QueryDexParams
+func (mr *MockDexMockRecorder) QueryDexParams() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryDexParams", reflect.TypeOf((*MockDex)(nil).QueryDexParams))
+}
+
+// QueryProduct mocks base method
+func (m *MockDex) QueryProduct(arg0 string) (types1.TokenPair, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryProduct", arg0)
+	ret0, _ := ret[0].(types1.TokenPair)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryProduct indicates an expected call of QueryProduct
+func (mr *MockDexMockRecorder) QueryProduct(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryProduct", reflect.TypeOf((*MockDex)(nil).QueryProduct), arg0)
+}
+
+// QueryProducts mocks base method
+func (m *MockDex) QueryProducts(arg0 string, arg1, arg2 int) ([]types1.TokenPair, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryProducts", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]types1.TokenPair)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryProducts indicates an expected call of QueryProducts
+func (mr *MockDexMockRecorder) QueryProducts(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryProducts", reflect.TypeOf((*MockDex)(nil).QueryProducts), arg0, arg1, arg2)
+}
+
+// QueryProductsPaged mocks base method
+func (m *MockDex) QueryProductsPaged(arg0 string, arg1 types10.PageRequest) ([]types1.TokenPair, types10.PagedResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryProductsPaged", arg0, arg1)
+	ret0, _ := ret[0].([]types1.TokenPair)
+	ret1, _ := ret[1].(types10.PagedResult)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// QueryProductsPaged indicates an expected call of QueryProductsPaged
+func (mr *MockDexMockRecorder) QueryProductsPaged(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryProductsPaged", reflect.TypeOf((*MockDex)(nil).QueryProductsPaged), arg0, arg1)
+}
+
+// RegisterCodec mocks base method
+func (m *MockDex) RegisterCodec(arg0 types10.SDKCodec) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RegisterCodec", arg0)
+}
+
+// RegisterCodec indicates an expected call of RegisterCodec
+func (mr *MockDexMockRecorder) RegisterCodec(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterCodec", reflect.TypeOf((*MockDex)(nil).RegisterCodec), arg0)
+}
+
+// TransferOwnership mocks base method
+func (m *MockDex) TransferOwnership(arg0 keys.Info, arg1, arg2 string, arg3, arg4 uint64) (types10.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferOwnership", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(types10.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransferOwnership indicates an expected call of TransferOwnership
+func (mr *MockDexMockRecorder) TransferOwnership(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferOwnership", reflect.TypeOf((*MockDex)(nil).TransferOwnership), arg0, arg1, arg2, arg3, arg4)
+}
+
+// Withdraw mocks base method
+func (m *MockDex) Withdraw(arg0 keys.Info, arg1, arg2 string, arg3 types10.Amount, arg4 string, arg5, arg6 uint64) (types10.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Withdraw", arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+	ret0, _ := ret[0].(types10.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Withdraw indicates an expected call of Withdraw
+func (mr *MockDexMockRecorder) Withdraw(arg0, arg1, arg2, arg3, arg4, arg5, arg6 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Withdraw", reflect.TypeOf((*MockDex)(nil).Withdraw), arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+}
+
+// MockDistribution is a mock of Distribution interface
+type MockDistribution struct {
+	ctrl     *gomock.Controller
+	recorder *MockDistributionMockRecorder
+}
+
+// MockDistributionMockRecorder is the mock recorder for MockDistribution
+type MockDistributionMockRecorder struct {
+	mock *MockDistribution
+}
+
+// NewMockDistribution creates a new mock instance
+func NewMockDistribution(ctrl *gomock.Controller) *MockDistribution {
+	mock := &MockDistribution{ctrl: ctrl}
+	mock.recorder = &MockDistributionMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockDistribution) EXPECT() *MockDistributionMockRecorder {
+	return m.recorder
+}
+
+// Name mocks base method
+func (m *MockDistribution) Name() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Name")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Name indicates an expected call of Name
+func (mr *MockDistributionMockRecorder) Name() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Name", reflect.TypeOf((*MockDistribution)(nil).Name))
+}
+
+// QueryCommunityTax mocks base method
+func (m *MockDistribution) QueryCommunityTax() (types10.Dec, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryCommunityTax")
+	ret0, _ := ret[0].(types10.Dec)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryCommunityTax indicates an expected call of QueryCommunityTax
+func (mr *MockDistributionMockRecorder) QueryCommunityTax() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryCommunityTax", reflect.TypeOf((*MockDistribution)(nil).QueryCommunityTax))
+}
+
+// QueryDistrParams mocks base method
+func (m *MockDistribution) QueryDistrParams() (types2.Params, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryDistrParams")
+	ret0, _ := ret[0].(types2.Params)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryDistrParams indicates an expected call of QueryDistrParams
+func (mr *MockDistributionMockRecorder) QueryDistrParams() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryDistrParams", reflect.TypeOf((*MockDistribution)(nil).QueryDistrParams))
+}
+
+// RegisterCodec mocks base method
+func (m *MockDistribution) RegisterCodec(arg0 types10.SDKCodec) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RegisterCodec", arg0)
+}
+
+// RegisterCodec indicates an expected call of RegisterCodec
+func (mr *MockDistributionMockRecorder) RegisterCodec(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterCodec", reflect.TypeOf((*MockDistribution)(nil).RegisterCodec), arg0)
+}
+
+// SetWithdrawAddr mocks base method
+func (m *MockDistribution) SetWithdrawAddr(arg0 keys.Info, arg1, arg2, arg3 string, arg4, arg5 uint64) (types10.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetWithdrawAddr", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(types10.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetWithdrawAddr indicates an expected call of SetWithdrawAddr
+func (mr *MockDistributionMockRecorder) SetWithdrawAddr(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetWithdrawAddr", reflect.TypeOf((*MockDistribution)(nil).SetWithdrawAddr), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// WithdrawRewards mocks base method
+func (m *MockDistribution) WithdrawRewards(arg0 keys.Info, arg1, arg2, arg3 string, arg4, arg5 uint64) (types10.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithdrawRewards", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(types10.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WithdrawRewards indicates an expected call of WithdrawRewards
+func (mr *MockDistributionMockRecorder) WithdrawRewards(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithdrawRewards", reflect.TypeOf((*MockDistribution)(nil).WithdrawRewards), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// MockGovernance is a mock of Governance interface
+type MockGovernance struct {
+	ctrl     *gomock.Controller
+	recorder *MockGovernanceMockRecorder
+}
+
+// MockGovernanceMockRecorder is the mock recorder for MockGovernance
+type MockGovernanceMockRecorder struct {
+	mock *MockGovernance
+}
+
+// NewMockGovernance creates a new mock instance
+func NewMockGovernance(ctrl *gomock.Controller) *MockGovernance {
+	mock := &MockGovernance{ctrl: ctrl}
+	mock.recorder = &MockGovernanceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockGovernance) EXPECT() *MockGovernanceMockRecorder {
+	return m.recorder
+}
+
+// CheckDelistProposal mocks base method
+func (m *MockGovernance) CheckDelistProposal(arg0 types3.DelistProposalJSON) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckDelistProposal", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckDelistProposal indicates an expected call of CheckDelistProposal
+func (mr *MockGovernanceMockRecorder) CheckDelistProposal(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckDelistProposal", reflect.TypeOf((*MockGovernance)(nil).CheckDelistProposal), arg0)
+}
+
+// CompleteProposal mocks base method
+func (m *MockGovernance) CompleteProposal(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteProposal", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompleteProposal indicates an expected call of CompleteProposal
+func (mr *MockGovernanceMockRecorder) CompleteProposal(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteProposal", reflect.TypeOf((*MockGovernance)(nil).CompleteProposal), arg0)
+}
+
+// Deposit mocks base method
+func (m *MockGovernance) Deposit(arg0 keys.Info, arg1 string, arg2 types10.Amount, arg3 string, arg4, arg5, arg6 uint64) (types10.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Deposit", arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+	ret0, _ := ret[0].(types10.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Deposit indicates an expected call of Deposit
+func (mr *MockGovernanceMockRecorder) Deposit(arg0, arg1, arg2, arg3, arg4, arg5, arg6 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deposit", reflect.TypeOf((*MockGovernance)(nil).Deposit), arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+}
+
+// Name mocks base method
+func (m *MockGovernance) Name() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Name")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Name indicates an expected call of Name
+func (mr *MockGovernanceMockRecorder) Name() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Name", reflect.TypeOf((*MockGovernance)(nil).Name))
+}
+
+// PreviewTally mocks base method
+func (m *MockGovernance) PreviewTally(arg0 uint64) (types3.TallyPreview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PreviewTally", arg0)
+	ret0, _ := ret[0].(types3.TallyPreview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PreviewTally indicates an expected call of PreviewTally
+func (mr *MockGovernanceMockRecorder) PreviewTally(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewTally", reflect.TypeOf((*MockGovernance)(nil).PreviewTally), arg0)
+}
+
+// QueryDepositParams mocks base method
+func (m *MockGovernance) QueryDepositParams() (types3.DepositParams, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryDepositParams")
+	ret0, _ := ret[0].(types3.DepositParams)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryDepositParams indicates an expected call of QueryDepositParams
+func (mr *MockGovernanceMockRecorder) QueryDepositParams() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryDepositParams", reflect.TypeOf((*MockGovernance)(nil).QueryDepositParams))
+}
+
+// QueryGovParams mocks base method
+func (m *MockGovernance) QueryGovParams() (types3.Params, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryGovParams")
+	ret0, _ := ret[0].(types3.Params)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryGovParams indicates an expected call of QueryGovParams
+func (mr *MockGovernanceMockRecorder) QueryGovParams() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryGovParams", reflect.TypeOf((*MockGovernance)(nil).QueryGovParams))
+}
+
+// QueryProposals mocks base method
+func (m *MockGovernance) QueryProposals(arg0 types3.ProposalStatus, arg1 types10.PageRequest) ([]types3.Proposal, types10.PagedResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryProposals", arg0, arg1)
+	ret0, _ := ret[0].([]types3.Proposal)
+	ret1, _ := ret[1].(types10.PagedResult)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// QueryProposals indicates an expected call of QueryProposals
+func (mr *MockGovernanceMockRecorder) QueryProposals(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryProposals", reflect.TypeOf((*MockGovernance)(nil).QueryProposals), arg0, arg1)
+}
+
+// QueryTally mocks base method
+func (m *MockGovernance) QueryTally(arg0 uint64) (types3.TallyResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryTally", arg0)
+	ret0, _ := ret[0].(types3.TallyResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryTally indicates an expected call of QueryTally
+func (mr *MockGovernanceMockRecorder) QueryTally(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryTally", reflect.TypeOf((*MockGovernance)(nil).QueryTally), arg0)
+}
+
+// QueryTallyParams mocks base method
+func (m *MockGovernance) QueryTallyParams() (types3.TallyParams, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryTallyParams")
+	ret0, _ := ret[0].(types3.TallyParams)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryTallyParams indicates an expected call of QueryTallyParams
+func (mr *MockGovernanceMockRecorder) QueryTallyParams() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryTallyParams", reflect.TypeOf((*MockGovernance)(nil).QueryTallyParams))
+}
+
+// QueryUpgradeAppliedHeight mocks base method
+func (m *MockGovernance) QueryUpgradeAppliedHeight(arg0 string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryUpgradeAppliedHeight", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryUpgradeAppliedHeight indicates an expected call of QueryUpgradeAppliedHeight
+func (mr *MockGovernanceMockRecorder) QueryUpgradeAppliedHeight(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryUpgradeAppliedHeight", reflect.TypeOf((*MockGovernance)(nil).QueryUpgradeAppliedHeight), arg0)
+}
+
+// QueryUpgradePlan mocks base method
+func (m *MockGovernance) QueryUpgradePlan() (*types3.Plan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryUpgradePlan")
+	ret0, _ := ret[0].(*types3.Plan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryUpgradePlan indicates an expected call of QueryUpgradePlan
+func (mr *MockGovernanceMockRecorder) QueryUpgradePlan() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryUpgradePlan", reflect.TypeOf((*MockGovernance)(nil).QueryUpgradePlan))
+}
+
+// QueryVotingParams mocks base method
+func (m *MockGovernance) QueryVotingParams() (types3.VotingParams, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryVotingParams")
+	ret0, _ := ret[0].(types3.VotingParams)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryVotingParams indicates an expected call of QueryVotingParams
+func (mr *MockGovernanceMockRecorder) QueryVotingParams() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryVotingParams", reflect.TypeOf((*MockGovernance)(nil).QueryVotingParams))
+}
+
+// RegisterCodec mocks base method
+func (m *MockGovernance) RegisterCodec(arg0 types10.SDKCodec) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RegisterCodec", arg0)
+}
+
+// RegisterCodec indicates an expected call of RegisterCodec
+func (mr *MockGovernanceMockRecorder) RegisterCodec(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterCodec", reflect.TypeOf((*MockGovernance)(nil).RegisterCodec), arg0)
+}
+
+// RenderProposal mocks base method
+func (m *MockGovernance) RenderProposal(arg0 string, arg1, arg2 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenderProposal", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RenderProposal indicates an expected call of RenderProposal
+func (mr *MockGovernanceMockRecorder) RenderProposal(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenderProposal", reflect.TypeOf((*MockGovernance)(nil).RenderProposal), arg0, arg1, arg2)
+}
+
+// RenderProposalJSON mocks base method
+func (m *MockGovernance) RenderProposalJSON(arg0 interface{}) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenderProposalJSON", arg0)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenderProposalJSON indicates an expected call of RenderProposalJSON
+func (mr *MockGovernanceMockRecorder) RenderProposalJSON(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenderProposalJSON", reflect.TypeOf((*MockGovernance)(nil).RenderProposalJSON), arg0)
+}
+
+// SubmitCancelSoftwareUpgradeProposal mocks base method
+func (m *MockGovernance) SubmitCancelSoftwareUpgradeProposal(arg0 keys.Info, arg1, arg2, arg3 string, arg4, arg5 uint64) (types10.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitCancelSoftwareUpgradeProposal", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(types10.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubmitCancelSoftwareUpgradeProposal indicates an expected call of SubmitCancelSoftwareUpgradeProposal
+func (mr *MockGovernanceMockRecorder) SubmitCancelSoftwareUpgradeProposal(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitCancelSoftwareUpgradeProposal", reflect.TypeOf((*MockGovernance)(nil).SubmitCancelSoftwareUpgradeProposal), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// SubmitCancelSoftwareUpgradeProposalFromStruct mocks base method
+func (m *MockGovernance) SubmitCancelSoftwareUpgradeProposalFromStruct(arg0 keys.Info, arg1 string, arg2 types3.CancelSoftwareUpgradeProposalJSON, arg3 string, arg4, arg5 uint64) (types10.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitCancelSoftwareUpgradeProposalFromStruct", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(types10.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubmitCancelSoftwareUpgradeProposalFromStruct indicates an expected call of SubmitCancelSoftwareUpgradeProposalFromStruct
+func (mr *MockGovernanceMockRecorder) SubmitCancelSoftwareUpgradeProposalFromStruct(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitCancelSoftwareUpgradeProposalFromStruct", reflect.TypeOf((*MockGovernance)(nil).SubmitCancelSoftwareUpgradeProposalFromStruct), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// SubmitCommunityPoolSpendProposal mocks base method
+func (m *MockGovernance) SubmitCommunityPoolSpendProposal(arg0 keys.Info, arg1, arg2, arg3 string, arg4, arg5 uint64) (types10.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitCommunityPoolSpendProposal", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(types10.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubmitCommunityPoolSpendProposal indicates an expected call of SubmitCommunityPoolSpendProposal
+func (mr *MockGovernanceMockRecorder) SubmitCommunityPoolSpendProposal(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitCommunityPoolSpendProposal", reflect.TypeOf((*MockGovernance)(nil).SubmitCommunityPoolSpendProposal), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// SubmitCommunityPoolSpendProposalFromStruct mocks base method
+func (m *MockGovernance) SubmitCommunityPoolSpendProposalFromStruct(arg0 keys.Info, arg1 string, arg2 types3.CommunityPoolSpendProposalJSON, arg3 string, arg4, arg5 uint64) (types10.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitCommunityPoolSpendProposalFromStruct", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(types10.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubmitCommunityPoolSpendProposalFromStruct indicates an expected call of SubmitCommunityPoolSpendProposalFromStruct
+func (mr *MockGovernanceMockRecorder) SubmitCommunityPoolSpendProposalFromStruct(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitCommunityPoolSpendProposalFromStruct", reflect.TypeOf((*MockGovernance)(nil).SubmitCommunityPoolSpendProposalFromStruct), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// SubmitDelistProposal mocks base method
+func (m *MockGovernance) SubmitDelistProposal(arg0 keys.Info, arg1, arg2, arg3 string, arg4, arg5 uint64) (types10.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitDelistProposal", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(types10.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubmitDelistProposal indicates an expected call of SubmitDelistProposal
+func (mr *MockGovernanceMockRecorder) SubmitDelistProposal(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitDelistProposal", reflect.TypeOf((*MockGovernance)(nil).SubmitDelistProposal), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// SubmitDelistProposalFromStruct mocks base method
+func (m *MockGovernance) SubmitDelistProposalFromStruct(arg0 keys.Info, arg1 string, arg2 types3.DelistProposalJSON, arg3 string, arg4, arg5 uint64) (types10.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitDelistProposalFromStruct", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(types10.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubmitDelistProposalFromStruct indicates an expected call of SubmitDelistProposalFromStruct
+func (mr *MockGovernanceMockRecorder) SubmitDelistProposalFromStruct(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitDelistProposalFromStruct", reflect.TypeOf((*MockGovernance)(nil).SubmitDelistProposalFromStruct), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// SubmitDexParamsProposalFromStruct mocks base method
+func (m *MockGovernance) SubmitDexParamsProposalFromStruct(arg0 keys.Info, arg1 string, arg2 types3.DexParamsProposalJSON, arg3 string, arg4, arg5 uint64) (types10.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitDexParamsProposalFromStruct", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(types10.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubmitDexParamsProposalFromStruct indicates an expected call of SubmitDexParamsProposalFromStruct
+func (mr *MockGovernanceMockRecorder) SubmitDexParamsProposalFromStruct(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitDexParamsProposalFromStruct", reflect.TypeOf((*MockGovernance)(nil).SubmitDexParamsProposalFromStruct), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// SubmitManageWhiteListProposal mocks base method
+func (m *MockGovernance) SubmitManageWhiteListProposal(arg0 keys.Info, arg1, arg2, arg3 string, arg4, arg5 uint64) (types10.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitManageWhiteListProposal", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(types10.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubmitManageWhiteListProposal indicates an expected call of SubmitManageWhiteListProposal
+func (mr *MockGovernanceMockRecorder) SubmitManageWhiteListProposal(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitManageWhiteListProposal", reflect.TypeOf((*MockGovernance)(nil).SubmitManageWhiteListProposal), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// SubmitManageWhiteListProposalFromStruct mocks base method
+func (m *MockGovernance) SubmitManageWhiteListProposalFromStruct(arg0 keys.Info, arg1 string, arg2 types3.ManageWhiteListProposalJSON, arg3 string, arg4, arg5 uint64) (types10.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitManageWhiteListProposalFromStruct", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(types10.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubmitManageWhiteListProposalFromStruct indicates an expected call of SubmitManageWhiteListProposalFromStruct
+func (mr *MockGovernanceMockRecorder) SubmitManageWhiteListProposalFromStruct(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitManageWhiteListProposalFromStruct", reflect.TypeOf((*MockGovernance)(nil).SubmitManageWhiteListProposalFromStruct), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// SubmitParamChangeProposal mocks base method
+func (m *MockGovernance) SubmitParamChangeProposal(arg0 keys.Info, arg1, arg2, arg3 string, arg4, arg5 uint64) (types10.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitParamChangeProposal", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(types10.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubmitParamChangeProposal indicates an expected call of SubmitParamChangeProposal
+func (mr *MockGovernanceMockRecorder) SubmitParamChangeProposal(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitParamChangeProposal", reflect.TypeOf((*MockGovernance)(nil).SubmitParamChangeProposal), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// SubmitParamChangeProposalFromStruct mocks base method
+func (m *MockGovernance) SubmitParamChangeProposalFromStruct(arg0 keys.Info, arg1 string, arg2 types3.ParamChangeProposalJSON, arg3 string, arg4, arg5 uint64) (types10.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitParamChangeProposalFromStruct", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(types10.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubmitParamChangeProposalFromStruct indicates an expected call of SubmitParamChangeProposalFromStruct
+func (mr *MockGovernanceMockRecorder) SubmitParamChangeProposalFromStruct(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitParamChangeProposalFromStruct", reflect.TypeOf((*MockGovernance)(nil).SubmitParamChangeProposalFromStruct), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// SubmitSoftwareUpgradeProposal mocks base method
+func (m *MockGovernance) SubmitSoftwareUpgradeProposal(arg0 keys.Info, arg1, arg2, arg3 string, arg4, arg5 uint64) (types10.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitSoftwareUpgradeProposal", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(types10.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubmitSoftwareUpgradeProposal indicates an expected call of SubmitSoftwareUpgradeProposal
+func (mr *MockGovernanceMockRecorder) SubmitSoftwareUpgradeProposal(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitSoftwareUpgradeProposal", reflect.TypeOf((*MockGovernance)(nil).SubmitSoftwareUpgradeProposal), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// SubmitSoftwareUpgradeProposalFromStruct mocks base method
+func (m *MockGovernance) SubmitSoftwareUpgradeProposalFromStruct(arg0 keys.Info, arg1 string, arg2 types3.SoftwareUpgradeProposalJSON, arg3 string, arg4, arg5 uint64) (types10.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitSoftwareUpgradeProposalFromStruct", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(types10.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubmitSoftwareUpgradeProposalFromStruct indicates an expected call of SubmitSoftwareUpgradeProposalFromStruct
+func (mr *MockGovernanceMockRecorder) SubmitSoftwareUpgradeProposalFromStruct(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitSoftwareUpgradeProposalFromStruct", reflect.TypeOf((*MockGovernance)(nil).SubmitSoftwareUpgradeProposalFromStruct), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// SubmitTextProposal mocks base method
+func (m *MockGovernance) SubmitTextProposal(arg0 keys.Info, arg1, arg2, arg3 string, arg4, arg5 uint64) (types10.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitTextProposal", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(types10.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubmitTextProposal indicates an expected call of SubmitTextProposal
+func (mr *MockGovernanceMockRecorder) SubmitTextProposal(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitTextProposal", reflect.TypeOf((*MockGovernance)(nil).SubmitTextProposal), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// SubmitTextProposalFromStruct mocks base method
+func (m *MockGovernance) SubmitTextProposalFromStruct(arg0 keys.Info, arg1 string, arg2 types3.ProposalJSON, arg3 string, arg4, arg5 uint64) (types10.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitTextProposalFromStruct", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(types10.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubmitTextProposalFromStruct indicates an expected call of SubmitTextProposalFromStruct
+func (mr *MockGovernanceMockRecorder) SubmitTextProposalFromStruct(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitTextProposalFromStruct", reflect.TypeOf((*MockGovernance)(nil).SubmitTextProposalFromStruct), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// Vote mocks base method
+func (m *MockGovernance) Vote(arg0 keys.Info, arg1 string, arg2 types3.VoteOption, arg3 string, arg4, arg5, arg6 uint64) (types10.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Vote", arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+	ret0, _ := ret[0].(types10.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Vote indicates an expected call of Vote
+func (mr *MockGovernanceMockRecorder) Vote(arg0, arg1, arg2, arg3, arg4, arg5, arg6 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Vote", reflect.TypeOf((*MockGovernance)(nil).Vote), arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+}
+
+// MockMint is a mock of Mint interface
+type MockMint struct {
+	ctrl     *gomock.Controller
+	recorder *MockMintMockRecorder
+}
+
+// MockMintMockRecorder is the mock recorder for MockMint
+type MockMintMockRecorder struct {
+	mock *MockMint
+}
+
+// NewMockMint creates a new mock instance
+func NewMockMint(ctrl *gomock.Controller) *MockMint {
+	mock := &MockMint{ctrl: ctrl}
+	mock.recorder = &MockMintMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockMint) EXPECT() *MockMintMockRecorder {
+	return m.recorder
+}
+
+// Name mocks base method
+func (m *MockMint) Name() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Name")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Name indicates an expected call of Name
+func (mr *MockMintMockRecorder) Name() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Name", reflect.TypeOf((*MockMint)(nil).Name))
+}
+
+// QueryAnnualProvisions mocks base method
+func (m *MockMint) QueryAnnualProvisions() (types10.Dec, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryAnnualProvisions")
+	ret0, _ := ret[0].(types10.Dec)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryAnnualProvisions indicates an expected call of QueryAnnualProvisions
+func (mr *MockMintMockRecorder) QueryAnnualProvisions() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryAnnualProvisions", reflect.TypeOf((*MockMint)(nil).QueryAnnualProvisions))
+}
+
+// QueryInflation mocks base method
+func (m *MockMint) QueryInflation() (types10.Dec, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryInflation")
+	ret0, _ := ret[0].(types10.Dec)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryInflation indicates an expected call of QueryInflation
+func (mr *MockMintMockRecorder) QueryInflation() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryInflation", reflect.TypeOf((*MockMint)(nil).QueryInflation))
+}
+
+// QueryMintParams mocks base method
+func (m *MockMint) QueryMintParams() (types4.Params, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryMintParams")
+	ret0, _ := ret[0].(types4.Params)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryMintParams indicates an expected call of QueryMintParams
+func (mr *MockMintMockRecorder) QueryMintParams() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryMintParams", reflect.TypeOf((*MockMint)(nil).QueryMintParams))
+}
+
+// RegisterCodec mocks base method
+func (m *MockMint) RegisterCodec(arg0 types10.SDKCodec) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RegisterCodec", arg0)
+}
+
+// RegisterCodec indicates an expected call of RegisterCodec
+func (mr *MockMintMockRecorder) RegisterCodec(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterCodec", reflect.TypeOf((*MockMint)(nil).RegisterCodec), arg0)
+}
+
+// MockOrder is a mock of Order interface
+type MockOrder struct {
+	ctrl     *gomock.Controller
+	recorder *MockOrderMockRecorder
+}
+
+// MockOrderMockRecorder is the mock recorder for MockOrder
+type MockOrderMockRecorder struct {
+	mock *MockOrder
+}
+
+// NewMockOrder creates a new mock instance
+func NewMockOrder(ctrl *gomock.Controller) *MockOrder {
+	mock := &MockOrder{ctrl: ctrl}
+	mock.recorder = &MockOrderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockOrder) EXPECT() *MockOrderMockRecorder {
+	return m.recorder
+}
+
+// CancelOrders mocks base method
+func (m *MockOrder) CancelOrders(arg0 keys.Info, arg1, arg2, arg3 string, arg4, arg5 uint64) (types10.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelOrders", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(types10.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelOrders indicates an expected call of CancelOrders
+func (mr *MockOrderMockRecorder) CancelOrders(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelOrders", reflect.TypeOf((*MockOrder)(nil).CancelOrders), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// Name mocks base method
+func (m *MockOrder) Name() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Name")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Name indicates an expected call of Name
+func (mr *MockOrderMockRecorder) Name() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Name", reflect.TypeOf((*MockOrder)(nil).Name))
+}
+
+// NewOrders mocks base method
+func (m *MockOrder) NewOrders(arg0 keys.Info, arg1, arg2, arg3, arg4, arg5, arg6 string, arg7, arg8 uint64) (types10.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewOrders", arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8)
+	ret0, _ := ret[0].(types10.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewOrders indicates an expected call of NewOrders
+func (mr *MockOrderMockRecorder) NewOrders(arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewOrders", reflect.TypeOf((*MockOrder)(nil).NewOrders), arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8)
+}
+
+// PlaceOrders mocks base method
+func (m *MockOrder) PlaceOrders(arg0 keys.Info, arg1 string, arg2 []types5.OrderItem, arg3 string, arg4, arg5 uint64) ([]types5.OrderResult, types10.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlaceOrders", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].([]types5.OrderResult)
+	ret1, _ := ret[1].(types10.TxResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// PlaceOrders indicates an expected call of PlaceOrders
+func (mr *MockOrderMockRecorder) PlaceOrders(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlaceOrders", reflect.TypeOf((*MockOrder)(nil).PlaceOrders), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// QueryDepthBook mocks base method
+func (m *MockOrder) QueryDepthBook(arg0 string) (types5.BookRes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryDepthBook", arg0)
+	ret0, _ := ret[0].(types5.BookRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryDepthBook indicates an expected call of QueryDepthBook
+func (mr *MockOrderMockRecorder) QueryDepthBook(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryDepthBook", reflect.TypeOf((*MockOrder)(nil).QueryDepthBook), arg0)
+}
+
+// QueryOrderDetail mocks base method
+func (m *MockOrder) QueryOrderDetail(arg0 string) (types5.OrderDetail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryOrderDetail", arg0)
+	ret0, _ := ret[0].(types5.OrderDetail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryOrderDetail indicates an expected call of QueryOrderDetail
+func (mr *MockOrderMockRecorder) QueryOrderDetail(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryOrderDetail", reflect.TypeOf((*MockOrder)(nil).QueryOrderDetail), arg0)
+}
+
+// QueryOrderParams mocks base method
+func (m *MockOrder) QueryOrderParams() (types5.Params, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryOrderParams")
+	ret0, _ := ret[0].(types5.Params)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryOrderParams indicates an expected call of QueryOrderParams
+func (mr *MockOrderMockRecorder) QueryOrderParams() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryOrderParams", reflect.TypeOf((*MockOrder)(nil).QueryOrderParams))
+}
+
+// QueryTxOrders mocks base method
+func (m *MockOrder) QueryTxOrders(arg0 string) (types5.TxOrders, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryTxOrders", arg0)
+	ret0, _ := ret[0].(types5.TxOrders)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryTxOrders indicates an expected call of QueryTxOrders
+func (mr *MockOrderMockRecorder) QueryTxOrders(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryTxOrders", reflect.TypeOf((*MockOrder)(nil).QueryTxOrders), arg0)
+}
+
+// RegisterCodec mocks base method
+func (m *MockOrder) RegisterCodec(arg0 types10.SDKCodec) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RegisterCodec", arg0)
+}
+
+// RegisterCodec indicates an expected call of RegisterCodec
+func (mr *MockOrderMockRecorder) RegisterCodec(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterCodec", reflect.TypeOf((*MockOrder)(nil).RegisterCodec), arg0)
+}
+
+// SubscribeDepthBook mocks base method
+func (m *MockOrder) SubscribeDepthBook(arg0 context.Context, arg1 string) (<-chan types5.BookUpdate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeDepthBook", arg0, arg1)
+	ret0, _ := ret[0].(<-chan types5.BookUpdate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubscribeDepthBook indicates an expected call of SubscribeDepthBook
+func (mr *MockOrderMockRecorder) SubscribeDepthBook(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeDepthBook", reflect.TypeOf((*MockOrder)(nil).SubscribeDepthBook), arg0, arg1)
+}
+
+// MockSlashing is a mock of Slashing interface
+type MockSlashing struct {
+	ctrl     *gomock.Controller
+	recorder *MockSlashingMockRecorder
+}
+
+// MockSlashingMockRecorder is the mock recorder for MockSlashing
+type MockSlashingMockRecorder struct {
+	mock *MockSlashing
+}
+
+// NewMockSlashing creates a new mock instance
+func NewMockSlashing(ctrl *gomock.Controller) *MockSlashing {
+	mock := &MockSlashing{ctrl: ctrl}
+	mock.recorder = &MockSlashingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockSlashing) EXPECT() *MockSlashingMockRecorder {
+	return m.recorder
+}
+
+// Name mocks base method
+func (m *MockSlashing) Name() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Name")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Name indicates an expected call of Name
+func (mr *MockSlashingMockRecorder) Name() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Name", reflect.TypeOf((*MockSlashing)(nil).Name))
+}
+
+// QuerySigningInfo mocks base method
+func (m *MockSlashing) QuerySigningInfo(arg0 string) (types6.ValidatorSigningInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QuerySigningInfo", arg0)
+	ret0, _ := ret[0].(types6.ValidatorSigningInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QuerySigningInfo indicates an expected call of QuerySigningInfo
+func (mr *MockSlashingMockRecorder) QuerySigningInfo(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuerySigningInfo", reflect.TypeOf((*MockSlashing)(nil).QuerySigningInfo), arg0)
+}
+
+// QuerySigningInfos mocks base method
+func (m *MockSlashing) QuerySigningInfos() ([]types6.ValidatorSigningInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QuerySigningInfos")
+	ret0, _ := ret[0].([]types6.ValidatorSigningInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QuerySigningInfos indicates an expected call of QuerySigningInfos
+func (mr *MockSlashingMockRecorder) QuerySigningInfos() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuerySigningInfos", reflect.TypeOf((*MockSlashing)(nil).QuerySigningInfos))
+}
+
+// QuerySlashingParams mocks base method
+func (m *MockSlashing) QuerySlashingParams() (types6.Params, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QuerySlashingParams")
+	ret0, _ := ret[0].(types6.Params)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QuerySlashingParams indicates an expected call of QuerySlashingParams
+func (mr *MockSlashingMockRecorder) QuerySlashingParams() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuerySlashingParams", reflect.TypeOf((*MockSlashing)(nil).QuerySlashingParams))
+}
+
+// RegisterCodec mocks base method
+func (m *MockSlashing) RegisterCodec(arg0 types10.SDKCodec) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RegisterCodec", arg0)
+}
+
+// RegisterCodec indicates an expected call of RegisterCodec
+func (mr *MockSlashingMockRecorder) RegisterCodec(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterCodec", reflect.TypeOf((*MockSlashing)(nil).RegisterCodec), arg0)
+}
+
+// Unjail mocks base method
+func (m *MockSlashing) Unjail(arg0 keys.Info, arg1, arg2 string, arg3, arg4 uint64) (types10.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unjail", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(types10.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Unjail indicates an expected call of Unjail
+func (mr *MockSlashingMockRecorder) Unjail(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unjail", reflect.TypeOf((*MockSlashing)(nil).Unjail), arg0, arg1, arg2, arg3, arg4)
+}
+
+// MockStaking is a mock of Staking interface
+type MockStaking struct {
+	ctrl     *gomock.Controller
+	recorder *MockStakingMockRecorder
+}
+
+// MockStakingMockRecorder is the mock recorder for MockStaking
+type MockStakingMockRecorder struct {
+	mock *MockStaking
+}
+
+// NewMockStaking creates a new mock instance
+func NewMockStaking(ctrl *gomock.Controller) *MockStaking {
+	mock := &MockStaking{ctrl: ctrl}
+	mock.recorder = &MockStakingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockStaking) EXPECT() *MockStakingMockRecorder {
+	return m.recorder
+}
+
+// BindProxy mocks base method
+func (m *MockStaking) BindProxy(arg0 keys.Info, arg1, arg2, arg3 string, arg4, arg5 uint64) (types10.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BindProxy", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(types10.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BindProxy indicates an expected call of BindProxy
+func (mr *MockStakingMockRecorder) BindProxy(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BindProxy", reflect.TypeOf((*MockStaking)(nil).BindProxy), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// CreateValidator mocks base method
+func (m *MockStaking) CreateValidator(arg0 keys.Info, arg1, arg2, arg3, arg4, arg5, arg6, arg7 string, arg8, arg9 uint64) (types10.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateValidator", arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9)
+	ret0, _ := ret[0].(types10.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateValidator indicates an expected call of CreateValidator
+func (mr *MockStakingMockRecorder) CreateValidator(arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateValidator", reflect.TypeOf((*MockStaking)(nil).CreateValidator), arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9)
+}
+
+// Delegate mocks base method
+func (m *MockStaking) Delegate(arg0 keys.Info, arg1 string, arg2 types10.Amount, arg3 string, arg4, arg5 uint64) (types10.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delegate", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(types10.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delegate indicates an expected call of Delegate
+func (mr *MockStakingMockRecorder) Delegate(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delegate", reflect.TypeOf((*MockStaking)(nil).Delegate), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// DestroyValidator mocks base method
+func (m *MockStaking) DestroyValidator(arg0 keys.Info, arg1, arg2 string, arg3, arg4 uint64) (types10.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DestroyValidator", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(types10.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DestroyValidator indicates an expected call of DestroyValidator
+func (mr *MockStakingMockRecorder) DestroyValidator(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroyValidator", reflect.TypeOf((*MockStaking)(nil).DestroyValidator), arg0, arg1, arg2, arg3, arg4)
+}
+
+// EditValidator mocks base method
+func (m *MockStaking) EditValidator(arg0 keys.Info, arg1, arg2, arg3, arg4, arg5, arg6 string, arg7, arg8 uint64) (types10.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EditValidator", arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8)
+	ret0, _ := ret[0].(types10.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EditValidator indicates an expected call of EditValidator
+func (mr *MockStakingMockRecorder) EditValidator(arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditValidator", reflect.TypeOf((*MockStaking)(nil).EditValidator), arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8)
+}
+
+// Name mocks base method
+func (m *MockStaking) Name() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Name")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Name indicates an expected call of Name
+func (mr *MockStakingMockRecorder) Name() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Name", reflect.TypeOf((*MockStaking)(nil).Name))
+}
+
+// QueryDelegator mocks base method
+func (m *MockStaking) QueryDelegator(arg0 string) (types7.DelegatorResp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryDelegator", arg0)
+	ret0, _ := ret[0].(types7.DelegatorResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryDelegator indicates an expected call of QueryDelegator
+func (mr *MockStakingMockRecorder) QueryDelegator(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryDelegator", reflect.TypeOf((*MockStaking)(nil).QueryDelegator), arg0)
+}
+
+// QueryDelegatorVotes mocks base method
+func (m *MockStaking) QueryDelegatorVotes(arg0 string) ([]types7.Vote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryDelegatorVotes", arg0)
+	ret0, _ := ret[0].([]types7.Vote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryDelegatorVotes indicates an expected call of QueryDelegatorVotes
+func (mr *MockStakingMockRecorder) QueryDelegatorVotes(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryDelegatorVotes", reflect.TypeOf((*MockStaking)(nil).QueryDelegatorVotes), arg0)
+}
+
+// QueryProxy mocks base method
+func (m *MockStaking) QueryProxy(arg0 string) (types7.ProxyResp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryProxy", arg0)
+	ret0, _ := ret[0].(types7.ProxyResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryProxy indicates an expected call of QueryProxy
+func (mr *MockStakingMockRecorder) QueryProxy(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryProxy", reflect.TypeOf((*MockStaking)(nil).QueryProxy), arg0)
+}
+
+// QueryStakingParams mocks base method
+func (m *MockStaking) QueryStakingParams() (types7.Params, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryStakingParams")
+	ret0, _ := ret[0].(types7.Params)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryStakingParams indicates an expected call of QueryStakingParams
+func (mr *MockStakingMockRecorder) QueryStakingParams() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryStakingParams", reflect.TypeOf((*MockStaking)(nil).QueryStakingParams))
+}
+
+// QueryValidator mocks base method
+func (m *MockStaking) QueryValidator(arg0 string) (types7.Validator, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryValidator", arg0)
+	ret0, _ := ret[0].(types7.Validator)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryValidator indicates an expected call of QueryValidator
+func (mr *MockStakingMockRecorder) QueryValidator(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryValidator", reflect.TypeOf((*MockStaking)(nil).QueryValidator), arg0)
+}
+
+// QueryValidatorVotes mocks base method
+func (m *MockStaking) QueryValidatorVotes(arg0 string) ([]types7.Vote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryValidatorVotes", arg0)
+	ret0, _ := ret[0].([]types7.Vote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryValidatorVotes indicates an expected call of QueryValidatorVotes
+func (mr *MockStakingMockRecorder) QueryValidatorVotes(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryValidatorVotes", reflect.TypeOf((*MockStaking)(nil).QueryValidatorVotes), arg0)
+}
+
+// QueryValidators mocks base method
+func (m *MockStaking) QueryValidators() ([]types7.Validator, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryValidators")
+	ret0, _ := ret[0].([]types7.Validator)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryValidators indicates an expected call of QueryValidators
+func (mr *MockStakingMockRecorder) QueryValidators() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryValidators", reflect.TypeOf((*MockStaking)(nil).QueryValidators))
+}
+
+// QueryValidatorsPaged mocks base method
+func (m *MockStaking) QueryValidatorsPaged(arg0 types10.PageRequest) ([]types7.Validator, types10.PagedResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryValidatorsPaged", arg0)
+	ret0, _ := ret[0].([]types7.Validator)
+	ret1, _ := ret[1].(types10.PagedResult)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// QueryValidatorsPaged indicates an expected call of QueryValidatorsPaged
+func (mr *MockStakingMockRecorder) QueryValidatorsPaged(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryValidatorsPaged", reflect.TypeOf((*MockStaking)(nil).QueryValidatorsPaged), arg0)
+}
+
+// RegisterCodec mocks base method
+func (m *MockStaking) RegisterCodec(arg0 types10.SDKCodec) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RegisterCodec", arg0)
+}
+
+// RegisterCodec indicates an expected call of RegisterCodec
+func (mr *MockStakingMockRecorder) RegisterCodec(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterCodec", reflect.TypeOf((*MockStaking)(nil).RegisterCodec), arg0)
+}
+
+// RegisterProxy mocks base method
+func (m *MockStaking) RegisterProxy(arg0 keys.Info, arg1, arg2 string, arg3, arg4 uint64) (types10.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterProxy", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(types10.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterProxy indicates an expected call of RegisterProxy
+func (mr *MockStakingMockRecorder) RegisterProxy(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterProxy", reflect.TypeOf((*MockStaking)(nil).RegisterProxy), arg0, arg1, arg2, arg3, arg4)
+}
+
+// SubscribeValidatorSetUpdates mocks base method
+func (m *MockStaking) SubscribeValidatorSetUpdates(arg0 context.Context) (<-chan types7.ValidatorSetEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeValidatorSetUpdates", arg0)
+	ret0, _ := ret[0].(<-chan types7.ValidatorSetEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubscribeValidatorSetUpdates indicates an expected call of SubscribeValidatorSetUpdates
+func (mr *MockStakingMockRecorder) SubscribeValidatorSetUpdates(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeValidatorSetUpdates", reflect.TypeOf((*MockStaking)(nil).SubscribeValidatorSetUpdates), arg0)
+}
+
+// UnbindProxy mocks base method
+func (m *MockStaking) UnbindProxy(arg0 keys.Info, arg1, arg2 string, arg3, arg4 uint64) (types10.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnbindProxy", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(types10.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnbindProxy indicates an expected call of UnbindProxy
+func (mr *MockStakingMockRecorder) UnbindProxy(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnbindProxy", reflect.TypeOf((*MockStaking)(nil).UnbindProxy), arg0, arg1, arg2, arg3, arg4)
+}
+
+// Unbond mocks base method
+func (m *MockStaking) Unbond(arg0 keys.Info, arg1 string, arg2 types10.Amount, arg3 string, arg4, arg5 uint64) (types10.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unbond", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(types10.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Unbond indicates an expected call of Unbond
+func (mr *MockStakingMockRecorder) Unbond(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unbond", reflect.TypeOf((*MockStaking)(nil).Unbond), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// UnregisterProxy mocks base method
+func (m *MockStaking) UnregisterProxy(arg0 keys.Info, arg1, arg2 string, arg3, arg4 uint64) (types10.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnregisterProxy", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(types10.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnregisterProxy indicates an expected call of UnregisterProxy
+func (mr *MockStakingMockRecorder) UnregisterProxy(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnregisterProxy", reflect.TypeOf((*MockStaking)(nil).UnregisterProxy), arg0, arg1, arg2, arg3, arg4)
+}
+
+// Vote mocks base method
+func (m *MockStaking) Vote(arg0 keys.Info, arg1 string, arg2 []string, arg3 string, arg4, arg5 uint64) (types10.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Vote", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(types10.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Vote indicates an expected call of Vote
+func (mr *MockStakingMockRecorder) Vote(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Vote", reflect.TypeOf((*MockStaking)(nil).Vote), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// MockTendermint is a mock of Tendermint interface
+type MockTendermint struct {
+	ctrl     *gomock.Controller
+	recorder *MockTendermintMockRecorder
+}
+
+// MockTendermintMockRecorder is the mock recorder for MockTendermint
+type MockTendermintMockRecorder struct {
+	mock *MockTendermint
+}
+
+// NewMockTendermint creates a new mock instance
+func NewMockTendermint(ctrl *gomock.Controller) *MockTendermint {
+	mock := &MockTendermint{ctrl: ctrl}
+	mock.recorder = &MockTendermintMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockTendermint) EXPECT() *MockTendermintMockRecorder {
+	return m.recorder
+}
+
+// EstimateBlockTime mocks base method
+func (m *MockTendermint) EstimateBlockTime(arg0 int64) (types8.BlockTimeEstimate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EstimateBlockTime", arg0)
+	ret0, _ := ret[0].(types8.BlockTimeEstimate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EstimateBlockTime indicates an expected call of EstimateBlockTime
+func (mr *MockTendermintMockRecorder) EstimateBlockTime(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EstimateBlockTime", reflect.TypeOf((*MockTendermint)(nil).EstimateBlockTime), arg0)
+}
+
+// Name mocks base method
+func (m *MockTendermint) Name() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Name")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Name indicates an expected call of Name
+func (mr *MockTendermintMockRecorder) Name() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Name", reflect.TypeOf((*MockTendermint)(nil).Name))
+}
+
+// QueryBlock mocks base method
+func (m *MockTendermint) QueryBlock(arg0 int64) (types8.Block, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryBlock", arg0)
+	ret0, _ := ret[0].(types8.Block)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryBlock indicates an expected call of QueryBlock
+func (mr *MockTendermintMockRecorder) QueryBlock(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryBlock", reflect.TypeOf((*MockTendermint)(nil).QueryBlock), arg0)
+}
+
+// QueryBlockResults mocks base method
+func (m *MockTendermint) QueryBlockResults(arg0 int64) (types8.BlockResults, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryBlockResults", arg0)
+	ret0, _ := ret[0].(types8.BlockResults)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryBlockResults indicates an expected call of QueryBlockResults
+func (mr *MockTendermintMockRecorder) QueryBlockResults(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryBlockResults", reflect.TypeOf((*MockTendermint)(nil).QueryBlockResults), arg0)
+}
+
+// QueryCommitResult mocks base method
+func (m *MockTendermint) QueryCommitResult(arg0 int64) (types8.ResultCommit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryCommitResult", arg0)
+	ret0, _ := ret[0].(types8.ResultCommit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryCommitResult indicates an expected call of QueryCommitResult
+func (mr *MockTendermintMockRecorder) QueryCommitResult(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryCommitResult", reflect.TypeOf((*MockTendermint)(nil).QueryCommitResult), arg0)
+}
+
+// QueryLatestCommitResult mocks base method
+func (m *MockTendermint) QueryLatestCommitResult() (types8.ResultCommit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryLatestCommitResult")
+	ret0, _ := ret[0].(types8.ResultCommit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryLatestCommitResult indicates an expected call of QueryLatestCommitResult
+func (mr *MockTendermintMockRecorder) QueryLatestCommitResult() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryLatestCommitResult", reflect.TypeOf((*MockTendermint)(nil).QueryLatestCommitResult))
+}
+
+// QueryStatus mocks base method
+func (m *MockTendermint) QueryStatus() (types8.ResultStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryStatus")
+	ret0, _ := ret[0].(types8.ResultStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryStatus indicates an expected call of QueryStatus
+func (mr *MockTendermintMockRecorder) QueryStatus() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryStatus", reflect.TypeOf((*MockTendermint)(nil).QueryStatus))
+}
+
+// QueryTxResult mocks base method
+func (m *MockTendermint) QueryTxResult(arg0 []byte, arg1 bool) (types8.ResultTx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryTxResult", arg0, arg1)
+	ret0, _ := ret[0].(types8.ResultTx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryTxResult indicates an expected call of QueryTxResult
+func (mr *MockTendermintMockRecorder) QueryTxResult(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryTxResult", reflect.TypeOf((*MockTendermint)(nil).QueryTxResult), arg0, arg1)
+}
+
+// QueryTxsResult mocks base method
+func (m *MockTendermint) QueryTxsResult(arg0 string, arg1, arg2 int) (types8.ResultTxs, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryTxsResult", arg0, arg1, arg2)
+	ret0, _ := ret[0].(types8.ResultTxs)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryTxsResult indicates an expected call of QueryTxsResult
+func (mr *MockTendermintMockRecorder) QueryTxsResult(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryTxsResult", reflect.TypeOf((*MockTendermint)(nil).QueryTxsResult), arg0, arg1, arg2)
+}
+
+// QueryTxsResultPaged mocks base method
+func (m *MockTendermint) QueryTxsResultPaged(arg0 string, arg1 types10.PageRequest) (types8.ResultTxs, types10.PagedResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryTxsResultPaged", arg0, arg1)
+	ret0, _ := ret[0].(types8.ResultTxs)
+	ret1, _ := ret[1].(types10.PagedResult)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// QueryTxsResultPaged indicates an expected call of QueryTxsResultPaged
+func (mr *MockTendermintMockRecorder) QueryTxsResultPaged(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryTxsResultPaged", reflect.TypeOf((*MockTendermint)(nil).QueryTxsResultPaged), arg0, arg1)
+}
+
+// QueryValidatorsResult mocks base method
+func (m *MockTendermint) QueryValidatorsResult(arg0 int64) (types8.ResultValidators, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryValidatorsResult", arg0)
+	ret0, _ := ret[0].(types8.ResultValidators)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryValidatorsResult indicates an expected call of QueryValidatorsResult
+func (mr *MockTendermintMockRecorder) QueryValidatorsResult(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryValidatorsResult", reflect.TypeOf((*MockTendermint)(nil).QueryValidatorsResult), arg0)
+}
+
+// RegisterCodec mocks base method
+func (m *MockTendermint) RegisterCodec(arg0 types10.SDKCodec) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RegisterCodec", arg0)
+}
+
+// RegisterCodec indicates an expected call of RegisterCodec
+func (mr *MockTendermintMockRecorder) RegisterCodec(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterCodec", reflect.TypeOf((*MockTendermint)(nil).RegisterCodec), arg0)
+}
+
+// MockToken is a mock of Token interface
+type MockToken struct {
+	ctrl     *gomock.Controller
+	recorder *MockTokenMockRecorder
+}
+
+// MockTokenMockRecorder is the mock recorder for MockToken
+type MockTokenMockRecorder struct {
+	mock *MockToken
+}
+
+// NewMockToken creates a new mock instance
+func NewMockToken(ctrl *gomock.Controller) *MockToken {
+	mock := &MockToken{ctrl: ctrl}
+	mock.recorder = &MockTokenMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockToken) EXPECT() *MockTokenMockRecorder {
+	return m.recorder
+}
+
+// Burn mocks base method
+func (m *MockToken) Burn(arg0 keys.Info, arg1 string, arg2 types10.Amount, arg3 string, arg4, arg5 uint64) (types10.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Burn", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(types10.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Burn indicates an expected call of Burn
+func (mr *MockTokenMockRecorder) Burn(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Burn", reflect.TypeOf((*MockToken)(nil).Burn), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// Edit mocks base method
+func (m *MockToken) Edit(arg0 keys.Info, arg1, arg2, arg3, arg4, arg5 string, arg6, arg7 bool, arg8, arg9 uint64) (types10.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Edit", arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9)
+	ret0, _ := ret[0].(types10.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Edit indicates an expected call of Edit
+func (mr *MockTokenMockRecorder) Edit(arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Edit", reflect.TypeOf((*MockToken)(nil).Edit), arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9)
+}
+
+// Issue mocks base method
+func (m *MockToken) Issue(arg0 keys.Info, arg1, arg2, arg3, arg4, arg5, arg6 string, arg7 bool, arg8, arg9 uint64) (types10.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Issue", arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9)
+	ret0, _ := ret[0].(types10.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Issue indicates an expected call of Issue
+func (mr *MockTokenMockRecorder) Issue(arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Issue", reflect.TypeOf((*MockToken)(nil).Issue), arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9)
+}
+
+// Mint mocks base method
+func (m *MockToken) Mint(arg0 keys.Info, arg1 string, arg2 types10.Amount, arg3 string, arg4, arg5 uint64) (types10.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Mint", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(types10.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Mint indicates an expected call of Mint
+func (mr *MockTokenMockRecorder) Mint(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Mint", reflect.TypeOf((*MockToken)(nil).Mint), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// MultiSend mocks base method
+func (m *MockToken) MultiSend(arg0 keys.Info, arg1 string, arg2 []types10.TransferUnit, arg3 string, arg4, arg5 uint64) (types10.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MultiSend", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(types10.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MultiSend indicates an expected call of MultiSend
+func (mr *MockTokenMockRecorder) MultiSend(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MultiSend", reflect.TypeOf((*MockToken)(nil).MultiSend), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// Name mocks base method
+func (m *MockToken) Name() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Name")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Name indicates an expected call of Name
+func (mr *MockTokenMockRecorder) Name() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Name", reflect.TypeOf((*MockToken)(nil).Name))
+}
+
+// QueryAccountTokenInfo mocks base method
+func (m *MockToken) QueryAccountTokenInfo(arg0, arg1 string) (types9.AccountTokensInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryAccountTokenInfo", arg0, arg1)
+	ret0, _ := ret[0].(types9.AccountTokensInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryAccountTokenInfo indicates an expected call of QueryAccountTokenInfo
+func (mr *MockTokenMockRecorder) QueryAccountTokenInfo(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryAccountTokenInfo", reflect.TypeOf((*MockToken)(nil).QueryAccountTokenInfo), arg0, arg1)
+}
+
+// QueryAccountTokensInfo mocks base method
+func (m *MockToken) QueryAccountTokensInfo(arg0 string) (types9.AccountTokensInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryAccountTokensInfo", arg0)
+	ret0, _ := ret[0].(types9.AccountTokensInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryAccountTokensInfo indicates an expected call of QueryAccountTokensInfo
+func (mr *MockTokenMockRecorder) QueryAccountTokensInfo(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryAccountTokensInfo", reflect.TypeOf((*MockToken)(nil).QueryAccountTokensInfo), arg0)
+}
+
+// QueryTokenInfo mocks base method
+func (m *MockToken) QueryTokenInfo(arg0, arg1 string) ([]types9.Token, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryTokenInfo", arg0, arg1)
+	ret0, _ := ret[0].([]types9.Token)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryTokenInfo indicates an expected call of QueryTokenInfo
+func (mr *MockTokenMockRecorder) QueryTokenInfo(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryTokenInfo", reflect.TypeOf((*MockToken)(nil).QueryTokenInfo), arg0, arg1)
+}
+
+// QueryTokenParams mocks base method
+func (m *MockToken) QueryTokenParams() (types9.Params, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryTokenParams")
+	ret0, _ := ret[0].(types9.Params)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryTokenParams indicates an expected call of QueryTokenParams
+func (mr *MockTokenMockRecorder) QueryTokenParams() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryTokenParams", reflect.TypeOf((*MockToken)(nil).QueryTokenParams))
+}
+
+// QueryTransfers mocks base method
+func (m *MockToken) QueryTransfers(arg0, arg1 string, arg2, arg3 int64) ([]types9.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryTransfers", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]types9.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryTransfers indicates an expected call of QueryTransfers
+func (mr *MockTokenMockRecorder) QueryTransfers(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryTransfers", reflect.TypeOf((*MockToken)(nil).QueryTransfers), arg0, arg1, arg2, arg3)
+}
+
+// RegisterCodec mocks base method
+func (m *MockToken) RegisterCodec(arg0 types10.SDKCodec) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RegisterCodec", arg0)
+}
+
+// RegisterCodec indicates an expected call of RegisterCodec
+func (mr *MockTokenMockRecorder) RegisterCodec(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterCodec", reflect.TypeOf((*MockToken)(nil).RegisterCodec), arg0)
+}
+
+// Send mocks base method
+func (m *MockToken) Send(arg0 keys.Info, arg1, arg2 string, arg3 types10.Amount, arg4 string, arg5, arg6 uint64) (types10.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+	ret0, _ := ret[0].(types10.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Send indicates an expected call of Send
+func (mr *MockTokenMockRecorder) Send(arg0, arg1, arg2, arg3, arg4, arg5, arg6 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockToken)(nil).Send), arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+}
@@ -0,0 +1,78 @@
+package mocks
+
+//go:generate mockgen -destination mock_exposed.go -package mocks github.com/okex/okchain-go-sdk/exposed Auth,Backend,Dex,Distribution,Governance,Mint,Order,Slashing,Staking,Tendermint,Token
+
+import (
+	"github.com/golang/mock/gomock"
+	"github.com/okex/okchain-go-sdk/exposed"
+)
+
+// MockModuleClient - structure of the mock client with all the module clients mocked, for the unit tests of the
+// applications embedding gosdk without a live node
+// The canned responses are configured by the EXPECT() of each module mock, e.g.
+//   cli.MockStaking.EXPECT().QueryValidators().Return(validators, nil).AnyTimes()
+type MockModuleClient struct {
+	MockAuth         *MockAuth
+	MockBackend      *MockBackend
+	MockDex          *MockDex
+	MockDistribution *MockDistribution
+	MockGovernance   *MockGovernance
+	MockMint         *MockMint
+	MockOrder        *MockOrder
+	MockSlashing     *MockSlashing
+	MockStaking      *MockStaking
+	MockTendermint   *MockTendermint
+	MockToken        *MockToken
+}
+
+// NewMockModuleClient creates a new instance of MockModuleClient
+func NewMockModuleClient(ctrl *gomock.Controller) *MockModuleClient {
+	return &MockModuleClient{
+		MockAuth:         NewMockAuth(ctrl),
+		MockBackend:      NewMockBackend(ctrl),
+		MockDex:          NewMockDex(ctrl),
+		MockDistribution: NewMockDistribution(ctrl),
+		MockGovernance:   NewMockGovernance(ctrl),
+		MockMint:         NewMockMint(ctrl),
+		MockOrder:        NewMockOrder(ctrl),
+		MockSlashing:     NewMockSlashing(ctrl),
+		MockStaking:      NewMockStaking(ctrl),
+		MockTendermint:   NewMockTendermint(ctrl),
+		MockToken:        NewMockToken(ctrl),
+	}
+}
+
+// nolint
+func (mmc *MockModuleClient) Auth() exposed.Auth {
+	return mmc.MockAuth
+}
+func (mmc *MockModuleClient) Backend() exposed.Backend {
+	return mmc.MockBackend
+}
+func (mmc *MockModuleClient) Dex() exposed.Dex {
+	return mmc.MockDex
+}
+func (mmc *MockModuleClient) Distribution() exposed.Distribution {
+	return mmc.MockDistribution
+}
+func (mmc *MockModuleClient) Governance() exposed.Governance {
+	return mmc.MockGovernance
+}
+func (mmc *MockModuleClient) Mint() exposed.Mint {
+	return mmc.MockMint
+}
+func (mmc *MockModuleClient) Order() exposed.Order {
+	return mmc.MockOrder
+}
+func (mmc *MockModuleClient) Slashing() exposed.Slashing {
+	return mmc.MockSlashing
+}
+func (mmc *MockModuleClient) Staking() exposed.Staking {
+	return mmc.MockStaking
+}
+func (mmc *MockModuleClient) Tendermint() exposed.Tendermint {
+	return mmc.MockTendermint
+}
+func (mmc *MockModuleClient) Token() exposed.Token {
+	return mmc.MockToken
+}
@@ -0,0 +1,41 @@
+package mocks
+
+import (
+	"github.com/okex/okchain-go-sdk/types/proof"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+)
+
+// NewStoreProofResponse builds the response of the store query of the key with the merkle proof of its existence in an
+// iavl store of a multistore, and returns the app hash committing the state
+func NewStoreProofResponse(storeName string, key, value []byte, height int64) (resp abci.ResponseQuery,
+	appHash []byte) {
+	leaf := proof.ProofLeafNode{Key: key, ValueHash: tmhash.Sum(value), Version: height}
+	rangeProof := &proof.RangeProof{
+		LeftPath: proof.PathToLeaf{
+			{Height: 1, Size: 2, Version: height, Right: tmhash.Sum([]byte("right leaf"))},
+		},
+		Leaves: []proof.ProofLeafNode{leaf},
+	}
+	storeRoot, err := rangeProof.ComputeRootHash()
+	if err != nil {
+		panic(err)
+	}
+
+	multiStoreProof := &proof.MultiStoreProof{StoreInfos: []proof.StoreInfo{
+		{Name: storeName, Core: proof.StoreCore{CommitID: proof.CommitID{Version: height, Hash: storeRoot}}},
+		{Name: "other", Core: proof.StoreCore{CommitID: proof.CommitID{Version: height, Hash: tmhash.Sum(nil)}}},
+	}}
+
+	resp = abci.ResponseQuery{
+		Key:    key,
+		Value:  value,
+		Height: height,
+		Proof: &merkle.Proof{Ops: []merkle.ProofOp{
+			proof.NewIAVLValueOp(key, rangeProof).ProofOp(),
+			proof.NewMultiStoreProofOp([]byte(storeName), multiStoreProof).ProofOp(),
+		}},
+	}
+	return resp, multiStoreProof.ComputeRootHash()
+}
@@ -0,0 +1,107 @@
+package mocks
+
+import (
+	"testing"
+	"time"
+
+	dex "github.com/okex/okchain-go-sdk/module/dex/types"
+	governance "github.com/okex/okchain-go-sdk/module/governance/types"
+	staking "github.com/okex/okchain-go-sdk/module/staking/types"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSerializer_PublicTypes(t *testing.T) {
+	owner, err := sdk.AccAddressFromBech32(FixtureAddr)
+	require.NoError(t, err)
+	deposit, err := sdk.ParseDecCoin("100okt")
+	require.NoError(t, err)
+	deposits, err := sdk.ParseDecCoins("100okt")
+	require.NoError(t, err)
+	now := time.Date(2020, 5, 20, 12, 0, 0, 0, time.UTC)
+
+	tokenPair := dex.TokenPair{
+		BaseAssetSymbol:  "btc-000",
+		QuoteAssetSymbol: "okt",
+		InitPrice:        sdk.NewDecWithPrec(1024, 2),
+		MaxPriceDigit:    4,
+		MaxQuantityDigit: 4,
+		MinQuantity:      sdk.NewDecWithPrec(1, 4),
+		ID:               1,
+		Owner:            owner,
+		Deposits:         deposit,
+		BlockHeight:      1024,
+	}
+	txResponse := sdk.TxResponse{
+		Height: 1024,
+		TxHash: "F8C1C6E4FDE1E9C0D6D5E4C2CF4F8B0E2B6F4C5E6A7B8C9D0E1F2A3B4C5D6E7F",
+		RawLog: "[]",
+		Logs:   sdk.ABCIMessageLogs{{MsgIndex: 0, Success: true, Log: ""}},
+	}
+	validator := staking.Validator{
+		OperatorAddress:         sdk.ValAddress(owner),
+		ConsPubKey:              "okchainvalconspub1zcjduepqpjq9n8g6fnjrys5t07cqcdcptu5d06tpxvhdu04mdrc4uc5swmmqfu3wku",
+		Tokens:                  sdk.NewInt(1024),
+		DelegatorShares:         sdk.NewDec(1024),
+		Description:             staking.Description{Moniker: "alice"},
+		UnbondingCompletionTime: now,
+		Commission: staking.Commission{
+			CommissionRates: staking.CommissionRates{
+				Rate:          sdk.MustNewDecFromStr("0.1"),
+				MaxRate:       sdk.MustNewDecFromStr("0.2"),
+				MaxChangeRate: sdk.MustNewDecFromStr("0.01"),
+			},
+			UpdateTime: now,
+		},
+		MinSelfDelegation: sdk.NewDec(1),
+	}
+	proposal := governance.Proposal{
+		Content:    governance.NewTextProposal("Text Proposal", "text proposal description"),
+		ProposalID: 1,
+		Status:     governance.ProposalStatusVotingPeriod,
+		FinalTallyResult: governance.TallyResult{
+			Yes:             sdk.NewDec(1),
+			Abstain:         sdk.ZeroDec(),
+			No:              sdk.ZeroDec(),
+			NoWithVeto:      sdk.ZeroDec(),
+			TotalPower:      sdk.NewDec(1024),
+			TotalVotedPower: sdk.NewDec(1),
+		},
+		SubmitTime:      now,
+		DepositEndTime:  now,
+		TotalDeposit:    deposits,
+		VotingStartTime: now,
+		VotingEndTime:   now,
+	}
+
+	for _, format := range sdk.SerializationFormats() {
+		serializer, err := sdk.NewSerializer(format, NewFixtureCodec())
+		require.NoError(t, err)
+		require.Equal(t, format, serializer.Format())
+
+		var decodedTokenPair dex.TokenPair
+		requireRoundTrip(t, serializer, tokenPair, &decodedTokenPair)
+		require.Equal(t, tokenPair, decodedTokenPair)
+
+		var decodedTxResponse sdk.TxResponse
+		requireRoundTrip(t, serializer, txResponse, &decodedTxResponse)
+		require.Equal(t, txResponse, decodedTxResponse)
+
+		var decodedValidator staking.Validator
+		requireRoundTrip(t, serializer, validator, &decodedValidator)
+		require.Equal(t, validator, decodedValidator)
+
+		var decodedProposal governance.Proposal
+		requireRoundTrip(t, serializer, proposal, &decodedProposal)
+		require.Equal(t, proposal, decodedProposal)
+	}
+
+	_, err = sdk.NewSerializer("xml", NewFixtureCodec())
+	require.Error(t, err)
+}
+
+func requireRoundTrip(t *testing.T, serializer sdk.Serializer, o, ptr interface{}) {
+	bytes, err := serializer.Marshal(o)
+	require.NoError(t, err)
+	require.NoError(t, serializer.Unmarshal(bytes, ptr))
+}
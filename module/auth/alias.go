@@ -9,5 +9,6 @@ const (
 
 type (
 	// nolint
-	Account = types.Account
+	Account       = types.Account
+	BalanceChange = types.BalanceChange
 )
@@ -1,11 +1,17 @@
 package auth
 
 import (
-	"github.com/okex/okchain-go-sdk/exposed"
+	"github.com/okex/okchain-go-sdk/module/auth/exposed"
 	"github.com/okex/okchain-go-sdk/module/auth/types"
 	sdk "github.com/okex/okchain-go-sdk/types"
 )
 
+func init() {
+	sdk.RegisterModule(types.ModuleName, func(baseClient sdk.BaseClient) sdk.Module {
+		return NewAuthClient(baseClient)
+	})
+}
+
 var _ sdk.Module = (*authClient)(nil)
 
 type authClient struct {
@@ -0,0 +1,25 @@
+package exposed
+
+import (
+	"context"
+
+	"github.com/okex/okchain-go-sdk/module/auth/types"
+	sdk "github.com/okex/okchain-go-sdk/types"
+)
+
+// Auth shows the expected behavior for inner auth client
+type Auth interface {
+	sdk.Module
+	AuthQuery
+	AuthSubscription
+}
+
+// AuthQuery shows the expected query behavior for inner auth client
+type AuthQuery interface {
+	QueryAccount(accAddrStr string) (types.Account, error)
+}
+
+// AuthSubscription shows the expected subscription behavior for inner auth client
+type AuthSubscription interface {
+	WatchBalances(ctx context.Context, accAddrStrs []string) (<-chan types.BalanceChange, error)
+}
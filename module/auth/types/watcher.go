@@ -0,0 +1,13 @@
+package types
+
+import (
+	sdk "github.com/okex/okchain-go-sdk/types"
+)
+
+// BalanceChange - structure of the notification that the balance of a watched account changed in a block
+type BalanceChange struct {
+	Address  sdk.AccAddress
+	Height   int64
+	Previous sdk.DecCoins
+	Current  sdk.DecCoins
+}
@@ -0,0 +1,171 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/okex/okchain-go-sdk/module/auth/types"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	"github.com/okex/okchain-go-sdk/utils"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+const (
+	newBlockHeaderQuery     = "tm.event='NewBlockHeader'"
+	balanceChangesCapacity  = 256
+	maxConcurrentAccQueries = 16
+)
+
+// WatchBalances watches the balances of the accounts, and notifies the changes once a new block is committed
+// The balances of all the accounts are queried in a batch at the height of each new block, where the first block only
+// sets up the baseline without any notification. The channel is closed when the ctx is done
+func (ac authClient) WatchBalances(ctx context.Context, accAddrStrs []string) (<-chan types.BalanceChange, error) {
+	if len(accAddrStrs) == 0 {
+		return nil, errors.New("failed. no account to watch")
+	}
+
+	accAddrs := make([]sdk.AccAddress, 0, len(accAddrStrs))
+	watched := make(map[string]bool)
+	for _, accAddrStr := range accAddrStrs {
+		accAddr, err := sdk.AccAddressFromBech32(accAddrStr)
+		if err != nil {
+			return nil, fmt.Errorf("failed. accAddress %s converted from Bech32 error", accAddrStr)
+		}
+		if watched[accAddrStr] {
+			continue
+		}
+		watched[accAddrStr] = true
+		accAddrs = append(accAddrs, accAddr)
+	}
+
+	subscriber := fmt.Sprintf("gosdk-balances-%d", time.Now().UnixNano())
+	events, err := ac.Subscribe(ctx, subscriber, newBlockHeaderQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make(chan types.BalanceChange, balanceChangesCapacity)
+	go newBalanceWatcher(ac, accAddrs).run(ctx, events, changes)
+	return changes, nil
+}
+
+// balanceWatcher keeps the balances of the watched accounts at the last block
+type balanceWatcher struct {
+	ac       authClient
+	accAddrs []sdk.AccAddress
+	// nil before the baseline is set up
+	balances []sdk.DecCoins
+}
+
+func newBalanceWatcher(ac authClient, accAddrs []sdk.AccAddress) *balanceWatcher {
+	return &balanceWatcher{
+		ac:       ac,
+		accAddrs: accAddrs,
+	}
+}
+
+func (bw *balanceWatcher) run(ctx context.Context, events <-chan ctypes.ResultEvent, changes chan<- types.BalanceChange) {
+	defer close(changes)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+
+			header, ok := event.Data.(tmtypes.EventDataNewBlockHeader)
+			if !ok {
+				continue
+			}
+
+			for _, change := range bw.onNewBlock(header.Header.Height) {
+				select {
+				case changes <- change:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}
+}
+
+func (bw *balanceWatcher) onNewBlock(height int64) (changes []types.BalanceChange) {
+	balances, err := bw.queryBalances(height)
+	if err != nil {
+		// the changes are caught up on the next block
+		return
+	}
+
+	if bw.balances != nil {
+		for i, accAddr := range bw.accAddrs {
+			if !balances[i].IsEqual(bw.balances[i]) {
+				changes = append(changes, types.BalanceChange{
+					Address:  accAddr,
+					Height:   height,
+					Previous: bw.balances[i],
+					Current:  balances[i],
+				})
+			}
+		}
+	}
+
+	bw.balances = balances
+	return
+}
+
+// queryBalances queries the balances of all the watched accounts at the same height concurrently
+func (bw *balanceWatcher) queryBalances(height int64) ([]sdk.DecCoins, error) {
+	baseClient, err := bw.ac.WithConsistency(sdk.AtHeight(height))
+	if err != nil {
+		return nil, err
+	}
+	ac := authClient{baseClient}
+
+	balances, errs := make([]sdk.DecCoins, len(bw.accAddrs)), make([]error, len(bw.accAddrs))
+	sem := make(chan struct{}, maxConcurrentAccQueries)
+	var wg sync.WaitGroup
+	for i := range bw.accAddrs {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			balances[i], errs[i] = ac.queryCoins(bw.accAddrs[i])
+		}(i)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return balances, nil
+}
+
+// queryCoins gets the coins of the account, which are empty if the account has no record on the chain yet
+func (ac authClient) queryCoins(accAddr sdk.AccAddress) (sdk.DecCoins, error) {
+	res, err := ac.Query(types.AccountInfoPath, types.GetAddressStoreKey(accAddr))
+	if err != nil {
+		return nil, utils.ErrClientQuery(err.Error())
+	}
+
+	if res == nil {
+		return sdk.DecCoins{}, nil
+	}
+
+	var account types.Account
+	if err = ac.GetCodec().UnmarshalBinaryBare(res, &account); err != nil {
+		return nil, err
+	}
+
+	return account.GetCoins(), nil
+}
@@ -0,0 +1,102 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/okex/okchain-go-sdk/mocks"
+	"github.com/okex/okchain-go-sdk/module/auth/types"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	"github.com/stretchr/testify/require"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+const recAddr = "okchain1wux20ku36ntgtxpgm7my9863xy3fqs0xgh66d7"
+
+func TestAuthClient_WatchBalances(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	config, err := sdk.NewClientConfig("testURL", "testChain", sdk.BroadcastBlock, "", 200000,
+		1.1, "0.00000001okt")
+	require.NoError(t, err)
+	mockCli := mocks.NewMockClient(t, ctrl, config)
+	mockCli.RegisterModule(NewAuthClient(mockCli.MockBaseClient))
+
+	accAddr, err := sdk.AccAddressFromBech32(addr)
+	require.NoError(t, err)
+	recAccAddr, err := sdk.AccAddressFromBech32(recAddr)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events := make(chan ctypes.ResultEvent)
+	mockCli.EXPECT().Subscribe(ctx, gomock.Any(), newBlockHeaderQuery).Return((<-chan ctypes.ResultEvent)(events), nil)
+	mockCli.EXPECT().GetCodec().Return(mockCli.GetCodec()).AnyTimes()
+	for height := int64(1); height <= 4; height++ {
+		mockCli.EXPECT().WithConsistency(sdk.AtHeight(height)).Return(mockCli.MockBaseClient, nil)
+	}
+
+	accKey, recAccKey := cmn.HexBytes(types.GetAddressStoreKey(accAddr)), cmn.HexBytes(types.GetAddressStoreKey(recAccAddr))
+	gomock.InOrder(
+		mockCli.EXPECT().Query(types.AccountInfoPath, accKey).
+			Return(mockCli.BuildAccountBytes(addr, accPubkey, "1024okt", 1, 2), nil),
+		mockCli.EXPECT().Query(types.AccountInfoPath, accKey).
+			Return(mockCli.BuildAccountBytes(addr, accPubkey, "1000okt", 1, 3), nil),
+		mockCli.EXPECT().Query(types.AccountInfoPath, accKey).Return(nil, errors.New("default error")),
+		mockCli.EXPECT().Query(types.AccountInfoPath, accKey).
+			Return(mockCli.BuildAccountBytes(addr, accPubkey, "1000okt", 1, 3), nil),
+	)
+	gomock.InOrder(
+		// no record on the chain yet
+		mockCli.EXPECT().Query(types.AccountInfoPath, recAccKey).Return(nil, nil),
+		mockCli.EXPECT().Query(types.AccountInfoPath, recAccKey).
+			Return(mockCli.BuildAccountBytes(recAddr, accPubkey, "24okt", 2, 0), nil),
+		mockCli.EXPECT().Query(types.AccountInfoPath, recAccKey).
+			Return(mockCli.BuildAccountBytes(recAddr, accPubkey, "48okt", 2, 0), nil),
+		mockCli.EXPECT().Query(types.AccountInfoPath, recAccKey).
+			Return(mockCli.BuildAccountBytes(recAddr, accPubkey, "48okt", 2, 0), nil),
+	)
+
+	changes, err := mockCli.Auth().WatchBalances(ctx, []string{addr, recAddr, addr})
+	require.NoError(t, err)
+	for height := int64(1); height <= 4; height++ {
+		events <- ctypes.ResultEvent{Data: tmtypes.EventDataNewBlockHeader{Header: tmtypes.Header{Height: height}}}
+	}
+
+	// changes at block 2
+	change := <-changes
+	require.Equal(t, accAddr, change.Address)
+	require.Equal(t, int64(2), change.Height)
+	require.True(t, mustParseDecCoins(t, "1024okt").IsEqual(change.Previous))
+	require.True(t, mustParseDecCoins(t, "1000okt").IsEqual(change.Current))
+	change = <-changes
+	require.Equal(t, recAccAddr, change.Address)
+	require.True(t, change.Previous.IsZero())
+	require.True(t, mustParseDecCoins(t, "24okt").IsEqual(change.Current))
+
+	// the failed block 3 is caught up at block 4
+	change = <-changes
+	require.Equal(t, recAccAddr, change.Address)
+	require.Equal(t, int64(4), change.Height)
+	require.True(t, mustParseDecCoins(t, "24okt").IsEqual(change.Previous))
+	require.True(t, mustParseDecCoins(t, "48okt").IsEqual(change.Current))
+
+	cancel()
+	_, ok := <-changes
+	require.False(t, ok)
+
+	_, err = mockCli.Auth().WatchBalances(ctx, nil)
+	require.Error(t, err)
+	_, err = mockCli.Auth().WatchBalances(ctx, []string{addr[1:]})
+	require.Error(t, err)
+}
+
+func mustParseDecCoins(t *testing.T, coinsStr string) sdk.DecCoins {
+	coins, err := sdk.ParseDecCoins(coinsStr)
+	require.NoError(t, err)
+	return coins
+}
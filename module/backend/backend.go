@@ -1,11 +1,17 @@
 package backend
 
 import (
-	"github.com/okex/okchain-go-sdk/exposed"
+	"github.com/okex/okchain-go-sdk/module/backend/exposed"
 	"github.com/okex/okchain-go-sdk/module/backend/types"
 	sdk "github.com/okex/okchain-go-sdk/types"
 )
 
+func init() {
+	sdk.RegisterModule(types.ModuleName, func(baseClient sdk.BaseClient) sdk.Module {
+		return NewBackendClient(baseClient)
+	})
+}
+
 var _ sdk.Module = (*backendClient)(nil)
 
 type backendClient struct {
@@ -2,6 +2,7 @@ package backend
 
 import (
 	"github.com/okex/okchain-go-sdk/module/backend/types"
+	sdk "github.com/okex/okchain-go-sdk/types"
 	"github.com/okex/okchain-go-sdk/types/params"
 	"github.com/okex/okchain-go-sdk/utils"
 )
@@ -81,33 +82,52 @@ func (bc backendClient) QueryRecentTxRecord(product string, start, end, page, pe
 // QueryOpenOrders gets the open orders of a specific account
 func (bc backendClient) QueryOpenOrders(addrStr, product, side string, start, end, page, perPage int) (orders []types.Order,
 	err error) {
-	perPageNum, err := params.CheckQueryOrdersParams(addrStr, product, side, start, end, page, perPage)
-	if err != nil {
+	orders, _, err = bc.queryOrders(types.OpenOrdersPath, "open orders", addrStr, product, side, start, end, page, perPage)
+	return
+}
+
+// QueryClosedOrders gets the closed orders of a specific account
+func (bc backendClient) QueryClosedOrders(addrStr, product, side string, start, end, page, perPage int) (orders []types.Order,
+	err error) {
+	orders, _, err = bc.queryOrders(types.ClosedOrdersPath, "closed orders", addrStr, product, side, start, end, page,
+		perPage)
+	return
+}
+
+// QueryOpenOrdersPaged gets the open orders of a specific account on a page with the paging info
+func (bc backendClient) QueryOpenOrdersPaged(addrStr, product, side string, start, end int, pageReq sdk.PageRequest) (
+	orders []types.Order, pagedRes sdk.PagedResult, err error) {
+	if err = pageReq.ValidateBasic(); err != nil {
 		return
 	}
 
-	// field hideNoFill fixed by false
-	ordersParams := params.NewQueryOrderListParams(addrStr, product, side, page, perPageNum, int64(start), int64(end), false)
-	jsonBytes, err := bc.GetCodec().MarshalJSON(ordersParams)
+	orders, paramPage, err := bc.queryOrders(types.OpenOrdersPath, "open orders", addrStr, product, side, start, end,
+		pageReq.Page, pageReq.Limit)
 	if err != nil {
-		return orders, utils.ErrMarshalJSON(err.Error())
+		return
 	}
 
-	res, err := bc.Query(types.OpenOrdersPath, jsonBytes)
-	if err != nil {
-		return orders, utils.ErrClientQuery(err.Error())
+	return orders, sdk.NewPagedResult(pageReq, len(orders), paramPage.Total), err
+}
+
+// QueryClosedOrdersPaged gets the closed orders of a specific account on a page with the paging info
+func (bc backendClient) QueryClosedOrdersPaged(addrStr, product, side string, start, end int, pageReq sdk.PageRequest) (
+	orders []types.Order, pagedRes sdk.PagedResult, err error) {
+	if err = pageReq.ValidateBasic(); err != nil {
+		return
 	}
 
-	if err = utils.UnmarshalListResponse(res, &orders); err != nil {
-		return orders, utils.ErrFilterDataFromListResponse("open orders", err.Error())
+	orders, paramPage, err := bc.queryOrders(types.ClosedOrdersPath, "closed orders", addrStr, product, side, start, end,
+		pageReq.Page, pageReq.Limit)
+	if err != nil {
+		return
 	}
 
-	return
+	return orders, sdk.NewPagedResult(pageReq, len(orders), paramPage.Total), err
 }
 
-// QueryClosedOrders gets the closed orders of a specific account
-func (bc backendClient) QueryClosedOrders(addrStr, product, side string, start, end, page, perPage int) (orders []types.Order,
-	err error) {
+func (bc backendClient) queryOrders(path, kind, addrStr, product, side string, start, end, page, perPage int) (
+	orders []types.Order, paramPage types.ParamPage, err error) {
 	perPageNum, err := params.CheckQueryOrdersParams(addrStr, product, side, start, end, page, perPage)
 	if err != nil {
 		return
@@ -117,16 +137,16 @@ func (bc backendClient) QueryClosedOrders(addrStr, product, side string, start,
 	ordersParams := params.NewQueryOrderListParams(addrStr, product, side, page, perPageNum, int64(start), int64(end), false)
 	jsonBytes, err := bc.GetCodec().MarshalJSON(ordersParams)
 	if err != nil {
-		return orders, utils.ErrMarshalJSON(err.Error())
+		return orders, paramPage, utils.ErrMarshalJSON(err.Error())
 	}
 
-	res, err := bc.Query(types.ClosedOrdersPath, jsonBytes)
+	res, err := bc.Query(path, jsonBytes)
 	if err != nil {
-		return orders, utils.ErrClientQuery(err.Error())
+		return orders, paramPage, utils.ErrClientQuery(err.Error())
 	}
 
-	if err = utils.UnmarshalListResponse(res, &orders); err != nil {
-		return orders, utils.ErrFilterDataFromListResponse("closed orders", err.Error())
+	if paramPage, err = utils.UnmarshalListResponseWithPage(res, &orders); err != nil {
+		return orders, paramPage, utils.ErrFilterDataFromListResponse(kind, err.Error())
 	}
 
 	return
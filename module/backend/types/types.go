@@ -1,5 +1,7 @@
 package types
 
+import sdk "github.com/okex/okchain-go-sdk/types"
+
 // const
 const (
 	ModuleName = "backend"
@@ -45,11 +47,7 @@ type BaseResponse struct {
 }
 
 // ParamPage - structure of page params
-type ParamPage struct {
-	Page    int `json:"page"`
-	PerPage int `json:"per_page"`
-	Total   int `json:"total"`
-}
+type ParamPage = sdk.ParamPage
 
 // ListDataRes - structure of list data in the list response
 type ListDataRes struct {
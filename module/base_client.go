@@ -1,62 +1,273 @@
 package module
 
 import (
+	"context"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	sdk "github.com/okex/okchain-go-sdk/types"
+	sdkerrors "github.com/okex/okchain-go-sdk/types/errors"
+	"github.com/okex/okchain-go-sdk/types/proof"
+	"github.com/okex/okchain-go-sdk/types/signing"
 	"github.com/okex/okchain-go-sdk/types/tx"
-	"github.com/okex/okchain-go-sdk/utils"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/crypto/tmhash"
 	cmn "github.com/tendermint/tendermint/libs/common"
 	rpcCli "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	"strings"
+	"time"
 )
 
 const (
-	simulationPath = "/app/simulate"
+	simulationPath     = "/app/simulate"
+	storePathPrefix    = "/store/"
+	storeKeyEndPath    = "key"
+	storeKeyPathSuffix = "/" + storeKeyEndPath
+	// secp256k1SigLen is the length of a secp256k1 signature in r || s
+	secp256k1SigLen = 64
 )
 
 var _ sdk.BaseClient = (*baseClient)(nil)
 
 type baseClient struct {
 	sdk.RPCClient
-	config *sdk.ClientConfig
-	cdc    sdk.SDKCodec
+	ctx sdk.ClientContext
+	cdc sdk.SDKCodec
+	// height of the state to query, 0 for the latest
+	height int64
+	// verifier of the headers to verify the merkle proofs of the store queries against, nil to skip the proofs
+	verifier sdk.HeaderVerifier
+	// recorder of the raw responses of the queries, optional
+	recorder sdk.ResponseRecorder
+	// cache of the responses of the queries, optional
+	cache *sdk.QueryCache
+	// dryRun simulates the txs instead of broadcasting them
+	dryRun bool
 }
 
 // NewBaseClient creates a new instance of baseClient
 func NewBaseClient(cdc sdk.SDKCodec, pConfig *sdk.ClientConfig) *baseClient {
+	var rpcClient sdk.RPCClient = NewHTTP(pConfig.NodeURI, pConfig.RPCAuth)
+	if pConfig.RPCRateLimit != nil {
+		rpcClient = newRateLimitedRPCClient(rpcClient, *pConfig.RPCRateLimit)
+	}
+	if pConfig.Transport == sdk.TransportGRPC {
+		dialOpts := newGRPCDialOptions(pConfig.GRPCAddr, pConfig.GRPCAuth)
+		rpcClient = NewGRPCTransport(pConfig.GRPCAddr, rpcClient, dialOpts...)
+		// only the ABCI queries go to the gRPC query service
+		if pConfig.GRPCRateLimit != nil {
+			rpcClient = newRateLimitedRPCClient(rpcClient, *pConfig.GRPCRateLimit, methodABCIQuery)
+		}
+	}
+	return NewBaseClientWithRPC(cdc, pConfig, rpcClient)
+}
+
+// NewBaseClientWithRPC creates a new instance of baseClient on a specific rpc client as the transport
+// The config is snapshotted into the client context, and all the rpc calls are logged by the logger of it if it's set
+func NewBaseClientWithRPC(cdc sdk.SDKCodec, pConfig *sdk.ClientConfig, rpcClient sdk.RPCClient) *baseClient {
+	if pConfig.Logger != nil {
+		rpcClient = newLoggingRPCClient(rpcClient, pConfig.Logger)
+	}
 	return &baseClient{
-		RPCClient: rpcCli.NewHTTP(pConfig.NodeURI, "/websocket"),
-		config:    pConfig,
+		RPCClient: rpcClient,
+		ctx:       sdk.NewClientContext(*pConfig),
 		cdc:       cdc,
 	}
 }
 
-// Query executes the basic query
-func (bc *baseClient) Query(path string, key cmn.HexBytes) ([]byte, error) {
+// Query executes the basic query, which is served by the cache if the response is cached
+func (bc *baseClient) Query(path string, key cmn.HexBytes) (value []byte, err error) {
+	// the responses to verify are never served by the cache
+	if bc.cache == nil || bc.verifier != nil {
+		return bc.query(path, key)
+	}
+
+	if value, ok := bc.cache.Get(path, key, bc.height); ok {
+		return value, nil
+	}
+	if value, err = bc.query(path, key); err != nil {
+		return
+	}
+	bc.cache.Set(path, key, bc.height, value)
+	return
+}
+
+func (bc *baseClient) query(path string, key cmn.HexBytes) (value []byte, err error) {
+	resp, err := bc.abciQuery(path, key, bc.height, bc.verifier != nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Value, nil
+}
+
+// abciQuery executes the ABCI query at the height, which is recorded by the recorder and verified by the verifier if
+// they are set
+func (bc *baseClient) abciQuery(path string, key cmn.HexBytes, height int64, prove bool) (resp abci.ResponseQuery,
+	err error) {
 	opts := rpcCli.ABCIQueryOptions{
-		Height: 0,
-		Prove:  false,
+		Height: height,
+		Prove:  prove,
 	}
 
 	result, err := bc.ABCIQueryWithOptions(path, key, opts)
 	if err != nil {
-		return nil, err
+		return
 	}
 
-	resp := result.Response
+	resp = result.Response
+	if bc.recorder != nil {
+		raw := sdk.RawResponse{
+			Path:       path,
+			Data:       key,
+			Value:      resp.Value,
+			Height:     resp.Height,
+			Code:       resp.Code,
+			Codespace:  resp.Codespace,
+			Log:        resp.Log,
+			Proof:      resp.Proof,
+			ReceivedAt: time.Now(),
+		}
+		// recorded once the proof is verified or the query fails
+		defer func() {
+			raw.ProofVerified = bc.verifier != nil && err == nil
+			bc.recorder.RecordResponse(raw)
+		}()
+	}
 	if !resp.IsOK() {
-		return nil, errors.New(resp.Log)
+		return resp, sdkerrors.FromABCI(resp.Codespace, resp.Code, resp.Log)
+	}
+	// the historical state must be served at the exact height
+	if height != 0 && resp.Height != 0 && resp.Height != height {
+		return resp, fmt.Errorf("failed. the state is served at height %d instead of the query height %d",
+			resp.Height, height)
+	}
+	if bc.verifier != nil {
+		if err = bc.verifyProof(path, key, resp); err != nil {
+			return resp, err
+		}
 	}
 
-	return resp.Value, nil
+	return
+}
+
+// verifyProof verifies the merkle proof of the store query against the app hash in the verified header of the next
+// block, which commits the state at the height of the response
+func (bc *baseClient) verifyProof(path string, key cmn.HexBytes, resp abci.ResponseQuery) error {
+	if !strings.HasPrefix(path, storePathPrefix) || !strings.HasSuffix(path, storeKeyPathSuffix) {
+		return fmt.Errorf("failed. the query of path %s carries no merkle proof to verify", path)
+	}
+	storeName := strings.TrimSuffix(strings.TrimPrefix(path, storePathPrefix), storeKeyPathSuffix)
+
+	header, err := bc.verifier.VerifiedHeader(resp.Height + 1)
+	if err != nil {
+		return fmt.Errorf("failed. verify header at height %d error: %w", resp.Height+1, err)
+	}
+
+	return proof.VerifyStoreValue(storeName, key, resp, header.AppHash)
+}
+
+// WithConsistency returns a copy of the base client with all the queries reading the state by the consistency option
+// The height is resolved once here, so that the queries through the copy are consistent with each other
+func (bc *baseClient) WithConsistency(consistency sdk.Consistency) (sdk.BaseClient, error) {
+	var latestHeight int64
+	if consistency.NeedsLatestHeight() {
+		res, err := bc.Commit(nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed. query latest height error: %s", err)
+		}
+		latestHeight = res.Height
+	}
+
+	height, err := consistency.ResolveHeight(latestHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	pCopy := *bc
+	pCopy.height = height
+	return &pCopy, nil
+}
+
+// WithProofVerification returns a copy of the base client requesting the merkle proofs of the store queries and verifying
+// them against the app hashes of the headers verified by the verifier, which rejects the queries without proofs
+func (bc *baseClient) WithProofVerification(verifier sdk.HeaderVerifier) sdk.BaseClient {
+	pCopy := *bc
+	pCopy.verifier = verifier
+	return &pCopy
+}
+
+// WithResponseRecorder returns a copy of the base client recording the raw responses of all the queries by the
+// recorder, along with their heights and proofs, whether the queries succeed or not
+func (bc *baseClient) WithResponseRecorder(recorder sdk.ResponseRecorder) sdk.BaseClient {
+	pCopy := *bc
+	pCopy.recorder = recorder
+	return &pCopy
+}
+
+// WithQueryCache returns a copy of the base client serving the queries by the cache, where the failed queries and
+// the ones with the proof verification are never cached
+func (bc *baseClient) WithQueryCache(cache *sdk.QueryCache) sdk.BaseClient {
+	pCopy := *bc
+	pCopy.cache = cache
+	return &pCopy
+}
+
+// WithChainID returns a copy of the base client signing the txs with the chain ID
+func (bc *baseClient) WithChainID(chainID string) sdk.BaseClient {
+	pCopy := *bc
+	pCopy.ctx = bc.ctx.WithChainID(chainID)
+	return &pCopy
+}
+
+// WithDryRun returns a copy of the base client simulating all the txs instead of broadcasting them. The txs are still
+// built and signed, against the sequences given as a sandbox, which are neither reserved by the sequence manager nor
+// recorded in the signed tx store
+func (bc *baseClient) WithDryRun() sdk.BaseClient {
+	pCopy := *bc
+	pCopy.dryRun = true
+	return &pCopy
+}
+
+// IsDryRun shows whether the txs are simulated instead of broadcast
+func (bc *baseClient) IsDryRun() bool {
+	return bc.dryRun
+}
+
+// QueryHeight returns the height of the state that the queries read, 0 for the latest
+func (bc *baseClient) QueryHeight() int64 {
+	return bc.height
 }
 
 // QueryStore executes the direct query to the store
 func (bc *baseClient) QueryStore(key cmn.HexBytes, storeName, endPath string) ([]byte, error) {
-	path := fmt.Sprintf("/store/%s/%s", storeName, endPath)
+	path := fmt.Sprintf("%s%s/%s", storePathPrefix, storeName, endPath)
 	return bc.Query(path, key)
 }
 
+// QueryStoreWithProof executes the direct query to the store at the height, 0 for the query height of the client, and
+// returns the raw value along with the merkle proof of it if the node serves one, i.e. for the queries by the key
+func (bc *baseClient) QueryStoreWithProof(key cmn.HexBytes, storeName, endPath string, height int64) (
+	res sdk.StoreQueryResult, err error) {
+	if height == 0 {
+		height = bc.height
+	}
+
+	path := fmt.Sprintf("%s%s/%s", storePathPrefix, storeName, endPath)
+	resp, err := bc.abciQuery(path, key, height, endPath == storeKeyEndPath || bc.verifier != nil)
+	if err != nil {
+		return
+	}
+
+	return sdk.StoreQueryResult{
+		Value:  resp.Value,
+		Height: resp.Height,
+		Proof:  resp.Proof,
+	}, nil
+}
+
 // QuerySubspace executes the direct query to the subspace
 func (bc *baseClient) QuerySubspace(subspace []byte, storeName string) (res []cmn.KVPair, err error) {
 	resRaw, err := bc.QueryStore(subspace, storeName, "subspace")
@@ -69,26 +280,57 @@ func (bc *baseClient) QuerySubspace(subspace []byte, storeName string) (res []cm
 }
 
 // Broadcast broadcasts by different modes
+// The tx is released from the signed tx store once it's rejected or committed, whose sequence is no longer pending
 func (bc *baseClient) Broadcast(txBytes []byte, broadcastMode sdk.BroadcastMode) (res sdk.TxResponse, err error) {
+	return bc.broadcastWithContext(context.Background(), txBytes, broadcastMode)
+}
+
+// broadcastWithContext broadcasts the tx in the span of the broadcast under the ctx
+func (bc *baseClient) broadcastWithContext(ctx context.Context, txBytes []byte, broadcastMode sdk.BroadcastMode) (
+	res sdk.TxResponse, err error) {
+	_, span := bc.tracer().Start(ctx, sdk.SpanTxBroadcast,
+		sdk.NewSpanAttribute(sdk.AttributeKeyBroadcastMode, string(broadcastMode)))
+	defer func() {
+		span.SetAttributes(sdk.NewSpanAttribute(sdk.AttributeKeyTxHash, res.TxHash),
+			sdk.NewSpanAttribute(sdk.AttributeKeyCode, res.Code), sdk.NewSpanAttribute(sdk.AttributeKeyHeight, res.Height))
+		endSpan(span, err)
+	}()
+
+	if bc.dryRun {
+		return bc.simulate(txBytes)
+	}
+
+	res, err = bc.broadcast(txBytes, broadcastMode)
+	if store := bc.GetConfig().SignedTxStore; store != nil && (res.Code != 0 || res.Height > 0) {
+		if releaseErr := store.Delete(res.TxHash); releaseErr != nil && err == nil {
+			err = fmt.Errorf("failed. release the signed tx error: %w", releaseErr)
+		}
+	}
+	return
+}
+
+func (bc *baseClient) broadcast(txBytes []byte, broadcastMode sdk.BroadcastMode) (res sdk.TxResponse, err error) {
 	switch broadcastMode {
 	case sdk.BroadcastSync:
 		retBroadcastTx, err := bc.BroadcastTxSync(txBytes)
-		return sdk.NewResponseFormatBroadcastTx(retBroadcastTx), err
+		return sdk.NewResponseFormatBroadcastTx(retBroadcastTx), sdkerrors.FromRPC(err)
 
 	case sdk.BroadcastAsync:
 		retBroadcastTx, err := bc.BroadcastTxAsync(txBytes)
-		return sdk.NewResponseFormatBroadcastTx(retBroadcastTx), err
+		return sdk.NewResponseFormatBroadcastTx(retBroadcastTx), sdkerrors.FromRPC(err)
 
 	case sdk.BroadcastBlock:
 		retBroadcastTxCommit, err := bc.BroadcastTxCommit(txBytes)
 		if err != nil {
-			return sdk.NewResponseFormatBroadcastTxCommit(retBroadcastTxCommit), err
+			return sdk.NewResponseFormatBroadcastTxCommit(retBroadcastTxCommit), sdkerrors.FromRPC(err)
 		}
-		if !retBroadcastTxCommit.CheckTx.IsOK() {
-			return sdk.NewResponseFormatBroadcastTxCommit(retBroadcastTxCommit), fmt.Errorf(retBroadcastTxCommit.CheckTx.Log)
+		if checkTx := retBroadcastTxCommit.CheckTx; !checkTx.IsOK() {
+			return sdk.NewResponseFormatBroadcastTxCommit(retBroadcastTxCommit),
+				sdkerrors.FromABCI(checkTx.Codespace, checkTx.Code, checkTx.Log)
 		}
-		if !retBroadcastTxCommit.DeliverTx.IsOK() {
-			return sdk.NewResponseFormatBroadcastTxCommit(retBroadcastTxCommit), fmt.Errorf(retBroadcastTxCommit.DeliverTx.Log)
+		if deliverTx := retBroadcastTxCommit.DeliverTx; !deliverTx.IsOK() {
+			return sdk.NewResponseFormatBroadcastTxCommit(retBroadcastTxCommit),
+				sdkerrors.FromABCI(deliverTx.Codespace, deliverTx.Code, deliverTx.Log)
 		}
 		return sdk.NewResponseFormatBroadcastTxCommit(retBroadcastTxCommit), err
 
@@ -98,50 +340,409 @@ func (bc *baseClient) Broadcast(txBytes []byte, broadcastMode sdk.BroadcastMode)
 	return
 }
 
+// simulate simulates the signed tx on the latest state of the node instead of broadcasting it, and returns the result
+// of the simulation as the response, where the failed simulation is returned with its code and log along with the error
+func (bc *baseClient) simulate(txBytes []byte) (res sdk.TxResponse, err error) {
+	txHash := cmn.HexBytes(tmhash.Sum(txBytes)).String()
+	resp, err := bc.abciQuery(simulationPath, txBytes, 0, false)
+	if err != nil {
+		return sdk.TxResponse{
+			TxHash:    txHash,
+			Code:      resp.Code,
+			RawLog:    resp.Log,
+			Codespace: resp.Codespace,
+		}, err
+	}
+
+	var simResult sdk.Result
+	if err = bc.cdc.UnmarshalBinaryLengthPrefixed(resp.Value, &simResult); err != nil {
+		return res, fmt.Errorf("failed. decode the simulation result error: %w", err)
+	}
+
+	return sdk.NewResponseFormatSimulation(txHash, simResult), nil
+}
+
+// BroadcastRawTx broadcasts the pre-signed tx bytes from the external systems, e.g. HSMs or other SDKs
+// The tx is decoded before broadcasting and attached to the response. The broadcast mode in config is used if it's empty
+func (bc *baseClient) BroadcastRawTx(txBytes []byte, broadcastMode sdk.BroadcastMode) (resp sdk.TxResponse, err error) {
+	var stdTx sdk.StdTx
+	if err = bc.cdc.UnmarshalBinaryLengthPrefixed(txBytes, &stdTx); err != nil {
+		return resp, fmt.Errorf("failed. decode the raw tx error: %w", err)
+	}
+	if len(stdTx.Signatures) == 0 {
+		return resp, errors.New("failed. the raw tx is unsigned")
+	}
+
+	if len(broadcastMode) == 0 {
+		broadcastMode = bc.ctx.BroadcastMode()
+	}
+
+	resp, err = bc.Broadcast(txBytes, broadcastMode)
+	resp.Tx = stdTx
+	return
+}
+
+// Status queries the status of the node by the tendermint rpc, i.e. its node info and sync info
+func (bc *baseClient) Status() (*ctypes.ResultStatus, error) {
+	statusClient, ok := unwrapRPCClient(bc.RPCClient).(rpcCli.StatusClient)
+	if !ok {
+		return nil, errors.New("failed. the rpc client doesn't support the node status")
+	}
+
+	return statusClient.Status()
+}
+
+// UnconfirmedTxs queries up to limit txs in the mempool of the node by the tendermint rpc
+func (bc *baseClient) UnconfirmedTxs(limit int) (*ctypes.ResultUnconfirmedTxs, error) {
+	mempoolClient, ok := unwrapRPCClient(bc.RPCClient).(rpcCli.MempoolClient)
+	if !ok {
+		return nil, errors.New("failed. the rpc client doesn't support the mempool query")
+	}
+
+	return mempoolClient.UnconfirmedTxs(limit)
+}
+
+// Subscribe subscribes the events matched by the query over the websocket of the node, e.g. "tm.event='NewBlock'"
+// The subscription ends and the channel is closed when the ctx is done
+func (bc *baseClient) Subscribe(ctx context.Context, subscriber, query string) (<-chan ctypes.ResultEvent, error) {
+	rpcClient := unwrapRPCClient(bc.RPCClient)
+	eventsClient, ok := rpcClient.(rpcCli.EventsClient)
+	if !ok {
+		return nil, errors.New("failed. the rpc client doesn't support the subscription")
+	}
+
+	// the websocket connection is started lazily by the first subscription
+	if service, ok := rpcClient.(cmn.Service); ok && !service.IsRunning() {
+		if err := service.Start(); err != nil && err != cmn.ErrAlreadyStarted {
+			return nil, fmt.Errorf("failed. start the websocket connection error: %w", err)
+		}
+	}
+
+	events, err := eventsClient.Subscribe(ctx, subscriber, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed. subscribe %s error: %w", query, err)
+	}
+
+	// the channel from the rpc client is never closed, so it's forwarded to one closed with the ctx
+	out := make(chan ctypes.ResultEvent)
+	go func() {
+		defer close(out)
+		defer eventsClient.Unsubscribe(context.Background(), subscriber, query) // nolint: errcheck
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event := <-events:
+				select {
+				case out <- event:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return out, nil
+}
+
 // GetCodec gets the codec of the base client
 func (bc *baseClient) GetCodec() sdk.SDKCodec {
 	return bc.cdc
 }
 
-// GetConfig gets the client config
+// GetConfig gets a copy of the client config in the client context
 func (bc *baseClient) GetConfig() sdk.ClientConfig {
-	return *bc.config
+	return bc.ctx.Config()
+}
+
+// GetClientContext gets the immutable client context shared by the module clients
+func (bc *baseClient) GetClientContext() sdk.ClientContext {
+	return bc.ctx
 }
 
 // BuildAndBroadcast implements the TxHandler interface
 func (bc *baseClient) BuildAndBroadcast(fromName, passphrase, memo string, msgs []sdk.Msg, accNumber,
-	seqNumber uint64) (resp sdk.TxResponse, err error) {
-	stdTx, err := bc.BuildStdTx(fromName, passphrase, memo, msgs, accNumber, seqNumber)
+	seqNumber uint64, opts ...sdk.TxOption) (resp sdk.TxResponse, err error) {
+	return bc.buildAndBroadcast(func() (sdk.Signer, error) {
+		return tx.NewKeybaseSigner(fromName, passphrase)
+	}, memo, msgs, accNumber, seqNumber, opts...)
+}
+
+// BuildAndBroadcastWithSigner builds a tx signed by the signer and broadcasts it
+func (bc *baseClient) BuildAndBroadcastWithSigner(signer sdk.Signer, memo string, msgs []sdk.Msg, accNumber,
+	seqNumber uint64, opts ...sdk.TxOption) (resp sdk.TxResponse, err error) {
+	return bc.buildAndBroadcast(func() (sdk.Signer, error) {
+		return signer, nil
+	}, memo, msgs, accNumber, seqNumber, opts...)
+}
+
+// buildAndBroadcast builds the signed tx and broadcasts it in the span of the tx lifecycle. With the sequence manager
+// of the config, the tx waits for the turn of its signer and takes the sequence reserved
+func (bc *baseClient) buildAndBroadcast(getSigner func() (sdk.Signer, error), memo string, msgs []sdk.Msg, accNumber,
+	seqNumber uint64, opts ...sdk.TxOption) (resp sdk.TxResponse, err error) {
+	options := sdk.NewTxOptions(opts...)
+	ctx, span := bc.tracer().Start(txContext(options), sdk.SpanTxLifecycle, sdk.MsgSpanAttributes(msgs)...)
+	defer func() { endSpan(span, err) }()
+
+	getSigner = refuseWatchOnly(getSigner)
+	var lease *sdk.SequenceLease
+	// the sequence of a dry run is taken as given
+	if manager := bc.GetConfig().SequenceManager; manager != nil && !bc.dryRun {
+		var signer sdk.Signer
+		if signer, err = getSigner(); err != nil {
+			return
+		}
+		if lease, err = manager.Acquire(ctx, sdk.GetSignerAddress(signer), seqNumber); err != nil {
+			return
+		}
+		defer func() { lease.Release(resp, err) }()
+
+		seqNumber = lease.Sequence
+		getSigner = func() (sdk.Signer, error) {
+			return signer, nil
+		}
+	}
+
+	// the spans of building and signing are the children of the lifecycle
+	stdTx, err := bc.buildStdTx(getSigner, memo, msgs, accNumber, seqNumber, append(opts, sdk.WithContext(ctx))...)
+	if err != nil {
+		return resp, fmt.Errorf("failed. build stdTx error: %w", err)
+	}
+	signedTx, err := bc.encodeSignedTx(stdTx)
 	if err != nil {
-		return resp, fmt.Errorf("failed. build stdTx error: %s", err)
+		return
 	}
+	span.SetAttributes(sdk.NewSpanAttribute(sdk.AttributeKeyTxHash, signedTx.Hash))
 
-	bytes, err := bc.cdc.MarshalBinaryLengthPrefixed(stdTx)
+	broadcastMode := bc.ctx.BroadcastMode()
+	resp, err = bc.broadcastWithContext(ctx, signedTx.Bytes, broadcastMode)
+	// the turn of the signer is passed on by the result of the broadcast, without waiting for the confirmation
+	if lease != nil {
+		lease.Release(resp, err)
+	}
+	if err != nil || resp.Code != 0 {
+		return
+	}
+	if options.ConfirmTimeout > 0 && broadcastMode != sdk.BroadcastBlock && !bc.dryRun {
+		return bc.confirm(ctx, signedTx.Hash, options.ConfirmTimeout)
+	}
+	return
+}
+
+// confirmPollInterval is the interval to poll the node for a tx broadcast until it's committed
+var confirmPollInterval = time.Second
+
+// confirm waits for the tx to be committed in the span of the confirmation, and returns its result in the block
+// The tx is released from the signed tx store once it's committed
+func (bc *baseClient) confirm(ctx context.Context, txHash string, timeout time.Duration) (res sdk.TxResponse,
+	err error) {
+	_, span := bc.tracer().Start(ctx, sdk.SpanTxConfirm, sdk.NewSpanAttribute(sdk.AttributeKeyTxHash, txHash))
+	defer func() {
+		span.SetAttributes(sdk.NewSpanAttribute(sdk.AttributeKeyCode, res.Code),
+			sdk.NewSpanAttribute(sdk.AttributeKeyHeight, res.Height))
+		endSpan(span, err)
+	}()
+
+	hash, err := hex.DecodeString(txHash)
+	if err != nil {
+		return res, fmt.Errorf("failed. decode tx hash %s error: %w", txHash, err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	ticker := time.NewTicker(confirmPollInterval)
+	defer ticker.Stop()
+	for {
+		// the tx not found yet is pending in the mempool
+		if resTx, txErr := bc.Tx(hash, false); txErr == nil {
+			res = sdk.NewResponseFormatResultTx(resTx)
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return sdk.TxResponse{TxHash: txHash}, sdkerrors.Wrapf(sdkerrors.ErrTxTimeout,
+				"failed. tx %s not committed in %s", txHash, timeout)
+		case <-ticker.C:
+		}
+	}
+
+	if store := bc.GetConfig().SignedTxStore; store != nil {
+		if err = store.Delete(res.TxHash); err != nil {
+			return res, fmt.Errorf("failed. release the signed tx error: %w", err)
+		}
+	}
+	if res.Code != 0 {
+		return res, sdkerrors.FromABCI(res.Codespace, res.Code, res.RawLog)
+	}
+	return
+}
+
+// BuildSignedTx builds a signed tx and encodes it with its hash, without broadcasting
+func (bc *baseClient) BuildSignedTx(fromName, passphrase, memo string, msgs []sdk.Msg, accNumber, seqNumber uint64,
+	opts ...sdk.TxOption) (signedTx sdk.SignedTx, err error) {
+	stdTx, err := bc.buildStdTx(refuseWatchOnly(func() (sdk.Signer, error) {
+		return tx.NewKeybaseSigner(fromName, passphrase)
+	}), memo, msgs, accNumber, seqNumber, opts...)
 	if err != nil {
-		return resp, fmt.Errorf("failed. encoded stdTx error: %s", err)
+		return signedTx, fmt.Errorf("failed. build stdTx error: %w", err)
 	}
 
-	return bc.Broadcast(bytes, bc.GetConfig().BroadcastMode)
+	return bc.encodeSignedTx(stdTx)
 }
 
-// BuildAndSign builds std sign context and sign it
-func (bc *baseClient) BuildStdTx(fromName, passphrase, memo string, msgs []sdk.Msg, accNumber, seqNumber uint64) (
-	stdTx sdk.StdTx, err error) {
+// BuildSignedTxWithSigner builds a tx signed by the signer and encodes it with its hash, without broadcasting
+func (bc *baseClient) BuildSignedTxWithSigner(signer sdk.Signer, memo string, msgs []sdk.Msg, accNumber,
+	seqNumber uint64, opts ...sdk.TxOption) (signedTx sdk.SignedTx, err error) {
+	stdTx, err := bc.buildStdTx(refuseWatchOnly(func() (sdk.Signer, error) {
+		return signer, nil
+	}), memo, msgs, accNumber, seqNumber, opts...)
+	if err != nil {
+		return signedTx, fmt.Errorf("failed. build stdTx error: %w", err)
+	}
+
+	return bc.encodeSignedTx(stdTx)
+}
+
+func (bc *baseClient) encodeSignedTx(stdTx sdk.StdTx) (signedTx sdk.SignedTx, err error) {
+	return signing.EncodeStdTx(bc.cdc, stdTx)
+}
+
+// BuildStdTx builds std sign context and signs it by the key in the keybase. The tx of a watch-only key is left
+// unsigned to be signed by an external signer
+func (bc *baseClient) BuildStdTx(fromName, passphrase, memo string, msgs []sdk.Msg, accNumber, seqNumber uint64,
+	opts ...sdk.TxOption) (stdTx sdk.StdTx, err error) {
+	return bc.buildStdTx(func() (sdk.Signer, error) {
+		return tx.NewKeybaseSigner(fromName, passphrase)
+	}, memo, msgs, accNumber, seqNumber, opts...)
+}
+
+// BuildStdTxWithSigner builds std sign context and signs it by the signer, e.g. a HSM or a remote KMS
+func (bc *baseClient) BuildStdTxWithSigner(signer sdk.Signer, memo string, msgs []sdk.Msg, accNumber,
+	seqNumber uint64, opts ...sdk.TxOption) (stdTx sdk.StdTx, err error) {
+	return bc.buildStdTx(func() (sdk.Signer, error) {
+		return signer, nil
+	}, memo, msgs, accNumber, seqNumber, opts...)
+}
+
+// buildStdTx builds the std tx, where the signer is got after the recipients are screened. The tx of a watch-only
+// signer is built unsigned
+func (bc *baseClient) buildStdTx(getSigner func() (sdk.Signer, error), memo string, msgs []sdk.Msg, accNumber,
+	seqNumber uint64, opts ...sdk.TxOption) (stdTx sdk.StdTx, err error) {
 	config := bc.GetConfig()
-	if len(config.ChainID) == 0 {
-		return stdTx, errors.New("failed. empty chain ID")
+	options := sdk.NewTxOptions(opts...)
+	ctx, span := bc.tracer().Start(txContext(options), sdk.SpanTxBuild, sdk.MsgSpanAttributes(msgs)...)
+	defer func() { endSpan(span, err) }()
+
+	signer, payerSigner, signMsg, err := bc.buildSignMsg(getSigner, memo, msgs, accNumber, seqNumber, options)
+	if err != nil {
+		return
+	}
+
+	signerAddr := sdk.GetSignerAddress(signer)
+	span.SetAttributes(sdk.NewSpanAttribute(sdk.AttributeKeySigner, signerAddr.String()),
+		sdk.NewSpanAttribute(sdk.AttributeKeySequence, seqNumber))
+	if sdk.IsWatchOnlySigner(signer) {
+		stdTx = sdk.NewStdTx(signMsg.Msgs, signMsg.Fee, nil, signMsg.Memo)
+		stdTx.TimeoutHeight = signMsg.TimeoutHeight
+		return stdTx, nil
+	}
+
+	signStart := time.Now()
+	sigs, err := bc.sign(ctx, signer, payerSigner, signMsg, options.FeePayer)
+	if err != nil {
+		if config.Logger != nil {
+			config.Logger.Error("sign tx failed", "signer", signerAddr.String(), "account_number", accNumber,
+				"sequence", seqNumber, "err", err)
+		}
+		return
+	}
+
+	stdTx = sdk.NewStdTx(signMsg.Msgs, signMsg.Fee, sigs, signMsg.Memo)
+	stdTx.TimeoutHeight = signMsg.TimeoutHeight
+	if (config.SignedTxStore == nil || bc.dryRun) && config.Logger == nil && config.Tracer == nil {
+		return stdTx, nil
+	}
+
+	signedTx, err := bc.encodeSignedTx(stdTx)
+	if err != nil {
+		return stdTx, err
+	}
+	span.SetAttributes(sdk.NewSpanAttribute(sdk.AttributeKeyTxHash, signedTx.Hash))
+	if config.Logger != nil {
+		config.Logger.Info("tx signed", "signer", signerAddr.String(), "account_number", accNumber, "sequence",
+			seqNumber, "tx_hash", signedTx.Hash, "duration", time.Since(signStart))
+	}
+	if bc.dryRun {
+		return stdTx, nil
+	}
+	return stdTx, sdk.RecordSignedTx(config, signerAddr, seqNumber, signedTx.Hash)
+}
+
+// buildSignMsg builds the sign msg of the tx with the fee estimated or fixed, after the msgs, the recipients, the fee
+// and the sequence are checked, where the signer is got after the recipients are screened. The tx is approved by the
+// pre-sign hook at last
+func (bc *baseClient) buildSignMsg(getSigner func() (sdk.Signer, error), memo string, msgs []sdk.Msg, accNumber,
+	seqNumber uint64, options sdk.TxOptions) (signer, payerSigner sdk.Signer, signMsg sdk.StdSignMsg, err error) {
+	config := bc.GetConfig()
+
+	if len(bc.ctx.ChainID()) == 0 {
+		return nil, nil, signMsg, errors.New("failed. empty chain ID")
+	}
+	if bc.ctx.SignMode() != sdk.SignModeAminoJSON {
+		return nil, nil, signMsg, fmt.Errorf("failed. unsupported sign mode: %s", bc.ctx.SignMode())
+	}
+	// the msgs of the caller are kept in the order given unless reordered explicitly
+	if len(options.MsgOrder) > 0 {
+		if msgs, err = sdk.ReorderMsgs(msgs, options.MsgOrder...); err != nil {
+			return
+		}
+	} else {
+		msgs = append([]sdk.Msg(nil), msgs...)
+	}
+	if err = sdk.ValidateTxBasic(memo, msgs); err != nil {
+		return
+	}
+	if err = sdk.ValidateMsgOrder(msgs); err != nil {
+		return
+	}
+	if err = bc.checkTimeoutHeight(options.TimeoutHeight); err != nil {
+		return
+	}
+	if err = checkFeePayer(config, options); err != nil {
+		return
+	}
+
+	if config.Screener != nil {
+		if err = config.Screener.Screen(sdk.GetRecipients(msgs)); err != nil {
+			return nil, nil, signMsg, fmt.Errorf("failed. address screening error: %w", err)
+		}
+	}
+
+	if signer, err = getSigner(); err != nil {
+		return
+	}
+	if payerSigner, err = getFeePayerSigner(options.FeePayer); err != nil {
+		return
+	}
+	if sdk.IsWatchOnlySigner(signer) && payerSigner != nil {
+		return nil, nil, signMsg, sdkerrors.Wrapf(sdkerrors.ErrWatchOnly,
+			"failed. the unsigned tx of %s is unable to be signed by the fee payer before its signer",
+			sdk.GetSignerAddress(signer))
 	}
 
 	var stdFee sdk.StdFee
-	if config.GasPrices.IsZero() {
+	if options.Fee != nil {
+		stdFee = *options.Fee
+	} else if config.GasPrices.IsZero() {
 		// fixed fees
 		stdFee = sdk.NewStdFee(config.Gas, config.Fees)
 	} else {
 		// auto gas calculation
 		var txBytes []byte
-		txBytes, err = bc.BuildTxForSim(msgs, memo, accNumber, seqNumber)
+		txBytes, err = bc.buildTxForSim(msgs, memo, options)
 		if err != nil {
-			return stdTx, fmt.Errorf("failed. build tx for simulation error: %s", err)
+			return nil, nil, signMsg, fmt.Errorf("failed. build tx for simulation error: %w", err)
 		}
 
 		stdFee, err = bc.CalculateGas(txBytes)
@@ -150,21 +751,173 @@ func (bc *baseClient) BuildStdTx(fromName, passphrase, memo string, msgs []sdk.M
 		}
 	}
 
-	signMsg := sdk.StdSignMsg{
-		ChainID:       config.ChainID,
+	signerAddr := sdk.GetSignerAddress(signer)
+	if err = sdk.EnforceFeeFloor(config, signerAddr, stdFee.Amount, options.FeeFloorOverrideReason); err != nil {
+		return
+	}
+	// the sandbox sequence of a dry run is never checked against the pending signed txs
+	if !bc.dryRun {
+		if err = sdk.CheckSequenceReuse(config, signerAddr, seqNumber, options.AllowSequenceReuse); err != nil {
+			return
+		}
+	}
+
+	if payerSigner != nil {
+		stdFee.Payer = sdk.GetSignerAddress(payerSigner)
+	}
+	stdFee.Granter = options.FeeGranter
+	var timeoutHeight uint64
+	if config.TimeoutHeightSupported {
+		timeoutHeight = options.TimeoutHeight
+	}
+	if err = bc.checkTxSize(msgs, stdFee, memo, timeoutHeight, signer, payerSigner); err != nil {
+		return
+	}
+
+	signMsg = sdk.StdSignMsg{
+		ChainID:       bc.ctx.ChainID(),
 		AccountNumber: accNumber,
 		Sequence:      seqNumber,
 		Memo:          memo,
 		Msgs:          msgs,
 		Fee:           stdFee,
+		TimeoutHeight: timeoutHeight,
+	}
+	err = sdk.EnforcePreSignHook(config, sdk.PreSignRequest{
+		ChainID:       signMsg.ChainID,
+		Signer:        signerAddr,
+		AccountNumber: accNumber,
+		Sequence:      seqNumber,
+		Msgs:          msgs,
+		Fee:           stdFee,
+		Memo:          memo,
+	})
+	return
+}
+
+// BuildSignDoc builds the sign doc of the tx to be signed by the signer without signing it, whose sign bytes are
+// exactly what the signer signs, e.g. for a human or a policy engine to approve the payload before the signature is
+// produced. The signer could be watch-only, e.g. of a custody account
+func (bc *baseClient) BuildSignDoc(signer sdk.Signer, memo string, msgs []sdk.Msg, accNumber, seqNumber uint64,
+	opts ...sdk.TxOption) (sdk.SignDoc, error) {
+	_, _, signMsg, err := bc.buildSignMsg(func() (sdk.Signer, error) {
+		return signer, nil
+	}, memo, msgs, accNumber, seqNumber, sdk.NewTxOptions(opts...))
+	if err != nil {
+		return sdk.SignDoc{}, err
 	}
 
-	sigBytes, err := tx.MakeSignature(fromName, passphrase, signMsg)
+	return sdk.NewSignDoc(signMsg), nil
+}
+
+// checkTxSize checks the size of the tx encoded with the placeholder signatures of the signers, which is the size of the
+// tx signed
+func (bc *baseClient) checkTxSize(msgs []sdk.Msg, stdFee sdk.StdFee, memo string, timeoutHeight uint64,
+	signers ...sdk.Signer) error {
+	var sigs []sdk.StdSignature
+	for _, signer := range signers {
+		if signer != nil {
+			// the public key of a watch-only signer may be unknown
+			pubKey := signer.PubKey()
+			if pubKey == nil {
+				pubKey = secp256k1.PubKeySecp256k1{}
+			}
+			sigs = append(sigs, sdk.StdSignature{
+				PubKey:    pubKey,
+				Signature: make([]byte, secp256k1SigLen),
+			})
+		}
+	}
+
+	stdTx := sdk.NewStdTx(msgs, stdFee, sigs, memo)
+	stdTx.TimeoutHeight = timeoutHeight
+	bytes, err := bc.GetCodec().MarshalBinaryLengthPrefixed(stdTx)
+	if err != nil {
+		return fmt.Errorf("failed. encode tx for the size check error: %w", err)
+	}
+	return sdk.CheckTxSize(bc.GetConfig(), len(bytes))
+}
+
+// checkTimeoutHeight refuses the tx with the timeout height reached by the latest block, which would never be
+// committed on the chains supporting the timeout heights, or land too late on the others
+func (bc *baseClient) checkTimeoutHeight(timeoutHeight uint64) error {
+	if timeoutHeight == 0 {
+		return nil
+	}
+
+	res, err := bc.Commit(nil)
+	if err != nil {
+		return fmt.Errorf("failed. query latest height for the timeout height error: %w", err)
+	}
+	if uint64(res.Height) >= timeoutHeight {
+		return sdkerrors.Wrapf(sdkerrors.ErrTimeoutHeight, "failed. latest height %d reached the timeout height %d",
+			res.Height, timeoutHeight)
+	}
+	return nil
+}
+
+// checkFeePayer refuses the fee payer and the fee granter of the tx on a chain not supporting them
+func checkFeePayer(config sdk.ClientConfig, options sdk.TxOptions) error {
+	if config.FeePayerSupported || (options.FeePayer == nil && options.FeeGranter.Empty()) {
+		return nil
+	}
+
+	return sdkerrors.Wrapf(sdkerrors.ErrFeePayerUnsupported,
+		"failed. the fee payer and the fee granter are unsupported by chain %s", config.ChainID)
+}
+
+// sign signs the tx by the signer, and by the fee payer with its own account number and sequence if any, in the span
+// of the signing
+func (bc *baseClient) sign(ctx context.Context, signer, payerSigner sdk.Signer, signMsg sdk.StdSignMsg,
+	payer *sdk.FeePayer) (sigs []sdk.StdSignature, err error) {
+	_, span := bc.tracer().Start(ctx, sdk.SpanTxSign)
+	defer func() { endSpan(span, err) }()
+
+	sig, err := tx.MakeSignatureWithSigner(signer, signMsg)
 	if err != nil {
 		return
 	}
+	sigs = append(sigs, sig)
 
-	return sdk.NewStdTx(signMsg.Msgs, signMsg.Fee, []sdk.StdSignature{sigBytes}, signMsg.Memo), err
+	if payerSigner != nil {
+		payerSignMsg := signMsg
+		payerSignMsg.AccountNumber, payerSignMsg.Sequence = payer.AccountNumber, payer.Sequence
+		payerSig, err := tx.MakeSignatureWithSigner(payerSigner, payerSignMsg)
+		if err != nil {
+			return nil, fmt.Errorf("failed. sign by the fee payer error: %w", err)
+		}
+		sigs = append(sigs, payerSig)
+	}
+	return
+}
+
+// refuseWatchOnly wraps the getter of the signer to refuse a watch-only signer, whose tx is never signed
+func refuseWatchOnly(getSigner func() (sdk.Signer, error)) func() (sdk.Signer, error) {
+	return func() (sdk.Signer, error) {
+		signer, err := getSigner()
+		if err == nil && sdk.IsWatchOnlySigner(signer) {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrWatchOnly,
+				"failed. %s is watch-only, whose tx is built unsigned by BuildStdTx for an external signer",
+				sdk.GetSignerAddress(signer))
+		}
+		return signer, err
+	}
+}
+
+// getFeePayerSigner returns the signer of the fee payer, which is nil without a fee payer
+func getFeePayerSigner(payer *sdk.FeePayer) (sdk.Signer, error) {
+	if payer == nil {
+		return nil, nil
+	}
+	if payer.Signer != nil {
+		return payer.Signer, nil
+	}
+
+	signer, err := tx.NewKeybaseSigner(payer.Name, payer.Passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("failed. get the key info of the fee payer error: %w", err)
+	}
+	return signer, nil
 }
 
 // BuildUnsignedStdTxOffline builds a stdTx without signature
@@ -180,7 +933,7 @@ func (bc *baseClient) CalculateGas(txBytes []byte) (stdFee sdk.StdFee, err error
 	// estimate the gas by a simulation query
 	rawRes, err := bc.Query(simulationPath, txBytes)
 	if err != nil {
-		return stdFee, utils.ErrClientQuery(err.Error())
+		return stdFee, fmt.Errorf("failed. ok client query error: %s", err)
 	}
 
 	// get simulation result
@@ -196,10 +949,26 @@ func (bc *baseClient) CalculateGas(txBytes []byte) (stdFee sdk.StdFee, err error
 
 // BuildTxForSim creates a StdSignMsg and encodes a transaction with the StdSignMsg for tx simulation
 func (bc *baseClient) BuildTxForSim(msgs []sdk.Msg, memo string, accNumber, seqNumber uint64) ([]byte, error) {
+	return bc.buildTxForSim(msgs, memo, sdk.NewTxOptions())
+}
+
+func (bc *baseClient) buildTxForSim(msgs []sdk.Msg, memo string, options sdk.TxOptions) ([]byte, error) {
 	config := bc.GetConfig()
+	stdFee := calculateStdFee(config.GasPrices, config.Gas)
+	sigs := []sdk.StdSignature{{}}
+	if options.FeePayer != nil {
+		// an extra signature of the fee payer is taken into the gas estimation
+		sigs = append(sigs, sdk.StdSignature{})
+		payerSigner, err := getFeePayerSigner(options.FeePayer)
+		if err != nil {
+			return nil, err
+		}
+		stdFee.Payer = sdk.GetSignerAddress(payerSigner)
+	}
+	stdFee.Granter = options.FeeGranter
 
 	// build std tx for simulation
-	simStdTx := sdk.NewStdTx(msgs, calculateStdFee(config.GasPrices, config.Gas), []sdk.StdSignature{{}}, memo)
+	simStdTx := sdk.NewStdTx(msgs, stdFee, sigs, memo)
 	return bc.GetCodec().MarshalBinaryLengthPrefixed(simStdTx)
 }
 
@@ -0,0 +1,1065 @@
+package module
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/okex/okchain-go-sdk/mocks"
+	distrtypes "github.com/okex/okchain-go-sdk/module/distribution/types"
+	stakingtypes "github.com/okex/okchain-go-sdk/module/staking/types"
+	tokentypes "github.com/okex/okchain-go-sdk/module/token/types"
+	"github.com/okex/okchain-go-sdk/testutil"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	sdkerrors "github.com/okex/okchain-go-sdk/types/errors"
+	"github.com/okex/okchain-go-sdk/types/proof"
+	"github.com/okex/okchain-go-sdk/types/tx"
+	"github.com/okex/okchain-go-sdk/utils"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+const (
+	addr    = "okchain1dcsxvxgj374dv3wt9szflf9nz6342juzzkjnlz"
+	recAddr = "okchain1wux20ku36ntgtxpgm7my9863xy3fqs0xgh66d7"
+)
+
+func TestBaseClient_QueryConformance(t *testing.T) {
+	config, err := sdk.NewClientConfig("testURL", "testChain", sdk.BroadcastBlock, "", 200000,
+		1.1, "0.00000001okt")
+	require.NoError(t, err)
+
+	mocks.RunQueryConformanceSuite(t, func(cdc sdk.SDKCodec, backend *mocks.ConformanceBackend) sdk.ClientQuery {
+		return NewBaseClientWithRPC(cdc, &config, backend)
+	})
+}
+
+func TestBaseClient_AddressScreening(t *testing.T) {
+	config, err := sdk.NewClientConfig("testURL", "testChain", sdk.BroadcastBlock, "0.01okt", 200000,
+		0, "")
+	require.NoError(t, err)
+
+	fromAddr, err := sdk.AccAddressFromBech32(addr)
+	require.NoError(t, err)
+	toAddr, err := sdk.AccAddressFromBech32(recAddr)
+	require.NoError(t, err)
+	coins, err := sdk.ParseDecCoins("10.24okt")
+	require.NoError(t, err)
+	msgs := []sdk.Msg{tokentypes.NewMsgTokenSend(fromAddr, toAddr, coins)}
+
+	config.Screener = sdk.NewDenylistScreener(toAddr)
+	bc := NewBaseClientWithRPC(mocks.NewFixtureCodec(), &config, mocks.NewConformanceBackend())
+	_, err = bc.BuildAndBroadcast("alice", "12345678", "my memo", msgs, 1, 2)
+	require.True(t, errors.Is(err, sdkerrors.ErrAddressScreened))
+
+	config.Screener = sdk.NewAllowlistScreener(fromAddr)
+	bc = NewBaseClientWithRPC(mocks.NewFixtureCodec(), &config, mocks.NewConformanceBackend())
+	_, err = bc.BuildStdTx("alice", "12345678", "my memo", msgs, 1, 2)
+	require.True(t, errors.Is(err, sdkerrors.ErrAddressScreened))
+
+	// screening passed and it fails on the signing without the key
+	config.Screener = sdk.NewAllowlistScreener(toAddr)
+	bc = NewBaseClientWithRPC(mocks.NewFixtureCodec(), &config, mocks.NewConformanceBackend())
+	_, err = bc.BuildStdTx("alice", "12345678", "my memo", msgs, 1, 2)
+	require.Error(t, err)
+	require.False(t, errors.Is(err, sdkerrors.ErrAddressScreened))
+}
+
+func TestBaseClient_PreSignHook(t *testing.T) {
+	config, err := sdk.NewClientConfig("testURL", "testChain", sdk.BroadcastBlock, "0.01okt", 200000,
+		0, "")
+	require.NoError(t, err)
+
+	accounts := testutil.NewAccounts(t, testutil.FixtureAccountsSeed, 2)
+	user, recipient := accounts[0], accounts[1]
+	maxAmount := sdk.MustNewDecFromStr("100")
+	errTooMuch := errors.New("too much okt sent")
+	var reqs []sdk.PreSignRequest
+	config.PreSignHook = sdk.PreSignHooks{
+		sdk.PreSignHookFunc(func(req sdk.PreSignRequest) error {
+			reqs = append(reqs, req)
+			return nil
+		}),
+		// never send more than 100okt
+		sdk.PreSignHookFunc(func(req sdk.PreSignRequest) error {
+			for _, msg := range req.Msgs {
+				if send, ok := msg.(tokentypes.MsgSend); ok && send.Amount.AmountOf("okt").GT(maxAmount) {
+					return errTooMuch
+				}
+			}
+			return nil
+		}),
+	}
+	bc := NewBaseClientWithRPC(mocks.NewFixtureCodec(), &config, mocks.NewConformanceBackend())
+
+	coins, err := sdk.ParseDecCoins("10.24okt")
+	require.NoError(t, err)
+	msgs := []sdk.Msg{tokentypes.NewMsgTokenSend(user.Address, recipient.Address, coins)}
+	signer := &remoteSigner{Signer: user.Signer()}
+	_, err = bc.BuildStdTxWithSigner(signer, "my memo", msgs, user.AccountNumber, 2)
+	require.NoError(t, err)
+	require.Len(t, signer.signed, 1)
+	require.Len(t, reqs, 1)
+	require.Equal(t, sdk.PreSignRequest{
+		ChainID:       config.ChainID,
+		Signer:        user.Address,
+		AccountNumber: user.AccountNumber,
+		Sequence:      2,
+		Msgs:          msgs,
+		Fee:           sdk.NewStdFee(config.Gas, config.Fees),
+		Memo:          "my memo",
+	}, reqs[0])
+
+	// vetoed without signing
+	coins, err = sdk.ParseDecCoins("1024okt")
+	require.NoError(t, err)
+	msgs = []sdk.Msg{tokentypes.NewMsgTokenSend(user.Address, recipient.Address, coins)}
+	_, err = bc.BuildStdTxWithSigner(signer, "my memo", msgs, user.AccountNumber, 3)
+	require.True(t, errors.Is(err, sdkerrors.ErrSignVetoed))
+	// the error of the hook is kept
+	require.True(t, errors.Is(err, errTooMuch))
+	require.Contains(t, err.Error(), errTooMuch.Error())
+	require.Len(t, signer.signed, 1)
+	_, err = bc.BuildSignDoc(signer, "my memo", msgs, user.AccountNumber, 3)
+	require.True(t, errors.Is(err, sdkerrors.ErrSignVetoed))
+}
+
+// feeAuditLog records the overrides of the fee floor in memory
+type feeAuditLog struct {
+	overrides []sdk.FeeFloorOverride
+	err       error
+}
+
+func (l *feeAuditLog) RecordFeeFloorOverride(override sdk.FeeFloorOverride) error {
+	if l.err != nil {
+		return l.err
+	}
+	l.overrides = append(l.overrides, override)
+	return nil
+}
+
+func TestBaseClient_FeeFloor(t *testing.T) {
+	config, err := sdk.NewClientConfig("testURL", "testChain", sdk.BroadcastBlock, "0.01okt", 200000,
+		0, "")
+	require.NoError(t, err)
+	config.MinFees, err = sdk.ParseDecCoins("0.02okt")
+	require.NoError(t, err)
+	auditLog := new(feeAuditLog)
+	config.FeeAuditLog = auditLog
+	bc := NewBaseClientWithRPC(mocks.NewFixtureCodec(), &config, mocks.NewConformanceBackend())
+
+	user := testutil.NewAccounts(t, testutil.FixtureAccountsSeed, 1)[0]
+	coins, err := sdk.ParseDecCoins("10.24okt")
+	require.NoError(t, err)
+	msgs := []sdk.Msg{tokentypes.NewMsgTokenSend(user.Address, user.Address, coins)}
+
+	_, err = bc.BuildAndBroadcastWithSigner(user.Signer(), "my memo", msgs, 1, 2)
+	require.True(t, errors.Is(err, sdkerrors.ErrFeeUnderFloor))
+	_, err = bc.BuildStdTxWithSigner(user.Signer(), "my memo", msgs, 1, 2, sdk.WithFeeFloorOverride(" "))
+	require.True(t, errors.Is(err, sdkerrors.ErrFeeUnderFloor))
+	require.Empty(t, auditLog.overrides)
+
+	// overridden with the reason recorded
+	stdTx, err := bc.BuildStdTxWithSigner(user.Signer(), "my memo", msgs, 1, 2,
+		sdk.WithFeeFloorOverride("rescue the stuck withdrawal"))
+	require.NoError(t, err)
+	require.Equal(t, config.Fees, stdTx.Fee.Amount)
+	require.Len(t, auditLog.overrides, 1)
+	require.Equal(t, "rescue the stuck withdrawal", auditLog.overrides[0].Reason)
+	require.True(t, user.Address.Equals(auditLog.overrides[0].Signer))
+	require.Equal(t, config.MinFees, auditLog.overrides[0].MinFees)
+
+	// the override isn't allowed without the audit record
+	auditLog.err = errors.New("default error")
+	_, err = bc.BuildStdTxWithSigner(user.Signer(), "my memo", msgs, 1, 2,
+		sdk.WithFeeFloorOverride("rescue the stuck withdrawal"))
+	require.Error(t, err)
+
+	// fees reaching the floor
+	config.MinFees, err = sdk.ParseDecCoins("0.01okt")
+	require.NoError(t, err)
+	bc = NewBaseClientWithRPC(mocks.NewFixtureCodec(), &config, mocks.NewConformanceBackend())
+	_, err = bc.BuildStdTxWithSigner(user.Signer(), "my memo", msgs, 1, 2)
+	require.NoError(t, err)
+
+	// the fixed fee under the floor
+	fixedFees, err := sdk.ParseDecCoins("0.005okt")
+	require.NoError(t, err)
+	_, err = bc.BuildStdTxWithSigner(user.Signer(), "my memo", msgs, 1, 2, sdk.WithFee(sdk.NewStdFee(100000, fixedFees)))
+	require.True(t, errors.Is(err, sdkerrors.ErrFeeUnderFloor))
+	fixedFees, err = sdk.ParseDecCoins("0.015okt")
+	require.NoError(t, err)
+	stdTx, err = bc.BuildStdTxWithSigner(user.Signer(), "my memo", msgs, 1, 2,
+		sdk.WithFee(sdk.NewStdFee(100000, fixedFees)))
+	require.NoError(t, err)
+	require.Equal(t, fixedFees, stdTx.Fee.Amount)
+	require.Equal(t, uint64(100000), stdTx.Fee.Gas)
+}
+
+func TestBaseClient_SignedTxStore(t *testing.T) {
+	config, err := sdk.NewClientConfig("testURL", "testChain", sdk.BroadcastSync, "0.01okt", 200000,
+		0, "")
+	require.NoError(t, err)
+	config.SignedTxStore = sdk.NewInMemorySignedTxStore()
+	backend := &broadcastBackend{ConformanceBackend: mocks.NewConformanceBackend()}
+	bc := NewBaseClientWithRPC(mocks.NewFixtureCodec(), &config, backend)
+
+	user := testutil.NewAccounts(t, testutil.FixtureAccountsSeed, 1)[0]
+	coins, err := sdk.ParseDecCoins("10.24okt")
+	require.NoError(t, err)
+	msgs := []sdk.Msg{tokentypes.NewMsgTokenSend(user.Address, user.Address, coins)}
+
+	signedTx, err := bc.BuildSignedTxWithSigner(user.Signer(), "my memo", msgs, 1, 2)
+	require.NoError(t, err)
+	_, err = bc.BuildSignedTxWithSigner(user.Signer(), "another memo", msgs, 1, 2)
+	require.True(t, errors.Is(err, sdkerrors.ErrSequenceReused))
+	_, err = bc.BuildSignedTxWithSigner(user.Signer(), "another memo", msgs, 1, 3)
+	require.NoError(t, err)
+
+	// reused on purpose
+	_, err = bc.BuildSignedTxWithSigner(user.Signer(), "another memo", msgs, 1, 2, sdk.WithSequenceReuse())
+	require.NoError(t, err)
+
+	// still pending after the sync broadcast
+	_, err = bc.Broadcast(signedTx.Bytes, sdk.BroadcastSync)
+	require.NoError(t, err)
+	records, err := config.SignedTxStore.Pending(config.ChainID, user.Address, 2, time.Now())
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+
+	// released once committed
+	_, err = bc.Broadcast(signedTx.Bytes, sdk.BroadcastBlock)
+	require.Error(t, err)
+	records, err = config.SignedTxStore.Pending(config.ChainID, user.Address, 2, time.Now())
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	require.NotEqual(t, signedTx.Hash, records[0].Hash)
+
+	// expired
+	config.SignedTxTTL = time.Millisecond
+	bc = NewBaseClientWithRPC(mocks.NewFixtureCodec(), &config, backend)
+	_, err = bc.BuildSignedTxWithSigner(user.Signer(), "my memo", msgs, 1, 4)
+	require.NoError(t, err)
+	time.Sleep(2 * time.Millisecond)
+	_, err = bc.BuildSignedTxWithSigner(user.Signer(), "another memo", msgs, 1, 4)
+	require.NoError(t, err)
+}
+
+func TestBaseClient_ClientContext(t *testing.T) {
+	config, err := sdk.NewClientConfig("testURL", "testChain", sdk.BroadcastBlock, "0.01okt", 200000,
+		0, "")
+	require.NoError(t, err)
+	bc := NewBaseClientWithRPC(mocks.NewFixtureCodec(), &config, mocks.NewConformanceBackend())
+
+	// another client on another chain with another fee in the same process
+	anotherConfig := config
+	anotherConfig.ChainID = "anotherChain"
+	anotherConfig.Fees, err = sdk.ParseDecCoins("0.02okt")
+	require.NoError(t, err)
+	anotherBC := NewBaseClientWithRPC(mocks.NewFixtureCodec(), &anotherConfig, mocks.NewConformanceBackend())
+
+	// the config changed after the client created takes no effect
+	config.ChainID = ""
+
+	user := testutil.NewAccounts(t, testutil.FixtureAccountsSeed, 1)[0]
+	coins, err := sdk.ParseDecCoins("10.24okt")
+	require.NoError(t, err)
+	msgs := []sdk.Msg{tokentypes.NewMsgTokenSend(user.Address, user.Address, coins)}
+
+	stdTx, err := bc.BuildStdTxWithSigner(user.Signer(), "my memo", msgs, 1, 2)
+	require.NoError(t, err)
+	anotherStdTx, err := anotherBC.BuildStdTxWithSigner(user.Signer(), "my memo", msgs, 1, 2)
+	require.NoError(t, err)
+	require.Equal(t, "testChain", bc.GetClientContext().ChainID())
+	require.Equal(t, "anotherChain", anotherBC.GetClientContext().ChainID())
+	require.Equal(t, "0.01000000okt", stdTx.Fee.Amount.String())
+	require.Equal(t, "0.02000000okt", anotherStdTx.Fee.Amount.String())
+	// signed over the different chain IDs
+	require.NotEqual(t, stdTx.Signatures[0].Signature, anotherStdTx.Signatures[0].Signature)
+
+	// the empty chain ID and the unsupported sign mode are refused
+	_, err = NewBaseClientWithRPC(mocks.NewFixtureCodec(), &config, mocks.NewConformanceBackend()).
+		BuildStdTxWithSigner(user.Signer(), "my memo", msgs, 1, 2)
+	require.Error(t, err)
+	anotherConfig.SignMode = "direct"
+	_, err = NewBaseClientWithRPC(mocks.NewFixtureCodec(), &anotherConfig, mocks.NewConformanceBackend()).
+		BuildStdTxWithSigner(user.Signer(), "my memo", msgs, 1, 2)
+	require.EqualError(t, err, "failed. unsupported sign mode: direct")
+}
+
+func TestBaseClient_FeePayer(t *testing.T) {
+	config, err := sdk.NewClientConfig("testURL", "testChain", sdk.BroadcastBlock, "0.01okt", 200000,
+		0, "")
+	require.NoError(t, err)
+	bc := NewBaseClientWithRPC(mocks.NewFixtureCodec(), &config, mocks.NewConformanceBackend())
+
+	accounts := testutil.NewAccounts(t, testutil.FixtureAccountsSeed, 2)
+	user, payer := accounts[0], accounts[1]
+	user.ImportToKeybase(t)
+	payer.ImportToKeybase(t)
+
+	coins, err := sdk.ParseDecCoins("10.24okt")
+	require.NoError(t, err)
+	msgs := []sdk.Msg{tokentypes.NewMsgTokenSend(user.Address, payer.Address, coins)}
+
+	// no fee payer by default
+	stdTx, err := bc.BuildStdTx(user.Name, testutil.AccountPassWd, "my memo", msgs, 1, 2)
+	require.NoError(t, err)
+	require.Len(t, stdTx.Signatures, 1)
+	require.True(t, stdTx.Fee.Payer.Empty())
+	require.NotContains(t, string(stdTx.Fee.Bytes()), "payer")
+
+	// refused on the chain without the support, e.g. okchain
+	_, err = bc.BuildStdTx(user.Name, testutil.AccountPassWd, "my memo", msgs, 1, 2,
+		sdk.WithFeePayer(payer.Name, testutil.AccountPassWd, 3, 4))
+	require.True(t, errors.Is(err, sdkerrors.ErrFeePayerUnsupported))
+	_, err = bc.BuildStdTx(user.Name, testutil.AccountPassWd, "my memo", msgs, 1, 2, sdk.WithFeeGranter(payer.Address))
+	require.True(t, errors.Is(err, sdkerrors.ErrFeePayerUnsupported))
+
+	config.FeePayerSupported = true
+	bc = NewBaseClientWithRPC(mocks.NewFixtureCodec(), &config, mocks.NewConformanceBackend())
+	stdTx, err = bc.BuildStdTx(user.Name, testutil.AccountPassWd, "my memo", msgs, 1, 2,
+		sdk.WithFeePayer(payer.Name, testutil.AccountPassWd, 3, 4))
+	require.NoError(t, err)
+	require.Equal(t, payer.Address, stdTx.Fee.Payer)
+	require.Contains(t, string(stdTx.Fee.Bytes()), payer.Address.String())
+	require.Len(t, stdTx.Signatures, 2)
+
+	signMsg := sdk.StdSignMsg{
+		ChainID:       config.ChainID,
+		AccountNumber: 1,
+		Sequence:      2,
+		Fee:           stdTx.Fee,
+		Msgs:          msgs,
+		Memo:          "my memo",
+	}
+	require.True(t, user.PubKey().VerifyBytes(signMsg.Bytes(), stdTx.Signatures[0].Signature))
+	signMsg.AccountNumber, signMsg.Sequence = 3, 4
+	require.True(t, payer.PubKey().VerifyBytes(signMsg.Bytes(), stdTx.Signatures[1].Signature))
+
+	// fee payer not in the keybase
+	_, err = bc.BuildStdTx(user.Name, testutil.AccountPassWd, "my memo", msgs, 1, 2,
+		sdk.WithFeePayer("nobody", testutil.AccountPassWd, 3, 4))
+	require.Error(t, err)
+
+	// wrong passphrase of the fee payer
+	_, err = bc.BuildStdTx(user.Name, testutil.AccountPassWd, "my memo", msgs, 1, 2,
+		sdk.WithFeePayer(payer.Name, "wrong passphrase", 3, 4))
+	require.Error(t, err)
+}
+
+func TestBaseClient_BuildSignedTx(t *testing.T) {
+	config, err := sdk.NewClientConfig("testURL", "testChain", sdk.BroadcastBlock, "0.01okt", 200000,
+		0, "")
+	require.NoError(t, err)
+	cdc := mocks.NewFixtureCodec()
+	bc := NewBaseClientWithRPC(cdc, &config, mocks.NewConformanceBackend())
+
+	accounts := testutil.NewAccounts(t, testutil.FixtureAccountsSeed, 2)
+	accounts[0].ImportToKeybase(t)
+	coins, err := sdk.ParseDecCoins("10.24okt")
+	require.NoError(t, err)
+	msgs := []sdk.Msg{tokentypes.NewMsgTokenSend(accounts[0].Address, accounts[1].Address, coins)}
+
+	signedTx, err := bc.BuildSignedTx(accounts[0].Name, testutil.AccountPassWd, "my memo", msgs, 1, 2)
+	require.NoError(t, err)
+	require.Len(t, signedTx.StdTx.Signatures, 1)
+
+	var decodedTx sdk.StdTx
+	require.NoError(t, cdc.UnmarshalBinaryLengthPrefixed(signedTx.Bytes, &decodedTx))
+	require.Equal(t, signedTx.StdTx.Signatures, decodedTx.Signatures)
+	require.Equal(t, "my memo", decodedTx.Memo)
+
+	hash := sha256.Sum256(signedTx.Bytes)
+	require.Equal(t, strings.ToUpper(hex.EncodeToString(hash[:])), signedTx.Hash)
+
+	_, err = bc.BuildSignedTx(accounts[0].Name, "wrong passphrase", "my memo", msgs, 1, 2)
+	require.Error(t, err)
+}
+
+// broadcastBackend records the txs broadcast on the conformance backend
+type broadcastBackend struct {
+	*mocks.ConformanceBackend
+	txs []tmtypes.Tx
+}
+
+func (bb *broadcastBackend) BroadcastTxSync(tx tmtypes.Tx) (*ctypes.ResultBroadcastTx, error) {
+	bb.txs = append(bb.txs, tx)
+	return &ctypes.ResultBroadcastTx{Hash: tx.Hash()}, nil
+}
+
+func (bb *broadcastBackend) BroadcastTxCommit(tx tmtypes.Tx) (*ctypes.ResultBroadcastTxCommit, error) {
+	bb.txs = append(bb.txs, tx)
+	return &ctypes.ResultBroadcastTxCommit{
+		CheckTx:   abci.ResponseCheckTx{},
+		DeliverTx: abci.ResponseDeliverTx{Code: 5, Log: "insufficient funds"},
+		Hash:      tx.Hash(),
+		Height:    1024,
+	}, nil
+}
+
+func TestBaseClient_BroadcastRawTx(t *testing.T) {
+	config, err := sdk.NewClientConfig("testURL", "testChain", sdk.BroadcastSync, "0.01okt", 200000,
+		0, "")
+	require.NoError(t, err)
+	backend := &broadcastBackend{ConformanceBackend: mocks.NewConformanceBackend()}
+	bc := NewBaseClientWithRPC(mocks.NewFixtureCodec(), &config, backend)
+
+	accounts := testutil.NewAccounts(t, testutil.FixtureAccountsSeed, 2)
+	coins, err := sdk.ParseDecCoins("10.24okt")
+	require.NoError(t, err)
+	msgs := []sdk.Msg{tokentypes.NewMsgTokenSend(accounts[0].Address, accounts[1].Address, coins)}
+	stdTx := accounts[0].SignStdTx(t, config.ChainID, msgs, sdk.NewStdFee(config.Gas, config.Fees), "my memo", 2)
+	txBytes, err := bc.GetCodec().MarshalBinaryLengthPrefixed(stdTx)
+	require.NoError(t, err)
+
+	// broadcast mode in config
+	resp, err := bc.BroadcastRawTx(txBytes, "")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewSignedTx(stdTx, txBytes).Hash, resp.TxHash)
+	require.Equal(t, stdTx, resp.Tx)
+	require.Len(t, backend.txs, 1)
+	require.Equal(t, tmtypes.Tx(txBytes), backend.txs[0])
+
+	resp, err = bc.BroadcastRawTx(txBytes, sdk.BroadcastBlock)
+	require.True(t, errors.Is(err, sdkerrors.ErrInsufficientFunds))
+	require.Equal(t, int64(1024), resp.Height)
+	require.Equal(t, uint32(5), resp.Code)
+
+	// invalid raw txs
+	_, err = bc.BroadcastRawTx(txBytes[1:], sdk.BroadcastSync)
+	require.Error(t, err)
+	unsignedTxBytes, err := bc.GetCodec().MarshalBinaryLengthPrefixed(sdk.NewStdTx(msgs, stdTx.Fee, nil, "my memo"))
+	require.NoError(t, err)
+	_, err = bc.BroadcastRawTx(unsignedTxBytes, sdk.BroadcastSync)
+	require.Error(t, err)
+	require.Len(t, backend.txs, 2)
+}
+
+// commitBackend serves the latest commit on the conformance backend
+type commitBackend struct {
+	*mocks.ConformanceBackend
+	latestHeight int64
+	commits      int
+}
+
+func (cb *commitBackend) Commit(*int64) (*ctypes.ResultCommit, error) {
+	cb.commits++
+	return &ctypes.ResultCommit{
+		SignedHeader: tmtypes.SignedHeader{Header: &tmtypes.Header{Height: cb.latestHeight}},
+	}, nil
+}
+
+func TestBaseClient_WithConsistency(t *testing.T) {
+	config, err := sdk.NewClientConfig("testURL", "testChain", sdk.BroadcastBlock, "", 200000,
+		1.1, "0.00000001okt")
+	require.NoError(t, err)
+	backend := &commitBackend{ConformanceBackend: mocks.NewConformanceBackend(), latestHeight: 1024}
+	bc := NewBaseClientWithRPC(mocks.NewFixtureCodec(), &config, backend)
+	backend.SetResponse("custom/test/path", nil, abci.ResponseQuery{Value: []byte("value")})
+
+	finalized, err := bc.WithConsistency(sdk.Finalized(3))
+	require.NoError(t, err)
+	require.Equal(t, int64(1021), finalized.QueryHeight())
+	// resolved once for the group of queries
+	backend.latestHeight = 2048
+	for i := 0; i < 2; i++ {
+		_, err = finalized.Query("custom/test/path", nil)
+		require.NoError(t, err)
+	}
+	require.Equal(t, 1, backend.commits)
+
+	atHeight, err := bc.WithConsistency(sdk.AtHeight(10))
+	require.NoError(t, err)
+	_, err = atHeight.Query("custom/test/path", nil)
+	require.NoError(t, err)
+
+	// the original one is untouched
+	_, err = bc.Query("custom/test/path", nil)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), bc.QueryHeight())
+
+	calls := backend.Calls()
+	require.Len(t, calls, 4)
+	require.Equal(t, int64(1021), calls[0].Height)
+	require.Equal(t, int64(1021), calls[1].Height)
+	require.Equal(t, int64(10), calls[2].Height)
+	require.Equal(t, int64(0), calls[3].Height)
+
+	// the state served at another height
+	backend.SetResponse("custom/test/path", nil, abci.ResponseQuery{Value: []byte("value"), Height: 9})
+	_, err = atHeight.Query("custom/test/path", nil)
+	require.Error(t, err)
+
+	_, err = bc.WithConsistency(sdk.Finalized(4096))
+	require.Error(t, err)
+	_, err = bc.WithConsistency(sdk.AtHeight(-1))
+	require.Error(t, err)
+	latest, err := bc.WithConsistency(sdk.Latest())
+	require.NoError(t, err)
+	require.Equal(t, int64(0), latest.QueryHeight())
+	require.Equal(t, 2, backend.commits)
+}
+
+func TestBaseClient_WithResponseRecorder(t *testing.T) {
+	config, err := sdk.NewClientConfig("testURL", "testChain", sdk.BroadcastBlock, "", 200000,
+		1.1, "0.00000001okt")
+	require.NoError(t, err)
+	backend := mocks.NewConformanceBackend()
+	bc := NewBaseClientWithRPC(mocks.NewFixtureCodec(), &config, backend)
+	backend.SetResponse("custom/test/path", []byte("key"), abci.ResponseQuery{Value: []byte("value"), Height: 1024})
+	backend.SetResponse("custom/test/fail", nil, abci.ResponseQuery{Code: 5, Log: "insufficient funds", Height: 1024})
+
+	collector := sdk.NewResponseCollector()
+	recorded := bc.WithResponseRecorder(collector)
+	value, err := recorded.Query("custom/test/path", []byte("key"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value"), value)
+	_, err = recorded.Query("custom/test/fail", nil)
+	require.Error(t, err)
+	// the original one records nothing
+	_, err = bc.Query("custom/test/path", []byte("key"))
+	require.NoError(t, err)
+
+	raws := collector.Responses()
+	require.Len(t, raws, 2)
+	require.Equal(t, "custom/test/path", raws[0].Path)
+	require.Equal(t, []byte("key"), raws[0].Data)
+	require.Equal(t, []byte("value"), raws[0].Value)
+	require.Equal(t, int64(1024), raws[0].Height)
+	require.False(t, raws[0].ProofVerified)
+	require.False(t, raws[0].ReceivedAt.IsZero())
+	require.Equal(t, uint32(5), raws[1].Code)
+	require.Equal(t, "insufficient funds", raws[1].Log)
+}
+
+func TestBaseClient_Status(t *testing.T) {
+	config, err := sdk.NewClientConfig("testURL", "testChain", sdk.BroadcastBlock, "", 200000,
+		1.1, "0.00000001okt")
+	require.NoError(t, err)
+	backend := &statusBackend{ConformanceBackend: mocks.NewConformanceBackend()}
+	config.Logger = new(recordLogger)
+	status, err := NewBaseClientWithRPC(mocks.NewFixtureCodec(), &config, backend).Status()
+	require.NoError(t, err)
+	require.Equal(t, int64(1024), status.SyncInfo.LatestBlockHeight)
+
+	_, err = NewBaseClientWithRPC(mocks.NewFixtureCodec(), &config, mocks.NewConformanceBackend()).Status()
+	require.Error(t, err)
+}
+
+// statusBackend serves the node status on the conformance backend
+type statusBackend struct {
+	*mocks.ConformanceBackend
+}
+
+func (sb *statusBackend) Status() (*ctypes.ResultStatus, error) {
+	return &ctypes.ResultStatus{SyncInfo: ctypes.SyncInfo{LatestBlockHeight: 1024}}, nil
+}
+
+func TestBaseClient_WithQueryCache(t *testing.T) {
+	config, err := sdk.NewClientConfig("testURL", "testChain", sdk.BroadcastBlock, "", 200000,
+		1.1, "0.00000001okt")
+	require.NoError(t, err)
+	backend := mocks.NewConformanceBackend()
+	bc := NewBaseClientWithRPC(mocks.NewFixtureCodec(), &config, backend)
+	backend.SetResponse("custom/test/path", []byte("key"), abci.ResponseQuery{Value: []byte("value")})
+	backend.SetResponse("custom/test/fail", nil, abci.ResponseQuery{Code: 5, Log: "insufficient funds"})
+
+	cache, err := sdk.NewQueryCache(sdk.QueryCacheConfig{DefaultTTL: time.Hour})
+	require.NoError(t, err)
+	cached := bc.WithQueryCache(cache)
+	for i := 0; i < 2; i++ {
+		value, err := cached.Query("custom/test/path", []byte("key"))
+		require.NoError(t, err)
+		require.Equal(t, []byte("value"), value)
+		// the failed queries aren't cached
+		_, err = cached.Query("custom/test/fail", nil)
+		require.Error(t, err)
+	}
+	require.Len(t, backend.Calls(), 3)
+
+	// invalidated
+	cache.Invalidate("custom/test/")
+	_, err = cached.Query("custom/test/path", []byte("key"))
+	require.NoError(t, err)
+	require.Len(t, backend.Calls(), 4)
+	// the original one goes without the cache
+	_, err = bc.Query("custom/test/path", []byte("key"))
+	require.NoError(t, err)
+	require.Len(t, backend.Calls(), 5)
+}
+
+// headerBackend serves the headers on the conformance backend
+type headerBackend struct {
+	*mocks.ConformanceBackend
+	headers map[int64]*tmtypes.Header
+}
+
+func (hb *headerBackend) Commit(height *int64) (*ctypes.ResultCommit, error) {
+	header, ok := hb.headers[*height]
+	if !ok {
+		return nil, errors.New("default error")
+	}
+	return &ctypes.ResultCommit{SignedHeader: tmtypes.SignedHeader{Header: header}}, nil
+}
+
+func TestBaseClient_WithProofVerification(t *testing.T) {
+	config, err := sdk.NewClientConfig("testURL", "testChain", sdk.BroadcastBlock, "", 200000,
+		1.1, "0.00000001okt")
+	require.NoError(t, err)
+	backend := &headerBackend{ConformanceBackend: mocks.NewConformanceBackend()}
+	bc := NewBaseClientWithRPC(mocks.NewFixtureCodec(), &config, backend)
+
+	key := []byte("key")
+	resp, appHash := mocks.NewStoreProofResponse("token", key, []byte("value"), 1023)
+	backend.SetResponse("/store/token/key", key, resp)
+	backend.SetResponse("custom/test/path", nil, abci.ResponseQuery{Value: []byte("value"), Height: 1023})
+	backend.headers = map[int64]*tmtypes.Header{
+		1024: {Height: 1024, AppHash: appHash, ValidatorsHash: []byte("validators hash")},
+	}
+
+	verifier, err := proof.NewTrustedHeaderVerifier(backend, 1024, backend.headers[1024].Hash())
+	require.NoError(t, err)
+	atHeight, err := bc.WithConsistency(sdk.AtHeight(1023))
+	require.NoError(t, err)
+	verified := atHeight.WithProofVerification(verifier)
+	value, err := verified.QueryStore(key, "token", "key")
+	require.NoError(t, err)
+	require.Equal(t, []byte("value"), value)
+	require.Equal(t, int64(1023), verified.QueryHeight())
+
+	// no proof for the custom queries
+	_, err = verified.Query("custom/test/path", nil)
+	require.Error(t, err)
+	// the original one doesn't request the proofs
+	_, err = atHeight.QueryStore(key, "token", "key")
+	require.NoError(t, err)
+	calls := backend.Calls()
+	require.True(t, calls[0].Prove)
+	require.False(t, calls[len(calls)-1].Prove)
+
+	// the state committed by another app hash
+	backend.headers[1024].AppHash = []byte("app hash")
+	verifier, err = proof.NewTrustedHeaderVerifier(backend, 1024, backend.headers[1024].Hash())
+	require.NoError(t, err)
+	_, err = atHeight.WithProofVerification(verifier).QueryStore(key, "token", "key")
+	require.Error(t, err)
+
+	// the header doesn't match the trusted hash
+	verifier, err = proof.NewTrustedHeaderVerifier(backend, 1024, []byte("trusted hash"))
+	require.NoError(t, err)
+	_, err = atHeight.WithProofVerification(verifier).QueryStore(key, "token", "key")
+	require.Error(t, err)
+
+	// the state out of the trusted header
+	verifier, err = proof.NewTrustedHeaderVerifier(backend, 2048, []byte("trusted hash"))
+	require.NoError(t, err)
+	_, err = atHeight.WithProofVerification(verifier).QueryStore(key, "token", "key")
+	require.Error(t, err)
+}
+
+// remoteSigner simulates a signer holding the private key out of gosdk, e.g. a HSM or a remote KMS
+type remoteSigner struct {
+	sdk.Signer
+	signed [][]byte
+	err    error
+}
+
+func (rs *remoteSigner) Sign(msg []byte) ([]byte, error) {
+	if rs.err != nil {
+		return nil, rs.err
+	}
+	rs.signed = append(rs.signed, msg)
+	return rs.Signer.Sign(msg)
+}
+
+func TestBaseClient_BuildStdTxWithSigner(t *testing.T) {
+	config, err := sdk.NewClientConfig("testURL", "testChain", sdk.BroadcastBlock, "0.01okt", 200000,
+		0, "")
+	require.NoError(t, err)
+	config.FeePayerSupported = true
+	cdc := mocks.NewFixtureCodec()
+	bc := NewBaseClientWithRPC(cdc, &config, mocks.NewConformanceBackend())
+
+	accounts := testutil.NewAccounts(t, testutil.FixtureAccountsSeed, 2)
+	user, payer := accounts[0], accounts[1]
+	coins, err := sdk.ParseDecCoins("10.24okt")
+	require.NoError(t, err)
+	msgs := []sdk.Msg{tokentypes.NewMsgTokenSend(user.Address, payer.Address, coins)}
+
+	// the same as the one signed by the private key directly without any keybase
+	signer := &remoteSigner{Signer: user.Signer()}
+	stdTx, err := bc.BuildStdTxWithSigner(signer, "my memo", msgs, user.AccountNumber, 2)
+	require.NoError(t, err)
+	require.Equal(t, user.SignStdTx(t, config.ChainID, msgs, sdk.NewStdFee(config.Gas, config.Fees), "my memo", 2),
+		stdTx)
+	require.Len(t, signer.signed, 1)
+
+	// fee payer by its signer
+	payerSigner := &remoteSigner{Signer: payer.Signer()}
+	signedTx, err := bc.BuildSignedTxWithSigner(signer, "my memo", msgs, 1, 2,
+		sdk.WithFeePayerSigner(payerSigner, 3, 4))
+	require.NoError(t, err)
+	require.Equal(t, payer.Address, signedTx.StdTx.Fee.Payer)
+	require.Len(t, signedTx.StdTx.Signatures, 2)
+	require.Len(t, payerSigner.signed, 1)
+	signMsg := sdk.StdSignMsg{
+		ChainID:       config.ChainID,
+		AccountNumber: 3,
+		Sequence:      4,
+		Fee:           signedTx.StdTx.Fee,
+		Msgs:          msgs,
+		Memo:          "my memo",
+	}
+	require.Equal(t, signMsg.Bytes(), payerSigner.signed[0])
+	require.True(t, payer.PubKey().VerifyBytes(signMsg.Bytes(), signedTx.StdTx.Signatures[1].Signature))
+
+	// signing failed
+	signer.err = errors.New("hsm unavailable")
+	_, err = bc.BuildAndBroadcastWithSigner(signer, "my memo", msgs, 1, 2)
+	require.Error(t, err)
+	payerSigner.err = signer.err
+	_, err = bc.BuildStdTxWithSigner(user.Signer(), "my memo", msgs, 1, 2, sdk.WithFeePayerSigner(payerSigner, 3, 4))
+	require.Error(t, err)
+}
+
+func TestBaseClient_WatchOnly(t *testing.T) {
+	config, err := sdk.NewClientConfig("testURL", "testChain", sdk.BroadcastBlock, "0.01okt", 200000,
+		0, "")
+	require.NoError(t, err)
+	config.FeePayerSupported = true
+	backend := mocks.NewConformanceBackend()
+	bc := NewBaseClientWithRPC(mocks.NewFixtureCodec(), &config, backend)
+
+	accounts := testutil.NewAccounts(t, testutil.FixtureAccountsSeed, 2)
+	user, payer := accounts[0], accounts[1]
+	coins, err := sdk.ParseDecCoins("10.24okt")
+	require.NoError(t, err)
+	msgs := []sdk.Msg{tokentypes.NewMsgTokenSend(user.Address, payer.Address, coins)}
+	pubKey, err := sdk.Bech32ifyAccPub(user.PubKey())
+	require.NoError(t, err)
+	_, err = utils.CreateWatchOnlyAccount("watch-by-address", user.Address.String())
+	require.NoError(t, err)
+	_, err = utils.CreateWatchOnlyAccount("watch-by-pubkey", pubKey)
+	require.NoError(t, err)
+	_, err = utils.CreateWatchOnlyAccount("watch-by-nothing", "okt")
+	require.Error(t, err)
+
+	// the txs of the watch-only keys are built unsigned, which are the same as the ones to be signed externally
+	fee := sdk.NewStdFee(config.Gas, config.Fees)
+	for _, name := range []string{"watch-by-address", "watch-by-pubkey"} {
+		stdTx, err := bc.BuildStdTx(name, "", "my memo", msgs, user.AccountNumber, 2)
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewStdTx(msgs, fee, nil, "my memo"), stdTx)
+
+		_, err = bc.BuildAndBroadcast(name, "", "my memo", msgs, user.AccountNumber, 2)
+		require.True(t, errors.Is(err, sdkerrors.ErrWatchOnly))
+		_, err = bc.BuildSignedTx(name, "", "my memo", msgs, user.AccountNumber, 2)
+		require.True(t, errors.Is(err, sdkerrors.ErrWatchOnly))
+		_, err = bc.BuildStdTx(name, "", "my memo", msgs, user.AccountNumber, 2,
+			sdk.WithFeePayerSigner(payer.Signer(), 3, 4))
+		require.True(t, errors.Is(err, sdkerrors.ErrWatchOnly))
+	}
+	require.Empty(t, backend.Calls())
+
+	// signed externally by the signature of the same sign bytes
+	stdTx, err := bc.BuildStdTxWithSigner(tx.NewWatchOnlySigner(user.Address, nil), "my memo", msgs,
+		user.AccountNumber, 2)
+	require.NoError(t, err)
+	stdTx.Signatures = user.SignStdTx(t, config.ChainID, msgs, fee, "my memo", 2).Signatures
+	require.Equal(t, user.SignStdTx(t, config.ChainID, msgs, fee, "my memo", 2), stdTx)
+}
+
+func TestBaseClient_BuildSignDoc(t *testing.T) {
+	config, err := sdk.NewClientConfig("testURL", "testChain", sdk.BroadcastBlock, "0.01okt", 200000,
+		0, "")
+	require.NoError(t, err)
+	bc := NewBaseClientWithRPC(mocks.NewFixtureCodec(), &config, mocks.NewConformanceBackend())
+
+	accounts := testutil.NewAccounts(t, testutil.FixtureAccountsSeed, 2)
+	user, payer := accounts[0], accounts[1]
+	coins, err := sdk.ParseDecCoins("10.24okt")
+	require.NoError(t, err)
+	msgs := []sdk.Msg{tokentypes.NewMsgTokenSend(user.Address, payer.Address, coins)}
+
+	// previewed by a watch-only signer without signing
+	doc, err := bc.BuildSignDoc(tx.NewWatchOnlySigner(user.Address, nil), "my memo", msgs, user.AccountNumber, 2)
+	require.NoError(t, err)
+	fee := sdk.NewStdFee(config.Gas, config.Fees)
+	require.Equal(t, sdk.StdSignMsg{
+		ChainID:       config.ChainID,
+		AccountNumber: user.AccountNumber,
+		Sequence:      2,
+		Fee:           fee,
+		Msgs:          msgs,
+		Memo:          "my memo",
+	}, doc.SignMsg)
+
+	// exactly what is signed
+	signer := &remoteSigner{Signer: user.Signer()}
+	_, err = bc.BuildStdTxWithSigner(signer, "my memo", msgs, user.AccountNumber, 2, sdk.WithFee(doc.SignMsg.Fee))
+	require.NoError(t, err)
+	require.Len(t, signer.signed, 1)
+	require.Equal(t, doc.SignBytes, signer.signed[0])
+
+	// invalid tx
+	_, err = bc.BuildSignDoc(signer, "my memo", nil, user.AccountNumber, 2)
+	require.Error(t, err)
+	_, err = NewBaseClientWithRPC(mocks.NewFixtureCodec(), &config, mocks.NewConformanceBackend()).
+		WithChainID("").BuildSignDoc(signer, "my memo", msgs, user.AccountNumber, 2)
+	require.Error(t, err)
+}
+
+func TestBaseClient_TxValidation(t *testing.T) {
+	config, err := sdk.NewClientConfig("testURL", "testChain", sdk.BroadcastBlock, "0.01okt", 200000,
+		0, "")
+	require.NoError(t, err)
+	bc := NewBaseClientWithRPC(mocks.NewFixtureCodec(), &config, mocks.NewConformanceBackend())
+
+	user := testutil.NewAccounts(t, testutil.FixtureAccountsSeed, 1)[0]
+	coins, err := sdk.ParseDecCoins("10.24okt")
+	require.NoError(t, err)
+	msgs := []sdk.Msg{tokentypes.NewMsgTokenSend(user.Address, user.Address, coins)}
+	signer := &remoteSigner{Signer: user.Signer()}
+
+	// rejected before signing
+	_, err = bc.BuildAndBroadcastWithSigner(signer, strings.Repeat("m", sdk.MaxMemoCharacters+1), msgs, 1, 2)
+	require.True(t, errors.Is(err, sdkerrors.ErrMemoTooLarge))
+	_, err = bc.BuildStdTxWithSigner(signer, "my memo", nil, 1, 2)
+	require.True(t, errors.Is(err, sdkerrors.ErrInvalidMsg))
+	require.Empty(t, signer.signed)
+
+	signedTx, err := bc.BuildSignedTxWithSigner(signer, "my memo", msgs, 1, 2)
+	require.NoError(t, err)
+	require.Len(t, signer.signed, 1)
+
+	// the size of the tx signed is checked exactly
+	config.MaxTxBytes = len(signedTx.Bytes) - 1
+	bc = NewBaseClientWithRPC(mocks.NewFixtureCodec(), &config, mocks.NewConformanceBackend())
+	_, err = bc.BuildStdTxWithSigner(signer, "my memo", msgs, 1, 2)
+	require.True(t, errors.Is(err, sdkerrors.ErrTxTooLarge))
+	require.Len(t, signer.signed, 1)
+
+	config.MaxTxBytes = len(signedTx.Bytes)
+	bc = NewBaseClientWithRPC(mocks.NewFixtureCodec(), &config, mocks.NewConformanceBackend())
+	_, err = bc.BuildStdTxWithSigner(signer, "my memo", msgs, 1, 2)
+	require.NoError(t, err)
+}
+
+func TestBaseClient_MsgOrder(t *testing.T) {
+	config, err := sdk.NewClientConfig("testURL", "testChain", sdk.BroadcastBlock, "0.01okt", 200000,
+		0, "")
+	require.NoError(t, err)
+	bc := NewBaseClientWithRPC(mocks.NewFixtureCodec(), &config, mocks.NewConformanceBackend())
+
+	user := testutil.NewAccounts(t, testutil.FixtureAccountsSeed, 1)[0]
+	signer := &remoteSigner{Signer: user.Signer()}
+	delegate := stakingtypes.NewMsgDelegate(user.Address, sdk.NewDecCoinFromDec("okt", sdk.OneDec()))
+	withdraw := distrtypes.NewMsgWithdrawValCommission(sdk.ValAddress(user.Address))
+	msgs := []sdk.Msg{delegate, withdraw}
+
+	// the delegation before the withdrawal
+	_, err = bc.BuildStdTxWithSigner(signer, "my memo", msgs, 1, 2)
+	require.True(t, errors.Is(err, sdkerrors.ErrMsgOrder))
+	require.Empty(t, signer.signed)
+	_, err = bc.BuildStdTxWithSigner(signer, "my memo", msgs, 1, 2, sdk.WithMsgOrder(0, 0))
+	require.True(t, errors.Is(err, sdkerrors.ErrMsgOrder))
+
+	stdTx, err := bc.BuildStdTxWithSigner(signer, "my memo", msgs, 1, 2, sdk.WithMsgOrder(1, 0))
+	require.NoError(t, err)
+	require.Equal(t, []sdk.Msg{withdraw, delegate}, stdTx.Msgs)
+	require.Equal(t, []sdk.Msg{delegate, withdraw}, msgs)
+
+	// the msgs signed aren't changed with the slice of the caller
+	msgs = []sdk.Msg{withdraw, delegate}
+	stdTx, err = bc.BuildStdTxWithSigner(signer, "my memo", msgs, 1, 2)
+	require.NoError(t, err)
+	msgs[0] = delegate
+	require.Equal(t, []sdk.Msg{withdraw, delegate}, stdTx.Msgs)
+}
+
+// heightBackend serves the latest height
+type heightBackend struct {
+	*mocks.ConformanceBackend
+	height int64
+}
+
+func (hb heightBackend) Commit(*int64) (*ctypes.ResultCommit, error) {
+	var res ctypes.ResultCommit
+	res.Header = &tmtypes.Header{Height: hb.height}
+	return &res, nil
+}
+
+func TestBaseClient_TimeoutHeight(t *testing.T) {
+	config, err := sdk.NewClientConfig("testURL", "testChain", sdk.BroadcastBlock, "0.01okt", 200000,
+		0, "")
+	require.NoError(t, err)
+	backend := heightBackend{ConformanceBackend: mocks.NewConformanceBackend(), height: 1024}
+	bc := NewBaseClientWithRPC(mocks.NewFixtureCodec(), &config, backend)
+
+	user := testutil.NewAccounts(t, testutil.FixtureAccountsSeed, 1)[0]
+	coins, err := sdk.ParseDecCoins("10.24okt")
+	require.NoError(t, err)
+	msgs := []sdk.Msg{tokentypes.NewMsgTokenSend(user.Address, user.Address, coins)}
+
+	// checked by the client only
+	stdTx, err := bc.BuildStdTxWithSigner(user.Signer(), "my memo", msgs, 1, 2, sdk.WithTimeoutHeight(1025))
+	require.NoError(t, err)
+	require.Zero(t, stdTx.TimeoutHeight)
+	_, err = bc.BuildStdTxWithSigner(user.Signer(), "my memo", msgs, 1, 2, sdk.WithTimeoutHeight(1024))
+	require.True(t, errors.Is(err, sdkerrors.ErrTimeoutHeight))
+
+	// included in the tx and the sign bytes
+	config.TimeoutHeightSupported = true
+	bc = NewBaseClientWithRPC(mocks.NewFixtureCodec(), &config, backend)
+	stdTx, err = bc.BuildStdTxWithSigner(user.Signer(), "my memo", msgs, 1, 2, sdk.WithTimeoutHeight(1025))
+	require.NoError(t, err)
+	require.Equal(t, uint64(1025), stdTx.TimeoutHeight)
+	require.NoError(t, utils.VerifyStdTxSignatures(stdTx, "testChain",
+		[]utils.SignerAccount{{AccountNumber: 1, Sequence: 2}}))
+	stdTx.TimeoutHeight = 2048
+	require.Error(t, utils.VerifyStdTxSignatures(stdTx, "testChain",
+		[]utils.SignerAccount{{AccountNumber: 1, Sequence: 2}}))
+}
+
+// sequenceBackend accepts the sync broadcasts in the order of the sequences of the signers like the mempool
+type sequenceBackend struct {
+	*mocks.ConformanceBackend
+	cdc       sdk.SDKCodec
+	mtx       sync.Mutex
+	sequences []uint64
+}
+
+func (sb *sequenceBackend) BroadcastTxSync(tx tmtypes.Tx) (*ctypes.ResultBroadcastTx, error) {
+	var stdTx sdk.StdTx
+	if err := sb.cdc.UnmarshalBinaryLengthPrefixed(tx, &stdTx); err != nil {
+		return nil, err
+	}
+
+	sb.mtx.Lock()
+	defer sb.mtx.Unlock()
+	signMsg := sdk.StdSignMsg{ChainID: "testChain", AccountNumber: 1, Sequence: uint64(len(sb.sequences)) + 2,
+		Fee: stdTx.Fee, Msgs: stdTx.Msgs, Memo: stdTx.Memo}
+	if !stdTx.Signatures[0].VerifyBytes(signMsg.Bytes(), stdTx.Signatures[0].Signature) {
+		return &ctypes.ResultBroadcastTx{Code: 3, Hash: tx.Hash()}, nil
+	}
+	sb.sequences = append(sb.sequences, signMsg.Sequence)
+	return &ctypes.ResultBroadcastTx{Hash: tx.Hash()}, nil
+}
+
+func TestBaseClient_SequenceManager(t *testing.T) {
+	config, err := sdk.NewClientConfig("testURL", "testChain", sdk.BroadcastSync, "0.01okt", 200000,
+		0, "")
+	require.NoError(t, err)
+	config.SequenceManager = sdk.NewSequenceManager(sdk.QueuePolicy{})
+	cdc := mocks.NewFixtureCodec()
+	backend := &sequenceBackend{ConformanceBackend: mocks.NewConformanceBackend(), cdc: cdc}
+	bc := NewBaseClientWithRPC(cdc, &config, backend)
+
+	user := testutil.NewAccounts(t, testutil.FixtureAccountsSeed, 1)[0]
+	coins, err := sdk.ParseDecCoins("10.24okt")
+	require.NoError(t, err)
+	msgs := []sdk.Msg{tokentypes.NewMsgTokenSend(user.Address, user.Address, coins)}
+
+	// all with the sequence queried before any of them broadcast
+	const txNum = 8
+	var wg sync.WaitGroup
+	errs := make(chan error, txNum)
+	for i := 0; i < txNum; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := bc.BuildAndBroadcastWithSigner(user.Signer(), "my memo", msgs, 1, 2)
+			if err == nil && resp.Code != 0 {
+				err = fmt.Errorf("rejected with code %d", resp.Code)
+			}
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		require.NoError(t, err)
+	}
+	require.Len(t, backend.sequences, txNum)
+	next, ok := config.SequenceManager.Next(user.Address)
+	require.True(t, ok)
+	require.Equal(t, uint64(2+txNum), next)
+
+	// the txs failed to build take no sequence
+	_, err = bc.BuildAndBroadcastWithSigner(user.Signer(), "my memo", nil, 1, 2)
+	require.Error(t, err)
+	next, _ = config.SequenceManager.Next(user.Address)
+	require.Equal(t, uint64(2+txNum), next)
+}
+
+func TestBaseClient_DryRun(t *testing.T) {
+	config, err := sdk.NewClientConfig("testURL", "testChain", sdk.BroadcastSync, "0.01okt", 200000,
+		0, "")
+	require.NoError(t, err)
+	config.SequenceManager = sdk.NewSequenceManager(sdk.QueuePolicy{})
+	config.SignedTxStore = sdk.NewInMemorySignedTxStore()
+	cdc := mocks.NewFixtureCodec()
+	backend := mocks.NewConformanceBackend()
+	bc := NewBaseClientWithRPC(cdc, &config, backend)
+	dryRun := bc.WithDryRun()
+	require.False(t, bc.IsDryRun())
+	require.True(t, dryRun.IsDryRun())
+
+	user := testutil.NewAccounts(t, testutil.FixtureAccountsSeed, 1)[0]
+	coins, err := sdk.ParseDecCoins("10.24okt")
+	require.NoError(t, err)
+	msgs := []sdk.Msg{tokentypes.NewMsgTokenSend(user.Address, user.Address, coins)}
+
+	// the tx signed in the dry run takes no sequence
+	signedTx, err := dryRun.BuildSignedTxWithSigner(user.Signer(), "my memo", msgs, 1, 2)
+	require.NoError(t, err)
+	backend.SetResponse(simulationPath, signedTx.Bytes, abci.ResponseQuery{
+		Value: cdc.MustMarshalBinaryLengthPrefixed(sdk.Result{
+			Log:       `[{"msg_index":0,"success":true,"log":""}]`,
+			GasWanted: 200000,
+			GasUsed:   51234,
+		}),
+	})
+
+	resp, err := dryRun.BuildAndBroadcastWithSigner(user.Signer(), "my memo", msgs, 1, 2)
+	require.NoError(t, err)
+	require.Equal(t, signedTx.Hash, resp.TxHash)
+	require.Equal(t, int64(51234), resp.GasUsed)
+	require.Equal(t, int64(200000), resp.GasWanted)
+	require.Zero(t, resp.Height)
+	require.Len(t, resp.Logs, 1)
+	require.True(t, resp.Logs[0].Success)
+
+	_, ok := config.SequenceManager.Next(user.Address)
+	require.False(t, ok)
+	records, err := config.SignedTxStore.Pending("testChain", user.Address, 2, time.Now())
+	require.NoError(t, err)
+	require.Empty(t, records)
+
+	// the failed simulation is returned with its code and log
+	resp, err = dryRun.BuildAndBroadcastWithSigner(user.Signer(), "another memo", msgs, 1, 2)
+	require.Error(t, err)
+	require.Equal(t, uint32(sdk.CodeUnknownRequest), resp.Code)
+	require.NotEmpty(t, resp.TxHash)
+
+	// the raw tx is simulated as well
+	resp, err = dryRun.BroadcastRawTx(signedTx.Bytes, "")
+	require.NoError(t, err)
+	require.Equal(t, int64(51234), resp.GasUsed)
+
+	// never simulated without the dry run
+	_, err = bc.BuildAndBroadcastWithSigner(user.Signer(), "my memo", msgs, 1, 2)
+	require.Error(t, err)
+	for _, call := range backend.Calls() {
+		require.Equal(t, simulationPath, call.Path)
+	}
+	require.Len(t, backend.Calls(), 3)
+}
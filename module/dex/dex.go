@@ -1,11 +1,17 @@
 package dex
 
 import (
-	"github.com/okex/okchain-go-sdk/exposed"
+	"github.com/okex/okchain-go-sdk/module/dex/exposed"
 	"github.com/okex/okchain-go-sdk/module/dex/types"
 	sdk "github.com/okex/okchain-go-sdk/types"
 )
 
+func init() {
+	sdk.RegisterModule(types.ModuleName, func(baseClient sdk.BaseClient) sdk.Module {
+		return NewDexClient(baseClient)
+	})
+}
+
 var _ sdk.Module = (*dexClient)(nil)
 
 type dexClient struct {
@@ -6,6 +6,7 @@ import (
 	"github.com/okex/okchain-go-sdk/module/dex/types"
 	sdk "github.com/okex/okchain-go-sdk/types"
 	"github.com/okex/okchain-go-sdk/types/crypto/keys"
+	sdkerrors "github.com/okex/okchain-go-sdk/types/errors"
 	"github.com/okex/okchain-go-sdk/types/tx"
 	"github.com/okex/okchain-go-sdk/utils"
 	"io/ioutil"
@@ -19,12 +20,12 @@ func (dc dexClient) GenerateUnsignedTransferOwnershipTx(product, fromAddrStr, to
 
 	fromAddr, err := sdk.AccAddressFromBech32(fromAddrStr)
 	if err != nil {
-		return fmt.Errorf("failed. parse Address [%s] error: %s", fromAddrStr, err)
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "failed. parse Address [%s] error: %s", fromAddrStr, err)
 	}
 
 	toAddr, err := sdk.AccAddressFromBech32(toAddrStr)
 	if err != nil {
-		return fmt.Errorf("failed. parse Address [%s] error: %s", toAddr, err)
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "failed. parse Address [%s] error: %s", toAddr, err)
 	}
 
 	msg := types.NewMsgTransferOwnership(fromAddr, toAddr, product)
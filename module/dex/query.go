@@ -1,11 +1,18 @@
 package dex
 
 import (
+	"fmt"
+
 	"github.com/okex/okchain-go-sdk/module/dex/types"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	sdkerrors "github.com/okex/okchain-go-sdk/types/errors"
 	"github.com/okex/okchain-go-sdk/types/params"
 	"github.com/okex/okchain-go-sdk/utils"
 )
 
+// productsPerPage is the page size to scan the token pairs
+const productsPerPage = 100
+
 // QueryProducts gets token pair info
 func (dc dexClient) QueryProducts(ownerAddr string, page, perPage int) (tokenPairs []types.TokenPair, err error) {
 	queryParams, err := params.NewQueryDexInfoParams(ownerAddr, page, perPage)
@@ -29,3 +36,53 @@ func (dc dexClient) QueryProducts(ownerAddr string, page, perPage int) (tokenPai
 
 	return
 }
+
+// QueryProductsPaged gets token pair info on a page with the paging info
+// The total count is unknown because the node doesn't report it
+func (dc dexClient) QueryProductsPaged(ownerAddr string, pageReq sdk.PageRequest) (tokenPairs []types.TokenPair,
+	pagedRes sdk.PagedResult, err error) {
+	if err = pageReq.ValidateBasic(); err != nil {
+		return
+	}
+
+	if tokenPairs, err = dc.QueryProducts(ownerAddr, pageReq.Page, pageReq.Limit); err != nil {
+		return
+	}
+
+	return tokenPairs, sdk.NewPagedResult(pageReq, len(tokenPairs), sdk.TotalUnknown), err
+}
+
+// QueryProduct gets the token pair of a product, e.g. "btc-000_okt", by scanning all the token pairs listed, since the
+// node has no query of a single one. It fails by sdkerrors.ErrProductNotListed if the product isn't listed
+func (dc dexClient) QueryProduct(product string) (tokenPair types.TokenPair, err error) {
+	for page := 1; ; page++ {
+		tokenPairs, err := dc.QueryProducts("", page, productsPerPage)
+		if err != nil {
+			return tokenPair, fmt.Errorf("failed. query token pairs error: %w", err)
+		}
+
+		for _, pair := range tokenPairs {
+			if pair.Product() == product {
+				return pair, nil
+			}
+		}
+		if len(tokenPairs) < productsPerPage {
+			return tokenPair, sdkerrors.Wrapf(sdkerrors.ErrProductNotListed, "failed. product %s isn't listed on dex",
+				product)
+		}
+	}
+}
+
+// QueryDexParams gets the params of the dex module, e.g. the fee to list a product
+func (dc dexClient) QueryDexParams() (dexParams types.Params, err error) {
+	res, err := dc.Query(types.ParamsPath, nil)
+	if err != nil {
+		return dexParams, utils.ErrClientQuery(err.Error())
+	}
+
+	if err = dc.GetCodec().UnmarshalJSON(res, &dexParams); err != nil {
+		return dexParams, utils.ErrUnmarshalJSON(err.Error())
+	}
+
+	return
+}
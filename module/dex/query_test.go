@@ -8,6 +8,7 @@ import (
 	"github.com/okex/okchain-go-sdk/mocks"
 	"github.com/okex/okchain-go-sdk/module/dex/types"
 	sdk "github.com/okex/okchain-go-sdk/types"
+	sdkerrors "github.com/okex/okchain-go-sdk/types/errors"
 	"github.com/okex/okchain-go-sdk/types/params"
 	"github.com/stretchr/testify/require"
 	cmn "github.com/tendermint/tendermint/libs/common"
@@ -93,3 +94,67 @@ func TestDexClient_QueryProducts(t *testing.T) {
 	require.Error(t, err)
 
 }
+
+func TestDexClient_QueryProduct(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	config, err := sdk.NewClientConfig("testURL", "testChain", sdk.BroadcastBlock, "", 200000,
+		1.1, "0.00000001okt")
+	require.NoError(t, err)
+	mockCli := mocks.NewMockClient(t, ctrl, config)
+	mockCli.RegisterModule(NewDexClient(mockCli.MockBaseClient))
+
+	ownerAddr, err := sdk.AccAddressFromBech32(addr)
+	require.NoError(t, err)
+	expectedRet := mockCli.BuildTokenPairsBytes("btc-000", "eth-000", "okt", sdk.OneDec(), sdk.OneDec(), 4, 4,
+		512, 1024, 2048, 4096, false, ownerAddr, sdk.NewDecCoinFromDec("okt", sdk.ZeroDec()))
+	expectedCdc := mockCli.GetCodec()
+	queryParams, err := params.NewQueryDexInfoParams("", 1, productsPerPage)
+	require.NoError(t, err)
+	queryBytes := expectedCdc.MustMarshalJSON(queryParams)
+
+	mockCli.EXPECT().GetCodec().Return(expectedCdc).AnyTimes()
+	mockCli.EXPECT().Query(types.ProductsPath, cmn.HexBytes(queryBytes)).Return(expectedRet, nil).Times(2)
+	tokenPair, err := mockCli.Dex().QueryProduct("eth-000_okt")
+	require.NoError(t, err)
+	require.Equal(t, uint64(4096), tokenPair.ID)
+	require.Equal(t, "eth-000_okt", tokenPair.Product())
+
+	_, err = mockCli.Dex().QueryProduct("xxb-000_okt")
+	require.True(t, errors.Is(err, sdkerrors.ErrProductNotListed))
+
+	mockCli.EXPECT().Query(types.ProductsPath, cmn.HexBytes(queryBytes)).Return(nil, errors.New("default error"))
+	_, err = mockCli.Dex().QueryProduct(product)
+	require.Error(t, err)
+}
+
+func TestDexClient_QueryDexParams(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	config, err := sdk.NewClientConfig("testURL", "testChain", sdk.BroadcastBlock, "", 200000,
+		1.1, "0.00000001okt")
+	require.NoError(t, err)
+	mockCli := mocks.NewMockClient(t, ctrl, config)
+	mockCli.RegisterModule(NewDexClient(mockCli.MockBaseClient))
+
+	expectedCdc := mockCli.GetCodec()
+	expectedRet := expectedCdc.MustMarshalJSON(types.Params{
+		ListFee:              sdk.NewDecCoinFromDec("okt", sdk.NewDec(20000)),
+		TransferOwnershipFee: sdk.NewDecCoinFromDec("okt", sdk.NewDec(10)),
+	})
+
+	mockCli.EXPECT().GetCodec().Return(expectedCdc)
+	mockCli.EXPECT().Query(types.ParamsPath, nil).Return(expectedRet, nil)
+	dexParams, err := mockCli.Dex().QueryDexParams()
+	require.NoError(t, err)
+	require.Equal(t, "20000.00000000okt", dexParams.ListFee.String())
+
+	mockCli.EXPECT().Query(types.ParamsPath, nil).Return(nil, errors.New("default error"))
+	_, err = mockCli.Dex().QueryDexParams()
+	require.Error(t, err)
+
+	mockCli.EXPECT().GetCodec().Return(expectedCdc)
+	mockCli.EXPECT().Query(types.ParamsPath, nil).Return(expectedRet[1:], nil)
+	_, err = mockCli.Dex().QueryDexParams()
+	require.Error(t, err)
+}
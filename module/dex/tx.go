@@ -5,6 +5,7 @@ import (
 	"github.com/okex/okchain-go-sdk/module/dex/types"
 	sdk "github.com/okex/okchain-go-sdk/types"
 	"github.com/okex/okchain-go-sdk/types/crypto/keys"
+	sdkerrors "github.com/okex/okchain-go-sdk/types/errors"
 	"github.com/okex/okchain-go-sdk/types/params"
 	"github.com/okex/okchain-go-sdk/utils"
 )
@@ -24,34 +25,36 @@ func (dc dexClient) List(fromInfo keys.Info, passWd, baseAsset, quoteAsset, init
 }
 
 // Deposit deposits some tokens to a specific product
-func (dc dexClient) Deposit(fromInfo keys.Info, passWd, product, amountStr, memo string, accNum, seqNum uint64) (
+func (dc dexClient) Deposit(fromInfo keys.Info, passWd, product string, amount sdk.Amount, memo string, accNum,
+	seqNum uint64) (
 	resp sdk.TxResponse, err error) {
 	if err = params.CheckProductParams(fromInfo, passWd, product); err != nil {
 		return
 	}
 
-	amount, err := sdk.ParseDecCoin(amountStr)
+	coin, err := amount.Coin()
 	if err != nil {
-		return
+		return resp, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "failed. invalid amount [%s]: %s", amount, err)
 	}
-	msg := types.NewMsgDeposit(fromInfo.GetAddress(), product, amount)
+	msg := types.NewMsgDeposit(fromInfo.GetAddress(), product, coin)
 
 	return dc.BuildAndBroadcast(fromInfo.GetName(), passWd, memo, []sdk.Msg{msg}, accNum, seqNum)
 
 }
 
 // Withdraw withdraws some tokens from a specific product
-func (dc dexClient) Withdraw(fromInfo keys.Info, passWd, product, amountStr, memo string, accNum, seqNum uint64) (
+func (dc dexClient) Withdraw(fromInfo keys.Info, passWd, product string, amount sdk.Amount, memo string, accNum,
+	seqNum uint64) (
 	resp sdk.TxResponse, err error) {
 	if err = params.CheckProductParams(fromInfo, passWd, product); err != nil {
 		return
 	}
 
-	amount, err := sdk.ParseDecCoin(amountStr)
+	coin, err := amount.Coin()
 	if err != nil {
-		return
+		return resp, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "failed. invalid amount [%s]: %s", amount, err)
 	}
-	msg := types.NewMsgWithdraw(fromInfo.GetAddress(), product, amount)
+	msg := types.NewMsgWithdraw(fromInfo.GetAddress(), product, coin)
 
 	return dc.BuildAndBroadcast(fromInfo.GetName(), passWd, memo, []sdk.Msg{msg}, accNum, seqNum)
 
@@ -81,20 +81,20 @@ func TestDexClient_Deposit(t *testing.T) {
 	mockCli.EXPECT().BuildAndBroadcast(
 		fromInfo.GetName(), passWd, memo, gomock.AssignableToTypeOf([]sdk.Msg{}), accInfo.GetAccountNumber(),
 		accInfo.GetSequence()).Return(mocks.DefaultMockSuccessTxResponse(), nil)
-	res, err := mockCli.Dex().Deposit(fromInfo, passWd, product, "10.24okt", memo,
+	res, err := mockCli.Dex().Deposit(fromInfo, passWd, product, sdk.MustParseAmount("10.24okt"), memo,
 		accInfo.GetAccountNumber(), accInfo.GetSequence())
 	require.NoError(t, err)
 	require.Equal(t, uint32(0), res.Code)
 
-	_, err = mockCli.Dex().Deposit(fromInfo, passWd, product, "10.24", memo,
+	_, err = mockCli.Dex().Deposit(fromInfo, passWd, product, sdk.Amount{}, memo,
 		accInfo.GetAccountNumber(), accInfo.GetSequence())
 	require.Error(t, err)
 
-	_, err = mockCli.Dex().Deposit(fromInfo, passWd, "", "10.24okt", memo,
+	_, err = mockCli.Dex().Deposit(fromInfo, passWd, "", sdk.MustParseAmount("10.24okt"), memo,
 		accInfo.GetAccountNumber(), accInfo.GetSequence())
 	require.Error(t, err)
 
-	_, err = mockCli.Dex().Deposit(fromInfo, "", product, "10.24okt", memo,
+	_, err = mockCli.Dex().Deposit(fromInfo, "", product, sdk.MustParseAmount("10.24okt"), memo,
 		accInfo.GetAccountNumber(), accInfo.GetSequence())
 	require.Error(t, err)
 }
@@ -122,20 +122,20 @@ func TestDexClient_Withdraw(t *testing.T) {
 	mockCli.EXPECT().BuildAndBroadcast(
 		fromInfo.GetName(), passWd, memo, gomock.AssignableToTypeOf([]sdk.Msg{}), accInfo.GetAccountNumber(),
 		accInfo.GetSequence()).Return(mocks.DefaultMockSuccessTxResponse(), nil)
-	res, err := mockCli.Dex().Withdraw(fromInfo, passWd, product, "1.024okt", memo,
+	res, err := mockCli.Dex().Withdraw(fromInfo, passWd, product, sdk.MustParseAmount("1.024okt"), memo,
 		accInfo.GetAccountNumber(), accInfo.GetSequence())
 	require.NoError(t, err)
 	require.Equal(t, uint32(0), res.Code)
 
-	_, err = mockCli.Dex().Withdraw(fromInfo, passWd, "", "1.024okt", memo,
+	_, err = mockCli.Dex().Withdraw(fromInfo, passWd, "", sdk.MustParseAmount("1.024okt"), memo,
 		accInfo.GetAccountNumber(), accInfo.GetSequence())
 	require.Error(t, err)
 
-	_, err = mockCli.Dex().Withdraw(fromInfo, "", product, "1.024okt", memo,
+	_, err = mockCli.Dex().Withdraw(fromInfo, "", product, sdk.MustParseAmount("1.024okt"), memo,
 		accInfo.GetAccountNumber(), accInfo.GetSequence())
 	require.Error(t, err)
 
-	_, err = mockCli.Dex().Withdraw(fromInfo, passWd, product, "1.024", memo,
+	_, err = mockCli.Dex().Withdraw(fromInfo, passWd, product, sdk.Amount{}, memo,
 		accInfo.GetAccountNumber(), accInfo.GetSequence())
 	require.Error(t, err)
 }
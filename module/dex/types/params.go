@@ -0,0 +1,20 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/okex/okchain-go-sdk/types"
+)
+
+// ParamsPath is the query path of the dex params
+const ParamsPath = "custom/dex/params"
+
+// Params - structure of the params of the dex module
+type Params struct {
+	ListFee                sdk.DecCoin   `json:"list_fee"`
+	TransferOwnershipFee   sdk.DecCoin   `json:"transfer_ownership_fee"`
+	DelistMaxDepositPeriod time.Duration `json:"delist_max_deposit_period"`
+	DelistMinDeposit       sdk.DecCoins  `json:"delist_min_deposit"`
+	DelistVotingPeriod     time.Duration `json:"delist_voting_period"`
+	WithdrawPeriod         time.Duration `json:"withdraw_period"`
+}
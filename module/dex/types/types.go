@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/okex/okchain-go-sdk/types"
 )
 
@@ -12,12 +14,15 @@ const (
 )
 
 var (
-	msgCdc = sdk.NewCodec()
+	msgCdc sdk.SDKCodec
 )
 
 func init() {
-	sdk.RegisterBasicCodec(msgCdc)
-	RegisterCodec(msgCdc)
+	sdk.RegisterCodecInitializer(func() {
+		msgCdc = sdk.NewCodec()
+		sdk.RegisterBasicCodec(msgCdc)
+		RegisterCodec(msgCdc)
+	})
 }
 
 // RegisterCodec registers the msg type for dex module
@@ -42,3 +47,8 @@ type TokenPair struct {
 	Deposits         sdk.DecCoin    `json:"deposits"`
 	BlockHeight      int64          `json:"block_height"`
 }
+
+// Product returns the name of the product of the token pair, i.e. the base asset and the quote asset joined by "_"
+func (tp TokenPair) Product() string {
+	return fmt.Sprintf("%s_%s", tp.BaseAssetSymbol, tp.QuoteAssetSymbol)
+}
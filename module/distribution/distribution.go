@@ -1,11 +1,17 @@
 package distribution
 
 import (
-	"github.com/okex/okchain-go-sdk/exposed"
+	"github.com/okex/okchain-go-sdk/module/distribution/exposed"
 	"github.com/okex/okchain-go-sdk/module/distribution/types"
 	sdk "github.com/okex/okchain-go-sdk/types"
 )
 
+func init() {
+	sdk.RegisterModule(types.ModuleName, func(baseClient sdk.BaseClient) sdk.Module {
+		return NewDistrClient(baseClient)
+	})
+}
+
 var _ sdk.Module = (*distrClient)(nil)
 
 type distrClient struct {
@@ -0,0 +1,26 @@
+package exposed
+
+import (
+	"github.com/okex/okchain-go-sdk/module/distribution/types"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	"github.com/okex/okchain-go-sdk/types/crypto/keys"
+)
+
+// Distribution shows the expected behavior for inner distribution client
+type Distribution interface {
+	sdk.Module
+	DistrTx
+	DistrQuery
+}
+
+// DistrTx shows the expected tx behavior for inner distribution client
+type DistrTx interface {
+	SetWithdrawAddr(fromInfo keys.Info, passWd, withdrawAddrStr, memo string, accNum, seqNum uint64) (sdk.TxResponse, error)
+	WithdrawRewards(fromInfo keys.Info, passWd, valAddrStr, memo string, accNum, seqNum uint64) (sdk.TxResponse, error)
+}
+
+// DistrQuery shows the expected query behavior for inner distribution client
+type DistrQuery interface {
+	QueryCommunityTax() (sdk.Dec, error)
+	QueryDistrParams() (types.Params, error)
+}
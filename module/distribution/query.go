@@ -0,0 +1,40 @@
+package distribution
+
+import (
+	"github.com/okex/okchain-go-sdk/module/distribution/types"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	"github.com/okex/okchain-go-sdk/utils"
+)
+
+// QueryCommunityTax gets the ratio of the block rewards taxed into the community pool
+func (dc distrClient) QueryCommunityTax() (communityTax sdk.Dec, err error) {
+	res, err := dc.Query(types.CommunityTaxPath, nil)
+	if err != nil {
+		return communityTax, utils.ErrClientQuery(err.Error())
+	}
+
+	if err = dc.GetCodec().UnmarshalJSON(res, &communityTax); err != nil {
+		return communityTax, utils.ErrUnmarshalJSON(err.Error())
+	}
+
+	return
+}
+
+// QueryDistrParams gets the params of the distribution module, i.e. the community tax and whether the withdraw
+// addresses are enabled
+func (dc distrClient) QueryDistrParams() (distrParams types.Params, err error) {
+	if distrParams.CommunityTax, err = dc.QueryCommunityTax(); err != nil {
+		return
+	}
+
+	res, err := dc.Query(types.WithdrawAddrEnabledPath, nil)
+	if err != nil {
+		return distrParams, utils.ErrClientQuery(err.Error())
+	}
+
+	if err = dc.GetCodec().UnmarshalJSON(res, &distrParams.WithdrawAddrEnabled); err != nil {
+		return distrParams, utils.ErrUnmarshalJSON(err.Error())
+	}
+
+	return
+}
@@ -0,0 +1,67 @@
+package distribution
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/okex/okchain-go-sdk/mocks"
+	"github.com/okex/okchain-go-sdk/module/distribution/types"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDistrClient_QueryCommunityTax(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	config, err := sdk.NewClientConfig("testURL", "testChain", sdk.BroadcastBlock, "", 200000,
+		1.1, "0.00000001okt")
+	require.NoError(t, err)
+	mockCli := mocks.NewMockClient(t, ctrl, config)
+	mockCli.RegisterModule(NewDistrClient(mockCli.MockBaseClient))
+
+	expectedCdc := mockCli.GetCodec()
+	expectedRet := expectedCdc.MustMarshalJSON(sdk.MustNewDecFromStr("0.02"))
+
+	mockCli.EXPECT().GetCodec().Return(expectedCdc)
+	mockCli.EXPECT().Query(types.CommunityTaxPath, nil).Return(expectedRet, nil)
+	communityTax, err := mockCli.Distribution().QueryCommunityTax()
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("0.02"), communityTax)
+
+	mockCli.EXPECT().Query(types.CommunityTaxPath, nil).Return(nil, errors.New("default error"))
+	_, err = mockCli.Distribution().QueryCommunityTax()
+	require.Error(t, err)
+
+	mockCli.EXPECT().GetCodec().Return(expectedCdc)
+	mockCli.EXPECT().Query(types.CommunityTaxPath, nil).Return(expectedRet[1:], nil)
+	_, err = mockCli.Distribution().QueryCommunityTax()
+	require.Error(t, err)
+}
+
+func TestDistrClient_QueryDistrParams(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	config, err := sdk.NewClientConfig("testURL", "testChain", sdk.BroadcastBlock, "", 200000,
+		1.1, "0.00000001okt")
+	require.NoError(t, err)
+	mockCli := mocks.NewMockClient(t, ctrl, config)
+	mockCli.RegisterModule(NewDistrClient(mockCli.MockBaseClient))
+
+	expectedCdc := mockCli.GetCodec()
+	communityTax := expectedCdc.MustMarshalJSON(sdk.MustNewDecFromStr("0.02"))
+
+	mockCli.EXPECT().GetCodec().Return(expectedCdc).Times(2)
+	mockCli.EXPECT().Query(types.CommunityTaxPath, nil).Return(communityTax, nil)
+	mockCli.EXPECT().Query(types.WithdrawAddrEnabledPath, nil).Return([]byte("true"), nil)
+	distrParams, err := mockCli.Distribution().QueryDistrParams()
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("0.02"), distrParams.CommunityTax)
+	require.True(t, distrParams.WithdrawAddrEnabled)
+
+	mockCli.EXPECT().GetCodec().Return(expectedCdc)
+	mockCli.EXPECT().Query(types.CommunityTaxPath, nil).Return(communityTax, nil)
+	mockCli.EXPECT().Query(types.WithdrawAddrEnabledPath, nil).Return(nil, errors.New("default error"))
+	_, err = mockCli.Distribution().QueryDistrParams()
+	require.Error(t, err)
+}
@@ -1,10 +1,10 @@
 package distribution
 
 import (
-	"fmt"
 	"github.com/okex/okchain-go-sdk/module/distribution/types"
 	sdk "github.com/okex/okchain-go-sdk/types"
 	"github.com/okex/okchain-go-sdk/types/crypto/keys"
+	sdkerrors "github.com/okex/okchain-go-sdk/types/errors"
 	"github.com/okex/okchain-go-sdk/types/params"
 )
 
@@ -17,7 +17,7 @@ func (dc distrClient) SetWithdrawAddr(fromInfo keys.Info, passWd, withdrawAddrSt
 
 	withdrawAddr, err := sdk.AccAddressFromBech32(withdrawAddrStr)
 	if err != nil {
-		return resp, fmt.Errorf("failed. parse Address [%s] error: %s", withdrawAddrStr, err)
+		return resp, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "failed. parse Address [%s] error: %s", withdrawAddrStr, err)
 	}
 
 	msg := types.NewMsgSetWithdrawAddr(fromInfo.GetAddress(), withdrawAddr)
@@ -35,7 +35,7 @@ func (dc distrClient) WithdrawRewards(fromInfo keys.Info, passWd, valAddrStr, me
 
 	valAddr, err := sdk.ValAddressFromBech32(valAddrStr)
 	if err != nil {
-		return resp, fmt.Errorf("failed. invalid validator address: %s", valAddrStr)
+		return resp, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "failed. invalid validator address: %s", valAddrStr)
 	}
 
 	msg := types.NewMsgWithdrawValCommission(valAddr)
@@ -0,0 +1,19 @@
+package types
+
+import (
+	sdk "github.com/okex/okchain-go-sdk/types"
+)
+
+// query paths of the distribution params
+const (
+	CommunityTaxPath        = "custom/distr/params/community_tax"
+	WithdrawAddrEnabledPath = "custom/distr/params/withdraw_addr_enabled"
+)
+
+// Params - structure of the params of the distribution module
+type Params struct {
+	// CommunityTax is the ratio of the block rewards taxed into the community pool
+	CommunityTax sdk.Dec `json:"community_tax"`
+	// WithdrawAddrEnabled tells the delegators are allowed to set the withdraw addresses of their rewards
+	WithdrawAddrEnabled bool `json:"withdraw_addr_enabled"`
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	stakingtypes "github.com/okex/okchain-go-sdk/module/staking/types"
 	sdk "github.com/okex/okchain-go-sdk/types"
 )
 
@@ -10,11 +11,27 @@ const (
 )
 
 var (
-	msgCdc = sdk.NewCodec()
+	msgCdc sdk.SDKCodec
 )
 
 func init() {
-	RegisterCodec(msgCdc)
+	sdk.RegisterCodecInitializer(func() {
+		msgCdc = sdk.NewCodec()
+		RegisterCodec(msgCdc)
+	})
+
+	sdk.RegisterMsgOrderRule(sdk.MsgOrderRule{
+		Name:   "withdraw-before-delegate",
+		Reason: "the rewards withdrawn are only spendable by the deposits after the withdrawal",
+		Before: func(msg sdk.Msg) bool {
+			_, ok := msg.(MsgWithdrawValCommission)
+			return ok
+		},
+		After: func(msg sdk.Msg) bool {
+			_, ok := msg.(stakingtypes.MsgDelegate)
+			return ok
+		},
+	})
 }
 
 // RegisterCodec registers the msg type for distribution module
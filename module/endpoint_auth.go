@@ -0,0 +1,258 @@
+package module
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	sdk "github.com/okex/okchain-go-sdk/types"
+	rpcCli "github.com/tendermint/tendermint/rpc/client"
+	rpcClientLib "github.com/tendermint/tendermint/rpc/lib/client"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+const wsEndpoint = "/websocket"
+
+// NewHTTP creates the tendermint rpc client of the node URI, whose requests are authenticated by the endpoint auth if
+// it's not nil. The auth requires a https node URI, and all the requests fail over a plaintext connection unless the
+// node URI is a loopback one with AllowPlaintext. The websocket subscriptions go without the auth, which the websocket
+// client of tendermint is unable to send
+func NewHTTP(nodeURI string, auth *sdk.EndpointAuth) *rpcCli.HTTP {
+	if auth == nil {
+		return rpcCli.NewHTTP(nodeURI, wsEndpoint)
+	}
+
+	rt := newAuthRoundTripper(nodeURI, auth)
+	// the tendermint rpc client mangles the path of the url, which is restored by the round tripper
+	remote := nodeURI
+	if rt.endpoint != nil {
+		remote = (&url.URL{Scheme: rt.endpoint.Scheme, User: rt.endpoint.User, Host: rt.endpoint.Host}).String()
+	}
+	return rpcCli.NewHTTPWithClient(remote, wsEndpoint, &http.Client{Transport: rt})
+}
+
+// authTokens - structure of the auth of an endpoint with the token refreshed by it
+type authTokens struct {
+	auth   sdk.EndpointAuth
+	mtx    sync.Mutex
+	token  string
+	expiry time.Time
+	// fetched tells whether the token is fetched by the refresher, or it's the static one
+	fetched bool
+	now     func() time.Time
+}
+
+func newAuthTokens(auth sdk.EndpointAuth) *authTokens {
+	return &authTokens{
+		auth:  auth,
+		token: auth.Token,
+		now:   time.Now,
+	}
+}
+
+// headers returns the headers of a request with the token, which is refreshed first if it's missing or expiring
+func (at *authTokens) headers() (map[string]string, error) {
+	if err := at.auth.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	headers := make(map[string]string, len(at.auth.Headers)+1)
+	for name, value := range at.auth.Headers {
+		headers[name] = value
+	}
+	token, err := at.getToken()
+	if err != nil {
+		return nil, err
+	}
+	if len(token) != 0 {
+		name, value := at.auth.TokenHeaderValue(token)
+		headers[name] = value
+	}
+	return headers, nil
+}
+
+func (at *authTokens) getToken() (string, error) {
+	at.mtx.Lock()
+	defer at.mtx.Unlock()
+	if at.auth.Refresh == nil {
+		return at.token, nil
+	}
+
+	expiring := !at.expiry.IsZero() && !at.now().Add(at.auth.GetRefreshMargin()).Before(at.expiry)
+	if at.fetched && !expiring {
+		return at.token, nil
+	}
+	token, expiry, err := at.auth.Refresh()
+	if err != nil {
+		return "", fmt.Errorf("failed. refresh auth token error: %w", err)
+	}
+	at.token, at.expiry, at.fetched = token, expiry, true
+	return token, nil
+}
+
+// invalidate drops the token rejected by the endpoint, and tells whether a new one is able to be fetched
+func (at *authTokens) invalidate() bool {
+	at.mtx.Lock()
+	defer at.mtx.Unlock()
+	at.fetched = false
+	return at.auth.Refresh != nil
+}
+
+// authRoundTripper - structure of the http transport adding the auth of the endpoint to the requests
+type authRoundTripper struct {
+	// endpoint is the url of the node URI with its path and query, which are dropped from the url given to the
+	// tendermint rpc client. nil for the non-http node URIs, e.g. a unix socket
+	endpoint *url.URL
+	tokens   *authTokens
+	base     http.RoundTripper
+	// err is the error of the insecure transport of the auth, returned by every request
+	err error
+}
+
+func newAuthRoundTripper(nodeURI string, auth *sdk.EndpointAuth) *authRoundTripper {
+	rt := &authRoundTripper{
+		tokens: newAuthTokens(*auth),
+		base:   rpcClientLib.DefaultHTTPClient(nodeURI).Transport,
+		err:    checkTransportSecurity(nodeURI, *auth),
+	}
+	if endpoint, err := url.Parse(nodeURI); err == nil && (endpoint.Scheme == "http" || endpoint.Scheme == "https") {
+		endpoint.Fragment = ""
+		rt.endpoint = endpoint
+		rt.base = &http.Transport{
+			Proxy:              http.ProxyFromEnvironment,
+			DisableCompression: true,
+		}
+	}
+	return rt
+}
+
+// RoundTrip sends the request with the auth, and retries it once with a new token if the token is rejected
+func (rt *authRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := rt.roundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized || req.GetBody == nil || !rt.tokens.invalidate() {
+		return resp, err
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return resp, nil
+	}
+	resp.Body.Close() // nolint: errcheck
+	retry := req.Clone(req.Context())
+	retry.Body = body
+	return rt.roundTrip(retry)
+}
+
+func (rt *authRoundTripper) roundTrip(req *http.Request) (*http.Response, error) {
+	headers, err := rt.tokens.headers()
+	if err == nil {
+		err = rt.err
+	}
+	if err != nil {
+		if req.Body != nil {
+			req.Body.Close() // nolint: errcheck
+		}
+		return nil, err
+	}
+
+	// a round tripper must not modify the request given
+	req = req.Clone(req.Context())
+	if rt.endpoint != nil {
+		req.URL.Scheme, req.URL.Host = rt.endpoint.Scheme, rt.endpoint.Host
+		req.URL.Path, req.URL.RawPath, req.URL.RawQuery = rt.endpoint.Path, rt.endpoint.RawPath, rt.endpoint.RawQuery
+		req.Host = rt.endpoint.Host
+	}
+	if len(rt.tokens.auth.QueryParams) != 0 {
+		query := req.URL.Query()
+		for name, value := range rt.tokens.auth.QueryParams {
+			query.Set(name, value)
+		}
+		req.URL.RawQuery = query.Encode()
+	}
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+	return rt.base.RoundTrip(req)
+}
+
+// checkTransportSecurity refuses to send the auth over a plaintext connection, except to a loopback node URI with
+// AllowPlaintext for local use. The unix sockets never leave the host
+func checkTransportSecurity(nodeURI string, auth sdk.EndpointAuth) error {
+	// the node URI without the scheme is dialed by tcp, e.g. "127.0.0.1:26657"
+	if !strings.Contains(nodeURI, "://") {
+		nodeURI = "tcp://" + nodeURI
+	}
+	endpoint, err := url.Parse(nodeURI)
+	if err != nil {
+		return fmt.Errorf("failed. parse node URI of the endpoint auth error: %w", err)
+	}
+
+	switch endpoint.Scheme {
+	case "https", "unix":
+		return nil
+	}
+	if auth.AllowPlaintext && isLoopbackHost(endpoint.Hostname()) {
+		return nil
+	}
+	return fmt.Errorf("failed. endpoint auth over the plaintext %s connection to %s is refused, which requires https",
+		endpoint.Scheme, endpoint.Host)
+}
+
+func isLoopbackHost(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+var _ credentials.PerRPCCredentials = grpcAuth{}
+
+// grpcAuth - structure of the credentials sending the headers of the endpoint auth as the metadata of the gRPC calls
+type grpcAuth struct {
+	tokens     *authTokens
+	requireTLS bool
+}
+
+// newGRPCDialOptions creates the dial options of the gRPC connection to the address, which is plaintext without the
+// endpoint auth. The auth is only sent over TLS, except to a loopback address with AllowPlaintext for local use
+func newGRPCDialOptions(grpcAddr string, auth *sdk.EndpointAuth) []grpc.DialOption {
+	if auth == nil {
+		return []grpc.DialOption{grpc.WithInsecure()}
+	}
+
+	host, _, err := net.SplitHostPort(grpcAddr)
+	if err == nil && auth.AllowPlaintext && isLoopbackHost(host) {
+		return []grpc.DialOption{grpc.WithInsecure(), grpc.WithPerRPCCredentials(grpcAuth{newAuthTokens(*auth), false})}
+	}
+	return []grpc.DialOption{
+		grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{})),
+		grpc.WithPerRPCCredentials(grpcAuth{newAuthTokens(*auth), true}),
+	}
+}
+
+// GetRequestMetadata returns the headers of the endpoint auth with the lowercase keys of the metadata
+func (ga grpcAuth) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
+	headers, err := ga.tokens.headers()
+	if err != nil {
+		return nil, err
+	}
+
+	metadata := make(map[string]string, len(headers))
+	for name, value := range headers {
+		metadata[strings.ToLower(name)] = value
+	}
+	return metadata, nil
+}
+
+// RequireTransportSecurity refuses the plaintext connections unless allowed for local use
+func (ga grpcAuth) RequireTransportSecurity() bool {
+	return ga.requireTLS
+}
@@ -0,0 +1,173 @@
+package module
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	sdk "github.com/okex/okchain-go-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeProvider - structure of a hosted rpc provider accepting the requests with the API key and the current token
+type fakeProvider struct {
+	mtx      sync.Mutex
+	token    string
+	requests []*http.Request
+}
+
+func (fp *fakeProvider) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	fp.mtx.Lock()
+	fp.requests = append(fp.requests, r)
+	token := fp.token
+	fp.mtx.Unlock()
+
+	if r.URL.Path != "/v1/rpc" || r.URL.Query().Get("apikey") != "key" || r.URL.Query().Get("region") != "eu" ||
+		r.Header.Get("X-Api-Key") != "key" || r.Header.Get("Authorization") != "Bearer "+token {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	var req struct {
+		ID json.RawMessage `json:"id"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":{}}`, req.ID)
+}
+
+func (fp *fakeProvider) rotate(token string) {
+	fp.mtx.Lock()
+	defer fp.mtx.Unlock()
+	fp.token = token
+}
+
+func TestNewHTTP_EndpointAuth(t *testing.T) {
+	provider := &fakeProvider{token: "token-1"}
+	server := httptest.NewServer(provider)
+	defer server.Close()
+
+	var refreshes int
+	auth := &sdk.EndpointAuth{
+		Headers:     map[string]string{"x-api-key": "key"},
+		QueryParams: map[string]string{"apikey": "key"},
+		Refresh: func() (string, time.Time, error) {
+			refreshes++
+			return fmt.Sprintf("token-%d", refreshes), time.Time{}, nil
+		},
+		// the test server is a plaintext one on the loopback
+		AllowPlaintext: true,
+	}
+	cli := NewHTTP(server.URL+"/v1/rpc?region=eu", auth)
+	_, err := cli.Health()
+	require.NoError(t, err)
+	_, err = cli.Health()
+	require.NoError(t, err)
+	require.Equal(t, 1, refreshes)
+
+	// the token rejected is refreshed and the request retried once
+	provider.rotate("token-2")
+	_, err = cli.Health()
+	require.NoError(t, err)
+	require.Equal(t, 2, refreshes)
+	require.Len(t, provider.requests, 4)
+
+	provider.rotate("token-9")
+	_, err = cli.Health()
+	require.Error(t, err)
+	require.Equal(t, 3, refreshes)
+
+	// without the auth
+	_, err = NewHTTP(server.URL+"/v1/rpc?region=eu", nil).Health()
+	require.Error(t, err)
+
+	// the auth is never sent over the plaintext connection without the opt-out
+	requests := len(provider.requests)
+	plaintext := *auth
+	plaintext.AllowPlaintext = false
+	_, err = NewHTTP(server.URL+"/v1/rpc?region=eu", &plaintext).Health()
+	require.Error(t, err)
+	require.Len(t, provider.requests, requests)
+}
+
+func TestCheckTransportSecurity(t *testing.T) {
+	plaintext := sdk.EndpointAuth{AllowPlaintext: true}
+	for _, tc := range []struct {
+		nodeURI string
+		auth    sdk.EndpointAuth
+		secure  bool
+	}{
+		{"https://rpc.provider.com/v1/key", sdk.EndpointAuth{}, true},
+		{"unix:///var/run/tendermint.sock", sdk.EndpointAuth{}, true},
+		{"http://rpc.provider.com/v1/key", sdk.EndpointAuth{}, false},
+		{"tcp://127.0.0.1:26657", sdk.EndpointAuth{}, false},
+		{"127.0.0.1:26657", sdk.EndpointAuth{}, false},
+		{"tcp://127.0.0.1:26657", plaintext, true},
+		{"127.0.0.1:26657", plaintext, true},
+		{"http://localhost:26657", plaintext, true},
+		{"http://[::1]:26657", plaintext, true},
+		// the opt-out is for local use only
+		{"http://rpc.provider.com/v1/key", plaintext, false},
+		{"tcp://10.0.0.1:26657", plaintext, false},
+	} {
+		err := checkTransportSecurity(tc.nodeURI, tc.auth)
+		require.Equal(t, tc.secure, err == nil, tc.nodeURI)
+	}
+}
+
+func TestAuthTokens(t *testing.T) {
+	now := time.Unix(1600000000, 0)
+	var refreshes int
+	tokens := newAuthTokens(sdk.EndpointAuth{
+		Token:       "static",
+		TokenHeader: "x-token",
+		Refresh: func() (string, time.Time, error) {
+			refreshes++
+			if refreshes == 3 {
+				return "", time.Time{}, errors.New("provider down")
+			}
+			return fmt.Sprintf("token-%d", refreshes), now.Add(time.Minute), nil
+		},
+	})
+	tokens.now = func() time.Time { return now }
+
+	headers, err := tokens.headers()
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"x-token": "token-1"}, headers)
+
+	// refreshed within the margin before the expiry
+	now = now.Add(29 * time.Second)
+	headers, err = tokens.headers()
+	require.NoError(t, err)
+	require.Equal(t, "token-1", headers["x-token"])
+	now = now.Add(time.Second)
+	headers, err = tokens.headers()
+	require.NoError(t, err)
+	require.Equal(t, "token-2", headers["x-token"])
+
+	now = now.Add(time.Minute)
+	_, err = tokens.headers()
+	require.Error(t, err)
+
+	_, err = newAuthTokens(sdk.EndpointAuth{RefreshMargin: -1}).headers()
+	require.Error(t, err)
+}
+
+func TestGRPCAuth(t *testing.T) {
+	creds := grpcAuth{newAuthTokens(sdk.EndpointAuth{
+		Headers: map[string]string{"X-Api-Key": "key"},
+		Token:   "token",
+	}), true}
+	metadata, err := creds.GetRequestMetadata(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"x-api-key": "key", "authorization": "Bearer token"}, metadata)
+	require.True(t, creds.RequireTransportSecurity())
+}
@@ -5,4 +5,47 @@ import "github.com/okex/okchain-go-sdk/module/governance/types"
 // const
 const (
 	ModuleName = types.ModuleName
+
+	ProposalKindText               = types.ProposalKindText
+	ProposalKindParamChange        = types.ProposalKindParamChange
+	ProposalKindDelist             = types.ProposalKindDelist
+	ProposalKindCommunityPoolSpend = types.ProposalKindCommunityPoolSpend
+	ProposalKindSoftwareUpgrade    = types.ProposalKindSoftwareUpgrade
+	ProposalKindCancelUpgrade      = types.ProposalKindCancelUpgrade
+	ProposalKindManageWhiteList    = types.ProposalKindManageWhiteList
+
+	VoteOptionYes        = types.VoteOptionYes
+	VoteOptionAbstain    = types.VoteOptionAbstain
+	VoteOptionNo         = types.VoteOptionNo
+	VoteOptionNoWithVeto = types.VoteOptionNoWithVeto
+
+	ProposalStatusDepositPeriod = types.ProposalStatusDepositPeriod
+	ProposalStatusVotingPeriod  = types.ProposalStatusVotingPeriod
+	ProposalStatusPassed        = types.ProposalStatusPassed
+	ProposalStatusRejected      = types.ProposalStatusRejected
+	ProposalStatusFailed        = types.ProposalStatusFailed
+)
+
+type (
+	// nolint
+	Proposal       = types.Proposal
+	ProposalStatus = types.ProposalStatus
+	VoteOption     = types.VoteOption
+	DepositParams  = types.DepositParams
+
+	ProposalJSON                   = types.ProposalJSON
+	ParamChangeProposalJSON        = types.ParamChangeProposalJSON
+	ParamChangeJSON                = types.ParamChangeJSON
+	DelistProposalJSON             = types.DelistProposalJSON
+	CommunityPoolSpendProposalJSON = types.CommunityPoolSpendProposalJSON
+
+	Plan                              = types.Plan
+	SoftwareUpgradeProposalJSON       = types.SoftwareUpgradeProposalJSON
+	CancelSoftwareUpgradeProposalJSON = types.CancelSoftwareUpgradeProposalJSON
+	ManageWhiteListProposalJSON       = types.ManageWhiteListProposalJSON
+	DexParamsProposalJSON             = types.DexParamsProposalJSON
+
+	ProposalKind        = types.ProposalKind
+	ProposalDiagnostic  = types.ProposalDiagnostic
+	ProposalDiagnostics = types.ProposalDiagnostics
 )
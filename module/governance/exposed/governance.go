@@ -0,0 +1,71 @@
+package exposed
+
+import (
+	"github.com/okex/okchain-go-sdk/module/governance/types"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	"github.com/okex/okchain-go-sdk/types/crypto/keys"
+)
+
+// Governance shows the expected behavior for inner governance client
+type Governance interface {
+	sdk.Module
+	GovTx
+	GovQuery
+	GovProposal
+}
+
+// GovTx shows the expected tx behavior for inner governance client
+type GovTx interface {
+	SubmitTextProposal(fromInfo keys.Info, passWd, proposalPath, memo string, accNum, seqNum uint64) (sdk.TxResponse, error)
+	SubmitParamChangeProposal(fromInfo keys.Info, passWd, proposalPath, memo string, accNum, seqNum uint64) (sdk.TxResponse, error)
+	SubmitDelistProposal(fromInfo keys.Info, passWd, proposalPath, memo string, accNum, seqNum uint64) (sdk.TxResponse, error)
+	SubmitCommunityPoolSpendProposal(fromInfo keys.Info, passWd, proposalPath, memo string, accNum, seqNum uint64) (sdk.TxResponse, error)
+	SubmitTextProposalFromStruct(fromInfo keys.Info, passWd string, proposal types.ProposalJSON, memo string, accNum,
+		seqNum uint64) (sdk.TxResponse, error)
+	SubmitParamChangeProposalFromStruct(fromInfo keys.Info, passWd string, proposal types.ParamChangeProposalJSON,
+		memo string, accNum, seqNum uint64) (sdk.TxResponse, error)
+	SubmitDelistProposalFromStruct(fromInfo keys.Info, passWd string, proposal types.DelistProposalJSON, memo string,
+		accNum, seqNum uint64) (sdk.TxResponse, error)
+	SubmitCommunityPoolSpendProposalFromStruct(fromInfo keys.Info, passWd string,
+		proposal types.CommunityPoolSpendProposalJSON, memo string, accNum, seqNum uint64) (sdk.TxResponse, error)
+	SubmitSoftwareUpgradeProposal(fromInfo keys.Info, passWd, proposalPath, memo string, accNum, seqNum uint64) (
+		sdk.TxResponse, error)
+	SubmitSoftwareUpgradeProposalFromStruct(fromInfo keys.Info, passWd string, proposal types.SoftwareUpgradeProposalJSON,
+		memo string, accNum, seqNum uint64) (sdk.TxResponse, error)
+	SubmitCancelSoftwareUpgradeProposal(fromInfo keys.Info, passWd, proposalPath, memo string, accNum, seqNum uint64) (
+		sdk.TxResponse, error)
+	SubmitCancelSoftwareUpgradeProposalFromStruct(fromInfo keys.Info, passWd string,
+		proposal types.CancelSoftwareUpgradeProposalJSON, memo string, accNum, seqNum uint64) (sdk.TxResponse, error)
+	SubmitManageWhiteListProposal(fromInfo keys.Info, passWd, proposalPath, memo string, accNum, seqNum uint64) (
+		sdk.TxResponse, error)
+	SubmitManageWhiteListProposalFromStruct(fromInfo keys.Info, passWd string, proposal types.ManageWhiteListProposalJSON,
+		memo string, accNum, seqNum uint64) (sdk.TxResponse, error)
+	SubmitDexParamsProposalFromStruct(fromInfo keys.Info, passWd string, proposal types.DexParamsProposalJSON,
+		memo string, accNum, seqNum uint64) (sdk.TxResponse, error)
+	Deposit(fromInfo keys.Info, passWd string, deposit sdk.Amount, memo string, proposalID, accNum, seqNum uint64) (
+		sdk.TxResponse, error)
+	Vote(fromInfo keys.Info, passWd string, voteOption types.VoteOption, memo string, proposalID, accNum,
+		seqNum uint64) (sdk.TxResponse, error)
+}
+
+// GovQuery shows the expected query behavior for inner governance client
+type GovQuery interface {
+	QueryProposals(status types.ProposalStatus, pageReq sdk.PageRequest) ([]types.Proposal, sdk.PagedResult, error)
+	QueryDepositParams() (types.DepositParams, error)
+	QueryVotingParams() (types.VotingParams, error)
+	QueryTallyParams() (types.TallyParams, error)
+	QueryGovParams() (types.Params, error)
+	QueryTally(proposalID uint64) (types.TallyResult, error)
+	PreviewTally(proposalID uint64) (types.TallyPreview, error)
+	QueryUpgradePlan() (*types.Plan, error)
+	QueryUpgradeAppliedHeight(name string) (int64, error)
+	// CheckDelistProposal checks the token pair of the delist proposal is listed and not being delisted
+	CheckDelistProposal(proposal types.DelistProposalJSON) error
+}
+
+// GovProposal shows the expected behavior to build the proposals programmatically for inner governance client
+type GovProposal interface {
+	RenderProposal(tmplText string, data, proposal interface{}) error
+	CompleteProposal(proposal interface{}) error
+	RenderProposalJSON(proposal interface{}) ([]byte, error)
+}
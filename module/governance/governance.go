@@ -1,11 +1,17 @@
 package governance
 
 import (
-	"github.com/okex/okchain-go-sdk/exposed"
+	"github.com/okex/okchain-go-sdk/module/governance/exposed"
 	"github.com/okex/okchain-go-sdk/module/governance/types"
 	sdk "github.com/okex/okchain-go-sdk/types"
 )
 
+func init() {
+	sdk.RegisterModule(types.ModuleName, func(baseClient sdk.BaseClient) sdk.Module {
+		return NewGovClient(baseClient)
+	})
+}
+
 var _ sdk.Module = (*govClient)(nil)
 
 type govClient struct {
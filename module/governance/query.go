@@ -0,0 +1,192 @@
+package governance
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/okex/okchain-go-sdk/module/dex"
+	"github.com/okex/okchain-go-sdk/module/governance/types"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	"github.com/okex/okchain-go-sdk/types/params"
+	"github.com/okex/okchain-go-sdk/utils"
+)
+
+// QueryProposals gets the proposals with a specific status on a page with the paging info
+// All the proposals will be returned with the status StatusNil
+func (gc govClient) QueryProposals(status types.ProposalStatus, pageReq sdk.PageRequest) (proposals []types.Proposal,
+	pagedRes sdk.PagedResult, err error) {
+	if err = pageReq.ValidateBasic(); err != nil {
+		return
+	}
+
+	// the node pages nothing but limits, so the pagination is on the client side
+	jsonBytes, err := gc.GetCodec().MarshalJSON(types.NewQueryProposalsParams(status, 0, nil, nil))
+	if err != nil {
+		return proposals, pagedRes, utils.ErrMarshalJSON(err.Error())
+	}
+
+	res, err := gc.Query(types.ProposalsPath, jsonBytes)
+	if err != nil {
+		return proposals, pagedRes, utils.ErrClientQuery(err.Error())
+	}
+
+	var allProposals []types.Proposal
+	if err = gc.GetCodec().UnmarshalJSON(res, &allProposals); err != nil {
+		return proposals, pagedRes, utils.ErrUnmarshalJSON(err.Error())
+	}
+
+	start, end := sdk.PaginateSlice(pageReq, len(allProposals))
+	proposals = allProposals[start:end]
+	return proposals, sdk.NewPagedResult(pageReq, len(proposals), len(allProposals)), err
+}
+
+// QueryDepositParams gets the params around deposits for governance, e.g. the min deposit of a proposal
+func (gc govClient) QueryDepositParams() (depositParams types.DepositParams, err error) {
+	res, err := gc.Query(types.DepositParamsPath, nil)
+	if err != nil {
+		return depositParams, utils.ErrClientQuery(err.Error())
+	}
+
+	if err = gc.GetCodec().UnmarshalJSON(res, &depositParams); err != nil {
+		return depositParams, utils.ErrUnmarshalJSON(err.Error())
+	}
+
+	return
+}
+
+// QueryVotingParams gets the params around voting in governance, e.g. the voting period of a proposal
+func (gc govClient) QueryVotingParams() (votingParams types.VotingParams, err error) {
+	res, err := gc.Query(types.VotingParamsPath, nil)
+	if err != nil {
+		return votingParams, utils.ErrClientQuery(err.Error())
+	}
+
+	if err = gc.GetCodec().UnmarshalJSON(res, &votingParams); err != nil {
+		return votingParams, utils.ErrUnmarshalJSON(err.Error())
+	}
+
+	return
+}
+
+// QueryTallyParams gets the params around the tallying of the votes, e.g. the quorum and the threshold
+func (gc govClient) QueryTallyParams() (tallyParams types.TallyParams, err error) {
+	res, err := gc.Query(types.TallyParamsPath, nil)
+	if err != nil {
+		return tallyParams, utils.ErrClientQuery(err.Error())
+	}
+
+	if err = gc.GetCodec().UnmarshalJSON(res, &tallyParams); err != nil {
+		return tallyParams, utils.ErrUnmarshalJSON(err.Error())
+	}
+
+	return
+}
+
+// QueryGovParams gets all the params of governance, i.e. the deposit, voting and tally params
+func (gc govClient) QueryGovParams() (govParams types.Params, err error) {
+	if govParams.Deposit, err = gc.QueryDepositParams(); err != nil {
+		return
+	}
+	if govParams.Voting, err = gc.QueryVotingParams(); err != nil {
+		return
+	}
+	govParams.Tally, err = gc.QueryTallyParams()
+	return
+}
+
+// QueryTally gets the current tally of the votes on a proposal, whose total power is the bonded power
+func (gc govClient) QueryTally(proposalID uint64) (tally types.TallyResult, err error) {
+	jsonBytes, err := gc.GetCodec().MarshalJSON(params.NewQueryProposalParams(proposalID))
+	if err != nil {
+		return tally, utils.ErrMarshalJSON(err.Error())
+	}
+
+	res, err := gc.Query(types.TallyPath, jsonBytes)
+	if err != nil {
+		return tally, utils.ErrClientQuery(err.Error())
+	}
+
+	if err = gc.GetCodec().UnmarshalJSON(res, &tally); err != nil {
+		return tally, utils.ErrUnmarshalJSON(err.Error())
+	}
+
+	return
+}
+
+// PreviewTally projects the outcome of a proposal in its voting period by the current tally, i.e. whether the quorum
+// is reached and the threshold is met. Whether a vote is decisive is told by the IsDecisive of the preview
+func (gc govClient) PreviewTally(proposalID uint64) (preview types.TallyPreview, err error) {
+	tally, err := gc.QueryTally(proposalID)
+	if err != nil {
+		return
+	}
+
+	tallyParams, err := gc.QueryTallyParams()
+	if err != nil {
+		return
+	}
+
+	return types.PreviewTally(tally, tallyParams), nil
+}
+
+// QueryUpgradePlan gets the plan of the pending software upgrade, nil if no upgrade is scheduled
+func (gc govClient) QueryUpgradePlan() (plan *types.Plan, err error) {
+	res, err := gc.Query(types.UpgradePlanPath, nil)
+	if err != nil {
+		return plan, utils.ErrClientQuery(err.Error())
+	}
+
+	if len(res) == 0 {
+		return
+	}
+
+	plan = new(types.Plan)
+	if err = gc.GetCodec().UnmarshalJSON(res, plan); err != nil {
+		return nil, utils.ErrUnmarshalJSON(err.Error())
+	}
+
+	return
+}
+
+// QueryUpgradeAppliedHeight gets the height at which the software upgrade of the name was applied, 0 if it's never
+// applied
+func (gc govClient) QueryUpgradeAppliedHeight(name string) (height int64, err error) {
+	jsonBytes, err := gc.GetCodec().MarshalJSON(types.QueryAppliedParams{Name: name})
+	if err != nil {
+		return height, utils.ErrMarshalJSON(err.Error())
+	}
+
+	res, err := gc.Query(types.UpgradeAppliedPath, jsonBytes)
+	if err != nil {
+		return height, utils.ErrClientQuery(err.Error())
+	}
+
+	if len(res) == 0 {
+		return
+	}
+	if len(res) != 8 {
+		return height, fmt.Errorf("failed. applied height of %d bytes rather than 8", len(res))
+	}
+
+	return int64(binary.BigEndian.Uint64(res)), nil
+}
+
+// CheckDelistProposal checks the delist proposal against the token pairs listed on dex before it's submitted, which
+// fails by sdkerrors.ErrProductNotListed if the token pair isn't listed, or if it's already being delisted, so that
+// the deposit isn't wasted on an invalid proposal
+func (gc govClient) CheckDelistProposal(proposal types.DelistProposalJSON) error {
+	if err := proposal.ValidateBasic(); err != nil {
+		return err
+	}
+
+	product := fmt.Sprintf("%s_%s", proposal.BaseAsset, proposal.QuoteAsset)
+	tokenPair, err := dex.NewDexClient(gc.BaseClient).QueryProduct(product)
+	if err != nil {
+		return err
+	}
+	if tokenPair.Delisting {
+		return fmt.Errorf("failed. product %s is already being delisted", product)
+	}
+
+	return nil
+}
@@ -0,0 +1,325 @@
+package governance
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/okex/okchain-go-sdk/mocks"
+	dextypes "github.com/okex/okchain-go-sdk/module/dex/types"
+	"github.com/okex/okchain-go-sdk/module/governance/types"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	sdkerrors "github.com/okex/okchain-go-sdk/types/errors"
+	"github.com/okex/okchain-go-sdk/types/params"
+	"github.com/stretchr/testify/require"
+	cmn "github.com/tendermint/tendermint/libs/common"
+)
+
+func TestGovClient_QueryProposals(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	config, err := sdk.NewClientConfig("testURL", "testChain", sdk.BroadcastBlock, "", 200000,
+		1.1, "0.00000001okt")
+	require.NoError(t, err)
+	mockCli := mocks.NewMockClient(t, ctrl, config)
+	mockCli.RegisterModule(NewGovClient(mockCli.MockBaseClient))
+
+	expectedRet := mockCli.BuildProposalsBytes(types.StatusVotingPeriod, 1, 2, 3)
+	expectedCdc := mockCli.GetCodec()
+	queryBytes := cmn.HexBytes(expectedCdc.MustMarshalJSON(types.NewQueryProposalsParams(types.StatusVotingPeriod, 0,
+		nil, nil)))
+
+	mockCli.EXPECT().GetCodec().Return(expectedCdc).Times(2)
+	mockCli.EXPECT().Query(types.ProposalsPath, queryBytes).Return(expectedRet, nil)
+
+	proposals, pagedRes, err := mockCli.Governance().QueryProposals(types.StatusVotingPeriod, sdk.NewPageRequest(1, 2))
+	require.NoError(t, err)
+	require.Equal(t, 2, len(proposals))
+	require.Equal(t, uint64(1), proposals[0].ProposalID)
+	require.Equal(t, types.StatusVotingPeriod, proposals[0].Status)
+	require.Equal(t, "proposal 2", proposals[1].Content.(types.TextProposal).Title)
+	require.Equal(t, 3, pagedRes.Total)
+	require.True(t, pagedRes.HasNext)
+
+	mockCli.EXPECT().GetCodec().Return(expectedCdc).Times(2)
+	mockCli.EXPECT().Query(types.ProposalsPath, queryBytes).Return(expectedRet, nil)
+
+	proposals, pagedRes, err = mockCli.Governance().QueryProposals(types.StatusVotingPeriod, sdk.NewPageRequest(2, 2))
+	require.NoError(t, err)
+	require.Equal(t, 1, len(proposals))
+	require.Equal(t, uint64(3), proposals[0].ProposalID)
+	require.False(t, pagedRes.HasNext)
+
+	_, _, err = mockCli.Governance().QueryProposals(types.StatusVotingPeriod, sdk.PageRequest{})
+	require.Error(t, err)
+
+	mockCli.EXPECT().GetCodec().Return(expectedCdc)
+	mockCli.EXPECT().Query(types.ProposalsPath, queryBytes).Return(nil, errors.New("default error"))
+	_, _, err = mockCli.Governance().QueryProposals(types.StatusVotingPeriod, sdk.NewPageRequest(1, 2))
+	require.Error(t, err)
+
+	mockCli.EXPECT().GetCodec().Return(expectedCdc).Times(2)
+	mockCli.EXPECT().Query(types.ProposalsPath, queryBytes).Return(expectedRet[1:], nil)
+	_, _, err = mockCli.Governance().QueryProposals(types.StatusVotingPeriod, sdk.NewPageRequest(1, 2))
+	require.Error(t, err)
+}
+
+func TestGovClient_QueryDepositParams(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	config, err := sdk.NewClientConfig("testURL", "testChain", sdk.BroadcastBlock, "", 200000,
+		1.1, "0.00000001okt")
+	require.NoError(t, err)
+	mockCli := mocks.NewMockClient(t, ctrl, config)
+	mockCli.RegisterModule(NewGovClient(mockCli.MockBaseClient))
+
+	minDeposit, err := sdk.ParseDecCoins("100okt")
+	require.NoError(t, err)
+	expectedCdc := mockCli.GetCodec()
+	expectedRet := expectedCdc.MustMarshalJSON(types.DepositParams{MinDeposit: minDeposit, MaxDepositPeriod: time.Hour})
+
+	mockCli.EXPECT().GetCodec().Return(expectedCdc)
+	mockCli.EXPECT().Query(types.DepositParamsPath, nil).Return(expectedRet, nil)
+	depositParams, err := mockCli.Governance().QueryDepositParams()
+	require.NoError(t, err)
+	require.True(t, minDeposit.IsEqual(depositParams.MinDeposit))
+	require.Equal(t, time.Hour, depositParams.MaxDepositPeriod)
+
+	mockCli.EXPECT().Query(types.DepositParamsPath, nil).Return(nil, errors.New("default error"))
+	_, err = mockCli.Governance().QueryDepositParams()
+	require.Error(t, err)
+
+	mockCli.EXPECT().GetCodec().Return(expectedCdc)
+	mockCli.EXPECT().Query(types.DepositParamsPath, nil).Return(expectedRet[1:], nil)
+	_, err = mockCli.Governance().QueryDepositParams()
+	require.Error(t, err)
+}
+
+func TestGovClient_QueryGovParams(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	config, err := sdk.NewClientConfig("testURL", "testChain", sdk.BroadcastBlock, "", 200000,
+		1.1, "0.00000001okt")
+	require.NoError(t, err)
+	mockCli := mocks.NewMockClient(t, ctrl, config)
+	mockCli.RegisterModule(NewGovClient(mockCli.MockBaseClient))
+
+	expectedCdc := mockCli.GetCodec()
+	tallyParams := types.TallyParams{
+		Quorum:          sdk.MustNewDecFromStr("0.334"),
+		Threshold:       sdk.MustNewDecFromStr("0.5"),
+		Veto:            sdk.MustNewDecFromStr("0.334"),
+		YesInVotePeriod: sdk.MustNewDecFromStr("0.667"),
+	}
+	mockCli.EXPECT().GetCodec().Return(expectedCdc).Times(3)
+	mockCli.EXPECT().Query(types.DepositParamsPath, nil).Return(
+		expectedCdc.MustMarshalJSON(types.DepositParams{MaxDepositPeriod: time.Hour}), nil)
+	mockCli.EXPECT().Query(types.VotingParamsPath, nil).Return(
+		expectedCdc.MustMarshalJSON(types.VotingParams{VotingPeriod: 2 * time.Hour}), nil)
+	mockCli.EXPECT().Query(types.TallyParamsPath, nil).Return(expectedCdc.MustMarshalJSON(tallyParams), nil)
+	govParams, err := mockCli.Governance().QueryGovParams()
+	require.NoError(t, err)
+	require.Equal(t, time.Hour, govParams.Deposit.MaxDepositPeriod)
+	require.Equal(t, 2*time.Hour, govParams.Voting.VotingPeriod)
+	require.Equal(t, tallyParams, govParams.Tally)
+
+	mockCli.EXPECT().Query(types.DepositParamsPath, nil).Return(nil, errors.New("default error"))
+	_, err = mockCli.Governance().QueryGovParams()
+	require.Error(t, err)
+}
+
+func TestGovClient_CheckDelistProposal(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	config, err := sdk.NewClientConfig("testURL", "testChain", sdk.BroadcastBlock, "", 200000,
+		1.1, "0.00000001okt")
+	require.NoError(t, err)
+	mockCli := mocks.NewMockClient(t, ctrl, config)
+	mockCli.RegisterModule(NewGovClient(mockCli.MockBaseClient))
+
+	deposit, err := sdk.ParseDecCoins("100okt")
+	require.NoError(t, err)
+	proposal := types.DelistProposalJSON{
+		Title:       "delist btc-000_okt",
+		Description: "the pair is inactive",
+		BaseAsset:   "btc-000",
+		QuoteAsset:  "okt",
+		Deposit:     deposit,
+	}
+	expectedCdc := mockCli.GetCodec()
+	mockCli.EXPECT().GetCodec().Return(expectedCdc).AnyTimes()
+	tokenPairs := []dextypes.TokenPair{{BaseAssetSymbol: "btc-000", QuoteAssetSymbol: "okt"}}
+	mockCli.EXPECT().Query(dextypes.ProductsPath, gomock.Any()).Return(expectedCdc.MustMarshalJSON(tokenPairs), nil)
+	require.NoError(t, mockCli.Governance().CheckDelistProposal(proposal))
+
+	// already being delisted
+	tokenPairs[0].Delisting = true
+	mockCli.EXPECT().Query(dextypes.ProductsPath, gomock.Any()).Return(expectedCdc.MustMarshalJSON(tokenPairs), nil)
+	require.Error(t, mockCli.Governance().CheckDelistProposal(proposal))
+
+	// not listed
+	proposal.BaseAsset = "eth-000"
+	mockCli.EXPECT().Query(dextypes.ProductsPath, gomock.Any()).Return(expectedCdc.MustMarshalJSON(tokenPairs), nil)
+	require.True(t, errors.Is(mockCli.Governance().CheckDelistProposal(proposal), sdkerrors.ErrProductNotListed))
+
+	// invalid without querying
+	proposal.QuoteAsset = "eth-000"
+	require.Error(t, mockCli.Governance().CheckDelistProposal(proposal))
+}
+
+func TestPreviewTally(t *testing.T) {
+	tallyParams := types.TallyParams{
+		Quorum:          sdk.MustNewDecFromStr("0.334"),
+		Threshold:       sdk.MustNewDecFromStr("0.5"),
+		Veto:            sdk.MustNewDecFromStr("0.334"),
+		YesInVotePeriod: sdk.MustNewDecFromStr("0.667"),
+	}
+	newTally := func(yes, abstain, no, noWithVeto, total int64) types.TallyResult {
+		return types.TallyResult{
+			Yes:             sdk.NewDec(yes),
+			Abstain:         sdk.NewDec(abstain),
+			No:              sdk.NewDec(no),
+			NoWithVeto:      sdk.NewDec(noWithVeto),
+			TotalPower:      sdk.NewDec(total),
+			TotalVotedPower: sdk.NewDec(yes + abstain + no + noWithVeto),
+		}
+	}
+
+	// short of the quorum
+	preview := types.PreviewTally(newTally(25, 0, 5, 0, 100), tallyParams)
+	require.False(t, preview.QuorumReached)
+	require.Equal(t, sdk.MustNewDecFromStr("0.3"), preview.Turnout)
+	require.Equal(t, sdk.MustNewDecFromStr("3.4"), preview.QuorumShortfall)
+	require.True(t, preview.ThresholdMet)
+	require.False(t, preview.Passing)
+	require.True(t, preview.IsDecisive(sdk.NewDec(4), types.OptionYes))
+	require.True(t, preview.IsDecisive(sdk.NewDec(4), types.OptionNoWithVeto))
+	require.False(t, preview.IsDecisive(sdk.NewDec(2), types.OptionYes))
+
+	// passing with the abstain excluded from the threshold
+	preview = types.PreviewTally(newTally(40, 10, 10, 0, 100), tallyParams)
+	require.True(t, preview.QuorumReached)
+	require.True(t, preview.QuorumShortfall.IsZero())
+	require.Equal(t, sdk.MustNewDecFromStr("0.8"), preview.YesRatio)
+	require.True(t, preview.Passing)
+	require.False(t, preview.YesInVotePeriodReached)
+	require.False(t, preview.IsDecisive(sdk.NewDec(10), types.OptionNo))
+	require.True(t, preview.IsDecisive(sdk.NewDec(30), types.OptionNoWithVeto))
+
+	// vetoed
+	preview = types.PreviewTally(newTally(40, 0, 0, 30, 100), tallyParams)
+	require.True(t, preview.Vetoed)
+	require.False(t, preview.Passing)
+
+	// passing in the voting period
+	preview = types.PreviewTally(newTally(70, 0, 0, 0, 100), tallyParams)
+	require.True(t, preview.YesInVotePeriodReached)
+	require.True(t, preview.Passing)
+
+	// no power bonded or voted
+	require.False(t, types.PreviewTally(types.TallyResult{}, tallyParams).Passing)
+	preview = types.PreviewTally(newTally(0, 0, 0, 0, 100), tallyParams)
+	require.False(t, preview.Passing)
+	require.Equal(t, sdk.MustNewDecFromStr("33.4"), preview.QuorumShortfall)
+	require.False(t, preview.IsDecisive(sdk.NewDec(1), types.OptionYes))
+}
+
+func TestGovClient_PreviewTally(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	config, err := sdk.NewClientConfig("testURL", "testChain", sdk.BroadcastBlock, "", 200000,
+		1.1, "0.00000001okt")
+	require.NoError(t, err)
+	mockCli := mocks.NewMockClient(t, ctrl, config)
+	mockCli.RegisterModule(NewGovClient(mockCli.MockBaseClient))
+
+	expectedCdc := mockCli.GetCodec()
+	tally := types.TallyResult{
+		Yes:             sdk.NewDec(40),
+		Abstain:         sdk.NewDec(10),
+		No:              sdk.NewDec(10),
+		NoWithVeto:      sdk.ZeroDec(),
+		TotalPower:      sdk.NewDec(100),
+		TotalVotedPower: sdk.NewDec(60),
+	}
+	tallyParams := types.TallyParams{
+		Quorum:          sdk.MustNewDecFromStr("0.334"),
+		Threshold:       sdk.MustNewDecFromStr("0.5"),
+		Veto:            sdk.MustNewDecFromStr("0.334"),
+		YesInVotePeriod: sdk.MustNewDecFromStr("0.667"),
+	}
+	queryBytes := cmn.HexBytes(expectedCdc.MustMarshalJSON(params.NewQueryProposalParams(1)))
+	tallyBytes := expectedCdc.MustMarshalJSON(tally)
+	tallyParamsBytes := expectedCdc.MustMarshalJSON(tallyParams)
+
+	mockCli.EXPECT().GetCodec().Return(expectedCdc).Times(3)
+	mockCli.EXPECT().Query(types.TallyPath, queryBytes).Return(tallyBytes, nil)
+	mockCli.EXPECT().Query(types.TallyParamsPath, nil).Return(tallyParamsBytes, nil)
+	preview, err := mockCli.Governance().PreviewTally(1)
+	require.NoError(t, err)
+	require.Equal(t, tally, preview.Tally)
+	require.Equal(t, tallyParams, preview.Params)
+	require.True(t, preview.Passing)
+
+	mockCli.EXPECT().GetCodec().Return(expectedCdc)
+	mockCli.EXPECT().Query(types.TallyPath, queryBytes).Return(nil, errors.New("default error"))
+	_, err = mockCli.Governance().PreviewTally(1)
+	require.Error(t, err)
+
+	mockCli.EXPECT().GetCodec().Return(expectedCdc).Times(2)
+	mockCli.EXPECT().Query(types.TallyPath, queryBytes).Return(tallyBytes, nil)
+	mockCli.EXPECT().Query(types.TallyParamsPath, nil).Return(nil, errors.New("default error"))
+	_, err = mockCli.Governance().PreviewTally(1)
+	require.Error(t, err)
+
+	mockCli.EXPECT().GetCodec().Return(expectedCdc).Times(2)
+	mockCli.EXPECT().Query(types.TallyPath, queryBytes).Return(tallyBytes[1:], nil)
+	_, err = mockCli.Governance().PreviewTally(1)
+	require.Error(t, err)
+}
+
+func TestGovClient_QueryUpgradePlan(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	config, err := sdk.NewClientConfig("testURL", "testChain", sdk.BroadcastBlock, "", 200000,
+		1.1, "0.00000001okt")
+	require.NoError(t, err)
+	mockCli := mocks.NewMockClient(t, ctrl, config)
+	mockCli.RegisterModule(NewGovClient(mockCli.MockBaseClient))
+
+	expectedCdc := mockCli.GetCodec()
+	expectedPlan := types.Plan{Name: "v0.11", Height: 1024, Info: "commit 1a2b3c"}
+	mockCli.EXPECT().GetCodec().Return(expectedCdc).AnyTimes()
+
+	mockCli.EXPECT().Query(types.UpgradePlanPath, nil).Return(expectedCdc.MustMarshalJSON(expectedPlan), nil)
+	plan, err := mockCli.Governance().QueryUpgradePlan()
+	require.NoError(t, err)
+	require.Equal(t, expectedPlan, *plan)
+
+	// no upgrade scheduled
+	mockCli.EXPECT().Query(types.UpgradePlanPath, nil).Return(nil, nil)
+	plan, err = mockCli.Governance().QueryUpgradePlan()
+	require.NoError(t, err)
+	require.Nil(t, plan)
+
+	mockCli.EXPECT().Query(types.UpgradePlanPath, nil).Return(nil, errors.New("default error"))
+	_, err = mockCli.Governance().QueryUpgradePlan()
+	require.Error(t, err)
+
+	queryBytes := cmn.HexBytes(expectedCdc.MustMarshalJSON(types.QueryAppliedParams{Name: "v0.10"}))
+	mockCli.EXPECT().Query(types.UpgradeAppliedPath, queryBytes).Return([]byte{0, 0, 0, 0, 0, 0, 4, 0}, nil)
+	height, err := mockCli.Governance().QueryUpgradeAppliedHeight("v0.10")
+	require.NoError(t, err)
+	require.Equal(t, int64(1024), height)
+
+	// never applied
+	mockCli.EXPECT().Query(types.UpgradeAppliedPath, queryBytes).Return(nil, nil)
+	height, err = mockCli.Governance().QueryUpgradeAppliedHeight("v0.10")
+	require.NoError(t, err)
+	require.Zero(t, height)
+
+	mockCli.EXPECT().Query(types.UpgradeAppliedPath, queryBytes).Return([]byte{4, 0}, nil)
+	_, err = mockCli.Governance().QueryUpgradeAppliedHeight("v0.10")
+	require.Error(t, err)
+}
@@ -0,0 +1,141 @@
+package governance
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/okex/okchain-go-sdk/module/governance/types"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	"github.com/okex/okchain-go-sdk/utils"
+)
+
+// RenderProposal renders the JSON template of a proposal with the data, and decodes it into the proposal, which is
+// the pointer of types.ProposalJSON, types.ParamChangeProposalJSON, types.DelistProposalJSON,
+// types.CommunityPoolSpendProposalJSON, types.SoftwareUpgradeProposalJSON, types.CancelSoftwareUpgradeProposalJSON or
+// types.ManageWhiteListProposalJSON. The proposal is completed and validated by CompleteProposal after that
+func (gc govClient) RenderProposal(tmplText string, data, proposal interface{}) error {
+	tmpl, err := template.New("proposal").Option("missingkey=error").Parse(tmplText)
+	if err != nil {
+		return fmt.Errorf("failed. parse proposal template error: %s", err)
+	}
+
+	var buf bytes.Buffer
+	if err = tmpl.Execute(&buf, data); err != nil {
+		return fmt.Errorf("failed. render proposal template error: %s", err)
+	}
+
+	switch proposal.(type) {
+	case *types.ProposalJSON:
+		// the same as the proposal file
+		err = json.Unmarshal(buf.Bytes(), proposal)
+	case *types.ParamChangeProposalJSON, *types.DelistProposalJSON, *types.CommunityPoolSpendProposalJSON,
+		*types.SoftwareUpgradeProposalJSON, *types.CancelSoftwareUpgradeProposalJSON, *types.ManageWhiteListProposalJSON:
+		err = types.MsgCdc.UnmarshalJSON(buf.Bytes(), proposal)
+	default:
+		return fmt.Errorf("failed. unsupported proposal type %T", proposal)
+	}
+	if err != nil {
+		return utils.ErrUnmarshalJSON(err.Error())
+	}
+
+	return gc.CompleteProposal(proposal)
+}
+
+// CompleteProposal defaults the empty deposit of the proposal to the min deposit in the chain params, and validates
+// it. The proposal is the pointer of the proposal types accepted by RenderProposal
+func (gc govClient) CompleteProposal(proposal interface{}) error {
+	switch p := proposal.(type) {
+	case *types.ProposalJSON:
+		if len(strings.TrimSpace(p.Deposit)) == 0 {
+			minDeposit, err := gc.queryMinDeposit()
+			if err != nil {
+				return err
+			}
+			p.Deposit = decCoinsToString(minDeposit)
+		}
+		return p.ValidateBasic()
+	case *types.ParamChangeProposalJSON:
+		if err := gc.defaultDeposit(&p.Deposit); err != nil {
+			return err
+		}
+		return p.ValidateBasic()
+	case *types.DelistProposalJSON:
+		if err := gc.defaultDeposit(&p.Deposit); err != nil {
+			return err
+		}
+		return p.ValidateBasic()
+	case *types.CommunityPoolSpendProposalJSON:
+		if err := gc.defaultDeposit(&p.Deposit); err != nil {
+			return err
+		}
+		return p.ValidateBasic()
+	case *types.SoftwareUpgradeProposalJSON:
+		if err := gc.defaultDeposit(&p.Deposit); err != nil {
+			return err
+		}
+		return p.ValidateBasic()
+	case *types.CancelSoftwareUpgradeProposalJSON:
+		if err := gc.defaultDeposit(&p.Deposit); err != nil {
+			return err
+		}
+		return p.ValidateBasic()
+	case *types.ManageWhiteListProposalJSON:
+		if err := gc.defaultDeposit(&p.Deposit); err != nil {
+			return err
+		}
+		return p.ValidateBasic()
+	default:
+		return fmt.Errorf("failed. unsupported proposal type %T", proposal)
+	}
+}
+
+// RenderProposalJSON completes and validates the proposal built from Go structs, and renders it to the JSON in the
+// format of the proposal file, which is able to be reviewed or submitted by the file-first workflow
+func (gc govClient) RenderProposalJSON(proposal interface{}) ([]byte, error) {
+	if err := gc.CompleteProposal(proposal); err != nil {
+		return nil, err
+	}
+
+	var (
+		jsonBytes []byte
+		err       error
+	)
+	if _, ok := proposal.(*types.ProposalJSON); ok {
+		jsonBytes, err = json.Marshal(proposal)
+	} else {
+		jsonBytes, err = types.MsgCdc.MarshalJSON(proposal)
+	}
+	if err != nil {
+		return nil, utils.ErrMarshalJSON(err.Error())
+	}
+
+	var buf bytes.Buffer
+	if err = json.Indent(&buf, jsonBytes, "", "  "); err != nil {
+		return nil, utils.ErrMarshalJSON(err.Error())
+	}
+	return buf.Bytes(), nil
+}
+
+func (gc govClient) defaultDeposit(pDeposit *sdk.DecCoins) error {
+	if !pDeposit.IsZero() {
+		return nil
+	}
+
+	minDeposit, err := gc.queryMinDeposit()
+	if err != nil {
+		return err
+	}
+	*pDeposit = minDeposit
+	return nil
+}
+
+func (gc govClient) queryMinDeposit() (sdk.DecCoins, error) {
+	depositParams, err := gc.QueryDepositParams()
+	if err != nil {
+		return nil, fmt.Errorf("failed. query the min deposit for the default deposit error: %s", err)
+	}
+	return depositParams.MinDeposit, nil
+}
@@ -0,0 +1,109 @@
+package governance
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/okex/okchain-go-sdk/mocks"
+	"github.com/okex/okchain-go-sdk/module/governance/types"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+const textProposalTmpl = `{
+  "title": "Upgrade {{.Version}}",
+  "description": "Upgrade the chain to {{.Version}} at height {{.Height}}",
+  "proposal_type": "Text"{{if .Deposit}},
+  "deposit": "{{.Deposit}}"{{end}}
+}`
+
+func TestGovClient_RenderProposal(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	config, err := sdk.NewClientConfig("testURL", "testChain", sdk.BroadcastBlock, "", 200000,
+		1.1, "0.00000001okt")
+	require.NoError(t, err)
+	mockCli := mocks.NewMockClient(t, ctrl, config)
+	mockCli.RegisterModule(NewGovClient(mockCli.MockBaseClient))
+
+	minDeposit, err := sdk.ParseDecCoins("100okt")
+	require.NoError(t, err)
+	expectedCdc := mockCli.GetCodec()
+	depositParamsBytes := expectedCdc.MustMarshalJSON(types.DepositParams{MinDeposit: minDeposit, MaxDepositPeriod: time.Hour})
+	mockCli.EXPECT().GetCodec().Return(expectedCdc).AnyTimes()
+
+	// deposit set in the template
+	var proposal types.ProposalJSON
+	data := map[string]interface{}{"Version": "v0.11", "Height": 1024, "Deposit": "10.24okt"}
+	require.NoError(t, mockCli.Governance().RenderProposal(textProposalTmpl, data, &proposal))
+	require.Equal(t, types.ProposalJSON{
+		Title:        "Upgrade v0.11",
+		Description:  "Upgrade the chain to v0.11 at height 1024",
+		ProposalType: "Text",
+		Deposit:      "10.24okt",
+	}, proposal)
+
+	// deposit defaulting from the chain params
+	mockCli.EXPECT().Query(types.DepositParamsPath, nil).Return(depositParamsBytes, nil)
+	proposal = types.ProposalJSON{}
+	data["Deposit"] = ""
+	require.NoError(t, mockCli.Governance().RenderProposal(textProposalTmpl, data, &proposal))
+	deposit, err := sdk.ParseDecCoins(proposal.Deposit)
+	require.NoError(t, err)
+	require.True(t, minDeposit.IsEqual(deposit))
+
+	// invalid templates and proposals
+	require.Error(t, mockCli.Governance().RenderProposal("{{.Version", data, &proposal))
+	require.Error(t, mockCli.Governance().RenderProposal(textProposalTmpl, map[string]interface{}{}, &proposal))
+	require.Error(t, mockCli.Governance().RenderProposal(textProposalTmpl[1:], data, &proposal))
+	require.Error(t, mockCli.Governance().RenderProposal(textProposalTmpl, data, proposal))
+	data["Version"], data["Deposit"] = strings.Repeat("v", types.MaxTitleLength), "10.24okt"
+	require.Error(t, mockCli.Governance().RenderProposal(textProposalTmpl, data, &proposal))
+
+	// delist proposal from the Go struct
+	mockCli.EXPECT().Query(types.DepositParamsPath, nil).Return(depositParamsBytes, nil)
+	delistProposal := types.DelistProposalJSON{
+		Title:       "delist btc-000_okt",
+		Description: "delist the token pair btc-000_okt",
+		BaseAsset:   "btc-000",
+		QuoteAsset:  "okt",
+	}
+	jsonBytes, err := mockCli.Governance().RenderProposalJSON(&delistProposal)
+	require.NoError(t, err)
+	require.True(t, minDeposit.IsEqual(delistProposal.Deposit))
+	var renderedProposal types.DelistProposalJSON
+	require.NoError(t, types.MsgCdc.UnmarshalJSON(jsonBytes, &renderedProposal))
+	require.Equal(t, delistProposal, renderedProposal)
+
+	delistProposal.QuoteAsset = delistProposal.BaseAsset
+	_, err = mockCli.Governance().RenderProposalJSON(&delistProposal)
+	require.Error(t, err)
+
+	// param change proposal
+	paramChangeProposal := types.ParamChangeProposalJSON{
+		Title:       "param change",
+		Description: "change the max validators",
+		Changes: types.ParamChangesJSON{
+			{Subspace: "staking", Key: "MaxValidators", Value: json.RawMessage(`21`)},
+		},
+		Deposit: minDeposit,
+	}
+	require.NoError(t, mockCli.Governance().CompleteProposal(&paramChangeProposal))
+	paramChangeProposal.Changes[0].Value = nil
+	require.Error(t, mockCli.Governance().CompleteProposal(&paramChangeProposal))
+
+	// community pool spend proposal
+	communityPoolSpendProposal := types.CommunityPoolSpendProposalJSON{
+		Title:       "community pool spend",
+		Description: "spend the community pool",
+		Deposit:     minDeposit,
+	}
+	require.Error(t, mockCli.Governance().CompleteProposal(&communityPoolSpendProposal))
+	communityPoolSpendProposal.Recipient, err = sdk.AccAddressFromBech32(addr)
+	require.NoError(t, err)
+	communityPoolSpendProposal.Amount = minDeposit
+	require.NoError(t, mockCli.Governance().CompleteProposal(&communityPoolSpendProposal))
+}
@@ -1,9 +1,11 @@
 package governance
 
 import (
+	"fmt"
 	"github.com/okex/okchain-go-sdk/module/governance/types"
 	sdk "github.com/okex/okchain-go-sdk/types"
 	"github.com/okex/okchain-go-sdk/types/crypto/keys"
+	sdkerrors "github.com/okex/okchain-go-sdk/types/errors"
 	"github.com/okex/okchain-go-sdk/types/params"
 )
 
@@ -19,6 +21,16 @@ func (gc govClient) SubmitTextProposal(fromInfo keys.Info, passWd, proposalPath,
 		return
 	}
 
+	return gc.SubmitTextProposalFromStruct(fromInfo, passWd, proposal, memo, accNum, seqNum)
+}
+
+// SubmitTextProposalFromStruct submits the text proposal built in memory on OKChain
+func (gc govClient) SubmitTextProposalFromStruct(fromInfo keys.Info, passWd string, proposal types.ProposalJSON, memo string,
+	accNum, seqNum uint64) (resp sdk.TxResponse, err error) {
+	if err = params.CheckKeyParams(fromInfo, passWd); err != nil {
+		return
+	}
+
 	deposit, err := sdk.ParseDecCoins(proposal.Deposit)
 	if err != nil {
 		return
@@ -46,6 +58,16 @@ func (gc govClient) SubmitParamChangeProposal(fromInfo keys.Info, passWd, propos
 		return
 	}
 
+	return gc.SubmitParamChangeProposalFromStruct(fromInfo, passWd, proposal, memo, accNum, seqNum)
+}
+
+// SubmitParamChangeProposalFromStruct submits the proposal built in memory to change the params on OKChain
+func (gc govClient) SubmitParamChangeProposalFromStruct(fromInfo keys.Info, passWd string,
+	proposal types.ParamChangeProposalJSON, memo string, accNum, seqNum uint64) (resp sdk.TxResponse, err error) {
+	if err = params.CheckKeyParams(fromInfo, passWd); err != nil {
+		return
+	}
+
 	msg := types.NewMsgSubmitProposal(
 		types.NewParameterChangeProposal(
 			proposal.Title,
@@ -73,6 +95,16 @@ func (gc govClient) SubmitDelistProposal(fromInfo keys.Info, passWd, proposalPat
 		return
 	}
 
+	return gc.SubmitDelistProposalFromStruct(fromInfo, passWd, proposal, memo, accNum, seqNum)
+}
+
+// SubmitDelistProposalFromStruct submits the proposal built in memory to delist a token pair from dex
+func (gc govClient) SubmitDelistProposalFromStruct(fromInfo keys.Info, passWd string, proposal types.DelistProposalJSON,
+	memo string, accNum, seqNum uint64) (resp sdk.TxResponse, err error) {
+	if err = params.CheckKeyParams(fromInfo, passWd); err != nil {
+		return
+	}
+
 	msg := types.NewMsgSubmitProposal(
 		types.NewDelistProposal(
 			proposal.Title,
@@ -101,6 +133,17 @@ func (gc govClient) SubmitCommunityPoolSpendProposal(fromInfo keys.Info, passWd,
 		return
 	}
 
+	return gc.SubmitCommunityPoolSpendProposalFromStruct(fromInfo, passWd, proposal, memo, accNum, seqNum)
+}
+
+// SubmitCommunityPoolSpendProposalFromStruct submits the proposal built in memory to spend the tokens from the
+// community pool on OKChain
+func (gc govClient) SubmitCommunityPoolSpendProposalFromStruct(fromInfo keys.Info, passWd string,
+	proposal types.CommunityPoolSpendProposalJSON, memo string, accNum, seqNum uint64) (resp sdk.TxResponse, err error) {
+	if err = params.CheckKeyParams(fromInfo, passWd); err != nil {
+		return
+	}
+
 	msg := types.NewMsgSubmitProposal(
 		types.NewCommunityPoolSpendProposal(
 			proposal.Title,
@@ -116,38 +159,150 @@ func (gc govClient) SubmitCommunityPoolSpendProposal(fromInfo keys.Info, passWd,
 
 }
 
-// Deposit increases the deposit amount on a specific proposal
-func (gc govClient) Deposit(fromInfo keys.Info, passWd, depositCoinsStr, memo string, proposalID, accNum,
+// SubmitSoftwareUpgradeProposal submits the proposal to upgrade the software of the chain at the height or the time in
+// the plan on OKChain
+func (gc govClient) SubmitSoftwareUpgradeProposal(fromInfo keys.Info, passWd, proposalPath, memo string, accNum,
 	seqNum uint64) (resp sdk.TxResponse, err error) {
-	if err = params.CheckProposalOperation(fromInfo, passWd, proposalID); err != nil {
+	if err = params.CheckKeyParams(fromInfo, passWd); err != nil {
 		return
 	}
 
-	deposit, err := sdk.ParseDecCoins(depositCoinsStr)
+	proposal, err := parseSoftwareUpgradeProposalFromFile(proposalPath)
 	if err != nil {
 		return
 	}
 
-	msg := types.NewMsgDeposit(fromInfo.GetAddress(), proposalID, deposit)
+	return gc.SubmitSoftwareUpgradeProposalFromStruct(fromInfo, passWd, proposal, memo, accNum, seqNum)
+}
+
+// SubmitSoftwareUpgradeProposalFromStruct submits the proposal built in memory to upgrade the software of the chain on
+// OKChain
+func (gc govClient) SubmitSoftwareUpgradeProposalFromStruct(fromInfo keys.Info, passWd string,
+	proposal types.SoftwareUpgradeProposalJSON, memo string, accNum, seqNum uint64) (resp sdk.TxResponse, err error) {
+	if err = params.CheckKeyParams(fromInfo, passWd); err != nil {
+		return
+	}
+
+	if err = proposal.Plan.ValidateBasic(); err != nil {
+		return
+	}
+
+	msg := types.NewMsgSubmitProposal(
+		types.NewSoftwareUpgradeProposal(proposal.Title, proposal.Description, proposal.Plan),
+		proposal.Deposit,
+		fromInfo.GetAddress(),
+	)
 
 	return gc.BuildAndBroadcast(fromInfo.GetName(), passWd, memo, []sdk.Msg{msg}, accNum, seqNum)
+}
 
+// SubmitCancelSoftwareUpgradeProposal submits the proposal to cancel the pending software upgrade on OKChain
+func (gc govClient) SubmitCancelSoftwareUpgradeProposal(fromInfo keys.Info, passWd, proposalPath, memo string, accNum,
+	seqNum uint64) (resp sdk.TxResponse, err error) {
+	if err = params.CheckKeyParams(fromInfo, passWd); err != nil {
+		return
+	}
+
+	proposal, err := parseCancelSoftwareUpgradeProposalFromFile(proposalPath)
+	if err != nil {
+		return
+	}
+
+	return gc.SubmitCancelSoftwareUpgradeProposalFromStruct(fromInfo, passWd, proposal, memo, accNum, seqNum)
 }
 
-// Vote votes for an active proposal
-// options: yes/no/no_with_veto/abstain
-func (gc govClient) Vote(fromInfo keys.Info, passWd, voteOption, memo string, proposalID, accNum, seqNum uint64) (
-	resp sdk.TxResponse, err error) {
-	if err = params.CheckProposalOperation(fromInfo, passWd, proposalID); err != nil {
+// SubmitCancelSoftwareUpgradeProposalFromStruct submits the proposal built in memory to cancel the pending software
+// upgrade on OKChain
+func (gc govClient) SubmitCancelSoftwareUpgradeProposalFromStruct(fromInfo keys.Info, passWd string,
+	proposal types.CancelSoftwareUpgradeProposalJSON, memo string, accNum, seqNum uint64) (resp sdk.TxResponse,
+	err error) {
+	if err = params.CheckKeyParams(fromInfo, passWd); err != nil {
 		return
 	}
 
-	voteOptionBytes, err := voteOptionFromString(voteOption)
+	msg := types.NewMsgSubmitProposal(
+		types.NewCancelSoftwareUpgradeProposal(proposal.Title, proposal.Description),
+		proposal.Deposit,
+		fromInfo.GetAddress(),
+	)
+
+	return gc.BuildAndBroadcast(fromInfo.GetName(), passWd, memo, []sdk.Msg{msg}, accNum, seqNum)
+}
+
+// SubmitManageWhiteListProposal submits the proposal to add a farm pool to or remove it from the white list on OKChain
+func (gc govClient) SubmitManageWhiteListProposal(fromInfo keys.Info, passWd, proposalPath, memo string, accNum,
+	seqNum uint64) (resp sdk.TxResponse, err error) {
+	if err = params.CheckKeyParams(fromInfo, passWd); err != nil {
+		return
+	}
+
+	proposal, err := parseManageWhiteListProposalFromFile(proposalPath)
+	if err != nil {
+		return
+	}
+
+	return gc.SubmitManageWhiteListProposalFromStruct(fromInfo, passWd, proposal, memo, accNum, seqNum)
+}
+
+// SubmitManageWhiteListProposalFromStruct submits the proposal built in memory to add a farm pool to or remove it from
+// the white list on OKChain
+func (gc govClient) SubmitManageWhiteListProposalFromStruct(fromInfo keys.Info, passWd string,
+	proposal types.ManageWhiteListProposalJSON, memo string, accNum, seqNum uint64) (resp sdk.TxResponse, err error) {
+	if err = params.CheckKeyParams(fromInfo, passWd); err != nil {
+		return
+	}
+
+	msg := types.NewMsgSubmitProposal(
+		types.NewManageWhiteListProposal(proposal.Title, proposal.Description, proposal.PoolName, proposal.IsAdded),
+		proposal.Deposit,
+		fromInfo.GetAddress(),
+	)
+
+	return gc.BuildAndBroadcast(fromInfo.GetName(), passWd, memo, []sdk.Msg{msg}, accNum, seqNum)
+}
+
+// SubmitDexParamsProposalFromStruct submits the typed proposal to change the dex params, e.g. the fee to list a token
+// pair, as the param change proposal on OKChain
+func (gc govClient) SubmitDexParamsProposalFromStruct(fromInfo keys.Info, passWd string,
+	proposal types.DexParamsProposalJSON, memo string, accNum, seqNum uint64) (resp sdk.TxResponse, err error) {
+	paramChangeProposal, err := proposal.ToParamChangeProposal()
 	if err != nil {
 		return
 	}
 
-	msg := types.NewMsgVote(fromInfo.GetAddress(), proposalID, voteOptionBytes)
+	return gc.SubmitParamChangeProposalFromStruct(fromInfo, passWd, paramChangeProposal, memo, accNum, seqNum)
+}
+
+// Deposit increases the deposit amount on a specific proposal
+func (gc govClient) Deposit(fromInfo keys.Info, passWd string, deposit sdk.Amount, memo string, proposalID, accNum,
+	seqNum uint64) (resp sdk.TxResponse, err error) {
+	if err = params.CheckProposalOperation(fromInfo, passWd, proposalID); err != nil {
+		return
+	}
+
+	if err = deposit.Validate(); err != nil {
+		return resp, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "failed. invalid deposit: %s", err)
+	}
+
+	msg := types.NewMsgDeposit(fromInfo.GetAddress(), proposalID, deposit.Coins())
+
+	return gc.BuildAndBroadcast(fromInfo.GetName(), passWd, memo, []sdk.Msg{msg}, accNum, seqNum)
+
+}
+
+// Vote votes for an active proposal with the option, e.g. types.VoteOptionYes, which is parsed from its name by
+// types.ParseVoteOption
+func (gc govClient) Vote(fromInfo keys.Info, passWd string, voteOption types.VoteOption, memo string, proposalID,
+	accNum, seqNum uint64) (resp sdk.TxResponse, err error) {
+	if err = params.CheckProposalOperation(fromInfo, passWd, proposalID); err != nil {
+		return
+	}
+
+	if !voteOption.IsValid() {
+		return resp, fmt.Errorf("failed. %d is not a valid vote option", voteOption)
+	}
+
+	msg := types.NewMsgVote(fromInfo.GetAddress(), proposalID, voteOption)
 
 	return gc.BuildAndBroadcast(fromInfo.GetName(), passWd, memo, []sdk.Msg{msg}, accNum, seqNum)
 
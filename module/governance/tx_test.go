@@ -5,12 +5,14 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/okex/okchain-go-sdk/mocks"
 	"github.com/okex/okchain-go-sdk/module/auth"
+	"github.com/okex/okchain-go-sdk/module/governance/types"
 	sdk "github.com/okex/okchain-go-sdk/types"
 	"github.com/okex/okchain-go-sdk/utils"
 	"github.com/stretchr/testify/require"
 	"io/ioutil"
 	"os"
 	"testing"
+	"time"
 )
 
 const (
@@ -28,10 +30,12 @@ const (
 	delistProposalFilePath             = "./delist_proposal.json"
 	communityPoolSpendProposalFilePath = "./community_pool_spend_proposal.json"
 	badProposalFilePath                = "./bad_proposal.json"
+	softwareUpgradeProposalFilePath    = "./software_upgrade_proposal.json"
 
 	textProposalJSON               = `{"title":"Text Proposal","description":"text proposal description","proposal_type":"Text","deposit":"100okt"}`
 	paramChangeProposalJSON        = `{"title":"Param Change Proposal","description":"param change proposal description","changes":[{"subspace":"staking","key":"MaxValidators","value":105}],"deposit":[{"denom":"okt","amount":"100"}],"height":"1024"}`
 	delistProposalJSON             = `{"title":"Delist Proposal","description":"delist proposal description","base_asset":"btc-000","quote_asset":"okt","deposit":[{"denom":"okt","amount":"100"}]}`
+	softwareUpgradeProposalJSON    = `{"title":"Upgrade v0.11","description":"upgrade the chain to v0.11","plan":{"name":"v0.11","height":"1024","info":"commit 1a2b3c"},"deposit":[{"denom":"okt","amount":"100"}]}`
 	communityPoolSpendProposalJSON = `{"title":"Community Pool Spend Proposal","description":"community pool spend description","recipient":"okchain1hw4r48aww06ldrfeuq2v438ujnl6alszzzqpph","amount":[{"denom":"okt","amount":"10.24"}],"deposit":[{"denom":"okt","amount":"100"}]}`
 )
 
@@ -290,6 +294,205 @@ func TestGovClient_SubmitCommunityPoolSpendProposal(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestGovClient_SubmitSoftwareUpgradeProposal(t *testing.T) {
+	err := ioutil.WriteFile(softwareUpgradeProposalFilePath, []byte(softwareUpgradeProposalJSON), 0644)
+	require.NoError(t, err)
+	defer os.Remove(softwareUpgradeProposalFilePath)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	config, err := sdk.NewClientConfig("testURL", "testChain", sdk.BroadcastBlock, "", 200000,
+		1.1, "0.00000001okt")
+	require.NoError(t, err)
+	mockCli := mocks.NewMockClient(t, ctrl, config)
+	mockCli.RegisterModule(NewGovClient(mockCli.MockBaseClient))
+
+	fromInfo, _, err := utils.CreateAccountWithMnemo(mnemonic, name, passWd)
+	require.NoError(t, err)
+
+	var msgs []sdk.Msg
+	mockCli.EXPECT().BuildAndBroadcast(
+		fromInfo.GetName(), passWd, memo, gomock.AssignableToTypeOf([]sdk.Msg{}), uint64(1), uint64(2)).
+		DoAndReturn(func(_, _, _ string, ms []sdk.Msg, _, _ uint64, _ ...sdk.TxOption) (sdk.TxResponse, error) {
+			msgs = append(msgs, ms...)
+			return mocks.DefaultMockSuccessTxResponse(), nil
+		}).Times(2)
+
+	res, err := mockCli.Governance().SubmitSoftwareUpgradeProposal(fromInfo, passWd, softwareUpgradeProposalFilePath,
+		memo, 1, 2)
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), res.Code)
+	proposal, ok := msgs[0].(types.MsgSubmitProposal).Content.(types.SoftwareUpgradeProposal)
+	require.True(t, ok)
+	require.Equal(t, types.Plan{Name: "v0.11", Height: 1024, Info: "commit 1a2b3c"}, proposal.Plan)
+
+	res, err = mockCli.Governance().SubmitCancelSoftwareUpgradeProposalFromStruct(fromInfo, passWd,
+		types.CancelSoftwareUpgradeProposalJSON{Title: "Cancel v0.11", Description: "cancel the upgrade to v0.11"}, memo,
+		1, 2)
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), res.Code)
+	_, ok = msgs[1].(types.MsgSubmitProposal).Content.(types.CancelSoftwareUpgradeProposal)
+	require.True(t, ok)
+
+	// neither the height nor the time of the plan
+	_, err = mockCli.Governance().SubmitSoftwareUpgradeProposalFromStruct(fromInfo, passWd,
+		types.SoftwareUpgradeProposalJSON{Title: "Upgrade v0.11", Plan: types.Plan{Name: "v0.11"}}, memo, 1, 2)
+	require.Error(t, err)
+	// both of them
+	plan := types.Plan{Name: "v0.11", Height: 1024, Time: time.Now()}
+	require.Error(t, plan.ValidateBasic())
+
+	// the plan name missing in the file
+	err = ioutil.WriteFile(badProposalFilePath, []byte(`{"title":"t","description":"d","plan":{"height":"1024"}}`),
+		0644)
+	require.NoError(t, err)
+	defer os.Remove(badProposalFilePath)
+	_, err = mockCli.Governance().SubmitSoftwareUpgradeProposal(fromInfo, passWd, badProposalFilePath, memo, 1, 2)
+	require.Error(t, err)
+	_, err = mockCli.Governance().SubmitCancelSoftwareUpgradeProposal(fromInfo, passWd, badProposalFilePath, memo, 1, 2)
+	require.Error(t, err)
+	_, err = mockCli.Governance().SubmitSoftwareUpgradeProposal(fromInfo, "", softwareUpgradeProposalFilePath, memo,
+		1, 2)
+	require.Error(t, err)
+}
+
+func TestGovClient_SubmitManageWhiteListProposal(t *testing.T) {
+	err := ioutil.WriteFile(badProposalFilePath, []byte(
+		`{"title":"Add pool","description":"add the pool to the white list","pool_name":"okt-pool","is_added":true}`),
+		0644)
+	require.NoError(t, err)
+	defer os.Remove(badProposalFilePath)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	config, err := sdk.NewClientConfig("testURL", "testChain", sdk.BroadcastBlock, "", 200000,
+		1.1, "0.00000001okt")
+	require.NoError(t, err)
+	mockCli := mocks.NewMockClient(t, ctrl, config)
+	mockCli.RegisterModule(NewGovClient(mockCli.MockBaseClient))
+
+	fromInfo, _, err := utils.CreateAccountWithMnemo(mnemonic, name, passWd)
+	require.NoError(t, err)
+
+	var msgs []sdk.Msg
+	mockCli.EXPECT().BuildAndBroadcast(
+		fromInfo.GetName(), passWd, memo, gomock.AssignableToTypeOf([]sdk.Msg{}), uint64(1), uint64(2)).
+		DoAndReturn(func(_, _, _ string, ms []sdk.Msg, _, _ uint64, _ ...sdk.TxOption) (sdk.TxResponse, error) {
+			msgs = append(msgs, ms...)
+			return mocks.DefaultMockSuccessTxResponse(), nil
+		}).Times(2)
+
+	_, err = mockCli.Governance().SubmitManageWhiteListProposal(fromInfo, passWd, badProposalFilePath, memo, 1, 2)
+	require.NoError(t, err)
+	require.Equal(t, types.NewManageWhiteListProposal("Add pool", "add the pool to the white list", "okt-pool", true),
+		msgs[0].(types.MsgSubmitProposal).Content)
+
+	// the dex params as the param changes
+	listFee, votingPeriod := sdk.NewDecCoinFromDec("okt", sdk.MustNewDecFromStr("200")), 72*time.Hour
+	_, err = mockCli.Governance().SubmitDexParamsProposalFromStruct(fromInfo, passWd, types.DexParamsProposalJSON{
+		Title:              "Dex params",
+		Description:        "lower the list fee",
+		ListFee:            &listFee,
+		DelistVotingPeriod: &votingPeriod,
+	}, memo, 1, 2)
+	require.NoError(t, err)
+	proposal := msgs[1].(types.MsgSubmitProposal).Content.(types.ParameterChangeProposal)
+	require.Equal(t, []types.ParamChange{
+		{Subspace: "dex", Key: "ListFee", Value: `{"denom":"okt","amount":"200.00000000"}`},
+		{Subspace: "dex", Key: "DelistVotingPeriod", Value: `"259200000000000"`},
+	}, proposal.Changes)
+
+	// no param changed
+	_, err = mockCli.Governance().SubmitDexParamsProposalFromStruct(fromInfo, passWd, types.DexParamsProposalJSON{
+		Title:       "Dex params",
+		Description: "nothing",
+	}, memo, 1, 2)
+	require.Error(t, err)
+
+	// the flag missing
+	err = ioutil.WriteFile(badProposalFilePath, []byte(`{"title":"t","description":"d","pool_name":"okt-pool"}`), 0644)
+	require.NoError(t, err)
+	_, err = mockCli.Governance().SubmitManageWhiteListProposal(fromInfo, passWd, badProposalFilePath, memo, 1, 2)
+	require.Error(t, err)
+	_, err = mockCli.Governance().SubmitManageWhiteListProposalFromStruct(fromInfo, "",
+		types.ManageWhiteListProposalJSON{}, memo, 1, 2)
+	require.Error(t, err)
+}
+
+func TestGovClient_SubmitProposalFromStruct(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	config, err := sdk.NewClientConfig("testURL", "testChain", sdk.BroadcastBlock, "", 200000,
+		1.1, "0.00000001okt")
+	require.NoError(t, err)
+	mockCli := mocks.NewMockClient(t, ctrl, config)
+	mockCli.RegisterModule(NewGovClient(mockCli.MockBaseClient), auth.NewAuthClient(mockCli.MockBaseClient))
+
+	fromInfo, _, err := utils.CreateAccountWithMnemo(mnemonic, name, passWd)
+	require.NoError(t, err)
+
+	deposit, err := sdk.ParseDecCoins("100okt")
+	require.NoError(t, err)
+	amount, err := sdk.ParseDecCoins("10.24okt")
+	require.NoError(t, err)
+	recipient, err := sdk.AccAddressFromBech32("okchain1hw4r48aww06ldrfeuq2v438ujnl6alszzzqpph")
+	require.NoError(t, err)
+
+	mockCli.EXPECT().BuildAndBroadcast(
+		fromInfo.GetName(), passWd, memo, gomock.AssignableToTypeOf([]sdk.Msg{}), uint64(1), uint64(2)).
+		Return(mocks.DefaultMockSuccessTxResponse(), nil).Times(4)
+
+	res, err := mockCli.Governance().SubmitTextProposalFromStruct(fromInfo, passWd, types.ProposalJSON{
+		Title:        "Text Proposal",
+		Description:  "text proposal description",
+		ProposalType: "Text",
+		Deposit:      "100okt",
+	}, memo, 1, 2)
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), res.Code)
+
+	res, err = mockCli.Governance().SubmitParamChangeProposalFromStruct(fromInfo, passWd, types.ParamChangeProposalJSON{
+		Title:       "Param Change Proposal",
+		Description: "param change proposal description",
+		Changes: types.ParamChangesJSON{
+			{Subspace: "staking", Key: "MaxValidators", Value: []byte("105")},
+		},
+		Deposit: deposit,
+		Height:  1024,
+	}, memo, 1, 2)
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), res.Code)
+
+	res, err = mockCli.Governance().SubmitDelistProposalFromStruct(fromInfo, passWd, types.DelistProposalJSON{
+		Title:       "Delist Proposal",
+		Description: "delist proposal description",
+		BaseAsset:   "btc-000",
+		QuoteAsset:  "okt",
+		Deposit:     deposit,
+	}, memo, 1, 2)
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), res.Code)
+
+	res, err = mockCli.Governance().SubmitCommunityPoolSpendProposalFromStruct(fromInfo, passWd,
+		types.CommunityPoolSpendProposalJSON{
+			Title:       "Community Pool Spend Proposal",
+			Description: "community pool spend description",
+			Recipient:   recipient,
+			Amount:      amount,
+			Deposit:     deposit,
+		}, memo, 1, 2)
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), res.Code)
+
+	// bad deposit of text proposal
+	_, err = mockCli.Governance().SubmitTextProposalFromStruct(fromInfo, passWd, types.ProposalJSON{Deposit: "100"},
+		memo, 1, 2)
+	require.Error(t, err)
+
+	_, err = mockCli.Governance().SubmitDelistProposalFromStruct(fromInfo, "", types.DelistProposalJSON{}, memo, 1, 2)
+	require.Error(t, err)
+}
+
 func TestGovClient_Deposit(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -314,27 +517,27 @@ func TestGovClient_Deposit(t *testing.T) {
 		fromInfo.GetName(), passWd, memo, gomock.AssignableToTypeOf([]sdk.Msg{}), accInfo.GetAccountNumber(), accInfo.GetSequence()).
 		Return(mocks.DefaultMockSuccessTxResponse(), nil)
 
-	res, err := mockCli.Governance().Deposit(fromInfo, passWd, "100okt", memo, 1,
+	res, err := mockCli.Governance().Deposit(fromInfo, passWd, sdk.MustParseAmount("100okt"), memo, 1,
 		accInfo.GetAccountNumber(), accInfo.GetSequence())
 	require.NoError(t, err)
 	require.Equal(t, uint32(0), res.Code)
 
-	_, err = mockCli.Governance().Deposit(fromInfo, passWd, "100okt", memo, 0,
+	_, err = mockCli.Governance().Deposit(fromInfo, passWd, sdk.MustParseAmount("100okt"), memo, 0,
 		accInfo.GetAccountNumber(), accInfo.GetSequence())
 	require.Error(t, err)
 
-	_, err = mockCli.Governance().Deposit(fromInfo, passWd, "100", memo, 1,
+	_, err = mockCli.Governance().Deposit(fromInfo, passWd, sdk.Amount{}, memo, 1,
 		accInfo.GetAccountNumber(), accInfo.GetSequence())
 	require.Error(t, err)
 
-	_, err = mockCli.Governance().Deposit(fromInfo, "", "100okt", memo, 1,
+	_, err = mockCli.Governance().Deposit(fromInfo, "", sdk.MustParseAmount("100okt"), memo, 1,
 		accInfo.GetAccountNumber(), accInfo.GetSequence())
 	require.Error(t, err)
 
 	mockCli.EXPECT().BuildAndBroadcast(
 		fromInfo.GetName(), passWd, memo, gomock.AssignableToTypeOf([]sdk.Msg{}), accInfo.GetAccountNumber(), accInfo.GetSequence()).
 		Return(sdk.TxResponse{}, errors.New("default error"))
-	_, err = mockCli.Governance().Deposit(fromInfo, passWd, "100okt", memo, 1,
+	_, err = mockCli.Governance().Deposit(fromInfo, passWd, sdk.MustParseAmount("100okt"), memo, 1,
 		accInfo.GetAccountNumber(), accInfo.GetSequence())
 	require.Error(t, err)
 }
@@ -363,40 +566,40 @@ func TestGovClient_Vote(t *testing.T) {
 		fromInfo.GetName(), passWd, memo, gomock.AssignableToTypeOf([]sdk.Msg{}), accInfo.GetAccountNumber(), accInfo.GetSequence()).
 		Return(mocks.DefaultMockSuccessTxResponse(), nil).Times(4)
 
-	res, err := mockCli.Governance().Vote(fromInfo, passWd, "yes", memo, 1, accInfo.GetAccountNumber(),
+	res, err := mockCli.Governance().Vote(fromInfo, passWd, types.VoteOptionYes, memo, 1, accInfo.GetAccountNumber(),
 		accInfo.GetSequence())
 	require.NoError(t, err)
 	require.Equal(t, uint32(0), res.Code)
 
-	_, err = mockCli.Governance().Vote(fromInfo, passWd, "Abstain", memo, 1, accInfo.GetAccountNumber(),
+	_, err = mockCli.Governance().Vote(fromInfo, passWd, types.VoteOptionAbstain, memo, 1, accInfo.GetAccountNumber(),
 		accInfo.GetSequence())
 	require.NoError(t, err)
 
-	_, err = mockCli.Governance().Vote(fromInfo, passWd, "no", memo, 1, accInfo.GetAccountNumber(),
+	_, err = mockCli.Governance().Vote(fromInfo, passWd, types.VoteOptionNo, memo, 1, accInfo.GetAccountNumber(),
 		accInfo.GetSequence())
 	require.NoError(t, err)
 
-	_, err = mockCli.Governance().Vote(fromInfo, passWd, "no_with_veto", memo, 1, accInfo.GetAccountNumber(),
+	_, err = mockCli.Governance().Vote(fromInfo, passWd, types.VoteOptionNoWithVeto, memo, 1, accInfo.GetAccountNumber(),
 		accInfo.GetSequence())
 	require.NoError(t, err)
 
 	// error
-	_, err = mockCli.Governance().Vote(fromInfo, passWd, "yes", memo, 0, accInfo.GetAccountNumber(),
+	_, err = mockCli.Governance().Vote(fromInfo, passWd, types.VoteOptionYes, memo, 0, accInfo.GetAccountNumber(),
 		accInfo.GetSequence())
 	require.Error(t, err)
 
-	_, err = mockCli.Governance().Vote(fromInfo, "", "yes", memo, 1, accInfo.GetAccountNumber(),
+	_, err = mockCli.Governance().Vote(fromInfo, "", types.VoteOptionYes, memo, 1, accInfo.GetAccountNumber(),
 		accInfo.GetSequence())
 	require.Error(t, err)
 
-	_, err = mockCli.Governance().Vote(fromInfo, passWd, "", memo, 1, accInfo.GetAccountNumber(),
+	_, err = mockCli.Governance().Vote(fromInfo, passWd, types.VoteOptionEmpty, memo, 1, accInfo.GetAccountNumber(),
 		accInfo.GetSequence())
 	require.Error(t, err)
 
 	mockCli.EXPECT().BuildAndBroadcast(
 		fromInfo.GetName(), passWd, memo, gomock.AssignableToTypeOf([]sdk.Msg{}), accInfo.GetAccountNumber(), accInfo.GetSequence()).
 		Return(sdk.TxResponse{}, errors.New("default error"))
-	_, err = mockCli.Governance().Vote(fromInfo, passWd, "yes", memo, 1, accInfo.GetAccountNumber(),
+	_, err = mockCli.Governance().Vote(fromInfo, passWd, types.VoteOptionYes, memo, 1, accInfo.GetAccountNumber(),
 		accInfo.GetSequence())
 	require.Error(t, err)
 }
@@ -0,0 +1,117 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/okex/okchain-go-sdk/types"
+)
+
+// dexSubspace is the params subspace of the dex module
+const dexSubspace = "dex"
+
+var _ Content = (*ManageWhiteListProposal)(nil)
+
+// ManageWhiteListProposal - structure of a proposal to add a farm pool to or remove it from the white list, whose
+// pools share the farm rewards, that implements interface Content
+type ManageWhiteListProposal struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	PoolName    string `json:"pool_name"`
+	IsAdded     bool   `json:"is_added"`
+}
+
+// NewManageWhiteListProposal is a constructor function for ManageWhiteListProposal
+func NewManageWhiteListProposal(title, description, poolName string, isAdded bool) ManageWhiteListProposal {
+	return ManageWhiteListProposal{
+		Title:       title,
+		Description: description,
+		PoolName:    poolName,
+		IsAdded:     isAdded,
+	}
+}
+
+// nolint
+func (ManageWhiteListProposal) GetTitle() string         { return "" }
+func (ManageWhiteListProposal) GetDescription() string   { return "" }
+func (ManageWhiteListProposal) ProposalRoute() string    { return "" }
+func (ManageWhiteListProposal) ProposalType() string     { return "" }
+func (ManageWhiteListProposal) String() string           { return "" }
+func (ManageWhiteListProposal) ValidateBasic() sdk.Error { return nil }
+
+type (
+	// ManageWhiteListProposalJSON - structure for a ManageWhiteListProposal with a deposit used to parse the white list
+	// proposals from the JSON file
+	ManageWhiteListProposalJSON struct {
+		Title       string       `json:"title"`
+		Description string       `json:"description"`
+		PoolName    string       `json:"pool_name"`
+		IsAdded     bool         `json:"is_added"`
+		Deposit     sdk.DecCoins `json:"deposit"`
+	}
+
+	// DexParamsProposalJSON - structure of a typed proposal to change the dex params, e.g. the fee to list a token pair,
+	// which is submitted as the param change proposal of the params set. The nil params are left unchanged
+	DexParamsProposalJSON struct {
+		Title                  string         `json:"title"`
+		Description            string         `json:"description"`
+		ListFee                *sdk.DecCoin   `json:"list_fee,omitempty"`
+		TransferOwnershipFee   *sdk.DecCoin   `json:"transfer_ownership_fee,omitempty"`
+		DelistMaxDepositPeriod *time.Duration `json:"delist_max_deposit_period,omitempty"`
+		DelistMinDeposit       sdk.DecCoins   `json:"delist_min_deposit,omitempty"`
+		DelistVotingPeriod     *time.Duration `json:"delist_voting_period,omitempty"`
+		WithdrawPeriod         *time.Duration `json:"withdraw_period,omitempty"`
+		Deposit                sdk.DecCoins   `json:"deposit"`
+		Height                 uint64         `json:"height"`
+	}
+)
+
+// ValidateBasic validates the white list proposal before it's submitted
+func (mwlpj ManageWhiteListProposalJSON) ValidateBasic() error {
+	if err := validateTitleAndDescription(mwlpj.Title, mwlpj.Description); err != nil {
+		return err
+	}
+
+	if len(strings.TrimSpace(mwlpj.PoolName)) == 0 {
+		return errors.New("failed. empty farm pool name of the white list proposal")
+	}
+
+	return validateDeposit(mwlpj.Deposit)
+}
+
+// ToParamChangeProposal converts the typed dex params proposal to the param change proposal of the params set
+func (dppj DexParamsProposalJSON) ToParamChangeProposal() (proposal ParamChangeProposalJSON, err error) {
+	var changes ParamChangesJSON
+	for _, param := range []struct {
+		key   string
+		value interface{}
+		set   bool
+	}{
+		{"ListFee", dppj.ListFee, dppj.ListFee != nil},
+		{"TransferOwnershipFee", dppj.TransferOwnershipFee, dppj.TransferOwnershipFee != nil},
+		{"DelistMaxDepositPeriod", dppj.DelistMaxDepositPeriod, dppj.DelistMaxDepositPeriod != nil},
+		{"DelistMinDeposit", dppj.DelistMinDeposit, dppj.DelistMinDeposit != nil},
+		{"DelistVotingPeriod", dppj.DelistVotingPeriod, dppj.DelistVotingPeriod != nil},
+		{"WithdrawPeriod", dppj.WithdrawPeriod, dppj.WithdrawPeriod != nil},
+	} {
+		if !param.set {
+			continue
+		}
+		value, err := MsgCdc.MarshalJSON(param.value)
+		if err != nil {
+			return proposal, fmt.Errorf("failed. marshal dex param %s error: %s", param.key, err)
+		}
+		changes = append(changes, ParamChangeJSON{Subspace: dexSubspace, Key: param.key, Value: value})
+	}
+
+	proposal = ParamChangeProposalJSON{
+		Title:       dppj.Title,
+		Description: dppj.Description,
+		Changes:     changes,
+		Deposit:     dppj.Deposit,
+		Height:      dppj.Height,
+	}
+	return proposal, proposal.ValidateBasic()
+}
@@ -25,6 +25,14 @@ func (msg MsgSubmitProposal) GetSignBytes() []byte {
 	return sdk.MustSortJSON(MsgCdc.MustMarshalJSON(msg))
 }
 
+// GetRecipients returns the recipient of the community pool spend proposal
+func (msg MsgSubmitProposal) GetRecipients() []sdk.AccAddress {
+	if proposal, ok := msg.Content.(CommunityPoolSpendProposal); ok {
+		return []sdk.AccAddress{proposal.Recipient}
+	}
+	return nil
+}
+
 // nolint
 func (MsgSubmitProposal) Route() string                { return "" }
 func (MsgSubmitProposal) Type() string                 { return "" }
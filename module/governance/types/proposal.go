@@ -0,0 +1,128 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/okex/okchain-go-sdk/types"
+)
+
+// const
+const (
+	DepositParamsPath = "custom/gov/params/deposit"
+	VotingParamsPath  = "custom/gov/params/voting"
+
+	MaxTitleLength       = 140
+	MaxDescriptionLength = 5000
+)
+
+// DepositParams - structure of the params around deposits for governance
+type DepositParams struct {
+	MinDeposit       sdk.DecCoins  `json:"min_deposit"`
+	MaxDepositPeriod time.Duration `json:"max_deposit_period"`
+}
+
+// VotingParams - structure of the params around voting in governance
+type VotingParams struct {
+	VotingPeriod time.Duration `json:"voting_period"`
+}
+
+// Params - structure of all the params of governance
+type Params struct {
+	Deposit DepositParams `json:"deposit"`
+	Voting  VotingParams  `json:"voting"`
+	Tally   TallyParams   `json:"tally"`
+}
+
+// ValidateBasic validates the text proposal before it's submitted
+func (pj ProposalJSON) ValidateBasic() error {
+	if err := validateTitleAndDescription(pj.Title, pj.Description); err != nil {
+		return err
+	}
+
+	deposit, err := sdk.ParseDecCoins(pj.Deposit)
+	if err != nil {
+		return fmt.Errorf("failed. invalid deposit: %s", err)
+	}
+	return validateDeposit(deposit)
+}
+
+// ValidateBasic validates the param change proposal before it's submitted
+func (pcpj ParamChangeProposalJSON) ValidateBasic() error {
+	if err := validateTitleAndDescription(pcpj.Title, pcpj.Description); err != nil {
+		return err
+	}
+
+	if len(pcpj.Changes) == 0 {
+		return errors.New("failed. no param change in the proposal")
+	}
+	for _, change := range pcpj.Changes {
+		if len(strings.TrimSpace(change.Subspace)) == 0 || len(strings.TrimSpace(change.Key)) == 0 {
+			return errors.New("failed. empty subspace or key of the param change")
+		}
+		if len(change.Value) == 0 {
+			return fmt.Errorf("failed. empty value of the param change %s/%s", change.Subspace, change.Key)
+		}
+	}
+
+	return validateDeposit(pcpj.Deposit)
+}
+
+// ValidateBasic validates the delist proposal before it's submitted
+func (dpj DelistProposalJSON) ValidateBasic() error {
+	if err := validateTitleAndDescription(dpj.Title, dpj.Description); err != nil {
+		return err
+	}
+
+	if len(dpj.BaseAsset) == 0 || len(dpj.QuoteAsset) == 0 {
+		return errors.New("failed. empty base asset or quote asset of the token pair to delist")
+	}
+	if dpj.BaseAsset == dpj.QuoteAsset {
+		return errors.New("failed. base asset and quote asset of the token pair to delist are the same")
+	}
+
+	return validateDeposit(dpj.Deposit)
+}
+
+// ValidateBasic validates the community pool spend proposal before it's submitted
+func (cpspj CommunityPoolSpendProposalJSON) ValidateBasic() error {
+	if err := validateTitleAndDescription(cpspj.Title, cpspj.Description); err != nil {
+		return err
+	}
+
+	if cpspj.Recipient.Empty() {
+		return errors.New("failed. empty recipient of the community pool spend")
+	}
+	if !cpspj.Amount.IsValid() || !cpspj.Amount.IsAllPositive() {
+		return fmt.Errorf("failed. invalid amount of the community pool spend: %v", cpspj.Amount)
+	}
+
+	return validateDeposit(cpspj.Deposit)
+}
+
+func validateTitleAndDescription(title, description string) error {
+	if len(strings.TrimSpace(title)) == 0 {
+		return errors.New("failed. proposal title cannot be blank")
+	}
+	if len(title) > MaxTitleLength {
+		return fmt.Errorf("failed. proposal title is longer than max length of %d", MaxTitleLength)
+	}
+
+	if len(strings.TrimSpace(description)) == 0 {
+		return errors.New("failed. proposal description cannot be blank")
+	}
+	if len(description) > MaxDescriptionLength {
+		return fmt.Errorf("failed. proposal description is longer than max length of %d", MaxDescriptionLength)
+	}
+
+	return nil
+}
+
+func validateDeposit(deposit sdk.DecCoins) error {
+	if !deposit.IsValid() {
+		return fmt.Errorf("failed. invalid deposit: %v", deposit)
+	}
+	return nil
+}
@@ -0,0 +1,370 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ProposalKind is the kind of a proposal file
+type ProposalKind string
+
+// kinds of the proposal files
+const (
+	ProposalKindText               ProposalKind = "text"
+	ProposalKindParamChange        ProposalKind = "param_change"
+	ProposalKindDelist             ProposalKind = "delist"
+	ProposalKindCommunityPoolSpend ProposalKind = "community_pool_spend"
+	ProposalKindSoftwareUpgrade    ProposalKind = "software_upgrade"
+	ProposalKindCancelUpgrade      ProposalKind = "cancel_software_upgrade"
+	ProposalKindManageWhiteList    ProposalKind = "manage_white_list"
+)
+
+// ProposalDiagnostic - structure of a problem found in a proposal file, located by its line and column
+type ProposalDiagnostic struct {
+	// Line and Column are 1-based, 0 if the problem isn't located in the file
+	Line    int
+	Column  int
+	Field   string
+	Message string
+}
+
+// String returns the readable text of the diagnostic
+func (pd ProposalDiagnostic) String() string {
+	var sb strings.Builder
+	if pd.Line > 0 {
+		sb.WriteString(fmt.Sprintf("line %d, column %d: ", pd.Line, pd.Column))
+	}
+	if len(pd.Field) != 0 {
+		sb.WriteString(fmt.Sprintf("field %q: ", pd.Field))
+	}
+	sb.WriteString(pd.Message)
+	return sb.String()
+}
+
+// ProposalDiagnostics are all the problems found in a proposal file
+type ProposalDiagnostics []ProposalDiagnostic
+
+// Error returns the diagnostics joined
+func (pds ProposalDiagnostics) Error() string {
+	strs := make([]string, len(pds))
+	for i, pd := range pds {
+		strs[i] = pd.String()
+	}
+	return "failed. invalid proposal: " + strings.Join(strs, "; ")
+}
+
+// kinds of the json values in the proposal schemas
+const (
+	valueString = "string"
+	valueUint   = "uint"
+	valueArray  = "array"
+	valueObject = "object"
+	valueBool   = "bool"
+	valueAny    = "any"
+)
+
+// valueSchema - structure of the expected json value of a field
+type valueSchema struct {
+	kind   string
+	elem   *valueSchema
+	fields []fieldSchema
+}
+
+// fieldSchema - structure of an expected field of a json object
+type fieldSchema struct {
+	name     string
+	required bool
+	value    valueSchema
+}
+
+var (
+	stringSchema = valueSchema{kind: valueString}
+	coinsSchema  = valueSchema{kind: valueArray, elem: &valueSchema{kind: valueObject, fields: []fieldSchema{
+		{name: "denom", required: true, value: stringSchema},
+		{name: "amount", required: true, value: stringSchema},
+	}}}
+	titleFields = []fieldSchema{
+		{name: "title", required: true, value: stringSchema},
+		{name: "description", required: true, value: stringSchema},
+	}
+
+	// the deposits are optional, which are defaulted to the min deposit by CompleteProposal
+	proposalSchemas = map[ProposalKind]valueSchema{
+		ProposalKindText: {kind: valueObject, fields: append(titleFields[:2:2],
+			fieldSchema{name: "proposal_type", value: stringSchema},
+			fieldSchema{name: "deposit", value: stringSchema},
+		)},
+		ProposalKindParamChange: {kind: valueObject, fields: append(titleFields[:2:2],
+			fieldSchema{name: "changes", required: true, value: valueSchema{kind: valueArray,
+				elem: &valueSchema{kind: valueObject, fields: []fieldSchema{
+					{name: "subspace", required: true, value: stringSchema},
+					{name: "key", required: true, value: stringSchema},
+					{name: "subkey", value: stringSchema},
+					{name: "value", required: true, value: valueSchema{kind: valueAny}},
+				}}}},
+			fieldSchema{name: "deposit", value: coinsSchema},
+			fieldSchema{name: "height", value: valueSchema{kind: valueUint}},
+		)},
+		ProposalKindDelist: {kind: valueObject, fields: append(titleFields[:2:2],
+			fieldSchema{name: "base_asset", required: true, value: stringSchema},
+			fieldSchema{name: "quote_asset", required: true, value: stringSchema},
+			fieldSchema{name: "deposit", value: coinsSchema},
+		)},
+		ProposalKindCommunityPoolSpend: {kind: valueObject, fields: append(titleFields[:2:2],
+			fieldSchema{name: "recipient", required: true, value: stringSchema},
+			fieldSchema{name: "amount", required: true, value: coinsSchema},
+			fieldSchema{name: "deposit", value: coinsSchema},
+		)},
+		ProposalKindSoftwareUpgrade: {kind: valueObject, fields: append(titleFields[:2:2],
+			fieldSchema{name: "plan", required: true, value: valueSchema{kind: valueObject, fields: []fieldSchema{
+				{name: "name", required: true, value: stringSchema},
+				{name: "time", value: stringSchema},
+				{name: "height", value: valueSchema{kind: valueUint}},
+				{name: "info", value: stringSchema},
+			}}},
+			fieldSchema{name: "deposit", value: coinsSchema},
+		)},
+		ProposalKindCancelUpgrade: {kind: valueObject, fields: append(titleFields[:2:2],
+			fieldSchema{name: "deposit", value: coinsSchema},
+		)},
+		ProposalKindManageWhiteList: {kind: valueObject, fields: append(titleFields[:2:2],
+			fieldSchema{name: "pool_name", required: true, value: stringSchema},
+			fieldSchema{name: "is_added", required: true, value: valueSchema{kind: valueBool}},
+			fieldSchema{name: "deposit", value: coinsSchema},
+		)},
+	}
+)
+
+// CheckProposalSchema checks the json of a proposal file against the schema of its kind, and reports all the missing,
+// mistyped and unknown fields with their lines and columns
+func CheckProposalSchema(kind ProposalKind, bz []byte) error {
+	schema, ok := proposalSchemas[kind]
+	if !ok {
+		return fmt.Errorf("failed. unknown proposal kind: %s", kind)
+	}
+
+	var value interface{}
+	if err := json.Unmarshal(bz, &value); err != nil {
+		var syntaxErr *json.SyntaxError
+		if errors.As(err, &syntaxErr) {
+			line, column := locate(bz, int(syntaxErr.Offset))
+			return ProposalDiagnostics{{Line: line, Column: column, Message: syntaxErr.Error()}}
+		}
+		return ProposalDiagnostics{{Message: err.Error()}}
+	}
+
+	checker := schemaChecker{
+		bz:      bz,
+		decoder: json.NewDecoder(bytes.NewReader(bz)),
+	}
+	checker.decoder.UseNumber()
+	checker.check("", schema)
+	if len(checker.diagnostics) != 0 {
+		return checker.diagnostics
+	}
+	return nil
+}
+
+// ValidateProposalJSON checks the json of a proposal file against the schema of its kind, and validates the proposal
+// decoded as it's submitted
+func ValidateProposalJSON(kind ProposalKind, bz []byte) error {
+	if err := CheckProposalSchema(kind, bz); err != nil {
+		return err
+	}
+
+	// the pointers of the proposals decoded as the proposal files
+	var proposal interface {
+		ValidateBasic() error
+	}
+	switch kind {
+	case ProposalKindText:
+		proposal = new(ProposalJSON)
+	case ProposalKindParamChange:
+		proposal = new(ParamChangeProposalJSON)
+	case ProposalKindDelist:
+		proposal = new(DelistProposalJSON)
+	case ProposalKindCommunityPoolSpend:
+		proposal = new(CommunityPoolSpendProposalJSON)
+	case ProposalKindSoftwareUpgrade:
+		proposal = new(SoftwareUpgradeProposalJSON)
+	case ProposalKindCancelUpgrade:
+		proposal = new(CancelSoftwareUpgradeProposalJSON)
+	case ProposalKindManageWhiteList:
+		proposal = new(ManageWhiteListProposalJSON)
+	}
+
+	var err error
+	if kind == ProposalKindText {
+		err = json.Unmarshal(bz, proposal)
+	} else {
+		err = MsgCdc.UnmarshalJSON(bz, proposal)
+	}
+	if err != nil {
+		return ProposalDiagnostics{{Message: err.Error()}}
+	}
+	if err = proposal.ValidateBasic(); err != nil {
+		return ProposalDiagnostics{{Message: strings.TrimPrefix(err.Error(), "failed. ")}}
+	}
+	return nil
+}
+
+// schemaChecker walks the json tokens of a proposal file, which is valid json already, against the schema
+type schemaChecker struct {
+	bz          []byte
+	decoder     *json.Decoder
+	diagnostics ProposalDiagnostics
+}
+
+func (sc *schemaChecker) report(offset int, field, format string, args ...interface{}) {
+	line, column := locate(sc.bz, offset)
+	sc.diagnostics = append(sc.diagnostics, ProposalDiagnostic{
+		Line:    line,
+		Column:  column,
+		Field:   field,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+// next returns the next token and the offset where it starts
+func (sc *schemaChecker) next() (json.Token, int) {
+	offset := int(sc.decoder.InputOffset())
+	for offset < len(sc.bz) && strings.IndexByte(" \t\r\n,:", sc.bz[offset]) >= 0 {
+		offset++
+	}
+	// never fails on the valid json
+	token, _ := sc.decoder.Token()
+	return token, offset
+}
+
+// check checks the next value against the schema
+func (sc *schemaChecker) check(field string, schema valueSchema) {
+	token, offset := sc.next()
+	got := tokenKind(token)
+	if schema.kind == valueAny {
+		sc.skip(token)
+		return
+	}
+
+	if schema.kind == valueUint {
+		if str, ok := token.(string); ok {
+			if _, err := strconv.ParseUint(str, 10, 64); err == nil {
+				return
+			}
+		}
+		sc.report(offset, field, "expected an unsigned integer in a string, e.g. \"1024\", got %s", got)
+		sc.skip(token)
+		return
+	}
+
+	if got != schema.kind {
+		sc.report(offset, field, "expected %s, got %s", schema.kind, got)
+		sc.skip(token)
+		return
+	}
+
+	switch schema.kind {
+	case valueArray:
+		for i := 0; sc.decoder.More(); i++ {
+			sc.check(fmt.Sprintf("%s[%d]", field, i), *schema.elem)
+		}
+		// the closing delim
+		sc.decoder.Token()
+	case valueObject:
+		sc.checkObject(field, offset, schema.fields)
+	}
+}
+
+// checkObject checks the fields of the object started at the offset
+func (sc *schemaChecker) checkObject(field string, offset int, fields []fieldSchema) {
+	seen := make(map[string]bool)
+	for sc.decoder.More() {
+		token, keyOffset := sc.next()
+		key := token.(string)
+		path := key
+		if len(field) != 0 {
+			path = field + "." + key
+		}
+
+		var fs *fieldSchema
+		for i := range fields {
+			if fields[i].name == key {
+				fs = &fields[i]
+				break
+			}
+		}
+		switch {
+		case fs == nil:
+			sc.report(keyOffset, path, "unknown field")
+			value, _ := sc.next()
+			sc.skip(value)
+		case seen[key]:
+			sc.report(keyOffset, path, "duplicate field")
+			value, _ := sc.next()
+			sc.skip(value)
+		default:
+			seen[key] = true
+			sc.check(path, fs.value)
+		}
+	}
+	// the closing delim
+	sc.decoder.Token()
+
+	for _, fs := range fields {
+		if fs.required && !seen[fs.name] {
+			path := fs.name
+			if len(field) != 0 {
+				path = field + "." + fs.name
+			}
+			sc.report(offset, path, "missing required field")
+		}
+	}
+}
+
+// skip skips the rest of the value started by the token
+func (sc *schemaChecker) skip(token json.Token) {
+	if delim, ok := token.(json.Delim); !ok || (delim != '{' && delim != '[') {
+		return
+	}
+	for depth := 1; depth > 0; {
+		token, _ = sc.decoder.Token()
+		if delim, ok := token.(json.Delim); ok {
+			if delim == '{' || delim == '[' {
+				depth++
+			} else {
+				depth--
+			}
+		}
+	}
+}
+
+// tokenKind returns the kind of the json value started by the token
+func tokenKind(token json.Token) string {
+	switch t := token.(type) {
+	case json.Delim:
+		if t == '{' {
+			return valueObject
+		}
+		return valueArray
+	case string:
+		return valueString
+	case json.Number:
+		return "number"
+	case bool:
+		return valueBool
+	default:
+		return "null"
+	}
+}
+
+// locate returns the 1-based line and column of the offset in the bytes
+func locate(bz []byte, offset int) (line, column int) {
+	if offset > len(bz) {
+		offset = len(bz)
+	}
+	line = bytes.Count(bz[:offset], []byte{'\n'}) + 1
+	return line, offset - bytes.LastIndexByte(bz[:offset], '\n')
+}
@@ -0,0 +1,118 @@
+package types
+
+import (
+	sdk "github.com/okex/okchain-go-sdk/types"
+)
+
+// const
+const (
+	TallyParamsPath = "custom/gov/params/tallying"
+	TallyPath       = "custom/gov/tally"
+)
+
+// TallyParams - structure of the params around the tallying of the votes on the proposals
+type TallyParams struct {
+	// Quorum is the min ratio of the voted power over the total bonded power for a proposal to be valid
+	Quorum sdk.Dec `json:"quorum"`
+	// Threshold is the min ratio of the yes over the voted power excluding the abstain for a proposal to pass
+	Threshold sdk.Dec `json:"threshold"`
+	// Veto is the min ratio of the no with veto over the voted power for a proposal to be vetoed
+	Veto sdk.Dec `json:"veto"`
+	// YesInVotePeriod is the ratio of the yes over the total bonded power, by which a proposal passes before its voting
+	// closes
+	YesInVotePeriod sdk.Dec `json:"yes_in_vote_period"`
+}
+
+// TallyPreview - structure of the projected outcome of a proposal by its current tally
+type TallyPreview struct {
+	Tally  TallyResult `json:"tally"`
+	Params TallyParams `json:"params"`
+	// Turnout is the ratio of the voted power over the total bonded power
+	Turnout       sdk.Dec `json:"turnout"`
+	QuorumReached bool    `json:"quorum_reached"`
+	// QuorumShortfall is the power yet to vote to reach the quorum
+	QuorumShortfall sdk.Dec `json:"quorum_shortfall"`
+	// YesRatio is the ratio of the yes over the voted power excluding the abstain
+	YesRatio     sdk.Dec `json:"yes_ratio"`
+	ThresholdMet bool    `json:"threshold_met"`
+	// VetoRatio is the ratio of the no with veto over the voted power
+	VetoRatio sdk.Dec `json:"veto_ratio"`
+	Vetoed    bool    `json:"vetoed"`
+	// YesInVotePeriodReached tells whether the proposal passes before its voting closes
+	YesInVotePeriodReached bool `json:"yes_in_vote_period_reached"`
+	// Passing tells whether the proposal passes if its voting closes with the current tally
+	Passing bool `json:"passing"`
+}
+
+// PreviewTally projects the outcome of a proposal by its current tally and the tally params
+func PreviewTally(tally TallyResult, params TallyParams) TallyPreview {
+	tally = TallyResult{
+		Yes:             decOrZero(tally.Yes),
+		Abstain:         decOrZero(tally.Abstain),
+		No:              decOrZero(tally.No),
+		NoWithVeto:      decOrZero(tally.NoWithVeto),
+		TotalPower:      decOrZero(tally.TotalPower),
+		TotalVotedPower: decOrZero(tally.TotalVotedPower),
+	}
+	preview := TallyPreview{
+		Tally:           tally,
+		Params:          params,
+		Turnout:         sdk.ZeroDec(),
+		QuorumShortfall: sdk.ZeroDec(),
+		YesRatio:        sdk.ZeroDec(),
+		VetoRatio:       sdk.ZeroDec(),
+	}
+	if !tally.TotalPower.IsPositive() {
+		return preview
+	}
+
+	quorum := decOrZero(params.Quorum)
+	preview.Turnout = tally.TotalVotedPower.Quo(tally.TotalPower)
+	preview.QuorumReached = preview.Turnout.GTE(quorum)
+	if shortfall := quorum.Mul(tally.TotalPower).Sub(tally.TotalVotedPower); shortfall.IsPositive() {
+		preview.QuorumShortfall = shortfall
+	}
+	if yesInVotePeriod := decOrZero(params.YesInVotePeriod); yesInVotePeriod.IsPositive() {
+		preview.YesInVotePeriodReached = tally.Yes.Quo(tally.TotalPower).GTE(yesInVotePeriod)
+	}
+	if !tally.TotalVotedPower.IsPositive() {
+		return preview
+	}
+
+	preview.VetoRatio = tally.NoWithVeto.Quo(tally.TotalVotedPower)
+	preview.Vetoed = preview.VetoRatio.GT(decOrZero(params.Veto))
+	if nonAbstain := tally.TotalVotedPower.Sub(tally.Abstain); nonAbstain.IsPositive() {
+		preview.YesRatio = tally.Yes.Quo(nonAbstain)
+		preview.ThresholdMet = preview.YesRatio.GT(decOrZero(params.Threshold))
+	}
+	preview.Passing = preview.QuorumReached && preview.ThresholdMet && !preview.Vetoed
+	return preview
+}
+
+// IsDecisive tells whether a vote of the power with the option, by a voter who hasn't voted yet, flips the projected
+// outcome of the proposal
+func (tp TallyPreview) IsDecisive(power sdk.Dec, option VoteOption) bool {
+	tally := tp.Tally
+	switch option {
+	case VoteOptionYes:
+		tally.Yes = tally.Yes.Add(power)
+	case VoteOptionAbstain:
+		tally.Abstain = tally.Abstain.Add(power)
+	case VoteOptionNo:
+		tally.No = tally.No.Add(power)
+	case VoteOptionNoWithVeto:
+		tally.NoWithVeto = tally.NoWithVeto.Add(power)
+	default:
+		return false
+	}
+	tally.TotalVotedPower = tally.TotalVotedPower.Add(power)
+	return PreviewTally(tally, tp.Params).Passing != tp.Passing
+}
+
+// decOrZero returns zero for a nil Dec, e.g. a field missing in the JSON
+func decOrZero(d sdk.Dec) sdk.Dec {
+	if d.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return d
+}
@@ -2,25 +2,64 @@ package types
 
 import (
 	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
 
 	sdk "github.com/okex/okchain-go-sdk/types"
 )
 
 // const
 const (
-	ModuleName                  = "governance"
-	OptionYes        VoteOption = 0x01
-	OptionAbstain    VoteOption = 0x02
-	OptionNo         VoteOption = 0x03
-	OptionNoWithVeto VoteOption = 0x04
+	ModuleName = "governance"
+
+	ProposalsPath = "custom/gov/proposals"
+)
+
+// vote options
+const (
+	VoteOptionEmpty      VoteOption = 0x00
+	VoteOptionYes        VoteOption = 0x01
+	VoteOptionAbstain    VoteOption = 0x02
+	VoteOptionNo         VoteOption = 0x03
+	VoteOptionNoWithVeto VoteOption = 0x04
+)
+
+// proposal statuses
+const (
+	ProposalStatusNil           ProposalStatus = 0x00
+	ProposalStatusDepositPeriod ProposalStatus = 0x01
+	ProposalStatusVotingPeriod  ProposalStatus = 0x02
+	ProposalStatusPassed        ProposalStatus = 0x03
+	ProposalStatusRejected      ProposalStatus = 0x04
+	ProposalStatusFailed        ProposalStatus = 0x05
+)
+
+// the former names of the vote options and the proposal statuses
+// Deprecated: use the VoteOption* and ProposalStatus* constants instead
+const (
+	OptionYes        = VoteOptionYes
+	OptionAbstain    = VoteOptionAbstain
+	OptionNo         = VoteOptionNo
+	OptionNoWithVeto = VoteOptionNoWithVeto
+
+	StatusNil           = ProposalStatusNil
+	StatusDepositPeriod = ProposalStatusDepositPeriod
+	StatusVotingPeriod  = ProposalStatusVotingPeriod
+	StatusPassed        = ProposalStatusPassed
+	StatusRejected      = ProposalStatusRejected
+	StatusFailed        = ProposalStatusFailed
 )
 
 var (
-	MsgCdc = sdk.NewCodec()
+	MsgCdc sdk.SDKCodec
 )
 
 func init() {
-	RegisterCodec(MsgCdc)
+	sdk.RegisterCodecInitializer(func() {
+		MsgCdc = sdk.NewCodec()
+		RegisterCodec(MsgCdc)
+	})
 }
 
 // RegisterCodec registers the msg type for governance module
@@ -34,15 +73,18 @@ func RegisterCodec(cdc sdk.SDKCodec) {
 	cdc.RegisterConcrete(ParameterChangeProposal{}, "okchain/params/ParameterChangeProposal")
 	cdc.RegisterConcrete(DelistProposal{}, "okchain/dex/DelistProposal")
 	cdc.RegisterConcrete(CommunityPoolSpendProposal{}, "okchain/distribution/CommunityPoolSpendProposal")
+	cdc.RegisterConcrete(SoftwareUpgradeProposal{}, "cosmos-sdk/SoftwareUpgradeProposal")
+	cdc.RegisterConcrete(CancelSoftwareUpgradeProposal{}, "cosmos-sdk/CancelSoftwareUpgradeProposal")
+	cdc.RegisterConcrete(ManageWhiteListProposal{}, "okchain/farm/ManageWhiteListProposal")
 }
 
 type (
 	// ProposalJSON - structure for a standard proposal from the JSON file
 	ProposalJSON struct {
-		Title        string
-		Description  string
-		ProposalType string
-		Deposit      string
+		Title        string `json:"title"`
+		Description  string `json:"description"`
+		ProposalType string `json:"proposal_type"`
+		Deposit      string `json:"deposit"`
 	}
 
 	// ParamChangeProposalJSON - structure for a ParamChangeProposal with a deposit used to parse parameter change proposals
@@ -244,23 +286,173 @@ func (CommunityPoolSpendProposal) ValidateBasic() sdk.Error { return nil }
 // VoteOption defines a vote option
 type VoteOption byte
 
+// ParseVoteOption parses the vote option from its name, e.g. "Yes" or "yes", "NoWithVeto" or "no_with_veto", with the
+// surrounding spaces trimmed
+func ParseVoteOption(str string) (VoteOption, error) {
+	switch strings.TrimSpace(str) {
+	case "Yes", "yes":
+		return VoteOptionYes, nil
+	case "Abstain", "abstain":
+		return VoteOptionAbstain, nil
+	case "No", "no":
+		return VoteOptionNo, nil
+	case "NoWithVeto", "no_with_veto":
+		return VoteOptionNoWithVeto, nil
+	default:
+		return VoteOptionEmpty, fmt.Errorf("failed. '%s' is not a valid vote option, which is one of Yes, Abstain, No "+
+			"and NoWithVeto", str)
+	}
+}
+
+// IsValid tells whether the vote option is one to vote with
+func (vo VoteOption) IsValid() bool {
+	return vo >= VoteOptionYes && vo <= VoteOptionNoWithVeto
+}
+
 // MarshalJSON Marshals to JSON using string
 func (vo VoteOption) MarshalJSON() ([]byte, error) {
 	return json.Marshal(vo.String())
 }
 
+// UnmarshalJSON unmarshals from JSON using string
+func (vo *VoteOption) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	option, err := ParseVoteOption(s)
+	if err != nil {
+		return err
+	}
+	*vo = option
+	return nil
+}
+
 // String implements the Stringer interface
 func (vo VoteOption) String() string {
 	switch vo {
-	case OptionYes:
+	case VoteOptionYes:
 		return "Yes"
-	case OptionAbstain:
+	case VoteOptionAbstain:
 		return "Abstain"
-	case OptionNo:
+	case VoteOptionNo:
 		return "No"
-	case OptionNoWithVeto:
+	case VoteOptionNoWithVeto:
 		return "NoWithVeto"
 	default:
 		return ""
 	}
-}
\ No newline at end of file
+}
+
+// ProposalStatus defines the status of a proposal
+type ProposalStatus byte
+
+// ParseProposalStatus parses the proposal status from its name, e.g. "VotingPeriod" or "voting_period", with the
+// surrounding spaces trimmed
+func ParseProposalStatus(str string) (ProposalStatus, error) {
+	switch strings.TrimSpace(str) {
+	case "DepositPeriod", "deposit_period":
+		return ProposalStatusDepositPeriod, nil
+	case "VotingPeriod", "voting_period":
+		return ProposalStatusVotingPeriod, nil
+	case "Passed", "passed":
+		return ProposalStatusPassed, nil
+	case "Rejected", "rejected":
+		return ProposalStatusRejected, nil
+	case "Failed", "failed":
+		return ProposalStatusFailed, nil
+	default:
+		return ProposalStatusNil, fmt.Errorf("failed. '%s' is not a valid proposal status, which is one of "+
+			"DepositPeriod, VotingPeriod, Passed, Rejected and Failed", str)
+	}
+}
+
+// IsValid tells whether the proposal status is a status of the proposals
+func (status ProposalStatus) IsValid() bool {
+	return status >= ProposalStatusDepositPeriod && status <= ProposalStatusFailed
+}
+
+// MarshalJSON marshals to JSON using string
+func (status ProposalStatus) MarshalJSON() ([]byte, error) {
+	return json.Marshal(status.String())
+}
+
+// UnmarshalJSON unmarshals from JSON using string
+func (status *ProposalStatus) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	if len(s) == 0 {
+		*status = ProposalStatusNil
+		return nil
+	}
+	parsed, err := ParseProposalStatus(s)
+	if err != nil {
+		return err
+	}
+	*status = parsed
+	return nil
+}
+
+// String implements the Stringer interface
+func (status ProposalStatus) String() string {
+	switch status {
+	case ProposalStatusDepositPeriod:
+		return "DepositPeriod"
+	case ProposalStatusVotingPeriod:
+		return "VotingPeriod"
+	case ProposalStatusPassed:
+		return "Passed"
+	case ProposalStatusRejected:
+		return "Rejected"
+	case ProposalStatusFailed:
+		return "Failed"
+	default:
+		return ""
+	}
+}
+
+// TallyResult - structure of the tally result of a proposal
+type TallyResult struct {
+	Yes             sdk.Dec `json:"yes"`
+	Abstain         sdk.Dec `json:"abstain"`
+	No              sdk.Dec `json:"no"`
+	NoWithVeto      sdk.Dec `json:"no_with_veto"`
+	TotalPower      sdk.Dec `json:"total_power"`
+	TotalVotedPower sdk.Dec `json:"total_voted_power"`
+}
+
+// Proposal - structure of a proposal on chain
+type Proposal struct {
+	Content          `json:"content"`
+	ProposalID       uint64         `json:"id"`
+	Status           ProposalStatus `json:"proposal_status"`
+	FinalTallyResult TallyResult    `json:"final_tally_result"`
+	SubmitTime       time.Time      `json:"submit_time"`
+	DepositEndTime   time.Time      `json:"deposit_end_time"`
+	TotalDeposit     sdk.DecCoins   `json:"total_deposit"`
+	VotingStartTime  time.Time      `json:"voting_start_time"`
+	VotingEndTime    time.Time      `json:"voting_end_time"`
+}
+
+// QueryProposalsParams defines query params of proposals
+type QueryProposalsParams struct {
+	Voter          sdk.AccAddress
+	Depositor      sdk.AccAddress
+	ProposalStatus ProposalStatus
+	Limit          uint64
+}
+
+// NewQueryProposalsParams creates a new instance of QueryProposalsParams
+func NewQueryProposalsParams(status ProposalStatus, limit uint64, voter, depositor sdk.AccAddress,
+) QueryProposalsParams {
+	return QueryProposalsParams{
+		Voter:          voter,
+		Depositor:      depositor,
+		ProposalStatus: status,
+		Limit:          limit,
+	}
+}
@@ -0,0 +1,154 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/okex/okchain-go-sdk/types"
+)
+
+// const
+const (
+	UpgradePlanPath    = "custom/upgrade/plan"
+	UpgradeAppliedPath = "custom/upgrade/applied"
+)
+
+var (
+	_ Content = (*SoftwareUpgradeProposal)(nil)
+	_ Content = (*CancelSoftwareUpgradeProposal)(nil)
+)
+
+// Plan - structure of the plan of a software upgrade, which is scheduled at either a height or a time
+type Plan struct {
+	// Name is the name of the upgrade, which the new binary handles by the upgrade handler of the same name
+	Name string `json:"name,omitempty"`
+	// Time is the time of the upgrade, no later than which the nodes halt for the new binary
+	Time time.Time `json:"time,omitempty"`
+	// Height is the height of the upgrade, at which the nodes halt for the new binary
+	Height int64 `json:"height,omitempty"`
+	// Info is the info of the new binary, e.g. the git commit or the download URLs
+	Info string `json:"info,omitempty"`
+}
+
+// ValidateBasic validates the name and the schedule of the upgrade plan
+func (p Plan) ValidateBasic() error {
+	if len(strings.TrimSpace(p.Name)) == 0 {
+		return errors.New("failed. empty name of the upgrade plan")
+	}
+	if p.Height < 0 {
+		return fmt.Errorf("failed. negative height %d of the upgrade plan", p.Height)
+	}
+
+	hasTime := !p.Time.IsZero()
+	if hasTime && p.Height != 0 {
+		return errors.New("failed. both the time and the height of the upgrade plan are set")
+	}
+	if !hasTime && p.Height == 0 {
+		return errors.New("failed. neither the time nor the height of the upgrade plan is set")
+	}
+	return nil
+}
+
+// String returns the readable text of the upgrade plan
+func (p Plan) String() string {
+	due := fmt.Sprintf("height %d", p.Height)
+	if !p.Time.IsZero() {
+		due = "time " + p.Time.UTC().Format(time.RFC3339)
+	}
+	return fmt.Sprintf("upgrade %s at %s: %s", p.Name, due, p.Info)
+}
+
+// QueryAppliedParams - structure of the params to query the height an upgrade was applied at
+type QueryAppliedParams struct {
+	Name string `json:"name"`
+}
+
+// SoftwareUpgradeProposal - structure of a software upgrade proposal that implements interface Content
+type SoftwareUpgradeProposal struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Plan        Plan   `json:"plan"`
+}
+
+// NewSoftwareUpgradeProposal is a constructor function for SoftwareUpgradeProposal
+func NewSoftwareUpgradeProposal(title, description string, plan Plan) SoftwareUpgradeProposal {
+	return SoftwareUpgradeProposal{
+		Title:       title,
+		Description: description,
+		Plan:        plan,
+	}
+}
+
+// nolint
+func (SoftwareUpgradeProposal) GetTitle() string         { return "" }
+func (SoftwareUpgradeProposal) GetDescription() string   { return "" }
+func (SoftwareUpgradeProposal) ProposalRoute() string    { return "" }
+func (SoftwareUpgradeProposal) ProposalType() string     { return "" }
+func (SoftwareUpgradeProposal) String() string           { return "" }
+func (SoftwareUpgradeProposal) ValidateBasic() sdk.Error { return nil }
+
+// CancelSoftwareUpgradeProposal - structure of a proposal to cancel the pending software upgrade that implements
+// interface Content
+type CancelSoftwareUpgradeProposal struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+}
+
+// NewCancelSoftwareUpgradeProposal is a constructor function for CancelSoftwareUpgradeProposal
+func NewCancelSoftwareUpgradeProposal(title, description string) CancelSoftwareUpgradeProposal {
+	return CancelSoftwareUpgradeProposal{
+		Title:       title,
+		Description: description,
+	}
+}
+
+// nolint
+func (CancelSoftwareUpgradeProposal) GetTitle() string         { return "" }
+func (CancelSoftwareUpgradeProposal) GetDescription() string   { return "" }
+func (CancelSoftwareUpgradeProposal) ProposalRoute() string    { return "" }
+func (CancelSoftwareUpgradeProposal) ProposalType() string     { return "" }
+func (CancelSoftwareUpgradeProposal) String() string           { return "" }
+func (CancelSoftwareUpgradeProposal) ValidateBasic() sdk.Error { return nil }
+
+type (
+	// SoftwareUpgradeProposalJSON - structure for a SoftwareUpgradeProposal with a deposit used to parse software
+	// upgrade proposals from the JSON file
+	SoftwareUpgradeProposalJSON struct {
+		Title       string       `json:"title"`
+		Description string       `json:"description"`
+		Plan        Plan         `json:"plan"`
+		Deposit     sdk.DecCoins `json:"deposit"`
+	}
+
+	// CancelSoftwareUpgradeProposalJSON - structure for a CancelSoftwareUpgradeProposal with a deposit used to parse
+	// cancel software upgrade proposals from the JSON file
+	CancelSoftwareUpgradeProposalJSON struct {
+		Title       string       `json:"title"`
+		Description string       `json:"description"`
+		Deposit     sdk.DecCoins `json:"deposit"`
+	}
+)
+
+// ValidateBasic validates the software upgrade proposal before it's submitted
+func (supj SoftwareUpgradeProposalJSON) ValidateBasic() error {
+	if err := validateTitleAndDescription(supj.Title, supj.Description); err != nil {
+		return err
+	}
+
+	if err := supj.Plan.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return validateDeposit(supj.Deposit)
+}
+
+// ValidateBasic validates the cancel software upgrade proposal before it's submitted
+func (csupj CancelSoftwareUpgradeProposalJSON) ValidateBasic() error {
+	if err := validateTitleAndDescription(csupj.Title, csupj.Description); err != nil {
+		return err
+	}
+
+	return validateDeposit(csupj.Deposit)
+}
@@ -4,8 +4,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/okex/okchain-go-sdk/module/governance/types"
+	sdk "github.com/okex/okchain-go-sdk/types"
 	"github.com/okex/okchain-go-sdk/utils"
 	"io/ioutil"
+	"strings"
 )
 
 func parseProposalFromFile(path string) (proposal types.ProposalJSON, err error) {
@@ -14,6 +16,10 @@ func parseProposalFromFile(path string) (proposal types.ProposalJSON, err error)
 		return
 	}
 
+	if err = types.CheckProposalSchema(types.ProposalKindText, contents); err != nil {
+		return
+	}
+
 	if err = json.Unmarshal(contents, &proposal); err != nil {
 		return proposal, utils.ErrUnmarshalJSON(err.Error())
 	}
@@ -27,6 +33,10 @@ func parseParamChangeProposalFromFile(path string) (proposal types.ParamChangePr
 		return
 	}
 
+	if err = types.CheckProposalSchema(types.ProposalKindParamChange, contents); err != nil {
+		return
+	}
+
 	if err = types.MsgCdc.UnmarshalJSON(contents, &proposal); err != nil {
 		return proposal, utils.ErrUnmarshalJSON(err.Error())
 	}
@@ -40,6 +50,10 @@ func parseDelistProposalFromFile(path string) (proposal types.DelistProposalJSON
 		return
 	}
 
+	if err = types.CheckProposalSchema(types.ProposalKindDelist, contents); err != nil {
+		return
+	}
+
 	if err = types.MsgCdc.UnmarshalJSON(contents, &proposal); err != nil {
 		return proposal, utils.ErrUnmarshalJSON(err.Error())
 	}
@@ -53,6 +67,10 @@ func parseCommunityPoolSpendProposalFromFile(path string) (proposal types.Commun
 		return
 	}
 
+	if err = types.CheckProposalSchema(types.ProposalKindCommunityPoolSpend, contents); err != nil {
+		return
+	}
+
 	if err = types.MsgCdc.UnmarshalJSON(contents, &proposal); err != nil {
 		return proposal, utils.ErrUnmarshalJSON(err.Error())
 	}
@@ -60,21 +78,74 @@ func parseCommunityPoolSpendProposalFromFile(path string) (proposal types.Commun
 	return
 }
 
-func voteOptionFromString(str string) (types.VoteOption, error) {
-	switch str {
-	case "Yes", "yes":
-		return types.OptionYes, nil
+func parseSoftwareUpgradeProposalFromFile(path string) (proposal types.SoftwareUpgradeProposalJSON, err error) {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return
+	}
+
+	if err = types.CheckProposalSchema(types.ProposalKindSoftwareUpgrade, contents); err != nil {
+		return
+	}
 
-	case "Abstain", "abstain":
-		return types.OptionAbstain, nil
+	if err = types.MsgCdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, utils.ErrUnmarshalJSON(err.Error())
+	}
 
-	case "No", "no":
-		return types.OptionNo, nil
+	return
+}
 
-	case "NoWithVeto", "no_with_veto":
-		return types.OptionNoWithVeto, nil
+func parseCancelSoftwareUpgradeProposalFromFile(path string) (proposal types.CancelSoftwareUpgradeProposalJSON,
+	err error) {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return
+	}
+
+	if err = types.CheckProposalSchema(types.ProposalKindCancelUpgrade, contents); err != nil {
+		return
+	}
+
+	if err = types.MsgCdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, utils.ErrUnmarshalJSON(err.Error())
+	}
+
+	return
+}
+
+func parseManageWhiteListProposalFromFile(path string) (proposal types.ManageWhiteListProposalJSON, err error) {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return
+	}
+
+	if err = types.CheckProposalSchema(types.ProposalKindManageWhiteList, contents); err != nil {
+		return
+	}
+
+	if err = types.MsgCdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, utils.ErrUnmarshalJSON(err.Error())
+	}
+
+	return
+}
+
+// ValidateProposalFile checks the proposal file of the kind before the submission, which reports the missing,
+// mistyped and unknown fields with their lines and columns as types.ProposalDiagnostics and validates the proposal
+func ValidateProposalFile(path string, kind types.ProposalKind) error {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed. read proposal file error: %w", err)
+	}
+
+	return types.ValidateProposalJSON(kind, contents)
+}
 
-	default:
-		return types.VoteOption(0xff), fmt.Errorf("failed. '%s' is not a valid vote option", str)
+// decCoinsToString converts the coins to the string able to be parsed by sdk.ParseDecCoins
+func decCoinsToString(coins sdk.DecCoins) string {
+	coinStrs := make([]string, len(coins))
+	for i, coin := range coins {
+		coinStrs[i] = coin.Amount.String() + coin.Denom
 	}
+	return strings.Join(coinStrs, ",")
 }
@@ -0,0 +1,137 @@
+package governance
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/okex/okchain-go-sdk/module/governance/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateProposalFile(t *testing.T) {
+	for kind, contents := range map[types.ProposalKind]string{
+		types.ProposalKindText:               textProposalJSON,
+		types.ProposalKindParamChange:        paramChangeProposalJSON,
+		types.ProposalKindDelist:             delistProposalJSON,
+		types.ProposalKindCommunityPoolSpend: communityPoolSpendProposalJSON,
+	} {
+		require.NoError(t, ioutil.WriteFile(badProposalFilePath, []byte(contents), 0644))
+		require.NoError(t, ValidateProposalFile(badProposalFilePath, kind), kind)
+	}
+
+	// missing, mistyped and unknown fields located
+	require.NoError(t, ioutil.WriteFile(badProposalFilePath, []byte(`{
+  "title": "Delist Proposal",
+  "base_asset": "btc-000",
+  "quote_assets": "okt",
+  "deposit": "100okt"
+}`), 0644))
+	err := ValidateProposalFile(badProposalFilePath, types.ProposalKindDelist)
+	var diagnostics types.ProposalDiagnostics
+	require.True(t, errors.As(err, &diagnostics))
+	require.Equal(t, types.ProposalDiagnostics{
+		{Line: 4, Column: 3, Field: "quote_assets", Message: "unknown field"},
+		{Line: 5, Column: 14, Field: "deposit", Message: "expected array, got string"},
+		{Line: 1, Column: 1, Field: "description", Message: "missing required field"},
+		{Line: 1, Column: 1, Field: "quote_asset", Message: "missing required field"},
+	}, diagnostics)
+
+	// nested fields
+	require.NoError(t, ioutil.WriteFile(badProposalFilePath, []byte(
+		`{"title":"t","description":"d","changes":[{"subspace":"staking","value":105}],"height":1024}`), 0644))
+	err = ValidateProposalFile(badProposalFilePath, types.ProposalKindParamChange)
+	require.True(t, errors.As(err, &diagnostics))
+	require.Len(t, diagnostics, 2)
+	require.Equal(t, "changes[0].key", diagnostics[0].Field)
+	require.Equal(t, 43, diagnostics[0].Column)
+	require.Equal(t, "height", diagnostics[1].Field)
+
+	// syntax error
+	require.NoError(t, ioutil.WriteFile(badProposalFilePath, []byte("{\n  \"title\": \"t\",\n}"), 0644))
+	err = ValidateProposalFile(badProposalFilePath, types.ProposalKindText)
+	require.True(t, errors.As(err, &diagnostics))
+	require.Equal(t, 3, diagnostics[0].Line)
+
+	// the schema passed but invalid
+	require.NoError(t, ioutil.WriteFile(badProposalFilePath, []byte(`{"title":"t","description":"d","deposit":"100"}`),
+		0644))
+	require.Error(t, ValidateProposalFile(badProposalFilePath, types.ProposalKindText))
+
+	// rejected on the submission
+	require.NoError(t, ioutil.WriteFile(badProposalFilePath, []byte(`{"title":"t","descriptions":"d"}`), 0644))
+	_, err = parseProposalFromFile(badProposalFilePath)
+	require.True(t, errors.As(err, &diagnostics))
+
+	require.NoError(t, os.Remove(badProposalFilePath))
+	require.Error(t, ValidateProposalFile(badProposalFilePath, types.ProposalKindText))
+	require.Error(t, ValidateProposalFile(badProposalFilePath, "unknown"))
+}
+
+func TestParseVoteOption(t *testing.T) {
+	for str, expected := range map[string]types.VoteOption{
+		"Yes":            types.VoteOptionYes,
+		"abstain":        types.VoteOptionAbstain,
+		"No":             types.VoteOptionNo,
+		"no_with_veto ":  types.VoteOptionNoWithVeto,
+		" NoWithVeto\n":  types.VoteOptionNoWithVeto,
+		"yes":            types.VoteOptionYes,
+		"no":             types.VoteOptionNo,
+		"Abstain":        types.VoteOptionAbstain,
+		"no_with_veto":   types.VoteOptionNoWithVeto,
+		"NoWithVeto":     types.VoteOptionNoWithVeto,
+		"no with veto":   types.VoteOptionEmpty,
+		"":               types.VoteOptionEmpty,
+		"yes_with_proxy": types.VoteOptionEmpty,
+	} {
+		option, err := types.ParseVoteOption(str)
+		require.Equal(t, expected, option, str)
+		require.Equal(t, expected.IsValid(), err == nil, str)
+		if err == nil {
+			// round trip of the name
+			parsed, err := types.ParseVoteOption(option.String())
+			require.NoError(t, err)
+			require.Equal(t, option, parsed)
+		}
+	}
+	require.False(t, types.VoteOption(0x05).IsValid())
+
+	bz, err := json.Marshal(types.VoteOptionNoWithVeto)
+	require.NoError(t, err)
+	require.Equal(t, `"NoWithVeto"`, string(bz))
+	var option types.VoteOption
+	require.NoError(t, json.Unmarshal(bz, &option))
+	require.Equal(t, types.VoteOptionNoWithVeto, option)
+	require.Error(t, json.Unmarshal([]byte(`"Maybe"`), &option))
+}
+
+func TestParseProposalStatus(t *testing.T) {
+	for str, expected := range map[string]types.ProposalStatus{
+		"DepositPeriod":   types.ProposalStatusDepositPeriod,
+		"voting_period":   types.ProposalStatusVotingPeriod,
+		" VotingPeriod ":  types.ProposalStatusVotingPeriod,
+		"Passed":          types.ProposalStatusPassed,
+		"rejected":        types.ProposalStatusRejected,
+		"Failed":          types.ProposalStatusFailed,
+		"":                types.ProposalStatusNil,
+		"Voting Period":   types.ProposalStatusNil,
+		"deposit-period":  types.ProposalStatusNil,
+		"UnknownProposal": types.ProposalStatusNil,
+	} {
+		status, err := types.ParseProposalStatus(str)
+		require.Equal(t, expected, status, str)
+		require.Equal(t, expected.IsValid(), err == nil, str)
+	}
+
+	bz, err := json.Marshal(types.ProposalStatusVotingPeriod)
+	require.NoError(t, err)
+	require.Equal(t, `"VotingPeriod"`, string(bz))
+	var status types.ProposalStatus
+	require.NoError(t, json.Unmarshal(bz, &status))
+	require.Equal(t, types.ProposalStatusVotingPeriod, status)
+	require.NoError(t, json.Unmarshal([]byte(`""`), &status))
+	require.Equal(t, types.ProposalStatusNil, status)
+	require.Error(t, json.Unmarshal([]byte(`"Pending"`), &status))
+}
@@ -0,0 +1,117 @@
+package module
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	sdk "github.com/okex/okchain-go-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	rpcCli "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	"google.golang.org/grpc"
+)
+
+const (
+	defaultGRPCQueryTimeout = 10 * time.Second
+
+	// GRPCABCIQueryMethod is the method of the gRPC query service of the chain running the ABCI queries by their paths,
+	// whose request and response share the wire format of the tendermint ABCI query, so the amino paths of the module
+	// clients are served unchanged
+	GRPCABCIQueryMethod = "/cosmos.base.tendermint.v1beta1.Service/ABCIQuery"
+)
+
+var _ sdk.RPCClient = (*grpcTransport)(nil)
+
+// GRPCInvoker shows the expected behavior of the connection to the gRPC query service, e.g. *grpc.ClientConn
+type GRPCInvoker interface {
+	Invoke(ctx context.Context, method string, args, reply interface{}, opts ...grpc.CallOption) error
+}
+
+// grpcTransport - structure of the transport routing the ABCI queries to the gRPC query service of the chain, and the
+// other calls to the tendermint rpc
+type grpcTransport struct {
+	sdk.RPCClient
+	grpcAddr string
+	// the gRPC connection is dialed on the first query
+	once     sync.Once
+	conn     GRPCInvoker
+	dialErr  error
+	timeout  time.Duration
+	dialOpts []grpc.DialOption
+}
+
+// NewGRPCTransport creates a transport of the ABCI queries through the gRPC query service at the address, with the
+// other calls on the rpc client. The connection is plaintext unless the dial options say otherwise
+func NewGRPCTransport(grpcAddr string, rpcClient sdk.RPCClient, dialOpts ...grpc.DialOption) sdk.RPCClient {
+	if len(dialOpts) == 0 {
+		dialOpts = []grpc.DialOption{grpc.WithInsecure()}
+	}
+
+	return &grpcTransport{
+		RPCClient: rpcClient,
+		grpcAddr:  grpcAddr,
+		timeout:   defaultGRPCQueryTimeout,
+		dialOpts:  dialOpts,
+	}
+}
+
+// NewGRPCTransportWithConn creates a transport of the ABCI queries on a specific gRPC connection, e.g. one shared with
+// the other gRPC services of the chain
+func NewGRPCTransportWithConn(conn GRPCInvoker, rpcClient sdk.RPCClient) sdk.RPCClient {
+	t := &grpcTransport{
+		RPCClient: rpcClient,
+		conn:      conn,
+		timeout:   defaultGRPCQueryTimeout,
+	}
+	// no dial
+	t.once.Do(func() {})
+	return t
+}
+
+// Unwrap returns the tendermint rpc client of the calls out of the ABCI queries
+func (t *grpcTransport) Unwrap() sdk.RPCClient {
+	return t.RPCClient
+}
+
+// ABCIQuery executes the ABCI query of the latest state through the gRPC query service
+func (t *grpcTransport) ABCIQuery(path string, data cmn.HexBytes) (*ctypes.ResultABCIQuery, error) {
+	return t.ABCIQueryWithOptions(path, data, rpcCli.DefaultABCIQueryOptions)
+}
+
+// ABCIQueryWithOptions executes the ABCI query through the gRPC query service
+func (t *grpcTransport) ABCIQueryWithOptions(path string, data cmn.HexBytes, opts rpcCli.ABCIQueryOptions) (
+	*ctypes.ResultABCIQuery, error) {
+	conn, err := t.getConn()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), t.timeout)
+	defer cancel()
+	var resp abci.ResponseQuery
+	if err = conn.Invoke(ctx, GRPCABCIQueryMethod, &abci.RequestQuery{
+		Data:   data,
+		Path:   path,
+		Height: opts.Height,
+		Prove:  opts.Prove,
+	}, &resp); err != nil {
+		return nil, fmt.Errorf("failed. gRPC query error: %w", err)
+	}
+
+	return &ctypes.ResultABCIQuery{Response: resp}, nil
+}
+
+func (t *grpcTransport) getConn() (GRPCInvoker, error) {
+	t.once.Do(func() {
+		conn, err := grpc.Dial(t.grpcAddr, t.dialOpts...)
+		if err != nil {
+			t.dialErr = fmt.Errorf("failed. dial gRPC query service %s error: %w", t.grpcAddr, err)
+			return
+		}
+		t.conn = conn
+	})
+	return t.conn, t.dialErr
+}
@@ -0,0 +1,124 @@
+package module
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/okex/okchain-go-sdk/mocks"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	rpcCli "github.com/tendermint/tendermint/rpc/client"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// grpcQueryService serves the ABCIQuery method of the gRPC query service by the conformance backend, and records the
+// metadata of the last query
+type grpcQueryService struct {
+	backend  *mocks.ConformanceBackend
+	metadata metadata.MD
+}
+
+func (qs *grpcQueryService) query(ctx context.Context, in *abci.RequestQuery) (*abci.ResponseQuery, error) {
+	qs.metadata, _ = metadata.FromIncomingContext(ctx)
+	res, err := qs.backend.ABCIQueryWithOptions(in.Path, in.Data, rpcCli.ABCIQueryOptions{
+		Height: in.Height,
+		Prove:  in.Prove,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &res.Response, nil
+}
+
+var grpcQueryServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.base.tendermint.v1beta1.Service",
+	HandlerType: (*interface{})(nil),
+	Methods: []grpc.MethodDesc{{
+		MethodName: "ABCIQuery",
+		Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error,
+			_ grpc.UnaryServerInterceptor) (interface{}, error) {
+			var in abci.RequestQuery
+			if err := dec(&in); err != nil {
+				return nil, err
+			}
+			return srv.(*grpcQueryService).query(ctx, &in)
+		},
+	}},
+}
+
+// startGRPCQueryService serves the gRPC query service on a loopback port until the test ends
+func startGRPCQueryService(t *testing.T, backend *mocks.ConformanceBackend) (*grpcQueryService, string) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	service := &grpcQueryService{backend: backend}
+	server := grpc.NewServer()
+	server.RegisterService(&grpcQueryServiceDesc, service)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(server.Stop)
+	return service, listener.Addr().String()
+}
+
+func TestGRPCTransport_QueryConformance(t *testing.T) {
+	config, err := sdk.NewClientConfig("testURL", "testChain", sdk.BroadcastBlock, "", 200000,
+		1.1, "0.00000001okt")
+	require.NoError(t, err)
+
+	mocks.RunQueryConformanceSuite(t, func(cdc sdk.SDKCodec, backend *mocks.ConformanceBackend) sdk.ClientQuery {
+		_, grpcAddr := startGRPCQueryService(t, backend)
+		// the tendermint rpc is never called by the queries
+		return NewBaseClientWithRPC(cdc, &config, NewGRPCTransport(grpcAddr, nil))
+	})
+}
+
+func TestGRPCTransport_WithConn(t *testing.T) {
+	backend := mocks.NewConformanceBackend()
+	backend.SetResponse("custom/test/path", nil, abci.ResponseQuery{Value: []byte("value")})
+	_, grpcAddr := startGRPCQueryService(t, backend)
+	conn, err := grpc.Dial(grpcAddr, grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	config, err := sdk.NewClientConfig("testURL", "testChain", sdk.BroadcastBlock, "", 200000,
+		1.1, "0.00000001okt")
+	require.NoError(t, err)
+	bc := NewBaseClientWithRPC(mocks.NewFixtureCodec(), &config, NewGRPCTransportWithConn(conn, nil))
+	value, err := bc.Query("custom/test/path", nil)
+	require.NoError(t, err)
+	require.Equal(t, []byte("value"), value)
+}
+
+func TestGRPCTransport_Auth(t *testing.T) {
+	service, grpcAddr := startGRPCQueryService(t, mocks.NewConformanceBackend())
+	auth := &sdk.EndpointAuth{Headers: map[string]string{"X-Api-Key": "key"}}
+
+	// the auth refused over the plaintext connection
+	transport := NewGRPCTransport(grpcAddr, nil, newGRPCDialOptions(grpcAddr, auth)...)
+	_, err := transport.ABCIQuery("custom/test/path", nil)
+	require.Error(t, err)
+	require.Nil(t, service.metadata)
+
+	// allowed to the loopback address for local use
+	auth.AllowPlaintext = true
+	transport = NewGRPCTransport(grpcAddr, nil, newGRPCDialOptions(grpcAddr, auth)...)
+	_, err = transport.ABCIQuery("custom/test/path", nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"key"}, service.metadata.Get("x-api-key"))
+}
+
+func TestNewBaseClient_Transport(t *testing.T) {
+	config, err := sdk.NewClientConfig("testURL", "testChain", sdk.BroadcastBlock, "", 200000,
+		1.1, "0.00000001okt")
+	require.NoError(t, err)
+	_, ok := NewBaseClient(mocks.NewFixtureCodec(), &config).RPCClient.(*grpcTransport)
+	require.False(t, ok)
+
+	config.Transport, config.GRPCAddr = sdk.TransportGRPC, "localhost:9090"
+	transport, ok := NewBaseClient(mocks.NewFixtureCodec(), &config).RPCClient.(*grpcTransport)
+	require.True(t, ok)
+	require.Equal(t, "localhost:9090", transport.grpcAddr)
+}
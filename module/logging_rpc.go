@@ -0,0 +1,166 @@
+package module
+
+import (
+	"time"
+
+	sdk "github.com/okex/okchain-go-sdk/types"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	rpcCli "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+var _ sdk.RPCClient = (*loggingRPCClient)(nil)
+
+// loggingRPCClient - structure of the rpc client logging every call to the inner one with its duration and result
+type loggingRPCClient struct {
+	sdk.RPCClient
+	logger sdk.Logger
+}
+
+// newLoggingRPCClient wraps the rpc client with the logging of the calls
+func newLoggingRPCClient(rpcClient sdk.RPCClient, logger sdk.Logger) *loggingRPCClient {
+	return &loggingRPCClient{
+		RPCClient: rpcClient,
+		logger:    logger,
+	}
+}
+
+// Unwrap returns the inner rpc client, e.g. for the subscriptions
+func (lc *loggingRPCClient) Unwrap() sdk.RPCClient {
+	return lc.RPCClient
+}
+
+// logCall logs the call at the debug level, or the error level if it failed
+func (lc *loggingRPCClient) logCall(method string, start time.Time, err error, keyvals ...interface{}) {
+	keyvals = append([]interface{}{"method", method, "duration", time.Since(start)}, keyvals...)
+	if err != nil {
+		lc.logger.Error("rpc call failed", append(keyvals, "err", err)...)
+		return
+	}
+	lc.logger.Debug("rpc call", keyvals...)
+}
+
+// logBroadcast logs the broadcast with the tx hash at the info level, or the error level if it failed
+func (lc *loggingRPCClient) logBroadcast(method string, start time.Time, tx tmtypes.Tx, err error,
+	keyvals ...interface{}) {
+	keyvals = append([]interface{}{"method", method, "duration", time.Since(start), "tx_hash",
+		cmn.HexBytes(tx.Hash()).String()}, keyvals...)
+	if err != nil {
+		lc.logger.Error("broadcast failed", append(keyvals, "err", err)...)
+		return
+	}
+	lc.logger.Info("broadcast", keyvals...)
+}
+
+// ABCIInfo implements the rpc.ABCIClient interface
+func (lc *loggingRPCClient) ABCIInfo() (res *ctypes.ResultABCIInfo, err error) {
+	defer func(start time.Time) { lc.logCall(methodABCIInfo, start, err) }(time.Now())
+	return lc.RPCClient.ABCIInfo()
+}
+
+// ABCIQuery implements the rpc.ABCIClient interface
+func (lc *loggingRPCClient) ABCIQuery(path string, data cmn.HexBytes) (res *ctypes.ResultABCIQuery, err error) {
+	defer func(start time.Time) { lc.logCall(methodABCIQuery, start, err, "path", path) }(time.Now())
+	return lc.RPCClient.ABCIQuery(path, data)
+}
+
+// ABCIQueryWithOptions implements the rpc.ABCIClient interface
+func (lc *loggingRPCClient) ABCIQueryWithOptions(path string, data cmn.HexBytes, opts rpcCli.ABCIQueryOptions) (
+	res *ctypes.ResultABCIQuery, err error) {
+	defer func(start time.Time) {
+		lc.logCall(methodABCIQuery, start, err, "path", path, "height", opts.Height, "prove", opts.Prove)
+	}(time.Now())
+	return lc.RPCClient.ABCIQueryWithOptions(path, data, opts)
+}
+
+// BroadcastTxCommit implements the rpc.ABCIClient interface
+func (lc *loggingRPCClient) BroadcastTxCommit(tx tmtypes.Tx) (res *ctypes.ResultBroadcastTxCommit, err error) {
+	defer func(start time.Time) {
+		if res == nil {
+			lc.logBroadcast(methodBroadcastTxCommit, start, tx, err)
+			return
+		}
+		lc.logBroadcast(methodBroadcastTxCommit, start, tx, err, "height", res.Height, "check_tx_code",
+			res.CheckTx.Code, "deliver_tx_code", res.DeliverTx.Code)
+	}(time.Now())
+	return lc.RPCClient.BroadcastTxCommit(tx)
+}
+
+// BroadcastTxAsync implements the rpc.ABCIClient interface
+func (lc *loggingRPCClient) BroadcastTxAsync(tx tmtypes.Tx) (res *ctypes.ResultBroadcastTx, err error) {
+	defer func(start time.Time) { lc.logBroadcast(methodBroadcastTxAsync, start, tx, err) }(time.Now())
+	return lc.RPCClient.BroadcastTxAsync(tx)
+}
+
+// BroadcastTxSync implements the rpc.ABCIClient interface
+func (lc *loggingRPCClient) BroadcastTxSync(tx tmtypes.Tx) (res *ctypes.ResultBroadcastTx, err error) {
+	defer func(start time.Time) {
+		if res == nil {
+			lc.logBroadcast(methodBroadcastTxSync, start, tx, err)
+			return
+		}
+		lc.logBroadcast(methodBroadcastTxSync, start, tx, err, "code", res.Code)
+	}(time.Now())
+	return lc.RPCClient.BroadcastTxSync(tx)
+}
+
+// Block implements the rpc.SignClient interface
+func (lc *loggingRPCClient) Block(height *int64) (res *ctypes.ResultBlock, err error) {
+	defer func(start time.Time) { lc.logCall(methodBlock, start, err, "height", heightValue(height)) }(time.Now())
+	return lc.RPCClient.Block(height)
+}
+
+// BlockResults implements the rpc.SignClient interface
+func (lc *loggingRPCClient) BlockResults(height *int64) (res *ctypes.ResultBlockResults, err error) {
+	defer func(start time.Time) { lc.logCall(methodBlockResults, start, err, "height", heightValue(height)) }(time.Now())
+	return lc.RPCClient.BlockResults(height)
+}
+
+// Commit implements the rpc.SignClient interface
+func (lc *loggingRPCClient) Commit(height *int64) (res *ctypes.ResultCommit, err error) {
+	defer func(start time.Time) { lc.logCall(methodCommit, start, err, "height", heightValue(height)) }(time.Now())
+	return lc.RPCClient.Commit(height)
+}
+
+// Validators implements the rpc.SignClient interface
+func (lc *loggingRPCClient) Validators(height *int64) (res *ctypes.ResultValidators, err error) {
+	defer func(start time.Time) { lc.logCall(methodValidators, start, err, "height", heightValue(height)) }(time.Now())
+	return lc.RPCClient.Validators(height)
+}
+
+// Tx implements the rpc.SignClient interface
+func (lc *loggingRPCClient) Tx(hash []byte, prove bool) (res *ctypes.ResultTx, err error) {
+	defer func(start time.Time) {
+		lc.logCall(methodTx, start, err, "tx_hash", cmn.HexBytes(hash).String())
+	}(time.Now())
+	return lc.RPCClient.Tx(hash, prove)
+}
+
+// TxSearch implements the rpc.SignClient interface
+func (lc *loggingRPCClient) TxSearch(query string, prove bool, page, perPage int) (res *ctypes.ResultTxSearch,
+	err error) {
+	defer func(start time.Time) {
+		lc.logCall(methodTxSearch, start, err, "query", query, "page", page, "per_page", perPage)
+	}(time.Now())
+	return lc.RPCClient.TxSearch(query, prove, page, perPage)
+}
+
+// heightValue returns the height to log, 0 for the latest
+func heightValue(height *int64) int64 {
+	if height == nil {
+		return 0
+	}
+	return *height
+}
+
+// unwrapRPCClient returns the innermost rpc client under the transports and the wrappers
+func unwrapRPCClient(rpcClient sdk.RPCClient) sdk.RPCClient {
+	for {
+		wrapper, ok := rpcClient.(interface{ Unwrap() sdk.RPCClient })
+		if !ok {
+			return rpcClient
+		}
+		rpcClient = wrapper.Unwrap()
+	}
+}
@@ -0,0 +1,86 @@
+package module
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/okex/okchain-go-sdk/mocks"
+	tokentypes "github.com/okex/okchain-go-sdk/module/token/types"
+	"github.com/okex/okchain-go-sdk/testutil"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmlog "github.com/tendermint/tendermint/libs/log"
+)
+
+// the tendermint loggers are plugged in directly
+var _ sdk.Logger = tmlog.NewNopLogger()
+
+type logEntry struct {
+	level   string
+	msg     string
+	keyvals map[interface{}]interface{}
+}
+
+// recordLogger records the log entries with the key-value pairs
+type recordLogger struct {
+	entries []logEntry
+}
+
+func (rl *recordLogger) record(level, msg string, keyvals []interface{}) {
+	entry := logEntry{level: level, msg: msg, keyvals: make(map[interface{}]interface{})}
+	for i := 0; i+1 < len(keyvals); i += 2 {
+		entry.keyvals[keyvals[i]] = keyvals[i+1]
+	}
+	rl.entries = append(rl.entries, entry)
+}
+
+func (rl *recordLogger) Debug(msg string, keyvals ...interface{}) { rl.record("debug", msg, keyvals) }
+func (rl *recordLogger) Info(msg string, keyvals ...interface{})  { rl.record("info", msg, keyvals) }
+func (rl *recordLogger) Error(msg string, keyvals ...interface{}) { rl.record("error", msg, keyvals) }
+
+func TestBaseClient_Logger(t *testing.T) {
+	config, err := sdk.NewClientConfig("testURL", "testChain", sdk.BroadcastSync, "0.01okt", 200000,
+		0, "")
+	require.NoError(t, err)
+	logger := new(recordLogger)
+	config.Logger = logger
+	conformance := mocks.NewConformanceBackend()
+	backend := &broadcastBackend{ConformanceBackend: conformance}
+	bc := NewBaseClientWithRPC(mocks.NewFixtureCodec(), &config, backend)
+	require.Equal(t, backend, unwrapRPCClient(bc.RPCClient))
+
+	// query
+	conformance.SetResponse("custom/test/path", nil, abci.ResponseQuery{Value: []byte("value")})
+	_, err = bc.Query("custom/test/path", nil)
+	require.NoError(t, err)
+	require.Len(t, logger.entries, 1)
+	require.Equal(t, "debug", logger.entries[0].level)
+	require.Equal(t, "abci_query", logger.entries[0].keyvals["method"])
+	require.Equal(t, "custom/test/path", logger.entries[0].keyvals["path"])
+	require.Contains(t, logger.entries[0].keyvals, "duration")
+
+	conformance.SetError("custom/test/path", nil, errors.New("default error"))
+	_, err = bc.Query("custom/test/path", nil)
+	require.Error(t, err)
+	require.Len(t, logger.entries, 2)
+	require.Equal(t, "error", logger.entries[1].level)
+	require.EqualError(t, logger.entries[1].keyvals["err"].(error), "default error")
+
+	// signing and broadcast
+	user := testutil.NewAccounts(t, testutil.FixtureAccountsSeed, 1)[0]
+	coins, err := sdk.ParseDecCoins("10.24okt")
+	require.NoError(t, err)
+	msgs := []sdk.Msg{tokentypes.NewMsgTokenSend(user.Address, user.Address, coins)}
+	resp, err := bc.BuildAndBroadcastWithSigner(user.Signer(), "my memo", msgs, 1, 2)
+	require.NoError(t, err)
+	require.Len(t, logger.entries, 4)
+	signEntry, broadcastEntry := logger.entries[2], logger.entries[3]
+	require.Equal(t, "tx signed", signEntry.msg)
+	require.Equal(t, user.Address.String(), signEntry.keyvals["signer"])
+	require.Equal(t, uint64(2), signEntry.keyvals["sequence"])
+	require.Equal(t, resp.TxHash, signEntry.keyvals["tx_hash"])
+	require.Equal(t, "info", broadcastEntry.level)
+	require.Equal(t, "broadcast_tx_sync", broadcastEntry.keyvals["method"])
+	require.Equal(t, resp.TxHash, broadcastEntry.keyvals["tx_hash"])
+}
@@ -0,0 +1,8 @@
+package mint
+
+import "github.com/okex/okchain-go-sdk/module/mint/types"
+
+// const
+const (
+	ModuleName = types.ModuleName
+)
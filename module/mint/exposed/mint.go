@@ -0,0 +1,19 @@
+package exposed
+
+import (
+	"github.com/okex/okchain-go-sdk/module/mint/types"
+	sdk "github.com/okex/okchain-go-sdk/types"
+)
+
+// Mint shows the expected behavior for inner mint client
+type Mint interface {
+	sdk.Module
+	MintQuery
+}
+
+// MintQuery shows the expected query behavior for inner mint client
+type MintQuery interface {
+	QueryMintParams() (types.Params, error)
+	QueryInflation() (sdk.Dec, error)
+	QueryAnnualProvisions() (sdk.Dec, error)
+}
@@ -0,0 +1,34 @@
+package mint
+
+import (
+	"github.com/okex/okchain-go-sdk/module/mint/exposed"
+	"github.com/okex/okchain-go-sdk/module/mint/types"
+	sdk "github.com/okex/okchain-go-sdk/types"
+)
+
+func init() {
+	sdk.RegisterModule(types.ModuleName, func(baseClient sdk.BaseClient) sdk.Module {
+		return NewMintClient(baseClient)
+	})
+}
+
+var _ sdk.Module = (*mintClient)(nil)
+
+type mintClient struct {
+	sdk.BaseClient
+}
+
+// RegisterCodec registers the msg type in mint module
+func (mc mintClient) RegisterCodec(cdc sdk.SDKCodec) {
+	types.RegisterCodec(cdc)
+}
+
+// Name returns the module name
+func (mintClient) Name() string {
+	return types.ModuleName
+}
+
+// NewMintClient creates a new instance of mint client as implement
+func NewMintClient(baseClient sdk.BaseClient) exposed.Mint {
+	return mintClient{baseClient}
+}
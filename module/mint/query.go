@@ -0,0 +1,45 @@
+package mint
+
+import (
+	"github.com/okex/okchain-go-sdk/module/mint/types"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	"github.com/okex/okchain-go-sdk/utils"
+)
+
+// QueryMintParams gets the params of the mint module, e.g. the mint denom and the blocks per year
+func (mc mintClient) QueryMintParams() (mintParams types.Params, err error) {
+	res, err := mc.Query(types.ParamsPath, nil)
+	if err != nil {
+		return mintParams, utils.ErrClientQuery(err.Error())
+	}
+
+	if err = mc.GetCodec().UnmarshalJSON(res, &mintParams); err != nil {
+		return mintParams, utils.ErrUnmarshalJSON(err.Error())
+	}
+
+	return
+}
+
+// QueryInflation gets the current annual inflation rate
+func (mc mintClient) QueryInflation() (sdk.Dec, error) {
+	return mc.queryDec(types.InflationPath)
+}
+
+// QueryAnnualProvisions gets the current annual provisions, i.e. the amount of the mint denom minted in a year at the
+// current inflation rate
+func (mc mintClient) QueryAnnualProvisions() (sdk.Dec, error) {
+	return mc.queryDec(types.AnnualProvisionsPath)
+}
+
+func (mc mintClient) queryDec(path string) (dec sdk.Dec, err error) {
+	res, err := mc.Query(path, nil)
+	if err != nil {
+		return dec, utils.ErrClientQuery(err.Error())
+	}
+
+	if err = mc.GetCodec().UnmarshalJSON(res, &dec); err != nil {
+		return dec, utils.ErrUnmarshalJSON(err.Error())
+	}
+
+	return
+}
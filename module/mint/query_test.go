@@ -0,0 +1,77 @@
+package mint
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/okex/okchain-go-sdk/mocks"
+	"github.com/okex/okchain-go-sdk/module/mint/types"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMintClient_QueryMintParams(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	config, err := sdk.NewClientConfig("testURL", "testChain", sdk.BroadcastBlock, "", 200000,
+		1.1, "0.00000001okt")
+	require.NoError(t, err)
+	mockCli := mocks.NewMockClient(t, ctrl, config)
+	mockCli.RegisterModule(NewMintClient(mockCli.MockBaseClient))
+
+	expectedCdc := mockCli.GetCodec()
+	expectedRet := expectedCdc.MustMarshalJSON(types.Params{
+		MintDenom:           "okt",
+		InflationRateChange: sdk.MustNewDecFromStr("0.13"),
+		InflationMax:        sdk.MustNewDecFromStr("0.2"),
+		InflationMin:        sdk.MustNewDecFromStr("0.07"),
+		GoalBonded:          sdk.MustNewDecFromStr("0.67"),
+		BlocksPerYear:       6311520,
+	})
+
+	mockCli.EXPECT().GetCodec().Return(expectedCdc)
+	mockCli.EXPECT().Query(types.ParamsPath, nil).Return(expectedRet, nil)
+	mintParams, err := mockCli.Mint().QueryMintParams()
+	require.NoError(t, err)
+	require.Equal(t, "okt", mintParams.MintDenom)
+	require.Equal(t, uint64(6311520), mintParams.BlocksPerYear)
+
+	mockCli.EXPECT().Query(types.ParamsPath, nil).Return(nil, errors.New("default error"))
+	_, err = mockCli.Mint().QueryMintParams()
+	require.Error(t, err)
+
+	mockCli.EXPECT().GetCodec().Return(expectedCdc)
+	mockCli.EXPECT().Query(types.ParamsPath, nil).Return(expectedRet[1:], nil)
+	_, err = mockCli.Mint().QueryMintParams()
+	require.Error(t, err)
+}
+
+func TestMintClient_QueryInflation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	config, err := sdk.NewClientConfig("testURL", "testChain", sdk.BroadcastBlock, "", 200000,
+		1.1, "0.00000001okt")
+	require.NoError(t, err)
+	mockCli := mocks.NewMockClient(t, ctrl, config)
+	mockCli.RegisterModule(NewMintClient(mockCli.MockBaseClient))
+
+	expectedCdc := mockCli.GetCodec()
+	mockCli.EXPECT().GetCodec().Return(expectedCdc)
+	mockCli.EXPECT().Query(types.InflationPath, nil).
+		Return(expectedCdc.MustMarshalJSON(sdk.MustNewDecFromStr("0.13")), nil)
+	inflation, err := mockCli.Mint().QueryInflation()
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("0.13"), inflation)
+
+	mockCli.EXPECT().GetCodec().Return(expectedCdc)
+	mockCli.EXPECT().Query(types.AnnualProvisionsPath, nil).
+		Return(expectedCdc.MustMarshalJSON(sdk.NewDec(1000000)), nil)
+	annualProvisions, err := mockCli.Mint().QueryAnnualProvisions()
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(1000000), annualProvisions)
+
+	mockCli.EXPECT().Query(types.AnnualProvisionsPath, nil).Return(nil, errors.New("default error"))
+	_, err = mockCli.Mint().QueryAnnualProvisions()
+	require.Error(t, err)
+}
@@ -0,0 +1,25 @@
+package types
+
+import sdk "github.com/okex/okchain-go-sdk/types"
+
+// const
+const (
+	ModuleName = "mint"
+
+	ParamsPath           = "custom/mint/parameters"
+	InflationPath        = "custom/mint/inflation"
+	AnnualProvisionsPath = "custom/mint/annual_provisions"
+)
+
+// RegisterCodec registers the msg type for mint module, which has no msgs
+func RegisterCodec(sdk.SDKCodec) {}
+
+// Params - structure of the params of the mint module
+type Params struct {
+	MintDenom           string  `json:"mint_denom"`
+	InflationRateChange sdk.Dec `json:"inflation_rate_change"`
+	InflationMax        sdk.Dec `json:"inflation_max"`
+	InflationMin        sdk.Dec `json:"inflation_min"`
+	GoalBonded          sdk.Dec `json:"goal_bonded"`
+	BlocksPerYear       uint64  `json:"blocks_per_year"`
+}
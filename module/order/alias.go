@@ -10,5 +10,10 @@ const (
 type (
 	// nolint
 	BookRes     = types.BookRes
+	BookUpdate  = types.BookUpdate
+	DepthBook   = types.DepthBook
 	OrderDetail = types.OrderDetail
+	OrderItem   = types.OrderItem
+	OrderResult = types.OrderResult
+	TxOrders    = types.TxOrders
 )
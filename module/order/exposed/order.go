@@ -0,0 +1,40 @@
+package exposed
+
+import (
+	"context"
+
+	"github.com/okex/okchain-go-sdk/module/order/types"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	"github.com/okex/okchain-go-sdk/types/crypto/keys"
+)
+
+// Order shows the expected behavior for inner order client
+type Order interface {
+	sdk.Module
+	OrderTx
+	OrderQuery
+	OrderSubscription
+}
+
+// OrderTx shows the expected tx behavior for inner order client
+type OrderTx interface {
+	// Deprecated: use PlaceOrders instead
+	NewOrders(fromInfo keys.Info, passWd, products, sides, prices, quantities, memo string, accNum, seqNum uint64) (
+		sdk.TxResponse, error)
+	PlaceOrders(fromInfo keys.Info, passWd string, orderItems []types.OrderItem, memo string, accNum, seqNum uint64) (
+		[]types.OrderResult, sdk.TxResponse, error)
+	CancelOrders(fromInfo keys.Info, passWd, orderIDs, memo string, accNum, seqNum uint64) (sdk.TxResponse, error)
+}
+
+// OrderQuery shows the expected query behavior for inner order client
+type OrderQuery interface {
+	QueryDepthBook(product string) (types.BookRes, error)
+	QueryOrderDetail(orderID string) (types.OrderDetail, error)
+	QueryOrderParams() (types.Params, error)
+	QueryTxOrders(txHash string) (types.TxOrders, error)
+}
+
+// OrderSubscription shows the expected subscription behavior for inner order client
+type OrderSubscription interface {
+	SubscribeDepthBook(ctx context.Context, product string) (<-chan types.BookUpdate, error)
+}
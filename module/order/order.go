@@ -1,11 +1,17 @@
 package order
 
 import (
-	"github.com/okex/okchain-go-sdk/exposed"
+	"github.com/okex/okchain-go-sdk/module/order/exposed"
 	"github.com/okex/okchain-go-sdk/module/order/types"
 	sdk "github.com/okex/okchain-go-sdk/types"
 )
 
+func init() {
+	sdk.RegisterModule(types.ModuleName, func(baseClient sdk.BaseClient) sdk.Module {
+		return NewOrderClient(baseClient)
+	})
+}
+
 var _ sdk.Module = (*orderClient)(nil)
 
 type orderClient struct {
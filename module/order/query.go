@@ -1,15 +1,17 @@
 package order
 
 import (
+	"encoding/hex"
 	"fmt"
 	"github.com/okex/okchain-go-sdk/module/order/types"
+	sdk "github.com/okex/okchain-go-sdk/types"
 	"github.com/okex/okchain-go-sdk/types/params"
 	"github.com/okex/okchain-go-sdk/utils"
 )
 
 // QueryDepthBook gets the current depth book info of a specific product
 func (oc orderClient) QueryDepthBook(product string) (depthBook types.BookRes, err error) {
-	depthBookParams := params.NewQueryDepthBookParams(product, 200)
+	depthBookParams := params.NewQueryDepthBookParams(product, types.DepthBookSize)
 	jsonBytes, err := oc.GetCodec().MarshalJSON(depthBookParams)
 	if err != nil {
 		return depthBook, utils.ErrMarshalJSON(err.Error())
@@ -44,3 +46,53 @@ func (oc orderClient) QueryOrderDetail(orderID string) (orderDetail types.OrderD
 
 	return
 }
+
+// QueryOrderParams gets the params of the order module, e.g. the order expiry blocks and the trade fee rate
+func (oc orderClient) QueryOrderParams() (orderParams types.Params, err error) {
+	res, err := oc.Query(types.ParamsPath, nil)
+	if err != nil {
+		return orderParams, utils.ErrClientQuery(err.Error())
+	}
+
+	if err = oc.GetCodec().UnmarshalJSON(res, &orderParams); err != nil {
+		return orderParams, utils.ErrUnmarshalJSON(err.Error())
+	}
+
+	return
+}
+
+// QueryTxOrders gets the orders placed and cancelled by a committed tx with their results, and the current fills of
+// the orders placed successfully
+func (oc orderClient) QueryTxOrders(txHash string) (txOrders types.TxOrders, err error) {
+	hash, err := hex.DecodeString(txHash)
+	if err != nil {
+		return txOrders, fmt.Errorf("failed. invalid tx hash %s: %w", txHash, err)
+	}
+
+	resTx, err := oc.Tx(hash, false)
+	if err != nil {
+		return txOrders, utils.ErrClientQuery(err.Error())
+	}
+	stdTx, err := utils.DecodeStdTx(oc.GetCodec(), resTx.Tx)
+	if err != nil {
+		return
+	}
+
+	txOrders, err = types.ParseTxOrders(stdTx, resTx.TxResult.Code, sdk.StringifyEvents(resTx.TxResult.Events))
+	if err != nil {
+		return
+	}
+	txOrders.TxHash, txOrders.Height = resTx.Hash.String(), resTx.Height
+
+	for _, placed := range txOrders.Placed {
+		if !placed.Result.IsSuccess() {
+			continue
+		}
+		orderDetail, err := oc.QueryOrderDetail(placed.Result.OrderID)
+		if err != nil {
+			return txOrders, fmt.Errorf("failed. query order %s error: %w", placed.Result.OrderID, err)
+		}
+		txOrders.Fills = append(txOrders.Fills, types.NewOrderFill(orderDetail))
+	}
+	return
+}
@@ -10,7 +10,9 @@ import (
 	sdk "github.com/okex/okchain-go-sdk/types"
 	"github.com/okex/okchain-go-sdk/types/params"
 	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
 	cmn "github.com/tendermint/tendermint/libs/common"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
 
 	"testing"
 )
@@ -126,3 +128,102 @@ func TestOrderClient_QueryDepthBook(t *testing.T) {
 	_, err = mockCli.Order().QueryDepthBook(product)
 	require.Error(t, err)
 }
+
+func TestOrderClient_QueryOrderParams(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	config, err := sdk.NewClientConfig("testURL", "testChain", sdk.BroadcastBlock, "", 200000,
+		1.1, "0.00000001okt")
+	require.NoError(t, err)
+	mockCli := mocks.NewMockClient(t, ctrl, config)
+	mockCli.RegisterModule(NewOrderClient(mockCli.MockBaseClient))
+
+	expectedCdc := mockCli.GetCodec()
+	expectedRet := expectedCdc.MustMarshalJSON(types.Params{
+		OrderExpireBlocks: 259200,
+		MaxDealsPerBlock:  1000,
+		FeePerBlock:       sdk.NewDecCoinFromDec("okt", sdk.MustNewDecFromStr("0.000001")),
+		TradeFeeRate:      sdk.MustNewDecFromStr("0.001"),
+	})
+
+	mockCli.EXPECT().GetCodec().Return(expectedCdc)
+	mockCli.EXPECT().Query(types.ParamsPath, nil).Return(expectedRet, nil)
+	orderParams, err := mockCli.Order().QueryOrderParams()
+	require.NoError(t, err)
+	require.Equal(t, int64(259200), orderParams.OrderExpireBlocks)
+	require.Equal(t, "0.00100000", orderParams.TradeFeeRate.String())
+
+	mockCli.EXPECT().Query(types.ParamsPath, nil).Return(nil, errors.New("default error"))
+	_, err = mockCli.Order().QueryOrderParams()
+	require.Error(t, err)
+
+	mockCli.EXPECT().GetCodec().Return(expectedCdc)
+	mockCli.EXPECT().Query(types.ParamsPath, nil).Return(expectedRet[1:], nil)
+	_, err = mockCli.Order().QueryOrderParams()
+	require.Error(t, err)
+}
+
+func TestOrderClient_QueryTxOrders(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	config, err := sdk.NewClientConfig("testURL", "testChain", sdk.BroadcastBlock, "", 200000,
+		1.1, "0.00000001okt")
+	require.NoError(t, err)
+	mockCli := mocks.NewMockClient(t, ctrl, config)
+	mockCli.RegisterModule(NewOrderClient(mockCli.MockBaseClient))
+
+	sender, err := sdk.AccAddressFromBech32(addr)
+	require.NoError(t, err)
+	expectedCdc := mockCli.GetCodec()
+	mockCli.EXPECT().GetCodec().Return(expectedCdc).AnyTimes()
+
+	// the cancellation after the placement in a tx
+	orderItems := []types.OrderItem{
+		types.NewOrderItem(product, "BUY", "1.024", "10.24"),
+		types.NewOrderItem(product, "SELL", "2.048", "20.48"),
+	}
+	stdTx := sdk.NewStdTx([]sdk.Msg{
+		types.NewMsgNewOrders(sender, orderItems),
+		types.NewMsgCancelOrders(sender, []string{"ID0000000001-1"}),
+	}, sdk.NewStdFee(200000, nil), nil, memo)
+	txBytes, err := expectedCdc.MarshalBinaryLengthPrefixed(stdTx)
+	require.NoError(t, err)
+	resTx := &ctypes.ResultTx{
+		Hash:   cmn.HexBytes{0xAB, 0xCD},
+		Height: 1024,
+		Tx:     txBytes,
+		TxResult: abci.ResponseDeliverTx{Events: []abci.Event{
+			{Type: "message", Attributes: []cmn.KVPair{{Key: []byte("orders"), Value: []byte(
+				`[{"code":0,"msg":"","orderid":"ID0000001024-1"},{"code":62014,"msg":"insufficient coins"}]`)}}},
+			{Type: "message", Attributes: []cmn.KVPair{{Key: []byte("orders"), Value: []byte(
+				`[{"code":0,"msg":"","orderid":"ID0000000001-1"}]`)}}},
+		}},
+	}
+	mockCli.EXPECT().Tx([]byte{0xAB, 0xCD}, false).Return(resTx, nil).Times(2)
+
+	orderID := "ID0000001024-1"
+	detailBytes := mockCli.BuildOrderDetailBytes("ABCD", orderID, "", product, "BUY", 0, 10240000, 1024, sender,
+		sdk.MustNewDecFromStr("1.024"), sdk.MustNewDecFromStr("10.24"), sdk.MustNewDecFromStr("1.024"),
+		sdk.MustNewDecFromStr("4.24"), sdk.MustNewDecFromStr("4.34176"), sdk.NewDecCoinFromDec("okt", sdk.ZeroDec()))
+	mockCli.EXPECT().Query(fmt.Sprintf("%s/%s", types.OrderDetailPath, orderID), nil).Return(detailBytes, nil)
+
+	txOrders, err := mockCli.Order().QueryTxOrders("ABCD")
+	require.NoError(t, err)
+	require.Equal(t, "ABCD", txOrders.TxHash)
+	require.Equal(t, int64(1024), txOrders.Height)
+	require.Len(t, txOrders.Placed, 2)
+	require.Equal(t, orderItems[1], txOrders.Placed[1].Item)
+	require.Equal(t, orderID, txOrders.Placed[0].Result.OrderID)
+	require.False(t, txOrders.Placed[1].Result.IsSuccess())
+	require.Equal(t, []types.CancelledOrder{{Sender: sender, OrderID: "ID0000000001-1",
+		Result: types.OrderResult{OrderID: "ID0000000001-1"}}}, txOrders.Cancelled)
+	require.Len(t, txOrders.Fills, 1)
+	require.Equal(t, sdk.MustNewDecFromStr("6"), txOrders.Fills[0].FilledQuantity)
+
+	// the order results missing
+	resTx.TxResult.Events = resTx.TxResult.Events[:1]
+	_, err = mockCli.Order().QueryTxOrders("ABCD")
+	require.Error(t, err)
+	_, err = mockCli.Order().QueryTxOrders("not hex")
+	require.Error(t, err)
+}
@@ -0,0 +1,185 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/okex/okchain-go-sdk/module/order/types"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+const (
+	newBlockQuery       = "tm.event='NewBlock'"
+	bookUpdatesCapacity = 64
+	// attributeKeyModule is the attribute of the module emitting an event
+	attributeKeyModule = "module"
+)
+
+// SubscribeDepthBook subscribes the depth book of a product, which is maintained in memory by the order events of
+// each new block, i.e. the orders placed on the product and cancelled in its txs and the matching and the expiry of
+// the orders in its begin and end block. The depth book is only queried at the height of a block changing it, so the
+// book of each update is the state of its block.
+// The first update on the channel is a snapshot of the whole book, followed by an incremental update per block with
+// the changed price levels, empty if the block changes nothing, so that each update applies to the book of the one
+// before. Another snapshot is sent to resync once a block is missed or its book fails to be queried
+// The channel is closed when the ctx is done
+func (oc orderClient) SubscribeDepthBook(ctx context.Context, product string) (<-chan types.BookUpdate, error) {
+	if len(product) == 0 {
+		return nil, errors.New("failed. empty product")
+	}
+
+	subscriber := fmt.Sprintf("gosdk-depthbook-%s-%d", product, time.Now().UnixNano())
+	events, err := oc.Subscribe(ctx, subscriber, newBlockQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	updates := make(chan types.BookUpdate, bookUpdatesCapacity)
+	go newBookMaintainer(oc, product).run(ctx, events, updates)
+	return updates, nil
+}
+
+// bookMaintainer maintains the depth book by the order events of the new blocks and generates the book updates
+type bookMaintainer struct {
+	oc     orderClient
+	book   *types.DepthBook
+	synced bool
+}
+
+func newBookMaintainer(oc orderClient, product string) *bookMaintainer {
+	return &bookMaintainer{
+		oc:   oc,
+		book: types.NewDepthBook(product),
+	}
+}
+
+func (bm *bookMaintainer) run(ctx context.Context, events <-chan ctypes.ResultEvent, updates chan<- types.BookUpdate) {
+	defer close(updates)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+
+			block, ok := event.Data.(tmtypes.EventDataNewBlock)
+			if !ok || block.Block == nil {
+				continue
+			}
+
+			update, ok := bm.onNewBlock(block)
+			if !ok {
+				continue
+			}
+
+			select {
+			case updates <- update:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
+
+func (bm *bookMaintainer) onNewBlock(block tmtypes.EventDataNewBlock) (update types.BookUpdate, ok bool) {
+	height := block.Block.Height
+	// the changes of a block missed are unknown
+	if !bm.synced || height != bm.book.Height+1 {
+		return bm.resync(height)
+	}
+
+	update = bm.book.Unchanged(height)
+	if bm.isChangedBy(block) {
+		bookRes, err := bm.queryDepthBook(height)
+		if err != nil {
+			// resync on the next block
+			bm.synced = false
+			return
+		}
+		update = bm.book.Diff(bookRes, height)
+	}
+
+	if err := bm.book.Apply(update); err != nil {
+		return bm.resync(height)
+	}
+	return update, true
+}
+
+// resync replaces the book by the snapshot queried at the height
+func (bm *bookMaintainer) resync(height int64) (update types.BookUpdate, ok bool) {
+	bm.synced = false
+	bookRes, err := bm.queryDepthBook(height)
+	if err != nil {
+		return
+	}
+
+	book := types.NewDepthBook(bm.book.Product)
+	if err = book.Apply(types.NewBookSnapshot(book.Product, height, bookRes)); err != nil {
+		return
+	}
+	bm.book, bm.synced = book, true
+	return book.Snapshot(), true
+}
+
+// queryDepthBook queries the depth book at the height, i.e. the state committed by the block of the event
+func (bm *bookMaintainer) queryDepthBook(height int64) (types.BookRes, error) {
+	baseClient, err := bm.oc.WithConsistency(sdk.AtHeight(height))
+	if err != nil {
+		return types.BookRes{}, err
+	}
+	return orderClient{baseClient}.QueryDepthBook(bm.book.Product)
+}
+
+// isChangedBy tells whether the block might change the depth book, by an order placed on the product or any order
+// cancelled in its txs, whose order IDs carry no product, or by any event of the order module in its begin and end
+// block, e.g. the matching and the expiry of the orders. The txs undecodable are taken as changing the book
+func (bm *bookMaintainer) isChangedBy(block tmtypes.EventDataNewBlock) bool {
+	for _, tx := range block.Block.Txs {
+		var stdTx sdk.StdTx
+		if err := bm.oc.GetCodec().UnmarshalBinaryLengthPrefixed(tx, &stdTx); err != nil {
+			return true
+		}
+		for _, msg := range stdTx.Msgs {
+			switch msg := msg.(type) {
+			case types.MsgNewOrders:
+				for _, item := range msg.OrderItems {
+					if item.Product == bm.book.Product {
+						return true
+					}
+				}
+			case types.MsgCancelOrders:
+				return true
+			}
+		}
+	}
+
+	for _, events := range [][]abci.Event{block.ResultBeginBlock.Events, block.ResultEndBlock.Events} {
+		for _, event := range events {
+			if isOrderEvent(event) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isOrderEvent tells whether the event is emitted by the order module, by its type or its module attribute
+func isOrderEvent(event abci.Event) bool {
+	if strings.HasPrefix(event.Type, types.ModuleName) {
+		return true
+	}
+	for _, attribute := range event.Attributes {
+		if string(attribute.Key) == attributeKeyModule && string(attribute.Value) == types.ModuleName {
+			return true
+		}
+	}
+	return false
+}
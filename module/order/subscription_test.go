@@ -0,0 +1,194 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/okex/okchain-go-sdk/mocks"
+	"github.com/okex/okchain-go-sdk/module/order/types"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+func newBlockEvent(t *testing.T, cdc sdk.SDKCodec, height int64, endBlockEvents []abci.Event,
+	msgs ...sdk.Msg) ctypes.ResultEvent {
+	var txs tmtypes.Txs
+	for _, msg := range msgs {
+		tx, err := cdc.MarshalBinaryLengthPrefixed(sdk.NewStdTx([]sdk.Msg{msg}, sdk.StdFee{}, nil, ""))
+		require.NoError(t, err)
+		txs = append(txs, tx)
+	}
+
+	return ctypes.ResultEvent{
+		Query: newBlockQuery,
+		Data: tmtypes.EventDataNewBlock{
+			Block:          &tmtypes.Block{Header: tmtypes.Header{Height: height}, Data: tmtypes.Data{Txs: txs}},
+			ResultEndBlock: abci.ResponseEndBlock{Events: endBlockEvents},
+		},
+	}
+}
+
+func TestOrderClient_SubscribeDepthBook(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	config, err := sdk.NewClientConfig("testURL", "testChain", sdk.BroadcastBlock, "", 200000,
+		1.1, "0.00000001okt")
+	require.NoError(t, err)
+	mockCli := mocks.NewMockClient(t, ctrl, config)
+	mockCli.RegisterModule(NewOrderClient(mockCli.MockBaseClient))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events := make(chan ctypes.ResultEvent)
+	mockCli.EXPECT().Subscribe(ctx, gomock.Any(), newBlockQuery).Return((<-chan ctypes.ResultEvent)(events), nil)
+	mockCli.EXPECT().GetCodec().Return(mockCli.GetCodec()).AnyTimes()
+	// the book is only queried at the heights of the blocks changing it
+	for _, height := range []int64{1, 4, 5, 6, 8} {
+		mockCli.EXPECT().WithConsistency(sdk.AtHeight(height)).Return(mockCli.MockBaseClient, nil)
+	}
+	gomock.InOrder(
+		mockCli.EXPECT().Query(types.DepthbookPath, gomock.Any()).
+			Return(mockCli.BuildBookResBytes("10.24", "1", "1.024", "2"), nil),
+		mockCli.EXPECT().Query(types.DepthbookPath, gomock.Any()).
+			Return(mockCli.BuildBookResBytes("10.24", "3", "1.000", "2"), nil),
+		// query failed and resync on the next block
+		mockCli.EXPECT().Query(types.DepthbookPath, gomock.Any()).Return(nil, errors.New("default error")),
+		mockCli.EXPECT().Query(types.DepthbookPath, gomock.Any()).
+			Return(mockCli.BuildBookResBytes("10.24", "3", "1.000", "4"), nil),
+		mockCli.EXPECT().Query(types.DepthbookPath, gomock.Any()).
+			Return(mockCli.BuildBookResBytes("10.24", "3", "1.000", "5"), nil),
+	)
+
+	updates, err := mockCli.Order().SubscribeDepthBook(ctx, product)
+	require.NoError(t, err)
+
+	cdc := mockCli.GetCodec()
+	sender, err := sdk.AccAddressFromBech32(addr)
+	require.NoError(t, err)
+	matchEvents := []abci.Event{{Type: "match", Attributes: []cmn.KVPair{{Key: []byte("module"), Value: []byte("order")}}}}
+	blocks := []ctypes.ResultEvent{
+		newBlockEvent(t, cdc, 1, nil),
+		// no order tx or event
+		newBlockEvent(t, cdc, 2, nil),
+		// order placed on another product
+		newBlockEvent(t, cdc, 3, nil, types.NewMsgNewOrders(sender, []types.OrderItem{{Product: "eth-000_okt"}})),
+		newBlockEvent(t, cdc, 4, nil, types.NewMsgNewOrders(sender, []types.OrderItem{{Product: product}})),
+		newBlockEvent(t, cdc, 5, matchEvents),
+		newBlockEvent(t, cdc, 6, nil),
+		// block 7 missed
+		newBlockEvent(t, cdc, 8, nil, types.NewMsgCancelOrders(sender, []string{"ID0000000001-1"})),
+	}
+	go func() {
+		for _, block := range blocks {
+			events <- block
+		}
+	}()
+
+	book := types.NewDepthBook(product)
+	// snapshot at the first block
+	update := <-updates
+	require.True(t, update.IsSnapshot)
+	require.Equal(t, int64(1), update.Height)
+	require.NoError(t, book.Apply(update))
+	require.Equal(t, types.BookRes{
+		Asks: []types.BookResItem{{Price: "10.24", Quantity: "1"}},
+		Bids: []types.BookResItem{{Price: "1.024", Quantity: "2"}},
+	}, book.BookRes())
+
+	// empty updates of the blocks 2 and 3 without changing the book
+	for _, height := range []int64{2, 3} {
+		update = <-updates
+		require.True(t, update.IsEmpty())
+		require.Equal(t, height, update.Height)
+		require.Equal(t, height-1, update.PrevHeight)
+		require.NoError(t, book.Apply(update))
+	}
+
+	// incremental update at block 4
+	update = <-updates
+	require.False(t, update.IsSnapshot)
+	require.Equal(t, int64(4), update.Height)
+	require.Equal(t, int64(3), update.PrevHeight)
+	require.Equal(t, []types.BookResItem{{Price: "10.24", Quantity: "3"}}, update.Asks)
+	require.Equal(t, []types.BookResItem{{Price: "1.000", Quantity: "2"}, {Price: "1.024", Quantity: "0"}},
+		update.Bids)
+	require.NoError(t, book.Apply(update))
+	require.Equal(t, []types.BookResItem{{Price: "1.000", Quantity: "2"}}, book.BookRes().Bids)
+
+	// resync at block 6 after the failed query at block 5
+	update = <-updates
+	require.True(t, update.IsSnapshot)
+	require.Equal(t, int64(6), update.Height)
+	require.NoError(t, book.Apply(update))
+
+	// resync at block 8 after block 7 missed
+	update = <-updates
+	require.True(t, update.IsSnapshot)
+	require.Equal(t, int64(8), update.Height)
+	require.NoError(t, book.Apply(update))
+	require.Equal(t, []types.BookResItem{{Price: "1.000", Quantity: "5"}}, book.BookRes().Bids)
+
+	// closed with the ctx
+	cancel()
+	_, ok := <-updates
+	require.False(t, ok)
+
+	_, err = mockCli.Order().SubscribeDepthBook(ctx, "")
+	require.Error(t, err)
+}
+
+func TestDepthBook_Apply(t *testing.T) {
+	book := types.NewDepthBook(product)
+	require.NoError(t, book.Apply(types.BookUpdate{
+		Product:    product,
+		Height:     10,
+		IsSnapshot: true,
+		Asks:       []types.BookResItem{{Price: "2", Quantity: "1"}, {Price: "10", Quantity: "1"}},
+		Bids:       []types.BookResItem{{Price: "1", Quantity: "1"}, {Price: "0.5", Quantity: "1"}},
+	}))
+	// asks ascending and bids descending
+	require.Equal(t, types.BookRes{
+		Asks: []types.BookResItem{{Price: "2", Quantity: "1"}, {Price: "10", Quantity: "1"}},
+		Bids: []types.BookResItem{{Price: "1", Quantity: "1"}, {Price: "0.5", Quantity: "1"}},
+	}, book.BookRes())
+
+	// out of sequence
+	require.Error(t, book.Apply(types.BookUpdate{Product: product, Height: 12, PrevHeight: 11}))
+	// another product
+	require.Error(t, book.Apply(types.BookUpdate{Product: "eth-000_okt", Height: 11, PrevHeight: 10}))
+
+	update := book.Diff(types.BookRes{Asks: []types.BookResItem{{Price: "10", Quantity: "1"}}}, 11)
+	require.Equal(t, int64(10), update.PrevHeight)
+	require.NoError(t, book.Apply(update))
+	require.Equal(t, int64(11), book.Height)
+	require.Equal(t, types.BookRes{Asks: []types.BookResItem{{Price: "10", Quantity: "1"}}, Bids: []types.BookResItem{}},
+		book.BookRes())
+	require.True(t, book.Diff(book.BookRes(), 12).IsEmpty())
+	require.Equal(t, book.BookRes().Asks, book.Snapshot().Asks)
+
+	// the asks truncated by the depth book size
+	asks := make([]types.BookResItem, types.DepthBookSize)
+	for i := range asks {
+		asks[i] = types.BookResItem{Price: fmt.Sprintf("%d", 10+i), Quantity: "1"}
+	}
+	snapshot := types.NewBookSnapshot(product, 12, types.BookRes{Asks: asks})
+	require.Equal(t, fmt.Sprintf("%d", 10+types.DepthBookSize-1), snapshot.AskBound)
+	require.Empty(t, snapshot.BidBound)
+	require.NoError(t, book.Apply(snapshot))
+
+	// a better ask pushes the worst one out of the book, which is not reported as removed
+	update = book.Diff(types.BookRes{Asks: append([]types.BookResItem{{Price: "9", Quantity: "1"}},
+		asks[:types.DepthBookSize-1]...)}, 13)
+	require.Equal(t, []types.BookResItem{{Price: "9", Quantity: "1"}}, update.Asks)
+	require.NoError(t, book.Apply(update))
+	require.Len(t, book.BookRes().Asks, types.DepthBookSize)
+	require.Equal(t, "9", book.BookRes().Asks[0].Price)
+	require.Equal(t, asks[types.DepthBookSize-2], book.BookRes().Asks[types.DepthBookSize-1])
+}
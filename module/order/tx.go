@@ -2,6 +2,7 @@ package order
 
 import (
 	"errors"
+	"fmt"
 	"github.com/okex/okchain-go-sdk/module/order/types"
 	sdk "github.com/okex/okchain-go-sdk/types"
 	"github.com/okex/okchain-go-sdk/types/crypto/keys"
@@ -9,7 +10,10 @@ import (
 	"strings"
 )
 
-// NewOrders places orders with some detail info
+// NewOrders places orders with some detail info, the products, sides, prices and quantities of which are joined by ","
+//
+// Deprecated: use PlaceOrders with the typed order items instead, whose prices and quantities are checked at compile
+// time rather than parsed from the strings
 func (oc orderClient) NewOrders(fromInfo keys.Info, passWd, products, sides, prices, quantities, memo string, accNum,
 	seqNum uint64) (resp sdk.TxResponse, err error) {
 	if len(products) == 0 || len(sides) == 0 || len(prices) == 0 || len(quantities) == 0 {
@@ -31,6 +35,38 @@ func (oc orderClient) NewOrders(fromInfo keys.Info, passWd, products, sides, pri
 
 }
 
+// PlaceOrders places the order items in one tx, up to types.MaxOrderItemsPerMsg, and returns the results of the orders
+// in the order of the items, with their order IDs. The results are only available in the block broadcast mode
+func (oc orderClient) PlaceOrders(fromInfo keys.Info, passWd string, orderItems []types.OrderItem, memo string, accNum,
+	seqNum uint64) (orderResults []types.OrderResult, resp sdk.TxResponse, err error) {
+	if err = params.CheckKeyParams(fromInfo, passWd); err != nil {
+		return
+	}
+	if len(orderItems) == 0 || len(orderItems) > types.MaxOrderItemsPerMsg {
+		return orderResults, resp, fmt.Errorf("failed. %d order items out of the range [1, %d]", len(orderItems),
+			types.MaxOrderItemsPerMsg)
+	}
+	for i, item := range orderItems {
+		if item.Side != "BUY" && item.Side != "SELL" {
+			return orderResults, resp, fmt.Errorf(`failed. side of order item %d must only be "BUY" or "SELL"`, i)
+		}
+	}
+
+	msg := types.NewMsgNewOrders(fromInfo.GetAddress(), orderItems)
+	if resp, err = oc.BuildAndBroadcast(fromInfo.GetName(), passWd, memo, []sdk.Msg{msg}, accNum, seqNum); err != nil {
+		return
+	}
+
+	if orderResults, err = types.ParseOrderResults(resp); err != nil {
+		return
+	}
+	if len(orderResults) != 0 && len(orderResults) != len(orderItems) {
+		return orderResults, resp, fmt.Errorf("failed. %d order results for %d order items", len(orderResults),
+			len(orderItems))
+	}
+	return
+}
+
 // CancelOrders cancels orders by orderIDs
 func (oc orderClient) CancelOrders(fromInfo keys.Info, passWd, orderIDs, memo string, accNum, seqNum uint64) (
 	resp sdk.TxResponse, err error) {
@@ -5,6 +5,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/okex/okchain-go-sdk/mocks"
 	"github.com/okex/okchain-go-sdk/module/auth"
+	"github.com/okex/okchain-go-sdk/module/order/types"
 	sdk "github.com/okex/okchain-go-sdk/types"
 	"github.com/okex/okchain-go-sdk/utils"
 	"github.com/stretchr/testify/require"
@@ -62,6 +63,61 @@ func TestOrderClient_NewOrders(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestOrderClient_PlaceOrders(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	config, err := sdk.NewClientConfig("testURL", "testChain", sdk.BroadcastBlock, "", 200000,
+		1.1, "0.00000001okt")
+	require.NoError(t, err)
+	mockCli := mocks.NewMockClient(t, ctrl, config)
+	mockCli.RegisterModule(NewOrderClient(mockCli.MockBaseClient))
+
+	fromInfo, _, err := utils.CreateAccountWithMnemo(mnemonic, name, passWd)
+	require.NoError(t, err)
+
+	orderItems := []types.OrderItem{
+		types.NewOrderItem(product, "BUY", "1.024", "10.24"),
+		types.NewOrderItem(product, "SELL", "2.048", "20.48"),
+	}
+	txResp := mocks.DefaultMockSuccessTxResponse()
+	txResp.Logs = sdk.ABCIMessageLogs{{Success: true, Events: sdk.StringEvents{{
+		Type: "message",
+		Attributes: []sdk.Attribute{
+			{Key: "module", Value: "order"},
+			{Key: "orders", Value: `[{"code":0,"msg":"","orderid":"ID0000000010-1"},` +
+				`{"code":62014,"msg":"insufficient coins","orderid":""}]`},
+		},
+	}}}}
+	mockCli.EXPECT().BuildAndBroadcast(fromInfo.GetName(), passWd, memo, gomock.AssignableToTypeOf([]sdk.Msg{}),
+		uint64(1), uint64(2)).Return(txResp, nil)
+
+	orderResults, resp, err := mockCli.Order().PlaceOrders(fromInfo, passWd, orderItems, memo, 1, 2)
+	require.NoError(t, err)
+	require.Equal(t, txResp.TxHash, resp.TxHash)
+	require.Len(t, orderResults, 2)
+	require.True(t, orderResults[0].IsSuccess())
+	require.Equal(t, "ID0000000010-1", orderResults[0].OrderID)
+	require.False(t, orderResults[1].IsSuccess())
+	require.Equal(t, "insufficient coins", orderResults[1].Message)
+
+	// the results mismatched with the items
+	mockCli.EXPECT().BuildAndBroadcast(fromInfo.GetName(), passWd, memo, gomock.AssignableToTypeOf([]sdk.Msg{}),
+		uint64(1), uint64(2)).Return(txResp, nil)
+	_, _, err = mockCli.Order().PlaceOrders(fromInfo, passWd, orderItems[:1], memo, 1, 2)
+	require.Error(t, err)
+
+	_, _, err = mockCli.Order().PlaceOrders(fromInfo, passWd, nil, memo, 1, 2)
+	require.Error(t, err)
+	_, _, err = mockCli.Order().PlaceOrders(fromInfo, passWd, make([]types.OrderItem, types.MaxOrderItemsPerMsg+1),
+		memo, 1, 2)
+	require.Error(t, err)
+	_, _, err = mockCli.Order().PlaceOrders(fromInfo, passWd, []types.OrderItem{
+		types.NewOrderItem(product, "BUY_", "1.024", "10.24")}, memo, 1, 2)
+	require.Error(t, err)
+	_, _, err = mockCli.Order().PlaceOrders(fromInfo, "", orderItems, memo, 1, 2)
+	require.Error(t, err)
+}
+
 func TestOrderClient_CancelOrders(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -0,0 +1,251 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/okex/okchain-go-sdk/types"
+)
+
+const (
+	// RemovedQuantity is the quantity of a price level removed from the depth book in an incremental update
+	RemovedQuantity = "0"
+	// DepthBookSize is the number of the price levels of each side in the depth book queried, beyond which the side is
+	// truncated
+	DepthBookSize = 200
+)
+
+// BookUpdate - structure of the update of the depth book of a product by the block at Height
+// It's a snapshot of the whole book if IsSnapshot is true. Otherwise it only contains the changed price levels, in
+// which the level with RemovedQuantity is removed, and applies to the book at PrevHeight, the block before. It's empty
+// if the block changes nothing
+type BookUpdate struct {
+	Product    string
+	Height     int64
+	PrevHeight int64
+	IsSnapshot bool
+	Asks       []BookResItem
+	Bids       []BookResItem
+	// AskBound and BidBound are the worst prices of the asks and the bids in the book if the sides are truncated by
+	// DepthBookSize, empty if the whole sides are in the book. The levels beyond the bounds are out of the book, which
+	// are dropped by Apply instead of being reported as removed
+	AskBound string
+	BidBound string
+}
+
+// DepthBook - structure of an in-memory depth book maintained by the sequence-checked book updates
+type DepthBook struct {
+	Product  string
+	Height   int64
+	asks     map[string]string
+	bids     map[string]string
+	askBound string
+	bidBound string
+}
+
+// NewBookSnapshot creates the snapshot update of the depth book queried at the height
+func NewBookSnapshot(product string, height int64, book BookRes) BookUpdate {
+	return BookUpdate{
+		Product:    product,
+		Height:     height,
+		PrevHeight: height,
+		IsSnapshot: true,
+		Asks:       book.Asks,
+		Bids:       book.Bids,
+		AskBound:   truncationBound(book.Asks, true),
+		BidBound:   truncationBound(book.Bids, false),
+	}
+}
+
+// NewDepthBook creates a new instance of DepthBook without any price level
+func NewDepthBook(product string) *DepthBook {
+	return &DepthBook{
+		Product: product,
+		asks:    make(map[string]string),
+		bids:    make(map[string]string),
+	}
+}
+
+// Apply applies the book update to the depth book, and drops the levels beyond the bounds of the update
+// An error is returned if the incremental update is out of sequence, e.g. after a block missed, when the book needs a
+// resync by a snapshot
+func (db *DepthBook) Apply(update BookUpdate) error {
+	if update.Product != db.Product {
+		return fmt.Errorf("failed. the update of %s applies to the depth book of %s", update.Product, db.Product)
+	}
+
+	if update.IsSnapshot {
+		db.asks, db.bids = make(map[string]string), make(map[string]string)
+	} else if update.PrevHeight != db.Height {
+		return fmt.Errorf("failed. the update applies to the depth book at height %d, but it's at %d. resync required",
+			update.PrevHeight, db.Height)
+	}
+
+	applyLevels(db.asks, update.Asks)
+	applyLevels(db.bids, update.Bids)
+	trimLevels(db.asks, update.AskBound, true)
+	trimLevels(db.bids, update.BidBound, false)
+	db.Height, db.askBound, db.bidBound = update.Height, update.AskBound, update.BidBound
+	return nil
+}
+
+// Diff returns the incremental update of the block at the height from the depth book at the block before to the new
+// book queried at the height. The levels out of the new book truncated by DepthBookSize are left to the bounds of the
+// update instead of being reported as removed
+func (db *DepthBook) Diff(book BookRes, height int64) BookUpdate {
+	askBound, bidBound := truncationBound(book.Asks, true), truncationBound(book.Bids, false)
+	return BookUpdate{
+		Product:    db.Product,
+		Height:     height,
+		PrevHeight: height - 1,
+		Asks:       diffLevels(db.asks, book.Asks, askBound, true),
+		Bids:       diffLevels(db.bids, book.Bids, bidBound, false),
+		AskBound:   askBound,
+		BidBound:   bidBound,
+	}
+}
+
+// Unchanged returns the empty incremental update of the block at the height, which changes nothing in the depth book
+func (db *DepthBook) Unchanged(height int64) BookUpdate {
+	return BookUpdate{
+		Product:    db.Product,
+		Height:     height,
+		PrevHeight: height - 1,
+		AskBound:   db.askBound,
+		BidBound:   db.bidBound,
+	}
+}
+
+// Snapshot returns the snapshot update of the whole depth book
+func (db *DepthBook) Snapshot() BookUpdate {
+	book := db.BookRes()
+	return BookUpdate{
+		Product:    db.Product,
+		Height:     db.Height,
+		PrevHeight: db.Height,
+		IsSnapshot: true,
+		Asks:       book.Asks,
+		Bids:       book.Bids,
+		AskBound:   db.askBound,
+		BidBound:   db.bidBound,
+	}
+}
+
+// BookRes returns the depth book with the asks in ascending order and the bids in descending order of the price
+func (db *DepthBook) BookRes() BookRes {
+	asks, bids := sortedLevels(db.asks), sortedLevels(db.bids)
+	// reverse the bids to the descending order
+	for i, j := 0, len(bids)-1; i < j; i, j = i+1, j-1 {
+		bids[i], bids[j] = bids[j], bids[i]
+	}
+
+	return BookRes{
+		Asks: asks,
+		Bids: bids,
+	}
+}
+
+// IsEmpty tells whether there's no price level changed in the incremental update
+func (bu BookUpdate) IsEmpty() bool {
+	return !bu.IsSnapshot && len(bu.Asks) == 0 && len(bu.Bids) == 0
+}
+
+func applyLevels(levels map[string]string, items []BookResItem) {
+	for _, item := range items {
+		if isRemovedQuantity(item.Quantity) {
+			delete(levels, item.Price)
+			continue
+		}
+		levels[item.Price] = item.Quantity
+	}
+}
+
+func diffLevels(levels map[string]string, items []BookResItem, bound string, isAsk bool) (changes []BookResItem) {
+	newLevels := make(map[string]string, len(items))
+	for _, item := range items {
+		newLevels[item.Price] = item.Quantity
+		if quantity, ok := levels[item.Price]; !ok || quantity != item.Quantity {
+			changes = append(changes, item)
+		}
+	}
+
+	for price := range levels {
+		if _, ok := newLevels[price]; !ok && !isBeyondBound(price, bound, isAsk) {
+			changes = append(changes, BookResItem{Price: price, Quantity: RemovedQuantity})
+		}
+	}
+
+	sortItems(changes)
+	return
+}
+
+// trimLevels drops the levels beyond the bound, which are out of the book truncated
+func trimLevels(levels map[string]string, bound string, isAsk bool) {
+	for price := range levels {
+		if isBeyondBound(price, bound, isAsk) {
+			delete(levels, price)
+		}
+	}
+}
+
+// truncationBound returns the worst price of the side truncated by DepthBookSize, i.e. the highest ask or the lowest
+// bid, or empty if the whole side is in the book
+func truncationBound(items []BookResItem, isAsk bool) (bound string) {
+	if len(items) < DepthBookSize {
+		return
+	}
+
+	for _, item := range items {
+		if len(bound) == 0 || isBeyondBound(item.Price, bound, isAsk) {
+			bound = item.Price
+		}
+	}
+	return
+}
+
+// isBeyondBound tells whether the price is worse than the bound, i.e. a higher ask or a lower bid. Nothing is beyond
+// an empty bound
+func isBeyondBound(price, bound string, isAsk bool) bool {
+	if len(bound) == 0 {
+		return false
+	}
+
+	priceDec, err := sdk.NewDecFromStr(price)
+	if err != nil {
+		return false
+	}
+	boundDec, err := sdk.NewDecFromStr(bound)
+	if err != nil {
+		return false
+	}
+	if isAsk {
+		return priceDec.GT(boundDec)
+	}
+	return priceDec.LT(boundDec)
+}
+
+func sortedLevels(levels map[string]string) []BookResItem {
+	items := make([]BookResItem, 0, len(levels))
+	for price, quantity := range levels {
+		items = append(items, BookResItem{Price: price, Quantity: quantity})
+	}
+	sortItems(items)
+	return items
+}
+
+// sortItems sorts the items in ascending order of the price
+func sortItems(items []BookResItem) {
+	sort.Slice(items, func(i, j int) bool {
+		priceI, errI := sdk.NewDecFromStr(items[i].Price)
+		priceJ, errJ := sdk.NewDecFromStr(items[j].Price)
+		if errI != nil || errJ != nil {
+			return items[i].Price < items[j].Price
+		}
+		return priceI.LT(priceJ)
+	})
+}
+
+func isRemovedQuantity(quantity string) bool {
+	dec, err := sdk.NewDecFromStr(quantity)
+	return err == nil && dec.IsZero()
+}
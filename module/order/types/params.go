@@ -0,0 +1,16 @@
+package types
+
+import (
+	sdk "github.com/okex/okchain-go-sdk/types"
+)
+
+// ParamsPath is the query path of the order params
+const ParamsPath = "custom/order/params"
+
+// Params - structure of the params of the order module
+type Params struct {
+	OrderExpireBlocks int64       `json:"order_expire_blocks"`
+	MaxDealsPerBlock  int64       `json:"max_deals_per_block"`
+	FeePerBlock       sdk.DecCoin `json:"fee_per_block"`
+	TradeFeeRate      sdk.Dec     `json:"trade_fee_rate"`
+}
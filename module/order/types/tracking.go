@@ -0,0 +1,107 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/okex/okchain-go-sdk/types"
+)
+
+// TxOrders - structure of the orders placed and cancelled by a committed tx, which reconciles an order management
+// system against the chain state
+type TxOrders struct {
+	TxHash string
+	Height int64
+	// Code is the result code of the tx, where nothing is placed or cancelled if it's not 0
+	Code      uint32
+	Placed    []PlacedOrder
+	Cancelled []CancelledOrder
+	// Fills are the current fills of the orders placed successfully, since the order module reports no fills by the
+	// events
+	Fills []OrderFill
+}
+
+// PlacedOrder - structure of an order item placed by a tx with its result, whose order ID is empty if it failed
+type PlacedOrder struct {
+	Sender sdk.AccAddress
+	Item   OrderItem
+	Result OrderResult
+}
+
+// CancelledOrder - structure of an order cancelled by a tx with its result
+type CancelledOrder struct {
+	Sender  sdk.AccAddress
+	OrderID string
+	Result  OrderResult
+}
+
+// OrderFill - structure of the fill of an order by the latest state
+type OrderFill struct {
+	OrderID        string
+	Status         int64
+	FilledQuantity sdk.Dec
+	FilledAvgPrice sdk.Dec
+	RemainQuantity sdk.Dec
+}
+
+// NewOrderFill creates a new instance of OrderFill by the detail of the order
+func NewOrderFill(orderDetail OrderDetail) OrderFill {
+	return OrderFill{
+		OrderID:        orderDetail.OrderID,
+		Status:         orderDetail.Status,
+		FilledQuantity: orderDetail.Quantity.Sub(orderDetail.RemainQuantity),
+		FilledAvgPrice: orderDetail.FilledAvgPrice,
+		RemainQuantity: orderDetail.RemainQuantity,
+	}
+}
+
+// ParseTxOrders matches the order msgs of a committed tx with the results of the orders in its events, each of the
+// msgs placing or cancelling the orders in order. The fills are left to query by the order IDs
+func ParseTxOrders(stdTx sdk.StdTx, code uint32, events sdk.StringEvents) (txOrders TxOrders, err error) {
+	txOrders.Code = code
+	if code != 0 {
+		return
+	}
+
+	groups, err := parseOrderResultGroups(events)
+	if err != nil {
+		return
+	}
+
+	var i int
+	nextGroup := func(size int) ([]OrderResult, error) {
+		if i >= len(groups) {
+			return nil, fmt.Errorf("failed. no order results of order msg %d", i)
+		}
+		if len(groups[i]) != size {
+			return nil, fmt.Errorf("failed. %d order results for %d orders of order msg %d", len(groups[i]), size, i)
+		}
+		i++
+		return groups[i-1], nil
+	}
+
+	for _, msg := range stdTx.Msgs {
+		switch msg := msg.(type) {
+		case MsgNewOrders:
+			results, err := nextGroup(len(msg.OrderItems))
+			if err != nil {
+				return txOrders, err
+			}
+			for j, item := range msg.OrderItems {
+				txOrders.Placed = append(txOrders.Placed, PlacedOrder{Sender: msg.Sender, Item: item, Result: results[j]})
+			}
+		case MsgCancelOrders:
+			results, err := nextGroup(len(msg.OrderIDs))
+			if err != nil {
+				return txOrders, err
+			}
+			for j, orderID := range msg.OrderIDs {
+				txOrders.Cancelled = append(txOrders.Cancelled, CancelledOrder{
+					Sender:  msg.Sender,
+					OrderID: orderID,
+					Result:  results[j],
+				})
+			}
+		}
+	}
+	return
+}
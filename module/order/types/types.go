@@ -1,6 +1,9 @@
 package types
 
 import (
+	"encoding/json"
+	"fmt"
+
 	sdk "github.com/okex/okchain-go-sdk/types"
 )
 
@@ -10,14 +13,23 @@ const (
 
 	DepthbookPath   = "custom/order/depthbook"
 	OrderDetailPath = "custom/order/detail"
+
+	// MaxOrderItemsPerMsg is the limit of the order items in a MsgNewOrders on OKChain
+	MaxOrderItemsPerMsg = 200
+
+	eventTypeMessage   = "message"
+	attributeKeyOrders = "orders"
 )
 
 var (
-	msgCdc = sdk.NewCodec()
+	msgCdc sdk.SDKCodec
 )
 
 func init() {
-	RegisterCodec(msgCdc)
+	sdk.RegisterCodecInitializer(func() {
+		msgCdc = sdk.NewCodec()
+		RegisterCodec(msgCdc)
+	})
 }
 
 // RegisterCodec registers the msg type for token module
@@ -63,6 +75,54 @@ type OrderResult struct {
 	OrderID string `json:"orderid"`
 }
 
+// IsSuccess tells whether the order was placed
+func (or OrderResult) IsSuccess() bool {
+	return or.Code == 0
+}
+
+// ParseOrderResults parses the results of the orders placed, in the order of the order items, from the events of the
+// tx response. It's only available for the tx response of the block broadcast mode, and none is returned otherwise
+func ParseOrderResults(txResp sdk.TxResponse) (orderResults []OrderResult, err error) {
+	// the events of the tx are deprecated in favor of the ones of the msg logs
+	events := txResp.Events
+	if len(txResp.Logs) != 0 {
+		events = nil
+		for _, log := range txResp.Logs {
+			events = append(events, log.Events...)
+		}
+	}
+
+	groups, err := parseOrderResultGroups(events)
+	if err != nil {
+		return
+	}
+	for _, results := range groups {
+		orderResults = append(orderResults, results...)
+	}
+	return
+}
+
+// parseOrderResultGroups parses the results of the orders from the events, grouped by the msgs placing or cancelling
+// the orders in the tx
+func parseOrderResultGroups(events sdk.StringEvents) (groups [][]OrderResult, err error) {
+	for _, event := range events {
+		if event.Type != eventTypeMessage {
+			continue
+		}
+		for _, attribute := range event.Attributes {
+			if attribute.Key != attributeKeyOrders {
+				continue
+			}
+			var results []OrderResult
+			if err = json.Unmarshal([]byte(attribute.Value), &results); err != nil {
+				return nil, fmt.Errorf("failed. unmarshal order results error: %w", err)
+			}
+			groups = append(groups, results)
+		}
+	}
+	return
+}
+
 // BookRes - structure of depthbook
 type BookRes struct {
 	Asks []BookResItem `json:"asks"`
@@ -0,0 +1,166 @@
+package module
+
+import (
+	"context"
+
+	sdk "github.com/okex/okchain-go-sdk/types"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	rpcCli "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// methods of the tendermint rpc, by which the rate limit weights the calls
+const (
+	methodABCIInfo          = "abci_info"
+	methodABCIQuery         = "abci_query"
+	methodBroadcastTxCommit = "broadcast_tx_commit"
+	methodBroadcastTxAsync  = "broadcast_tx_async"
+	methodBroadcastTxSync   = "broadcast_tx_sync"
+	methodBlock             = "block"
+	methodBlockResults      = "block_results"
+	methodCommit            = "commit"
+	methodValidators        = "validators"
+	methodTx                = "tx"
+	methodTxSearch          = "tx_search"
+)
+
+var _ sdk.RPCClient = (*rateLimitedRPCClient)(nil)
+
+// rateLimitedRPCClient - structure of the rpc client waiting for the rate limiter before every call to the inner one
+type rateLimitedRPCClient struct {
+	sdk.RPCClient
+	limiter *sdk.RateLimiter
+	// err is the error of the invalid rate limit, returned by every call like the invalid endpoint auth
+	err error
+	// methods are the methods limited, all if empty
+	methods map[string]bool
+}
+
+// newRateLimitedRPCClient wraps the rpc client with the rate limit of the methods, all the methods if none
+func newRateLimitedRPCClient(rpcClient sdk.RPCClient, limit sdk.RateLimit, methods ...string) *rateLimitedRPCClient {
+	limiter, err := sdk.NewRateLimiter(limit)
+	rc := &rateLimitedRPCClient{
+		RPCClient: rpcClient,
+		limiter:   limiter,
+		err:       err,
+		methods:   make(map[string]bool, len(methods)),
+	}
+	for _, method := range methods {
+		rc.methods[method] = true
+	}
+	return rc
+}
+
+// Unwrap returns the inner rpc client, e.g. for the subscriptions
+func (rc *rateLimitedRPCClient) Unwrap() sdk.RPCClient {
+	return rc.RPCClient
+}
+
+// wait waits for the turn of the call to the method
+func (rc *rateLimitedRPCClient) wait(method string) error {
+	if len(rc.methods) != 0 && !rc.methods[method] {
+		return nil
+	}
+	if rc.err != nil {
+		return rc.err
+	}
+	return rc.limiter.Wait(context.Background(), method)
+}
+
+// ABCIInfo implements the rpc.ABCIClient interface
+func (rc *rateLimitedRPCClient) ABCIInfo() (*ctypes.ResultABCIInfo, error) {
+	if err := rc.wait(methodABCIInfo); err != nil {
+		return nil, err
+	}
+	return rc.RPCClient.ABCIInfo()
+}
+
+// ABCIQuery implements the rpc.ABCIClient interface
+func (rc *rateLimitedRPCClient) ABCIQuery(path string, data cmn.HexBytes) (*ctypes.ResultABCIQuery, error) {
+	if err := rc.wait(methodABCIQuery); err != nil {
+		return nil, err
+	}
+	return rc.RPCClient.ABCIQuery(path, data)
+}
+
+// ABCIQueryWithOptions implements the rpc.ABCIClient interface
+func (rc *rateLimitedRPCClient) ABCIQueryWithOptions(path string, data cmn.HexBytes, opts rpcCli.ABCIQueryOptions) (
+	*ctypes.ResultABCIQuery, error) {
+	if err := rc.wait(methodABCIQuery); err != nil {
+		return nil, err
+	}
+	return rc.RPCClient.ABCIQueryWithOptions(path, data, opts)
+}
+
+// BroadcastTxCommit implements the rpc.ABCIClient interface
+func (rc *rateLimitedRPCClient) BroadcastTxCommit(tx tmtypes.Tx) (*ctypes.ResultBroadcastTxCommit, error) {
+	if err := rc.wait(methodBroadcastTxCommit); err != nil {
+		return nil, err
+	}
+	return rc.RPCClient.BroadcastTxCommit(tx)
+}
+
+// BroadcastTxAsync implements the rpc.ABCIClient interface
+func (rc *rateLimitedRPCClient) BroadcastTxAsync(tx tmtypes.Tx) (*ctypes.ResultBroadcastTx, error) {
+	if err := rc.wait(methodBroadcastTxAsync); err != nil {
+		return nil, err
+	}
+	return rc.RPCClient.BroadcastTxAsync(tx)
+}
+
+// BroadcastTxSync implements the rpc.ABCIClient interface
+func (rc *rateLimitedRPCClient) BroadcastTxSync(tx tmtypes.Tx) (*ctypes.ResultBroadcastTx, error) {
+	if err := rc.wait(methodBroadcastTxSync); err != nil {
+		return nil, err
+	}
+	return rc.RPCClient.BroadcastTxSync(tx)
+}
+
+// Block implements the rpc.SignClient interface
+func (rc *rateLimitedRPCClient) Block(height *int64) (*ctypes.ResultBlock, error) {
+	if err := rc.wait(methodBlock); err != nil {
+		return nil, err
+	}
+	return rc.RPCClient.Block(height)
+}
+
+// BlockResults implements the rpc.SignClient interface
+func (rc *rateLimitedRPCClient) BlockResults(height *int64) (*ctypes.ResultBlockResults, error) {
+	if err := rc.wait(methodBlockResults); err != nil {
+		return nil, err
+	}
+	return rc.RPCClient.BlockResults(height)
+}
+
+// Commit implements the rpc.SignClient interface
+func (rc *rateLimitedRPCClient) Commit(height *int64) (*ctypes.ResultCommit, error) {
+	if err := rc.wait(methodCommit); err != nil {
+		return nil, err
+	}
+	return rc.RPCClient.Commit(height)
+}
+
+// Validators implements the rpc.SignClient interface
+func (rc *rateLimitedRPCClient) Validators(height *int64) (*ctypes.ResultValidators, error) {
+	if err := rc.wait(methodValidators); err != nil {
+		return nil, err
+	}
+	return rc.RPCClient.Validators(height)
+}
+
+// Tx implements the rpc.SignClient interface
+func (rc *rateLimitedRPCClient) Tx(hash []byte, prove bool) (*ctypes.ResultTx, error) {
+	if err := rc.wait(methodTx); err != nil {
+		return nil, err
+	}
+	return rc.RPCClient.Tx(hash, prove)
+}
+
+// TxSearch implements the rpc.SignClient interface
+func (rc *rateLimitedRPCClient) TxSearch(query string, prove bool, page, perPage int) (*ctypes.ResultTxSearch, error) {
+	if err := rc.wait(methodTxSearch); err != nil {
+		return nil, err
+	}
+	return rc.RPCClient.TxSearch(query, prove, page, perPage)
+}
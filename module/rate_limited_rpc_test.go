@@ -0,0 +1,62 @@
+package module
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/okex/okchain-go-sdk/mocks"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	sdkerrors "github.com/okex/okchain-go-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestRateLimitedRPCClient(t *testing.T) {
+	conformance := mocks.NewConformanceBackend()
+	conformance.SetResponse("custom/test/path", nil, abci.ResponseQuery{Value: []byte("value")})
+	limit := sdk.RateLimit{RequestsPerSecond: 1, MaxWait: time.Millisecond}
+	rc := newRateLimitedRPCClient(conformance, limit)
+	require.Equal(t, conformance, unwrapRPCClient(rc))
+
+	_, err := rc.ABCIQuery("custom/test/path", nil)
+	require.NoError(t, err)
+	// over the max wait of the next second
+	_, err = rc.ABCIQuery("custom/test/path", nil)
+	require.True(t, errors.Is(err, sdkerrors.ErrRateLimited))
+	require.Len(t, conformance.Calls(), 1)
+
+	// the methods out of the ones limited go freely
+	rc = newRateLimitedRPCClient(&broadcastBackend{ConformanceBackend: conformance}, limit, methodABCIQuery)
+	_, err = rc.ABCIQuery("custom/test/path", nil)
+	require.NoError(t, err)
+	for i := 0; i < 2; i++ {
+		_, err = rc.BroadcastTxSync([]byte("tx"))
+		require.NoError(t, err)
+	}
+
+	// the invalid rate limit fails every call
+	rc = newRateLimitedRPCClient(conformance, sdk.RateLimit{})
+	_, err = rc.ABCIQuery("custom/test/path", nil)
+	require.Error(t, err)
+}
+
+func TestNewBaseClient_RateLimit(t *testing.T) {
+	config, err := sdk.NewClientConfig("testURL", "testChain", sdk.BroadcastBlock, "", 200000,
+		1.1, "0.00000001okt")
+	require.NoError(t, err)
+	config.RPCRateLimit = &sdk.RateLimit{RequestsPerSecond: 10}
+	rc, ok := NewBaseClient(mocks.NewFixtureCodec(), &config).RPCClient.(*rateLimitedRPCClient)
+	require.True(t, ok)
+	require.Empty(t, rc.methods)
+
+	config.Transport, config.GRPCAddr = sdk.TransportGRPC, "localhost:9090"
+	config.GRPCRateLimit = &sdk.RateLimit{RequestsPerSecond: 10}
+	rc, ok = NewBaseClient(mocks.NewFixtureCodec(), &config).RPCClient.(*rateLimitedRPCClient)
+	require.True(t, ok)
+	require.Equal(t, map[string]bool{methodABCIQuery: true}, rc.methods)
+	transport, ok := rc.RPCClient.(*grpcTransport)
+	require.True(t, ok)
+	_, ok = transport.RPCClient.(*rateLimitedRPCClient)
+	require.True(t, ok)
+}
@@ -0,0 +1,26 @@
+package exposed
+
+import (
+	"github.com/okex/okchain-go-sdk/module/slashing/types"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	"github.com/okex/okchain-go-sdk/types/crypto/keys"
+)
+
+// Slashing shows the expected behavior for inner slashing client
+type Slashing interface {
+	sdk.Module
+	SlashingTx
+	SlashingQuery
+}
+
+// SlashingTx shows the expected tx behavior for inner slashing client
+type SlashingTx interface {
+	Unjail(fromInfo keys.Info, passWd, memo string, accNum, seqNum uint64) (sdk.TxResponse, error)
+}
+
+// SlashingQuery shows the expected query behavior for inner slashing client
+type SlashingQuery interface {
+	QuerySigningInfo(consAddrStr string) (types.ValidatorSigningInfo, error)
+	QuerySigningInfos() ([]types.ValidatorSigningInfo, error)
+	QuerySlashingParams() (types.Params, error)
+}
@@ -0,0 +1,61 @@
+package slashing
+
+import (
+	"fmt"
+
+	"github.com/okex/okchain-go-sdk/module/slashing/types"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	"github.com/okex/okchain-go-sdk/utils"
+)
+
+// QuerySigningInfo gets the signing info of a validator by its bech32 consensus address, e.g. the missed blocks in the
+// signed blocks window and the time it's jailed until
+func (sc slashingClient) QuerySigningInfo(consAddrStr string) (signingInfo types.ValidatorSigningInfo, err error) {
+	consAddr, err := sdk.ConsAddressFromBech32(consAddrStr)
+	if err != nil {
+		return
+	}
+
+	res, err := sc.QueryStore(types.GetValidatorSigningInfoKey(consAddr), ModuleName, "key")
+	if err != nil {
+		return signingInfo, utils.ErrClientQuery(err.Error())
+	}
+	if len(res) == 0 {
+		return signingInfo, fmt.Errorf("failed. no signing info found with consensus address %s", consAddrStr)
+	}
+
+	err = sc.GetCodec().UnmarshalBinaryLengthPrefixed(res, &signingInfo)
+	return
+}
+
+// QuerySigningInfos gets the signing infos of all the validators
+func (sc slashingClient) QuerySigningInfos() (signingInfos []types.ValidatorSigningInfo, err error) {
+	resKVs, err := sc.QuerySubspace(types.ValidatorSigningInfoKey, ModuleName)
+	if err != nil {
+		return
+	}
+
+	for _, kv := range resKVs {
+		var signingInfo types.ValidatorSigningInfo
+		if err = sc.GetCodec().UnmarshalBinaryLengthPrefixed(kv.Value, &signingInfo); err != nil {
+			return nil, err
+		}
+		signingInfos = append(signingInfos, signingInfo)
+	}
+
+	return
+}
+
+// QuerySlashingParams gets the params of the slashing module, e.g. the signed blocks window and the jail duration
+func (sc slashingClient) QuerySlashingParams() (slashingParams types.Params, err error) {
+	res, err := sc.Query(types.ParamsPath, nil)
+	if err != nil {
+		return slashingParams, utils.ErrClientQuery(err.Error())
+	}
+
+	if err = sc.GetCodec().UnmarshalJSON(res, &slashingParams); err != nil {
+		return slashingParams, utils.ErrUnmarshalJSON(err.Error())
+	}
+
+	return
+}
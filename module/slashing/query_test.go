@@ -0,0 +1,96 @@
+package slashing
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/okex/okchain-go-sdk/mocks"
+	"github.com/okex/okchain-go-sdk/module/slashing/types"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	"github.com/stretchr/testify/require"
+	cmn "github.com/tendermint/tendermint/libs/common"
+)
+
+func TestSlashingClient_QuerySigningInfo(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	config, err := sdk.NewClientConfig("testURL", "testChain", sdk.BroadcastBlock, "", 200000,
+		1.1, "0.00000001okt")
+	require.NoError(t, err)
+	mockCli := mocks.NewMockClient(t, ctrl, config)
+	mockCli.RegisterModule(NewSlashingClient(mockCli.MockBaseClient))
+
+	consAddr := sdk.ConsAddress(cmn.RandBytes(20))
+	expectedCdc := mockCli.GetCodec()
+	signingInfo := types.ValidatorSigningInfo{
+		Address:             consAddr,
+		StartHeight:         10,
+		IndexOffset:         1024,
+		JailedUntil:         time.Unix(0, 0).UTC(),
+		MissedBlocksCounter: 3,
+	}
+	expectedRet := expectedCdc.MustMarshalBinaryLengthPrefixed(signingInfo)
+
+	mockCli.EXPECT().GetCodec().Return(expectedCdc)
+	mockCli.EXPECT().QueryStore(cmn.HexBytes(types.GetValidatorSigningInfoKey(consAddr)), ModuleName, "key").
+		Return(expectedRet, nil)
+	res, err := mockCli.Slashing().QuerySigningInfo(consAddr.String())
+	require.NoError(t, err)
+	require.Equal(t, signingInfo, res)
+
+	mockCli.EXPECT().GetCodec().Return(expectedCdc)
+	mockCli.EXPECT().QuerySubspace(types.ValidatorSigningInfoKey, ModuleName).
+		Return([]cmn.KVPair{{Value: expectedRet}}, nil)
+	infos, err := mockCli.Slashing().QuerySigningInfos()
+	require.NoError(t, err)
+	require.Equal(t, []types.ValidatorSigningInfo{signingInfo}, infos)
+
+	mockCli.EXPECT().QueryStore(gomock.Any(), ModuleName, "key").Return(nil, nil)
+	_, err = mockCli.Slashing().QuerySigningInfo(consAddr.String())
+	require.Error(t, err)
+
+	mockCli.EXPECT().QueryStore(gomock.Any(), ModuleName, "key").Return(nil, errors.New("default error"))
+	_, err = mockCli.Slashing().QuerySigningInfo(consAddr.String())
+	require.Error(t, err)
+
+	_, err = mockCli.Slashing().QuerySigningInfo(addr)
+	require.Error(t, err)
+}
+
+func TestSlashingClient_QuerySlashingParams(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	config, err := sdk.NewClientConfig("testURL", "testChain", sdk.BroadcastBlock, "", 200000,
+		1.1, "0.00000001okt")
+	require.NoError(t, err)
+	mockCli := mocks.NewMockClient(t, ctrl, config)
+	mockCli.RegisterModule(NewSlashingClient(mockCli.MockBaseClient))
+
+	expectedCdc := mockCli.GetCodec()
+	expectedRet := expectedCdc.MustMarshalJSON(types.Params{
+		SignedBlocksWindow:      100,
+		MinSignedPerWindow:      sdk.MustNewDecFromStr("0.05"),
+		DowntimeJailDuration:    time.Hour,
+		SlashFractionDoubleSign: sdk.MustNewDecFromStr("0.05"),
+		SlashFractionDowntime:   sdk.MustNewDecFromStr("0.0001"),
+	})
+
+	mockCli.EXPECT().GetCodec().Return(expectedCdc)
+	mockCli.EXPECT().Query(types.ParamsPath, nil).Return(expectedRet, nil)
+	slashingParams, err := mockCli.Slashing().QuerySlashingParams()
+	require.NoError(t, err)
+	require.Equal(t, int64(100), slashingParams.SignedBlocksWindow)
+	require.Equal(t, time.Hour, slashingParams.DowntimeJailDuration)
+	require.Equal(t, int64(95), slashingParams.MaxMissedBlocks())
+
+	mockCli.EXPECT().Query(types.ParamsPath, nil).Return(nil, errors.New("default error"))
+	_, err = mockCli.Slashing().QuerySlashingParams()
+	require.Error(t, err)
+
+	mockCli.EXPECT().GetCodec().Return(expectedCdc)
+	mockCli.EXPECT().Query(types.ParamsPath, nil).Return(expectedRet[1:], nil)
+	_, err = mockCli.Slashing().QuerySlashingParams()
+	require.Error(t, err)
+}
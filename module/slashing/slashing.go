@@ -1,11 +1,17 @@
 package slashing
 
 import (
-	"github.com/okex/okchain-go-sdk/exposed"
+	"github.com/okex/okchain-go-sdk/module/slashing/exposed"
 	"github.com/okex/okchain-go-sdk/module/slashing/types"
 	sdk "github.com/okex/okchain-go-sdk/types"
 )
 
+func init() {
+	sdk.RegisterModule(types.ModuleName, func(baseClient sdk.BaseClient) sdk.Module {
+		return NewSlashingClient(baseClient)
+	})
+}
+
 var _ sdk.Module = (*slashingClient)(nil)
 
 type slashingClient struct {
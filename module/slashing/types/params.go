@@ -0,0 +1,27 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/okex/okchain-go-sdk/types"
+)
+
+// ParamsPath is the query path of the slashing params
+const ParamsPath = "custom/slashing/parameters"
+
+// Params - structure of the params of the slashing module
+type Params struct {
+	MaxEvidenceAge time.Duration `json:"max_evidence_age"`
+	// SignedBlocksWindow is the number of the latest blocks where the missed blocks of a validator are counted
+	SignedBlocksWindow int64 `json:"signed_blocks_window"`
+	// MinSignedPerWindow is the min ratio of the blocks signed in the window, below which the validator is jailed
+	MinSignedPerWindow      sdk.Dec       `json:"min_signed_per_window"`
+	DowntimeJailDuration    time.Duration `json:"downtime_jail_duration"`
+	SlashFractionDoubleSign sdk.Dec       `json:"slash_fraction_double_sign"`
+	SlashFractionDowntime   sdk.Dec       `json:"slash_fraction_downtime"`
+}
+
+// MaxMissedBlocks returns the max number of the blocks a validator misses in the window without being jailed
+func (p Params) MaxMissedBlocks() int64 {
+	return p.SignedBlocksWindow - p.MinSignedPerWindow.MulInt64(p.SignedBlocksWindow).RoundInt64()
+}
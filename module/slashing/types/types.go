@@ -1,6 +1,10 @@
 package types
 
-import sdk "github.com/okex/okchain-go-sdk/types"
+import (
+	"time"
+
+	sdk "github.com/okex/okchain-go-sdk/types"
+)
 
 // const
 const (
@@ -8,14 +12,39 @@ const (
 )
 
 var (
-	msgCdc = sdk.NewCodec()
+	msgCdc sdk.SDKCodec
 )
 
 func init() {
-	RegisterCodec(msgCdc)
+	sdk.RegisterCodecInitializer(func() {
+		msgCdc = sdk.NewCodec()
+		RegisterCodec(msgCdc)
+	})
 }
 
 // RegisterCodec registers the msg type for slashing module
 func RegisterCodec(cdc sdk.SDKCodec) {
 	cdc.RegisterConcrete(MsgUnjail{}, "cosmos-sdk/MsgUnjail")
 }
+
+var (
+	// ValidatorSigningInfoKey is useful for subspace and store query about the signing info of the validators
+	ValidatorSigningInfoKey = []byte{0x01}
+)
+
+// GetValidatorSigningInfoKey builds the store key for the signing info of a specific validator
+func GetValidatorSigningInfoKey(consAddr sdk.ConsAddress) []byte {
+	return append(ValidatorSigningInfoKey, consAddr.Bytes()...)
+}
+
+// ValidatorSigningInfo - structure of the signing info of a validator, which tracks its liveness
+type ValidatorSigningInfo struct {
+	Address     sdk.ConsAddress `json:"address"`
+	StartHeight int64           `json:"start_height"`
+	// IndexOffset is the index of the next block in the signed blocks window
+	IndexOffset int64     `json:"index_offset"`
+	JailedUntil time.Time `json:"jailed_until"`
+	Tombstoned  bool      `json:"tombstoned"`
+	// MissedBlocksCounter is the number of the blocks missed in the signed blocks window
+	MissedBlocksCounter int64 `json:"missed_blocks_counter"`
+}
@@ -11,4 +11,6 @@ type (
 	// nolint
 	Validator     = types.Validator
 	DelegatorResp = types.DelegatorResp
+	Vote          = types.Vote
+	ProxyResp     = types.ProxyResp
 )
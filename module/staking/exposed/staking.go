@@ -0,0 +1,52 @@
+package exposed
+
+import (
+	"context"
+
+	"github.com/okex/okchain-go-sdk/module/staking/types"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	"github.com/okex/okchain-go-sdk/types/crypto/keys"
+)
+
+// Staking shows the expected behavior for inner staking client
+type Staking interface {
+	sdk.Module
+	StakingTx
+	StakingQuery
+	StakingSubscription
+}
+
+// StakingTx shows the expected tx behavior for inner staking client
+type StakingTx interface {
+	CreateValidator(fromInfo keys.Info, passWd, pubkeyStr, moniker, identity, website, details, memo string, accNum,
+		seqNum uint64) (sdk.TxResponse, error)
+	DestroyValidator(fromInfo keys.Info, passWd string, memo string, accNum, seqNum uint64) (sdk.TxResponse, error)
+	EditValidator(fromInfo keys.Info, passWd, moniker, identity, website, details, memo string, accNum, seqNum uint64) (
+		sdk.TxResponse, error)
+	Delegate(fromInfo keys.Info, passWd string, amount sdk.Amount, memo string, accNum, seqNum uint64) (sdk.TxResponse,
+		error)
+	Unbond(fromInfo keys.Info, passWd string, amount sdk.Amount, memo string, accNum, seqNum uint64) (sdk.TxResponse,
+		error)
+	Vote(fromInfo keys.Info, passWd string, valAddrsStr []string, memo string, accNum, seqNum uint64) (sdk.TxResponse, error)
+	RegisterProxy(fromInfo keys.Info, passWd, memo string, accNum, seqNum uint64) (sdk.TxResponse, error)
+	UnregisterProxy(fromInfo keys.Info, passWd, memo string, accNum, seqNum uint64) (sdk.TxResponse, error)
+	BindProxy(fromInfo keys.Info, passWd, proxyAddrStr, memo string, accNum, seqNum uint64) (sdk.TxResponse, error)
+	UnbindProxy(fromInfo keys.Info, passWd, memo string, accNum, seqNum uint64) (sdk.TxResponse, error)
+}
+
+// StakingQuery shows the expected query behavior for inner staking client
+type StakingQuery interface {
+	QueryValidators() ([]types.Validator, error)
+	QueryValidatorsPaged(pageReq sdk.PageRequest) ([]types.Validator, sdk.PagedResult, error)
+	QueryValidator(valAddrStr string) (types.Validator, error)
+	QueryDelegator(delAddrStr string) (types.DelegatorResp, error)
+	QueryDelegatorVotes(delAddrStr string) ([]types.Vote, error)
+	QueryValidatorVotes(valAddrStr string) ([]types.Vote, error)
+	QueryProxy(proxyAddrStr string) (types.ProxyResp, error)
+	QueryStakingParams() (types.Params, error)
+}
+
+// StakingSubscription shows the expected subscription behavior for inner staking client
+type StakingSubscription interface {
+	SubscribeValidatorSetUpdates(ctx context.Context) (<-chan types.ValidatorSetEvent, error)
+}
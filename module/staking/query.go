@@ -29,6 +29,23 @@ func (sc stakingClient) QueryValidators() (vals []types.Validator, err error) {
 
 }
 
+// QueryValidatorsPaged gets the validators info on a page with the paging info
+func (sc stakingClient) QueryValidatorsPaged(pageReq sdk.PageRequest) (vals []types.Validator, pagedRes sdk.PagedResult,
+	err error) {
+	if err = pageReq.ValidateBasic(); err != nil {
+		return
+	}
+
+	allVals, err := sc.QueryValidators()
+	if err != nil {
+		return
+	}
+
+	start, end := sdk.PaginateSlice(pageReq, len(allVals))
+	vals = allVals[start:end]
+	return vals, sdk.NewPagedResult(pageReq, len(vals), len(allVals)), err
+}
+
 // QueryValidator gets the info of a specific validator
 func (sc stakingClient) QueryValidator(valAddrStr string) (val types.Validator, err error) {
 	valAddr, err := sdk.ValAddressFromBech32(valAddrStr)
@@ -84,3 +101,129 @@ func (sc stakingClient) QueryDelegator(delAddrStr string) (delResp types.Delegat
 
 	return types.ConvertToDelegatorResp(delegator, undelegation), nil
 }
+
+// QueryStakingParams gets the params of the staking module, e.g. the unbonding time and the max number of validators
+func (sc stakingClient) QueryStakingParams() (stakingParams types.Params, err error) {
+	res, err := sc.Query(types.ParamsPath, nil)
+	if err != nil {
+		return stakingParams, utils.ErrClientQuery(err.Error())
+	}
+
+	if err = sc.GetCodec().UnmarshalJSON(res, &stakingParams); err != nil {
+		return stakingParams, utils.ErrUnmarshalJSON(err.Error())
+	}
+
+	return
+}
+
+// QueryDelegatorVotes gets the votes cast by a delegator, or by its proxy if it's bound to one, whose address is the
+// voter of the votes then
+func (sc stakingClient) QueryDelegatorVotes(delAddrStr string) (votes []types.Vote, err error) {
+	delAddr, err := sdk.AccAddressFromBech32(delAddrStr)
+	if err != nil {
+		return
+	}
+
+	delegator, err := sc.queryDelegator(delAddr)
+	if err != nil {
+		return
+	}
+	if delegator.ProxyAddress.Empty() {
+		return delegator.GetVotes(), nil
+	}
+
+	proxy, err := sc.queryDelegator(delegator.ProxyAddress)
+	if err != nil {
+		return
+	}
+	return proxy.GetVotes(), nil
+}
+
+// QueryValidatorVotes gets the votes of all the delegators and proxies voting for a validator
+func (sc stakingClient) QueryValidatorVotes(valAddrStr string) (votes []types.Vote, err error) {
+	valAddr, err := sdk.ValAddressFromBech32(valAddrStr)
+	if err != nil {
+		return
+	}
+
+	delegators, err := sc.queryAllDelegators()
+	if err != nil {
+		return
+	}
+
+	for _, delegator := range delegators {
+		for _, vote := range delegator.GetVotes() {
+			if vote.ValidatorAddress.Equals(valAddr) {
+				votes = append(votes, vote)
+			}
+		}
+	}
+	return
+}
+
+// QueryProxy gets the info of a proxy with the total tokens delegated to it and its bound delegators
+func (sc stakingClient) QueryProxy(proxyAddrStr string) (proxyResp types.ProxyResp, err error) {
+	proxyAddr, err := sdk.AccAddressFromBech32(proxyAddrStr)
+	if err != nil {
+		return
+	}
+
+	proxy, err := sc.queryDelegator(proxyAddr)
+	if err != nil {
+		return
+	}
+	if !proxy.IsProxy {
+		return proxyResp, fmt.Errorf("failed. %s isn't a proxy", proxyAddrStr)
+	}
+
+	delegators, err := sc.queryAllDelegators()
+	if err != nil {
+		return
+	}
+
+	proxyResp = types.ProxyResp{
+		ProxyAddress:         proxy.DelegatorAddress,
+		ValidatorAddresses:   proxy.ValidatorAddresses,
+		Shares:               proxy.Shares,
+		Tokens:               proxy.Tokens,
+		TotalDelegatedTokens: proxy.TotalDelegatedTokens,
+	}
+	for _, delegator := range delegators {
+		if delegator.ProxyAddress.Equals(proxyAddr) {
+			proxyResp.BoundDelegators = append(proxyResp.BoundDelegators, delegator.DelegatorAddress)
+		}
+	}
+	return
+}
+
+// queryDelegator gets the delegator from the store, the empty one if it never delegated
+func (sc stakingClient) queryDelegator(delAddr sdk.AccAddress) (delegator types.Delegator, err error) {
+	res, err := sc.QueryStore(types.GetDelegatorKey(delAddr), ModuleName, "key")
+	if err != nil {
+		return delegator, utils.ErrClientQuery(err.Error())
+	}
+
+	delegator = types.NewDelegator(delAddr)
+	if len(res) != 0 {
+		if err = sc.GetCodec().UnmarshalBinaryLengthPrefixed(res, &delegator); err != nil {
+			return delegator, fmt.Errorf("failed. unmarshal delegator error: %w", err)
+		}
+	}
+	return
+}
+
+// queryAllDelegators gets all the delegators from the store
+func (sc stakingClient) queryAllDelegators() (delegators []types.Delegator, err error) {
+	resKVs, err := sc.QuerySubspace(types.DelegatorKey, ModuleName)
+	if err != nil {
+		return nil, utils.ErrClientQuery(err.Error())
+	}
+
+	delegators = make([]types.Delegator, len(resKVs))
+	for i, kv := range resKVs {
+		if err = sc.GetCodec().UnmarshalBinaryLengthPrefixed(kv.Value, &delegators[i]); err != nil {
+			return nil, fmt.Errorf("failed. unmarshal delegator error: %w", err)
+		}
+	}
+	return
+}
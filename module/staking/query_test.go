@@ -194,3 +194,136 @@ func TestStakingClient_QueryDelegator(t *testing.T) {
 	require.Error(t, err)
 
 }
+
+func TestStakingClient_QueryValidatorsPaged(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	config, err := sdk.NewClientConfig("testURL", "testChain", sdk.BroadcastBlock, "", 200000,
+		1.1, "0.00000001okt")
+	require.NoError(t, err)
+	mockCli := mocks.NewMockClient(t, ctrl, config)
+	mockCli.RegisterModule(NewStakingClient(mockCli.MockBaseClient))
+
+	valOperAddr, err := sdk.ValAddressFromBech32(valAddr)
+	require.NoError(t, err)
+	kvPair := cmn.KVPair{
+		Key:   append(types.ValidatorsKey, valOperAddr.Bytes()...),
+		Value: rawValBytes,
+	}
+	expectedRet := []cmn.KVPair{kvPair, kvPair, kvPair}
+	expectedCdc := mockCli.GetCodec()
+
+	mockCli.EXPECT().GetCodec().Return(expectedCdc).Times(3)
+	mockCli.EXPECT().QuerySubspace(types.ValidatorsKey, types.ModuleName).Return(expectedRet, nil)
+
+	vals, pagedRes, err := mockCli.Staking().QueryValidatorsPaged(sdk.NewPageRequest(2, 2))
+	require.NoError(t, err)
+	require.Equal(t, 1, len(vals))
+	require.Equal(t, valOperAddr, vals[0].OperatorAddress)
+	require.Equal(t, 3, pagedRes.Total)
+	require.False(t, pagedRes.HasNext)
+
+	_, _, err = mockCli.Staking().QueryValidatorsPaged(sdk.PageRequest{Page: 1})
+	require.Error(t, err)
+
+	mockCli.EXPECT().QuerySubspace(types.ValidatorsKey, types.ModuleName).Return(nil, errors.New("default error"))
+	_, _, err = mockCli.Staking().QueryValidatorsPaged(sdk.NewPageRequest(1, 2))
+	require.Error(t, err)
+}
+
+func TestStakingClient_QueryStakingParams(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	config, err := sdk.NewClientConfig("testURL", "testChain", sdk.BroadcastBlock, "", 200000,
+		1.1, "0.00000001okt")
+	require.NoError(t, err)
+	mockCli := mocks.NewMockClient(t, ctrl, config)
+	mockCli.RegisterModule(NewStakingClient(mockCli.MockBaseClient))
+
+	expectedCdc := mockCli.GetCodec()
+	expectedRet := expectedCdc.MustMarshalJSON(types.Params{
+		UnbondingTime:     14 * 24 * time.Hour,
+		MaxValidators:     21,
+		BondDenom:         "okt",
+		MinDelegation:     sdk.MustNewDecFromStr("0.0001"),
+		MinSelfDelegation: sdk.NewDec(10000),
+	})
+
+	mockCli.EXPECT().GetCodec().Return(expectedCdc)
+	mockCli.EXPECT().Query(types.ParamsPath, nil).Return(expectedRet, nil)
+	stakingParams, err := mockCli.Staking().QueryStakingParams()
+	require.NoError(t, err)
+	require.Equal(t, 14*24*time.Hour, stakingParams.UnbondingTime)
+	require.Equal(t, uint16(21), stakingParams.MaxValidators)
+
+	mockCli.EXPECT().Query(types.ParamsPath, nil).Return(nil, errors.New("default error"))
+	_, err = mockCli.Staking().QueryStakingParams()
+	require.Error(t, err)
+
+	mockCli.EXPECT().GetCodec().Return(expectedCdc)
+	mockCli.EXPECT().Query(types.ParamsPath, nil).Return(expectedRet[1:], nil)
+	_, err = mockCli.Staking().QueryStakingParams()
+	require.Error(t, err)
+}
+
+func TestStakingClient_QueryVotes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	config, err := sdk.NewClientConfig("testURL", "testChain", sdk.BroadcastBlock, "", 200000,
+		1.1, "0.00000001okt")
+	require.NoError(t, err)
+	mockCli := mocks.NewMockClient(t, ctrl, config)
+	mockCli.RegisterModule(NewStakingClient(mockCli.MockBaseClient))
+
+	delAddr, err := sdk.AccAddressFromBech32(addr)
+	require.NoError(t, err)
+	proxyAddr, err := sdk.AccAddressFromBech32(proxyAddr)
+	require.NoError(t, err)
+	valAddr, err := sdk.ValAddressFromBech32(valAddr)
+	require.NoError(t, err)
+	otherValAddr := sdk.ValAddress(proxyAddr)
+	delShares, proxyShares := sdk.MustNewDecFromStr("1024"), sdk.MustNewDecFromStr("4096")
+	tokens, totalDelegatedTokens := sdk.MustNewDecFromStr("10.24"), sdk.MustNewDecFromStr("20.48")
+
+	// the delegator bound to the proxy voting for both
+	delBytes := mockCli.BuildDelegatorBytes(delAddr, proxyAddr, nil, delShares, tokens, sdk.ZeroDec(), false)
+	proxyBytes := mockCli.BuildDelegatorBytes(proxyAddr, nil, []sdk.ValAddress{valAddr, otherValAddr}, proxyShares,
+		tokens, totalDelegatedTokens, true)
+	expectedCdc := mockCli.GetCodec()
+	mockCli.EXPECT().GetCodec().Return(expectedCdc).AnyTimes()
+	mockCli.EXPECT().QueryStore(cmn.HexBytes(types.GetDelegatorKey(delAddr)), ModuleName, "key").
+		Return(delBytes, nil).AnyTimes()
+	mockCli.EXPECT().QueryStore(cmn.HexBytes(types.GetDelegatorKey(proxyAddr)), ModuleName, "key").
+		Return(proxyBytes, nil).AnyTimes()
+	mockCli.EXPECT().QuerySubspace(types.DelegatorKey, ModuleName).Return([]cmn.KVPair{
+		{Key: types.GetDelegatorKey(delAddr), Value: delBytes},
+		{Key: types.GetDelegatorKey(proxyAddr), Value: proxyBytes},
+	}, nil).AnyTimes()
+
+	votes, err := mockCli.Staking().QueryDelegatorVotes(addr)
+	require.NoError(t, err)
+	require.Len(t, votes, 2)
+	require.Equal(t, proxyAddr, votes[0].VoterAddress)
+	require.Equal(t, valAddr, votes[0].ValidatorAddress)
+	require.Equal(t, otherValAddr, votes[1].ValidatorAddress)
+	require.Equal(t, proxyShares, votes[1].Shares)
+
+	votes, err = mockCli.Staking().QueryValidatorVotes(otherValAddr.String())
+	require.NoError(t, err)
+	require.Equal(t, []types.Vote{{VoterAddress: proxyAddr, ValidatorAddress: otherValAddr, Shares: proxyShares}},
+		votes)
+
+	proxyResp, err := mockCli.Staking().QueryProxy(proxyAddr.String())
+	require.NoError(t, err)
+	require.Equal(t, totalDelegatedTokens, proxyResp.TotalDelegatedTokens)
+	require.Equal(t, tokens, proxyResp.Tokens)
+	require.Equal(t, []sdk.AccAddress{delAddr}, proxyResp.BoundDelegators)
+
+	// not a proxy
+	_, err = mockCli.Staking().QueryProxy(addr)
+	require.Error(t, err)
+	_, err = mockCli.Staking().QueryDelegatorVotes(addr[1:])
+	require.Error(t, err)
+	_, err = mockCli.Staking().QueryValidatorVotes(addr)
+	require.Error(t, err)
+}
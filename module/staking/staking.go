@@ -1,11 +1,17 @@
 package staking
 
 import (
-	"github.com/okex/okchain-go-sdk/exposed"
+	"github.com/okex/okchain-go-sdk/module/staking/exposed"
 	"github.com/okex/okchain-go-sdk/module/staking/types"
 	sdk "github.com/okex/okchain-go-sdk/types"
 )
 
+func init() {
+	sdk.RegisterModule(types.ModuleName, func(baseClient sdk.BaseClient) sdk.Module {
+		return NewStakingClient(baseClient)
+	})
+}
+
 var _ sdk.Module = (*stakingClient)(nil)
 
 type stakingClient struct {
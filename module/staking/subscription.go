@@ -0,0 +1,163 @@
+package staking
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/okex/okchain-go-sdk/module/staking/types"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+const (
+	validatorSetUpdatesQuery   = "tm.event='ValidatorSetUpdates'"
+	validatorSetEventsCapacity = 64
+)
+
+// SubscribeValidatorSetUpdates subscribes the changes to the validator set, each of which is enriched with the staking
+// info of the validator and the delegators voting for it. The validator set at the latest height is queried first as
+// the base of the previous powers
+// The channel is closed when the ctx is done
+func (sc stakingClient) SubscribeValidatorSetUpdates(ctx context.Context) (<-chan types.ValidatorSetEvent, error) {
+	valsRes, err := sc.Validators(nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed. query validator set error: %w", err)
+	}
+
+	powers := make(map[string]int64, len(valsRes.Validators))
+	for _, val := range valsRes.Validators {
+		powers[val.PubKey.Address().String()] = val.VotingPower
+	}
+
+	subscriber := fmt.Sprintf("gosdk-valset-%d", time.Now().UnixNano())
+	events, err := sc.Subscribe(ctx, subscriber, validatorSetUpdatesQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	valSetEvents := make(chan types.ValidatorSetEvent, validatorSetEventsCapacity)
+	go sc.watchValidatorSet(ctx, powers, events, valSetEvents)
+	return valSetEvents, nil
+}
+
+func (sc stakingClient) watchValidatorSet(ctx context.Context, powers map[string]int64,
+	events <-chan ctypes.ResultEvent, valSetEvents chan<- types.ValidatorSetEvent) {
+	defer close(valSetEvents)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+
+			updates, ok := event.Data.(tmtypes.EventDataValidatorSetUpdates)
+			if !ok {
+				continue
+			}
+
+			for _, valSetEvent := range sc.enrichValidatorUpdates(powers, updates.ValidatorUpdates) {
+				select {
+				case valSetEvents <- valSetEvent:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}
+}
+
+// enrichValidatorUpdates converts the validator updates to the events and updates the powers
+func (sc stakingClient) enrichValidatorUpdates(powers map[string]int64,
+	valUpdates []*tmtypes.Validator) (valSetEvents []types.ValidatorSetEvent) {
+	for _, valUpdate := range valUpdates {
+		addrStr := valUpdate.PubKey.Address().String()
+		prevPower := powers[addrStr]
+		valSetEvent := types.ValidatorSetEvent{
+			Change:    types.ValidatorPowerChanged,
+			PrevPower: prevPower,
+			Power:     valUpdate.VotingPower,
+		}
+		switch {
+		case valUpdate.VotingPower == 0:
+			valSetEvent.Change = types.ValidatorLeft
+			delete(powers, addrStr)
+		case prevPower == 0:
+			valSetEvent.Change = types.ValidatorJoined
+			fallthrough
+		default:
+			powers[addrStr] = valUpdate.VotingPower
+		}
+
+		if valSetEvent.ConsPubKey, valSetEvent.Err = sdk.Bech32ifyConsPub(valUpdate.PubKey); valSetEvent.Err != nil {
+			valSetEvent.Err = fmt.Errorf("failed. bech32ify consensus pubkey error: %w", valSetEvent.Err)
+		}
+		valSetEvents = append(valSetEvents, valSetEvent)
+	}
+
+	if len(valSetEvents) == 0 {
+		return
+	}
+
+	if err := sc.attachStakingContext(valSetEvents); err != nil {
+		for i := range valSetEvents {
+			if valSetEvents[i].Err == nil {
+				valSetEvents[i].Err = err
+			}
+		}
+	}
+	return
+}
+
+// attachStakingContext fills the events with the staking info of the validators and the delegators voting for them
+func (sc stakingClient) attachStakingContext(valSetEvents []types.ValidatorSetEvent) error {
+	vals, err := sc.QueryValidators()
+	if err != nil {
+		return fmt.Errorf("failed. query validators error: %w", err)
+	}
+
+	valsMap := make(map[string]types.Validator, len(vals))
+	for _, val := range vals {
+		valsMap[val.ConsPubKey] = val
+	}
+
+	delegatorsMap := make(map[string][]types.Delegator)
+	for i := range valSetEvents {
+		val, ok := valsMap[valSetEvents[i].ConsPubKey]
+		if !ok {
+			continue
+		}
+		valSetEvents[i].Validator = &val
+		delegatorsMap[val.OperatorAddress.String()] = nil
+	}
+
+	if len(delegatorsMap) == 0 {
+		return nil
+	}
+
+	resKVs, err := sc.QuerySubspace(types.DelegatorKey, ModuleName)
+	if err != nil {
+		return fmt.Errorf("failed. query delegators error: %w", err)
+	}
+
+	for _, kv := range resKVs {
+		var delegator types.Delegator
+		sc.GetCodec().MustUnmarshalBinaryLengthPrefixed(kv.Value, &delegator)
+		for _, valAddr := range delegator.ValidatorAddresses {
+			valAddrStr := valAddr.String()
+			if delegators, ok := delegatorsMap[valAddrStr]; ok {
+				delegatorsMap[valAddrStr] = append(delegators, delegator)
+			}
+		}
+	}
+
+	for i := range valSetEvents {
+		if valSetEvents[i].Validator != nil {
+			valSetEvents[i].Delegators = delegatorsMap[valSetEvents[i].Validator.OperatorAddress.String()]
+		}
+	}
+	return nil
+}
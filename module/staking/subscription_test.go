@@ -0,0 +1,119 @@
+package staking
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/okex/okchain-go-sdk/mocks"
+	"github.com/okex/okchain-go-sdk/module/staking/types"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+func TestStakingClient_SubscribeValidatorSetUpdates(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	config, err := sdk.NewClientConfig("testURL", "testChain", sdk.BroadcastBlock, "", 200000,
+		1.1, "0.00000001okt")
+	require.NoError(t, err)
+	mockCli := mocks.NewMockClient(t, ctrl, config)
+	mockCli.RegisterModule(NewStakingClient(mockCli.MockBaseClient))
+
+	consPK, err := sdk.GetConsPubKeyBech32(valConsPK)
+	require.NoError(t, err)
+	// a validator unknown by staking
+	otherConsPK := ed25519.GenPrivKey().PubKey()
+	valOperAddr, err := sdk.ValAddressFromBech32(valAddr)
+	require.NoError(t, err)
+	delAddr, err := sdk.AccAddressFromBech32(addr)
+	require.NoError(t, err)
+	otherDelAddr, err := sdk.AccAddressFromBech32(proxyAddr)
+	require.NoError(t, err)
+
+	valKVs := []cmn.KVPair{{
+		Key: types.GetValidatorKey(valOperAddr),
+		Value: mockCli.BuildValidatorBytes(valOperAddr, valConsPK, "node", "", "", "", 2, sdk.OneDec(),
+			sdk.OneDec(), 0, time.Unix(0, 0).UTC(), false),
+	}}
+	delKVs := []cmn.KVPair{
+		{
+			Key: types.GetDelegatorKey(delAddr),
+			Value: mockCli.BuildDelegatorBytes(delAddr, nil, []sdk.ValAddress{valOperAddr}, sdk.OneDec(),
+				sdk.OneDec(), sdk.ZeroDec(), false),
+		},
+		{
+			Key: types.GetDelegatorKey(otherDelAddr),
+			Value: mockCli.BuildDelegatorBytes(otherDelAddr, nil, nil, sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec(),
+				false),
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events := make(chan ctypes.ResultEvent)
+	mockCli.EXPECT().GetCodec().Return(mockCli.GetCodec()).AnyTimes()
+	mockCli.EXPECT().Validators(nil).Return(mockCli.GetRawValidatorsResultPointer(1024, 10, 0, consPK), nil)
+	mockCli.EXPECT().Subscribe(ctx, gomock.Any(), validatorSetUpdatesQuery).
+		Return((<-chan ctypes.ResultEvent)(events), nil)
+	gomock.InOrder(
+		mockCli.EXPECT().QuerySubspace(types.ValidatorsKey, ModuleName).Return(valKVs, nil),
+		mockCli.EXPECT().QuerySubspace(types.DelegatorKey, ModuleName).Return(delKVs, nil),
+		mockCli.EXPECT().QuerySubspace(types.ValidatorsKey, ModuleName).Return(nil, errors.New("default error")),
+	)
+
+	valSetEvents, err := mockCli.Staking().SubscribeValidatorSetUpdates(ctx)
+	require.NoError(t, err)
+
+	events <- ctypes.ResultEvent{
+		Query: validatorSetUpdatesQuery,
+		Data: tmtypes.EventDataValidatorSetUpdates{ValidatorUpdates: []*tmtypes.Validator{
+			tmtypes.NewValidator(consPK, 15),
+			tmtypes.NewValidator(otherConsPK, 5),
+		}},
+	}
+
+	valSetEvent := <-valSetEvents
+	require.NoError(t, valSetEvent.Err)
+	require.Equal(t, types.ValidatorPowerChanged, valSetEvent.Change)
+	require.Equal(t, valConsPK, valSetEvent.ConsPubKey)
+	require.Equal(t, int64(5), valSetEvent.PowerDelta())
+	require.NotNil(t, valSetEvent.Validator)
+	require.Equal(t, "node", valSetEvent.Validator.Description.Moniker)
+	require.Len(t, valSetEvent.Delegators, 1)
+	require.Equal(t, delAddr, valSetEvent.Delegators[0].DelegatorAddress)
+
+	valSetEvent = <-valSetEvents
+	require.NoError(t, valSetEvent.Err)
+	require.Equal(t, types.ValidatorJoined, valSetEvent.Change)
+	require.Equal(t, int64(0), valSetEvent.PrevPower)
+	require.Nil(t, valSetEvent.Validator)
+	require.Empty(t, valSetEvent.Delegators)
+
+	// the powers carried only if the staking queries failed
+	events <- ctypes.ResultEvent{
+		Query: validatorSetUpdatesQuery,
+		Data:  tmtypes.EventDataValidatorSetUpdates{ValidatorUpdates: []*tmtypes.Validator{tmtypes.NewValidator(consPK, 0)}},
+	}
+	valSetEvent = <-valSetEvents
+	require.Error(t, valSetEvent.Err)
+	require.Equal(t, types.ValidatorLeft, valSetEvent.Change)
+	require.Equal(t, int64(15), valSetEvent.PrevPower)
+	require.Equal(t, int64(-15), valSetEvent.PowerDelta())
+
+	// closed with the ctx
+	cancel()
+	_, ok := <-valSetEvents
+	require.False(t, ok)
+
+	// failed to query the validator set
+	mockCli.EXPECT().Validators(nil).Return(nil, errors.New("default error"))
+	_, err = mockCli.Staking().SubscribeValidatorSetUpdates(context.Background())
+	require.Error(t, err)
+}
@@ -1,24 +1,25 @@
 package staking
 
 import (
-	"fmt"
 	"github.com/okex/okchain-go-sdk/module/staking/types"
 	sdk "github.com/okex/okchain-go-sdk/types"
 	"github.com/okex/okchain-go-sdk/types/crypto/keys"
+	sdkerrors "github.com/okex/okchain-go-sdk/types/errors"
 	"github.com/okex/okchain-go-sdk/types/params"
 	"github.com/okex/okchain-go-sdk/utils"
 )
 
 // Delegate delegates okt for voting
-func (sc stakingClient) Delegate(fromInfo keys.Info, passWd, coinsStr, memo string, accNum, seqNum uint64) (
+func (sc stakingClient) Delegate(fromInfo keys.Info, passWd string, amount sdk.Amount, memo string, accNum,
+	seqNum uint64) (
 	resp sdk.TxResponse, err error) {
 	if err = params.CheckKeyParams(fromInfo, passWd); err != nil {
 		return
 	}
 
-	coin, err := sdk.ParseDecCoin(coinsStr)
+	coin, err := amount.Coin()
 	if err != nil {
-		return resp, fmt.Errorf("failed : parse Coins [%s] error: %s", coinsStr, err)
+		return resp, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "failed. invalid amount [%s]: %s", amount, err)
 	}
 
 	msg := types.NewMsgDelegate(fromInfo.GetAddress(), coin)
@@ -27,15 +28,16 @@ func (sc stakingClient) Delegate(fromInfo keys.Info, passWd, coinsStr, memo stri
 }
 
 // Unbond unbonds the delegation on okchain
-func (sc stakingClient) Unbond(fromInfo keys.Info, passWd, coinsStr, memo string, accNum, seqNum uint64) (
+func (sc stakingClient) Unbond(fromInfo keys.Info, passWd string, amount sdk.Amount, memo string, accNum,
+	seqNum uint64) (
 	resp sdk.TxResponse, err error) {
 	if err = params.CheckKeyParams(fromInfo, passWd); err != nil {
 		return
 	}
 
-	coin, err := sdk.ParseDecCoin(coinsStr)
+	coin, err := amount.Coin()
 	if err != nil {
-		return resp, fmt.Errorf("failed : parse Coins [%s] error: %s", coinsStr, err)
+		return resp, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "failed. invalid amount [%s]: %s", amount, err)
 	}
 
 	msg := types.NewMsgUndelegate(fromInfo.GetAddress(), coin)
@@ -53,7 +55,7 @@ func (sc stakingClient) Vote(fromInfo keys.Info, passWd string, valAddrsStr []st
 
 	valAddrs, err := utils.ParseValAddresses(valAddrsStr)
 	if err != nil {
-		return resp, fmt.Errorf("failed. validator address parsed error: %s", err.Error())
+		return resp, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "failed. validator address parsed error: %s", err.Error())
 	}
 
 	msg := types.NewMsgVote(fromInfo.GetAddress(), valAddrs)
@@ -142,7 +144,7 @@ func (sc stakingClient) BindProxy(fromInfo keys.Info, passWd, proxyAddrStr, memo
 
 	proxyAddr, err := sdk.AccAddressFromBech32(proxyAddrStr)
 	if err != nil {
-		return resp, fmt.Errorf("failed. parse Address [%s] error: %s", proxyAddrStr, err)
+		return resp, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "failed. parse Address [%s] error: %s", proxyAddrStr, err)
 	}
 
 	msg := types.NewMsgBindProxy(fromInfo.GetAddress(), proxyAddr)
@@ -148,14 +148,14 @@ func TestStakingClient_Delegate(t *testing.T) {
 		fromInfo.GetName(), passWd, memo, gomock.AssignableToTypeOf([]sdk.Msg{}), accInfo.GetAccountNumber(), accInfo.GetSequence()).
 		Return(mocks.DefaultMockSuccessTxResponse(), nil)
 
-	res, err := mockCli.Staking().Delegate(fromInfo, passWd, "1024.1024okt", memo, accInfo.GetAccountNumber(), accInfo.GetSequence())
+	res, err := mockCli.Staking().Delegate(fromInfo, passWd, sdk.MustParseAmount("1024.1024okt"), memo, accInfo.GetAccountNumber(), accInfo.GetSequence())
 	require.NoError(t, err)
 	require.Equal(t, uint32(0), res.Code)
 
-	_, err = mockCli.Staking().Delegate(fromInfo, passWd, "1024.1024", memo, accInfo.GetAccountNumber(), accInfo.GetSequence())
+	_, err = mockCli.Staking().Delegate(fromInfo, passWd, sdk.Amount{}, memo, accInfo.GetAccountNumber(), accInfo.GetSequence())
 	require.Error(t, err)
 
-	_, err = mockCli.Staking().Delegate(fromInfo, "", "1024.1024okt", memo, accInfo.GetAccountNumber(), accInfo.GetSequence())
+	_, err = mockCli.Staking().Delegate(fromInfo, "", sdk.MustParseAmount("1024.1024okt"), memo, accInfo.GetAccountNumber(), accInfo.GetSequence())
 	require.Error(t, err)
 }
 
@@ -183,14 +183,14 @@ func TestStakingClient_Unbond(t *testing.T) {
 		fromInfo.GetName(), passWd, memo, gomock.AssignableToTypeOf([]sdk.Msg{}), accInfo.GetAccountNumber(), accInfo.GetSequence()).
 		Return(mocks.DefaultMockSuccessTxResponse(), nil)
 
-	res, err := mockCli.Staking().Unbond(fromInfo, passWd, "1024.1024okt", memo, accInfo.GetAccountNumber(), accInfo.GetSequence())
+	res, err := mockCli.Staking().Unbond(fromInfo, passWd, sdk.MustParseAmount("1024.1024okt"), memo, accInfo.GetAccountNumber(), accInfo.GetSequence())
 	require.NoError(t, err)
 	require.Equal(t, uint32(0), res.Code)
 
-	_, err = mockCli.Staking().Unbond(fromInfo, passWd, "1024.1024", memo, accInfo.GetAccountNumber(), accInfo.GetSequence())
+	_, err = mockCli.Staking().Unbond(fromInfo, passWd, sdk.Amount{}, memo, accInfo.GetAccountNumber(), accInfo.GetSequence())
 	require.Error(t, err)
 
-	_, err = mockCli.Staking().Unbond(fromInfo, "", "1024.1024okt", memo, accInfo.GetAccountNumber(), accInfo.GetSequence())
+	_, err = mockCli.Staking().Unbond(fromInfo, "", sdk.MustParseAmount("1024.1024okt"), memo, accInfo.GetAccountNumber(), accInfo.GetSequence())
 	require.Error(t, err)
 }
 
@@ -0,0 +1,21 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/okex/okchain-go-sdk/types"
+)
+
+// ParamsPath is the query path of the staking params
+const ParamsPath = "custom/staking/parameters"
+
+// Params - structure of the params of the staking module
+type Params struct {
+	UnbondingTime     time.Duration `json:"unbonding_time"`
+	MaxValidators     uint16        `json:"max_bonded_validators"`
+	Epoch             uint16        `json:"epoch"`
+	MaxValsToVote     uint16        `json:"max_validators_to_add_shares"`
+	BondDenom         string        `json:"bond_denom"`
+	MinDelegation     sdk.Dec       `json:"min_delegation"`
+	MinSelfDelegation sdk.Dec       `json:"min_self_delegation"`
+}
@@ -17,7 +17,7 @@ const (
 )
 
 var (
-	msgCdc = sdk.NewCodec()
+	msgCdc sdk.SDKCodec
 	// ValidatorsKey is useful for subspace and store query about validator
 	ValidatorsKey = []byte{0x21}
 	// DelegatorKey is useful for subspace and store query about delegator
@@ -25,7 +25,23 @@ var (
 )
 
 func init() {
-	RegisterCodec(msgCdc)
+	sdk.RegisterCodecInitializer(func() {
+		msgCdc = sdk.NewCodec()
+		RegisterCodec(msgCdc)
+	})
+
+	sdk.RegisterMsgOrderRule(sdk.MsgOrderRule{
+		Name:   "unbind-before-bind-proxy",
+		Reason: "a delegator bound to a proxy is refused to bind another one until unbound",
+		Before: func(msg sdk.Msg) bool {
+			_, ok := msg.(MsgUnbindProxy)
+			return ok
+		},
+		After: func(msg sdk.Msg) bool {
+			_, ok := msg.(MsgBindProxy)
+			return ok
+		},
+	})
 }
 
 // RegisterCodec registers the msg type for staking module
@@ -67,10 +83,12 @@ func (vi ValidatorInner) Standardize() (val Validator, err error) {
 		ConsPubKey:              bechConsPubKey,
 		Jailed:                  vi.Jailed,
 		Status:                  vi.Status,
+		Tokens:                  vi.Tokens,
 		DelegatorShares:         vi.DelegatorShares,
 		Description:             vi.Description,
 		UnbondingHeight:         vi.UnbondingHeight,
 		UnbondingCompletionTime: vi.UnbondingCompletionTime,
+		Commission:              vi.Commission,
 		MinSelfDelegation:       vi.MinSelfDelegation,
 	}, err
 }
@@ -161,19 +179,33 @@ func ConvertToDelegatorResp(delegator Delegator, undelegation Undelegation) Dele
 	}
 }
 
+// bond statuses of the validators
+const (
+	Unbonded  byte = 0x00
+	Unbonding byte = 0x01
+	Bonded    byte = 0x02
+)
+
 // Validator is the struct of standard validator's detail info
 type Validator struct {
 	OperatorAddress         sdk.ValAddress `json:"operator_address"`
 	ConsPubKey              string         `json:"consensus_pubkey"`
 	Jailed                  bool           `json:"jailed"`
 	Status                  byte           `json:"status"`
+	Tokens                  sdk.Int        `json:"tokens"`
 	DelegatorShares         sdk.Dec        `json:"delegator_shares"`
 	Description             Description    `json:"description"`
 	UnbondingHeight         int64          `json:"unbonding_height"`
 	UnbondingCompletionTime time.Time      `json:"unbonding_time"`
+	Commission              Commission     `json:"commission"`
 	MinSelfDelegation       sdk.Dec        `json:"min_self_delegation"`
 }
 
+// IsBonded tells whether the validator is in the validator set, i.e. signing the blocks and earning the rewards
+func (v Validator) IsBonded() bool {
+	return v.Status == Bonded
+}
+
 // Description shows the detail info of a validator
 type Description struct {
 	Moniker  string `json:"moniker"`
@@ -194,3 +226,34 @@ type DelegatorResp struct {
 	TotalDelegatedTokens sdk.Dec          `json:"total_delegated_tokens"`
 	ProxyAddress         sdk.AccAddress   `json:"proxy_address"`
 }
+
+// Vote - structure of the shares a delegator or a proxy added to a validator. The shares of a voter are added to each
+// of the validators it voted for, and a proxy votes with the tokens of its bound delegators as well
+type Vote struct {
+	VoterAddress     sdk.AccAddress `json:"voter_address"`
+	ValidatorAddress sdk.ValAddress `json:"validator_address"`
+	Shares           sdk.Dec        `json:"shares"`
+}
+
+// ProxyResp is designed only for proxy query
+type ProxyResp struct {
+	ProxyAddress         sdk.AccAddress   `json:"proxy_address"`
+	ValidatorAddresses   []sdk.ValAddress `json:"validator_address"`
+	Shares               sdk.Dec          `json:"shares"`
+	Tokens               sdk.Dec          `json:"tokens"`
+	TotalDelegatedTokens sdk.Dec          `json:"total_delegated_tokens"`
+	BoundDelegators      []sdk.AccAddress `json:"bound_delegators"`
+}
+
+// GetVotes returns the votes cast by the delegator itself, none if it's bound to a proxy voting on its behalf
+func (d Delegator) GetVotes() []Vote {
+	votes := make([]Vote, len(d.ValidatorAddresses))
+	for i, valAddr := range d.ValidatorAddresses {
+		votes[i] = Vote{
+			VoterAddress:     d.DelegatorAddress,
+			ValidatorAddress: valAddr,
+			Shares:           d.Shares,
+		}
+	}
+	return votes
+}
@@ -0,0 +1,31 @@
+package types
+
+// ValidatorSetChange is the kind of a change to the validator set
+type ValidatorSetChange string
+
+// kinds of the changes to the validator set
+const (
+	ValidatorJoined       ValidatorSetChange = "joined"
+	ValidatorLeft         ValidatorSetChange = "left"
+	ValidatorPowerChanged ValidatorSetChange = "power_changed"
+)
+
+// ValidatorSetEvent - structure of a change of a validator in the validator set with its staking context
+type ValidatorSetEvent struct {
+	Change ValidatorSetChange
+	// ConsPubKey is the bech32 consensus pubkey of the validator
+	ConsPubKey string
+	PrevPower  int64
+	Power      int64
+	// Validator is the staking info of the validator, nil if not found in staking
+	Validator *Validator
+	// Delegators are the delegators voting for the validator, who are affected by the change
+	Delegators []Delegator
+	// Err is the failure of the staking queries, with which the event carries the powers only
+	Err error
+}
+
+// PowerDelta returns the change of the voting power of the validator
+func (vse ValidatorSetEvent) PowerDelta() int64 {
+	return vse.Power - vse.PrevPower
+}
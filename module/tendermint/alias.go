@@ -9,10 +9,11 @@ const (
 
 type (
 	// nolint
-	Block            = types.Block
-	BlockResults     = types.BlockResults
-	ResultCommit     = types.ResultCommit
-	ResultValidators = types.ResultValidators
-	ResultTx         = types.ResultTx
-	ResultTxs        = types.ResultTxs
+	Block             = types.Block
+	BlockResults      = types.BlockResults
+	ResultCommit      = types.ResultCommit
+	ResultValidators  = types.ResultValidators
+	ResultTx          = types.ResultTx
+	ResultTxs         = types.ResultTxs
+	BlockTimeEstimate = types.BlockTimeEstimate
 )
@@ -0,0 +1,48 @@
+package tendermint
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/okex/okchain-go-sdk/module/tendermint/types"
+	"github.com/okex/okchain-go-sdk/utils"
+)
+
+// QueryLatestCommitResult gets the commit info of the latest block
+func (tc tendermintClient) QueryLatestCommitResult() (commitResult types.ResultCommit, err error) {
+	pTmCommitResult, err := tc.Commit(nil)
+	if err != nil {
+		return
+	}
+
+	return utils.ParseCommitResult(pTmCommitResult), err
+}
+
+// EstimateBlockTime estimates the block time by the average interval of the latest window blocks
+func (tc tendermintClient) EstimateBlockTime(window int64) (estimate types.BlockTimeEstimate, err error) {
+	if window <= 0 {
+		return estimate, fmt.Errorf("failed. window of the block time estimation must be positive: %d", window)
+	}
+
+	latest, err := tc.QueryLatestCommitResult()
+	if err != nil {
+		return
+	}
+
+	estimate.LatestHeight, estimate.LatestTime = latest.Height, latest.Time
+	startHeight := latest.Height - window
+	if startHeight < 1 {
+		startHeight = 1
+	}
+	if startHeight == latest.Height {
+		return estimate, fmt.Errorf("failed. no block before the latest height %d for the estimation", latest.Height)
+	}
+
+	start, err := tc.QueryCommitResult(startHeight)
+	if err != nil {
+		return
+	}
+
+	estimate.BlockInterval = latest.Time.Sub(start.Time) / time.Duration(latest.Height-startHeight)
+	return
+}
@@ -0,0 +1,29 @@
+package exposed
+
+import (
+	"github.com/okex/okchain-go-sdk/module/tendermint/types"
+	sdk "github.com/okex/okchain-go-sdk/types"
+)
+
+// Tendermint shows the expected behavior for inner tendermint client
+type Tendermint interface {
+	sdk.Module
+	TendermintQuery
+}
+
+// TendermintQuery shows the expected query behavior for inner tendermint client
+type TendermintQuery interface {
+	QueryBlock(height int64) (types.Block, error)
+	QueryBlockResults(height int64) (types.BlockResults, error)
+	QueryCommitResult(height int64) (types.ResultCommit, error)
+	QueryLatestCommitResult() (types.ResultCommit, error)
+	// EstimateBlockTime estimates the block time by the average interval of the latest window blocks
+	EstimateBlockTime(window int64) (types.BlockTimeEstimate, error)
+	QueryValidatorsResult(height int64) (types.ResultValidators, error)
+	// QueryStatus gets the status of the node, which is a health signal of the failover
+	QueryStatus() (types.ResultStatus, error)
+	QueryTxResult(txHash []byte, prove bool) (types.ResultTx, error)
+	// QueryTxsResult assumes the node to query a truth teller
+	QueryTxsResult(queryStr string, page, perPage int) (types.ResultTxs, error)
+	QueryTxsResultPaged(queryStr string, pageReq sdk.PageRequest) (types.ResultTxs, sdk.PagedResult, error)
+}
@@ -3,6 +3,7 @@ package tendermint
 import (
 	"fmt"
 	"github.com/okex/okchain-go-sdk/module/tendermint/types"
+	sdk "github.com/okex/okchain-go-sdk/types"
 	"github.com/okex/okchain-go-sdk/types/params"
 	"github.com/okex/okchain-go-sdk/utils"
 	tmtypes "github.com/tendermint/tendermint/types"
@@ -39,6 +40,16 @@ func (tc tendermintClient) QueryCommitResult(height int64) (commitResult types.R
 	return utils.ParseCommitResult(pTmCommitResult), err
 }
 
+// QueryStatus gets the status of the node, i.e. its latest block and whether it's catching up
+func (tc tendermintClient) QueryStatus() (status types.ResultStatus, err error) {
+	pTmStatusResult, err := tc.Status()
+	if err != nil {
+		return
+	}
+
+	return utils.ParseStatusResult(pTmStatusResult), err
+}
+
 // QueryValidatorsResult gets the validators info on a specific height
 func (tc tendermintClient) QueryValidatorsResult(height int64) (valsResult types.ResultValidators, err error) {
 	pTmValsResult, err := tc.Validators(&height)
@@ -83,6 +94,20 @@ func (tc tendermintClient) QueryTxsResult(searchStr string, page, perPage int) (
 	return utils.ParseTxsResult(pTmTxsResult), err
 }
 
+// QueryTxsResultPaged gets txs result by a specific searching string on a page with the paging info
+func (tc tendermintClient) QueryTxsResultPaged(searchStr string, pageReq sdk.PageRequest) (txsResult types.ResultTxs,
+	pagedRes sdk.PagedResult, err error) {
+	if err = pageReq.ValidateBasic(); err != nil {
+		return
+	}
+
+	if txsResult, err = tc.QueryTxsResult(searchStr, pageReq.Page, pageReq.Limit); err != nil {
+		return
+	}
+
+	return txsResult, sdk.NewPagedResult(pageReq, len(txsResult.Txs), txsResult.TotalCount), err
+}
+
 func parseSearchingStr(searchStr string) (tmEventStrs []string, err error) {
 	var events []string
 	searchStr = strings.TrimSpace(searchStr)
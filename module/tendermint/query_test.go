@@ -8,6 +8,8 @@ import (
 	sdk "github.com/okex/okchain-go-sdk/types"
 	"github.com/stretchr/testify/require"
 	cmn "github.com/tendermint/tendermint/libs/common"
+	"github.com/tendermint/tendermint/p2p"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
 	tmtypes "github.com/tendermint/tendermint/types"
 	"testing"
 	"time"
@@ -107,6 +109,48 @@ func TestTendermintClient_QueryCommitResult(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestTendermintClient_EstimateBlockTime(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	config, err := sdk.NewClientConfig("testURL", "testChain", sdk.BroadcastBlock, "", 200000,
+		1.1, "0.00000001okt")
+	require.NoError(t, err)
+	mockCli := mocks.NewMockClient(t, ctrl, config)
+	mockCli.RegisterModule(NewTendermintClient(mockCli.MockBaseClient))
+
+	latestHeight, startHeight, latestTime := int64(1024), int64(924), time.Now()
+	appHash, blockIDHash := cmn.HexBytes("default app hash"), cmn.HexBytes("default block ID hash")
+
+	latestRet := mockCli.GetRawCommitResultPointer(true, "default chainID", latestHeight, latestTime, appHash,
+		blockIDHash)
+	startRet := mockCli.GetRawCommitResultPointer(true, "default chainID", startHeight,
+		latestTime.Add(-300*time.Second), appHash, blockIDHash)
+	mockCli.EXPECT().Commit(gomock.Nil()).Return(latestRet, nil)
+	mockCli.EXPECT().Commit(gomock.Eq(&startHeight)).Return(startRet, nil)
+
+	estimate, err := mockCli.Tendermint().EstimateBlockTime(100)
+	require.NoError(t, err)
+	require.Equal(t, latestHeight, estimate.LatestHeight)
+	require.True(t, latestTime.Equal(estimate.LatestTime))
+	require.Equal(t, 3*time.Second, estimate.BlockInterval)
+	require.Equal(t, latestHeight+4, estimate.HeightAt(latestTime.Add(10*time.Second)))
+	require.Equal(t, latestHeight, estimate.HeightAt(latestTime.Add(-time.Minute)))
+	require.True(t, latestTime.Add(30*time.Second).Equal(estimate.TimeAt(latestHeight+10)))
+
+	// window beyond the genesis block
+	latestRet = mockCli.GetRawCommitResultPointer(true, "default chainID", 1, latestTime, appHash, blockIDHash)
+	mockCli.EXPECT().Commit(gomock.Nil()).Return(latestRet, nil)
+	_, err = mockCli.Tendermint().EstimateBlockTime(100)
+	require.Error(t, err)
+
+	mockCli.EXPECT().Commit(gomock.Nil()).Return(nil, errors.New("default error"))
+	_, err = mockCli.Tendermint().EstimateBlockTime(100)
+	require.Error(t, err)
+
+	_, err = mockCli.Tendermint().EstimateBlockTime(0)
+	require.Error(t, err)
+}
+
 func TestTendermintClient_QueryValidatorsResult(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -135,6 +179,38 @@ func TestTendermintClient_QueryValidatorsResult(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestTendermintClient_QueryStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	config, err := sdk.NewClientConfig("testURL", "testChain", sdk.BroadcastBlock, "", 200000,
+		1.1, "0.00000001okt")
+	require.NoError(t, err)
+	mockCli := mocks.NewMockClient(t, ctrl, config)
+	mockCli.RegisterModule(NewTendermintClient(mockCli.MockBaseClient))
+
+	blockTime := time.Now().Add(-time.Minute)
+	expectedRet := &ctypes.ResultStatus{
+		NodeInfo: p2p.DefaultNodeInfo{ID_: "node id", Network: "testChain", Moniker: "node"},
+		SyncInfo: ctypes.SyncInfo{LatestBlockHeight: 1024, LatestBlockTime: blockTime},
+	}
+	mockCli.EXPECT().Status().Return(expectedRet, nil)
+
+	status, err := mockCli.Tendermint().QueryStatus()
+	require.NoError(t, err)
+	require.Equal(t, "node id", status.NodeID)
+	require.Equal(t, "testChain", status.Network)
+	require.Equal(t, int64(1024), status.LatestBlockHeight)
+	require.True(t, blockTime.Equal(status.LatestBlockTime))
+	require.True(t, status.IsHealthy(time.Hour))
+	require.False(t, status.IsHealthy(time.Second))
+	status.CatchingUp = true
+	require.False(t, status.IsHealthy(time.Hour))
+
+	mockCli.EXPECT().Status().Return(nil, errors.New("default error"))
+	_, err = mockCli.Tendermint().QueryStatus()
+	require.Error(t, err)
+}
+
 func TestTendermintClient_QueryTxResult(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
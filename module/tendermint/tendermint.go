@@ -1,11 +1,17 @@
 package tendermint
 
 import (
-	"github.com/okex/okchain-go-sdk/exposed"
+	"github.com/okex/okchain-go-sdk/module/tendermint/exposed"
 	"github.com/okex/okchain-go-sdk/module/tendermint/types"
 	sdk "github.com/okex/okchain-go-sdk/types"
 )
 
+func init() {
+	sdk.RegisterModule(types.ModuleName, func(baseClient sdk.BaseClient) sdk.Module {
+		return NewTendermintClient(baseClient)
+	})
+}
+
 var _ sdk.Module = (*tendermintClient)(nil)
 
 type tendermintClient struct {
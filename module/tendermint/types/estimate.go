@@ -0,0 +1,31 @@
+package types
+
+import (
+	"time"
+)
+
+// BlockTimeEstimate - structure of the block time estimation by the average interval of the latest blocks
+type BlockTimeEstimate struct {
+	LatestHeight  int64
+	LatestTime    time.Time
+	BlockInterval time.Duration
+}
+
+// HeightAt estimates the height of the first block committed at or after the time t
+func (bte BlockTimeEstimate) HeightAt(t time.Time) int64 {
+	if !t.After(bte.LatestTime) || bte.BlockInterval <= 0 {
+		return bte.LatestHeight
+	}
+
+	elapsed := t.Sub(bte.LatestTime)
+	blocks := int64(elapsed / bte.BlockInterval)
+	if elapsed%bte.BlockInterval != 0 {
+		blocks++
+	}
+	return bte.LatestHeight + blocks
+}
+
+// TimeAt estimates the time when the block of the height is committed
+func (bte BlockTimeEstimate) TimeAt(height int64) time.Time {
+	return bte.LatestTime.Add(time.Duration(height-bte.LatestHeight) * bte.BlockInterval)
+}
@@ -0,0 +1,33 @@
+package types
+
+import (
+	"time"
+
+	cmn "github.com/tendermint/tendermint/libs/common"
+)
+
+// ResultStatus - structure of the status of the node, i.e. its identity and how far it's synced
+type ResultStatus struct {
+	NodeID  string
+	Moniker string
+	// Network is the chain ID of the node
+	Network           string
+	Version           string
+	LatestBlockHash   cmn.HexBytes
+	LatestAppHash     cmn.HexBytes
+	LatestBlockHeight int64
+	LatestBlockTime   time.Time
+	// CatchingUp tells the node is still syncing the blocks from its peers
+	CatchingUp bool
+}
+
+// Lag returns the time from the latest block of the node to now
+func (rs ResultStatus) Lag(now time.Time) time.Duration {
+	return now.Sub(rs.LatestBlockTime)
+}
+
+// IsHealthy tells the node has caught up with its peers and its latest block is within the max lag, so that it's safe
+// to broadcast against
+func (rs ResultStatus) IsHealthy(maxLag time.Duration) bool {
+	return !rs.CatchingUp && rs.Lag(time.Now()) <= maxLag
+}
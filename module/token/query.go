@@ -82,3 +82,18 @@ func (tc tokenClient) QueryTokenInfo(ownerAddr, symbol string) (tokens []types.T
 	return
 
 }
+
+// QueryTokenParams gets the params of the token module, i.e. the fees of issuing, minting, burning, modifying and
+// transferring the ownership of a token
+func (tc tokenClient) QueryTokenParams() (tokenParams types.Params, err error) {
+	res, err := tc.Query(types.ParamsPath, nil)
+	if err != nil {
+		return tokenParams, utils.ErrClientQuery(err.Error())
+	}
+
+	if err = tc.GetCodec().UnmarshalJSON(res, &tokenParams); err != nil {
+		return tokenParams, utils.ErrUnmarshalJSON(err.Error())
+	}
+
+	return
+}
@@ -176,3 +176,32 @@ func TestTokenClient_QueryTokenInfo(t *testing.T) {
 	_, err = mockCli.Token().QueryTokenInfo(addr, "")
 	require.Error(t, err)
 }
+
+func TestTokenClient_QueryTokenParams(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	config, err := sdk.NewClientConfig("testURL", "testChain", sdk.BroadcastBlock, "", 200000,
+		1.1, "0.00000001okt")
+	require.NoError(t, err)
+	mockCli := mocks.NewMockClient(t, ctrl, config)
+	mockCli.RegisterModule(NewTokenClient(mockCli.MockBaseClient))
+
+	issueFee := sdk.NewDecCoinFromDec("okt", sdk.NewDec(20000))
+	expectedCdc := mockCli.GetCodec()
+	expectedRet := expectedCdc.MustMarshalJSON(types.Params{FeeIssue: issueFee})
+
+	mockCli.EXPECT().GetCodec().Return(expectedCdc)
+	mockCli.EXPECT().Query(types.ParamsPath, nil).Return(expectedRet, nil)
+	tokenParams, err := mockCli.Token().QueryTokenParams()
+	require.NoError(t, err)
+	require.Equal(t, issueFee.String(), tokenParams.FeeIssue.String())
+
+	mockCli.EXPECT().Query(types.ParamsPath, nil).Return(nil, errors.New("default error"))
+	_, err = mockCli.Token().QueryTokenParams()
+	require.Error(t, err)
+
+	mockCli.EXPECT().GetCodec().Return(expectedCdc)
+	mockCli.EXPECT().Query(types.ParamsPath, nil).Return(expectedRet[1:], nil)
+	_, err = mockCli.Token().QueryTokenParams()
+	require.Error(t, err)
+}
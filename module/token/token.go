@@ -1,11 +1,17 @@
 package token
 
 import (
-	"github.com/okex/okchain-go-sdk/exposed"
+	"github.com/okex/okchain-go-sdk/module/token/exposed"
 	"github.com/okex/okchain-go-sdk/module/token/types"
 	sdk "github.com/okex/okchain-go-sdk/types"
 )
 
+func init() {
+	sdk.RegisterModule(types.ModuleName, func(baseClient sdk.BaseClient) sdk.Module {
+		return NewTokenClient(baseClient)
+	})
+}
+
 var _ sdk.Module = (*tokenClient)(nil)
 
 type tokenClient struct {
@@ -0,0 +1,145 @@
+package token
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/okex/okchain-go-sdk/module/token/types"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+)
+
+const (
+	transfersPerPage = 100
+
+	eventTypeTransfer     = "transfer"
+	eventTypeMessage      = "message"
+	attributeKeySender    = "sender"
+	attributeKeyRecipient = "recipient"
+	attributeKeyAmount    = "amount"
+)
+
+// QueryTransfers gets all the transfers of the denom to or from the address committed in the height range
+// [fromHeight, toHeight], where toHeight 0 means the latest. The txs are searched on the transfer events by the address
+// and the denom is filtered on the client side, so the node is required to index the events
+func (tc tokenClient) QueryTransfers(addrStr, denom string, fromHeight, toHeight int64) (transfers []types.Transfer,
+	err error) {
+	if _, err = sdk.AccAddressFromBech32(addrStr); err != nil {
+		return nil, fmt.Errorf("failed. accAddress %s converted from Bech32 error", addrStr)
+	}
+	if len(denom) == 0 {
+		return nil, errors.New("failed. empty denom")
+	}
+	if fromHeight <= 0 || (toHeight != 0 && toHeight < fromHeight) {
+		return nil, fmt.Errorf("failed. invalid height range [%d, %d]", fromHeight, toHeight)
+	}
+
+	heightCond := fmt.Sprintf("tx.height>=%d", fromHeight)
+	if toHeight != 0 {
+		heightCond += fmt.Sprintf(" AND tx.height<=%d", toHeight)
+	}
+
+	// the sender is attached to the message event if the transfer event has none
+	searched := make(map[string]bool)
+	for _, cond := range []string{
+		fmt.Sprintf("%s.%s='%s'", eventTypeTransfer, attributeKeyRecipient, addrStr),
+		fmt.Sprintf("%s.%s='%s'", eventTypeTransfer, attributeKeySender, addrStr),
+		fmt.Sprintf("%s.%s='%s'", eventTypeMessage, attributeKeySender, addrStr),
+	} {
+		if err = tc.searchTransfers(cond+" AND "+heightCond, addrStr, denom, searched, &transfers); err != nil {
+			return nil, err
+		}
+	}
+
+	sort.SliceStable(transfers, func(i, j int) bool {
+		return transfers[i].Height < transfers[j].Height
+	})
+	return
+}
+
+// searchTransfers searches the txs by the query on all the pages, and collects the transfers of the txs unsearched
+func (tc tokenClient) searchTransfers(query, addrStr, denom string, searched map[string]bool,
+	transfers *[]types.Transfer) error {
+	return sdk.FetchAllPages(sdk.NewPageRequest(1, transfersPerPage), func(pr sdk.PageRequest) (sdk.PagedResult, error) {
+		res, err := tc.TxSearch(query, false, pr.Page, pr.Limit)
+		if err != nil {
+			return sdk.PagedResult{}, fmt.Errorf("failed. search txs by %s error: %w", query, err)
+		}
+
+		for _, tx := range res.Txs {
+			hash := tx.Hash.String()
+			if searched[hash] {
+				continue
+			}
+			searched[hash] = true
+			*transfers = append(*transfers, parseTransfers(tx, addrStr, denom)...)
+		}
+		return sdk.NewPagedResult(pr, len(res.Txs), res.TotalCount), nil
+	})
+}
+
+// parseTransfers parses the transfers of the denom involving the address from the events of the committed tx. Each
+// recipient attribute starts a new transfer, so the transfers flattened into one event are parsed as well
+func parseTransfers(tx *ctypes.ResultTx, addrStr, denom string) (transfers []types.Transfer) {
+	if tx.TxResult.Code != 0 {
+		return
+	}
+
+	var msgSender string
+	for _, event := range tx.TxResult.Events {
+		if event.Type != eventTypeMessage {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == attributeKeySender {
+				msgSender = string(attr.Value)
+			}
+		}
+	}
+
+	for _, event := range tx.TxResult.Events {
+		if event.Type != eventTypeTransfer {
+			continue
+		}
+
+		var parsed []types.Transfer
+		amounts := make([]string, 0)
+		for _, attr := range event.Attributes {
+			value := string(attr.Value)
+			switch string(attr.Key) {
+			case attributeKeyRecipient:
+				parsed = append(parsed, types.Transfer{Recipient: value, Sender: msgSender})
+				amounts = append(amounts, "")
+			case attributeKeySender:
+				if len(parsed) != 0 {
+					parsed[len(parsed)-1].Sender = value
+				}
+			case attributeKeyAmount:
+				if len(amounts) != 0 {
+					amounts[len(amounts)-1] = value
+				}
+			}
+		}
+
+		for i, transfer := range parsed {
+			if transfer.Sender != addrStr && transfer.Recipient != addrStr {
+				continue
+			}
+			coins, err := sdk.ParseDecCoins(strings.TrimSpace(amounts[i]))
+			if err != nil {
+				continue
+			}
+			amount := coins.AmountOf(denom)
+			if !amount.IsPositive() {
+				continue
+			}
+
+			transfer.Height, transfer.TxHash = tx.Height, tx.Hash.String()
+			transfer.Amount = sdk.NewDecCoinFromDec(denom, amount)
+			transfers = append(transfers, transfer)
+		}
+	}
+	return
+}
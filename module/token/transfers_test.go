@@ -0,0 +1,102 @@
+package token
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/okex/okchain-go-sdk/mocks"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+)
+
+func newTransferTx(hash string, height int64, code uint32, events ...abci.Event) *ctypes.ResultTx {
+	return &ctypes.ResultTx{
+		Hash:     cmn.HexBytes(hash),
+		Height:   height,
+		TxResult: abci.ResponseDeliverTx{Code: code, Events: events},
+	}
+}
+
+func newABCIEvent(eventType string, kvs ...string) abci.Event {
+	event := abci.Event{Type: eventType}
+	for i := 0; i < len(kvs); i += 2 {
+		event.Attributes = append(event.Attributes, cmn.KVPair{Key: []byte(kvs[i]), Value: []byte(kvs[i+1])})
+	}
+	return event
+}
+
+func TestTokenClient_QueryTransfers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	config, err := sdk.NewClientConfig("testURL", "testChain", sdk.BroadcastBlock, "", 200000,
+		1.1, "0.00000001okt")
+	require.NoError(t, err)
+	mockCli := mocks.NewMockClient(t, ctrl, config)
+	mockCli.RegisterModule(NewTokenClient(mockCli.MockBaseClient))
+
+	received := &ctypes.ResultTxSearch{
+		Txs: []*ctypes.ResultTx{
+			newTransferTx("tx2", 20, 0, newABCIEvent("message", "sender", recAddr),
+				newABCIEvent("transfer", "recipient", addr, "amount", "1.00000000btc-000,2.00000000okt")),
+			// transfers flattened with another recipient
+			newTransferTx("tx3", 30, 0, newABCIEvent("transfer", "recipient", recAddr, "sender", addr, "amount",
+				"5.00000000btc-000", "recipient", addr, "sender", recAddr, "amount", "3.00000000btc-000")),
+			// failed
+			newTransferTx("tx4", 40, 5, newABCIEvent("transfer", "recipient", addr, "amount", "1.00000000btc-000")),
+		},
+		TotalCount: 3,
+	}
+	sent := &ctypes.ResultTxSearch{
+		Txs: []*ctypes.ResultTx{
+			newTransferTx("tx1", 10, 0, newABCIEvent("transfer", "recipient", recAddr, "sender", addr, "amount",
+				"0.50000000btc-000")),
+			received.Txs[1],
+			// other denoms only
+			newTransferTx("tx5", 50, 0, newABCIEvent("transfer", "recipient", recAddr, "sender", addr, "amount",
+				"0.50000000okt")),
+		},
+		TotalCount: 3,
+	}
+	mockCli.EXPECT().TxSearch("transfer.recipient='"+addr+"' AND tx.height>=10 AND tx.height<=50", false, 1, 100).
+		Return(received, nil)
+	mockCli.EXPECT().TxSearch("transfer.sender='"+addr+"' AND tx.height>=10 AND tx.height<=50", false, 1, 100).
+		Return(sent, nil)
+	mockCli.EXPECT().TxSearch("message.sender='"+addr+"' AND tx.height>=10 AND tx.height<=50", false, 1, 100).
+		Return(&ctypes.ResultTxSearch{}, nil)
+
+	transfers, err := mockCli.Token().QueryTransfers(addr, tokenSymbol, 10, 50)
+	require.NoError(t, err)
+	require.Len(t, transfers, 4)
+	require.Equal(t, int64(10), transfers[0].Height)
+	require.Equal(t, addr, transfers[0].Sender)
+	require.Equal(t, "0.50000000btc-000", transfers[0].Amount.String())
+	// the sender of the message event
+	require.Equal(t, int64(20), transfers[1].Height)
+	require.Equal(t, recAddr, transfers[1].Sender)
+	require.Equal(t, addr, transfers[1].Recipient)
+	require.Equal(t, "1.00000000btc-000", transfers[1].Amount.String())
+	require.Equal(t, cmn.HexBytes("tx2").String(), transfers[1].TxHash)
+	require.Equal(t, "5.00000000btc-000", transfers[2].Amount.String())
+	require.Equal(t, recAddr, transfers[2].Recipient)
+	require.Equal(t, "3.00000000btc-000", transfers[3].Amount.String())
+	require.Equal(t, addr, transfers[3].Recipient)
+
+	// up to the latest
+	mockCli.EXPECT().TxSearch("transfer.recipient='"+addr+"' AND tx.height>=10", false, 1, 100).
+		Return(nil, errors.New("default error"))
+	_, err = mockCli.Token().QueryTransfers(addr, tokenSymbol, 10, 0)
+	require.Error(t, err)
+
+	_, err = mockCli.Token().QueryTransfers(addr[1:], tokenSymbol, 10, 50)
+	require.Error(t, err)
+	_, err = mockCli.Token().QueryTransfers(addr, "", 10, 50)
+	require.Error(t, err)
+	_, err = mockCli.Token().QueryTransfers(addr, tokenSymbol, 0, 50)
+	require.Error(t, err)
+	_, err = mockCli.Token().QueryTransfers(addr, tokenSymbol, 60, 50)
+	require.Error(t, err)
+}
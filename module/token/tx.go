@@ -1,15 +1,16 @@
 package token
 
 import (
-	"fmt"
 	"github.com/okex/okchain-go-sdk/module/token/types"
 	sdk "github.com/okex/okchain-go-sdk/types"
 	"github.com/okex/okchain-go-sdk/types/crypto/keys"
+	sdkerrors "github.com/okex/okchain-go-sdk/types/errors"
 	"github.com/okex/okchain-go-sdk/types/params"
 )
 
 // Send transfers coins to other receiver
-func (tc tokenClient) Send(fromInfo keys.Info, passWd, toAddrStr, coinsStr, memo string, accNum, seqNum uint64) (
+func (tc tokenClient) Send(fromInfo keys.Info, passWd, toAddrStr string, amount sdk.Amount, memo string, accNum,
+	seqNum uint64) (
 	resp sdk.TxResponse, err error) {
 	if err = params.CheckSendParams(fromInfo, passWd, toAddrStr); err != nil {
 		return
@@ -17,15 +18,14 @@ func (tc tokenClient) Send(fromInfo keys.Info, passWd, toAddrStr, coinsStr, memo
 
 	toAddr, err := sdk.AccAddressFromBech32(toAddrStr)
 	if err != nil {
-		return resp, fmt.Errorf("failed. parse Address [%s] error: %s", toAddrStr, err)
+		return resp, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "failed. parse Address [%s] error: %s", toAddrStr, err)
 	}
 
-	coins, err := sdk.ParseDecCoins(coinsStr)
-	if err != nil {
-		return resp, fmt.Errorf("failed. parse DecCoins [%s] error: %s", coinsStr, err)
+	if err = amount.Validate(); err != nil {
+		return resp, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "failed. invalid amount: %s", err)
 	}
 
-	msg := types.NewMsgTokenSend(fromInfo.GetAddress(), toAddr, coins)
+	msg := types.NewMsgTokenSend(fromInfo.GetAddress(), toAddr, amount.Coins())
 
 	return tc.BuildAndBroadcast(fromInfo.GetName(), passWd, memo, []sdk.Msg{msg}, accNum, seqNum)
 
@@ -58,15 +58,15 @@ func (tc tokenClient) Issue(fromInfo keys.Info, passWd, orgSymbol, wholeName, to
 }
 
 // Mint increases the total supply of a kind of token by its owner
-func (tc tokenClient) Mint(fromInfo keys.Info, passWd, coinsStr, memo string, accNum, seqNum uint64) (resp sdk.TxResponse,
-	err error) {
+func (tc tokenClient) Mint(fromInfo keys.Info, passWd string, amount sdk.Amount, memo string, accNum, seqNum uint64) (
+	resp sdk.TxResponse, err error) {
 	if err = params.CheckKeyParams(fromInfo, passWd); err != nil {
 		return
 	}
 
-	coin, err := sdk.ParseDecCoin(coinsStr)
+	coin, err := amount.Coin()
 	if err != nil {
-		return resp, fmt.Errorf("failed : parse Coins [%s] error: %s", coinsStr, err)
+		return resp, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "failed. invalid amount [%s]: %s", amount, err)
 	}
 
 	msg := types.NewMsgTokenMint(coin, fromInfo.GetAddress())
@@ -76,15 +76,15 @@ func (tc tokenClient) Mint(fromInfo keys.Info, passWd, coinsStr, memo string, ac
 }
 
 // Burn decreases the total supply of a kind of token by burning a specific amount of that from the own account
-func (tc tokenClient) Burn(fromInfo keys.Info, passWd, coinsStr, memo string, accNum, seqNum uint64) (resp sdk.TxResponse,
-	err error) {
+func (tc tokenClient) Burn(fromInfo keys.Info, passWd string, amount sdk.Amount, memo string, accNum, seqNum uint64) (
+	resp sdk.TxResponse, err error) {
 	if err = params.CheckKeyParams(fromInfo, passWd); err != nil {
 		return
 	}
 
-	coin, err := sdk.ParseDecCoin(coinsStr)
+	coin, err := amount.Coin()
 	if err != nil {
-		return resp, fmt.Errorf("failed : parse Coins [%s] error: %s", coinsStr, err)
+		return resp, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "failed. invalid amount [%s]: %s", amount, err)
 	}
 
 	msg := types.NewMsgTokenBurn(coin, fromInfo.GetAddress())
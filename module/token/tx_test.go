@@ -38,26 +38,26 @@ func TestTokenClient_Send(t *testing.T) {
 	mockCli.EXPECT().BuildAndBroadcast(
 		fromInfo.GetName(), passWd, memo, gomock.AssignableToTypeOf([]sdk.Msg{}), accInfo.GetAccountNumber(),
 		accInfo.GetSequence()).Return(mocks.DefaultMockSuccessTxResponse(), nil)
-	res, err := mockCli.Token().Send(fromInfo, passWd, recAddr, "10.24okt", memo, accInfo.GetAccountNumber(),
-		accInfo.GetSequence())
+	res, err := mockCli.Token().Send(fromInfo, passWd, recAddr, sdk.MustParseAmount("10.24okt"), memo,
+		accInfo.GetAccountNumber(), accInfo.GetSequence())
 	require.NoError(t, err)
 	require.Equal(t, uint32(0), res.Code)
 
-	res, err = mockCli.Token().Send(fromInfo, passWd, recAddr[1:], "10.24okt", memo, accInfo.GetAccountNumber(),
-		accInfo.GetSequence())
+	res, err = mockCli.Token().Send(fromInfo, passWd, recAddr[1:], sdk.MustParseAmount("10.24okt"), memo,
+		accInfo.GetAccountNumber(), accInfo.GetSequence())
 	require.Error(t, err)
 
-	_, err = mockCli.Token().Send(fromInfo, "", recAddr, "10.24okt", memo, accInfo.GetAccountNumber(),
-		accInfo.GetSequence())
+	_, err = mockCli.Token().Send(fromInfo, "", recAddr, sdk.MustParseAmount("10.24okt"), memo,
+		accInfo.GetAccountNumber(), accInfo.GetSequence())
 	require.Error(t, err)
 
-	_, err = mockCli.Token().Send(fromInfo, passWd, recAddr, "10.24", memo, accInfo.GetAccountNumber(),
+	_, err = mockCli.Token().Send(fromInfo, passWd, recAddr, sdk.Amount{}, memo, accInfo.GetAccountNumber(),
 		accInfo.GetSequence())
 	require.Error(t, err)
 
 	badBech32Addr := fmt.Sprintf("%s1", recAddr[:len(recAddr)-1])
-	_, err = mockCli.Token().Send(fromInfo, passWd, badBech32Addr, "10.24okt", memo, accInfo.GetAccountNumber(),
-		accInfo.GetSequence())
+	_, err = mockCli.Token().Send(fromInfo, passWd, badBech32Addr, sdk.MustParseAmount("10.24okt"), memo,
+		accInfo.GetAccountNumber(), accInfo.GetSequence())
 	require.Error(t, err)
 }
 
@@ -190,24 +190,24 @@ func TestTokenClient_Mint(t *testing.T) {
 		fromInfo.GetName(), passWd, memo, gomock.AssignableToTypeOf([]sdk.Msg{}), accInfo.GetAccountNumber(), accInfo.GetSequence()).
 		Return(mocks.DefaultMockSuccessTxResponse(), nil)
 
-	res, err := mockCli.Token().Mint(fromInfo, passWd, "1024.1024okt", memo, accInfo.GetAccountNumber(),
-		accInfo.GetSequence())
+	res, err := mockCli.Token().Mint(fromInfo, passWd, sdk.MustParseAmount("1024.1024okt"), memo,
+		accInfo.GetAccountNumber(), accInfo.GetSequence())
 	require.NoError(t, err)
 	require.Equal(t, uint32(0), res.Code)
 
-	res, err = mockCli.Token().Mint(fromInfo, passWd, "1024.1024", memo, accInfo.GetAccountNumber(),
+	res, err = mockCli.Token().Mint(fromInfo, passWd, sdk.Amount{}, memo, accInfo.GetAccountNumber(),
 		accInfo.GetSequence())
 	require.Error(t, err)
 
-	res, err = mockCli.Token().Mint(fromInfo, "", "1024.1024okt", memo, accInfo.GetAccountNumber(),
+	res, err = mockCli.Token().Mint(fromInfo, "", sdk.MustParseAmount("1024.1024okt"), memo, accInfo.GetAccountNumber(),
 		accInfo.GetSequence())
 	require.Error(t, err)
 
 	mockCli.EXPECT().BuildAndBroadcast(
 		fromInfo.GetName(), passWd, memo, gomock.AssignableToTypeOf([]sdk.Msg{}), accInfo.GetAccountNumber(), accInfo.GetSequence()).
 		Return(sdk.TxResponse{}, errors.New("default error"))
-	res, err = mockCli.Token().Mint(fromInfo, passWd, "1024.1024okt", memo, accInfo.GetAccountNumber(),
-		accInfo.GetSequence())
+	res, err = mockCli.Token().Mint(fromInfo, passWd, sdk.MustParseAmount("1024.1024okt"), memo,
+		accInfo.GetAccountNumber(), accInfo.GetSequence())
 	require.Error(t, err)
 
 }
@@ -236,24 +236,24 @@ func TestTokenClient_Burn(t *testing.T) {
 		fromInfo.GetName(), passWd, memo, gomock.AssignableToTypeOf([]sdk.Msg{}), accInfo.GetAccountNumber(), accInfo.GetSequence()).
 		Return(mocks.DefaultMockSuccessTxResponse(), nil)
 
-	res, err := mockCli.Token().Burn(fromInfo, passWd, "1024.1024okt", memo, accInfo.GetAccountNumber(),
-		accInfo.GetSequence())
+	res, err := mockCli.Token().Burn(fromInfo, passWd, sdk.MustParseAmount("1024.1024okt"), memo,
+		accInfo.GetAccountNumber(), accInfo.GetSequence())
 	require.NoError(t, err)
 	require.Equal(t, uint32(0), res.Code)
 
-	res, err = mockCli.Token().Burn(fromInfo, passWd, "1024.1024", memo, accInfo.GetAccountNumber(),
+	res, err = mockCli.Token().Burn(fromInfo, passWd, sdk.Amount{}, memo, accInfo.GetAccountNumber(),
 		accInfo.GetSequence())
 	require.Error(t, err)
 
-	res, err = mockCli.Token().Burn(fromInfo, "", "1024.1024okt", memo, accInfo.GetAccountNumber(),
+	res, err = mockCli.Token().Burn(fromInfo, "", sdk.MustParseAmount("1024.1024okt"), memo, accInfo.GetAccountNumber(),
 		accInfo.GetSequence())
 	require.Error(t, err)
 
 	mockCli.EXPECT().BuildAndBroadcast(
 		fromInfo.GetName(), passWd, memo, gomock.AssignableToTypeOf([]sdk.Msg{}), accInfo.GetAccountNumber(), accInfo.GetSequence()).
 		Return(sdk.TxResponse{}, errors.New("default error"))
-	res, err = mockCli.Token().Burn(fromInfo, passWd, "1024.1024okt", memo, accInfo.GetAccountNumber(),
-		accInfo.GetSequence())
+	res, err = mockCli.Token().Burn(fromInfo, passWd, sdk.MustParseAmount("1024.1024okt"), memo,
+		accInfo.GetAccountNumber(), accInfo.GetSequence())
 	require.Error(t, err)
 
 }
@@ -25,6 +25,11 @@ func (msg MsgSend) GetSignBytes() []byte {
 	return sdk.MustSortJSON(msgCdc.MustMarshalJSON(msg))
 }
 
+// GetRecipients returns the receiver of the transfer
+func (msg MsgSend) GetRecipients() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.ToAddress}
+}
+
 // nolint
 func (MsgSend) Route() string                { return "" }
 func (MsgSend) Type() string                 { return "" }
@@ -50,6 +55,15 @@ func (msg MsgMultiSend) GetSignBytes() []byte {
 	return sdk.MustSortJSON(msgCdc.MustMarshalJSON(msg))
 }
 
+// GetRecipients returns all the receivers of the multi-send
+func (msg MsgMultiSend) GetRecipients() []sdk.AccAddress {
+	recipients := make([]sdk.AccAddress, len(msg.Transfers))
+	for i, transfer := range msg.Transfers {
+		recipients[i] = transfer.To
+	}
+	return recipients
+}
+
 // nolint
 func (MsgMultiSend) Route() string                { return "" }
 func (MsgMultiSend) Type() string                 { return "" }
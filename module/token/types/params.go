@@ -0,0 +1,17 @@
+package types
+
+import (
+	sdk "github.com/okex/okchain-go-sdk/types"
+)
+
+// ParamsPath is the query path of the token params
+const ParamsPath = "custom/token/params"
+
+// Params - structure of the params of the token module, i.e. the fees of the token operations
+type Params struct {
+	FeeIssue  sdk.DecCoin `json:"issue_fee"`
+	FeeMint   sdk.DecCoin `json:"mint_fee"`
+	FeeBurn   sdk.DecCoin `json:"burn_fee"`
+	FeeModify sdk.DecCoin `json:"modify_fee"`
+	FeeChown  sdk.DecCoin `json:"transfer_ownership_fee"`
+}
@@ -12,11 +12,14 @@ const (
 )
 
 var (
-	msgCdc = sdk.NewCodec()
+	msgCdc sdk.SDKCodec
 )
 
 func init() {
-	RegisterCodec(msgCdc)
+	sdk.RegisterCodecInitializer(func() {
+		msgCdc = sdk.NewCodec()
+		RegisterCodec(msgCdc)
+	})
 }
 
 // RegisterCodec registers the msg type for token module
@@ -30,10 +33,7 @@ func RegisterCodec(cdc sdk.SDKCodec) {
 }
 
 // TransferUnit - amount part for multi-send
-type TransferUnit struct {
-	To    sdk.AccAddress `json:"to"`
-	Coins sdk.DecCoins   `json:"coins"`
-}
+type TransferUnit = sdk.TransferUnit
 
 // NewTransferUnit creates a new instance of TransferUnit
 func NewTransferUnit(addr sdk.AccAddress, coins sdk.DecCoins) TransferUnit {
@@ -68,3 +68,12 @@ type CoinInfo struct {
 	Freeze    string `json:"freeze"`
 	Locked    string `json:"locked"`
 }
+
+// Transfer - structure of a transfer of a denom recorded by a transfer event of a committed tx
+type Transfer struct {
+	Height    int64       `json:"height"`
+	TxHash    string      `json:"tx_hash"`
+	Sender    string      `json:"sender"`
+	Recipient string      `json:"recipient"`
+	Amount    sdk.DecCoin `json:"amount"`
+}
@@ -0,0 +1,51 @@
+package module
+
+import (
+	"context"
+
+	sdk "github.com/okex/okchain-go-sdk/types"
+)
+
+var (
+	_ sdk.Tracer = nopTracer{}
+	_ sdk.Span   = nopSpan{}
+)
+
+// nopTracer - structure of the tracer without any tracing, used if the tracer of the config isn't set
+type nopTracer struct{}
+
+// Start implements the sdk.Tracer interface
+func (nopTracer) Start(ctx context.Context, _ string, _ ...sdk.SpanAttribute) (context.Context, sdk.Span) {
+	return ctx, nopSpan{}
+}
+
+type nopSpan struct{}
+
+func (nopSpan) SetAttributes(...sdk.SpanAttribute) {}
+func (nopSpan) RecordError(error)                  {}
+func (nopSpan) End()                               {}
+
+// tracer returns the tracer of the config, or the one without any tracing
+func (bc *baseClient) tracer() sdk.Tracer {
+	tracer := bc.ctx.Config().Tracer
+	if tracer == nil {
+		return nopTracer{}
+	}
+	return tracer
+}
+
+// txContext returns the ctx of the tx options, the background one by default
+func txContext(options sdk.TxOptions) context.Context {
+	if options.Context == nil {
+		return context.Background()
+	}
+	return options.Context
+}
+
+// endSpan ends the span with the error recorded if any
+func endSpan(span sdk.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+	}
+	span.End()
+}
@@ -0,0 +1,183 @@
+package module
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/okex/okchain-go-sdk/mocks"
+	tokentypes "github.com/okex/okchain-go-sdk/module/token/types"
+	"github.com/okex/okchain-go-sdk/testutil"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	sdkerrors "github.com/okex/okchain-go-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+)
+
+type spanKey struct{}
+
+// recordSpan records a span with its parent
+type recordSpan struct {
+	name   string
+	parent *recordSpan
+	attrs  map[string]interface{}
+	err    error
+	ended  bool
+}
+
+func (rs *recordSpan) SetAttributes(attrs ...sdk.SpanAttribute) {
+	for _, attr := range attrs {
+		rs.attrs[attr.Key] = attr.Value
+	}
+}
+
+func (rs *recordSpan) RecordError(err error) { rs.err = err }
+func (rs *recordSpan) End()                  { rs.ended = true }
+
+// recordTracer records the spans started with the parents in the ctx
+type recordTracer struct {
+	spans []*recordSpan
+}
+
+func (rt *recordTracer) Start(ctx context.Context, spanName string, attrs ...sdk.SpanAttribute) (context.Context,
+	sdk.Span) {
+	parent, _ := ctx.Value(spanKey{}).(*recordSpan)
+	span := &recordSpan{name: spanName, parent: parent, attrs: make(map[string]interface{})}
+	span.SetAttributes(attrs...)
+	rt.spans = append(rt.spans, span)
+	return context.WithValue(ctx, spanKey{}, span), span
+}
+
+func TestBaseClient_Tracer(t *testing.T) {
+	config, err := sdk.NewClientConfig("testURL", "testChain", sdk.BroadcastBlock, "0.01okt", 200000,
+		0, "")
+	require.NoError(t, err)
+	tracer := new(recordTracer)
+	config.Tracer = tracer
+	backend := &broadcastBackend{ConformanceBackend: mocks.NewConformanceBackend()}
+	bc := NewBaseClientWithRPC(mocks.NewFixtureCodec(), &config, backend)
+
+	user := testutil.NewAccounts(t, testutil.FixtureAccountsSeed, 1)[0]
+	coins, err := sdk.ParseDecCoins("10.24okt")
+	require.NoError(t, err)
+	msgs := []sdk.Msg{tokentypes.NewMsgTokenSend(user.Address, user.Address, coins)}
+
+	// under the span of the caller
+	ctx, parent := tracer.Start(context.Background(), "caller")
+	resp, err := bc.BuildAndBroadcastWithSigner(user.Signer(), "my memo", msgs, 1, 2, sdk.WithContext(ctx))
+	require.Error(t, err)
+	require.Len(t, tracer.spans, 5)
+	lifecycle, build, sign, broadcast := tracer.spans[1], tracer.spans[2], tracer.spans[3], tracer.spans[4]
+
+	require.Equal(t, sdk.SpanTxLifecycle, lifecycle.name)
+	require.Equal(t, parent, lifecycle.parent)
+	require.Equal(t, "token", lifecycle.attrs[sdk.AttributeKeyMsgModules])
+	require.Equal(t, "MsgSend", lifecycle.attrs[sdk.AttributeKeyMsgTypes])
+	require.Equal(t, resp.TxHash, lifecycle.attrs[sdk.AttributeKeyTxHash])
+	require.Error(t, lifecycle.err)
+
+	require.Equal(t, sdk.SpanTxBuild, build.name)
+	require.Equal(t, lifecycle, build.parent)
+	require.Equal(t, resp.TxHash, build.attrs[sdk.AttributeKeyTxHash])
+	require.Equal(t, user.Address.String(), build.attrs[sdk.AttributeKeySigner])
+	require.Equal(t, uint64(2), build.attrs[sdk.AttributeKeySequence])
+	require.NoError(t, build.err)
+
+	require.Equal(t, sdk.SpanTxSign, sign.name)
+	require.Equal(t, build, sign.parent)
+
+	require.Equal(t, sdk.SpanTxBroadcast, broadcast.name)
+	require.Equal(t, lifecycle, broadcast.parent)
+	require.Equal(t, string(sdk.BroadcastBlock), broadcast.attrs[sdk.AttributeKeyBroadcastMode])
+	require.Equal(t, int64(1024), broadcast.attrs[sdk.AttributeKeyHeight])
+	require.Equal(t, uint32(5), broadcast.attrs[sdk.AttributeKeyCode])
+	require.Error(t, broadcast.err)
+	for _, span := range tracer.spans[1:] {
+		require.True(t, span.ended)
+	}
+
+	// the failure of building
+	tracer.spans = nil
+	_, err = bc.BuildStdTxWithSigner(user.Signer(), "my memo", msgs, 1, 2, sdk.WithFeePayer("unknown", "", 3, 4))
+	require.Error(t, err)
+	require.Len(t, tracer.spans, 1)
+	require.Nil(t, tracer.spans[0].parent)
+	require.Equal(t, err, tracer.spans[0].err)
+	require.True(t, tracer.spans[0].ended)
+}
+
+// confirmBackend commits the txs broadcast after they're polled for a number of times
+type confirmBackend struct {
+	*broadcastBackend
+	pending int
+}
+
+func (cb *confirmBackend) Tx(hash []byte, _ bool) (*ctypes.ResultTx, error) {
+	if cb.pending > 0 {
+		cb.pending--
+		return nil, errors.New("tx not found")
+	}
+	for _, tx := range cb.txs {
+		if bytes.Equal(tx.Hash(), hash) {
+			return &ctypes.ResultTx{Hash: hash, Height: 1025, TxResult: abci.ResponseDeliverTx{GasUsed: 10}, Tx: tx}, nil
+		}
+	}
+	return nil, errors.New("tx not found")
+}
+
+func TestBaseClient_TracerConfirm(t *testing.T) {
+	defer func(interval time.Duration) { confirmPollInterval = interval }(confirmPollInterval)
+	confirmPollInterval = time.Millisecond
+
+	config, err := sdk.NewClientConfig("testURL", "testChain", sdk.BroadcastSync, "0.01okt", 200000,
+		0, "")
+	require.NoError(t, err)
+	tracer := new(recordTracer)
+	config.Tracer = tracer
+	config.SequenceManager = sdk.NewSequenceManager(sdk.QueuePolicy{})
+	backend := &confirmBackend{broadcastBackend: &broadcastBackend{ConformanceBackend: mocks.NewConformanceBackend()},
+		pending: 2}
+	bc := NewBaseClientWithRPC(mocks.NewFixtureCodec(), &config, backend)
+
+	user := testutil.NewAccounts(t, testutil.FixtureAccountsSeed, 1)[0]
+	coins, err := sdk.ParseDecCoins("10.24okt")
+	require.NoError(t, err)
+	msgs := []sdk.Msg{tokentypes.NewMsgTokenSend(user.Address, user.Address, coins)}
+
+	resp, err := bc.BuildAndBroadcastWithSigner(user.Signer(), "my memo", msgs, 1, 2,
+		sdk.WithConfirmation(time.Second))
+	require.NoError(t, err)
+	require.Equal(t, int64(1025), resp.Height)
+	require.Equal(t, int64(10), resp.GasUsed)
+	require.Len(t, tracer.spans, 5)
+	lifecycle, confirm := tracer.spans[0], tracer.spans[4]
+	require.Equal(t, sdk.SpanTxBroadcast, tracer.spans[3].name)
+	require.Equal(t, sdk.SpanTxConfirm, confirm.name)
+	require.Equal(t, lifecycle, confirm.parent)
+	require.Equal(t, resp.TxHash, confirm.attrs[sdk.AttributeKeyTxHash])
+	require.Equal(t, int64(1025), confirm.attrs[sdk.AttributeKeyHeight])
+	require.NoError(t, confirm.err)
+	require.True(t, confirm.ended)
+
+	// not committed in time
+	tracer.spans, backend.pending = nil, 1<<20
+	_, err = bc.BuildAndBroadcastWithSigner(user.Signer(), "my memo", msgs, 1, 3,
+		sdk.WithConfirmation(10*time.Millisecond))
+	require.True(t, errors.Is(err, sdkerrors.ErrTxTimeout))
+	require.Len(t, tracer.spans, 5)
+	require.Equal(t, err, tracer.spans[4].err)
+	// the sequence is taken by the tx accepted by the mempool
+	next, ok := config.SequenceManager.Next(user.Address)
+	require.True(t, ok)
+	require.Equal(t, uint64(4), next)
+
+	// no confirmation without the option
+	tracer.spans = nil
+	resp, err = bc.BuildAndBroadcastWithSigner(user.Signer(), "my memo", msgs, 1, 4)
+	require.NoError(t, err)
+	require.Zero(t, resp.Height)
+	require.Len(t, tracer.spans, 4)
+}
@@ -0,0 +1,103 @@
+package module
+
+import (
+	"context"
+
+	sdk "github.com/okex/okchain-go-sdk/types"
+	sdkerrors "github.com/okex/okchain-go-sdk/types/errors"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+)
+
+var _ sdk.BaseClient = unavailableBaseClient{}
+
+// unavailableBaseClient - structure of the base client of a module unavailable on the node or not registered in the
+// client, which refuses the queries, the txs and the subscriptions of the module with ErrModuleUnavailable instead of
+// sending them to the node
+type unavailableBaseClient struct {
+	sdk.BaseClient
+	moduleName string
+	reason     string
+}
+
+// NewUnavailableBaseClient creates the base client of the module unavailable for the reason
+func NewUnavailableBaseClient(baseClient sdk.BaseClient, moduleName, reason string) sdk.BaseClient {
+	return unavailableBaseClient{
+		BaseClient: baseClient,
+		moduleName: moduleName,
+		reason:     reason,
+	}
+}
+
+func (ubc unavailableBaseClient) err() error {
+	return sdkerrors.Wrapf(sdkerrors.ErrModuleUnavailable, "failed. module %s is unavailable: %s",
+		ubc.moduleName, ubc.reason)
+}
+
+// Query refuses the query of the module
+func (ubc unavailableBaseClient) Query(string, cmn.HexBytes) ([]byte, error) {
+	return nil, ubc.err()
+}
+
+// QueryStore refuses the store query of the module
+func (ubc unavailableBaseClient) QueryStore(cmn.HexBytes, string, string) ([]byte, error) {
+	return nil, ubc.err()
+}
+
+// QueryStoreWithProof refuses the store query of the module
+func (ubc unavailableBaseClient) QueryStoreWithProof(cmn.HexBytes, string, string, int64) (sdk.StoreQueryResult,
+	error) {
+	return sdk.StoreQueryResult{}, ubc.err()
+}
+
+// QuerySubspace refuses the subspace query of the module
+func (ubc unavailableBaseClient) QuerySubspace([]byte, string) ([]cmn.KVPair, error) {
+	return nil, ubc.err()
+}
+
+// BuildAndBroadcast refuses the tx of the module
+func (ubc unavailableBaseClient) BuildAndBroadcast(string, string, string, []sdk.Msg, uint64, uint64,
+	...sdk.TxOption) (sdk.TxResponse, error) {
+	return sdk.TxResponse{}, ubc.err()
+}
+
+// BuildStdTx refuses the tx of the module
+func (ubc unavailableBaseClient) BuildStdTx(string, string, string, []sdk.Msg, uint64, uint64, ...sdk.TxOption) (
+	sdk.StdTx, error) {
+	return sdk.StdTx{}, ubc.err()
+}
+
+// BuildSignedTx refuses the tx of the module
+func (ubc unavailableBaseClient) BuildSignedTx(string, string, string, []sdk.Msg, uint64, uint64, ...sdk.TxOption) (
+	sdk.SignedTx, error) {
+	return sdk.SignedTx{}, ubc.err()
+}
+
+// BuildAndBroadcastWithSigner refuses the tx of the module
+func (ubc unavailableBaseClient) BuildAndBroadcastWithSigner(sdk.Signer, string, []sdk.Msg, uint64, uint64,
+	...sdk.TxOption) (sdk.TxResponse, error) {
+	return sdk.TxResponse{}, ubc.err()
+}
+
+// BuildStdTxWithSigner refuses the tx of the module
+func (ubc unavailableBaseClient) BuildStdTxWithSigner(sdk.Signer, string, []sdk.Msg, uint64, uint64,
+	...sdk.TxOption) (sdk.StdTx, error) {
+	return sdk.StdTx{}, ubc.err()
+}
+
+// BuildSignedTxWithSigner refuses the tx of the module
+func (ubc unavailableBaseClient) BuildSignedTxWithSigner(sdk.Signer, string, []sdk.Msg, uint64, uint64,
+	...sdk.TxOption) (sdk.SignedTx, error) {
+	return sdk.SignedTx{}, ubc.err()
+}
+
+// BuildSignDoc refuses the tx of the module
+func (ubc unavailableBaseClient) BuildSignDoc(sdk.Signer, string, []sdk.Msg, uint64, uint64, ...sdk.TxOption) (
+	sdk.SignDoc, error) {
+	return sdk.SignDoc{}, ubc.err()
+}
+
+// Subscribe refuses the subscription of the module
+func (ubc unavailableBaseClient) Subscribe(context.Context, string, string) (<-chan ctypes.ResultEvent, error) {
+	return nil, ubc.err()
+}
@@ -0,0 +1,90 @@
+package gosdk
+
+import (
+	"sync"
+
+	sdk "github.com/okex/okchain-go-sdk/types"
+)
+
+// capabilityState - structure of the capabilities detected, shared by the copies and the views of the client, whose
+// version is bumped on every detection for the module sets to rebuild their modules
+type capabilityState struct {
+	mtx          sync.RWMutex
+	capabilities sdk.Capabilities
+	version      uint64
+}
+
+func (cs *capabilityState) get() (sdk.Capabilities, uint64) {
+	cs.mtx.RLock()
+	defer cs.mtx.RUnlock()
+	return cs.capabilities, cs.version
+}
+
+func (cs *capabilityState) set(capabilities sdk.Capabilities) {
+	cs.mtx.Lock()
+	defer cs.mtx.Unlock()
+	cs.capabilities = capabilities
+	cs.version++
+}
+
+// moduleSet - structure of the modules of a client on its base client, shared by the value copies of the client
+// The modules are never changed in place. A new map is built and swapped under the lock once the capabilities are
+// detected or the base client is replaced, so that the modules are safe to get from the concurrent goroutines
+type moduleSet struct {
+	mtx          sync.RWMutex
+	baseClient   sdk.BaseClient
+	moduleNames  []string
+	modules      map[string]sdk.Module
+	capabilities *capabilityState
+	// version of the capabilities that the modules are built with
+	version uint64
+}
+
+func newModuleSet(baseClient sdk.BaseClient, moduleNames []string, capabilities *capabilityState) *moduleSet {
+	ms := &moduleSet{
+		baseClient:   baseClient,
+		moduleNames:  moduleNames,
+		capabilities: capabilities,
+	}
+	ms.modules, ms.version = ms.build(baseClient)
+	return ms
+}
+
+// build creates a new map of the modules on the base client by the capabilities detected
+func (ms *moduleSet) build(baseClient sdk.BaseClient) (map[string]sdk.Module, uint64) {
+	capabilities, version := ms.capabilities.get()
+	modules := make(map[string]sdk.Module, len(ms.moduleNames))
+	for _, mod := range newModules(baseClient, ms.moduleNames, capabilities) {
+		modules[mod.Name()] = mod
+	}
+	return modules, version
+}
+
+// get gets the module by its name, where the modules are rebuilt first if the capabilities are detected after they
+// were built
+func (ms *moduleSet) get(moduleName string) (sdk.Module, bool) {
+	_, version := ms.capabilities.get()
+	ms.mtx.RLock()
+	if ms.version == version {
+		mod, ok := ms.modules[moduleName]
+		ms.mtx.RUnlock()
+		return mod, ok
+	}
+	ms.mtx.RUnlock()
+
+	ms.mtx.Lock()
+	defer ms.mtx.Unlock()
+	if ms.version != version {
+		ms.modules, ms.version = ms.build(ms.baseClient)
+	}
+	mod, ok := ms.modules[moduleName]
+	return mod, ok
+}
+
+// reset swaps the base client and the modules rebuilt on it
+func (ms *moduleSet) reset(baseClient sdk.BaseClient) {
+	modules, version := ms.build(baseClient)
+	ms.mtx.Lock()
+	defer ms.mtx.Unlock()
+	ms.baseClient, ms.modules, ms.version = baseClient, modules, version
+}
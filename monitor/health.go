@@ -0,0 +1,123 @@
+package monitor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	tmtypes "github.com/okex/okchain-go-sdk/module/tendermint/types"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+)
+
+// HealthSource shows the expected behavior of the node queried for the chain health, whose peers and mempool are only
+// served by the tendermint rpc
+type HealthSource interface {
+	QueryLatestCommitResult() (tmtypes.ResultCommit, error)
+	NetInfo() (*ctypes.ResultNetInfo, error)
+	NumUnconfirmedTxs() (*ctypes.ResultUnconfirmedTxs, error)
+}
+
+// HealthConfig - structure of the config of the chain health monitor
+type HealthConfig struct {
+	// Interval is the pause between two checks
+	Interval time.Duration
+}
+
+// HealthReport - structure of the result of a check of the chain health monitor
+type HealthReport struct {
+	LatestHeight   int64
+	SinceLastBlock time.Duration
+	Peers          int
+	MempoolTxs     int
+	// Errs are the errors of the gauges failed to query, keyed by the gauge names, which keep their last values
+	Errs map[string]error
+}
+
+// HealthMonitor - structure of the monitor of the chain health derived from the node, which publishes the latest
+// height, the time since the last block, the peer count and the mempool size as the gauges of the collector, so that
+// a gosdk instance doubles as a lightweight chain monitor
+type HealthMonitor struct {
+	source    HealthSource
+	collector sdk.MetricsCollector
+	config    HealthConfig
+	now       func() time.Time
+}
+
+// NewHealthMonitor creates a new instance of HealthMonitor publishing the gauges through the collector
+func NewHealthMonitor(source HealthSource, collector sdk.MetricsCollector, config HealthConfig) (*HealthMonitor,
+	error) {
+	if source == nil || collector == nil {
+		return nil, errors.New("failed. nil health source or metrics collector")
+	}
+	if config.Interval < 0 {
+		return nil, errors.New("failed. interval must not be negative")
+	}
+
+	return &HealthMonitor{
+		source:    source,
+		collector: collector,
+		config:    config,
+		now:       time.Now,
+	}, nil
+}
+
+// Check queries the chain health once and sets the gauges queried successfully
+func (m *HealthMonitor) Check() (report HealthReport) {
+	addErr := func(gauge string, err error) {
+		if report.Errs == nil {
+			report.Errs = make(map[string]error)
+		}
+		report.Errs[gauge] = err
+	}
+
+	if commit, err := m.source.QueryLatestCommitResult(); err != nil {
+		err = fmt.Errorf("failed. query latest block error: %w", err)
+		addErr(sdk.GaugeLatestHeight, err)
+		addErr(sdk.GaugeSecondsSinceLastBlock, err)
+	} else {
+		report.LatestHeight = commit.Height
+		report.SinceLastBlock = m.now().Sub(commit.Time)
+		m.collector.SetGauge(sdk.GaugeLatestHeight, float64(report.LatestHeight))
+		m.collector.SetGauge(sdk.GaugeSecondsSinceLastBlock, report.SinceLastBlock.Seconds())
+	}
+
+	if netInfo, err := m.source.NetInfo(); err != nil {
+		addErr(sdk.GaugePeers, fmt.Errorf("failed. query net info error: %w", err))
+	} else {
+		report.Peers = netInfo.NPeers
+		m.collector.SetGauge(sdk.GaugePeers, float64(report.Peers))
+	}
+
+	if unconfirmed, err := m.source.NumUnconfirmedTxs(); err != nil {
+		addErr(sdk.GaugeMempoolTxs, fmt.Errorf("failed. query mempool error: %w", err))
+	} else {
+		report.MempoolTxs = unconfirmed.Total
+		m.collector.SetGauge(sdk.GaugeMempoolTxs, float64(report.MempoolTxs))
+	}
+	return
+}
+
+// Run checks the chain health by the interval until the ctx is done, and sends the reports to the channel returned.
+// The channel is closed when the ctx is done
+func (m *HealthMonitor) Run(ctx context.Context) <-chan HealthReport {
+	reports := make(chan HealthReport, 1)
+	go func() {
+		defer close(reports)
+		for {
+			select {
+			case reports <- m.Check():
+			case <-ctx.Done():
+				return
+			}
+
+			select {
+			case <-time.After(m.config.Interval):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return reports
+}
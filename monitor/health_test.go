@@ -0,0 +1,76 @@
+package monitor
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	tmtypes "github.com/okex/okchain-go-sdk/module/tendermint/types"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	"github.com/stretchr/testify/require"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+)
+
+// fakeHealthSource serves the chain health, or fails the net info query if netErr is set
+type fakeHealthSource struct {
+	blockTime time.Time
+	netErr    error
+}
+
+func (fs *fakeHealthSource) QueryLatestCommitResult() (tmtypes.ResultCommit, error) {
+	var commit tmtypes.ResultCommit
+	commit.Height, commit.Time = 1024, fs.blockTime
+	return commit, nil
+}
+
+func (fs *fakeHealthSource) NetInfo() (*ctypes.ResultNetInfo, error) {
+	if fs.netErr != nil {
+		return nil, fs.netErr
+	}
+	return &ctypes.ResultNetInfo{NPeers: 8}, nil
+}
+
+func (fs *fakeHealthSource) NumUnconfirmedTxs() (*ctypes.ResultUnconfirmedTxs, error) {
+	return &ctypes.ResultUnconfirmedTxs{Count: 30, Total: 42}, nil
+}
+
+func TestHealthMonitor_Check(t *testing.T) {
+	now := time.Now()
+	source := &fakeHealthSource{blockTime: now.Add(-3 * time.Second)}
+	gauges := sdk.NewGaugeSet()
+	monitor, err := NewHealthMonitor(source, gauges, HealthConfig{})
+	require.NoError(t, err)
+	monitor.now = func() time.Time { return now }
+
+	report := monitor.Check()
+	require.Empty(t, report.Errs)
+	require.Equal(t, int64(1024), report.LatestHeight)
+	require.Equal(t, 3*time.Second, report.SinceLastBlock)
+	require.Equal(t, 8, report.Peers)
+	require.Equal(t, 42, report.MempoolTxs)
+
+	var buf bytes.Buffer
+	require.NoError(t, gauges.WritePrometheus(&buf))
+	require.Equal(t, "# TYPE okchain_latest_block_height gauge\nokchain_latest_block_height 1024\n"+
+		"# TYPE okchain_mempool_txs gauge\nokchain_mempool_txs 42\n"+
+		"# TYPE okchain_peers gauge\nokchain_peers 8\n"+
+		"# TYPE okchain_seconds_since_last_block gauge\nokchain_seconds_since_last_block 3\n", buf.String())
+
+	// the peers kept after the failure
+	source.netErr = errors.New("default error")
+	source.blockTime = now
+	report = monitor.Check()
+	require.Len(t, report.Errs, 1)
+	require.Error(t, report.Errs[sdk.GaugePeers])
+	peers, ok := gauges.Gauge(sdk.GaugePeers)
+	require.True(t, ok)
+	require.Equal(t, float64(8), peers)
+	sinceLastBlock, _ := gauges.Gauge(sdk.GaugeSecondsSinceLastBlock)
+	require.Zero(t, sinceLastBlock)
+
+	_, err = NewHealthMonitor(nil, gauges, HealthConfig{})
+	require.Error(t, err)
+	_, err = NewHealthMonitor(source, gauges, HealthConfig{Interval: -1})
+	require.Error(t, err)
+}
@@ -0,0 +1,203 @@
+package monitor
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// ParamsFetcher fetches the params of a module, which are compared by their json fields
+type ParamsFetcher func() (interface{}, error)
+
+// ParamsConfig - structure of the config of the params monitor
+type ParamsConfig struct {
+	// Interval is the pause between two checks
+	Interval time.Duration
+}
+
+// ParamChange - structure of a change of a param of a module
+type ParamChange struct {
+	Module string
+	// Key is the json path of the param in the params of the module, e.g. "fee_per_block.amount"
+	Key string
+	// Old and New are the values of the param in json, the strings unquoted, and empty if the param is added or
+	// removed
+	Old string
+	New string
+}
+
+// String returns the readable text of the change
+func (pc ParamChange) String() string {
+	return fmt.Sprintf("%s.%s: %s -> %s", pc.Module, pc.Key, strconv.Quote(pc.Old), strconv.Quote(pc.New))
+}
+
+// ParamsReport - structure of the result of a check of the params monitor
+type ParamsReport struct {
+	Changes []ParamChange
+	// Errs are the errors of the modules whose params failed to fetch, which are compared on the next check instead
+	Errs map[string]error
+}
+
+// IsEmpty tells whether the report has neither changes nor errors
+func (pr ParamsReport) IsEmpty() bool {
+	return len(pr.Changes) == 0 && len(pr.Errs) == 0
+}
+
+// ParamsMonitor - structure of the monitor of the params of the modules, which tells the changes made by governance
+// so that the fee and precision assumptions of the integrators are adjusted instead of breaking silently
+type ParamsMonitor struct {
+	sources map[string]ParamsFetcher
+	modules []string
+	config  ParamsConfig
+	// last holds the params of each module fetched by the last successful check, flattened by their json paths
+	last map[string]map[string]string
+}
+
+// NewParamsMonitor creates a new instance of ParamsMonitor with the fetchers of the params keyed by the module names
+func NewParamsMonitor(sources map[string]ParamsFetcher, config ParamsConfig) (*ParamsMonitor, error) {
+	if len(sources) == 0 {
+		return nil, errors.New("failed. no params to monitor")
+	}
+	if config.Interval < 0 {
+		return nil, errors.New("failed. interval must not be negative")
+	}
+
+	modules := make([]string, 0, len(sources))
+	for module, fetcher := range sources {
+		if fetcher == nil {
+			return nil, fmt.Errorf("failed. nil params fetcher of module %s", module)
+		}
+		modules = append(modules, module)
+	}
+	sort.Strings(modules)
+
+	return &ParamsMonitor{
+		sources: sources,
+		modules: modules,
+		config:  config,
+		last:    make(map[string]map[string]string),
+	}, nil
+}
+
+// Check fetches the params of all the modules once and reports the changes since the last check. The params fetched
+// by the first check of a module are the base of the comparison without any change reported
+func (m *ParamsMonitor) Check() (report ParamsReport) {
+	for _, module := range m.modules {
+		params, err := m.fetch(module)
+		if err != nil {
+			if report.Errs == nil {
+				report.Errs = make(map[string]error)
+			}
+			report.Errs[module] = err
+			continue
+		}
+
+		if last, ok := m.last[module]; ok {
+			report.Changes = append(report.Changes, diffParams(module, last, params)...)
+		}
+		m.last[module] = params
+	}
+	return
+}
+
+// fetch fetches the params of the module and flattens them by their json paths
+func (m *ParamsMonitor) fetch(module string) (map[string]string, error) {
+	params, err := m.sources[module]()
+	if err != nil {
+		return nil, fmt.Errorf("failed. query params of module %s error: %w", module, err)
+	}
+
+	bz, err := json.Marshal(params)
+	if err != nil {
+		return nil, fmt.Errorf("failed. marshal params of module %s error: %w", module, err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(bz))
+	decoder.UseNumber()
+	var value interface{}
+	if err = decoder.Decode(&value); err != nil {
+		return nil, fmt.Errorf("failed. unmarshal params of module %s error: %w", module, err)
+	}
+
+	flattened := make(map[string]string)
+	flattenParams("", value, flattened)
+	return flattened, nil
+}
+
+// flattenParams flattens the json objects into the values keyed by their paths joined with dots. The arrays are kept
+// as their whole json values
+func flattenParams(path string, value interface{}, flattened map[string]string) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			if len(path) != 0 {
+				key = path + "." + key
+			}
+			flattenParams(key, field, flattened)
+		}
+	case string:
+		flattened[path] = v
+	default:
+		bz, _ := json.Marshal(v)
+		flattened[path] = string(bz)
+	}
+}
+
+// diffParams returns the changes between the flattened params sorted by their keys
+func diffParams(module string, last, params map[string]string) (changes []ParamChange) {
+	keys := make(map[string]bool, len(params))
+	for key := range last {
+		keys[key] = true
+	}
+	for key := range params {
+		keys[key] = true
+	}
+
+	sortedKeys := make([]string, 0, len(keys))
+	for key := range keys {
+		sortedKeys = append(sortedKeys, key)
+	}
+	sort.Strings(sortedKeys)
+
+	for _, key := range sortedKeys {
+		if last[key] != params[key] {
+			changes = append(changes, ParamChange{
+				Module: module,
+				Key:    key,
+				Old:    last[key],
+				New:    params[key],
+			})
+		}
+	}
+	return
+}
+
+// Run checks the params by the interval until the ctx is done, and sends the reports with any change or error to the
+// channel returned. The channel is closed when the ctx is done
+func (m *ParamsMonitor) Run(ctx context.Context) <-chan ParamsReport {
+	reports := make(chan ParamsReport, 1)
+	go func() {
+		defer close(reports)
+		for {
+			if report := m.Check(); !report.IsEmpty() {
+				select {
+				case reports <- report:
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			select {
+			case <-time.After(m.config.Interval):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return reports
+}
@@ -0,0 +1,80 @@
+package monitor
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	ordertypes "github.com/okex/okchain-go-sdk/module/order/types"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParamsMonitor_Check(t *testing.T) {
+	orderParams := ordertypes.Params{
+		OrderExpireBlocks: 259200,
+		FeePerBlock:       sdk.NewDecCoinFromDec("okt", sdk.MustNewDecFromStr("0.000001")),
+		TradeFeeRate:      sdk.MustNewDecFromStr("0.001"),
+	}
+	var queryErr error
+	monitor, err := NewParamsMonitor(map[string]ParamsFetcher{
+		"order": func() (interface{}, error) { return orderParams, queryErr },
+		"gov": func() (interface{}, error) {
+			return map[string]interface{}{"voting": map[string]interface{}{"voting_period": time.Hour}}, nil
+		},
+	}, ParamsConfig{})
+	require.NoError(t, err)
+
+	// the base of the comparison
+	report := monitor.Check()
+	require.True(t, report.IsEmpty())
+
+	orderParams.FeePerBlock.Amount = sdk.MustNewDecFromStr("0.000002")
+	orderParams.TradeFeeRate = sdk.MustNewDecFromStr("0.002")
+	report = monitor.Check()
+	require.Empty(t, report.Errs)
+	require.Equal(t, []ParamChange{
+		{Module: "order", Key: "fee_per_block.amount", Old: "0.00000100", New: "0.00000200"},
+		{Module: "order", Key: "trade_fee_rate", Old: "0.00100000", New: "0.00200000"},
+	}, report.Changes)
+	require.Equal(t, `order.trade_fee_rate: "0.00100000" -> "0.00200000"`, report.Changes[1].String())
+
+	// compared again on the next check after the failure
+	queryErr = errors.New("default error")
+	orderParams.OrderExpireBlocks = 86400
+	report = monitor.Check()
+	require.Empty(t, report.Changes)
+	require.Error(t, report.Errs["order"])
+	queryErr = nil
+	report = monitor.Check()
+	require.Equal(t, []ParamChange{{Module: "order", Key: "order_expire_blocks", Old: "259200", New: "86400"}},
+		report.Changes)
+}
+
+func TestParamsMonitor_Run(t *testing.T) {
+	votingPeriod := time.Hour
+	monitor, err := NewParamsMonitor(map[string]ParamsFetcher{
+		"gov": func() (interface{}, error) {
+			votingPeriod += time.Hour
+			return map[string]interface{}{"voting_period": votingPeriod}, nil
+		},
+	}, ParamsConfig{Interval: time.Millisecond})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	reports := monitor.Run(ctx)
+	report := <-reports
+	require.Equal(t, "voting_period", report.Changes[0].Key)
+	cancel()
+	for range reports {
+	}
+
+	_, err = NewParamsMonitor(nil, ParamsConfig{})
+	require.Error(t, err)
+	_, err = NewParamsMonitor(map[string]ParamsFetcher{"gov": nil}, ParamsConfig{})
+	require.Error(t, err)
+	_, err = NewParamsMonitor(map[string]ParamsFetcher{"gov": func() (interface{}, error) { return nil, nil }},
+		ParamsConfig{Interval: -1})
+	require.Error(t, err)
+}
@@ -0,0 +1,219 @@
+// Package monitor provides the monitors for the operators automating their validators and the integrators
+// following the chain via gosdk.
+package monitor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/okex/okchain-go-sdk/exposed"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	"github.com/okex/okchain-go-sdk/types/crypto/keys"
+)
+
+// defaultTopUpPendingTimeout is the time a top-up is taken as in flight by default
+const defaultTopUpPendingTimeout = 5 * time.Minute
+
+// SelfBondConfig - structure of the config of the self-bond monitor of a validator
+type SelfBondConfig struct {
+	// ValAddr is the operator address of the validator
+	ValAddr string
+	// Buffer is the margin of the self delegation above the min self delegation to keep, e.g. "10"
+	Buffer string
+	// Interval is the pause between two checks, which must be positive
+	Interval time.Duration
+	// TopUp delegates the coins from the operator once the margin falls below the buffer, optional
+	TopUp *TopUpConfig
+}
+
+// TopUpConfig - structure of the config of the top-up delegation from the operator of the validator
+type TopUpConfig struct {
+	// Operator is the key info of the account of the validator operator
+	Operator keys.Info
+	PassWd   string
+	// Amount is the coins of each top-up delegation, e.g. sdk.MustParseAmount("10okt")
+	Amount sdk.Amount
+	Memo   string
+	// PendingTimeout is the max time a top-up is taken as in flight before another one is allowed, which is cut short
+	// once the sequence of the operator goes past it, defaultTopUpPendingTimeout by default
+	PendingTimeout time.Duration
+}
+
+// ValidateBasic gives a quick validity check for the self-bond monitor config
+func (c SelfBondConfig) ValidateBasic() error {
+	valAddr, err := sdk.ValAddressFromBech32(c.ValAddr)
+	if err != nil {
+		return fmt.Errorf("failed. valAddress %s converted from Bech32 error", c.ValAddr)
+	}
+	if c.Interval <= 0 {
+		return errors.New("failed. interval must be positive")
+	}
+
+	if c.TopUp != nil {
+		if c.TopUp.Operator == nil || len(c.TopUp.PassWd) == 0 {
+			return errors.New("failed. empty operator key info or password for the top-up")
+		}
+		if !c.TopUp.Operator.GetAddress().Equals(sdk.AccAddress(valAddr)) {
+			return fmt.Errorf("failed. %s is not the operator of the validator %s",
+				c.TopUp.Operator.GetAddress(), c.ValAddr)
+		}
+		if c.TopUp.PendingTimeout < 0 {
+			return errors.New("failed. pending timeout of the top-up must not be negative")
+		}
+	}
+	return nil
+}
+
+// SelfBondReport - structure of the result of a check of the self-bond monitor
+type SelfBondReport struct {
+	SelfDelegation    sdk.Dec
+	MinSelfDelegation sdk.Dec
+	// Margin is the self delegation above the min self delegation, which is negative if it's already below
+	Margin sdk.Dec
+	// BelowBuffer tells whether the margin falls below the buffer
+	BelowBuffer bool
+	// TopUp is the response of the top-up delegation if it's submitted
+	TopUp *sdk.TxResponse
+	// TopUpPending tells whether the top-up is skipped for the previous one still in flight
+	TopUpPending bool
+	// Err is the error of the check or the top-up
+	Err error
+}
+
+// SelfBondMonitor - structure of the monitor of the min self delegation margin of a validator
+type SelfBondMonitor struct {
+	auth    exposed.AuthQuery
+	staking exposed.Staking
+	config  SelfBondConfig
+	buffer  sdk.Dec
+
+	mtx sync.Mutex
+	// the top-up submitted but not yet committed, nil if none
+	pending *pendingTopUp
+}
+
+// pendingTopUp - structure of a top-up in flight, which is committed once the sequence of the operator goes past it
+type pendingTopUp struct {
+	sequence uint64
+	expiry   time.Time
+}
+
+// NewSelfBondMonitor creates a new instance of SelfBondMonitor
+func NewSelfBondMonitor(auth exposed.AuthQuery, staking exposed.Staking, config SelfBondConfig) (*SelfBondMonitor,
+	error) {
+	if err := config.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	buffer := sdk.ZeroDec()
+	if len(config.Buffer) != 0 {
+		var err error
+		if buffer, err = sdk.NewDecFromStr(config.Buffer); err != nil {
+			return nil, fmt.Errorf("failed. parse the buffer of the self-bond monitor error: %w", err)
+		}
+		if buffer.IsNegative() {
+			return nil, errors.New("failed. buffer must not be negative")
+		}
+	}
+	if config.TopUp != nil {
+		if err := config.TopUp.Amount.Validate(); err != nil {
+			return nil, fmt.Errorf("failed. invalid amount of the top-up: %w", err)
+		}
+		topUp := *config.TopUp
+		if topUp.PendingTimeout == 0 {
+			topUp.PendingTimeout = defaultTopUpPendingTimeout
+		}
+		config.TopUp = &topUp
+	}
+
+	return &SelfBondMonitor{
+		auth:    auth,
+		staking: staking,
+		config:  config,
+		buffer:  buffer,
+	}, nil
+}
+
+// Check checks the margin of the self delegation once, and submits the top-up delegation if it's configured and the
+// margin falls below the buffer, unless the previous top-up is still in flight
+// The concurrent checks run one by one
+func (m *SelfBondMonitor) Check() (report SelfBondReport) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	val, err := m.staking.QueryValidator(m.config.ValAddr)
+	if err != nil {
+		report.Err = fmt.Errorf("failed. query validator error: %w", err)
+		return
+	}
+
+	operatorAddr := sdk.AccAddress(val.OperatorAddress).String()
+	delResp, err := m.staking.QueryDelegator(operatorAddr)
+	if err != nil {
+		report.Err = fmt.Errorf("failed. query self delegation error: %w", err)
+		return
+	}
+
+	report.SelfDelegation, report.MinSelfDelegation = delResp.Tokens, val.MinSelfDelegation
+	report.Margin = delResp.Tokens.Sub(val.MinSelfDelegation)
+	report.BelowBuffer = report.Margin.LT(m.buffer)
+	if !report.BelowBuffer || m.config.TopUp == nil {
+		return
+	}
+
+	acc, err := m.auth.QueryAccount(operatorAddr)
+	if err != nil {
+		report.Err = fmt.Errorf("failed. query operator account for the top-up error: %w", err)
+		return
+	}
+
+	if m.pending != nil {
+		if acc.GetSequence() <= m.pending.sequence && time.Now().Before(m.pending.expiry) {
+			report.TopUpPending = true
+			return
+		}
+		m.pending = nil
+	}
+
+	topUp := m.config.TopUp
+	resp, err := m.staking.Delegate(topUp.Operator, topUp.PassWd, topUp.Amount, topUp.Memo, acc.GetAccountNumber(),
+		acc.GetSequence())
+	if err != nil {
+		report.Err = fmt.Errorf("failed. top-up delegation error: %w", err)
+		return
+	}
+	report.TopUp = &resp
+	if resp.Code == 0 {
+		m.pending = &pendingTopUp{
+			sequence: acc.GetSequence(),
+			expiry:   time.Now().Add(topUp.PendingTimeout),
+		}
+	}
+	return
+}
+
+// Run checks the margin of the self delegation by the interval until the ctx is done, and sends the reports to the
+// channel returned. The channel is closed when the ctx is done
+func (m *SelfBondMonitor) Run(ctx context.Context) <-chan SelfBondReport {
+	reports := make(chan SelfBondReport, 1)
+	go func() {
+		defer close(reports)
+		for {
+			select {
+			case reports <- m.Check():
+			case <-ctx.Done():
+				return
+			}
+
+			select {
+			case <-time.After(m.config.Interval):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return reports
+}
@@ -0,0 +1,224 @@
+package monitor
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/okex/okchain-go-sdk/exposed"
+	authtypes "github.com/okex/okchain-go-sdk/module/auth/types"
+	"github.com/okex/okchain-go-sdk/module/staking/types"
+	"github.com/okex/okchain-go-sdk/testutil"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	"github.com/okex/okchain-go-sdk/types/crypto/keys"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeStaking serves the validator and its self delegation from memory
+type fakeStaking struct {
+	// the txs and queries out of the monitor are never called
+	exposed.Staking
+	operator       testutil.Account
+	minSelfDel     sdk.Dec
+	selfDel        sdk.Dec
+	delegated      []string
+	queryErr       error
+	delegateCalled int
+	sequence       uint64
+	// the delegations are left in flight until committed
+	inFlight bool
+}
+
+func (fs *fakeStaking) QueryValidator(valAddrStr string) (types.Validator, error) {
+	if fs.queryErr != nil {
+		return types.Validator{}, fs.queryErr
+	}
+	return types.Validator{
+		OperatorAddress:   sdk.ValAddress(fs.operator.Address),
+		MinSelfDelegation: fs.minSelfDel,
+	}, nil
+}
+
+func (fs *fakeStaking) QueryDelegator(delAddrStr string) (types.DelegatorResp, error) {
+	return types.DelegatorResp{
+		DelegatorAddress: fs.operator.Address,
+		Tokens:           fs.selfDel,
+	}, nil
+}
+
+func (fs *fakeStaking) QueryAccount(accAddrStr string) (authtypes.Account, error) {
+	acc := fs.operator.BaseAccount(fs.sequence)
+	return &acc, nil
+}
+
+func (fs *fakeStaking) Delegate(fromInfo keys.Info, _ string, amount sdk.Amount, _ string, _, seqNum uint64) (
+	sdk.TxResponse, error) {
+	if !fromInfo.GetAddress().Equals(fs.operator.Address) || seqNum != fs.sequence {
+		return sdk.TxResponse{}, errors.New("invalid delegation")
+	}
+	fs.delegateCalled++
+	fs.delegated = append(fs.delegated, amount.String())
+	if fs.inFlight {
+		return sdk.TxResponse{TxHash: "pending"}, nil
+	}
+	return sdk.TxResponse{Height: 1024}, fs.commit(amount)
+}
+
+func (fs *fakeStaking) commit(amount sdk.Amount) error {
+	coin, err := amount.Coin()
+	if err != nil {
+		return err
+	}
+	fs.sequence++
+	fs.selfDel = fs.selfDel.Add(coin.Amount)
+	return nil
+}
+
+func newFakeStaking(t *testing.T) *fakeStaking {
+	operator := testutil.NewAccounts(t, testutil.FixtureAccountsSeed, 1)[0]
+	return &fakeStaking{
+		operator:   operator,
+		minSelfDel: sdk.NewDec(100),
+		selfDel:    sdk.NewDec(105),
+	}
+}
+
+func TestSelfBondMonitor_Check(t *testing.T) {
+	fs := newFakeStaking(t)
+	config := SelfBondConfig{
+		ValAddr:  sdk.ValAddress(fs.operator.Address).String(),
+		Buffer:   "10",
+		Interval: time.Second,
+	}
+
+	// monitoring only
+	monitor, err := NewSelfBondMonitor(fs, fs, config)
+	require.NoError(t, err)
+	report := monitor.Check()
+	require.NoError(t, report.Err)
+	require.Equal(t, sdk.NewDec(5), report.Margin)
+	require.True(t, report.BelowBuffer)
+	require.Nil(t, report.TopUp)
+
+	// auto top-up
+	config.TopUp = &TopUpConfig{
+		Operator: fs.operator.ImportToKeybase(t),
+		PassWd:   testutil.AccountPassWd,
+		Amount:   sdk.MustParseAmount("10okt"),
+	}
+	monitor, err = NewSelfBondMonitor(fs, fs, config)
+	require.NoError(t, err)
+	report = monitor.Check()
+	require.NoError(t, report.Err)
+	require.True(t, report.BelowBuffer)
+	require.Equal(t, int64(1024), report.TopUp.Height)
+	require.Equal(t, []string{"10.00000000okt"}, fs.delegated)
+
+	// above the buffer after the top-up
+	report = monitor.Check()
+	require.NoError(t, report.Err)
+	require.Equal(t, sdk.NewDec(15), report.Margin)
+	require.False(t, report.BelowBuffer)
+	require.Nil(t, report.TopUp)
+	require.Equal(t, 1, fs.delegateCalled)
+
+	fs.queryErr = errors.New("default error")
+	require.Error(t, monitor.Check().Err)
+}
+
+func TestSelfBondMonitor_PendingTopUp(t *testing.T) {
+	fs := newFakeStaking(t)
+	fs.inFlight = true
+	amount := sdk.MustParseAmount("10okt")
+	monitor, err := NewSelfBondMonitor(fs, fs, SelfBondConfig{
+		ValAddr:  sdk.ValAddress(fs.operator.Address).String(),
+		Buffer:   "10",
+		Interval: time.Second,
+		TopUp: &TopUpConfig{
+			Operator:       fs.operator.ImportToKeybase(t),
+			PassWd:         testutil.AccountPassWd,
+			Amount:         amount,
+			PendingTimeout: 50 * time.Millisecond,
+		},
+	})
+	require.NoError(t, err)
+
+	report := monitor.Check()
+	require.NoError(t, report.Err)
+	require.NotNil(t, report.TopUp)
+
+	// no more top-up while the first one is in flight
+	for i := 0; i < 3; i++ {
+		report = monitor.Check()
+		require.NoError(t, report.Err)
+		require.True(t, report.BelowBuffer)
+		require.True(t, report.TopUpPending)
+		require.Nil(t, report.TopUp)
+	}
+	require.Equal(t, 1, fs.delegateCalled)
+
+	// the top-up dropped is given up after the timeout
+	time.Sleep(60 * time.Millisecond)
+	report = monitor.Check()
+	require.NoError(t, report.Err)
+	require.False(t, report.TopUpPending)
+	require.NotNil(t, report.TopUp)
+	require.Equal(t, 2, fs.delegateCalled)
+
+	// committed once the sequence goes past it
+	require.NoError(t, fs.commit(amount))
+	require.NoError(t, fs.commit(amount))
+	report = monitor.Check()
+	require.NoError(t, report.Err)
+	require.False(t, report.BelowBuffer)
+	require.False(t, report.TopUpPending)
+}
+
+func TestSelfBondMonitor_Run(t *testing.T) {
+	fs := newFakeStaking(t)
+	monitor, err := NewSelfBondMonitor(fs, fs, SelfBondConfig{
+		ValAddr:  sdk.ValAddress(fs.operator.Address).String(),
+		Interval: time.Millisecond,
+	})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	reports := monitor.Run(ctx)
+	for i := 0; i < 3; i++ {
+		report := <-reports
+		require.NoError(t, report.Err)
+		require.False(t, report.BelowBuffer)
+	}
+
+	cancel()
+	for range reports {
+	}
+}
+
+func TestNewSelfBondMonitor(t *testing.T) {
+	fs := newFakeStaking(t)
+	valAddrStr := sdk.ValAddress(fs.operator.Address).String()
+	others := testutil.NewAccounts(t, "others", 1)
+	// the key name is taken by the fixture of the operator
+	others[0].Name = "other0"
+
+	for _, config := range []SelfBondConfig{
+		{ValAddr: fs.operator.Address.String(), Interval: time.Second},
+		{ValAddr: valAddrStr, Buffer: "-1", Interval: time.Second},
+		{ValAddr: valAddrStr, Buffer: "ten", Interval: time.Second},
+		{ValAddr: valAddrStr, Interval: -1},
+		{ValAddr: valAddrStr},
+		{ValAddr: valAddrStr, Interval: time.Second, TopUp: &TopUpConfig{Operator: fs.operator.ImportToKeybase(t),
+			Amount: sdk.MustParseAmount("10okt")}},
+		{ValAddr: valAddrStr, Interval: time.Second, TopUp: &TopUpConfig{Operator: fs.operator.ImportToKeybase(t),
+			PassWd: "12345678", Amount: sdk.Amount{}}},
+		{ValAddr: valAddrStr, Interval: time.Second, TopUp: &TopUpConfig{Operator: others[0].ImportToKeybase(t),
+			PassWd: "12345678", Amount: sdk.MustParseAmount("10okt")}},
+		{ValAddr: valAddrStr, Interval: time.Second, TopUp: &TopUpConfig{Operator: fs.operator.ImportToKeybase(t),
+			PassWd: "12345678", Amount: sdk.MustParseAmount("10okt"), PendingTimeout: -1}},
+	} {
+		_, err := NewSelfBondMonitor(fs, fs, config)
+		require.Error(t, err)
+	}
+}
@@ -0,0 +1,253 @@
+package monitor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/okex/okchain-go-sdk/exposed"
+	tmtypes "github.com/okex/okchain-go-sdk/module/tendermint/types"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	"github.com/okex/okchain-go-sdk/types/crypto/keys"
+)
+
+const (
+	bondDenom                = "okt"
+	defaultEstimationWindow  = 100
+	defaultUnbondingInterval = time.Minute
+)
+
+// UnbondingConfig - structure of the config of the unbonding watcher of a delegator
+type UnbondingConfig struct {
+	// DelAddr is the address of the delegator
+	DelAddr string
+	// Interval is the max pause between two checks, which is shortened once the completion is estimated to be sooner
+	Interval time.Duration
+	// EstimationWindow is the number of the latest blocks to estimate the block time by, 100 by default
+	EstimationWindow int64
+	// OnCompleted is called on each completion of the unbonding, optional
+	OnCompleted func(UnbondingCompletion)
+	// FollowUp transfers the unbonded tokens once the unbonding completes, e.g. to a cold wallet, optional
+	FollowUp *FollowUpConfig
+}
+
+// FollowUpConfig - structure of the config of the follow-up transfer of the unbonded tokens
+type FollowUpConfig struct {
+	// From is the key info of the delegator account
+	From   keys.Info
+	PassWd string
+	ToAddr string
+	Memo   string
+}
+
+// ValidateBasic gives a quick validity check for the unbonding watcher config
+func (c UnbondingConfig) ValidateBasic() error {
+	delAddr, err := sdk.AccAddressFromBech32(c.DelAddr)
+	if err != nil {
+		return fmt.Errorf("failed. accAddress %s converted from Bech32 error", c.DelAddr)
+	}
+	if c.Interval < 0 {
+		return errors.New("failed. interval must not be negative")
+	}
+	if c.EstimationWindow < 0 {
+		return errors.New("failed. estimation window must not be negative")
+	}
+
+	if c.FollowUp != nil {
+		if c.FollowUp.From == nil || len(c.FollowUp.PassWd) == 0 {
+			return errors.New("failed. empty key info or password for the follow-up")
+		}
+		if !c.FollowUp.From.GetAddress().Equals(delAddr) {
+			return fmt.Errorf("failed. %s is not the delegator %s", c.FollowUp.From.GetAddress(), c.DelAddr)
+		}
+		if _, err := sdk.AccAddressFromBech32(c.FollowUp.ToAddr); err != nil {
+			return fmt.Errorf("failed. accAddress %s converted from Bech32 error", c.FollowUp.ToAddr)
+		}
+	}
+	return nil
+}
+
+// UnbondingCompletion - structure of a completed unbonding of the delegator
+type UnbondingCompletion struct {
+	Amount         sdk.Dec
+	CompletionTime time.Time
+	// Height is the latest height when the completion is observed
+	Height int64
+	// FollowUp is the response of the follow-up transfer if it's submitted
+	FollowUp *sdk.TxResponse
+	// Err is the error of the follow-up transfer
+	Err error
+}
+
+// UnbondingReport - structure of the result of a check of the unbonding watcher
+type UnbondingReport struct {
+	// Pending is the amount of the tokens still unbonding
+	Pending        sdk.Dec
+	CompletionTime time.Time
+	// EstimatedHeight is the estimated height of the first block committed at the completion time
+	EstimatedHeight int64
+	// Estimate is the block time estimation of the check
+	Estimate tmtypes.BlockTimeEstimate
+	// Completed is the unbonding completed since the last check
+	Completed *UnbondingCompletion
+	// Err is the error of the check
+	Err error
+}
+
+// unbondingEntry - structure of the unbonding entry of the delegator
+type unbondingEntry struct {
+	amount         sdk.Dec
+	completionTime time.Time
+}
+
+// UnbondingWatcher - structure of the watcher of the unbonding entry of a delegator
+type UnbondingWatcher struct {
+	auth    exposed.AuthQuery
+	staking exposed.StakingQuery
+	token   exposed.TokenTx
+	tm      exposed.TendermintQuery
+	config  UnbondingConfig
+	// the unbonding entry tracked until its completion
+	pending *unbondingEntry
+	// the completion time of the last completed unbonding to avoid the duplicate completion
+	lastCompleted time.Time
+}
+
+// NewUnbondingWatcher creates a new instance of UnbondingWatcher
+func NewUnbondingWatcher(auth exposed.AuthQuery, staking exposed.StakingQuery, token exposed.TokenTx,
+	tm exposed.TendermintQuery, config UnbondingConfig) (*UnbondingWatcher, error) {
+	if err := config.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if config.Interval == 0 {
+		config.Interval = defaultUnbondingInterval
+	}
+	if config.EstimationWindow == 0 {
+		config.EstimationWindow = defaultEstimationWindow
+	}
+
+	return &UnbondingWatcher{
+		auth:    auth,
+		staking: staking,
+		token:   token,
+		tm:      tm,
+		config:  config,
+	}, nil
+}
+
+// Check checks the unbonding entry of the delegator once. The unbonding completes once the time of the latest block
+// reaches its completion time, when the callback is called and the follow-up transfer is submitted if configured
+func (w *UnbondingWatcher) Check() (report UnbondingReport) {
+	estimate, err := w.tm.EstimateBlockTime(w.config.EstimationWindow)
+	if err != nil {
+		report.Err = fmt.Errorf("failed. estimate block time error: %w", err)
+		return
+	}
+	report.Estimate = estimate
+
+	delResp, err := w.staking.QueryDelegator(w.config.DelAddr)
+	if err != nil {
+		report.Err = fmt.Errorf("failed. query unbonding error: %w", err)
+		return
+	}
+
+	// the tracked entry might be removed from the chain right after its completion
+	if w.pending != nil && !estimate.LatestTime.Before(w.pending.completionTime) {
+		report.Completed = w.complete(*w.pending, estimate.LatestHeight)
+	}
+	w.pending = nil
+
+	if delResp.UnbondedTokens.IsNil() || !delResp.UnbondedTokens.IsPositive() {
+		return
+	}
+
+	entry := unbondingEntry{
+		amount:         delResp.UnbondedTokens,
+		completionTime: delResp.CompletionTime,
+	}
+	if estimate.LatestTime.Before(entry.completionTime) {
+		w.pending = &entry
+		report.Pending, report.CompletionTime = entry.amount, entry.completionTime
+		report.EstimatedHeight = estimate.HeightAt(entry.completionTime)
+		return
+	}
+
+	if report.Completed == nil && !entry.completionTime.Equal(w.lastCompleted) {
+		report.Completed = w.complete(entry, estimate.LatestHeight)
+	}
+	return
+}
+
+func (w *UnbondingWatcher) complete(entry unbondingEntry, height int64) *UnbondingCompletion {
+	w.lastCompleted = entry.completionTime
+	completion := UnbondingCompletion{
+		Amount:         entry.amount,
+		CompletionTime: entry.completionTime,
+		Height:         height,
+	}
+
+	if followUp := w.config.FollowUp; followUp != nil {
+		acc, err := w.auth.QueryAccount(w.config.DelAddr)
+		amount, amountErr := sdk.NewAmountFromDec(bondDenom, entry.amount)
+		if err != nil {
+			completion.Err = fmt.Errorf("failed. query delegator account for the follow-up error: %w", err)
+		} else if amountErr != nil {
+			completion.Err = fmt.Errorf("failed. invalid amount of the follow-up transfer: %w", amountErr)
+		} else {
+			resp, err := w.token.Send(followUp.From, followUp.PassWd, followUp.ToAddr, amount, followUp.Memo,
+				acc.GetAccountNumber(), acc.GetSequence())
+			if err != nil {
+				completion.Err = fmt.Errorf("failed. follow-up transfer error: %w", err)
+			} else {
+				completion.FollowUp = &resp
+			}
+		}
+	}
+
+	if w.config.OnCompleted != nil {
+		w.config.OnCompleted(completion)
+	}
+	return &completion
+}
+
+// Run checks the unbonding entry of the delegator until the ctx is done, and sends the reports to the channel
+// returned. The pause between two checks is shortened to the estimated completion once it's sooner than the interval
+// The channel is closed when the ctx is done
+func (w *UnbondingWatcher) Run(ctx context.Context) <-chan UnbondingReport {
+	reports := make(chan UnbondingReport, 1)
+	go func() {
+		defer close(reports)
+		for {
+			report := w.Check()
+			select {
+			case reports <- report:
+			case <-ctx.Done():
+				return
+			}
+
+			select {
+			case <-time.After(w.nextPause(report)):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return reports
+}
+
+// nextPause returns the pause until the block after the estimated completion, capped by the interval
+func (w *UnbondingWatcher) nextPause(report UnbondingReport) time.Duration {
+	if report.Err != nil || report.EstimatedHeight == 0 {
+		return w.config.Interval
+	}
+
+	untilCompletion := time.Until(report.Estimate.TimeAt(report.EstimatedHeight)) + report.Estimate.BlockInterval
+	if untilCompletion < w.config.Interval {
+		if untilCompletion < 0 {
+			return 0
+		}
+		return untilCompletion
+	}
+	return w.config.Interval
+}
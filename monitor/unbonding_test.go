@@ -0,0 +1,188 @@
+package monitor
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/okex/okchain-go-sdk/exposed"
+	authtypes "github.com/okex/okchain-go-sdk/module/auth/types"
+	"github.com/okex/okchain-go-sdk/module/staking/types"
+	tmtypes "github.com/okex/okchain-go-sdk/module/tendermint/types"
+	"github.com/okex/okchain-go-sdk/testutil"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	"github.com/okex/okchain-go-sdk/types/crypto/keys"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeUnbondingChain serves the unbonding entry and the latest block from memory
+type fakeUnbondingChain struct {
+	// the txs and queries out of the watcher are never called
+	exposed.StakingQuery
+	exposed.TokenTx
+	exposed.TendermintQuery
+	delegator      testutil.Account
+	latestHeight   int64
+	latestTime     time.Time
+	unbonded       sdk.Dec
+	completionTime time.Time
+	sent           []string
+	queryErr       error
+}
+
+func (fc *fakeUnbondingChain) EstimateBlockTime(window int64) (tmtypes.BlockTimeEstimate, error) {
+	if fc.queryErr != nil {
+		return tmtypes.BlockTimeEstimate{}, fc.queryErr
+	}
+	return tmtypes.BlockTimeEstimate{
+		LatestHeight:  fc.latestHeight,
+		LatestTime:    fc.latestTime,
+		BlockInterval: 3 * time.Second,
+	}, nil
+}
+
+func (fc *fakeUnbondingChain) QueryDelegator(delAddrStr string) (types.DelegatorResp, error) {
+	return types.DelegatorResp{
+		DelegatorAddress: fc.delegator.Address,
+		Tokens:           sdk.ZeroDec(),
+		UnbondedTokens:   fc.unbonded,
+		CompletionTime:   fc.completionTime,
+	}, nil
+}
+
+func (fc *fakeUnbondingChain) QueryAccount(accAddrStr string) (authtypes.Account, error) {
+	acc := fc.delegator.BaseAccount(uint64(len(fc.sent)))
+	return &acc, nil
+}
+
+func (fc *fakeUnbondingChain) Send(fromInfo keys.Info, _, _ string, amount sdk.Amount, _ string, _, seqNum uint64) (
+	sdk.TxResponse, error) {
+	if !fromInfo.GetAddress().Equals(fc.delegator.Address) || seqNum != uint64(len(fc.sent)) {
+		return sdk.TxResponse{}, errors.New("invalid transfer")
+	}
+	fc.sent = append(fc.sent, amount.String())
+	return sdk.TxResponse{Height: fc.latestHeight}, nil
+}
+
+// advance commits the blocks and completes the unbonding on chain once its completion time is reached
+func (fc *fakeUnbondingChain) advance(blocks int64) {
+	fc.latestHeight += blocks
+	fc.latestTime = fc.latestTime.Add(time.Duration(blocks) * 3 * time.Second)
+	if !fc.latestTime.Before(fc.completionTime) {
+		fc.unbonded = sdk.ZeroDec()
+	}
+}
+
+func newFakeUnbondingChain(t *testing.T) *fakeUnbondingChain {
+	delegator := testutil.NewAccounts(t, testutil.FixtureAccountsSeed, 1)[0]
+	latestTime := time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC)
+	return &fakeUnbondingChain{
+		delegator:      delegator,
+		latestHeight:   1024,
+		latestTime:     latestTime,
+		unbonded:       sdk.NewDec(10),
+		completionTime: latestTime.Add(30 * time.Second),
+	}
+}
+
+func TestUnbondingWatcher_Check(t *testing.T) {
+	fc := newFakeUnbondingChain(t)
+	others := testutil.NewAccounts(t, "others", 1)
+	var completions []UnbondingCompletion
+	config := UnbondingConfig{
+		DelAddr:     fc.delegator.Address.String(),
+		OnCompleted: func(completion UnbondingCompletion) { completions = append(completions, completion) },
+		FollowUp: &FollowUpConfig{
+			From:   fc.delegator.ImportToKeybase(t),
+			PassWd: testutil.AccountPassWd,
+			ToAddr: others[0].Address.String(),
+		},
+	}
+	watcher, err := NewUnbondingWatcher(fc, fc, fc, fc, config)
+	require.NoError(t, err)
+
+	// pending
+	report := watcher.Check()
+	require.NoError(t, report.Err)
+	require.Equal(t, sdk.NewDec(10), report.Pending)
+	require.Equal(t, int64(1034), report.EstimatedHeight)
+	require.Nil(t, report.Completed)
+
+	// the entry is removed from the chain on the completion
+	fc.advance(12)
+	report = watcher.Check()
+	require.NoError(t, report.Err)
+	require.True(t, report.Pending.IsNil())
+	require.NotNil(t, report.Completed)
+	require.NoError(t, report.Completed.Err)
+	require.Equal(t, sdk.NewDec(10), report.Completed.Amount)
+	require.Equal(t, int64(1036), report.Completed.Height)
+	require.Equal(t, int64(1036), report.Completed.FollowUp.Height)
+	require.Equal(t, []string{"10.00000000okt"}, fc.sent)
+	require.Len(t, completions, 1)
+
+	// nothing unbonding
+	fc.advance(1)
+	report = watcher.Check()
+	require.NoError(t, report.Err)
+	require.Nil(t, report.Completed)
+	require.Len(t, completions, 1)
+
+	// the entry first seen after its completion is completed only once
+	fc.unbonded, fc.completionTime = sdk.NewDec(5), fc.latestTime
+	for i := 0; i < 2; i++ {
+		report = watcher.Check()
+		require.NoError(t, report.Err)
+	}
+	require.Len(t, completions, 2)
+	require.Equal(t, sdk.NewDec(5), completions[1].Amount)
+	require.Equal(t, []string{"10.00000000okt", "5.00000000okt"}, fc.sent)
+
+	fc.queryErr = errors.New("default error")
+	require.Error(t, watcher.Check().Err)
+}
+
+func TestUnbondingWatcher_Run(t *testing.T) {
+	fc := newFakeUnbondingChain(t)
+	watcher, err := NewUnbondingWatcher(fc, fc, fc, fc, UnbondingConfig{
+		DelAddr:  fc.delegator.Address.String(),
+		Interval: time.Millisecond,
+	})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	reports := watcher.Run(ctx)
+	for i := 0; i < 3; i++ {
+		report := <-reports
+		require.NoError(t, report.Err)
+		require.Equal(t, sdk.NewDec(10), report.Pending)
+	}
+
+	cancel()
+	for range reports {
+	}
+}
+
+func TestNewUnbondingWatcher(t *testing.T) {
+	fc := newFakeUnbondingChain(t)
+	delAddrStr := fc.delegator.Address.String()
+	others := testutil.NewAccounts(t, "others", 1)
+	// the key name is taken by the fixture of the delegator
+	others[0].Name = "other0"
+
+	for _, config := range []UnbondingConfig{
+		{DelAddr: sdk.ValAddress(fc.delegator.Address).String()},
+		{DelAddr: delAddrStr, Interval: -1},
+		{DelAddr: delAddrStr, EstimationWindow: -1},
+		{DelAddr: delAddrStr, FollowUp: &FollowUpConfig{From: fc.delegator.ImportToKeybase(t),
+			ToAddr: others[0].Address.String()}},
+		{DelAddr: delAddrStr, FollowUp: &FollowUpConfig{From: fc.delegator.ImportToKeybase(t), PassWd: "12345678",
+			ToAddr: "cold wallet"}},
+		{DelAddr: delAddrStr, FollowUp: &FollowUpConfig{From: others[0].ImportToKeybase(t), PassWd: "12345678",
+			ToAddr: others[0].Address.String()}},
+	} {
+		_, err := NewUnbondingWatcher(fc, fc, fc, fc, config)
+		require.Error(t, err)
+	}
+}
@@ -0,0 +1,305 @@
+// Package orderwatch provides the watcher of the open orders of an address against the order expiry of the chain,
+// which warns of the orders near expiry and optionally cancels and re-places them with their remaining quantities, so
+// that the passive liquidity of the market makers doesn't silently disappear.
+package orderwatch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/okex/okchain-go-sdk/exposed"
+	backendtypes "github.com/okex/okchain-go-sdk/module/backend/types"
+	ordertypes "github.com/okex/okchain-go-sdk/module/order/types"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	"github.com/okex/okchain-go-sdk/types/crypto/keys"
+)
+
+const (
+	defaultWarnBlocks = 100
+	orderIDPrefix     = "ID"
+)
+
+// Config - structure of the config of the order expiry watcher
+type Config struct {
+	// Addr is the address whose open orders are watched
+	Addr string
+	// Product filters the orders watched, all the products if empty
+	Product string
+	// WarnBlocks is the number of the blocks before the expiry of an order, within which it's near expiry, 100 by
+	// default
+	WarnBlocks int64
+	// Interval is the pause between two checks
+	Interval time.Duration
+	// Replace cancels the orders near expiry and places them again with their remaining quantities, optional
+	Replace *ReplaceConfig
+}
+
+// ReplaceConfig - structure of the config of the re-placement of the orders near expiry
+type ReplaceConfig struct {
+	// Owner is the key info of the account placing the orders
+	Owner  keys.Info
+	PassWd string
+	Memo   string
+}
+
+// ValidateBasic gives a quick validity check for the order expiry watcher config
+func (c Config) ValidateBasic() error {
+	addr, err := sdk.AccAddressFromBech32(c.Addr)
+	if err != nil {
+		return fmt.Errorf("failed. accAddress %s converted from Bech32 error", c.Addr)
+	}
+	if c.WarnBlocks < 0 || c.Interval < 0 {
+		return errors.New("failed. warn blocks and interval must not be negative")
+	}
+
+	if c.Replace != nil {
+		if c.Replace.Owner == nil || len(c.Replace.PassWd) == 0 {
+			return errors.New("failed. empty owner key info or password for the re-placement")
+		}
+		if !c.Replace.Owner.GetAddress().Equals(addr) {
+			return fmt.Errorf("failed. %s is not the owner of the orders of %s", c.Replace.Owner.GetAddress(), c.Addr)
+		}
+	}
+	return nil
+}
+
+// Action is the action taken on an order near expiry
+type Action string
+
+// actions on the orders near expiry
+const (
+	// ActionWarned means the order is reported only
+	ActionWarned Action = "warned"
+	// ActionReplaced means the order is cancelled and placed again
+	ActionReplaced Action = "replaced"
+)
+
+// Event - structure of an order near expiry found by a check of the watcher
+type Event struct {
+	Order backendtypes.Order
+	// ExpireHeight is the height at which the order expires
+	ExpireHeight int64
+	// BlocksLeft is the number of the blocks before the expiry of the order
+	BlocksLeft int64
+	Action     Action
+	// Cancel and Place are the responses of the txs cancelling and placing the order again if it's replaced
+	Cancel *sdk.TxResponse
+	Place  *sdk.TxResponse
+	// Err is the error of the expiry lookup or the re-placement of the order
+	Err error
+}
+
+// Watcher - structure of the watcher of the open orders of an address against the order expiry
+type Watcher struct {
+	tm      exposed.TendermintQuery
+	auth    exposed.AuthQuery
+	backend exposed.BackendQuery
+	order   exposed.Order
+	config  Config
+	// expireHeights caches the expiry heights of the orders watched, which never change
+	expireHeights map[string]int64
+}
+
+// NewWatcher creates a new instance of Watcher
+func NewWatcher(tm exposed.TendermintQuery, auth exposed.AuthQuery, backend exposed.BackendQuery,
+	order exposed.Order, config Config) (*Watcher, error) {
+	if err := config.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if config.WarnBlocks == 0 {
+		config.WarnBlocks = defaultWarnBlocks
+	}
+
+	return &Watcher{
+		tm:            tm,
+		auth:          auth,
+		backend:       backend,
+		order:         order,
+		config:        config,
+		expireHeights: make(map[string]int64),
+	}, nil
+}
+
+// Check checks the open orders once and returns the events of the ones near expiry, which are re-placed together if
+// the re-placement is configured
+func (w *Watcher) Check() ([]Event, error) {
+	commit, err := w.tm.QueryLatestCommitResult()
+	if err != nil {
+		return nil, fmt.Errorf("failed. query latest height error: %w", err)
+	}
+	height := commit.Height
+
+	var orders []backendtypes.Order
+	if err = sdk.FetchAllPages(sdk.NewPageRequest(1, 0), func(pr sdk.PageRequest) (sdk.PagedResult, error) {
+		pageOrders, pagedRes, err := w.backend.QueryOpenOrdersPaged(w.config.Addr, w.config.Product, "", 0, 0, pr)
+		orders = append(orders, pageOrders...)
+		return pagedRes, err
+	}); err != nil {
+		return nil, fmt.Errorf("failed. query open orders error: %w", err)
+	}
+
+	open := make(map[string]bool, len(orders))
+	var events []Event
+	var nearExpiry []int
+	for _, order := range orders {
+		open[order.OrderID] = true
+		expireHeight, err := w.expireHeight(order.OrderID)
+		if err != nil {
+			events = append(events, Event{Order: order, Action: ActionWarned, Err: err})
+			continue
+		}
+
+		blocksLeft := expireHeight - height
+		if blocksLeft > w.config.WarnBlocks {
+			continue
+		}
+
+		nearExpiry = append(nearExpiry, len(events))
+		events = append(events, Event{
+			Order:        order,
+			ExpireHeight: expireHeight,
+			BlocksLeft:   blocksLeft,
+			Action:       ActionWarned,
+		})
+	}
+
+	// forget the orders closed
+	for orderID := range w.expireHeights {
+		if !open[orderID] {
+			delete(w.expireHeights, orderID)
+		}
+	}
+
+	if w.config.Replace != nil && len(nearExpiry) != 0 {
+		w.replace(events, nearExpiry)
+	}
+	return events, nil
+}
+
+// expireHeight returns the expiry height of the order, which is the height where it was placed, encoded in its ID,
+// plus its lifetime in blocks
+func (w *Watcher) expireHeight(orderID string) (int64, error) {
+	if expireHeight, ok := w.expireHeights[orderID]; ok {
+		return expireHeight, nil
+	}
+
+	placedHeight, err := ParseOrderHeight(orderID)
+	if err != nil {
+		return 0, err
+	}
+
+	orderDetail, err := w.order.QueryOrderDetail(orderID)
+	if err != nil {
+		return 0, fmt.Errorf("failed. query order detail of %s error: %w", orderID, err)
+	}
+
+	expireHeight := placedHeight + orderDetail.OrderExpireBlocks
+	w.expireHeights[orderID] = expireHeight
+	return expireHeight, nil
+}
+
+// replace cancels the orders near expiry in one tx and places them again in the next one
+func (w *Watcher) replace(events []Event, nearExpiry []int) {
+	fail := func(err error) {
+		for _, i := range nearExpiry {
+			events[i].Err = err
+		}
+	}
+
+	// the rest are re-placed on the next check
+	if len(nearExpiry) > ordertypes.MaxOrderItemsPerMsg {
+		nearExpiry = nearExpiry[:ordertypes.MaxOrderItemsPerMsg]
+	}
+
+	// the orders are checked before any of them is cancelled
+	orderIDs := make([]string, len(nearExpiry))
+	orderItems := make([]ordertypes.OrderItem, len(nearExpiry))
+	for j, i := range nearExpiry {
+		order := events[i].Order
+		price, err := sdk.NewDecFromStr(order.Price)
+		if err != nil {
+			fail(fmt.Errorf("failed. invalid price of order %s: %w", order.OrderID, err))
+			return
+		}
+		quantity, err := sdk.NewDecFromStr(order.RemainQuantity)
+		if err != nil {
+			fail(fmt.Errorf("failed. invalid remaining quantity of order %s: %w", order.OrderID, err))
+			return
+		}
+		orderIDs[j] = order.OrderID
+		orderItems[j] = ordertypes.OrderItem{Product: order.Product, Side: order.Side, Price: price, Quantity: quantity}
+	}
+
+	rc := w.config.Replace
+	acc, err := w.auth.QueryAccount(w.config.Addr)
+	if err != nil {
+		fail(fmt.Errorf("failed. query owner account for the re-placement error: %w", err))
+		return
+	}
+
+	cancelResp, err := w.order.CancelOrders(rc.Owner, rc.PassWd, strings.Join(orderIDs, ","),
+		rc.Memo, acc.GetAccountNumber(), acc.GetSequence())
+	if err != nil {
+		fail(fmt.Errorf("failed. cancel orders near expiry error: %w", err))
+		return
+	}
+
+	_, placeResp, err := w.order.PlaceOrders(rc.Owner, rc.PassWd, orderItems, rc.Memo, acc.GetAccountNumber(),
+		acc.GetSequence()+1)
+	for _, i := range nearExpiry {
+		events[i].Action, events[i].Cancel = ActionReplaced, &cancelResp
+		if err != nil {
+			events[i].Err = fmt.Errorf("failed. place orders again after the cancellation error: %w", err)
+			continue
+		}
+		events[i].Place = &placeResp
+		delete(w.expireHeights, events[i].Order.OrderID)
+	}
+}
+
+// Run checks the open orders by the interval until the ctx is done, and sends the events to the channel returned. A
+// failed check is sent as an event with the error only. The channel is closed when the ctx is done
+func (w *Watcher) Run(ctx context.Context) <-chan Event {
+	events := make(chan Event, 1)
+	go func() {
+		defer close(events)
+		for {
+			checked, err := w.Check()
+			if err != nil {
+				checked = []Event{{Err: err}}
+			}
+			for _, event := range checked {
+				select {
+				case events <- event:
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			select {
+			case <-time.After(w.config.Interval):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return events
+}
+
+// ParseOrderHeight parses the height where the order was placed from its ID, e.g. ID0000000010-1 placed at 10
+func ParseOrderHeight(orderID string) (int64, error) {
+	parts := strings.SplitN(strings.TrimPrefix(orderID, orderIDPrefix), "-", 2)
+	if !strings.HasPrefix(orderID, orderIDPrefix) || len(parts) != 2 {
+		return 0, fmt.Errorf("failed. invalid order ID %s", orderID)
+	}
+
+	height, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil || height <= 0 {
+		return 0, fmt.Errorf("failed. invalid height in order ID %s", orderID)
+	}
+	return height, nil
+}
@@ -0,0 +1,209 @@
+package orderwatch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/okex/okchain-go-sdk/exposed"
+	authtypes "github.com/okex/okchain-go-sdk/module/auth/types"
+	backendtypes "github.com/okex/okchain-go-sdk/module/backend/types"
+	ordertypes "github.com/okex/okchain-go-sdk/module/order/types"
+	tmtypes "github.com/okex/okchain-go-sdk/module/tendermint/types"
+	"github.com/okex/okchain-go-sdk/testutil"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	"github.com/okex/okchain-go-sdk/types/crypto/keys"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeDex serves the open orders of an account from memory and records the cancellations and the placements
+type fakeDex struct {
+	// the calls out of the watcher are never made
+	exposed.TendermintQuery
+	exposed.BackendQuery
+	exposed.Order
+	owner        testutil.Account
+	height       int64
+	expireBlocks int64
+	orders       []backendtypes.Order
+	sequence     uint64
+	detailCalls  int
+	cancelled    []string
+	placed       []string
+	placeErr     error
+}
+
+func (fd *fakeDex) QueryLatestCommitResult() (tmtypes.ResultCommit, error) {
+	var commit tmtypes.ResultCommit
+	commit.Height = fd.height
+	return commit, nil
+}
+
+func (fd *fakeDex) QueryOpenOrdersPaged(addrStr, product, _ string, _, _ int, pageReq sdk.PageRequest) (
+	[]backendtypes.Order, sdk.PagedResult, error) {
+	var orders []backendtypes.Order
+	for _, order := range fd.orders {
+		if order.Sender == addrStr && (len(product) == 0 || order.Product == product) {
+			orders = append(orders, order)
+		}
+	}
+	start, end := sdk.PaginateSlice(pageReq, len(orders))
+	return orders[start:end], sdk.NewPagedResult(pageReq, end-start, len(orders)), nil
+}
+
+func (fd *fakeDex) QueryOrderDetail(orderID string) (ordertypes.OrderDetail, error) {
+	fd.detailCalls++
+	return ordertypes.OrderDetail{OrderID: orderID, OrderExpireBlocks: fd.expireBlocks}, nil
+}
+
+func (fd *fakeDex) QueryAccount(string) (authtypes.Account, error) {
+	acc := fd.owner.BaseAccount(fd.sequence)
+	return &acc, nil
+}
+
+func (fd *fakeDex) CancelOrders(fromInfo keys.Info, _, orderIDs, _ string, _, seqNum uint64) (sdk.TxResponse,
+	error) {
+	if !fromInfo.GetAddress().Equals(fd.owner.Address) || seqNum != fd.sequence {
+		return sdk.TxResponse{}, errors.New("invalid cancellation")
+	}
+	fd.sequence++
+	fd.cancelled = append(fd.cancelled, orderIDs)
+	return sdk.TxResponse{TxHash: "cancel"}, nil
+}
+
+func (fd *fakeDex) PlaceOrders(_ keys.Info, _ string, orderItems []ordertypes.OrderItem, _ string, _,
+	seqNum uint64) ([]ordertypes.OrderResult, sdk.TxResponse, error) {
+	if fd.placeErr != nil {
+		return nil, sdk.TxResponse{}, fd.placeErr
+	}
+	if seqNum != fd.sequence {
+		return nil, sdk.TxResponse{}, errors.New("invalid placement")
+	}
+	fd.sequence++
+	for _, item := range orderItems {
+		fd.placed = append(fd.placed, strings.Join([]string{item.Product, item.Side, item.Price.String(),
+			item.Quantity.String()}, "/"))
+	}
+	return nil, sdk.TxResponse{TxHash: "place"}, nil
+}
+
+func newFakeDex(t *testing.T) *fakeDex {
+	owner := testutil.NewAccounts(t, testutil.FixtureAccountsSeed, 1)[0]
+	fd := &fakeDex{
+		owner:        owner,
+		height:       1000,
+		expireBlocks: 500,
+	}
+	for i, height := range []int64{100, 520, 900} {
+		fd.orders = append(fd.orders, backendtypes.Order{
+			OrderID:        fmt.Sprintf("ID%010d-%d", height, i+1),
+			Sender:         owner.Address.String(),
+			Product:        "xxb-000_okt",
+			Side:           "BUY",
+			Price:          "0.1",
+			Quantity:       "10",
+			RemainQuantity: "2.5",
+		})
+	}
+	return fd
+}
+
+func TestWatcher_Check(t *testing.T) {
+	fd := newFakeDex(t)
+	config := Config{Addr: fd.owner.Address.String()}
+
+	// warning only
+	watcher, err := NewWatcher(fd, fd, fd, fd, config)
+	require.NoError(t, err)
+	events, err := watcher.Check()
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	// expired already but still open till it's pruned
+	require.Equal(t, fd.orders[0].OrderID, events[0].Order.OrderID)
+	require.Equal(t, int64(600), events[0].ExpireHeight)
+	require.Equal(t, int64(-400), events[0].BlocksLeft)
+	require.Equal(t, int64(20), events[1].BlocksLeft)
+	require.Equal(t, ActionWarned, events[1].Action)
+	require.Nil(t, events[1].Cancel)
+
+	// the expiry heights cached
+	_, err = watcher.Check()
+	require.NoError(t, err)
+	require.Equal(t, 3, fd.detailCalls)
+
+	// auto re-placement
+	fd.orders = fd.orders[1:]
+	config.Replace = &ReplaceConfig{
+		Owner:  fd.owner.ImportToKeybase(t),
+		PassWd: testutil.AccountPassWd,
+	}
+	watcher, err = NewWatcher(fd, fd, fd, fd, config)
+	require.NoError(t, err)
+	events, err = watcher.Check()
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.NoError(t, events[0].Err)
+	require.Equal(t, ActionReplaced, events[0].Action)
+	require.Equal(t, "place", events[0].Place.TxHash)
+	require.Equal(t, []string{fd.orders[0].OrderID}, fd.cancelled)
+	require.Equal(t, []string{"xxb-000_okt/BUY/0.10000000/2.50000000"}, fd.placed)
+
+	// cancelled but failed to place again
+	fd.placeErr = errors.New("default error")
+	events, err = watcher.Check()
+	require.NoError(t, err)
+	require.Error(t, events[0].Err)
+	require.Equal(t, ActionReplaced, events[0].Action)
+	require.NotNil(t, events[0].Cancel)
+	require.Nil(t, events[0].Place)
+}
+
+func TestWatcher_Run(t *testing.T) {
+	fd := newFakeDex(t)
+	watcher, err := NewWatcher(fd, fd, fd, fd, Config{Addr: fd.owner.Address.String(), WarnBlocks: 10})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	events := watcher.Run(ctx)
+	event := <-events
+	require.Equal(t, fd.orders[0].OrderID, event.Order.OrderID)
+	cancel()
+	for range events {
+	}
+}
+
+func TestWatcher_Errors(t *testing.T) {
+	fd := newFakeDex(t)
+	other := testutil.NewAccounts(t, testutil.FixtureAccountsSeed, 2)[1]
+	_, err := NewWatcher(fd, fd, fd, fd, Config{Addr: "invalid"})
+	require.Error(t, err)
+	_, err = NewWatcher(fd, fd, fd, fd, Config{Addr: fd.owner.Address.String(), WarnBlocks: -1})
+	require.Error(t, err)
+	_, err = NewWatcher(fd, fd, fd, fd, Config{
+		Addr:    fd.owner.Address.String(),
+		Replace: &ReplaceConfig{Owner: other.ImportToKeybase(t), PassWd: testutil.AccountPassWd},
+	})
+	require.Error(t, err)
+
+	// the order with an unknown ID reported with the error
+	fd.orders[1].OrderID = "invalid"
+	watcher, err := NewWatcher(fd, fd, fd, fd, Config{Addr: fd.owner.Address.String()})
+	require.NoError(t, err)
+	events, err := watcher.Check()
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	require.Error(t, events[1].Err)
+}
+
+func TestParseOrderHeight(t *testing.T) {
+	height, err := ParseOrderHeight("ID0000000010-1")
+	require.NoError(t, err)
+	require.Equal(t, int64(10), height)
+
+	for _, orderID := range []string{"", "ID", "0000000010-1", "ID0000000010", "IDxx-1", "ID0000000000-1"} {
+		_, err = ParseOrderHeight(orderID)
+		require.Error(t, err, orderID)
+	}
+}
@@ -0,0 +1,129 @@
+package gosdk
+
+import (
+	"fmt"
+
+	"github.com/okex/okchain-go-sdk/module/auth"
+	authtypes "github.com/okex/okchain-go-sdk/module/auth/types"
+	"github.com/okex/okchain-go-sdk/module/backend"
+	backendtypes "github.com/okex/okchain-go-sdk/module/backend/types"
+	"github.com/okex/okchain-go-sdk/module/staking"
+	stakingtypes "github.com/okex/okchain-go-sdk/module/staking/types"
+	"github.com/okex/okchain-go-sdk/module/token"
+	tokentypes "github.com/okex/okchain-go-sdk/module/token/types"
+	sdk "github.com/okex/okchain-go-sdk/types"
+)
+
+// QueryGroup - structure of a group of the queries run against a single height resolved once, so that their results
+// are a consistent snapshot instead of the states at the different latest heights, e.g. the balances and the
+// delegations of a dashboard moving across a block in between
+type QueryGroup struct {
+	view    Client
+	queries []func(view Client) error
+}
+
+// NewQueryGroup creates a query group reading the state by the consistency option. Other than WithConsistency, the
+// latest height is pinned when the group is created, so sdk.Latest() reads the state of the latest block then
+func (cli *Client) NewQueryGroup(consistency sdk.Consistency) (*QueryGroup, error) {
+	if consistency.Mode == sdk.ConsistencyLatest {
+		res, err := cli.baseClient.Commit(nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed. query latest height error: %w", err)
+		}
+		consistency = sdk.AtHeight(res.Height)
+	}
+
+	view, err := cli.WithConsistency(consistency)
+	if err != nil {
+		return nil, err
+	}
+	return &QueryGroup{view: view}, nil
+}
+
+// Height returns the height of the state that the queries of the group read
+func (qg *QueryGroup) Height() int64 {
+	return qg.view.QueryHeight()
+}
+
+// View returns the view of the client at the height of the group for the queries run directly
+func (qg *QueryGroup) View() Client {
+	return qg.view
+}
+
+// Add adds the query run on the view at the height of the group by Run, which keeps its results by itself, e.g.
+//
+//	group.Add(func(view gosdk.Client) (err error) {
+//		validators, err = view.Staking().QueryValidators()
+//		return
+//	})
+func (qg *QueryGroup) Add(query func(view Client) error) *QueryGroup {
+	qg.queries = append(qg.queries, query)
+	return qg
+}
+
+// Run runs the queries added in order, and stops at the first one failed
+func (qg *QueryGroup) Run() error {
+	for i, query := range qg.queries {
+		if err := query(qg.view); err != nil {
+			return fmt.Errorf("failed. query %d of the group at height %d error: %w", i, qg.Height(), err)
+		}
+	}
+	return nil
+}
+
+// AccountSnapshot - structure of the state of an account at a single height
+type AccountSnapshot struct {
+	Height  int64
+	Address string
+	Account authtypes.Account
+	// Tokens are the balances with the frozen and locked amounts, empty without the token module
+	Tokens tokentypes.AccountTokensInfo
+	// Delegator is the delegation and the unbonding, empty without the staking module
+	Delegator stakingtypes.DelegatorResp
+	// OpenOrders are empty without the backend module
+	OpenOrders []backendtypes.Order
+}
+
+// QueryAccountSnapshot queries the account, its balances, delegations and open orders at the height of the group, where
+// the queries of the modules not registered are skipped
+// NOTE: the open orders are read from the db of the backend module, which has no historical state but the latest one
+func (qg *QueryGroup) QueryAccountSnapshot(accAddrStr string) (snapshot AccountSnapshot, err error) {
+	snapshot = AccountSnapshot{
+		Height:  qg.Height(),
+		Address: accAddrStr,
+	}
+
+	group := QueryGroup{view: qg.view}
+	if group.view.HasModule(auth.ModuleName) {
+		group.Add(func(view Client) (err error) {
+			snapshot.Account, err = view.Auth().QueryAccount(accAddrStr)
+			return
+		})
+	}
+	if group.view.HasModule(token.ModuleName) {
+		group.Add(func(view Client) (err error) {
+			snapshot.Tokens, err = view.Token().QueryAccountTokensInfo(accAddrStr)
+			return
+		})
+	}
+	if group.view.HasModule(staking.ModuleName) {
+		group.Add(func(view Client) (err error) {
+			snapshot.Delegator, err = view.Staking().QueryDelegator(accAddrStr)
+			return
+		})
+	}
+	if group.view.HasModule(backend.ModuleName) {
+		group.Add(func(view Client) error {
+			return sdk.FetchAllPages(sdk.NewPageRequest(1, 0), func(pr sdk.PageRequest) (sdk.PagedResult, error) {
+				orders, pagedRes, err := view.Backend().QueryOpenOrdersPaged(accAddrStr, "", "", 0, 0, pr)
+				snapshot.OpenOrders = append(snapshot.OpenOrders, orders...)
+				return pagedRes, err
+			})
+		})
+	}
+
+	if err = group.Run(); err != nil {
+		return AccountSnapshot{}, err
+	}
+	return
+}
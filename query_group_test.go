@@ -0,0 +1,87 @@
+package gosdk
+
+import (
+	"testing"
+
+	"github.com/okex/okchain-go-sdk/mocks"
+	"github.com/okex/okchain-go-sdk/module/auth"
+	authtypes "github.com/okex/okchain-go-sdk/module/auth/types"
+	"github.com/okex/okchain-go-sdk/module/staking"
+	stakingtypes "github.com/okex/okchain-go-sdk/module/staking/types"
+	"github.com/okex/okchain-go-sdk/module/tendermint"
+	"github.com/okex/okchain-go-sdk/testutil"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// latestBackend answers the latest height by a new block each time
+type latestBackend struct {
+	*mocks.ConformanceBackend
+	latestHeight int64
+}
+
+func (lb *latestBackend) Commit(*int64) (*ctypes.ResultCommit, error) {
+	lb.latestHeight++
+	return &ctypes.ResultCommit{
+		SignedHeader: tmtypes.SignedHeader{Header: &tmtypes.Header{Height: lb.latestHeight}},
+	}, nil
+}
+
+func TestClient_NewQueryGroup(t *testing.T) {
+	config, err := sdk.NewClientConfig("testURL", "okchain", sdk.BroadcastBlock, "0.01okt", 200000,
+		0, "")
+	require.NoError(t, err)
+	backend := &latestBackend{ConformanceBackend: mocks.NewConformanceBackend(), latestHeight: 1023}
+	cli := newClientWithRPC(config, []string{auth.ModuleName, staking.ModuleName, tendermint.ModuleName}, backend)
+
+	user := testutil.NewAccounts(t, testutil.FixtureAccountsSeed, 1)[0]
+	var account authtypes.Account = &authtypes.BaseAccount{
+		Address:       user.Address,
+		Coins:         user.Coins,
+		AccountNumber: user.AccountNumber,
+		Sequence:      3,
+	}
+	bz, err := cli.cdc.MarshalBinaryBare(account)
+	require.NoError(t, err)
+	backend.SetResponse(authtypes.AccountInfoPath, authtypes.GetAddressStoreKey(user.Address),
+		abci.ResponseQuery{Value: bz})
+	backend.SetResponse("/store/staking/key", stakingtypes.GetDelegatorKey(user.Address), abci.ResponseQuery{})
+
+	// the latest height is pinned once for all the queries of the group
+	group, err := cli.NewQueryGroup(sdk.Latest())
+	require.NoError(t, err)
+	require.Equal(t, int64(1024), group.Height())
+	snapshot, err := group.QueryAccountSnapshot(user.Address.String())
+	require.NoError(t, err)
+	require.Equal(t, int64(1024), snapshot.Height)
+	require.Equal(t, user.Coins, snapshot.Account.GetCoins())
+	require.Equal(t, user.Address, snapshot.Delegator.DelegatorAddress)
+	require.Empty(t, snapshot.OpenOrders)
+
+	var sequence uint64
+	require.NoError(t, group.Add(func(view Client) error {
+		acc, err := view.Auth().QueryAccount(user.Address.String())
+		sequence = acc.GetSequence()
+		return err
+	}).Run())
+	require.Equal(t, uint64(3), sequence)
+	calls := backend.Calls()
+	require.Len(t, calls, 4)
+	for _, call := range calls {
+		require.Equal(t, int64(1024), call.Height)
+	}
+
+	// the first failure stops the group
+	_, err = group.QueryAccountSnapshot(testutil.NewAccounts(t, "other", 1)[0].Address.String())
+	require.Error(t, err)
+	require.Len(t, backend.Calls(), 5)
+
+	finalized, err := cli.NewQueryGroup(sdk.Finalized(3))
+	require.NoError(t, err)
+	require.Equal(t, int64(1022), finalized.Height())
+	_, err = cli.NewQueryGroup(sdk.AtHeight(0))
+	require.Error(t, err)
+}
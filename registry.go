@@ -0,0 +1,111 @@
+package gosdk
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	sdk "github.com/okex/okchain-go-sdk/types"
+)
+
+// ClientRegistry - structure of the registry of the clients on multiple chains, e.g. the mainnet, the testnet and a
+// private fork, routing the calls by the chain ID. Every client has its own codec and rpc connection, while the
+// keystore is shared by all of them
+type ClientRegistry struct {
+	mtx     sync.RWMutex
+	clients map[string]*Client
+}
+
+// NewClientRegistry creates a new instance of ClientRegistry
+func NewClientRegistry() *ClientRegistry {
+	return &ClientRegistry{
+		clients: make(map[string]*Client),
+	}
+}
+
+// Register creates a client by the config with the modules, or all the modules if none, and registers it by the chain
+// ID of the config
+func (r *ClientRegistry) Register(config sdk.ClientConfig, moduleNames ...string) (*Client, error) {
+	var cli Client
+	if len(moduleNames) == 0 {
+		cli = NewClient(config)
+	} else {
+		var err error
+		if cli, err = NewClientWithModules(config, moduleNames...); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := r.Add(&cli); err != nil {
+		return nil, err
+	}
+	return &cli, nil
+}
+
+// Add registers a client created already by the chain ID of its client context
+func (r *ClientRegistry) Add(cli *Client) error {
+	chainID := cli.GetClientContext().ChainID()
+	if len(chainID) == 0 {
+		return errors.New("failed. empty chain ID of the client")
+	}
+
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	if _, ok := r.clients[chainID]; ok {
+		return fmt.Errorf("failed. client of chain %s is registered already", chainID)
+	}
+	r.clients[chainID] = cli
+	return nil
+}
+
+// Remove unregisters the client of the chain ID
+func (r *ClientRegistry) Remove(chainID string) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	delete(r.clients, chainID)
+}
+
+// Get returns the client of the chain ID
+func (r *ClientRegistry) Get(chainID string) (*Client, error) {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+	cli, ok := r.clients[chainID]
+	if !ok {
+		return nil, fmt.Errorf("failed. no client of chain %s registered", chainID)
+	}
+	return cli, nil
+}
+
+// ChainIDs returns the chain IDs of all the clients registered in order
+func (r *ClientRegistry) ChainIDs() []string {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+	chainIDs := make([]string, 0, len(r.clients))
+	for chainID := range r.clients {
+		chainIDs = append(chainIDs, chainID)
+	}
+	sort.Strings(chainIDs)
+	return chainIDs
+}
+
+// BuildAndBroadcast builds, signs and broadcasts the msgs on the chain of the chain ID by the key in the shared
+// keystore
+func (r *ClientRegistry) BuildAndBroadcast(chainID, fromName, passWd, memo string, msgs []sdk.Msg, accNum,
+	seqNum uint64, opts ...sdk.TxOption) (resp sdk.TxResponse, err error) {
+	cli, err := r.Get(chainID)
+	if err != nil {
+		return
+	}
+	return cli.BuildAndBroadcast(fromName, passWd, memo, msgs, accNum, seqNum, opts...)
+}
+
+// BroadcastRawTx broadcasts the pre-signed tx bytes on the chain of the chain ID
+func (r *ClientRegistry) BroadcastRawTx(chainID string, txBytes []byte, broadcastMode sdk.BroadcastMode) (
+	resp sdk.TxResponse, err error) {
+	cli, err := r.Get(chainID)
+	if err != nil {
+		return
+	}
+	return cli.BroadcastRawTx(txBytes, broadcastMode)
+}
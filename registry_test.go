@@ -0,0 +1,57 @@
+package gosdk
+
+import (
+	"testing"
+
+	"github.com/okex/okchain-go-sdk/module/dex"
+	"github.com/okex/okchain-go-sdk/module/staking"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientRegistry(t *testing.T) {
+	mainnetConfig, err := sdk.NewClientConfig("mainnetURL", "okchain", sdk.BroadcastBlock, "", 200000,
+		1.1, "0.00000001okt")
+	require.NoError(t, err)
+	testnetConfig, err := sdk.NewClientConfig("testnetURL", "okchain-testnet", sdk.BroadcastSync, "0.01okt",
+		200000, 0, "")
+	require.NoError(t, err)
+
+	registry := NewClientRegistry()
+	mainnet, err := registry.Register(mainnetConfig)
+	require.NoError(t, err)
+	require.True(t, mainnet.HasModule(dex.ModuleName))
+	testnet, err := registry.Register(testnetConfig, staking.ModuleName)
+	require.NoError(t, err)
+	require.False(t, testnet.HasModule(dex.ModuleName))
+	require.Equal(t, []string{"okchain", "okchain-testnet"}, registry.ChainIDs())
+
+	// routed by the chain ID
+	cli, err := registry.Get("okchain-testnet")
+	require.NoError(t, err)
+	require.Equal(t, testnet, cli)
+	require.Equal(t, "testnetURL", cli.GetConfig().NodeURI)
+	// the codecs aren't shared
+	require.NotEqual(t, mainnet.cdc, testnet.cdc)
+
+	_, err = registry.Get("unknown")
+	require.Error(t, err)
+	_, err = registry.BroadcastRawTx("unknown", nil, sdk.BroadcastSync)
+	require.Error(t, err)
+	_, err = registry.BuildAndBroadcast("unknown", "alice", "12345678", "", nil, 1, 2)
+	require.Error(t, err)
+
+	// duplicate or empty chain IDs
+	_, err = registry.Register(mainnetConfig)
+	require.Error(t, err)
+	_, err = registry.Register(mainnetConfig, "unknown")
+	require.Error(t, err)
+	mainnetConfig.ChainID = ""
+	_, err = registry.Register(mainnetConfig)
+	require.Error(t, err)
+
+	registry.Remove("okchain")
+	require.Equal(t, []string{"okchain-testnet"}, registry.ChainIDs())
+	_, err = registry.Get("okchain")
+	require.Error(t, err)
+}
@@ -0,0 +1,169 @@
+package rewards
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/okex/okchain-go-sdk/exposed"
+	minttypes "github.com/okex/okchain-go-sdk/module/mint/types"
+	stakingtypes "github.com/okex/okchain-go-sdk/module/staking/types"
+	sdk "github.com/okex/okchain-go-sdk/types"
+)
+
+// RewardEstimate - structure of the rewards expected for a delegation to a validator at the current chain state
+type RewardEstimate struct {
+	Validator sdk.ValAddress
+	Moniker   string
+	// Amount is the delegation amount in the bond denom, and Votes are the votes it adds to the validator
+	Amount sdk.Dec
+	Votes  sdk.Dec
+	// CommissionRate is the ratio of the rewards kept by the validator
+	CommissionRate sdk.Dec
+	// Denom is the mint denom the rewards are paid in
+	Denom string
+	// PerBlock and Annual are the rewards of the delegation expected per block and per year
+	PerBlock sdk.Dec
+	Annual   sdk.Dec
+	// APR is the annual rewards over the amount, zero if the validator isn't bonded
+	APR sdk.Dec
+}
+
+// rewardParams - structure of the chain state the rewards are estimated by, queried once for all the validators
+type rewardParams struct {
+	bondDenom        string
+	mintParams       minttypes.Params
+	blockRewards     sdk.Dec
+	totalVotes       sdk.Dec
+	bondedValidators []stakingtypes.Validator
+	validators       []stakingtypes.Validator
+}
+
+// Estimator - structure of the estimator of the delegator rewards by the inflation, the commission and the votes of
+// the validators queried, e.g. for the wallets to show the APR of the validators
+type Estimator struct {
+	staking exposed.StakingQuery
+	distr   exposed.DistrQuery
+	mint    exposed.MintQuery
+}
+
+// NewEstimator creates a new instance of Estimator
+func NewEstimator(staking exposed.StakingQuery, distr exposed.DistrQuery, mint exposed.MintQuery) *Estimator {
+	return &Estimator{
+		staking: staking,
+		distr:   distr,
+		mint:    mint,
+	}
+}
+
+// Estimate estimates the rewards of delegating the amount of the bond denom to the validator. The block rewards minted
+// by the annual provisions are shared by the bonded validators in proportion to their votes after the community tax,
+// and the delegation earns its share of the votes of the validator after the commission
+// NOTE: it's an estimate at the current inflation, votes and commission, without the proposer bonus, and the votes of
+// the amount are converted at the current votes per token of the validator
+func (e *Estimator) Estimate(valAddrStr string, amount sdk.Dec) (estimate RewardEstimate, err error) {
+	params, err := e.queryRewardParams()
+	if err != nil {
+		return
+	}
+
+	for _, val := range params.validators {
+		if val.OperatorAddress.String() == valAddrStr {
+			return params.estimate(val, amount)
+		}
+	}
+	return estimate, fmt.Errorf("failed. no validator found with address %s", valAddrStr)
+}
+
+// EstimateAll estimates the rewards of delegating the amount of the bond denom to each bonded validator, e.g. for the
+// list of the validators with their APRs
+func (e *Estimator) EstimateAll(amount sdk.Dec) (estimates []RewardEstimate, err error) {
+	params, err := e.queryRewardParams()
+	if err != nil {
+		return
+	}
+
+	for _, val := range params.bondedValidators {
+		estimate, err := params.estimate(val, amount)
+		if err != nil {
+			return nil, err
+		}
+		estimates = append(estimates, estimate)
+	}
+	return
+}
+
+func (e *Estimator) queryRewardParams() (params rewardParams, err error) {
+	stakingParams, err := e.staking.QueryStakingParams()
+	if err != nil {
+		return params, fmt.Errorf("failed. query staking params error: %w", err)
+	}
+	params.bondDenom = stakingParams.BondDenom
+
+	if params.mintParams, err = e.mint.QueryMintParams(); err != nil {
+		return params, fmt.Errorf("failed. query mint params error: %w", err)
+	}
+	if params.mintParams.BlocksPerYear == 0 {
+		return params, errors.New("failed. invalid blocks per year 0 in the mint params")
+	}
+	annualProvisions, err := e.mint.QueryAnnualProvisions()
+	if err != nil {
+		return params, fmt.Errorf("failed. query annual provisions error: %w", err)
+	}
+	communityTax, err := e.distr.QueryCommunityTax()
+	if err != nil {
+		return params, fmt.Errorf("failed. query community tax error: %w", err)
+	}
+	params.blockRewards = annualProvisions.QuoInt64(int64(params.mintParams.BlocksPerYear)).
+		Mul(sdk.OneDec().Sub(communityTax))
+
+	if params.validators, err = e.staking.QueryValidators(); err != nil {
+		return params, fmt.Errorf("failed. query validators error: %w", err)
+	}
+	params.totalVotes = sdk.ZeroDec()
+	for _, val := range params.validators {
+		if val.IsBonded() {
+			params.bondedValidators = append(params.bondedValidators, val)
+			params.totalVotes = params.totalVotes.Add(val.DelegatorShares)
+		}
+	}
+	return
+}
+
+func (params rewardParams) estimate(val stakingtypes.Validator, amount sdk.Dec) (estimate RewardEstimate, err error) {
+	if amount.IsNil() || !amount.IsPositive() {
+		return estimate, fmt.Errorf("failed. invalid delegation amount %s", amount)
+	}
+	if params.mintParams.MintDenom != params.bondDenom {
+		return estimate, fmt.Errorf("failed. mint denom %s differs from bond denom %s", params.mintParams.MintDenom,
+			params.bondDenom)
+	}
+
+	estimate = RewardEstimate{
+		Validator:      val.OperatorAddress,
+		Moniker:        val.Description.Moniker,
+		Amount:         amount,
+		Votes:          amount,
+		CommissionRate: sdk.ZeroDec(),
+		Denom:          params.mintParams.MintDenom,
+		PerBlock:       sdk.ZeroDec(),
+		Annual:         sdk.ZeroDec(),
+		APR:            sdk.ZeroDec(),
+	}
+	if !val.Commission.Rate.IsNil() {
+		estimate.CommissionRate = val.Commission.Rate
+	}
+	if (val.Tokens != sdk.Int{}) && val.Tokens.IsPositive() {
+		estimate.Votes = amount.Mul(val.DelegatorShares).QuoInt(val.Tokens)
+	}
+	// the validators out of the validator set earn nothing
+	if !val.IsBonded() {
+		return
+	}
+
+	// the delegation adds its votes to the validator and to the total votes as well
+	estimate.PerBlock = params.blockRewards.Mul(sdk.OneDec().Sub(estimate.CommissionRate)).Mul(estimate.Votes).
+		Quo(params.totalVotes.Add(estimate.Votes))
+	estimate.Annual = estimate.PerBlock.MulInt64(int64(params.mintParams.BlocksPerYear))
+	estimate.APR = estimate.Annual.Quo(amount)
+	return
+}
@@ -0,0 +1,100 @@
+package rewards
+
+import (
+	"testing"
+
+	"github.com/okex/okchain-go-sdk/exposed"
+	minttypes "github.com/okex/okchain-go-sdk/module/mint/types"
+	stakingtypes "github.com/okex/okchain-go-sdk/module/staking/types"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+)
+
+// fakeInflation serves the validators and the inflation params from memory
+type fakeInflation struct {
+	// the queries out of the estimator are never called
+	exposed.StakingQuery
+	exposed.DistrQuery
+	vals      []stakingtypes.Validator
+	mintDenom string
+}
+
+func (fi *fakeInflation) QueryStakingParams() (stakingtypes.Params, error) {
+	return stakingtypes.Params{BondDenom: "okt"}, nil
+}
+
+func (fi *fakeInflation) QueryValidators() ([]stakingtypes.Validator, error) {
+	return fi.vals, nil
+}
+
+func (fi *fakeInflation) QueryCommunityTax() (sdk.Dec, error) {
+	return sdk.MustNewDecFromStr("0.02"), nil
+}
+
+func (fi *fakeInflation) QueryMintParams() (minttypes.Params, error) {
+	return minttypes.Params{MintDenom: fi.mintDenom, BlocksPerYear: 1000}, nil
+}
+
+func (fi *fakeInflation) QueryInflation() (sdk.Dec, error) {
+	return sdk.MustNewDecFromStr("0.1"), nil
+}
+
+func (fi *fakeInflation) QueryAnnualProvisions() (sdk.Dec, error) {
+	return sdk.NewDec(10000), nil
+}
+
+func newTestValidator(moniker string, status byte, tokens, votes int64, commissionRate string) stakingtypes.Validator {
+	return stakingtypes.Validator{
+		OperatorAddress: sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address()),
+		Status:          status,
+		Tokens:          sdk.NewInt(tokens),
+		DelegatorShares: sdk.NewDec(votes),
+		Description:     stakingtypes.Description{Moniker: moniker},
+		Commission: stakingtypes.Commission{
+			CommissionRates: stakingtypes.CommissionRates{Rate: sdk.MustNewDecFromStr(commissionRate)},
+		},
+	}
+}
+
+func TestEstimator(t *testing.T) {
+	fi := &fakeInflation{
+		vals: []stakingtypes.Validator{
+			newTestValidator("alice", stakingtypes.Bonded, 500, 500, "0.1"),
+			// 2 votes per token
+			newTestValidator("bob", stakingtypes.Bonded, 200, 400, "0"),
+			newTestValidator("carol", stakingtypes.Unbonded, 100, 100, "0"),
+		},
+		mintDenom: "okt",
+	}
+	estimator := NewEstimator(fi, fi, fi)
+
+	// 9.8okt per block after the community tax, shared by the votes of 1000 with the delegation
+	estimates, err := estimator.EstimateAll(sdk.NewDec(100))
+	require.NoError(t, err)
+	require.Len(t, estimates, 2)
+	require.Equal(t, "alice", estimates[0].Moniker)
+	require.Equal(t, "okt", estimates[0].Denom)
+	require.Equal(t, sdk.MustNewDecFromStr("0.882"), estimates[0].PerBlock)
+	require.Equal(t, sdk.NewDec(882), estimates[0].Annual)
+	require.Equal(t, sdk.MustNewDecFromStr("8.82"), estimates[0].APR)
+
+	estimate, err := estimator.Estimate(fi.vals[1].OperatorAddress.String(), sdk.NewDec(50))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(100), estimate.Votes)
+	require.Equal(t, sdk.MustNewDecFromStr("0.98"), estimate.PerBlock)
+	require.Equal(t, sdk.MustNewDecFromStr("19.6"), estimate.APR)
+
+	// the validators out of the validator set earn nothing
+	estimate, err = estimator.Estimate(fi.vals[2].OperatorAddress.String(), sdk.NewDec(50))
+	require.NoError(t, err)
+	require.True(t, estimate.APR.IsZero())
+
+	_, err = estimator.Estimate(sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address()).String(), sdk.NewDec(50))
+	require.Error(t, err)
+	_, err = estimator.EstimateAll(sdk.ZeroDec())
+	require.Error(t, err)
+	fi.mintDenom = "okb"
+	_, err = estimator.EstimateAll(sdk.NewDec(100))
+	require.Error(t, err)
+}
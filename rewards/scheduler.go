@@ -0,0 +1,258 @@
+// Package rewards provides the scheduler withdrawing the rewards of the validators under management, which withdraws
+// the commission of each validator once per period in small batches within the configured windows, and retries the
+// failed withdrawals on the later rounds, and the estimator of the rewards expected for a delegation by the inflation,
+// the commissions and the votes of the validators.
+package rewards
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/okex/okchain-go-sdk/exposed"
+	distrtypes "github.com/okex/okchain-go-sdk/module/distribution/types"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	"github.com/okex/okchain-go-sdk/types/crypto/keys"
+)
+
+const (
+	defaultPeriod       = 24 * time.Hour
+	defaultBatchSize    = 10
+	defaultRetryBackoff = time.Minute
+)
+
+// Window - structure of a daily window in UTC, e.g. from 2h to 4h, which wraps around the midnight if the start is
+// after the end
+type Window struct {
+	Start time.Duration
+	End   time.Duration
+}
+
+// Contains tells whether the time is in the window
+func (w Window) Contains(t time.Time) bool {
+	t = t.UTC()
+	offset := t.Sub(time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC))
+	if w.Start <= w.End {
+		return offset >= w.Start && offset < w.End
+	}
+	return offset >= w.Start || offset < w.End
+}
+
+// Config - structure of the config of the withdrawal scheduler
+type Config struct {
+	// Operators are the key infos of the operator accounts of the validators, unlocked by the same PassWd
+	Operators []keys.Info
+	PassWd    string
+	// Period is the min time between two withdrawals of a validator, which saves the fees of withdrawing the small
+	// rewards, 24h by default
+	Period time.Duration
+	// BatchSize is the max number of the withdrawals in a round, 10 by default
+	BatchSize int
+	// Windows are the daily windows the withdrawals are made in, e.g. the off-peak hours, anytime if empty
+	Windows []Window
+	// Interval is the pause between two rounds
+	Interval time.Duration
+	// MaxRetries is the max number of the retries of a failed withdrawal in a period, after which the validator waits
+	// for the next period
+	MaxRetries int
+	// RetryBackoff is the wait before the first retry, doubled on each retry, 1m by default
+	RetryBackoff time.Duration
+	// Fee fixes the fee of each withdrawal instead of the fees or the gas simulation of the client config, optional
+	Fee *sdk.StdFee
+}
+
+// ValidateBasic gives a quick validity check for the scheduler config
+func (c Config) ValidateBasic() error {
+	if len(c.Operators) == 0 {
+		return errors.New("failed. no operator to withdraw for")
+	}
+	if len(c.PassWd) == 0 {
+		return errors.New("failed. empty password of the operators")
+	}
+	if c.Period < 0 || c.Interval < 0 || c.RetryBackoff < 0 {
+		return errors.New("failed. period, interval and retry backoff must not be negative")
+	}
+	if c.BatchSize < 0 || c.MaxRetries < 0 {
+		return errors.New("failed. batch size and max retries must not be negative")
+	}
+	for _, window := range c.Windows {
+		if window.Start < 0 || window.End < 0 || window.Start == window.End {
+			return fmt.Errorf("failed. invalid window from %s to %s", window.Start, window.End)
+		}
+	}
+	return nil
+}
+
+// Result - structure of the result of a withdrawal of a validator
+type Result struct {
+	Operator sdk.AccAddress
+	ValAddr  sdk.ValAddress
+	// Attempt counts the attempts of the withdrawal in the period, 1 for the first one
+	Attempt int
+	Resp    sdk.TxResponse
+	// Err is the failure of the withdrawal, which is retried unless GaveUp
+	Err    error
+	GaveUp bool
+}
+
+// operatorState - structure of the withdrawal state of an operator
+type operatorState struct {
+	info keys.Info
+	// due is the time of the next withdrawal or retry
+	due      time.Time
+	failures int
+}
+
+// Scheduler - structure of the scheduler withdrawing the rewards of the validators periodically
+type Scheduler struct {
+	auth      exposed.AuthQuery
+	txHandler sdk.TxHandler
+	config    Config
+	operators []*operatorState
+	now       func() time.Time
+}
+
+// NewScheduler creates a new instance of Scheduler, whose first round withdraws for all the validators
+func NewScheduler(auth exposed.AuthQuery, txHandler sdk.TxHandler, config Config) (*Scheduler, error) {
+	if err := config.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if config.Period == 0 {
+		config.Period = defaultPeriod
+	}
+	if config.BatchSize == 0 {
+		config.BatchSize = defaultBatchSize
+	}
+	if config.RetryBackoff == 0 {
+		config.RetryBackoff = defaultRetryBackoff
+	}
+
+	operators := make([]*operatorState, len(config.Operators))
+	for i, info := range config.Operators {
+		operators[i] = &operatorState{info: info}
+	}
+	return &Scheduler{
+		auth:      auth,
+		txHandler: txHandler,
+		config:    config,
+		operators: operators,
+		now:       time.Now,
+	}, nil
+}
+
+// RunOnce makes a round of the withdrawals of the validators due, up to the batch size, if it's in a window. The
+// validators overdue longest go first
+func (s *Scheduler) RunOnce() (results []Result) {
+	now := s.now()
+	if !s.inWindow(now) {
+		return
+	}
+
+	for _, operator := range s.dueOperators(now) {
+		results = append(results, s.withdraw(operator, now))
+	}
+	return
+}
+
+func (s *Scheduler) inWindow(now time.Time) bool {
+	if len(s.config.Windows) == 0 {
+		return true
+	}
+	for _, window := range s.config.Windows {
+		if window.Contains(now) {
+			return true
+		}
+	}
+	return false
+}
+
+// dueOperators picks the operators due by their due times, up to the batch size
+func (s *Scheduler) dueOperators(now time.Time) (due []*operatorState) {
+	for _, operator := range s.operators {
+		if !operator.due.After(now) {
+			due = append(due, operator)
+		}
+	}
+	// insertion sort keeps the order of the config for the same due times
+	for i := 1; i < len(due); i++ {
+		for j := i; j > 0 && due[j].due.Before(due[j-1].due); j-- {
+			due[j], due[j-1] = due[j-1], due[j]
+		}
+	}
+	if len(due) > s.config.BatchSize {
+		due = due[:s.config.BatchSize]
+	}
+	return
+}
+
+// withdraw withdraws the commission of the validator of the operator, and schedules the next withdrawal or the retry
+func (s *Scheduler) withdraw(operator *operatorState, now time.Time) (result Result) {
+	result.Operator = operator.info.GetAddress()
+	result.ValAddr = sdk.ValAddress(result.Operator)
+	result.Attempt = operator.failures + 1
+	result.Resp, result.Err = s.broadcast(operator.info, result.ValAddr)
+
+	if result.Err == nil {
+		operator.failures = 0
+		operator.due = now.Add(s.config.Period)
+		return
+	}
+
+	operator.failures++
+	if operator.failures > s.config.MaxRetries {
+		result.GaveUp = true
+		operator.failures = 0
+		operator.due = now.Add(s.config.Period)
+		return
+	}
+	operator.due = now.Add(s.config.RetryBackoff << uint(operator.failures-1))
+	return
+}
+
+func (s *Scheduler) broadcast(info keys.Info, valAddr sdk.ValAddress) (resp sdk.TxResponse, err error) {
+	acc, err := s.auth.QueryAccount(info.GetAddress().String())
+	if err != nil {
+		return resp, fmt.Errorf("failed. query account %s error: %w", info.GetAddress(), err)
+	}
+
+	var opts []sdk.TxOption
+	if s.config.Fee != nil {
+		opts = append(opts, sdk.WithFee(*s.config.Fee))
+	}
+	msgs := []sdk.Msg{distrtypes.NewMsgWithdrawValCommission(valAddr)}
+	resp, err = s.txHandler.BuildAndBroadcast(info.GetName(), s.config.PassWd, "", msgs, acc.GetAccountNumber(),
+		acc.GetSequence(), opts...)
+	if err != nil {
+		return resp, fmt.Errorf("failed. withdraw rewards of %s error: %w", valAddr, err)
+	}
+	if resp.Code != 0 {
+		return resp, fmt.Errorf("failed. withdraw rewards of %s with code %d: %s", valAddr, resp.Code, resp.RawLog)
+	}
+	return
+}
+
+// Run makes the rounds by the interval until the ctx is done, and sends the results of the rounds with any withdrawal
+// to the channel returned. The channel is closed when the ctx is done
+func (s *Scheduler) Run(ctx context.Context) <-chan []Result {
+	rounds := make(chan []Result, 1)
+	go func() {
+		defer close(rounds)
+		for {
+			if results := s.RunOnce(); len(results) != 0 {
+				select {
+				case rounds <- results:
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			select {
+			case <-time.After(s.config.Interval):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return rounds
+}
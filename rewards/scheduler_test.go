@@ -0,0 +1,151 @@
+package rewards
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/okex/okchain-go-sdk/exposed"
+	"github.com/okex/okchain-go-sdk/mocks"
+	authtypes "github.com/okex/okchain-go-sdk/module/auth/types"
+	distrtypes "github.com/okex/okchain-go-sdk/module/distribution/types"
+	"github.com/okex/okchain-go-sdk/testutil"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	"github.com/okex/okchain-go-sdk/types/crypto/keys"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeChain serves the accounts from memory and records the withdrawals broadcast
+type fakeChain struct {
+	// the calls out of the scheduler are never made
+	exposed.AuthQuery
+	sdk.TxHandler
+	accounts map[string]*authtypes.BaseAccount
+	// failing maps the validators to the codes of their failed withdrawals
+	failing map[string]uint32
+	sent    []distrtypes.MsgWithdrawValCommission
+	fees    []*sdk.StdFee
+}
+
+func (fc *fakeChain) QueryAccount(accAddrStr string) (authtypes.Account, error) {
+	acc, ok := fc.accounts[accAddrStr]
+	if !ok {
+		return nil, errors.New("failed. your account has no record on the chain")
+	}
+	return acc, nil
+}
+
+func (fc *fakeChain) BuildAndBroadcast(_, _, _ string, msgs []sdk.Msg, _, _ uint64, opts ...sdk.TxOption) (
+	sdk.TxResponse, error) {
+	msg := msgs[0].(distrtypes.MsgWithdrawValCommission)
+	fc.sent = append(fc.sent, msg)
+	fc.fees = append(fc.fees, sdk.NewTxOptions(opts...).Fee)
+	resp := mocks.DefaultMockSuccessTxResponse()
+	resp.Code = fc.failing[msg.ValAddr.String()]
+	return resp, nil
+}
+
+func newTestScheduler(t *testing.T, n int, config Config) (*Scheduler, *fakeChain, []testutil.Account) {
+	accs := testutil.NewAccounts(t, testutil.FixtureAccountsSeed, n)
+	fc := &fakeChain{accounts: make(map[string]*authtypes.BaseAccount), failing: make(map[string]uint32)}
+	for _, acc := range accs {
+		config.Operators = append(config.Operators, keys.NewLocalInfo(acc.Name, acc.PubKey(), ""))
+		baseAcc := acc.BaseAccount(2)
+		fc.accounts[acc.Address.String()] = &baseAcc
+	}
+	config.PassWd = testutil.AccountPassWd
+
+	scheduler, err := NewScheduler(fc, fc, config)
+	require.NoError(t, err)
+	return scheduler, fc, accs
+}
+
+func TestWindow_Contains(t *testing.T) {
+	day := time.Date(2020, 5, 1, 0, 0, 0, 0, time.UTC)
+	window := Window{Start: 2 * time.Hour, End: 4 * time.Hour}
+	require.True(t, window.Contains(day.Add(3*time.Hour)))
+	require.False(t, window.Contains(day.Add(4*time.Hour)))
+	require.False(t, window.Contains(day.Add(time.Hour)))
+
+	// wrapping around the midnight
+	window = Window{Start: 22 * time.Hour, End: 2 * time.Hour}
+	require.True(t, window.Contains(day.Add(23*time.Hour)))
+	require.True(t, window.Contains(day.Add(time.Hour)))
+	require.False(t, window.Contains(day.Add(12*time.Hour)))
+}
+
+func TestScheduler_RunOnce(t *testing.T) {
+	fee := sdk.NewStdFee(200000, sdk.NewDecCoins(sdk.NewDecCoinFromDec("okt", sdk.NewDecWithPrec(2, 2))))
+	scheduler, fc, accs := newTestScheduler(t, 3, Config{
+		Period:       time.Hour,
+		BatchSize:    2,
+		Windows:      []Window{{Start: 0, End: 12 * time.Hour}},
+		MaxRetries:   1,
+		RetryBackoff: time.Minute,
+		Fee:          &fee,
+	})
+	now := time.Date(2020, 5, 1, 1, 0, 0, 0, time.UTC)
+	scheduler.now = func() time.Time { return now }
+	fc.failing[sdk.ValAddress(accs[1].Address).String()] = 1
+
+	// the first batch
+	results := scheduler.RunOnce()
+	require.Len(t, results, 2)
+	require.NoError(t, results[0].Err)
+	require.True(t, results[0].ValAddr.Equals(sdk.ValAddress(accs[0].Address)))
+	require.Equal(t, 1, results[0].Attempt)
+	require.Error(t, results[1].Err)
+	require.False(t, results[1].GaveUp)
+	require.Len(t, fc.sent, 2)
+	require.Equal(t, &fee, fc.fees[0])
+
+	// the rest before the retry
+	now = now.Add(30 * time.Second)
+	results = scheduler.RunOnce()
+	require.Len(t, results, 1)
+	require.True(t, results[0].ValAddr.Equals(sdk.ValAddress(accs[2].Address)))
+
+	// the retry given up after the max retries
+	now = now.Add(time.Minute)
+	results = scheduler.RunOnce()
+	require.Len(t, results, 1)
+	require.Equal(t, 2, results[0].Attempt)
+	require.True(t, results[0].GaveUp)
+
+	// nothing due within the period
+	now = now.Add(10 * time.Minute)
+	require.Empty(t, scheduler.RunOnce())
+
+	// due again but out of the window
+	now = now.Add(12 * time.Hour)
+	require.Empty(t, scheduler.RunOnce())
+	now = now.Add(12 * time.Hour)
+	require.Len(t, scheduler.RunOnce(), 2)
+	require.Len(t, fc.sent, 6)
+}
+
+func TestScheduler_QueryAccountFailure(t *testing.T) {
+	scheduler, fc, accs := newTestScheduler(t, 2, Config{})
+	delete(fc.accounts, accs[0].Address.String())
+
+	results := scheduler.RunOnce()
+	require.Len(t, results, 2)
+	require.Error(t, results[0].Err)
+	require.NoError(t, results[1].Err)
+	require.Len(t, fc.sent, 1)
+	require.Nil(t, fc.fees[0])
+}
+
+func TestNewScheduler(t *testing.T) {
+	_, err := NewScheduler(nil, nil, Config{})
+	require.Error(t, err)
+
+	accs := testutil.NewAccounts(t, testutil.FixtureAccountsSeed, 1)
+	config := Config{
+		Operators: []keys.Info{keys.NewLocalInfo(accs[0].Name, accs[0].PubKey(), "")},
+		PassWd:    testutil.AccountPassWd,
+		Windows:   []Window{{Start: time.Hour, End: time.Hour}},
+	}
+	_, err = NewScheduler(nil, nil, config)
+	require.Error(t, err)
+}
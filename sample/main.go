@@ -59,7 +59,7 @@ func main() {
 
 	// sequence number of the account must be increased by 1 whenever a transaction of the account takes effect
 	accountNum, sequenceNum := accInfo.GetAccountNumber(), accInfo.GetSequence()
-	res, err := cli.Token().Send(fromInfo, passWd, addr, "1"+baseCoin, "my memo", accountNum, sequenceNum)
+	res, err := cli.Token().Send(fromInfo, passWd, addr, sdk.MustParseAmount("1"+baseCoin), "my memo", accountNum, sequenceNum)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -70,7 +70,7 @@ func main() {
 
 	// increase sequence number
 	sequenceNum++
-	res, err = cli.Staking().Delegate(fromInfo, passWd, "0.1"+baseCoin, "my memo", accountNum, sequenceNum)
+	res, err = cli.Staking().Delegate(fromInfo, passWd, sdk.MustParseAmount("0.1"+baseCoin), "my memo", accountNum, sequenceNum)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"strings"
+
+	sdk "github.com/okex/okchain-go-sdk"
+	"github.com/okex/okchain-go-sdk/soak"
+	"github.com/okex/okchain-go-sdk/types/crypto/keys"
+	"github.com/okex/okchain-go-sdk/utils"
+)
+
+// envMnemonics is the env of the mnemonics of the accounts dedicated to the soak test, separated by commas
+const envMnemonics = "SOAK_MNEMONICS"
+
+func main() {
+	rpcURL := flag.String("rpc", "tcp://127.0.0.1:26657", "rpc url of the target network")
+	chainID := flag.String("chain-id", "okchain", "chain id of the target network")
+	amount := flag.String("amount", "0.0001okt", "coins of each tx")
+	fees := flag.String("fees", "0.01okt", "fixed fees of each tx")
+	rounds := flag.Int("rounds", 0, "count of txs to send, 0 for running until interrupted")
+	interval := flag.Duration("interval", 0, "pause between two txs")
+	flag.Parse()
+
+	// the fees must be fixed to check the balance conservation
+	config, err := sdk.NewClientConfig(*rpcURL, *chainID, sdk.BroadcastBlock, *fees, 200000, 0, "")
+	if err != nil {
+		log.Fatal(err)
+	}
+	cli := sdk.NewClient(config)
+
+	const passWd = "12345678"
+	var accounts []keys.Info
+	for i, mnemonic := range strings.Split(os.Getenv(envMnemonics), ",") {
+		info, _, err := utils.CreateAccountWithMnemo(strings.TrimSpace(mnemonic), fmt.Sprintf("soak%d", i), passWd)
+		if err != nil {
+			log.Fatal(err)
+		}
+		accounts = append(accounts, info)
+	}
+
+	harness, err := soak.NewHarness(cli.Auth(), cli.Token(), soak.Config{
+		Accounts: accounts,
+		PassWd:   passWd,
+		Amount:   *amount,
+		Fees:     *fees,
+		Memo:     "soak test",
+		Interval: *interval,
+		Rounds:   *rounds,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	go func() {
+		<-interrupt
+		cancel()
+	}()
+
+	report, err := harness.Run(ctx)
+	log.Printf("rounds: %d, txs sent: %d, txs failed: %d\n", report.Rounds, report.TxsSent, report.TxsFailed)
+	for _, violation := range report.Violations {
+		log.Println(violation)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+	if !report.Passed() {
+		os.Exit(1)
+	}
+}
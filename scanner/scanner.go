@@ -0,0 +1,189 @@
+// Package scanner provides a historical tx scanner, which iterates the blocks to find all the txs involving an
+// address, for building the account history without a full indexer.
+//
+// The scanner is resumable by a cursor, which is able to be persisted as a string between the scans.
+package scanner
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/okex/okchain-go-sdk/exposed"
+	"github.com/okex/okchain-go-sdk/module/tendermint/types"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// Cursor - structure of the position of the next tx to scan, which is the index of the tx in the block at the height
+type Cursor struct {
+	Height int64  `json:"height"`
+	Index  uint32 `json:"index"`
+}
+
+// NewCursor creates a new instance of Cursor from the first tx of the block at the height
+func NewCursor(height int64) Cursor {
+	return Cursor{Height: height}
+}
+
+// ParseCursor parses the cursor from the string of the format {height}:{index}
+func ParseCursor(cursorStr string) (cursor Cursor, err error) {
+	strs := strings.Split(strings.TrimSpace(cursorStr), ":")
+	if len(strs) != 2 {
+		return cursor, fmt.Errorf("failed. cursor %s should be of the format: {height}:{index}", cursorStr)
+	}
+
+	if cursor.Height, err = strconv.ParseInt(strs[0], 10, 64); err != nil {
+		return cursor, fmt.Errorf("failed. invalid height of the cursor: %s", err)
+	}
+	index, err := strconv.ParseUint(strs[1], 10, 32)
+	if err != nil {
+		return cursor, fmt.Errorf("failed. invalid index of the cursor: %s", err)
+	}
+	cursor.Index = uint32(index)
+
+	return cursor, cursor.ValidateBasic()
+}
+
+// String returns the cursor in the format {height}:{index} to persist
+func (c Cursor) String() string {
+	return fmt.Sprintf("%d:%d", c.Height, c.Index)
+}
+
+// ValidateBasic gives a quick validity check for the cursor
+func (c Cursor) ValidateBasic() error {
+	if c.Height <= 0 {
+		return fmt.Errorf("failed. invalid height of the cursor: %d", c.Height)
+	}
+	return nil
+}
+
+// MatchedTx - structure of a tx involving the address scanned
+type MatchedTx struct {
+	Height int64
+	Index  uint32
+	Hash   string
+	Tx     sdk.StdTx
+	// Msgs are the decoded msgs of the tx involving the address, which are able to be type-switched to the msgs of the
+	// modules
+	Msgs   []sdk.Msg
+	Result types.ResponseDeliverTx
+}
+
+// Succeeded tells whether the tx was executed successfully on the chain
+func (mt MatchedTx) Succeeded() bool {
+	return mt.Result.Code == 0
+}
+
+// Scanner - structure of the scanner of the txs involving an address
+type Scanner struct {
+	tm      exposed.TendermintQuery
+	cdc     sdk.SDKCodec
+	addrStr string
+}
+
+// NewScanner creates a new instance of Scanner for the address on the tendermint query client, where the codec with
+// the msgs of all the modules registered is used to encode the txs for their hashes
+func NewScanner(tm exposed.TendermintQuery, cdc sdk.SDKCodec, accAddrStr string) (Scanner, error) {
+	if _, err := sdk.AccAddressFromBech32(accAddrStr); err != nil {
+		return Scanner{}, fmt.Errorf("failed. accAddress %s converted from Bech32 error", accAddrStr)
+	}
+
+	return Scanner{
+		tm:      tm,
+		cdc:     cdc,
+		addrStr: accAddrStr,
+	}, nil
+}
+
+// Scan scans the blocks from the cursor to the height toHeight inclusively, and returns the txs involving the address in
+// order with the cursor to resume. It stops early once limit txs are found, where limit 0 means no limit
+func (s Scanner) Scan(cursor Cursor, toHeight int64, limit int) (matchedTxs []MatchedTx, next Cursor, err error) {
+	if err = cursor.ValidateBasic(); err != nil {
+		return nil, cursor, err
+	}
+	if limit < 0 {
+		return nil, cursor, errors.New("failed. limit must not be negative")
+	}
+
+	next = cursor
+	for next.Height <= toHeight {
+		block, err := s.tm.QueryBlock(next.Height)
+		if err != nil {
+			return matchedTxs, next, fmt.Errorf("failed. query block %d error: %s", next.Height, err)
+		}
+
+		matchedTxs, next, err = s.scanBlock(block, next, matchedTxs, limit)
+		if err != nil || (limit != 0 && len(matchedTxs) == limit) {
+			return matchedTxs, next, err
+		}
+	}
+
+	return matchedTxs, next, nil
+}
+
+// scanBlock scans the txs in the block from the cursor, and moves the cursor to the next block if it's scanned totally
+func (s Scanner) scanBlock(block types.Block, cursor Cursor, matchedTxs []MatchedTx, limit int) ([]MatchedTx, Cursor,
+	error) {
+	var results *types.BlockResults
+	for ; int(cursor.Index) < len(block.Txs); cursor.Index++ {
+		if limit != 0 && len(matchedTxs) == limit {
+			return matchedTxs, cursor, nil
+		}
+
+		stdTx := block.Txs[cursor.Index]
+		msgs := s.matchMsgs(stdTx)
+		if len(msgs) == 0 {
+			continue
+		}
+
+		// the results are queried once for all the matched txs in the block
+		if results == nil {
+			blockResults, err := s.tm.QueryBlockResults(cursor.Height)
+			if err != nil {
+				return matchedTxs, cursor, fmt.Errorf("failed. query block results %d error: %s", cursor.Height, err)
+			}
+			results = &blockResults
+		}
+
+		// amino encoding is deterministic, so the tx bytes are the same as the ones in the block
+		txBytes, err := s.cdc.MarshalBinaryLengthPrefixed(stdTx)
+		if err != nil {
+			return matchedTxs, cursor, fmt.Errorf("failed. encode tx %d in block %d error: %s", cursor.Index,
+				cursor.Height, err)
+		}
+
+		matchedTx := MatchedTx{
+			Height: cursor.Height,
+			Index:  cursor.Index,
+			Hash:   cmn.HexBytes(tmtypes.Tx(txBytes).Hash()).String(),
+			Tx:     stdTx,
+			Msgs:   msgs,
+		}
+		if int(cursor.Index) < len(results.Results.DeliverTx) {
+			matchedTx.Result = results.Results.DeliverTx[cursor.Index]
+		}
+		matchedTxs = append(matchedTxs, matchedTx)
+	}
+
+	return matchedTxs, NewCursor(cursor.Height + 1), nil
+}
+
+// matchMsgs returns the msgs involving the address, where the tx paid by the address matches all its msgs
+func (s Scanner) matchMsgs(stdTx sdk.StdTx) (msgs []sdk.Msg) {
+	addrBytes := []byte(s.addrStr)
+	if stdTx.Fee.Payer.String() == s.addrStr {
+		return stdTx.Msgs
+	}
+
+	for _, msg := range stdTx.Msgs {
+		// all the addresses in the sign bytes are bech32 encoded
+		if bytes.Contains(msg.GetSignBytes(), addrBytes) {
+			msgs = append(msgs, msg)
+		}
+	}
+	return
+}
@@ -0,0 +1,126 @@
+package scanner
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/okex/okchain-go-sdk/exposed"
+	"github.com/okex/okchain-go-sdk/mocks"
+	tmtypes "github.com/okex/okchain-go-sdk/module/tendermint/types"
+	tokentypes "github.com/okex/okchain-go-sdk/module/token/types"
+	"github.com/okex/okchain-go-sdk/testutil"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	"github.com/stretchr/testify/require"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	"github.com/tendermint/tendermint/types"
+)
+
+// fakeChain serves the blocks and their results from memory
+type fakeChain struct {
+	// the queries out of the scanner are never called
+	exposed.TendermintQuery
+	blocks       map[int64]tmtypes.Block
+	resultsCalls int
+}
+
+func (fc *fakeChain) QueryBlock(height int64) (tmtypes.Block, error) {
+	block, ok := fc.blocks[height]
+	if !ok {
+		return block, errors.New("block not found")
+	}
+	return block, nil
+}
+
+func (fc *fakeChain) QueryBlockResults(height int64) (tmtypes.BlockResults, error) {
+	fc.resultsCalls++
+	block := fc.blocks[height]
+	results := tmtypes.BlockResults{Height: height}
+	for i := range block.Txs {
+		results.Results.DeliverTx = append(results.Results.DeliverTx, tmtypes.ResponseDeliverTx{Code: uint32(i)})
+	}
+	return results, nil
+}
+
+func TestScanner_Scan(t *testing.T) {
+	accounts := testutil.NewAccounts(t, testutil.FixtureAccountsSeed, 3)
+	cdc := mocks.NewFixtureCodec()
+	coins, err := sdk.ParseDecCoins("10.24okt")
+	require.NoError(t, err)
+	fee := sdk.NewStdFee(200000, coins)
+
+	send := func(from, to int) sdk.StdTx {
+		msgs := []sdk.Msg{tokentypes.NewMsgTokenSend(accounts[from].Address, accounts[to].Address, coins)}
+		return accounts[from].SignStdTx(t, "testChain", msgs, fee, "", 0)
+	}
+	paidTx := send(1, 2)
+	paidTx.Fee.Payer = accounts[0].Address
+
+	fc := &fakeChain{blocks: map[int64]tmtypes.Block{
+		1: {Data: tmtypes.Data{Txs: []sdk.StdTx{send(0, 1), send(1, 2)}}},
+		2: {},
+		3: {Data: tmtypes.Data{Txs: []sdk.StdTx{send(2, 1), send(1, 0), paidTx}}},
+	}}
+
+	scanner, err := NewScanner(fc, cdc, accounts[0].Address.String())
+	require.NoError(t, err)
+
+	matchedTxs, next, err := scanner.Scan(NewCursor(1), 3, 0)
+	require.NoError(t, err)
+	require.Equal(t, Cursor{Height: 4}, next)
+	require.Len(t, matchedTxs, 3)
+	require.Equal(t, int64(1), matchedTxs[0].Height)
+	require.Equal(t, uint32(0), matchedTxs[0].Index)
+	require.True(t, matchedTxs[0].Succeeded())
+	msgSend, ok := matchedTxs[0].Msgs[0].(tokentypes.MsgSend)
+	require.True(t, ok)
+	require.Equal(t, accounts[1].Address, msgSend.ToAddress)
+	txBytes, err := cdc.MarshalBinaryLengthPrefixed(fc.blocks[1].Txs[0])
+	require.NoError(t, err)
+	require.Equal(t, cmn.HexBytes(types.Tx(txBytes).Hash()).String(), matchedTxs[0].Hash)
+	// received
+	require.Equal(t, Cursor{Height: 3, Index: 1}, Cursor{Height: matchedTxs[1].Height, Index: matchedTxs[1].Index})
+	require.False(t, matchedTxs[1].Succeeded())
+	// fees paid
+	require.Equal(t, uint32(2), matchedTxs[2].Index)
+	require.Len(t, matchedTxs[2].Msgs, 1)
+	// the results are queried only for the blocks with matched txs
+	require.Equal(t, 2, fc.resultsCalls)
+
+	// resume with the limit
+	matchedTxs, next, err = scanner.Scan(NewCursor(1), 3, 2)
+	require.NoError(t, err)
+	require.Len(t, matchedTxs, 2)
+	require.Equal(t, "3:2", next.String())
+	cursor, err := ParseCursor(next.String())
+	require.NoError(t, err)
+	matchedTxs, next, err = scanner.Scan(cursor, 3, 2)
+	require.NoError(t, err)
+	require.Len(t, matchedTxs, 1)
+	require.Equal(t, uint32(2), matchedTxs[0].Index)
+	require.Equal(t, NewCursor(4), next)
+
+	// stopped at the missing block with the cursor kept
+	matchedTxs, next, err = scanner.Scan(next, 5, 0)
+	require.Error(t, err)
+	require.Empty(t, matchedTxs)
+	require.Equal(t, NewCursor(4), next)
+
+	// invalid params
+	_, _, err = scanner.Scan(Cursor{}, 3, 0)
+	require.Error(t, err)
+	_, _, err = scanner.Scan(NewCursor(1), 3, -1)
+	require.Error(t, err)
+	_, err = NewScanner(fc, cdc, "okchain1")
+	require.Error(t, err)
+}
+
+func TestParseCursor(t *testing.T) {
+	cursor, err := ParseCursor("1024:3")
+	require.NoError(t, err)
+	require.Equal(t, Cursor{Height: 1024, Index: 3}, cursor)
+
+	for _, cursorStr := range []string{"", "1024", "1024:-1", "a:3", "0:0", "1024:3:1"} {
+		_, err = ParseCursor(cursorStr)
+		require.Error(t, err, cursorStr)
+	}
+}
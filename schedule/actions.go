@@ -0,0 +1,65 @@
+package schedule
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/okex/okchain-go-sdk/exposed"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	"github.com/okex/okchain-go-sdk/types/crypto/keys"
+)
+
+// bondDenom is the denom of the tokens delegated
+const bondDenom = "okt"
+
+// Transfer returns the action sending the coins from the account to the address, e.g. a monthly treasury transfer
+func Transfer(auth exposed.AuthQuery, token exposed.TokenTx, from keys.Info, passWd, toAddrStr string,
+	amount sdk.Amount, memo string) Action {
+	return func(context.Context) ([]sdk.TxResponse, error) {
+		acc, err := auth.QueryAccount(from.GetAddress().String())
+		if err != nil {
+			return nil, fmt.Errorf("failed. query account error: %w", err)
+		}
+
+		resp, err := token.Send(from, passWd, toAddrStr, amount, memo, acc.GetAccountNumber(), acc.GetSequence())
+		if err != nil {
+			return nil, fmt.Errorf("failed. transfer error: %w", err)
+		}
+		return []sdk.TxResponse{resp}, nil
+	}
+}
+
+// ClaimAndRestake returns the action withdrawing the rewards of the validator operated by the account and delegating
+// the spendable okt above the reserve, which is kept for the fees. The rewards are expected to be committed by the
+// withdrawal before the balance is queried, i.e. the client broadcasts in BroadcastBlock mode
+func ClaimAndRestake(auth exposed.AuthQuery, distr exposed.DistrTx, staking exposed.StakingTx, from keys.Info,
+	passWd, valAddrStr string, reserve sdk.Dec) Action {
+	return func(context.Context) (resps []sdk.TxResponse, err error) {
+		accAddrStr := from.GetAddress().String()
+		acc, err := auth.QueryAccount(accAddrStr)
+		if err != nil {
+			return nil, fmt.Errorf("failed. query account error: %w", err)
+		}
+
+		resp, err := distr.WithdrawRewards(from, passWd, valAddrStr, "", acc.GetAccountNumber(), acc.GetSequence())
+		if err != nil {
+			return nil, fmt.Errorf("failed. withdraw rewards error: %w", err)
+		}
+		resps = append(resps, resp)
+
+		if acc, err = auth.QueryAccount(accAddrStr); err != nil {
+			return resps, fmt.Errorf("failed. query account after the withdrawal error: %w", err)
+		}
+		amount, err := sdk.NewAmountFromDec(bondDenom, acc.SpendableCoins(time.Now()).AmountOf(bondDenom).Sub(reserve))
+		if err != nil {
+			// nothing above the reserve
+			return resps, nil
+		}
+
+		if resp, err = staking.Delegate(from, passWd, amount, "", acc.GetAccountNumber(), acc.GetSequence()); err != nil {
+			return resps, fmt.Errorf("failed. restake %s error: %w", amount, err)
+		}
+		return append(resps, resp), nil
+	}
+}
@@ -0,0 +1,177 @@
+package schedule
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schedule shows the expected behavior of the schedule of a recurring tx
+type Schedule interface {
+	// Next returns the first activation time after the time
+	Next(after time.Time) time.Time
+}
+
+// descriptors of the common cron specs
+var descriptors = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// ParseSchedule parses a cron spec of 5 fields, i.e. minute, hour, day of month, month and day of week, in the time
+// zone of the times given to Next. The fields support "*", the lists, the ranges and the steps, e.g. "0 9 * * 1-5" or
+// "*/15 * * * *". The descriptors "@hourly", "@daily", "@weekly", "@monthly", "@yearly" and "@every <duration>" are
+// supported as well
+func ParseSchedule(spec string) (Schedule, error) {
+	spec = strings.TrimSpace(spec)
+	if strings.HasPrefix(spec, "@every ") {
+		interval, err := time.ParseDuration(strings.TrimSpace(strings.TrimPrefix(spec, "@every ")))
+		if err != nil {
+			return nil, fmt.Errorf("failed. invalid interval of spec %q: %w", spec, err)
+		}
+		if interval < time.Second {
+			return nil, fmt.Errorf("failed. interval of spec %q is under 1s", spec)
+		}
+		return everySchedule(interval), nil
+	}
+	if descriptor, ok := descriptors[spec]; ok {
+		spec = descriptor
+	}
+
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("failed. spec %q has %d fields rather than 5", spec, len(fields))
+	}
+
+	var (
+		cs  cronSchedule
+		err error
+	)
+	if cs.minute, err = parseField(fields[0], 0, 59); err != nil {
+		return nil, fmt.Errorf("failed. invalid minute of spec %q: %w", spec, err)
+	}
+	if cs.hour, err = parseField(fields[1], 0, 23); err != nil {
+		return nil, fmt.Errorf("failed. invalid hour of spec %q: %w", spec, err)
+	}
+	if cs.dom, err = parseField(fields[2], 1, 31); err != nil {
+		return nil, fmt.Errorf("failed. invalid day of month of spec %q: %w", spec, err)
+	}
+	if cs.month, err = parseField(fields[3], 1, 12); err != nil {
+		return nil, fmt.Errorf("failed. invalid month of spec %q: %w", spec, err)
+	}
+	// both 0 and 7 are Sunday
+	if cs.dow, err = parseField(fields[4], 0, 7); err != nil {
+		return nil, fmt.Errorf("failed. invalid day of week of spec %q: %w", spec, err)
+	}
+	if cs.dow&(1<<7) != 0 {
+		cs.dow |= 1
+	}
+	cs.domStar, cs.dowStar = fields[2] == "*", fields[4] == "*"
+	return cs, nil
+}
+
+// everySchedule activates by a fixed interval
+type everySchedule time.Duration
+
+// Next implements the Schedule interface
+func (es everySchedule) Next(after time.Time) time.Time {
+	return after.Add(time.Duration(es))
+}
+
+// cronSchedule activates by the bit sets of the cron fields
+type cronSchedule struct {
+	minute, hour, dom, month, dow uint64
+	// the day is matched by either of the day of month and the day of week if both are restricted
+	domStar, dowStar bool
+}
+
+// maxSearchYears bounds the search of the next activation, e.g. of "0 0 30 2 *" never activated
+const maxSearchYears = 5
+
+// Next implements the Schedule interface, which returns the zero time if the schedule never activates
+func (cs cronSchedule) Next(after time.Time) time.Time {
+	t := after.Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(maxSearchYears, 0, 0)
+	for t.Before(limit) {
+		if !has(cs.month, int(t.Month())) {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if !cs.matchDay(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if !has(cs.hour, t.Hour()) {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+			continue
+		}
+		if !has(cs.minute, t.Minute()) {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
+
+func (cs cronSchedule) matchDay(t time.Time) bool {
+	domMatch, dowMatch := has(cs.dom, t.Day()), has(cs.dow, int(t.Weekday()))
+	if cs.domStar || cs.dowStar {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
+
+func has(bits uint64, i int) bool {
+	return bits&(1<<uint(i)) != 0
+}
+
+// parseField parses a comma separated list of "*", a value or a range, each with an optional step, into a bit set
+func parseField(field string, min, max int) (bits uint64, err error) {
+	for _, part := range strings.Split(field, ",") {
+		rangePart, step := part, 1
+		if i := strings.Index(part, "/"); i >= 0 {
+			rangePart = part[:i]
+			if step, err = strconv.Atoi(part[i+1:]); err != nil || step <= 0 {
+				return 0, fmt.Errorf("invalid step %q", part)
+			}
+		}
+
+		var lo, hi int
+		switch {
+		case rangePart == "*":
+			lo, hi = min, max
+		case strings.Contains(rangePart, "-"):
+			bounds := strings.SplitN(rangePart, "-", 2)
+			if lo, err = strconv.Atoi(bounds[0]); err != nil {
+				return 0, fmt.Errorf("invalid range %q", part)
+			}
+			if hi, err = strconv.Atoi(bounds[1]); err != nil {
+				return 0, fmt.Errorf("invalid range %q", part)
+			}
+		default:
+			if lo, err = strconv.Atoi(rangePart); err != nil {
+				return 0, fmt.Errorf("invalid value %q", part)
+			}
+			hi = lo
+			// a single value with a step runs to the max, e.g. "5/15"
+			if step > 1 {
+				hi = max
+			}
+		}
+
+		if lo < min || hi > max || lo > hi {
+			return 0, fmt.Errorf("%q out of range [%d, %d]", part, min, max)
+		}
+		for i := lo; i <= hi; i += step {
+			bits |= 1 << uint(i)
+		}
+	}
+	return bits, nil
+}
@@ -0,0 +1,48 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSchedule(t *testing.T) {
+	// Wednesday
+	now := time.Date(2020, 4, 15, 9, 30, 20, 0, time.UTC)
+	testCases := []struct {
+		spec string
+		next time.Time
+	}{
+		{"* * * * *", time.Date(2020, 4, 15, 9, 31, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2020, 4, 15, 9, 45, 0, 0, time.UTC)},
+		{"5/15 * * * *", time.Date(2020, 4, 15, 9, 35, 0, 0, time.UTC)},
+		{"0 9 * * 1-5", time.Date(2020, 4, 16, 9, 0, 0, 0, time.UTC)},
+		{"0 0 * * 0", time.Date(2020, 4, 19, 0, 0, 0, 0, time.UTC)},
+		{"0 0 * * 7", time.Date(2020, 4, 19, 0, 0, 0, 0, time.UTC)},
+		{"@weekly", time.Date(2020, 4, 19, 0, 0, 0, 0, time.UTC)},
+		{"@monthly", time.Date(2020, 5, 1, 0, 0, 0, 0, time.UTC)},
+		{"@yearly", time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"30 8,10 * * *", time.Date(2020, 4, 15, 10, 30, 0, 0, time.UTC)},
+		// either of the day of month and the day of week
+		{"0 0 1 * 5", time.Date(2020, 4, 17, 0, 0, 0, 0, time.UTC)},
+		{"0 0 29 2 *", time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{"@every 90m", time.Date(2020, 4, 15, 11, 0, 20, 0, time.UTC)},
+	}
+	for _, tc := range testCases {
+		schedule, err := ParseSchedule(tc.spec)
+		require.NoError(t, err, tc.spec)
+		require.Equal(t, tc.next, schedule.Next(now), tc.spec)
+	}
+
+	// never activated
+	schedule, err := ParseSchedule("0 0 30 2 *")
+	require.NoError(t, err)
+	require.True(t, schedule.Next(now).IsZero())
+
+	for _, spec := range []string{"", "* * * *", "60 * * * *", "* 24 * * *", "* * 0 * *", "* * * 13 *", "* * * * 8",
+		"*/0 * * * *", "5-1 * * * *", "a * * * *", "@every 1ms", "@every soon", "@fortnightly"} {
+		_, err = ParseSchedule(spec)
+		require.Error(t, err, spec)
+	}
+}
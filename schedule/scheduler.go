@@ -0,0 +1,269 @@
+// Package schedule provides the scheduler of the recurring txs by the cron-style specs, e.g. a weekly reward claim with
+// restake or a monthly treasury transfer. Each run is delayed by a random jitter, the failures are alerted, and the
+// states and the history of the runs are persisted by a Store so that the schedule survives the restarts.
+package schedule
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	sdk "github.com/okex/okchain-go-sdk/types"
+)
+
+// maxIdle is the max pause of the scheduler between two checks of the due txs
+const maxIdle = time.Minute
+
+// Action executes a run of a recurring tx, and returns the responses of the txs broadcast
+type Action func(ctx context.Context) ([]sdk.TxResponse, error)
+
+// RecurringTx - structure of the definition of a recurring tx
+type RecurringTx struct {
+	// ID identifies the recurring tx in the store, which contains no "/"
+	ID string
+	// Spec is the cron-style schedule parsed by ParseSchedule, e.g. "0 9 * * 1" or "@monthly"
+	Spec string
+	// Jitter is the max random delay of each run, e.g. to keep the runs of many accounts apart, optional
+	Jitter time.Duration
+	Action Action
+}
+
+// ValidateBasic gives a quick validity check for the recurring tx
+func (rt RecurringTx) ValidateBasic() error {
+	if len(rt.ID) == 0 || strings.Contains(rt.ID, "/") {
+		return fmt.Errorf("failed. invalid recurring tx ID %q", rt.ID)
+	}
+	if rt.Jitter < 0 {
+		return errors.New("failed. jitter must not be negative")
+	}
+	if rt.Action == nil {
+		return fmt.Errorf("failed. no action of recurring tx %s", rt.ID)
+	}
+	return nil
+}
+
+// Config - structure of the config of the scheduler
+type Config struct {
+	// Store persists the states and the history of the recurring txs, in memory by default
+	Store Store
+	// OnFailure alerts a failed run with the number of the consecutive failures of the recurring tx, optional
+	OnFailure func(run Run, failures int)
+	// Now returns the current time, time.Now by default
+	Now func() time.Time
+}
+
+// entry - structure of a recurring tx scheduled
+type entry struct {
+	tx       RecurringTx
+	schedule Schedule
+	state    State
+}
+
+// Scheduler - structure of the scheduler executing the recurring txs
+type Scheduler struct {
+	mtx     sync.Mutex
+	config  Config
+	entries map[string]*entry
+	rand    *rand.Rand
+}
+
+// NewScheduler creates a new instance of Scheduler
+func NewScheduler(config Config) *Scheduler {
+	if config.Store == nil {
+		config.Store = NewInMemoryStore()
+	}
+	if config.Now == nil {
+		config.Now = time.Now
+	}
+
+	return &Scheduler{
+		config:  config,
+		entries: make(map[string]*entry),
+		rand:    rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+}
+
+// Add schedules the recurring tx. The next run persisted is kept if the spec is unchanged, where a run missed during a
+// restart is executed once by the next check
+func (s *Scheduler) Add(tx RecurringTx) error {
+	if err := tx.ValidateBasic(); err != nil {
+		return err
+	}
+	schedule, err := ParseSchedule(tx.Spec)
+	if err != nil {
+		return err
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if _, ok := s.entries[tx.ID]; ok {
+		return fmt.Errorf("failed. recurring tx %s is scheduled already", tx.ID)
+	}
+
+	state, ok, err := s.config.Store.LoadState(tx.ID)
+	if err != nil {
+		return err
+	}
+	if !ok || state.Spec != tx.Spec {
+		state = State{
+			ID:   tx.ID,
+			Spec: tx.Spec,
+		}
+		if state.NextRun = s.next(schedule, tx.Jitter, s.config.Now()); state.NextRun.IsZero() {
+			return fmt.Errorf("failed. spec %q never activates", tx.Spec)
+		}
+		if err = s.config.Store.SaveState(state); err != nil {
+			return err
+		}
+	}
+
+	s.entries[tx.ID] = &entry{
+		tx:       tx,
+		schedule: schedule,
+		state:    state,
+	}
+	return nil
+}
+
+// Remove unschedules the recurring tx, whose history is kept
+func (s *Scheduler) Remove(id string) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	delete(s.entries, id)
+	return s.config.Store.DeleteState(id)
+}
+
+// State returns the state of the recurring tx scheduled
+func (s *Scheduler) State(id string) (State, bool) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	e, ok := s.entries[id]
+	if !ok {
+		return State{}, false
+	}
+	return e.state, true
+}
+
+// History returns the latest runs of the recurring tx newest first, at most limit if it's positive
+func (s *Scheduler) History(id string, limit int) ([]Run, error) {
+	return s.config.Store.History(id, limit)
+}
+
+// RunDue executes the recurring txs due at the time one by one in the order of their next runs, and returns the runs
+func (s *Scheduler) RunDue(ctx context.Context) (runs []Run) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	now := s.config.Now()
+	var due []*entry
+	for _, e := range s.entries {
+		if !e.state.NextRun.After(now) {
+			due = append(due, e)
+		}
+	}
+	sort.Slice(due, func(i, j int) bool {
+		if due[i].state.NextRun.Equal(due[j].state.NextRun) {
+			return due[i].tx.ID < due[j].tx.ID
+		}
+		return due[i].state.NextRun.Before(due[j].state.NextRun)
+	})
+
+	for _, e := range due {
+		if ctx.Err() != nil {
+			return
+		}
+		runs = append(runs, s.execute(ctx, e))
+	}
+	return
+}
+
+// execute runs the recurring tx, and persists the run and the next run
+func (s *Scheduler) execute(ctx context.Context, e *entry) (run Run) {
+	run = Run{
+		ID:          e.tx.ID,
+		ScheduledAt: e.state.NextRun,
+		StartedAt:   s.config.Now(),
+	}
+	resps, err := e.tx.Action(ctx)
+	run.FinishedAt = s.config.Now()
+	for _, resp := range resps {
+		run.TxHashes = append(run.TxHashes, resp.TxHash)
+	}
+
+	if err != nil {
+		run.Err = err.Error()
+		e.state.Failures++
+	} else {
+		e.state.Failures = 0
+	}
+	// the runs missed are skipped rather than executed in a burst
+	e.state.NextRun = s.next(e.schedule, e.tx.Jitter, run.FinishedAt)
+
+	if storeErr := s.config.Store.AppendRun(run); storeErr != nil && err == nil {
+		run.Err = storeErr.Error()
+	}
+	if storeErr := s.config.Store.SaveState(e.state); storeErr != nil && len(run.Err) == 0 {
+		run.Err = storeErr.Error()
+	}
+	if !run.Succeeded() && s.config.OnFailure != nil {
+		s.config.OnFailure(run, e.state.Failures)
+	}
+	return
+}
+
+// Run checks and executes the due recurring txs until the ctx is done, and sends the runs to the channel returned.
+// The channel is closed when the ctx is done
+func (s *Scheduler) Run(ctx context.Context) <-chan Run {
+	runs := make(chan Run, 1)
+	go func() {
+		defer close(runs)
+		for {
+			for _, run := range s.RunDue(ctx) {
+				select {
+				case runs <- run:
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			select {
+			case <-time.After(s.idle()):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return runs
+}
+
+// idle returns the pause until the next run, at most maxIdle in case of the clock changes
+func (s *Scheduler) idle() time.Duration {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	idle := maxIdle
+	now := s.config.Now()
+	for _, e := range s.entries {
+		if d := e.state.NextRun.Sub(now); d < idle {
+			idle = d
+		}
+	}
+	if idle < 0 {
+		return 0
+	}
+	return idle
+}
+
+// next returns the next activation of the schedule after the time with a random jitter
+func (s *Scheduler) next(schedule Schedule, jitter time.Duration, after time.Time) time.Time {
+	next := schedule.Next(after)
+	if next.IsZero() || jitter == 0 {
+		return next
+	}
+	return next.Add(time.Duration(s.rand.Int63n(int64(jitter))))
+}
@@ -0,0 +1,213 @@
+package schedule
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/okex/okchain-go-sdk/exposed"
+	authtypes "github.com/okex/okchain-go-sdk/module/auth/types"
+	"github.com/okex/okchain-go-sdk/testutil"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	"github.com/okex/okchain-go-sdk/types/crypto/keys"
+	"github.com/stretchr/testify/require"
+)
+
+// clock is the fake time of the scheduler
+type clock struct {
+	now time.Time
+}
+
+func (c *clock) Now() time.Time {
+	return c.now
+}
+
+func TestScheduler(t *testing.T) {
+	c := &clock{now: time.Date(2020, 4, 15, 9, 30, 0, 0, time.UTC)}
+	store := NewInMemoryStore()
+	var alerts []int
+	config := Config{
+		Store:     store,
+		Now:       c.Now,
+		OnFailure: func(run Run, failures int) { alerts = append(alerts, failures) },
+	}
+	scheduler := NewScheduler(config)
+
+	var calls int
+	failing := true
+	require.NoError(t, scheduler.Add(RecurringTx{
+		ID:   "claim",
+		Spec: "@hourly",
+		Action: func(context.Context) ([]sdk.TxResponse, error) {
+			calls++
+			if failing {
+				return nil, errors.New("default error")
+			}
+			return []sdk.TxResponse{{TxHash: fmt.Sprintf("hash%d", calls)}}, nil
+		},
+	}))
+	state, ok := scheduler.State("claim")
+	require.True(t, ok)
+	require.Equal(t, time.Date(2020, 4, 15, 10, 0, 0, 0, time.UTC), state.NextRun)
+	require.Error(t, scheduler.Add(RecurringTx{ID: "claim", Spec: "@hourly", Action: func(context.Context) (
+		[]sdk.TxResponse, error) {
+		return nil, nil
+	}}))
+
+	// not due yet
+	require.Empty(t, scheduler.RunDue(context.Background()))
+
+	// failures alerted
+	c.now = time.Date(2020, 4, 15, 10, 0, 5, 0, time.UTC)
+	runs := scheduler.RunDue(context.Background())
+	require.Len(t, runs, 1)
+	require.False(t, runs[0].Succeeded())
+	c.now = time.Date(2020, 4, 15, 11, 0, 5, 0, time.UTC)
+	scheduler.RunDue(context.Background())
+	require.Equal(t, []int{1, 2}, alerts)
+
+	// the runs missed are skipped
+	failing = false
+	c.now = time.Date(2020, 4, 15, 15, 20, 0, 0, time.UTC)
+	runs = scheduler.RunDue(context.Background())
+	require.Len(t, runs, 1)
+	require.True(t, runs[0].Succeeded())
+	require.Equal(t, []string{"hash3"}, runs[0].TxHashes)
+	require.Equal(t, time.Date(2020, 4, 15, 12, 0, 0, 0, time.UTC), runs[0].ScheduledAt)
+	state, _ = scheduler.State("claim")
+	require.Zero(t, state.Failures)
+	require.Equal(t, time.Date(2020, 4, 15, 16, 0, 0, 0, time.UTC), state.NextRun)
+
+	history, err := scheduler.History("claim", 0)
+	require.NoError(t, err)
+	require.Len(t, history, 3)
+	require.True(t, history[0].Succeeded())
+	require.Equal(t, "default error", history[2].Err)
+	history, err = scheduler.History("claim", 2)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+
+	// the next run is kept across the restarts, and the run missed is executed once
+	c.now = time.Date(2020, 4, 15, 18, 30, 0, 0, time.UTC)
+	restarted := NewScheduler(config)
+	require.NoError(t, restarted.Add(RecurringTx{ID: "claim", Spec: "@hourly", Action: func(context.Context) (
+		[]sdk.TxResponse, error) {
+		return nil, nil
+	}}))
+	state, _ = restarted.State("claim")
+	require.Equal(t, time.Date(2020, 4, 15, 16, 0, 0, 0, time.UTC), state.NextRun)
+	require.Len(t, restarted.RunDue(context.Background()), 1)
+	require.Empty(t, restarted.RunDue(context.Background()))
+
+	// rescheduled by a new spec
+	require.NoError(t, restarted.Remove("claim"))
+	_, ok = restarted.State("claim")
+	require.False(t, ok)
+	require.NoError(t, restarted.Add(RecurringTx{ID: "claim", Spec: "@daily", Action: func(context.Context) (
+		[]sdk.TxResponse, error) {
+		return nil, nil
+	}}))
+	state, _ = restarted.State("claim")
+	require.Equal(t, time.Date(2020, 4, 16, 0, 0, 0, 0, time.UTC), state.NextRun)
+	history, err = restarted.History("claim", 0)
+	require.NoError(t, err)
+	require.Len(t, history, 4)
+}
+
+func TestScheduler_Jitter(t *testing.T) {
+	c := &clock{now: time.Date(2020, 4, 15, 9, 30, 0, 0, time.UTC)}
+	scheduler := NewScheduler(Config{Now: c.Now})
+	noop := func(context.Context) ([]sdk.TxResponse, error) { return nil, nil }
+
+	for i := 0; i < 10; i++ {
+		id := fmt.Sprintf("transfer%d", i)
+		require.NoError(t, scheduler.Add(RecurringTx{ID: id, Spec: "@monthly", Jitter: time.Hour, Action: noop}))
+		state, _ := scheduler.State(id)
+		start := time.Date(2020, 5, 1, 0, 0, 0, 0, time.UTC)
+		require.False(t, state.NextRun.Before(start))
+		require.True(t, state.NextRun.Before(start.Add(time.Hour)))
+	}
+
+	require.Error(t, scheduler.Add(RecurringTx{ID: "a/b", Spec: "@monthly", Action: noop}))
+	require.Error(t, scheduler.Add(RecurringTx{ID: "never", Spec: "0 0 30 2 *", Action: noop}))
+	require.Error(t, scheduler.Add(RecurringTx{ID: "invalid", Spec: "@fortnightly", Action: noop}))
+	require.Error(t, scheduler.Add(RecurringTx{ID: "no-action", Spec: "@monthly"}))
+	require.Error(t, scheduler.Add(RecurringTx{ID: "negative", Spec: "@monthly", Jitter: -time.Second, Action: noop}))
+}
+
+// fakeChain serves the accounts from memory and records the txs broadcast
+type fakeChain struct {
+	exposed.AuthQuery
+	exposed.DistrTx
+	exposed.StakingTx
+	exposed.TokenTx
+	acc       *authtypes.BaseAccount
+	rewards   sdk.DecCoins
+	delegated []string
+	sent      []string
+}
+
+func (fc *fakeChain) QueryAccount(string) (authtypes.Account, error) {
+	acc := *fc.acc
+	return &acc, nil
+}
+
+func (fc *fakeChain) WithdrawRewards(_ keys.Info, _, _, _ string, _, seqNum uint64) (sdk.TxResponse, error) {
+	if seqNum != fc.acc.Sequence {
+		return sdk.TxResponse{}, errors.New("failed. sequence mismatch")
+	}
+	fc.acc.Coins = fc.acc.Coins.Add(fc.rewards)
+	fc.acc.Sequence++
+	return sdk.TxResponse{TxHash: "withdraw"}, nil
+}
+
+func (fc *fakeChain) Delegate(_ keys.Info, _ string, amount sdk.Amount, _ string, _, seqNum uint64) (sdk.TxResponse,
+	error) {
+	if seqNum != fc.acc.Sequence {
+		return sdk.TxResponse{}, errors.New("failed. sequence mismatch")
+	}
+	fc.delegated = append(fc.delegated, amount.String())
+	fc.acc.Sequence++
+	return sdk.TxResponse{TxHash: "delegate"}, nil
+}
+
+func (fc *fakeChain) Send(_ keys.Info, _, toAddrStr string, amount sdk.Amount, _ string, _, _ uint64) (sdk.TxResponse,
+	error) {
+	fc.sent = append(fc.sent, toAddrStr+":"+amount.String())
+	return sdk.TxResponse{TxHash: "send"}, nil
+}
+
+func TestActions(t *testing.T) {
+	user := testutil.NewAccounts(t, testutil.FixtureAccountsSeed, 2)
+	acc := user[0].BaseAccount(2)
+	var err error
+	acc.Coins, err = sdk.ParseDecCoins("0.5okt")
+	require.NoError(t, err)
+	rewards, err := sdk.ParseDecCoins("1.25okt")
+	require.NoError(t, err)
+	fc := &fakeChain{acc: &acc, rewards: rewards}
+	info := keys.NewLocalInfo(user[0].Name, user[0].PubKey(), "")
+
+	// the reserve kept
+	resps, err := ClaimAndRestake(fc, fc, fc, info, testutil.AccountPassWd, "okchainvaloper1",
+		sdk.MustNewDecFromStr("0.75"))(context.Background())
+	require.NoError(t, err)
+	require.Len(t, resps, 2)
+	require.Equal(t, []string{"1.00000000okt"}, fc.delegated)
+
+	// nothing above the reserve
+	fc.rewards = nil
+	resps, err = ClaimAndRestake(fc, fc, fc, info, testutil.AccountPassWd, "okchainvaloper1",
+		sdk.MustNewDecFromStr("10"))(context.Background())
+	require.NoError(t, err)
+	require.Len(t, resps, 1)
+	require.Len(t, fc.delegated, 1)
+
+	resps, err = Transfer(fc, fc, info, testutil.AccountPassWd, user[1].Address.String(), sdk.MustParseAmount("10okt"),
+		"monthly")(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "send", resps[0].TxHash)
+	require.Equal(t, []string{user[1].Address.String() + ":10.00000000okt"}, fc.sent)
+}
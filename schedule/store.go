@@ -0,0 +1,155 @@
+package schedule
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	dbm "github.com/tendermint/tm-db"
+)
+
+const (
+	statePrefix = "state/"
+	runPrefix   = "run/"
+)
+
+// State - structure of the persistent state of a recurring tx, which keeps the schedule across the restarts
+type State struct {
+	ID   string `json:"id"`
+	Spec string `json:"spec"`
+	// NextRun is the time of the next run with the jitter
+	NextRun time.Time `json:"next_run"`
+	// Failures is the number of the consecutive failed runs
+	Failures int `json:"failures"`
+}
+
+// Run - structure of a run of a recurring tx in the history
+type Run struct {
+	ID string `json:"id"`
+	// ScheduledAt is the time the run was due with the jitter
+	ScheduledAt time.Time `json:"scheduled_at"`
+	StartedAt   time.Time `json:"started_at"`
+	FinishedAt  time.Time `json:"finished_at"`
+	TxHashes    []string  `json:"tx_hashes"`
+	// Err is the error message of the failed run, empty if succeeded
+	Err string `json:"err,omitempty"`
+}
+
+// Succeeded tells whether the run succeeded
+func (r Run) Succeeded() bool {
+	return len(r.Err) == 0
+}
+
+// Store shows the expected behavior of the store persisting the states and the history of the recurring txs
+type Store interface {
+	SaveState(state State) error
+	// LoadState returns the state of the recurring tx, false if none
+	LoadState(id string) (State, bool, error)
+	DeleteState(id string) error
+	// AppendRun records a run into the history
+	AppendRun(run Run) error
+	// History returns the latest runs of the recurring tx newest first, at most limit if it's positive
+	History(id string, limit int) ([]Run, error)
+}
+
+var _ Store = (*DBStore)(nil)
+
+// DBStore - structure of the store on a tendermint db, which survives the restarts of the process on a persistent db
+type DBStore struct {
+	mtx sync.Mutex
+	db  dbm.DB
+}
+
+// NewStore creates a new instance of DBStore on the db
+func NewStore(db dbm.DB) *DBStore {
+	return &DBStore{
+		db: db,
+	}
+}
+
+// NewInMemoryStore creates a transient store in memory
+func NewInMemoryStore() *DBStore {
+	return NewStore(dbm.NewMemDB())
+}
+
+// SaveState implements the Store interface
+func (s *DBStore) SaveState(state State) error {
+	bz, err := json.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("failed. encode the state of recurring tx %s error: %w", state.ID, err)
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.db.SetSync([]byte(statePrefix+state.ID), bz)
+	return nil
+}
+
+// LoadState implements the Store interface
+func (s *DBStore) LoadState(id string) (state State, ok bool, err error) {
+	s.mtx.Lock()
+	bz := s.db.Get([]byte(statePrefix + id))
+	s.mtx.Unlock()
+	if bz == nil {
+		return
+	}
+	if err = json.Unmarshal(bz, &state); err != nil {
+		return state, false, fmt.Errorf("failed. decode the state of recurring tx %s error: %w", id, err)
+	}
+	return state, true, nil
+}
+
+// DeleteState implements the Store interface, which keeps the history
+func (s *DBStore) DeleteState(id string) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.db.DeleteSync([]byte(statePrefix + id))
+	return nil
+}
+
+// AppendRun implements the Store interface
+func (s *DBStore) AppendRun(run Run) error {
+	bz, err := json.Marshal(run)
+	if err != nil {
+		return fmt.Errorf("failed. encode the run of recurring tx %s error: %w", run.ID, err)
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.db.SetSync(runKey(run.ID, run.StartedAt), bz)
+	return nil
+}
+
+// History implements the Store interface
+func (s *DBStore) History(id string, limit int) (runs []Run, err error) {
+	prefix := runHistoryPrefix(id)
+	// the end of the prefix range, i.e. the prefix with its trailing "/" incremented
+	end := append(append([]byte{}, prefix[:len(prefix)-1]...), '/'+1)
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	iter := s.db.ReverseIterator(prefix, end)
+	defer iter.Close()
+	for ; iter.Valid() && (limit <= 0 || len(runs) < limit); iter.Next() {
+		var run Run
+		if err = json.Unmarshal(iter.Value(), &run); err != nil {
+			return nil, fmt.Errorf("failed. decode the run of recurring tx %s error: %w", id, err)
+		}
+		runs = append(runs, run)
+	}
+	return
+}
+
+// runHistoryPrefix returns the prefix of the keys of the runs of the recurring tx
+func runHistoryPrefix(id string) []byte {
+	return []byte(runPrefix + id + "/")
+}
+
+// runKey returns the key of a run ordered by its start time
+func runKey(id string, startedAt time.Time) []byte {
+	var bz [8]byte
+	binary.BigEndian.PutUint64(bz[:], uint64(startedAt.UnixNano()))
+	return append(runHistoryPrefix(id), bz[:]...)
+}
@@ -0,0 +1,103 @@
+// Package slim provides the slim client of okchain gosdk linking only the modules imported by the application, e.g.
+// for the gomobile builds and the other constrained deployments. Each module registers itself by the init of its own
+// package, so the modules never imported are neither linked nor registered in the codec:
+//
+//	import (
+//		"github.com/okex/okchain-go-sdk/module/staking"
+//		stakingexposed "github.com/okex/okchain-go-sdk/module/staking/exposed"
+//		"github.com/okex/okchain-go-sdk/slim"
+//	)
+//
+//	cli, err := slim.NewClient(config, staking.ModuleName)
+//	mod, err := cli.Module(staking.ModuleName)
+//	validators, err := mod.(stakingexposed.Staking).QueryValidators()
+package slim
+
+import (
+	"fmt"
+
+	"github.com/okex/okchain-go-sdk/module"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	sdkerrors "github.com/okex/okchain-go-sdk/types/errors"
+)
+
+// Client - structure of the slim client with the modules imported only
+type Client struct {
+	baseClient  sdk.BaseClient
+	cdc         sdk.SDKCodec
+	modules     map[string]sdk.Module
+	moduleNames []string
+}
+
+// NewClient creates a new instance of Client with the modules, or all the modules imported if none
+func NewClient(config sdk.ClientConfig, moduleNames ...string) (*Client, error) {
+	return newClient(config, moduleNames, func(cdc sdk.SDKCodec) sdk.BaseClient {
+		return module.NewBaseClient(cdc, &config)
+	})
+}
+
+// NewClientWithRPC creates a new instance of Client with the modules on the rpc client injected, e.g. a transport of
+// the host app, or all the modules imported if none
+func NewClientWithRPC(config sdk.ClientConfig, rpcClient sdk.RPCClient, moduleNames ...string) (*Client, error) {
+	return newClient(config, moduleNames, func(cdc sdk.SDKCodec) sdk.BaseClient {
+		return module.NewBaseClientWithRPC(cdc, &config, rpcClient)
+	})
+}
+
+func newClient(config sdk.ClientConfig, moduleNames []string, newBaseClient func(sdk.SDKCodec) sdk.BaseClient) (
+	*Client, error) {
+	if len(moduleNames) == 0 {
+		moduleNames = sdk.RegisteredModuleNames()
+	}
+
+	cdc := sdk.NewCodec()
+	cli := &Client{
+		baseClient: newBaseClient(cdc),
+		cdc:        cdc,
+		modules:    make(map[string]sdk.Module),
+	}
+	for _, name := range moduleNames {
+		if _, ok := cli.modules[name]; ok {
+			continue
+		}
+		creator, ok := sdk.GetModuleCreator(name)
+		if !ok {
+			return nil, fmt.Errorf("failed. module %s is not imported", name)
+		}
+
+		mod := creator(cli.baseClient)
+		mod.RegisterCodec(cdc)
+		cli.modules[name] = mod
+		cli.moduleNames = append(cli.moduleNames, name)
+	}
+	sdk.RegisterBasicCodec(cdc)
+	cdc.Seal()
+
+	return cli, nil
+}
+
+// Module gets the client of the module registered by its name, which is asserted to the exposed interface of the
+// module, e.g. stakingexposed.Staking. It fails if the module is not registered in the client
+func (cli *Client) Module(moduleName string) (sdk.Module, error) {
+	mod, ok := cli.modules[moduleName]
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrModuleUnavailable, "failed. module %s is not registered in the client",
+			moduleName)
+	}
+	return mod, nil
+}
+
+// ModuleNames returns the names of the modules registered in the client
+func (cli *Client) ModuleNames() []string {
+	return append([]string{}, cli.moduleNames...)
+}
+
+// BaseClient returns the base client, e.g. to build and broadcast the txs with the msgs of the modules
+func (cli *Client) BaseClient() sdk.BaseClient {
+	return cli.baseClient
+}
+
+// GetCodec returns the codec with the msgs of the modules registered
+func (cli *Client) GetCodec() sdk.SDKCodec {
+	return cli.cdc
+}
@@ -0,0 +1,47 @@
+package slim
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/okex/okchain-go-sdk/module/staking"
+	stakingexposed "github.com/okex/okchain-go-sdk/module/staking/exposed"
+	"github.com/okex/okchain-go-sdk/module/token"
+	tokentypes "github.com/okex/okchain-go-sdk/module/token/types"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	sdkerrors "github.com/okex/okchain-go-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewClient(t *testing.T) {
+	config, err := sdk.NewClientConfig("testURL", "testChain", sdk.BroadcastBlock, "", 200000,
+		1.1, "0.00000001okt")
+	require.NoError(t, err)
+
+	// only the modules imported are linked and registered
+	require.Equal(t, []string{staking.ModuleName, token.ModuleName}, sdk.RegisteredModuleNames())
+	_, err = NewClient(config, staking.ModuleName, "dex")
+	require.Error(t, err)
+
+	cli, err := NewClient(config, staking.ModuleName, staking.ModuleName)
+	require.NoError(t, err)
+	require.Equal(t, []string{staking.ModuleName}, cli.ModuleNames())
+	mod, err := cli.Module(staking.ModuleName)
+	require.NoError(t, err)
+	_, ok := mod.(stakingexposed.Staking)
+	require.True(t, ok)
+	_, err = cli.Module(token.ModuleName)
+	require.True(t, errors.Is(err, sdkerrors.ErrModuleUnavailable))
+
+	// all the modules imported by default, whose msgs are registered in the codec
+	cli, err = NewClient(config)
+	require.NoError(t, err)
+	require.Equal(t, []string{staking.ModuleName, token.ModuleName}, cli.ModuleNames())
+	var msg sdk.Msg = tokentypes.NewMsgTokenSend(make(sdk.AccAddress, 20), make(sdk.AccAddress, 20), nil)
+	bz, err := cli.GetCodec().MarshalJSON(&msg)
+	require.NoError(t, err)
+	var decoded sdk.Msg
+	require.NoError(t, cli.GetCodec().UnmarshalJSON(bz, &decoded))
+	require.Equal(t, msg, decoded)
+	require.NotNil(t, cli.BaseClient())
+}
@@ -0,0 +1,271 @@
+// Package soak provides a long-running soak test harness, which sends small txs continuously among a set of accounts
+// on a target network and asserts the invariants after each tx, to validate the compatibility of gosdk and the node
+// before upgrades.
+//
+// The accounts used by the harness are expected to be dedicated to it. Any tx out of the harness touching them will be
+// reported as a violation of the balance conservation.
+package soak
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/okex/okchain-go-sdk/exposed"
+	"github.com/okex/okchain-go-sdk/module/auth/types"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	"github.com/okex/okchain-go-sdk/types/crypto/keys"
+)
+
+// names of the invariants asserted by the harness
+const (
+	InvariantSequenceMonotonicity = "sequence monotonicity"
+	InvariantBalanceConservation  = "balance conservation"
+)
+
+// Config - structure of the config of the soak test
+type Config struct {
+	// Accounts sends txs round-robin, from Accounts[i] to Accounts[i+1]. At least 2 accounts are required
+	Accounts []keys.Info
+	PassWd   string
+	// Amount is the coins of each tx, e.g. "0.0001okt"
+	Amount string
+	// Fees is the fees paid by each tx which must be the same as the fixed fees in the client config
+	Fees string
+	Memo string
+	// Interval is the pause between two rounds
+	Interval time.Duration
+	// Rounds is the count of the txs to send. The soak test runs until the context is done if it's 0
+	Rounds int
+}
+
+// ValidateBasic gives a quick validity check for the soak test config
+func (c Config) ValidateBasic() error {
+	if len(c.Accounts) < 2 {
+		return errors.New("failed. at least 2 accounts are required for the soak test")
+	}
+	if len(c.PassWd) == 0 {
+		return errors.New("failed. empty password")
+	}
+	if c.Rounds < 0 {
+		return errors.New("failed. rounds must not be negative")
+	}
+	if c.Interval < 0 {
+		return errors.New("failed. interval must not be negative")
+	}
+	return nil
+}
+
+// Violation - structure of an invariant violated during the soak test
+type Violation struct {
+	Round     int
+	Invariant string
+	Detail    string
+}
+
+// String returns a human readable string representation of Violation
+func (v Violation) String() string {
+	return fmt.Sprintf("round %d: %s violated: %s", v.Round, v.Invariant, v.Detail)
+}
+
+// Report - structure of the result of the soak test
+type Report struct {
+	Rounds     int
+	TxsSent    int
+	TxsFailed  int
+	Violations []Violation
+}
+
+// Passed tells whether all the invariants held during the soak test
+func (r Report) Passed() bool {
+	return len(r.Violations) == 0
+}
+
+// Harness - structure of the soak test harness
+type Harness struct {
+	auth   exposed.AuthQuery
+	token  exposed.TokenTx
+	config Config
+	amount sdk.Amount
+	fees   sdk.DecCoins
+	denoms []string
+}
+
+// NewHarness creates a new instance of Harness
+func NewHarness(auth exposed.AuthQuery, token exposed.TokenTx, config Config) (*Harness, error) {
+	if err := config.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	amount, err := sdk.ParseAmount(config.Amount)
+	if err != nil {
+		return nil, fmt.Errorf("failed. parse the amount of soak test error: %w", err)
+	}
+	fees, err := sdk.ParseDecCoins(config.Fees)
+	if err != nil {
+		return nil, fmt.Errorf("failed. parse the fees of soak test error: %w", err)
+	}
+
+	return &Harness{
+		auth:   auth,
+		token:  token,
+		config: config,
+		amount: amount,
+		fees:   fees,
+		denoms: collectDenoms(amount.Coins(), fees),
+	}, nil
+}
+
+// Run runs the soak test until the rounds in config are finished or the context is done
+// The error returned means that the soak test is aborted by a failed query, while the violations of the invariants are
+// collected in the report
+func (h *Harness) Run(ctx context.Context) (report Report, err error) {
+	accounts, err := h.queryAccounts()
+	if err != nil {
+		return
+	}
+	baseline := newSnapshot(accounts, h.denoms)
+
+	accLen := len(h.config.Accounts)
+	for round := 1; h.config.Rounds == 0 || round <= h.config.Rounds; round++ {
+		if round > 1 && h.config.Interval > 0 {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(h.config.Interval):
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return
+		default:
+		}
+
+		fromIndex := (round - 1) % accLen
+		fromInfo, toInfo := h.config.Accounts[fromIndex], h.config.Accounts[(fromIndex+1)%accLen]
+		fromAcc := accounts[fromIndex]
+
+		resp, sendErr := h.token.Send(fromInfo, h.config.PassWd, toInfo.GetAddress().String(), h.amount,
+			h.config.Memo, fromAcc.GetAccountNumber(), fromAcc.GetSequence())
+		succeeded := sendErr == nil && resp.Code == 0
+		if succeeded {
+			report.TxsSent++
+		} else {
+			report.TxsFailed++
+		}
+		report.Rounds = round
+
+		lastAccounts := accounts
+		if accounts, err = h.queryAccounts(); err != nil {
+			return
+		}
+
+		violations := h.checkSequences(round, lastAccounts, accounts, fromIndex, succeeded)
+		violations = append(violations, h.checkBalances(round, baseline, accounts)...)
+		if len(violations) != 0 {
+			report.Violations = append(report.Violations, violations...)
+			// rebase to avoid the violation reported again in the subsequent rounds
+			baseline = newSnapshot(accounts, h.denoms)
+		}
+	}
+
+	return
+}
+
+func (h *Harness) queryAccounts() ([]types.Account, error) {
+	accounts := make([]types.Account, len(h.config.Accounts))
+	for i, info := range h.config.Accounts {
+		acc, err := h.auth.QueryAccount(info.GetAddress().String())
+		if err != nil {
+			return nil, fmt.Errorf("failed. query the account %s in soak test error: %w", info.GetAddress(), err)
+		}
+		accounts[i] = acc
+	}
+	return accounts, nil
+}
+
+func (h *Harness) checkSequences(round int, lastAccounts, accounts []types.Account, fromIndex int, succeeded bool) (
+	violations []Violation) {
+	for i, acc := range accounts {
+		lastSeq, seq := lastAccounts[i].GetSequence(), acc.GetSequence()
+		if seq < lastSeq {
+			violations = append(violations, Violation{round, InvariantSequenceMonotonicity,
+				fmt.Sprintf("sequence of %s decreased from %d to %d", acc.GetAddress(), lastSeq, seq)})
+			continue
+		}
+
+		if i == fromIndex && succeeded && seq != lastSeq+1 {
+			violations = append(violations, Violation{round, InvariantSequenceMonotonicity,
+				fmt.Sprintf("sequence of %s is %d after a successful tx with sequence %d", acc.GetAddress(), seq,
+					lastSeq)})
+		}
+	}
+	return
+}
+
+func (h *Harness) checkBalances(round int, baseline snapshot, accounts []types.Account) (violations []Violation) {
+	current := newSnapshot(accounts, h.denoms)
+
+	// every tx included in a block, successful or not, increases the sequence and charges the fees
+	var chargedTxs int64
+	for i := range accounts {
+		chargedTxs += int64(current.sequences[i] - baseline.sequences[i])
+	}
+
+	for _, denom := range h.denoms {
+		expected := baseline.totals[denom].Sub(amountOf(h.fees, denom).MulInt64(chargedTxs))
+		if !current.totals[denom].Equal(expected) {
+			violations = append(violations, Violation{round, InvariantBalanceConservation,
+				fmt.Sprintf("total %s is %s, expected %s net of the fees of %d txs", denom, current.totals[denom],
+					expected, chargedTxs)})
+		}
+	}
+	return
+}
+
+// snapshot - structure of the state of the accounts at a time
+type snapshot struct {
+	totals    map[string]sdk.Dec
+	sequences []uint64
+}
+
+func newSnapshot(accounts []types.Account, denoms []string) snapshot {
+	s := snapshot{
+		totals:    make(map[string]sdk.Dec, len(denoms)),
+		sequences: make([]uint64, len(accounts)),
+	}
+	for _, denom := range denoms {
+		s.totals[denom] = sdk.ZeroDec()
+	}
+
+	for i, acc := range accounts {
+		s.sequences[i] = acc.GetSequence()
+		for _, denom := range denoms {
+			s.totals[denom] = s.totals[denom].Add(amountOf(acc.GetCoins(), denom))
+		}
+	}
+	return s
+}
+
+func amountOf(coins sdk.DecCoins, denom string) sdk.Dec {
+	for _, coin := range coins {
+		if coin.Denom == denom {
+			return coin.Amount
+		}
+	}
+	return sdk.ZeroDec()
+}
+
+func collectDenoms(coinsList ...sdk.DecCoins) (denoms []string) {
+	seen := make(map[string]bool)
+	for _, coins := range coinsList {
+		for _, coin := range coins {
+			if !seen[coin.Denom] {
+				seen[coin.Denom] = true
+				denoms = append(denoms, coin.Denom)
+			}
+		}
+	}
+	return
+}
@@ -0,0 +1,148 @@
+package soak
+
+import (
+	"context"
+	"testing"
+
+	"github.com/okex/okchain-go-sdk/exposed"
+	"github.com/okex/okchain-go-sdk/module/auth/types"
+	"github.com/okex/okchain-go-sdk/testutil"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	"github.com/okex/okchain-go-sdk/types/crypto/keys"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeChain simulates the accounts and the transfers on chain, with the bugs to be injected
+type fakeChain struct {
+	// the txs out of the soak test are never called
+	exposed.TokenTx
+	t        *testing.T
+	accounts map[string]*types.BaseAccount
+	fees     sdk.DecCoins
+	// bugs
+	skipSequence bool
+	leakFees     bool
+}
+
+func newFakeChain(t *testing.T, testAccounts []testutil.Account) *fakeChain {
+	fees, err := sdk.ParseDecCoins("0.01okt")
+	require.NoError(t, err)
+	fc := &fakeChain{
+		t:        t,
+		accounts: make(map[string]*types.BaseAccount),
+		fees:     fees,
+	}
+	for _, acc := range testAccounts {
+		baseAcc := acc.BaseAccount(0)
+		fc.accounts[acc.Address.String()] = &baseAcc
+	}
+	return fc
+}
+
+func (fc *fakeChain) QueryAccount(accAddrStr string) (types.Account, error) {
+	acc := *fc.accounts[accAddrStr]
+	return &acc, nil
+}
+
+func (fc *fakeChain) Send(fromInfo keys.Info, _, toAddrStr string, amount sdk.Amount, _ string, _, seqNum uint64) (
+	sdk.TxResponse, error) {
+	from, to := fc.accounts[fromInfo.GetAddress().String()], fc.accounts[toAddrStr]
+	require.Equal(fc.t, from.Sequence, seqNum)
+	coins := amount.Coins()
+
+	fees := fc.fees
+	if fc.leakFees {
+		fees = fees.Add(fees)
+	}
+	from.Coins = subCoins(from.Coins, coins.Add(fees))
+	to.Coins = to.Coins.Add(coins)
+	if !fc.skipSequence {
+		from.Sequence++
+	}
+	return sdk.TxResponse{}, nil
+}
+
+func subCoins(coins, coinsB sdk.DecCoins) sdk.DecCoins {
+	res := make(sdk.DecCoins, len(coins))
+	copy(res, coins)
+	for _, coinB := range coinsB {
+		for i := range res {
+			if res[i].Denom == coinB.Denom {
+				res[i].Amount = res[i].Amount.Sub(coinB.Amount)
+			}
+		}
+	}
+	return res
+}
+
+func newTestConfig(t *testing.T, testAccounts []testutil.Account, rounds int) Config {
+	infos := make([]keys.Info, len(testAccounts))
+	for i, acc := range testAccounts {
+		infos[i] = acc.ImportToKeybase(t)
+	}
+	return Config{
+		Accounts: infos,
+		PassWd:   testutil.AccountPassWd,
+		Amount:   "0.0001okt",
+		Fees:     "0.01okt",
+		Rounds:   rounds,
+	}
+}
+
+func TestHarness_Run(t *testing.T) {
+	testAccounts := testutil.NewAccounts(t, testutil.FixtureAccountsSeed, 3)
+	fc := newFakeChain(t, testAccounts)
+	config := newTestConfig(t, testAccounts, 7)
+
+	harness, err := NewHarness(fc, fc, config)
+	require.NoError(t, err)
+	report, err := harness.Run(context.Background())
+	require.NoError(t, err)
+	require.True(t, report.Passed(), report.Violations)
+	require.Equal(t, 7, report.Rounds)
+	require.Equal(t, 7, report.TxsSent)
+	require.Equal(t, 0, report.TxsFailed)
+
+	// sequence not increased
+	fc.skipSequence = true
+	report, err = harness.Run(context.Background())
+	require.NoError(t, err)
+	require.False(t, report.Passed())
+	require.Equal(t, InvariantSequenceMonotonicity, report.Violations[0].Invariant)
+
+	// more fees charged than expected
+	fc.skipSequence, fc.leakFees = false, true
+	report, err = harness.Run(context.Background())
+	require.NoError(t, err)
+	require.Len(t, report.Violations, 7)
+	require.Equal(t, InvariantBalanceConservation, report.Violations[0].Invariant)
+
+	// stopped by the context
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	config.Rounds = 0
+	harness, err = NewHarness(fc, fc, config)
+	require.NoError(t, err)
+	report, err = harness.Run(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 0, report.Rounds)
+}
+
+func TestNewHarness(t *testing.T) {
+	testAccounts := testutil.NewAccounts(t, testutil.FixtureAccountsSeed, 2)
+	fc := newFakeChain(t, testAccounts)
+
+	config := newTestConfig(t, testAccounts[:1], 0)
+	_, err := NewHarness(fc, fc, config)
+	require.Error(t, err)
+
+	config = newTestConfig(t, testAccounts, 0)
+	config.Amount = "0.0001"
+	_, err = NewHarness(fc, fc, config)
+	require.Error(t, err)
+
+	config = newTestConfig(t, testAccounts, 0)
+	config.PassWd = ""
+	_, err = NewHarness(fc, fc, config)
+	require.Error(t, err)
+}
@@ -0,0 +1,190 @@
+// Package sweep provides the consolidation of the accounts, which empties the balances of multiple accounts into one
+// destination with the fees sized to leave nothing behind, e.g. sweeping the deposit addresses into a treasury wallet,
+// or collects only the dust below the thresholds to keep the custodial accounts clean.
+package sweep
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/okex/okchain-go-sdk/exposed"
+	tokentypes "github.com/okex/okchain-go-sdk/module/token/types"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	"github.com/okex/okchain-go-sdk/types/crypto/keys"
+)
+
+// TxBuilder shows the expected behavior of building and broadcasting the sweeping txs
+type TxBuilder interface {
+	sdk.TxHandler
+	sdk.SimulationHandler
+	GetConfig() sdk.ClientConfig
+}
+
+// Result - structure of the result of sweeping an account
+type Result struct {
+	From sdk.AccAddress
+	// Amount is the balance swept to the destination, empty if the account has nothing to sweep
+	Amount sdk.DecCoins
+	Fee    sdk.StdFee
+	Resp   sdk.TxResponse
+	// Err is the failure of sweeping the account, which doesn't stop sweeping the others
+	Err error
+}
+
+// Swept tells whether the balance of the account was broadcast to the destination
+func (r Result) Swept() bool {
+	return r.Err == nil && len(r.Amount) != 0
+}
+
+// Sweeper - structure of the sweeper emptying the accounts into a destination
+type Sweeper struct {
+	auth      exposed.AuthQuery
+	txBuilder TxBuilder
+}
+
+// NewSweeper creates a new instance of Sweeper
+func NewSweeper(auth exposed.AuthQuery, txBuilder TxBuilder) *Sweeper {
+	return &Sweeper{
+		auth:      auth,
+		txBuilder: txBuilder,
+	}
+}
+
+// Sweep sends the whole spendable balance of each account to the destination, less the fee of its tx. The keys of the
+// accounts are unlocked by the same passphrase. Every account is swept by a separate tx, whose failure is reported in
+// its result without stopping the others
+func (s *Sweeper) Sweep(from []keys.Info, passWd string, to sdk.AccAddress) ([]Result, error) {
+	if to.Empty() {
+		return nil, errors.New("failed. empty destination address")
+	}
+
+	results := make([]Result, len(from))
+	for i, info := range from {
+		results[i] = s.sweepAccount(info, passWd, to, subFee)
+	}
+	return results, nil
+}
+
+// SweepDust sends the dust of each account to the destination, i.e. the coins below the thresholds of their denoms,
+// while the fee is paid by the rest of the balance. The denoms without a threshold are never taken as dust
+func (s *Sweeper) SweepDust(from []keys.Info, passWd string, thresholds sdk.DecCoins, to sdk.AccAddress) ([]Result,
+	error) {
+	if to.Empty() {
+		return nil, errors.New("failed. empty destination address")
+	}
+
+	pickDust := func(balance, fees sdk.DecCoins) (sdk.DecCoins, error) {
+		dust := FindDust(balance, thresholds)
+		if len(dust) == 0 {
+			return nil, nil
+		}
+		if _, err := subFee(balance.Sub(dust), fees); err != nil {
+			return nil, fmt.Errorf("the balance out of the dust can't pay the fee: %w", err)
+		}
+		return dust, nil
+	}
+
+	results := make([]Result, len(from))
+	for i, info := range from {
+		results[i] = s.sweepAccount(info, passWd, to, pickDust)
+	}
+	return results, nil
+}
+
+// FindDust returns the positive coins of the balance below the thresholds of their denoms
+func FindDust(balance, thresholds sdk.DecCoins) (dust sdk.DecCoins) {
+	for _, threshold := range thresholds {
+		amount := balance.AmountOf(threshold.Denom)
+		if amount.IsPositive() && amount.LT(threshold.Amount) {
+			dust = append(dust, sdk.NewDecCoinFromDec(threshold.Denom, amount))
+		}
+	}
+	return dust.Sort()
+}
+
+// sweepAccount sends the amount picked from the balance of the account with the fee left to the destination
+func (s *Sweeper) sweepAccount(info keys.Info, passWd string, to sdk.AccAddress,
+	pick func(balance, fees sdk.DecCoins) (sdk.DecCoins, error)) (result Result) {
+	result.From = info.GetAddress()
+	if result.From.Equals(to) {
+		result.Err = errors.New("failed. the account to sweep is the destination")
+		return
+	}
+
+	acc, err := s.auth.QueryAccount(result.From.String())
+	if err != nil {
+		result.Err = fmt.Errorf("failed. query account %s error: %w", result.From, err)
+		return
+	}
+	balance := acc.SpendableCoins(time.Now())
+	if balance.IsZero() {
+		return
+	}
+
+	// nothing to sweep even without the fee
+	if amount, _ := pick(balance, nil); len(amount) == 0 {
+		return
+	}
+
+	result.Fee, err = s.estimateFee(result.From, balance, to, acc.GetAccountNumber(), acc.GetSequence(), pick)
+	if err != nil {
+		result.Err = err
+		return
+	}
+	amount, err := pick(balance, result.Fee.Amount)
+	if err != nil {
+		result.Err = fmt.Errorf("failed. sweep account %s error: %w", result.From, err)
+		return
+	}
+	if len(amount) == 0 {
+		// the balance covers the fee only
+		return
+	}
+
+	msgs := []sdk.Msg{tokentypes.NewMsgTokenSend(result.From, to, amount)}
+	result.Resp, result.Err = s.txBuilder.BuildAndBroadcast(info.GetName(), passWd, "", msgs, acc.GetAccountNumber(),
+		acc.GetSequence(), sdk.WithFee(result.Fee))
+	if result.Err == nil {
+		result.Amount = amount
+	}
+	return
+}
+
+// estimateFee sizes the fee of the sweeping tx, by the fixed fees in the config or by the simulation of the tx
+func (s *Sweeper) estimateFee(from sdk.AccAddress, balance sdk.DecCoins, to sdk.AccAddress, accNum, seqNum uint64,
+	pick func(balance, fees sdk.DecCoins) (sdk.DecCoins, error)) (fee sdk.StdFee, err error) {
+	config := s.txBuilder.GetConfig()
+	if config.GasPrices.IsZero() {
+		return sdk.NewStdFee(config.Gas, config.Fees), nil
+	}
+
+	// the simulation pays the fee at the gas limit in the config, which is left out of the amount to keep it valid
+	simFees := make(sdk.DecCoins, len(config.GasPrices))
+	for i, gasPrice := range config.GasPrices {
+		simFees[i] = sdk.NewDecCoinFromDec(gasPrice.Denom, gasPrice.Amount.MulInt64(int64(config.Gas)))
+	}
+	simAmount, err := pick(balance, simFees)
+	if err != nil {
+		return fee, fmt.Errorf("failed. simulate sweeping account %s error: %w", from, err)
+	}
+
+	msgs := []sdk.Msg{tokentypes.NewMsgTokenSend(from, to, simAmount)}
+	txBytes, err := s.txBuilder.BuildTxForSim(msgs, "", accNum, seqNum)
+	if err != nil {
+		return fee, fmt.Errorf("failed. build tx for simulation error: %w", err)
+	}
+	if fee, err = s.txBuilder.CalculateGas(txBytes); err != nil {
+		return fee, fmt.Errorf("failed. simulate sweeping account %s error: %w", from, err)
+	}
+	return
+}
+
+// subFee subtracts the fee from the balance without the zero coins left, which fails if the balance can't cover it
+func subFee(balance, fees sdk.DecCoins) (sdk.DecCoins, error) {
+	amount, hasNeg := balance.SafeSub(fees)
+	if hasNeg {
+		return nil, fmt.Errorf("balance %s can't cover the fee %s", balance, fees)
+	}
+	return amount, nil
+}
@@ -0,0 +1,190 @@
+package sweep
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/okex/okchain-go-sdk/exposed"
+	"github.com/okex/okchain-go-sdk/mocks"
+	authtypes "github.com/okex/okchain-go-sdk/module/auth/types"
+	tokentypes "github.com/okex/okchain-go-sdk/module/token/types"
+	"github.com/okex/okchain-go-sdk/testutil"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	"github.com/okex/okchain-go-sdk/types/crypto/keys"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeChain serves the accounts from memory and records the txs broadcast
+type fakeChain struct {
+	// the calls out of the sweeper are never made
+	exposed.AuthQuery
+	sdk.TxHandler
+	sdk.SimulationHandler
+	config   sdk.ClientConfig
+	accounts map[string]*authtypes.BaseAccount
+	simGas   uint64
+	sims     []tokentypes.MsgSend
+	sent     []tokentypes.MsgSend
+	fees     []sdk.StdFee
+}
+
+func (fc *fakeChain) QueryAccount(accAddrStr string) (authtypes.Account, error) {
+	acc, ok := fc.accounts[accAddrStr]
+	if !ok {
+		return nil, errors.New("failed. your account has no record on the chain")
+	}
+	return acc, nil
+}
+
+func (fc *fakeChain) GetConfig() sdk.ClientConfig {
+	return fc.config
+}
+
+func (fc *fakeChain) BuildTxForSim(msgs []sdk.Msg, _ string, _, _ uint64) ([]byte, error) {
+	fc.sims = append(fc.sims, msgs[0].(tokentypes.MsgSend))
+	return []byte("sim"), nil
+}
+
+func (fc *fakeChain) CalculateGas([]byte) (sdk.StdFee, error) {
+	gasPrice := fc.config.GasPrices[0]
+	fee := gasPrice.Amount.MulInt64(int64(fc.simGas))
+	return sdk.NewStdFee(fc.simGas, sdk.NewDecCoins(sdk.NewDecCoinFromDec(gasPrice.Denom, fee))), nil
+}
+
+func (fc *fakeChain) BuildAndBroadcast(_, _, _ string, msgs []sdk.Msg, _, _ uint64, opts ...sdk.TxOption) (
+	sdk.TxResponse, error) {
+	options := sdk.NewTxOptions(opts...)
+	if options.Fee == nil {
+		return sdk.TxResponse{}, errors.New("no fee fixed")
+	}
+	fc.sent = append(fc.sent, msgs[0].(tokentypes.MsgSend))
+	fc.fees = append(fc.fees, *options.Fee)
+	return mocks.DefaultMockSuccessTxResponse(), nil
+}
+
+func newFakeChain(t *testing.T, config sdk.ClientConfig, accs []testutil.Account, balances ...string) *fakeChain {
+	fc := &fakeChain{config: config, accounts: make(map[string]*authtypes.BaseAccount)}
+	for i, balance := range balances {
+		coins, err := sdk.ParseDecCoins(balance)
+		require.NoError(t, err)
+		acc := accs[i].BaseAccount(2)
+		acc.Coins = coins
+		fc.accounts[accs[i].Address.String()] = &acc
+	}
+	return fc
+}
+
+func newInfos(accs []testutil.Account) []keys.Info {
+	infos := make([]keys.Info, len(accs))
+	for i, acc := range accs {
+		infos[i] = keys.NewLocalInfo(acc.Name, acc.PubKey(), "")
+	}
+	return infos
+}
+
+func TestSweeper_SweepFixedFees(t *testing.T) {
+	config, err := sdk.NewClientConfig("testURL", "testChain", sdk.BroadcastBlock, "0.02okt", 200000,
+		0, "")
+	require.NoError(t, err)
+	accs := testutil.NewAccounts(t, testutil.FixtureAccountsSeed, 5)
+	treasury := accs[4].Address
+	fc := newFakeChain(t, config, accs, "10.02okt,1btc", "0.02okt", "0.01okt,1btc")
+
+	results, err := NewSweeper(fc, fc).Sweep(newInfos(accs), testutil.AccountPassWd, treasury)
+	require.NoError(t, err)
+	require.Len(t, results, 5)
+
+	// swept to zero
+	require.True(t, results[0].Swept())
+	require.Equal(t, "10.00000000okt", results[0].Amount[1].Amount.String()+results[0].Amount[1].Denom)
+	require.Equal(t, config.Fees, results[0].Fee.Amount)
+	require.Len(t, fc.sent, 1)
+	require.True(t, fc.sent[0].FromAddress.Equals(accs[0].Address))
+	require.True(t, fc.sent[0].ToAddress.Equals(treasury))
+	require.Equal(t, results[0].Amount, fc.sent[0].Amount)
+
+	// the balance covers the fee only
+	require.NoError(t, results[1].Err)
+	require.False(t, results[1].Swept())
+	// the balance under the fee
+	require.Error(t, results[2].Err)
+	// no record on chain
+	require.Error(t, results[3].Err)
+	// the destination itself
+	require.Error(t, results[4].Err)
+	require.Len(t, fc.sent, 1)
+
+	_, err = NewSweeper(fc, fc).Sweep(newInfos(accs), testutil.AccountPassWd, nil)
+	require.Error(t, err)
+}
+
+func TestSweeper_SweepGasPrices(t *testing.T) {
+	config, err := sdk.NewClientConfig("testURL", "testChain", sdk.BroadcastBlock, "", 200000,
+		1.1, "0.0000001okt")
+	require.NoError(t, err)
+	accs := testutil.NewAccounts(t, testutil.FixtureAccountsSeed, 3)
+	fc := newFakeChain(t, config, accs, "1okt", "0.01okt")
+	fc.simGas = 100000
+
+	results, err := NewSweeper(fc, fc).Sweep(newInfos(accs[:2]), testutil.AccountPassWd, accs[2].Address)
+	require.NoError(t, err)
+
+	// simulated with the fee at the gas limit left out
+	require.Len(t, fc.sims, 1)
+	require.Equal(t, "0.98000000", fc.sims[0].Amount.AmountOf("okt").String())
+	require.True(t, results[0].Swept())
+	require.Equal(t, "0.99000000", results[0].Amount.AmountOf("okt").String())
+	require.Equal(t, uint64(100000), fc.fees[0].Gas)
+	require.Equal(t, results[0].Fee, fc.fees[0])
+
+	// the balance under the fee of the simulation
+	require.Error(t, results[1].Err)
+	require.Len(t, fc.sent, 1)
+}
+
+func TestFindDust(t *testing.T) {
+	balance, err := sdk.ParseDecCoins("0.5btc,0.001eth,10okt,0.1xxb")
+	require.NoError(t, err)
+	thresholds, err := sdk.ParseDecCoins("1btc,0.001eth,0.01okt,1usdk")
+	require.NoError(t, err)
+
+	dust := FindDust(balance, thresholds)
+	require.Len(t, dust, 1)
+	require.Equal(t, "btc", dust[0].Denom)
+	require.Equal(t, "0.50000000", dust[0].Amount.String())
+	require.Empty(t, FindDust(balance, nil))
+}
+
+func TestSweeper_SweepDust(t *testing.T) {
+	config, err := sdk.NewClientConfig("testURL", "testChain", sdk.BroadcastBlock, "0.02okt", 200000,
+		0, "")
+	require.NoError(t, err)
+	accs := testutil.NewAccounts(t, testutil.FixtureAccountsSeed, 4)
+	fc := newFakeChain(t, config, accs, "10okt,0.5btc,0.1eth,5xxb", "10okt,5btc", "0.01okt,0.5btc")
+	thresholds, err := sdk.ParseDecCoins("1btc,1eth,0.1okt")
+	require.NoError(t, err)
+
+	results, err := NewSweeper(fc, fc).SweepDust(newInfos(accs[:3]), testutil.AccountPassWd, thresholds,
+		accs[3].Address)
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+
+	// only the dust is swept with the fee paid by the rest
+	require.True(t, results[0].Swept())
+	require.Len(t, fc.sent, 1)
+	require.Equal(t, results[0].Amount, fc.sent[0].Amount)
+	require.Len(t, fc.sent[0].Amount, 2)
+	require.Equal(t, "0.50000000", fc.sent[0].Amount.AmountOf("btc").String())
+	require.Equal(t, "0.10000000", fc.sent[0].Amount.AmountOf("eth").String())
+	require.True(t, fc.sent[0].Amount.AmountOf("okt").IsZero())
+
+	// no dust
+	require.NoError(t, results[1].Err)
+	require.False(t, results[1].Swept())
+	// the fee unpaid by the balance out of the dust
+	require.Error(t, results[2].Err)
+	require.Len(t, fc.sent, 1)
+
+	_, err = NewSweeper(fc, fc).SweepDust(newInfos(accs), testutil.AccountPassWd, thresholds, nil)
+	require.Error(t, err)
+}
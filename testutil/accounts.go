@@ -0,0 +1,190 @@
+package testutil
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/go-bip39"
+	auth "github.com/okex/okchain-go-sdk/module/auth/types"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	"github.com/okex/okchain-go-sdk/types/crypto/keys"
+	"github.com/okex/okchain-go-sdk/types/tx"
+	"github.com/okex/okchain-go-sdk/utils"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+)
+
+// the well-known mnemonics of the accounts funded in the genesis of the okchain local testnet
+const (
+	CaptainMnemonic = "puzzle glide follow cruel say burst deliver wild tragic galaxy lumber offer"
+	Admin16Mnemonic = "palace cube bitter light woman side pave cereal donor bronze twice work"
+	Admin17Mnemonic = "antique onion adult slot sad dizzy sure among cement demise submit scare"
+	Admin18Mnemonic = "lazy cause kite fence gravity regret visa fuel tone clerk motor rent"
+)
+
+// const of the account fixtures
+const (
+	// AccountPassWd is the passphrase of the accounts imported into the keybase
+	AccountPassWd         = "12345678"
+	FixtureAccountsSeed   = "okchain-go-sdk/test-accounts"
+	FixtureAccountBalance = "1024okt"
+)
+
+// Account - structure of an account fixture derived from its mnemonic on the first path of the coin type in the
+// config, i.e. an account of a local devnet or a deterministic one of the unit tests
+type Account struct {
+	Name          string
+	Mnemonic      string
+	PrivKey       secp256k1.PrivKeySecp256k1
+	Address       sdk.AccAddress
+	AccountNumber uint64
+	Coins         sdk.DecCoins
+}
+
+// NewAccount derives the account from the mnemonic
+func NewAccount(name, mnemonic string) (account Account, err error) {
+	privKeyHex, err := utils.GeneratePrivateKeyFromMnemo(mnemonic)
+	if err != nil {
+		return account, fmt.Errorf("failed. derive the key of %s error: %w", name, err)
+	}
+	privKeyBytes, err := hex.DecodeString(privKeyHex)
+	if err != nil {
+		return
+	}
+
+	account = Account{
+		Name:     name,
+		Mnemonic: mnemonic,
+	}
+	copy(account.PrivKey[:], privKeyBytes)
+	account.Address = sdk.AccAddress(account.PrivKey.PubKey().Address())
+	return
+}
+
+// NewAccounts derives n accounts from the seed deterministically, which look funded with FixtureAccountBalance, so
+// that the downstream test suites get stable fixtures without storing mnemonics in code. The same seed always
+// produces the same names, mnemonics and keys
+func NewAccounts(t testing.TB, seed string, n int) []Account {
+	t.Helper()
+	coins, err := sdk.ParseDecCoins(FixtureAccountBalance)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	accounts := make([]Account, n)
+	for i := 0; i < n; i++ {
+		// 256 bits of entropy derived from the seed and the index give a 24-word mnemonic
+		entropy := sha256.Sum256([]byte(fmt.Sprintf("%s/%d", seed, i)))
+		mnemonic, err := bip39.NewMnemonic(entropy[:])
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if accounts[i], err = NewAccount(fmt.Sprintf("account%d", i), mnemonic); err != nil {
+			t.Fatal(err)
+		}
+		accounts[i].AccountNumber, accounts[i].Coins = uint64(i), coins
+	}
+
+	return accounts
+}
+
+// Captain returns the captain account of the local testnet, which is the validator and the owner of the native token
+func Captain() Account {
+	return mustNewAccount("captain", CaptainMnemonic)
+}
+
+// Admins returns the admin accounts of the local testnet funded in the genesis
+func Admins() []Account {
+	return []Account{
+		mustNewAccount("admin16", Admin16Mnemonic),
+		mustNewAccount("admin17", Admin17Mnemonic),
+		mustNewAccount("admin18", Admin18Mnemonic),
+	}
+}
+
+// DevnetAccounts returns the captain followed by the admins of the local testnet
+func DevnetAccounts() []Account {
+	return append([]Account{Captain()}, Admins()...)
+}
+
+// the well-known mnemonics are always valid
+func mustNewAccount(name, mnemonic string) Account {
+	account, err := NewAccount(name, mnemonic)
+	if err != nil {
+		panic(err)
+	}
+	return account
+}
+
+// PubKey returns the public key of the account
+func (a Account) PubKey() crypto.PubKey {
+	return a.PrivKey.PubKey()
+}
+
+// Signer returns the signer of the account by its private key in memory
+func (a Account) Signer() sdk.Signer {
+	return tx.NewPrivKeySigner(a.PrivKey)
+}
+
+// BaseAccount returns the account state of the account on chain with a specific sequence
+func (a Account) BaseAccount(seqNum uint64) auth.BaseAccount {
+	return auth.BaseAccount{
+		Address:       a.Address,
+		Coins:         a.Coins,
+		PubKey:        a.PubKey(),
+		AccountNumber: a.AccountNumber,
+		Sequence:      seqNum,
+	}
+}
+
+// ImportToKeybase imports the account into the global keybase with AccountPassWd, so that it's able to be used as the
+// fromInfo of the module clients. The key imported already is returned as it is
+func (a Account) ImportToKeybase(t testing.TB) keys.Info {
+	t.Helper()
+	info, err := a.importToKeybase()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return info
+}
+
+func (a Account) importToKeybase() (keys.Info, error) {
+	if info, err := tx.Kb.Get(a.Name); err == nil {
+		if !info.GetAddress().Equals(a.Address) {
+			return nil, fmt.Errorf("failed. key %s in the keybase is of another address %s", a.Name,
+				info.GetAddress())
+		}
+		return info, nil
+	}
+
+	info, _, err := utils.CreateAccountWithMnemo(a.Mnemonic, a.Name, AccountPassWd)
+	return info, err
+}
+
+// SignStdTx builds a StdTx signed by the account with its own account number
+func (a Account) SignStdTx(t testing.TB, chainID string, msgs []sdk.Msg, fee sdk.StdFee, memo string,
+	seqNum uint64) sdk.StdTx {
+	t.Helper()
+	signMsg := sdk.StdSignMsg{
+		ChainID:       chainID,
+		AccountNumber: a.AccountNumber,
+		Sequence:      seqNum,
+		Memo:          memo,
+		Msgs:          msgs,
+		Fee:           fee,
+	}
+
+	sigBytes, err := a.PrivKey.Sign(signMsg.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig := sdk.StdSignature{
+		PubKey:    a.PubKey(),
+		Signature: sigBytes,
+	}
+
+	return sdk.NewStdTx(signMsg.Msgs, signMsg.Fee, []sdk.StdSignature{sig}, signMsg.Memo)
+}
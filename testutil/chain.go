@@ -0,0 +1,75 @@
+package testutil
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/okex/okchain-go-sdk/exposed"
+	sdk "github.com/okex/okchain-go-sdk/types"
+)
+
+// BlockPollInterval is the interval of polling the latest height while waiting for the blocks
+var BlockPollInterval = 500 * time.Millisecond
+
+// CheckFunds checks that the account holds at least the coins, and returns the error of the shortfall otherwise
+func CheckFunds(auth exposed.AuthQuery, accAddrStr string, minCoins sdk.DecCoins) error {
+	account, err := auth.QueryAccount(accAddrStr)
+	if err != nil {
+		return fmt.Errorf("failed. query account %s error: %w", accAddrStr, err)
+	}
+
+	coins := account.GetCoins()
+	var shortfall sdk.DecCoins
+	for _, coin := range minCoins {
+		if held := coins.AmountOf(coin.Denom); held.LT(coin.Amount) {
+			shortfall = append(shortfall, sdk.NewDecCoinFromDec(coin.Denom, coin.Amount.Sub(held)))
+		}
+	}
+	if len(shortfall) != 0 {
+		return fmt.Errorf("failed. account %s holding %s is short of %s", accAddrStr, coins, shortfall)
+	}
+	return nil
+}
+
+// RequireFunds fails the test at once unless the account holds at least the coins, e.g. "100okt"
+func RequireFunds(t testing.TB, auth exposed.AuthQuery, accAddrStr, minCoinsStr string) {
+	t.Helper()
+	minCoins, err := sdk.ParseDecCoins(minCoinsStr)
+	if err != nil {
+		t.Fatalf("failed. parse coins %s error: %v", minCoinsStr, err)
+	}
+	if err = CheckFunds(auth, accAddrStr, minCoins); err != nil {
+		t.Fatalf("%v", err)
+	}
+}
+
+// WaitForHeight waits until the block at the height is committed, and returns the latest height then
+func WaitForHeight(ctx context.Context, tm exposed.TendermintQuery, height int64) (int64, error) {
+	for {
+		latest, err := tm.QueryLatestCommitResult()
+		if err != nil {
+			return 0, fmt.Errorf("failed. query latest height error: %w", err)
+		}
+		if latest.Height >= height {
+			return latest.Height, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return latest.Height, fmt.Errorf("failed. wait for height %d at height %d: %w", height, latest.Height,
+				ctx.Err())
+		case <-time.After(BlockPollInterval):
+		}
+	}
+}
+
+// WaitForNBlocks waits until n more blocks are committed after the latest one, and returns the latest height then
+func WaitForNBlocks(ctx context.Context, tm exposed.TendermintQuery, n int64) (int64, error) {
+	latest, err := tm.QueryLatestCommitResult()
+	if err != nil {
+		return 0, fmt.Errorf("failed. query latest height error: %w", err)
+	}
+	return WaitForHeight(ctx, tm, latest.Height+n)
+}
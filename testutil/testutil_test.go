@@ -0,0 +1,148 @@
+package testutil
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/okex/okchain-go-sdk/exposed"
+	auth "github.com/okex/okchain-go-sdk/module/auth/types"
+	tmtypes "github.com/okex/okchain-go-sdk/module/tendermint/types"
+	token "github.com/okex/okchain-go-sdk/module/token/types"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	"github.com/stretchr/testify/require"
+	tmheader "github.com/tendermint/tendermint/types"
+)
+
+func TestDevnetAccounts(t *testing.T) {
+	accounts := DevnetAccounts()
+	require.Len(t, accounts, 4)
+	require.Equal(t, "captain", accounts[0].Name)
+	require.Equal(t, Captain(), accounts[0])
+	require.Equal(t, Admins(), accounts[1:])
+
+	// derived deterministically and distinctly
+	seen := make(map[string]bool)
+	for _, account := range accounts {
+		derived, err := NewAccount(account.Name, account.Mnemonic)
+		require.NoError(t, err)
+		require.Equal(t, account, derived)
+		require.Equal(t, sdk.AccAddress(account.PubKey().Address()), account.Address)
+		require.False(t, seen[account.Address.String()])
+		seen[account.Address.String()] = true
+	}
+	_, err := NewAccount("nobody", "not a mnemonic")
+	require.Error(t, err)
+
+	// imported once, and the same key is returned then
+	require.Equal(t, accounts[1].Address, accounts[1].ImportToKeybase(t).GetAddress())
+	require.Equal(t, accounts[1].Address, accounts[1].ImportToKeybase(t).GetAddress())
+	other := accounts[2]
+	other.Name = accounts[1].Name
+	_, err = other.importToKeybase()
+	require.Error(t, err)
+}
+
+func TestNewAccounts(t *testing.T) {
+	accounts := NewAccounts(t, FixtureAccountsSeed, 3)
+	require.Len(t, accounts, 3)
+
+	// deterministic with the same seed
+	again := NewAccounts(t, FixtureAccountsSeed, 3)
+	require.Equal(t, accounts, again)
+
+	// distinct with another seed
+	other := NewAccounts(t, "another seed", 1)
+	require.NotEqual(t, accounts[0].Address, other[0].Address)
+
+	for i, acc := range accounts {
+		require.Equal(t, uint64(i), acc.AccountNumber)
+		require.Equal(t, acc.Address, sdk.AccAddress(acc.PubKey().Address()))
+		require.Equal(t, acc.Coins, acc.BaseAccount(2).Coins)
+		for j := i + 1; j < len(accounts); j++ {
+			require.NotEqual(t, acc.Mnemonic, accounts[j].Mnemonic)
+			require.NotEqual(t, acc.Address, accounts[j].Address)
+		}
+
+		info := acc.ImportToKeybase(t)
+		require.Equal(t, acc.Name, info.GetName())
+		require.Equal(t, acc.Address, info.GetAddress())
+	}
+
+	coins, err := sdk.ParseDecCoins("10.24okt")
+	require.NoError(t, err)
+	fees, err := sdk.ParseDecCoins("0.01okt")
+	require.NoError(t, err)
+	msgs := []sdk.Msg{token.NewMsgTokenSend(accounts[0].Address, accounts[1].Address, coins)}
+	stdTx := accounts[0].SignStdTx(t, "okchain", msgs, sdk.NewStdFee(200000, fees), "my memo", 2)
+	require.Len(t, stdTx.Signatures, 1)
+
+	signBytes := sdk.StdSignMsg{
+		ChainID:       "okchain",
+		AccountNumber: accounts[0].AccountNumber,
+		Sequence:      2,
+		Fee:           stdTx.Fee,
+		Msgs:          msgs,
+		Memo:          "my memo",
+	}.Bytes()
+	require.True(t, accounts[0].PubKey().VerifyBytes(signBytes, stdTx.Signatures[0].Signature))
+}
+
+type authQuery map[string]sdk.DecCoins
+
+func (aq authQuery) QueryAccount(accAddrStr string) (auth.Account, error) {
+	coins, ok := aq[accAddrStr]
+	if !ok {
+		return nil, errors.New("unknown account")
+	}
+	return &auth.BaseAccount{Coins: coins}, nil
+}
+
+func TestCheckFunds(t *testing.T) {
+	captain := Captain().Address.String()
+	coins, err := sdk.ParseDecCoins("100okt,10xxb")
+	require.NoError(t, err)
+	aq := authQuery{captain: coins}
+
+	RequireFunds(t, aq, captain, "100okt,1xxb")
+	minCoins, err := sdk.ParseDecCoins("101okt,1xxb,1yyb")
+	require.NoError(t, err)
+	err = CheckFunds(aq, captain, minCoins)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "1.00000000okt,1.00000000yyb")
+	require.Error(t, CheckFunds(aq, Admins()[0].Address.String(), minCoins))
+}
+
+// tendermintQuery commits a block on each query of the latest height
+type tendermintQuery struct {
+	exposed.TendermintQuery
+	mtx    sync.Mutex
+	height int64
+}
+
+func (tq *tendermintQuery) QueryLatestCommitResult() (tmtypes.ResultCommit, error) {
+	tq.mtx.Lock()
+	defer tq.mtx.Unlock()
+	tq.height++
+	return tmtypes.ResultCommit{SignedHeader: tmtypes.SignedHeader{Header: tmheader.Header{Height: tq.height}}}, nil
+}
+
+func TestWaitForNBlocks(t *testing.T) {
+	defer func(interval time.Duration) { BlockPollInterval = interval }(BlockPollInterval)
+	BlockPollInterval = time.Millisecond
+	tq := &tendermintQuery{height: 9}
+
+	height, err := WaitForNBlocks(context.Background(), tq, 3)
+	require.NoError(t, err)
+	require.Equal(t, int64(13), height)
+	height, err = WaitForHeight(context.Background(), tq, 10)
+	require.NoError(t, err)
+	require.Equal(t, int64(14), height)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = WaitForNBlocks(ctx, tq, 3)
+	require.True(t, errors.Is(err, context.Canceled))
+}
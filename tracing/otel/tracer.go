@@ -0,0 +1,93 @@
+// Package otel adapts an OpenTelemetry tracer to the tracer of the tx lifecycle spans of gosdk. It's a module of its
+// own, so that the core of gosdk stays free of the OpenTelemetry dependency
+package otel
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	sdk "github.com/okex/okchain-go-sdk/types"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	_ sdk.Tracer = tracer{}
+	_ sdk.Span   = span{}
+)
+
+// tracer - structure of the sdk.Tracer starting the spans by an OpenTelemetry tracer
+type tracer struct {
+	tracer trace.Tracer
+}
+
+// NewTracer creates an sdk.Tracer on the OpenTelemetry tracer, e.g. otel.Tracer("gosdk") of the global
+// TracerProvider, which is set as the Tracer of the client config
+func NewTracer(t trace.Tracer) sdk.Tracer {
+	return tracer{t}
+}
+
+// Start implements the sdk.Tracer interface, whose spans are of the client kind
+func (t tracer) Start(ctx context.Context, spanName string, attrs ...sdk.SpanAttribute) (context.Context, sdk.Span) {
+	ctx, otelSpan := t.tracer.Start(ctx, spanName, trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(Attributes(attrs...)...))
+	return ctx, span{otelSpan}
+}
+
+// span - structure of the sdk.Span on an OpenTelemetry span
+type span struct {
+	span trace.Span
+}
+
+func (s span) SetAttributes(attrs ...sdk.SpanAttribute) {
+	s.span.SetAttributes(Attributes(attrs...)...)
+}
+
+// RecordError records the error as an event of the span and sets the error status
+func (s span) RecordError(err error) {
+	s.span.RecordError(err)
+	s.span.SetStatus(codes.Error, err.Error())
+}
+
+func (s span) End() {
+	s.span.End()
+}
+
+// Attributes converts the span attributes into the OpenTelemetry ones by the types of their values, where the values
+// of other types are taken as their strings
+func Attributes(attrs ...sdk.SpanAttribute) []attribute.KeyValue {
+	kvs := make([]attribute.KeyValue, len(attrs))
+	for i, attr := range attrs {
+		kvs[i] = keyValue(attr)
+	}
+	return kvs
+}
+
+func keyValue(attr sdk.SpanAttribute) attribute.KeyValue {
+	key := attribute.Key(attr.Key)
+	switch value := attr.Value.(type) {
+	case string:
+		return key.String(value)
+	case bool:
+		return key.Bool(value)
+	case int:
+		return key.Int(value)
+	case int64:
+		return key.Int64(value)
+	case uint32:
+		return key.Int64(int64(value))
+	case uint64:
+		if value > math.MaxInt64 {
+			return key.String(fmt.Sprint(value))
+		}
+		return key.Int64(int64(value))
+	case float64:
+		return key.Float64(value)
+	case fmt.Stringer:
+		return key.String(value.String())
+	default:
+		return key.String(fmt.Sprint(value))
+	}
+}
@@ -0,0 +1,49 @@
+package otel
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	sdk "github.com/okex/okchain-go-sdk/types"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestTracer(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	tracer := NewTracer(provider.Tracer("gosdk"))
+
+	ctx, lifecycle := tracer.Start(context.Background(), sdk.SpanTxLifecycle,
+		sdk.NewSpanAttribute(sdk.AttributeKeyMsgModules, "token"))
+	_, broadcast := tracer.Start(ctx, sdk.SpanTxBroadcast)
+	broadcast.SetAttributes(sdk.NewSpanAttribute(sdk.AttributeKeyCode, uint32(5)),
+		sdk.NewSpanAttribute(sdk.AttributeKeyHeight, int64(1024)),
+		sdk.NewSpanAttribute(sdk.AttributeKeySequence, uint64(2)),
+		sdk.NewSpanAttribute(sdk.AttributeKeyBroadcastMode, sdk.BroadcastBlock))
+	broadcast.RecordError(errors.New("insufficient funds"))
+	broadcast.End()
+	lifecycle.End()
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	require.Equal(t, sdk.SpanTxBroadcast, spans[0].Name())
+	require.Equal(t, sdk.SpanTxLifecycle, spans[1].Name())
+	require.Equal(t, spans[1].SpanContext().SpanID(), spans[0].Parent().SpanID())
+	require.Equal(t, trace.SpanKindClient, spans[0].SpanKind())
+	require.Equal(t, []attribute.KeyValue{attribute.String(sdk.AttributeKeyMsgModules, "token")},
+		spans[1].Attributes())
+	require.Equal(t, []attribute.KeyValue{
+		attribute.Int64(sdk.AttributeKeyCode, 5),
+		attribute.Int64(sdk.AttributeKeyHeight, 1024),
+		attribute.Int64(sdk.AttributeKeySequence, 2),
+		attribute.String(sdk.AttributeKeyBroadcastMode, string(sdk.BroadcastBlock)),
+	}, spans[0].Attributes())
+	require.Equal(t, codes.Error, spans[0].Status().Code)
+	require.Len(t, spans[0].Events(), 1)
+}
@@ -0,0 +1,89 @@
+package gosdk
+
+import (
+	sdk "github.com/okex/okchain-go-sdk/types"
+)
+
+// TxBuilder - structure of the fluent builder of a single tx combining the msgs of multiple modules, which is signed
+// and broadcast once instead of one tx by each helper of the module clients, e.g.
+//
+//	res, err := cli.NewTx().
+//		AddMsg(stakingtypes.NewMsgDelegate(addr, amount)).
+//		AddMsg(govtypes.NewMsgVote(addr, proposalID, govtypes.VoteOptionYes)).
+//		WithMemo("delegate and vote").
+//		Broadcast(fromName, passWd, accNum, seqNum)
+type TxBuilder struct {
+	cli  *Client
+	msgs []sdk.Msg
+	memo string
+	opts []sdk.TxOption
+}
+
+// NewTx creates a builder of a tx with the msgs of any modules registered in the client
+func (cli *Client) NewTx() *TxBuilder {
+	return &TxBuilder{cli: cli}
+}
+
+// AddMsg adds a msg to the tx, which is signed in the order added
+func (tb *TxBuilder) AddMsg(msg sdk.Msg) *TxBuilder {
+	tb.msgs = append(tb.msgs, msg)
+	return tb
+}
+
+// AddMsgs adds multiple msgs to the tx in order
+func (tb *TxBuilder) AddMsgs(msgs ...sdk.Msg) *TxBuilder {
+	tb.msgs = append(tb.msgs, msgs...)
+	return tb
+}
+
+// WithMemo sets the memo of the tx
+func (tb *TxBuilder) WithMemo(memo string) *TxBuilder {
+	tb.memo = memo
+	return tb
+}
+
+// WithFee fixes the fee of the tx instead of the fees or the gas estimation of the client config
+func (tb *TxBuilder) WithFee(fee sdk.StdFee) *TxBuilder {
+	return tb.WithOptions(sdk.WithFee(fee))
+}
+
+// WithOptions adds the optional settings of the tx, e.g. sdk.WithFeePayer and sdk.WithTimeoutHeight
+func (tb *TxBuilder) WithOptions(opts ...sdk.TxOption) *TxBuilder {
+	tb.opts = append(tb.opts, opts...)
+	return tb
+}
+
+// Msgs returns the msgs of the tx in order
+func (tb *TxBuilder) Msgs() []sdk.Msg {
+	return append([]sdk.Msg(nil), tb.msgs...)
+}
+
+// Memo returns the memo of the tx
+func (tb *TxBuilder) Memo() string {
+	return tb.memo
+}
+
+// Broadcast signs the tx by the key in the keybase and broadcasts it
+func (tb *TxBuilder) Broadcast(fromName, passWd string, accNum, seqNum uint64) (sdk.TxResponse, error) {
+	return tb.cli.BuildAndBroadcast(fromName, passWd, tb.memo, tb.msgs, accNum, seqNum, tb.opts...)
+}
+
+// BroadcastWithSigner signs the tx by the signer, e.g. a HSM or a KMS, and broadcasts it
+func (tb *TxBuilder) BroadcastWithSigner(signer sdk.Signer, accNum, seqNum uint64) (sdk.TxResponse, error) {
+	return tb.cli.BuildAndBroadcastWithSigner(signer, tb.memo, tb.msgs, accNum, seqNum, tb.opts...)
+}
+
+// BuildSignedTx signs the tx by the key in the keybase and returns its encoded bytes and hash without broadcasting
+func (tb *TxBuilder) BuildSignedTx(fromName, passWd string, accNum, seqNum uint64) (sdk.SignedTx, error) {
+	return tb.cli.BuildSignedTx(fromName, passWd, tb.memo, tb.msgs, accNum, seqNum, tb.opts...)
+}
+
+// BuildSignedTxWithSigner signs the tx by the signer and returns its encoded bytes and hash without broadcasting
+func (tb *TxBuilder) BuildSignedTxWithSigner(signer sdk.Signer, accNum, seqNum uint64) (sdk.SignedTx, error) {
+	return tb.cli.BuildSignedTxWithSigner(signer, tb.memo, tb.msgs, accNum, seqNum, tb.opts...)
+}
+
+// BuildSignDoc returns the sign doc of the tx to be signed by the signer without signing it, e.g. for the approval
+func (tb *TxBuilder) BuildSignDoc(signer sdk.Signer, accNum, seqNum uint64) (sdk.SignDoc, error) {
+	return tb.cli.BuildSignDoc(signer, tb.memo, tb.msgs, accNum, seqNum, tb.opts...)
+}
@@ -0,0 +1,62 @@
+package gosdk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/okex/okchain-go-sdk/mocks"
+	"github.com/okex/okchain-go-sdk/module/auth"
+	"github.com/okex/okchain-go-sdk/module/governance"
+	govtypes "github.com/okex/okchain-go-sdk/module/governance/types"
+	"github.com/okex/okchain-go-sdk/module/staking"
+	stakingtypes "github.com/okex/okchain-go-sdk/module/staking/types"
+	"github.com/okex/okchain-go-sdk/module/tendermint"
+	"github.com/okex/okchain-go-sdk/testutil"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	sdkerrors "github.com/okex/okchain-go-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_NewTx(t *testing.T) {
+	config, err := sdk.NewClientConfig("testURL", "okchain", sdk.BroadcastBlock, "0.01okt", 200000,
+		0, "")
+	require.NoError(t, err)
+	cli := newClientWithRPC(config, []string{auth.ModuleName, staking.ModuleName, governance.ModuleName,
+		tendermint.ModuleName}, mocks.NewConformanceBackend())
+
+	user := testutil.NewAccounts(t, testutil.FixtureAccountsSeed, 1)[0]
+	amount, err := sdk.ParseDecCoin("10.24okt")
+	require.NoError(t, err)
+	msgs := []sdk.Msg{
+		stakingtypes.NewMsgDelegate(user.Address, amount),
+		govtypes.NewMsgVote(user.Address, 1, govtypes.VoteOptionYes),
+	}
+	fees, err := sdk.ParseDecCoins("0.02okt")
+	require.NoError(t, err)
+	fee := sdk.NewStdFee(300000, fees)
+
+	txb := cli.NewTx().
+		AddMsg(msgs[0]).
+		AddMsg(msgs[1]).
+		WithMemo("delegate and vote").
+		WithFee(fee)
+	require.Equal(t, msgs, txb.Msgs())
+	require.Equal(t, "delegate and vote", txb.Memo())
+
+	// the msgs of the modules are signed in a single tx
+	signedTx, err := txb.BuildSignedTxWithSigner(user.Signer(), user.AccountNumber, 2)
+	require.NoError(t, err)
+	require.Equal(t, user.SignStdTx(t, config.ChainID, msgs, fee, "delegate and vote", 2), signedTx.StdTx)
+	decoded, err := cli.DecodeStdTx(signedTx.Bytes)
+	require.NoError(t, err)
+	require.Equal(t, msgs, decoded.Msgs)
+
+	doc, err := txb.BuildSignDoc(user.Signer(), user.AccountNumber, 2)
+	require.NoError(t, err)
+	require.Equal(t, fee, doc.SignMsg.Fee)
+	require.True(t, user.PubKey().VerifyBytes(doc.SignBytes, signedTx.StdTx.Signatures[0].Signature))
+
+	// no msg
+	_, err = cli.NewTx().BuildSignedTxWithSigner(user.Signer(), user.AccountNumber, 2)
+	require.True(t, errors.Is(err, sdkerrors.ErrInvalidMsg))
+}
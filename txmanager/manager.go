@@ -0,0 +1,495 @@
+// Package txmanager provides the manager of the txs broadcast by the automated services, which detects the txs that
+// never land, e.g. evicted from the mempool, and resubmits them with the same sequence and optionally bumped fees. A gap
+// of the sequences of a signer, which blocks all its later txs, is filled by the no-op txs given, so that the stuck
+// sequences are recovered without operator intervention.
+package txmanager
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/okex/okchain-go-sdk/exposed"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+)
+
+const (
+	defaultStuckAfter   = 10
+	defaultMaxResubmits = 3
+	// the most txs of the mempool served by the tendermint rpc
+	mempoolQueryLimit = 100
+)
+
+// TxBuilder shows the expected behavior of building and broadcasting the txs managed
+type TxBuilder interface {
+	BuildSignedTxWithSigner(signer sdk.Signer, memo string, msgs []sdk.Msg, accNumber, seqNumber uint64,
+		opts ...sdk.TxOption) (sdk.SignedTx, error)
+	Broadcast(txBytes []byte, broadcastMode sdk.BroadcastMode) (sdk.TxResponse, error)
+}
+
+// Mempool shows the expected behavior of the node queried for the txs in its mempool. A stuck tx still in the mempool
+// isn't resubmitted if the tx builder serves the mempool as well
+type Mempool interface {
+	UnconfirmedTxs(limit int) (*ctypes.ResultUnconfirmedTxs, error)
+}
+
+// Config - structure of the config of the tx manager
+type Config struct {
+	// StuckAfter is the number of the blocks since a tx was broadcast, after which it's stuck if not committed, 10 by
+	// default
+	StuckAfter int64
+	// FeeBump multiplies the fees of a stuck tx on each resubmission, e.g. 1.1 for 10% more, no bump if nil or not
+	// greater than 1
+	FeeBump sdk.Dec
+	// MaxFees caps the bumped fees in each denom, optional
+	MaxFees sdk.DecCoins
+	// MaxResubmits is the max number of the resubmissions of a tx, after which it's dropped, 3 by default
+	MaxResubmits int
+	// GapFill returns the msgs of a no-op tx of the signer, e.g. a tiny transfer to itself, which fills a sequence
+	// missing before the txs managed. The gaps are reported only if nil
+	GapFill func(signer sdk.AccAddress) []sdk.Msg
+}
+
+// ValidateBasic gives a quick validity check for the tx manager config
+func (c Config) ValidateBasic() error {
+	if c.StuckAfter < 0 || c.MaxResubmits < 0 {
+		return errors.New("failed. stuck after and max resubmits must not be negative")
+	}
+	if !c.FeeBump.IsNil() && c.FeeBump.IsNegative() {
+		return errors.New("failed. fee bump must not be negative")
+	}
+	return nil
+}
+
+// Status is the status of a tx managed
+type Status string
+
+// statuses of the txs managed
+const (
+	// StatusPending means the tx is waiting to be committed
+	StatusPending Status = "pending"
+	// StatusCommitted means the tx or one of its resubmissions is committed
+	StatusCommitted Status = "committed"
+	// StatusSuperseded means the sequence of the tx is taken by another tx never managed
+	StatusSuperseded Status = "superseded"
+	// StatusResubmitted means the tx was stuck and is resubmitted with the same sequence
+	StatusResubmitted Status = "resubmitted"
+	// StatusDropped means the tx is stuck after the max resubmissions, and isn't managed any more
+	StatusDropped Status = "dropped"
+	// StatusGap means the sequences before the tx are missing, which blocks it
+	StatusGap Status = "gap"
+	// StatusExpired means the tx isn't committed by its timeout height, and isn't resubmitted or managed any more
+	StatusExpired Status = "expired"
+)
+
+// Tx - structure of a tx managed
+type Tx struct {
+	Signer        sdk.Signer
+	Memo          string
+	Msgs          []sdk.Msg
+	AccountNumber uint64
+	Sequence      uint64
+	// Fee is the fee of the last submission
+	Fee sdk.StdFee
+	// Hashes are the hashes of all the submissions, the last one latest
+	Hashes []string
+	// TimeoutHeight is the last height to commit the tx, no timeout if 0
+	TimeoutHeight uint64
+	// BroadcastHeight is the latest height when the tx was submitted last
+	BroadcastHeight int64
+	// Resubmits is the number of the resubmissions accepted by the mempool
+	Resubmits int
+}
+
+// Hash returns the hash of the last submission
+func (tx Tx) Hash() string {
+	return tx.Hashes[len(tx.Hashes)-1]
+}
+
+// Event - structure of the result of checking a tx managed
+type Event struct {
+	Tx     Tx
+	Status Status
+	// CommittedHash is the hash of the submission committed with StatusCommitted
+	CommittedHash string
+	// Err is the failure of checking or resubmitting the tx, which is checked again next time
+	Err error
+}
+
+// Manager - structure of the manager of the txs broadcast
+type Manager struct {
+	mtx     sync.Mutex
+	tm      exposed.TendermintQuery
+	auth    exposed.AuthQuery
+	builder TxBuilder
+	config  Config
+	txs     map[string]*Tx
+}
+
+// NewManager creates a new instance of Manager
+func NewManager(tm exposed.TendermintQuery, auth exposed.AuthQuery, builder TxBuilder, config Config) (*Manager,
+	error) {
+	if err := config.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if config.StuckAfter == 0 {
+		config.StuckAfter = defaultStuckAfter
+	}
+	if config.MaxResubmits == 0 {
+		config.MaxResubmits = defaultMaxResubmits
+	}
+
+	return &Manager{
+		tm:      tm,
+		auth:    auth,
+		builder: builder,
+		config:  config,
+		txs:     make(map[string]*Tx),
+	}, nil
+}
+
+// Submit builds the tx signed by the signer and broadcasts it in sync mode, which is managed once accepted by the
+// mempool
+func (m *Manager) Submit(signer sdk.Signer, memo string, msgs []sdk.Msg, accNumber, seqNumber uint64,
+	opts ...sdk.TxOption) (tx Tx, resp sdk.TxResponse, err error) {
+	height, err := m.latestHeight()
+	if err != nil {
+		return
+	}
+
+	signedTx, err := m.builder.BuildSignedTxWithSigner(signer, memo, msgs, accNumber, seqNumber, opts...)
+	if err != nil {
+		return
+	}
+	if resp, err = m.broadcast(signedTx); err != nil {
+		return
+	}
+
+	tx = Tx{
+		Signer:          signer,
+		Memo:            memo,
+		Msgs:            msgs,
+		AccountNumber:   accNumber,
+		Sequence:        seqNumber,
+		Fee:             signedTx.StdTx.Fee,
+		Hashes:          []string{signedTx.Hash},
+		TimeoutHeight:   sdk.NewTxOptions(opts...).TimeoutHeight,
+		BroadcastHeight: height,
+	}
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	m.txs[txKey(sdk.GetSignerAddress(signer), seqNumber)] = &tx
+	return tx, resp, nil
+}
+
+// Pending returns the txs managed in the order of the signers and the sequences
+func (m *Manager) Pending() []Tx {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	txs := make([]Tx, 0, len(m.txs))
+	for _, tx := range m.txs {
+		txs = append(txs, *tx)
+	}
+	sortTxs(txs)
+	return txs
+}
+
+// Check checks all the txs managed, resubmits the stuck ones out of the mempool and fills the gaps before them, and
+// returns the events of the txs in the order of the signers and the sequences. The txs committed, superseded, dropped or
+// expired aren't managed any more
+func (m *Manager) Check() ([]Event, error) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	height, err := m.latestHeight()
+	if err != nil {
+		return nil, err
+	}
+	mempool, err := m.mempoolTxs()
+	if err != nil {
+		return nil, err
+	}
+
+	bySigner := make(map[string][]*Tx)
+	var signers []string
+	for _, tx := range m.txs {
+		signer := sdk.GetSignerAddress(tx.Signer).String()
+		if _, ok := bySigner[signer]; !ok {
+			signers = append(signers, signer)
+		}
+		bySigner[signer] = append(bySigner[signer], tx)
+	}
+	sort.Strings(signers)
+
+	var events []Event
+	for _, signer := range signers {
+		txs := bySigner[signer]
+		sort.Slice(txs, func(i, j int) bool { return txs[i].Sequence < txs[j].Sequence })
+		events = append(events, m.checkSigner(signer, txs, height, mempool)...)
+	}
+	return events, nil
+}
+
+// checkSigner checks the txs of a signer in the order of the sequences
+func (m *Manager) checkSigner(signer string, txs []*Tx, height int64, mempool map[string]bool) (events []Event) {
+	acc, err := m.auth.QueryAccount(signer)
+	if err != nil {
+		for _, tx := range txs {
+			events = append(events, Event{Tx: *tx, Status: StatusPending,
+				Err: fmt.Errorf("failed. query account %s error: %w", signer, err)})
+		}
+		return
+	}
+
+	accSeq := acc.GetSequence()
+	// the tx with the sequence of the account blocks the later ones, which are missing if it's not managed
+	var blocking bool
+	for _, tx := range txs {
+		blocking = blocking || tx.Sequence == accSeq
+	}
+
+	gapChecked := blocking
+	for _, tx := range txs {
+		event := Event{Tx: *tx, Status: StatusPending}
+		stuck := height-tx.BroadcastHeight >= m.config.StuckAfter
+		switch committedHash, found, err := m.findCommitted(tx); {
+		case err != nil:
+			event.Err = err
+		case found:
+			event.Status, event.CommittedHash = StatusCommitted, committedHash
+			m.untrack(tx)
+		case accSeq > tx.Sequence:
+			event.Status = StatusSuperseded
+			m.untrack(tx)
+		case accSeq < tx.Sequence:
+			// waiting for the earlier txs
+			if gapChecked || !stuck {
+				break
+			}
+			gapChecked = true
+			event.Status = StatusGap
+			if m.config.GapFill != nil {
+				event.Err = m.fillGap(tx, accSeq, height)
+			}
+		case inMempool(tx, mempool):
+			// still waiting to be committed, never evicted
+		case tx.TimeoutHeight > 0 && uint64(height) >= tx.TimeoutHeight:
+			// never committed by the timeout height, which refuses the resubmission
+			event.Status = StatusExpired
+			m.untrack(tx)
+		case !stuck:
+		case tx.Resubmits >= m.config.MaxResubmits:
+			event.Status = StatusDropped
+			m.untrack(tx)
+		default:
+			if event.Err = m.resubmit(tx, height); event.Err == nil {
+				event.Status = StatusResubmitted
+			}
+			event.Tx = *tx
+		}
+		events = append(events, event)
+	}
+	return
+}
+
+// findCommitted looks for the submission of the tx committed
+func (m *Manager) findCommitted(tx *Tx) (string, bool, error) {
+	for _, hash := range tx.Hashes {
+		hashBytes, err := hex.DecodeString(hash)
+		if err != nil {
+			return "", false, fmt.Errorf("failed. invalid tx hash %s: %w", hash, err)
+		}
+		if _, err = m.tm.QueryTxResult(hashBytes, false); err == nil {
+			return hash, true, nil
+		} else if !strings.Contains(err.Error(), "not found") {
+			return "", false, fmt.Errorf("failed. query tx %s error: %w", hash, err)
+		}
+	}
+	return "", false, nil
+}
+
+// resubmit broadcasts the stuck tx again with the same sequence and the fees bumped
+func (m *Manager) resubmit(tx *Tx, height int64) error {
+	fee := sdk.NewStdFee(tx.Fee.Gas, m.bumpFees(tx.Fee.Amount))
+	fee.Payer, fee.Granter = tx.Fee.Payer, tx.Fee.Granter
+	signedTx, err := m.builder.BuildSignedTxWithSigner(tx.Signer, tx.Memo, tx.Msgs, tx.AccountNumber, tx.Sequence,
+		sdk.WithFee(fee), sdk.WithSequenceReuse(), sdk.WithTimeoutHeight(tx.TimeoutHeight))
+	if err != nil {
+		return fmt.Errorf("failed. rebuild stuck tx %s error: %w", tx.Hash(), err)
+	}
+	// the failed resubmission is tried again on the next check, which isn't counted
+	if _, err = m.broadcast(signedTx); err != nil {
+		return fmt.Errorf("failed. resubmit stuck tx %s error: %w", tx.Hash(), err)
+	}
+
+	tx.Resubmits++
+	tx.BroadcastHeight = height
+	tx.Fee = signedTx.StdTx.Fee
+	if signedTx.Hash != tx.Hash() {
+		tx.Hashes = append(tx.Hashes, signedTx.Hash)
+	}
+	return nil
+}
+
+// mempoolTxs returns the hashes of the txs in the mempool of the node, nil if the tx builder doesn't serve the mempool.
+// Only the first txs of a large mempool are returned, and the stuck txs missing from them are resubmitted, which is
+// rejected by the mempool holding them already
+func (m *Manager) mempoolTxs() (map[string]bool, error) {
+	mempool, ok := m.builder.(Mempool)
+	if !ok {
+		return nil, nil
+	}
+
+	res, err := mempool.UnconfirmedTxs(mempoolQueryLimit)
+	if err != nil {
+		return nil, fmt.Errorf("failed. query mempool error: %w", err)
+	}
+	hashes := make(map[string]bool, len(res.Txs))
+	for _, tx := range res.Txs {
+		hashes[fmt.Sprintf("%X", tx.Hash())] = true
+	}
+	return hashes, nil
+}
+
+// inMempool tells whether any submission of the tx is in the mempool
+func inMempool(tx *Tx, mempool map[string]bool) bool {
+	for _, hash := range tx.Hashes {
+		if mempool[strings.ToUpper(hash)] {
+			return true
+		}
+	}
+	return false
+}
+
+// bumpFees multiplies the fees by the fee bump, capped by the max fees
+func (m *Manager) bumpFees(fees sdk.DecCoins) sdk.DecCoins {
+	if m.config.FeeBump.IsNil() || m.config.FeeBump.LTE(sdk.OneDec()) {
+		return fees
+	}
+
+	bumped := fees.MulDec(m.config.FeeBump)
+	if len(m.config.MaxFees) == 0 {
+		return bumped
+	}
+	for i, fee := range bumped {
+		// never lower than the fees before
+		if maxFee := m.config.MaxFees.AmountOf(fee.Denom); fee.Amount.GT(maxFee) {
+			bumped[i].Amount = maxFee
+			if before := fees.AmountOf(fee.Denom); before.GT(maxFee) {
+				bumped[i].Amount = before
+			}
+		}
+	}
+	return bumped
+}
+
+// fillGap broadcasts the no-op txs with the sequences missing before the tx, which are managed as well
+func (m *Manager) fillGap(tx *Tx, from uint64, height int64) error {
+	signer := sdk.GetSignerAddress(tx.Signer)
+	for seq := from; seq < tx.Sequence; seq++ {
+		key := txKey(signer, seq)
+		if _, ok := m.txs[key]; ok {
+			continue
+		}
+
+		msgs := m.config.GapFill(signer)
+		signedTx, err := m.builder.BuildSignedTxWithSigner(tx.Signer, "", msgs, tx.AccountNumber, seq,
+			sdk.WithSequenceReuse())
+		if err != nil {
+			return fmt.Errorf("failed. build gap filler of sequence %d error: %w", seq, err)
+		}
+		if _, err = m.broadcast(signedTx); err != nil {
+			return fmt.Errorf("failed. broadcast gap filler of sequence %d error: %w", seq, err)
+		}
+		m.txs[key] = &Tx{
+			Signer:          tx.Signer,
+			Msgs:            msgs,
+			AccountNumber:   tx.AccountNumber,
+			Sequence:        seq,
+			Fee:             signedTx.StdTx.Fee,
+			Hashes:          []string{signedTx.Hash},
+			BroadcastHeight: height,
+		}
+	}
+	// the blocked tx waits for the gap filled
+	tx.BroadcastHeight = height
+	return nil
+}
+
+// Run checks the txs managed by the interval until the ctx is done, and sends the events except the pending ones to
+// the channel returned. The channel is closed when the ctx is done
+func (m *Manager) Run(ctx context.Context, interval time.Duration) <-chan Event {
+	events := make(chan Event, 1)
+	go func() {
+		defer close(events)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			checked, err := m.Check()
+			if err != nil {
+				// checked again on the next tick
+				continue
+			}
+			for _, event := range checked {
+				if event.Status == StatusPending && event.Err == nil {
+					continue
+				}
+				select {
+				case events <- event:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return events
+}
+
+// broadcast broadcasts the signed tx in sync mode, which fails if the tx isn't accepted by the mempool
+func (m *Manager) broadcast(signedTx sdk.SignedTx) (resp sdk.TxResponse, err error) {
+	if resp, err = m.builder.Broadcast(signedTx.Bytes, sdk.BroadcastSync); err != nil {
+		return
+	}
+	if resp.Code != 0 {
+		return resp, fmt.Errorf("failed. tx %s rejected with code %d: %s", signedTx.Hash, resp.Code, resp.RawLog)
+	}
+	return
+}
+
+func (m *Manager) latestHeight() (int64, error) {
+	commit, err := m.tm.QueryLatestCommitResult()
+	if err != nil {
+		return 0, fmt.Errorf("failed. query latest height error: %w", err)
+	}
+	return commit.Height, nil
+}
+
+func (m *Manager) untrack(tx *Tx) {
+	delete(m.txs, txKey(sdk.GetSignerAddress(tx.Signer), tx.Sequence))
+}
+
+func txKey(signer sdk.AccAddress, sequence uint64) string {
+	return fmt.Sprintf("%s/%d", signer, sequence)
+}
+
+func sortTxs(txs []Tx) {
+	sort.Slice(txs, func(i, j int) bool {
+		si, sj := sdk.GetSignerAddress(txs[i].Signer).String(), sdk.GetSignerAddress(txs[j].Signer).String()
+		if si != sj {
+			return si < sj
+		}
+		return txs[i].Sequence < txs[j].Sequence
+	})
+}
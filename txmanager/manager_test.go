@@ -0,0 +1,306 @@
+package txmanager
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/okex/okchain-go-sdk/exposed"
+	authtypes "github.com/okex/okchain-go-sdk/module/auth/types"
+	tmtypes "github.com/okex/okchain-go-sdk/module/tendermint/types"
+	tokentypes "github.com/okex/okchain-go-sdk/module/token/types"
+	"github.com/okex/okchain-go-sdk/testutil"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	"github.com/stretchr/testify/require"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	"github.com/tendermint/tendermint/types"
+)
+
+// fakeChain commits the txs on demand and records the txs broadcast
+type fakeChain struct {
+	// the calls out of the manager are never made
+	exposed.TendermintQuery
+	exposed.AuthQuery
+	height    int64
+	account   authtypes.BaseAccount
+	committed map[string]bool
+	broadcast []sdk.StdTx
+	reuses    int
+	mempool   []types.Tx
+	rejecting bool
+}
+
+func (fc *fakeChain) QueryLatestCommitResult() (tmtypes.ResultCommit, error) {
+	var commit tmtypes.ResultCommit
+	commit.Height = fc.height
+	return commit, nil
+}
+
+func (fc *fakeChain) QueryTxResult(txHash []byte, _ bool) (tmtypes.ResultTx, error) {
+	if !fc.committed[fmt.Sprintf("%X", txHash)] {
+		return tmtypes.ResultTx{}, fmt.Errorf("tx (%X) not found", txHash)
+	}
+	return tmtypes.ResultTx{Hash: txHash, Height: fc.height}, nil
+}
+
+func (fc *fakeChain) QueryAccount(string) (authtypes.Account, error) {
+	return &fc.account, nil
+}
+
+func (fc *fakeChain) BuildSignedTxWithSigner(_ sdk.Signer, memo string, msgs []sdk.Msg, _, seqNumber uint64,
+	opts ...sdk.TxOption) (sdk.SignedTx, error) {
+	options := sdk.NewTxOptions(opts...)
+	if options.AllowSequenceReuse {
+		fc.reuses++
+	}
+	fee := sdk.NewStdFee(200000, sdk.NewDecCoins(sdk.NewDecCoinFromDec("okt", sdk.MustNewDecFromStr("0.01"))))
+	if options.Fee != nil {
+		fee = *options.Fee
+	}
+	stdTx := sdk.NewStdTx(msgs, fee, nil, memo)
+	txBytes := []byte(fmt.Sprintf("%d/%s/%s", seqNumber, memo, fee.Amount))
+	return sdk.SignedTx{StdTx: stdTx, Bytes: txBytes, Hash: fmt.Sprintf("%X", sha256.Sum256(txBytes))}, nil
+}
+
+func (fc *fakeChain) Broadcast([]byte, sdk.BroadcastMode) (sdk.TxResponse, error) {
+	if fc.rejecting {
+		return sdk.TxResponse{Code: 4, RawLog: "mempool is full"}, nil
+	}
+	return sdk.TxResponse{}, nil
+}
+
+func (fc *fakeChain) UnconfirmedTxs(limit int) (*ctypes.ResultUnconfirmedTxs, error) {
+	return &ctypes.ResultUnconfirmedTxs{Count: len(fc.mempool), Total: len(fc.mempool), Txs: fc.mempool}, nil
+}
+
+func newFakeChain(t *testing.T) (*fakeChain, testutil.Account, []sdk.Msg) {
+	user := testutil.NewAccounts(t, testutil.FixtureAccountsSeed, 1)[0]
+	coins, err := sdk.ParseDecCoins("10.24okt")
+	require.NoError(t, err)
+	fc := &fakeChain{
+		height:    100,
+		account:   user.BaseAccount(5),
+		committed: make(map[string]bool),
+	}
+	return fc, user, []sdk.Msg{tokentypes.NewMsgTokenSend(user.Address, user.Address, coins)}
+}
+
+func TestManager_Resubmit(t *testing.T) {
+	fc, user, msgs := newFakeChain(t)
+	m, err := NewManager(fc, fc, fc, Config{
+		StuckAfter:   5,
+		FeeBump:      sdk.MustNewDecFromStr("1.5"),
+		MaxFees:      sdk.NewDecCoins(sdk.NewDecCoinFromDec("okt", sdk.MustNewDecFromStr("0.02"))),
+		MaxResubmits: 2,
+	})
+	require.NoError(t, err)
+
+	tx, _, err := m.Submit(user.Signer(), "my memo", msgs, 1, 5)
+	require.NoError(t, err)
+	require.Equal(t, int64(100), tx.BroadcastHeight)
+	require.Len(t, m.Pending(), 1)
+
+	// not stuck yet
+	fc.height = 104
+	events, err := m.Check()
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, StatusPending, events[0].Status)
+
+	// resubmitted with the fees bumped
+	fc.height = 105
+	events, err = m.Check()
+	require.NoError(t, err)
+	require.Equal(t, StatusResubmitted, events[0].Status)
+	require.Equal(t, "0.01500000okt", events[0].Tx.Fee.Amount.String())
+	require.Equal(t, uint64(5), events[0].Tx.Sequence)
+	require.Len(t, events[0].Tx.Hashes, 2)
+	require.Equal(t, 1, fc.reuses)
+
+	// capped by the max fees
+	fc.height = 110
+	events, err = m.Check()
+	require.NoError(t, err)
+	require.Equal(t, StatusResubmitted, events[0].Status)
+	require.Equal(t, "0.02000000okt", events[0].Tx.Fee.Amount.String())
+
+	// the first submission committed at last
+	fc.committed[tx.Hash()] = true
+	events, err = m.Check()
+	require.NoError(t, err)
+	require.Equal(t, StatusCommitted, events[0].Status)
+	require.Equal(t, tx.Hash(), events[0].CommittedHash)
+	require.Empty(t, m.Pending())
+}
+
+func TestManager_Dropped(t *testing.T) {
+	fc, user, msgs := newFakeChain(t)
+	m, err := NewManager(fc, fc, fc, Config{MaxResubmits: 1})
+	require.NoError(t, err)
+
+	tx, _, err := m.Submit(user.Signer(), "my memo", msgs, 1, 5)
+	require.NoError(t, err)
+
+	fc.height += defaultStuckAfter
+	events, err := m.Check()
+	require.NoError(t, err)
+	require.Equal(t, StatusResubmitted, events[0].Status)
+	// the same tx without any fee bump
+	require.Equal(t, []string{tx.Hash()}, events[0].Tx.Hashes)
+
+	fc.height += defaultStuckAfter
+	events, err = m.Check()
+	require.NoError(t, err)
+	require.Equal(t, StatusDropped, events[0].Status)
+	require.Empty(t, m.Pending())
+}
+
+func TestManager_Superseded(t *testing.T) {
+	fc, user, msgs := newFakeChain(t)
+	m, err := NewManager(fc, fc, fc, Config{})
+	require.NoError(t, err)
+
+	_, _, err = m.Submit(user.Signer(), "my memo", msgs, 1, 5)
+	require.NoError(t, err)
+	_, _, err = m.Submit(user.Signer(), "my memo", msgs, 1, 6)
+	require.NoError(t, err)
+
+	// the sequence 5 taken by another tx
+	fc.account.Sequence = 6
+	events, err := m.Check()
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	require.Equal(t, StatusSuperseded, events[0].Status)
+	require.Equal(t, StatusPending, events[1].Status)
+	require.Len(t, m.Pending(), 1)
+}
+
+func TestManager_Gap(t *testing.T) {
+	fc, user, msgs := newFakeChain(t)
+	m, err := NewManager(fc, fc, fc, Config{})
+	require.NoError(t, err)
+
+	// the sequences 5 and 6 missing
+	_, _, err = m.Submit(user.Signer(), "my memo", msgs, 1, 7)
+	require.NoError(t, err)
+	_, _, err = m.Submit(user.Signer(), "my memo", msgs, 1, 8)
+	require.NoError(t, err)
+
+	fc.height += defaultStuckAfter
+	events, err := m.Check()
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	require.Equal(t, StatusGap, events[0].Status)
+	require.Equal(t, uint64(7), events[0].Tx.Sequence)
+	// blocked by the gap rather than stuck
+	require.Equal(t, StatusPending, events[1].Status)
+	require.Len(t, m.Pending(), 2)
+
+	// filled by the no-op txs
+	m.config.GapFill = func(signer sdk.AccAddress) []sdk.Msg {
+		return msgs
+	}
+	events, err = m.Check()
+	require.NoError(t, err)
+	require.Equal(t, StatusGap, events[0].Status)
+	require.NoError(t, events[0].Err)
+	pending := m.Pending()
+	require.Len(t, pending, 4)
+	require.Equal(t, uint64(5), pending[0].Sequence)
+	require.Equal(t, uint64(6), pending[1].Sequence)
+
+	// the fillers are resubmitted rather than refilled once stuck
+	fc.height += defaultStuckAfter
+	events, err = m.Check()
+	require.NoError(t, err)
+	require.Len(t, events, 4)
+	require.Equal(t, StatusResubmitted, events[0].Status)
+	require.Equal(t, StatusPending, events[1].Status)
+	require.Len(t, m.Pending(), 4)
+}
+
+func TestManager_Mempool(t *testing.T) {
+	fc, user, msgs := newFakeChain(t)
+	m, err := NewManager(fc, fc, fc, Config{MaxResubmits: 1})
+	require.NoError(t, err)
+
+	_, _, err = m.Submit(user.Signer(), "my memo", msgs, 1, 5)
+	require.NoError(t, err)
+	signedTx, err := fc.BuildSignedTxWithSigner(user.Signer(), "my memo", msgs, 1, 5)
+	require.NoError(t, err)
+
+	// still in the mempool rather than stuck
+	fc.mempool = []types.Tx{signedTx.Bytes}
+	fc.height += defaultStuckAfter
+	events, err := m.Check()
+	require.NoError(t, err)
+	require.Equal(t, StatusPending, events[0].Status)
+	require.Zero(t, fc.reuses)
+
+	// the resubmission rejected isn't counted
+	fc.mempool, fc.rejecting = nil, true
+	events, err = m.Check()
+	require.NoError(t, err)
+	require.Equal(t, StatusPending, events[0].Status)
+	require.Error(t, events[0].Err)
+	require.Zero(t, events[0].Tx.Resubmits)
+
+	fc.rejecting = false
+	events, err = m.Check()
+	require.NoError(t, err)
+	require.Equal(t, StatusResubmitted, events[0].Status)
+	require.Equal(t, 1, events[0].Tx.Resubmits)
+}
+
+func TestManager_Expired(t *testing.T) {
+	fc, user, msgs := newFakeChain(t)
+	m, err := NewManager(fc, fc, fc, Config{})
+	require.NoError(t, err)
+
+	tx, _, err := m.Submit(user.Signer(), "my memo", msgs, 1, 5, sdk.WithTimeoutHeight(115))
+	require.NoError(t, err)
+	require.Equal(t, uint64(115), tx.TimeoutHeight)
+
+	// resubmitted before the timeout height
+	fc.height += defaultStuckAfter
+	events, err := m.Check()
+	require.NoError(t, err)
+	require.Equal(t, StatusResubmitted, events[0].Status)
+
+	fc.height = 115
+	events, err = m.Check()
+	require.NoError(t, err)
+	require.Equal(t, StatusExpired, events[0].Status)
+	require.Empty(t, m.Pending())
+}
+
+// failingChain fails the queries of the txs
+type failingChain struct {
+	*fakeChain
+}
+
+func (failingChain) QueryTxResult([]byte, bool) (tmtypes.ResultTx, error) {
+	return tmtypes.ResultTx{}, errors.New("connection refused")
+}
+
+func TestManager_Errors(t *testing.T) {
+	_, err := NewManager(nil, nil, nil, Config{StuckAfter: -1})
+	require.Error(t, err)
+	_, err = NewManager(nil, nil, nil, Config{FeeBump: sdk.MustNewDecFromStr("-1")})
+	require.Error(t, err)
+
+	fc, user, msgs := newFakeChain(t)
+	m, err := NewManager(failingChain{fc}, fc, fc, Config{})
+	require.NoError(t, err)
+	_, _, err = m.Submit(user.Signer(), "my memo", msgs, 1, 5)
+	require.NoError(t, err)
+
+	// kept to check again
+	events, err := m.Check()
+	require.NoError(t, err)
+	require.Error(t, events[0].Err)
+	require.True(t, strings.Contains(events[0].Err.Error(), "connection refused"))
+	require.Len(t, m.Pending(), 1)
+}
@@ -81,8 +81,12 @@ func AccAddressFromBech32(address string) (addr AccAddress, err error) {
 	return AccAddress(bz), nil
 }
 
-// Equals returns boolean for whether two AccAddresses are Equal
+// Equals returns boolean for whether two AccAddresses are Equal, which are never equal to the other types of addresses
+// even with the same bytes
 func (aa AccAddress) Equals(aa2 Address) bool {
+	if _, ok := aa2.(AccAddress); !ok {
+		return false
+	}
 	if aa.Empty() && aa2.Empty() {
 		return true
 	}
@@ -172,6 +176,12 @@ func (aa AccAddress) Format(s fmt.State, verb rune) {
 	}
 }
 
+// ToValAddress converts the account address into the validator operator address of the account with the same bytes,
+// which the validator created by the account is addressed by
+func (aa AccAddress) ToValAddress() ValAddress {
+	return ValAddress(aa)
+}
+
 // MustBech32ifyAccPub returns the result of Bech32ifyAccPub panicing on failure.
 func MustBech32ifyAccPub(pub crypto.PubKey) string {
 	enc, err := Bech32ifyAccPub(pub)
@@ -236,8 +246,12 @@ func Bech32ifyAccPub(pub crypto.PubKey) (string, error) {
 // operator. When marshaled to a string or JSON, it uses Bech32.
 type ValAddress []byte
 
-// Equals returns boolean for whether two ValAddresses are Equal
+// Equals returns boolean for whether two ValAddresses are Equal, which are never equal to the other types of addresses
+// even with the same bytes
 func (va ValAddress) Equals(va2 Address) bool {
+	if _, ok := va2.(ValAddress); !ok {
+		return false
+	}
 	if va.Empty() && va2.Empty() {
 		return true
 	}
@@ -331,6 +345,11 @@ func (va ValAddress) Format(s fmt.State, verb rune) {
 	}
 }
 
+// ToAccAddress converts the validator operator address into the account address of the operator with the same bytes
+func (va ValAddress) ToAccAddress() AccAddress {
+	return AccAddress(va)
+}
+
 // ValAddressFromBech32 creates a ValAddress from a Bech32 string.
 func ValAddressFromBech32(address string) (addr ValAddress, err error) {
 	if len(strings.TrimSpace(address)) == 0 {
@@ -373,8 +392,12 @@ func VerifyAddressFormat(bz []byte) error {
 // When marshaled to a string or JSON, it uses Bech32.
 type ConsAddress []byte
 
-// Equals returns boolean for whether two ConsAddress are Equal
+// Equals returns boolean for whether two ConsAddress are Equal, which are never equal to the other types of addresses
+// even with the same bytes
 func (ca ConsAddress) Equals(ca2 Address) bool {
+	if _, ok := ca2.(ConsAddress); !ok {
+		return false
+	}
 	if ca.Empty() && ca2.Empty() {
 		return true
 	}
@@ -466,6 +489,12 @@ func (ca ConsAddress) Format(s fmt.State, verb rune) {
 	}
 }
 
+// GetConsAddress derives the consensus address of a validator from its consensus public key, which never shares the
+// bytes with the account or the operator address of the validator
+func GetConsAddress(pubKey crypto.PubKey) ConsAddress {
+	return ConsAddress(pubKey.Address())
+}
+
 // ConsAddressFromBech32 creates a ConsAddress from a Bech32 string
 func ConsAddressFromBech32(address string) (addr ConsAddress, err error) {
 	if len(strings.TrimSpace(address)) == 0 {
@@ -0,0 +1,114 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// maxAmountPrecision is the max precision of the integers in the base units of the denoms, e.g. 18 of the wei
+const maxAmountPrecision = 36
+
+// Amount - structure of the amount of coins given to the tx methods, e.g. the transfers, the delegations and the dex
+// deposits, which is constructed from a coins string, a Dec with the denom or an integer in the base unit of the denom
+// with its precision. The coins of an amount constructed are always valid and positive, and the zero value is empty
+type Amount struct {
+	coins DecCoins
+}
+
+// ParseAmount creates an amount from a string of the comma separated coins, e.g. "10.5okt,1btc-000"
+func ParseAmount(coinsStr string) (Amount, error) {
+	coins, err := ParseDecCoinsStrict(coinsStr)
+	if err != nil {
+		return Amount{}, err
+	}
+	return Amount{coins}, nil
+}
+
+// MustParseAmount creates an amount from a string of the comma separated coins, and panics if it's invalid
+func MustParseAmount(coinsStr string) Amount {
+	amount, err := ParseAmount(coinsStr)
+	if err != nil {
+		panic(err)
+	}
+	return amount
+}
+
+// NewAmountFromDec creates an amount of a coin from the Dec with the denom
+func NewAmountFromDec(denom string, amount Dec) (Amount, error) {
+	if err := validateDenom(denom); err != nil {
+		return Amount{}, err
+	}
+	if amount.IsNil() {
+		return Amount{}, errors.New("nil decimal amount")
+	}
+	if !amount.IsPositive() {
+		return Amount{}, fmt.Errorf("amount %s%s is not positive", amount, denom)
+	}
+	return Amount{DecCoins{DecCoin{Denom: denom, Amount: amount}}}, nil
+}
+
+// NewAmountFromInt creates an amount of a coin from the integer in the base unit of the denom with the precision, e.g.
+// 1500000000 with the precision 9 for 1.5, which is refused if it's finer than the precision of Dec
+func NewAmountFromInt(denom string, amount *big.Int, precision int) (Amount, error) {
+	if amount == nil {
+		return Amount{}, errors.New("nil integer amount")
+	}
+	if precision < 0 || precision > maxAmountPrecision {
+		return Amount{}, fmt.Errorf("precision %d out of range [0, %d]", precision, maxAmountPrecision)
+	}
+
+	if precision <= Precision {
+		return NewAmountFromDec(denom, NewDecFromBigIntWithPrec(amount, int64(precision)))
+	}
+	divisor := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(precision-Precision)), nil)
+	quo, rem := new(big.Int).QuoRem(amount, divisor, new(big.Int))
+	if rem.Sign() != 0 {
+		return Amount{}, fmt.Errorf("amount %s with precision %d is finer than the precision %d of Dec", amount,
+			precision, Precision)
+	}
+	return NewAmountFromDec(denom, NewDecFromBigIntWithPrec(quo, Precision))
+}
+
+// NewAmountFromCoins creates an amount from the coins, which must be positive with the distinct denoms
+func NewAmountFromCoins(coins ...DecCoin) (Amount, error) {
+	sorted := append(DecCoins(nil), coins...).Sort()
+	if len(sorted) == 0 {
+		return Amount{}, errors.New("empty coins")
+	}
+	if err := sorted.Validate(); err != nil {
+		return Amount{}, err
+	}
+	return Amount{sorted}, nil
+}
+
+// IsEmpty tells whether the amount has no coin, i.e. the zero value
+func (a Amount) IsEmpty() bool {
+	return len(a.coins) == 0
+}
+
+// Validate checks the amount isn't empty, which is the only invalid amount by the constructors
+func (a Amount) Validate() error {
+	if a.IsEmpty() {
+		return errors.New("empty amount")
+	}
+	return nil
+}
+
+// Coins returns a copy of the coins of the amount sorted by the denoms
+func (a Amount) Coins() DecCoins {
+	return append(DecCoins(nil), a.coins...)
+}
+
+// Coin returns the only coin of the amount, which fails if the amount has none or multiple coins
+func (a Amount) Coin() (DecCoin, error) {
+	if len(a.coins) != 1 {
+		return DecCoin{}, fmt.Errorf("amount %q isn't of exactly one coin", a)
+	}
+	return a.coins[0], nil
+}
+
+// String returns the amount as the comma separated coins, which is parsed back by ParseAmount
+func (a Amount) String() string {
+	return a.coins.String()
+}
@@ -0,0 +1,79 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAmount(t *testing.T) {
+	amount, err := ParseAmount("10.5okt,1btc-000")
+	require.NoError(t, err)
+	require.Equal(t, "1.00000000btc-000,10.50000000okt", amount.String())
+	require.NoError(t, amount.Validate())
+	_, err = amount.Coin()
+	require.Error(t, err)
+
+	for _, coinsStr := range []string{"", "10", "-1okt", "0okt", "1okt,2okt"} {
+		_, err = ParseAmount(coinsStr)
+		require.Error(t, err, coinsStr)
+	}
+	require.Panics(t, func() { MustParseAmount("10") })
+
+	require.True(t, Amount{}.IsEmpty())
+	require.Error(t, Amount{}.Validate())
+}
+
+func TestNewAmountFromDec(t *testing.T) {
+	amount, err := NewAmountFromDec("okt", NewDecWithPrec(15, 1))
+	require.NoError(t, err)
+	coin, err := amount.Coin()
+	require.NoError(t, err)
+	require.Equal(t, NewDecCoinFromDec("okt", NewDecWithPrec(15, 1)), coin)
+
+	_, err = NewAmountFromDec("okt", ZeroDec())
+	require.Error(t, err)
+	_, err = NewAmountFromDec("okt", Dec{})
+	require.Error(t, err)
+	_, err = NewAmountFromDec("OKT", OneDec())
+	require.Error(t, err)
+}
+
+func TestNewAmountFromInt(t *testing.T) {
+	amount, err := NewAmountFromInt("okt", big.NewInt(1500000000), 9)
+	require.NoError(t, err)
+	require.Equal(t, "1.50000000okt", amount.String())
+
+	wei, ok := new(big.Int).SetString("2500000000000000000", 10)
+	require.True(t, ok)
+	amount, err = NewAmountFromInt("okt", wei, 18)
+	require.NoError(t, err)
+	require.Equal(t, "2.50000000okt", amount.String())
+
+	amount, err = NewAmountFromInt("okt", big.NewInt(3), 0)
+	require.NoError(t, err)
+	require.Equal(t, "3.00000000okt", amount.String())
+
+	_, err = NewAmountFromInt("okt", big.NewInt(1500000001), 9)
+	require.Error(t, err)
+	_, err = NewAmountFromInt("okt", big.NewInt(1), -1)
+	require.Error(t, err)
+	_, err = NewAmountFromInt("okt", nil, 8)
+	require.Error(t, err)
+}
+
+func TestNewAmountFromCoins(t *testing.T) {
+	amount, err := NewAmountFromCoins(NewDecCoinFromDec("okt", OneDec()), NewDecCoinFromDec("btc", OneDec()))
+	require.NoError(t, err)
+	require.Equal(t, "1.00000000btc,1.00000000okt", amount.String())
+
+	coins := amount.Coins()
+	coins[0].Amount = ZeroDec()
+	require.Equal(t, "1.00000000btc,1.00000000okt", amount.String())
+
+	_, err = NewAmountFromCoins()
+	require.Error(t, err)
+	_, err = NewAmountFromCoins(NewDecCoinFromDec("okt", OneDec()), NewDecCoinFromDec("okt", OneDec()))
+	require.Error(t, err)
+}
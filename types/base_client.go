@@ -0,0 +1,85 @@
+//go:build !js
+// +build !js
+
+package types
+
+import (
+	"context"
+
+	cmn "github.com/tendermint/tendermint/libs/common"
+	rpc "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+)
+
+// BaseClient shows the expected behavior for a base client
+type BaseClient interface {
+	ClientQuery
+	ClientTx
+	ClientSubscription
+	TxHandler
+	SimulationHandler
+	GetCodec() SDKCodec
+	GetConfig() ClientConfig
+	GetClientContext() ClientContext
+	WithConsistency(consistency Consistency) (BaseClient, error)
+	WithProofVerification(verifier HeaderVerifier) BaseClient
+	WithResponseRecorder(recorder ResponseRecorder) BaseClient
+	WithQueryCache(cache *QueryCache) BaseClient
+	WithChainID(chainID string) BaseClient
+	WithDryRun() BaseClient
+	IsDryRun() bool
+	QueryHeight() int64
+}
+
+// TxHandler shows the expected behavior to handle tx
+type TxHandler interface {
+	BuildAndBroadcast(fromName, passphrase, memo string, msgs []Msg, accNumber, seqNumber uint64, opts ...TxOption) (
+		TxResponse, error)
+	BuildStdTx(fromName, passphrase, memo string, msgs []Msg, accNumber, seqNumber uint64, opts ...TxOption) (StdTx,
+		error)
+	BuildSignedTx(fromName, passphrase, memo string, msgs []Msg, accNumber, seqNumber uint64, opts ...TxOption) (
+		SignedTx, error)
+	BuildUnsignedStdTxOffline(msgs []Msg, memo string) StdTx
+	BuildAndBroadcastWithSigner(signer Signer, memo string, msgs []Msg, accNumber, seqNumber uint64,
+		opts ...TxOption) (TxResponse, error)
+	BuildStdTxWithSigner(signer Signer, memo string, msgs []Msg, accNumber, seqNumber uint64, opts ...TxOption) (StdTx,
+		error)
+	BuildSignedTxWithSigner(signer Signer, memo string, msgs []Msg, accNumber, seqNumber uint64, opts ...TxOption) (
+		SignedTx, error)
+	BuildSignDoc(signer Signer, memo string, msgs []Msg, accNumber, seqNumber uint64, opts ...TxOption) (SignDoc,
+		error)
+}
+
+// SimulationHandler shows the expected behavior to handle simulation
+type SimulationHandler interface {
+	CalculateGas(txBytes []byte) (StdFee, error)
+	BuildTxForSim(msgs []Msg, memo string, accNumber, seqNumber uint64) ([]byte, error)
+}
+
+// ClientQuery shows the expected query behavior
+type ClientQuery interface {
+	rpc.SignClient
+	Query(path string, key cmn.HexBytes) ([]byte, error)
+	QueryStore(key cmn.HexBytes, storeName, endPath string) ([]byte, error)
+	QuerySubspace(subspace []byte, storeName string) ([]cmn.KVPair, error)
+	QueryStoreWithProof(key cmn.HexBytes, storeName, endPath string, height int64) (StoreQueryResult, error)
+	Status() (*ctypes.ResultStatus, error)
+	UnconfirmedTxs(limit int) (*ctypes.ResultUnconfirmedTxs, error)
+}
+
+// ClientTx shows the expected tx behavior
+type ClientTx interface {
+	Broadcast(txBytes []byte, broadcastMode BroadcastMode) (res TxResponse, err error)
+	BroadcastRawTx(txBytes []byte, broadcastMode BroadcastMode) (TxResponse, error)
+}
+
+// ClientSubscription shows the expected behavior to subscribe the events of the node
+type ClientSubscription interface {
+	Subscribe(ctx context.Context, subscriber, query string) (<-chan ctypes.ResultEvent, error)
+}
+
+// RPCClient shows the expected behavior for a inner exposed client
+type RPCClient interface {
+	rpc.ABCIClient
+	rpc.SignClient
+}
@@ -0,0 +1,38 @@
+package types
+
+// ModuleCapability - structure of the availability of a module registered in the client on the node
+type ModuleCapability struct {
+	Module    string
+	Available bool
+	// Reason is the log of the node telling the module is unavailable
+	Reason string
+}
+
+// Capabilities - structure of the availability of the modules registered in the client on the node, which is detected
+// when the client connects, so that the applications adapt their UI to the node build, e.g. hiding the dex pages on a
+// node without the dex module
+type Capabilities struct {
+	// Detected tells whether the modules are probed on the node. All the modules are assumed available otherwise
+	Detected bool
+	Modules  []ModuleCapability
+}
+
+// IsAvailable tells whether the module is available on the node, which is false for a module not registered
+func (c Capabilities) IsAvailable(moduleName string) bool {
+	for _, module := range c.Modules {
+		if module.Module == moduleName {
+			return module.Available
+		}
+	}
+	return false
+}
+
+// Unavailable returns the names of the modules registered but unavailable on the node
+func (c Capabilities) Unavailable() (moduleNames []string) {
+	for _, module := range c.Modules {
+		if !module.Available {
+			moduleNames = append(moduleNames, module.Module)
+		}
+	}
+	return
+}
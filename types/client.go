@@ -2,51 +2,20 @@ package types
 
 import (
 	"errors"
-	cmn "github.com/tendermint/tendermint/libs/common"
-	rpc "github.com/tendermint/tendermint/rpc/client"
+	"time"
 )
 
-// BaseClient shows the expected behavior for a base client
-type BaseClient interface {
-	ClientQuery
-	ClientTx
-	TxHandler
-	SimulationHandler
-	GetCodec() SDKCodec
-	GetConfig() ClientConfig
-}
-
-// TxHandler shows the expected behavior to handle tx
-type TxHandler interface {
-	BuildAndBroadcast(fromName, passphrase, memo string, msgs []Msg, accNumber, seqNumber uint64) (TxResponse, error)
-	BuildStdTx(fromName, passphrase, memo string, msgs []Msg, accNumber, seqNumber uint64) (StdTx, error)
-	BuildUnsignedStdTxOffline(msgs []Msg, memo string) StdTx
-}
+// Transport defines the transport of the ABCI queries to the node
+type Transport string
 
-// SimulationHandler shows the expected behavior to handle simulation
-type SimulationHandler interface {
-	CalculateGas(txBytes []byte) (StdFee, error)
-	BuildTxForSim(msgs []Msg, memo string, accNumber, seqNumber uint64) ([]byte, error)
-}
-
-// ClientQuery shows the expected query behavior
-type ClientQuery interface {
-	rpc.SignClient
-	Query(path string, key cmn.HexBytes) ([]byte, error)
-	QueryStore(key cmn.HexBytes, storeName, endPath string) ([]byte, error)
-	QuerySubspace(subspace []byte, storeName string) ([]cmn.KVPair, error)
-}
-
-// ClientTx shows the expected tx behavior
-type ClientTx interface {
-	Broadcast(txBytes []byte, broadcastMode BroadcastMode) (res TxResponse, err error)
-}
-
-// RPCClient shows the expected behavior for a inner exposed client
-type RPCClient interface {
-	rpc.ABCIClient
-	rpc.SignClient
-}
+// transports of the ABCI queries
+const (
+	// TransportRPC routes the ABCI queries through the tendermint rpc
+	TransportRPC Transport = "rpc"
+	// TransportGRPC routes the ABCI queries through the ABCIQuery method of the gRPC query service of the chain, i.e.
+	// cosmos.base.tendermint.v1beta1.Service, which okchain doesn't expose
+	TransportGRPC Transport = "grpc"
+)
 
 // ClientConfig records the base config of gosdk client
 type ClientConfig struct {
@@ -57,6 +26,55 @@ type ClientConfig struct {
 	GasAdjustment float64
 	Fees          DecCoins
 	GasPrices     DecCoins
+	// Screener screens all the recipient addresses in a tx before it's signed, optional
+	Screener AddressScreener
+	// PreSignHook approves or vetoes every tx with its msgs and fee before it's signed, optional
+	PreSignHook PreSignHook
+	// SignMode is the format of the sign bytes of the txs, SignModeAminoJSON by default
+	SignMode SignMode
+	// MinFees is the fee floor of all the txs built, which refuses the lower fees unless overridden, optional
+	MinFees DecCoins
+	// FeeAuditLog records the overrides of the fee floor, the standard logger by default
+	FeeAuditLog FeeAuditLog
+	// SignedTxStore records the txs signed locally and refuses to sign another tx with a sequence taken by a pending
+	// one, optional
+	SignedTxStore SignedTxStore
+	// SignedTxTTL is the time a signed tx keeps its sequence taken if never broadcast, DefaultSignedTxTTL by default
+	SignedTxTTL time.Duration
+	// Logger receives the structured events of all the rpc calls, broadcasts and signings with their durations and
+	// tx hashes, optional
+	Logger Logger
+	// Tracer traces the tx lifecycle with the spans of building, signing and broadcasting, optional
+	Tracer Tracer
+	// Transport selects the transport of the ABCI queries, TransportRPC by default. The txs, blocks and subscriptions
+	// always go through the tendermint rpc of NodeURI
+	Transport Transport
+	// GRPCAddr is the host:port of the gRPC query service with TransportGRPC
+	GRPCAddr string
+	// RPCAuth authenticates the requests to the tendermint rpc of NodeURI, e.g. by the API key of a hosted rpc provider,
+	// optional, which requires a https NodeURI. The websocket subscriptions are unauthenticated, since the websocket
+	// client of tendermint sends no headers
+	RPCAuth *EndpointAuth
+	// GRPCAuth authenticates the queries to the gRPC query service of GRPCAddr by the headers as the metadata, optional,
+	// which requires TLS in the same way as RPCAuth
+	GRPCAuth *EndpointAuth
+	// RPCRateLimit throttles the requests to the tendermint rpc of NodeURI, e.g. under the limits of a public node
+	// provider, optional. The websocket subscriptions go without it
+	RPCRateLimit *RateLimit
+	// GRPCRateLimit throttles the queries to the gRPC query service of GRPCAddr, optional
+	GRPCRateLimit *RateLimit
+	// MaxTxBytes is the limit of the encoded tx size checked before signing, DefaultMaxTxBytes by default
+	MaxTxBytes int
+	// SequenceManager serializes the txs broadcast by each signer and reserves their sequences, which makes the
+	// concurrent broadcasts from one key safe, optional
+	SequenceManager *SequenceManager
+	// TimeoutHeightSupported tells the chain rejects the txs after their timeout heights, with which the timeout
+	// heights are included in the txs and their sign bytes. Otherwise they're only checked by the client before
+	// building
+	TimeoutHeightSupported bool
+	// FeePayerSupported tells the chain takes the fee payer and the fee granter of StdFee, with the signature of the
+	// fee payer after the one of the signer. Otherwise the txs with them, which okchain rejects, are refused to build
+	FeePayerSupported bool
 }
 
 // NewClientConfig creates a new instance of ClientConfig
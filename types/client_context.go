@@ -0,0 +1,81 @@
+package types
+
+// SignMode defines the format of the sign bytes of the txs built by a client
+type SignMode string
+
+// sign modes of the txs
+const (
+	// SignModeAminoJSON signs the sorted amino JSON of the StdSignMsg, the only format okchain verifies by now
+	SignModeAminoJSON SignMode = "amino-json"
+)
+
+// ClientContext - structure of the immutable context of a client shared by its module clients, which carries the chain
+// ID, the sign mode and the fee config snapshotted from the client config, so that the clients configured differently
+// coexist in one process without any package state
+type ClientContext struct {
+	config ClientConfig
+}
+
+// NewClientContext creates a new instance of ClientContext by the snapshot of the config, which is never affected by
+// the later changes of the config
+func NewClientContext(config ClientConfig) ClientContext {
+	if len(config.SignMode) == 0 {
+		config.SignMode = SignModeAminoJSON
+	}
+	config.Fees = copyDecCoins(config.Fees)
+	config.GasPrices = copyDecCoins(config.GasPrices)
+	config.MinFees = copyDecCoins(config.MinFees)
+	return ClientContext{config}
+}
+
+// ChainID returns the chain ID of the txs to sign
+func (ctx ClientContext) ChainID() string {
+	return ctx.config.ChainID
+}
+
+// WithChainID returns a copy of the context with the chain ID, e.g. the one detected from the node
+func (ctx ClientContext) WithChainID(chainID string) ClientContext {
+	ctx.config.ChainID = chainID
+	return ctx
+}
+
+// SignMode returns the sign mode of the txs, SignModeAminoJSON by default
+func (ctx ClientContext) SignMode() SignMode {
+	return ctx.config.SignMode
+}
+
+// BroadcastMode returns the default broadcast mode of the txs
+func (ctx ClientContext) BroadcastMode() BroadcastMode {
+	return ctx.config.BroadcastMode
+}
+
+// Gas returns the gas limit of the txs with the fixed fees
+func (ctx ClientContext) Gas() uint64 {
+	return ctx.config.Gas
+}
+
+// Fees returns a copy of the fixed fees of the txs
+func (ctx ClientContext) Fees() DecCoins {
+	return copyDecCoins(ctx.config.Fees)
+}
+
+// GasPrices returns a copy of the gas prices of the auto gas calculation, empty with the fixed fees
+func (ctx ClientContext) GasPrices() DecCoins {
+	return copyDecCoins(ctx.config.GasPrices)
+}
+
+// Config returns a copy of the client config snapshotted
+func (ctx ClientContext) Config() ClientConfig {
+	config := ctx.config
+	config.Fees = copyDecCoins(config.Fees)
+	config.GasPrices = copyDecCoins(config.GasPrices)
+	config.MinFees = copyDecCoins(config.MinFees)
+	return config
+}
+
+func copyDecCoins(coins DecCoins) DecCoins {
+	if coins == nil {
+		return nil
+	}
+	return append(DecCoins{}, coins...)
+}
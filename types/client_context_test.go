@@ -0,0 +1,33 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewClientContext(t *testing.T) {
+	config, err := NewClientConfig("testURL", "testChain", BroadcastBlock, "", 200000, 1.1, "0.00000001okt")
+	require.NoError(t, err)
+
+	ctx := NewClientContext(config)
+	require.Equal(t, "testChain", ctx.ChainID())
+	require.Equal(t, SignModeAminoJSON, ctx.SignMode())
+	require.Equal(t, BroadcastBlock, ctx.BroadcastMode())
+	require.Equal(t, uint64(200000), ctx.Gas())
+	require.Equal(t, "0.00000001okt", ctx.GasPrices().String())
+
+	// never affected by the later changes of the config
+	config.ChainID = "anotherChain"
+	config.GasPrices[0].Amount = MustNewDecFromStr("1")
+	require.Equal(t, "testChain", ctx.ChainID())
+	require.Equal(t, "0.00000001okt", ctx.GasPrices().String())
+
+	// nor by the changes of the copies returned
+	ctx.GasPrices()[0].Amount = MustNewDecFromStr("1")
+	snapshot := ctx.Config()
+	snapshot.GasPrices[0].Amount = MustNewDecFromStr("1")
+	require.Equal(t, "0.00000001okt", ctx.GasPrices().String())
+	require.Equal(t, SignModeAminoJSON, snapshot.SignMode)
+	require.Nil(t, ctx.Fees())
+}
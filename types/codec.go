@@ -8,8 +8,12 @@ import (
 	"github.com/tendermint/tendermint/crypto/secp256k1"
 )
 
-// Cdc is the codec for signing
-var Cdc = NewCodec()
+var (
+	// Cdc is the codec for signing
+	Cdc = NewCodec()
+
+	codecInitializers []func()
+)
 
 // SDKCodec shows the expected behaviour of codec in okchain gosdk
 type SDKCodec interface {
@@ -45,8 +49,9 @@ func NewCodec() SDKCodec {
 }
 
 // RegisterConcrete implements the SDKCodec interface
+// The name is translated by the amino names and prefixes overridden in the config
 func (cdc Codec) RegisterConcrete(o interface{}, name string) {
-	cdc.Codec.RegisterConcrete(o, name, nil)
+	cdc.Codec.RegisterConcrete(o, GetConfig().GetAminoName(name), nil)
 }
 
 // RegisterInterface implements the SDKCodec interface
@@ -75,3 +80,16 @@ func RegisterBasicCodec(cdc SDKCodec) {
 	// msg
 	cdc.RegisterInterface((*Msg)(nil))
 }
+
+// RegisterCodecInitializer runs the initializer of a package-level codec and keeps it to rerun once the amino names
+// are overridden in the config
+func RegisterCodecInitializer(initializer func()) {
+	initializer()
+	codecInitializers = append(codecInitializers, initializer)
+}
+
+func reinitCodecs() {
+	for _, initializer := range codecInitializers {
+		initializer()
+	}
+}
@@ -1,10 +1,30 @@
 package types
 
+import (
+	"fmt"
+	"strings"
+)
+
+// BIP44 coin types of the networks which the keys are derived for
+const (
+	// CoinTypeOKChain is the coin type registered for OKChain mainnet
+	CoinTypeOKChain uint32 = 996
+	// CoinTypeEVM is the coin type of Ethereum, used by OKExChain in the EVM era
+	CoinTypeEVM uint32 = 60
+	// CoinTypeCosmos is the coin type of Cosmos Hub for the interop with the cosmos tooling
+	CoinTypeCosmos uint32 = 118
+
+	bip44Purpose = 44
+)
+
 // Config is the structure that holds the SDK configuration parameters.
 // This could be used to initialize certain configuration parameters for the SDK.
 type Config struct {
 	sealed              bool
 	bech32AddressPrefix map[string]string
+	aminoNames          map[string]string
+	aminoPrefixes       map[string]string
+	coinType            uint32
 	txEncoder           TxEncoder
 	addressVerifier     func([]byte) error
 }
@@ -21,7 +41,10 @@ var (
 			"validator_pub":  Bech32PrefixValPub,
 			"consensus_pub":  Bech32PrefixConsPub,
 		},
-		txEncoder: nil,
+		aminoNames:    make(map[string]string),
+		aminoPrefixes: make(map[string]string),
+		coinType:      CoinTypeOKChain,
+		txEncoder:     nil,
 	}
 )
 
@@ -30,6 +53,71 @@ func GetConfig() *Config {
 	return sdkConfig
 }
 
+// Seal seals the config so that it can't be modified any more
+func (config *Config) Seal() *Config {
+	config.sealed = true
+	return config
+}
+
+func (config *Config) assertNotSealed() {
+	if config.sealed {
+		panic("config is sealed")
+	}
+}
+
+// SetAminoName overrides the amino registration name of a type, e.g. "okchain/token/MsgTransfer", for the forked chain
+// with renamed routes. It should be called before the client is created
+func (config *Config) SetAminoName(defaultName, name string) {
+	config.assertNotSealed()
+	config.aminoNames[defaultName] = name
+	reinitCodecs()
+}
+
+// SetAminoPrefix overrides the prefix of the amino registration names, e.g. "okchain/", for the forked chain with
+// renamed routes. It should be called before the client is created
+func (config *Config) SetAminoPrefix(defaultPrefix, prefix string) {
+	config.assertNotSealed()
+	config.aminoPrefixes[defaultPrefix] = prefix
+	reinitCodecs()
+}
+
+// GetAminoName returns the amino registration name overridden in the config
+func (config *Config) GetAminoName(defaultName string) string {
+	if name, ok := config.aminoNames[defaultName]; ok {
+		return name
+	}
+
+	// the longest default prefix matched wins
+	var matchedPrefix string
+	for defaultPrefix := range config.aminoPrefixes {
+		if strings.HasPrefix(defaultName, defaultPrefix) && len(defaultPrefix) > len(matchedPrefix) {
+			matchedPrefix = defaultPrefix
+		}
+	}
+	if len(matchedPrefix) == 0 {
+		return defaultName
+	}
+
+	return config.aminoPrefixes[matchedPrefix] + strings.TrimPrefix(defaultName, matchedPrefix)
+}
+
+// SetCoinType sets the BIP44 coin type used to derive the keys from mnemonics, e.g. CoinTypeEVM, so that the derived
+// addresses match other tooling on the target network. It should be called before any key is created
+func (config *Config) SetCoinType(coinType uint32) {
+	config.assertNotSealed()
+	config.coinType = coinType
+}
+
+// GetCoinType returns the BIP44 coin type used to derive the keys
+func (config *Config) GetCoinType() uint32 {
+	return config.coinType
+}
+
+// GetFullFundraiserPath returns the BIP44 path of the first key derived from a mnemonic, e.g. "44'/996'/0'/0/0"
+func (config *Config) GetFullFundraiserPath() string {
+	return fmt.Sprintf("%d'/%d'/0'/0/0", bip44Purpose, config.coinType)
+}
+
 // GetBech32AccountAddrPrefix returns the Bech32 prefix for account address
 func (config *Config) GetBech32AccountAddrPrefix() string {
 	return config.bech32AddressPrefix["account_addr"]
@@ -0,0 +1,101 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+)
+
+// ClientConfigFile - structure of the json config file of the client. Any of its string values could be a secret
+// reference resolved on load, e.g. "env:OKCHAIN_NODE_URI" for a node URI with the credentials, so that the config file
+// checked into a deployment repo never contains the secrets
+type ClientConfigFile struct {
+	NodeURI       string        `json:"node_uri"`
+	ChainID       string        `json:"chain_id"`
+	BroadcastMode BroadcastMode `json:"broadcast_mode"`
+	Fees          string        `json:"fees,omitempty"`
+	Gas           uint64        `json:"gas"`
+	GasAdjustment float64       `json:"gas_adjustment,omitempty"`
+	GasPrices     string        `json:"gas_prices,omitempty"`
+	Transport     Transport     `json:"transport,omitempty"`
+	GRPCAddr      string        `json:"grpc_addr,omitempty"`
+	RPCAuth       *EndpointAuth `json:"rpc_auth,omitempty"`
+	GRPCAuth      *EndpointAuth `json:"grpc_auth,omitempty"`
+	RPCRateLimit  *RateLimit    `json:"rpc_rate_limit,omitempty"`
+	GRPCRateLimit *RateLimit    `json:"grpc_rate_limit,omitempty"`
+	// Secrets are the named secrets of the application, e.g. the passphrases of its keys
+	Secrets map[string]string `json:"secrets,omitempty"`
+}
+
+// LoadClientConfigFile reads the json config file and resolves its secret references by the resolvers,
+// DefaultSecretResolvers if nil. The unknown fields are refused, which are likely typos
+func LoadClientConfigFile(path string, resolvers SecretResolvers) (configFile ClientConfigFile, err error) {
+	bz, err := ioutil.ReadFile(path)
+	if err != nil {
+		return configFile, fmt.Errorf("failed. read config file error: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(bz))
+	decoder.DisallowUnknownFields()
+	if err = decoder.Decode(&configFile); err != nil {
+		return configFile, fmt.Errorf("failed. decode config file %s error: %w", path, err)
+	}
+
+	if resolvers == nil {
+		resolvers = DefaultSecretResolvers()
+	}
+	for field, value := range map[string]*string{
+		"node_uri":   &configFile.NodeURI,
+		"chain_id":   &configFile.ChainID,
+		"fees":       &configFile.Fees,
+		"gas_prices": &configFile.GasPrices,
+		"grpc_addr":  &configFile.GRPCAddr,
+	} {
+		if *value, err = resolvers.Resolve(*value); err != nil {
+			return configFile, fmt.Errorf("failed. config field %s: %w", field, err)
+		}
+	}
+	for field, auth := range map[string]*EndpointAuth{
+		"rpc_auth":  configFile.RPCAuth,
+		"grpc_auth": configFile.GRPCAuth,
+	} {
+		if auth == nil {
+			continue
+		}
+		if err = auth.resolve(resolvers); err != nil {
+			return configFile, fmt.Errorf("failed. config field %s.%w", field, err)
+		}
+	}
+	for name, value := range configFile.Secrets {
+		if configFile.Secrets[name], err = resolvers.Resolve(value); err != nil {
+			return configFile, fmt.Errorf("failed. config secret %s: %w", name, err)
+		}
+	}
+
+	return configFile, nil
+}
+
+// ClientConfig creates the client config from the config file loaded
+func (ccf ClientConfigFile) ClientConfig() (ClientConfig, error) {
+	config, err := NewClientConfig(ccf.NodeURI, ccf.ChainID, ccf.BroadcastMode, ccf.Fees, ccf.Gas, ccf.GasAdjustment,
+		ccf.GasPrices)
+	if err != nil {
+		return config, err
+	}
+
+	config.Transport = ccf.Transport
+	config.GRPCAddr = ccf.GRPCAddr
+	config.RPCAuth, config.GRPCAuth = ccf.RPCAuth, ccf.GRPCAuth
+	config.RPCRateLimit, config.GRPCRateLimit = ccf.RPCRateLimit, ccf.GRPCRateLimit
+	return config, nil
+}
+
+// Secret returns the named secret resolved, e.g. the passphrase of a key
+func (ccf ClientConfigFile) Secret(name string) (string, error) {
+	secret, ok := ccf.Secrets[name]
+	if !ok {
+		return "", fmt.Errorf("failed. secret %s is missing in the config file", name)
+	}
+	return secret, nil
+}
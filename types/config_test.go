@@ -0,0 +1,41 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testMsg struct {
+	Amount string `json:"amount"`
+}
+
+func TestConfig_AminoNames(t *testing.T) {
+	config := GetConfig()
+	defer func() {
+		config.aminoNames = make(map[string]string)
+		config.aminoPrefixes = make(map[string]string)
+		reinitCodecs()
+	}()
+
+	var testCdc SDKCodec
+	RegisterCodecInitializer(func() {
+		testCdc = NewCodec()
+		testCdc.RegisterConcrete(testMsg{}, "okchain/test/MsgTest")
+	})
+	require.Equal(t, `{"type":"okchain/test/MsgTest","value":{"amount":"1"}}`,
+		string(testCdc.MustMarshalJSON(testMsg{"1"})))
+
+	config.SetAminoPrefix("okchain/", "fork/")
+	require.Equal(t, "fork/test/MsgTest", config.GetAminoName("okchain/test/MsgTest"))
+	require.Equal(t, "cosmos-sdk/StdTx", config.GetAminoName("cosmos-sdk/StdTx"))
+	require.Equal(t, `{"type":"fork/test/MsgTest","value":{"amount":"1"}}`,
+		string(testCdc.MustMarshalJSON(testMsg{"1"})))
+
+	// longer prefix and exact name take precedence
+	config.SetAminoPrefix("okchain/test/", "fork/renamed/")
+	require.Equal(t, "fork/renamed/MsgTest", config.GetAminoName("okchain/test/MsgTest"))
+	config.SetAminoName("okchain/test/MsgTest", "fork/test/MsgRenamed")
+	require.Equal(t, `{"type":"fork/test/MsgRenamed","value":{"amount":"1"}}`,
+		string(testCdc.MustMarshalJSON(testMsg{"1"})))
+}
@@ -0,0 +1,78 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ConsistencyMode decides which height of the state the queries read
+type ConsistencyMode int
+
+// consistency modes of the queries
+const (
+	// ConsistencyLatest reads the latest state of the node, the freshest but might be reverted by a reorg
+	ConsistencyLatest ConsistencyMode = iota
+	// ConsistencyFinalized reads the state a number of confirmations behind the latest block
+	ConsistencyFinalized
+	// ConsistencyHeight reads the state at an explicit height
+	ConsistencyHeight
+)
+
+// Consistency - structure of the consistency option of the queries, which trades the freshness for the stability
+type Consistency struct {
+	Mode          ConsistencyMode
+	Confirmations int64
+	Height        int64
+}
+
+// Latest returns the consistency option to query the latest state
+func Latest() Consistency {
+	return Consistency{Mode: ConsistencyLatest}
+}
+
+// Finalized returns the consistency option to query the state with the number of confirmations
+func Finalized(confirmations int64) Consistency {
+	return Consistency{
+		Mode:          ConsistencyFinalized,
+		Confirmations: confirmations,
+	}
+}
+
+// AtHeight returns the consistency option to query the state at the explicit height
+func AtHeight(height int64) Consistency {
+	return Consistency{
+		Mode:   ConsistencyHeight,
+		Height: height,
+	}
+}
+
+// NeedsLatestHeight tells whether the latest height of the chain is required to resolve the query height
+func (c Consistency) NeedsLatestHeight() bool {
+	return c.Mode == ConsistencyFinalized
+}
+
+// ResolveHeight resolves the height of the state to query with the latest height of the chain
+// 0 is returned for the latest state, which is the default height of the ABCI query
+func (c Consistency) ResolveHeight(latestHeight int64) (int64, error) {
+	switch c.Mode {
+	case ConsistencyLatest:
+		return 0, nil
+	case ConsistencyFinalized:
+		if c.Confirmations < 0 {
+			return 0, errors.New("failed. confirmations must not be negative")
+		}
+		height := latestHeight - c.Confirmations
+		if height <= 0 {
+			return 0, fmt.Errorf("failed. no block with %d confirmations at the latest height %d", c.Confirmations,
+				latestHeight)
+		}
+		return height, nil
+	case ConsistencyHeight:
+		if c.Height <= 0 {
+			return 0, fmt.Errorf("failed. invalid query height %d", c.Height)
+		}
+		return c.Height, nil
+	default:
+		return 0, fmt.Errorf("failed. unknown consistency mode %d", c.Mode)
+	}
+}
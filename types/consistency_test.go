@@ -0,0 +1,32 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConsistency_ResolveHeight(t *testing.T) {
+	height, err := Latest().ResolveHeight(1024)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), height)
+	require.False(t, Latest().NeedsLatestHeight())
+
+	require.True(t, Finalized(3).NeedsLatestHeight())
+	height, err = Finalized(3).ResolveHeight(1024)
+	require.NoError(t, err)
+	require.Equal(t, int64(1021), height)
+	_, err = Finalized(1024).ResolveHeight(1024)
+	require.Error(t, err)
+	_, err = Finalized(-1).ResolveHeight(1024)
+	require.Error(t, err)
+
+	height, err = AtHeight(10).ResolveHeight(0)
+	require.NoError(t, err)
+	require.Equal(t, int64(10), height)
+	_, err = AtHeight(0).ResolveHeight(1024)
+	require.Error(t, err)
+
+	_, err = Consistency{Mode: 3}.ResolveHeight(1024)
+	require.Error(t, err)
+}
@@ -0,0 +1,69 @@
+package keys
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/okex/okchain-go-sdk/types"
+)
+
+// KeyDetails - structure of a key with its metadata for display, e.g. by a wallet showing where each key came from
+type KeyDetails struct {
+	Name    string `json:"name"`
+	Type    string `json:"type"`
+	Address string `json:"address"`
+	// PubKey is empty if unknown, e.g. for a watch-only key by its address
+	PubKey string `json:"pubkey"`
+	// Path is the full BIP44 derivation path, empty if unknown
+	Path string      `json:"path,omitempty"`
+	Algo SigningAlgo `json:"algo,omitempty"`
+	// CreatedAt is zero if unknown, e.g. for the keys stored before the metadata was kept
+	CreatedAt time.Time `json:"created_at"`
+	Labels    []string  `json:"labels,omitempty"`
+}
+
+// NewKeyDetails creates the details of the key info
+func NewKeyDetails(info Info) (details KeyDetails, err error) {
+	// the public key of a watch-only key may be unknown
+	var pubKey string
+	if info.GetPubKey() != nil {
+		if pubKey, err = types.Bech32ifyAccPub(info.GetPubKey()); err != nil {
+			return details, fmt.Errorf("failed. bech32ify the pubkey of %s error: %w", info.GetName(), err)
+		}
+	}
+
+	meta := info.GetMetadata()
+	details = KeyDetails{
+		Name:      info.GetName(),
+		Type:      info.GetType().String(),
+		Address:   info.GetAddress().String(),
+		PubKey:    pubKey,
+		Path:      meta.Path,
+		Algo:      meta.Algo,
+		CreatedAt: meta.CreationTime(),
+		Labels:    meta.Labels,
+	}
+	// the ledger keys always knew their paths
+	if len(details.Path) == 0 {
+		if path, err := info.GetPath(); err == nil {
+			details.Path = path.String()
+		}
+	}
+	return
+}
+
+// ListKeysDetailed returns the details of all the keys in the keybase in alphabetical order
+func ListKeysDetailed(kb Keybase) ([]KeyDetails, error) {
+	infos, err := kb.List()
+	if err != nil {
+		return nil, err
+	}
+
+	details := make([]KeyDetails, len(infos))
+	for i, info := range infos {
+		if details[i], err = NewKeyDetails(info); err != nil {
+			return nil, err
+		}
+	}
+	return details, nil
+}
@@ -0,0 +1,94 @@
+package keys
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/okex/okchain-go-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+)
+
+func TestListKeysDetailed(t *testing.T) {
+	kb, err := NewFileKeybase(t.TempDir())
+	require.NoError(t, err)
+	_, mnemonic, err := kb.CreateMnemonic("alice", English, "12345678", Secp256k1)
+	require.NoError(t, err)
+	bob, err := kb.CreateAccount("bob", mnemonic, "", "12345678", 0, 1)
+	require.NoError(t, err)
+	_, err = kb.CreateOffline("carol", secp256k1.GenPrivKey().PubKey())
+	require.NoError(t, err)
+
+	meta := bob.GetMetadata()
+	require.Equal(t, fmt.Sprintf("44'/%d'/0'/0/1", types.GetConfig().GetCoinType()), meta.Path)
+	require.Equal(t, Secp256k1, meta.Algo)
+	require.WithinDuration(t, time.Now(), meta.CreationTime(), time.Minute)
+
+	// the labels and the metadata survive the change of the passphrase
+	_, err = kb.SetLabels("bob", "cold", "savings")
+	require.NoError(t, err)
+	require.NoError(t, kb.Update("bob", "12345678", func() (string, error) { return "87654321", nil }))
+	_, err = kb.SetLabels("nobody", "cold")
+	require.Error(t, err)
+
+	details, err := ListKeysDetailed(kb)
+	require.NoError(t, err)
+	require.Len(t, details, 3)
+	require.Equal(t, "alice", details[0].Name)
+	require.Equal(t, types.GetConfig().GetFullFundraiserPath(), details[0].Path)
+	require.Equal(t, "bob", details[1].Name)
+	require.Equal(t, "local", details[1].Type)
+	require.Equal(t, bob.GetAddress().String(), details[1].Address)
+	require.Equal(t, meta.Path, details[1].Path)
+	require.Equal(t, meta.CreationTime(), details[1].CreatedAt)
+	require.Equal(t, []string{"cold", "savings"}, details[1].Labels)
+	require.Equal(t, "offline", details[2].Type)
+	require.Empty(t, details[2].Path)
+	require.Equal(t, Secp256k1, details[2].Algo)
+}
+
+func TestKeyDetails_WithoutMetadata(t *testing.T) {
+	// the keys stored before the metadata was kept
+	priv := secp256k1.GenPrivKey()
+	info, err := readInfo(writeInfo(NewLocalInfo("alice", priv.PubKey(), "")))
+	require.NoError(t, err)
+	require.Equal(t, KeyMetadata{}, info.GetMetadata())
+
+	details, err := NewKeyDetails(info)
+	require.NoError(t, err)
+	require.Empty(t, details.Path)
+	require.True(t, details.CreatedAt.IsZero())
+}
+
+func TestKeybase_WatchOnly(t *testing.T) {
+	kb, err := NewFileKeybase(t.TempDir())
+	require.NoError(t, err)
+	address := types.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	_, err = kb.CreateWatchOnly("alice", nil)
+	require.Error(t, err)
+	info, err := kb.CreateWatchOnly("alice", address)
+	require.NoError(t, err)
+	offline, err := kb.CreateOffline("bob", secp256k1.GenPrivKey().PubKey())
+	require.NoError(t, err)
+	require.True(t, IsWatchOnly(info))
+	require.True(t, IsWatchOnly(offline))
+
+	// found by the address, without the public key
+	info, err = kb.GetByAddress(address)
+	require.NoError(t, err)
+	require.Equal(t, TypeWatch, info.GetType())
+	require.Equal(t, address, info.GetAddress())
+	require.Nil(t, info.GetPubKey())
+	details, err := NewKeyDetails(info)
+	require.NoError(t, err)
+	require.Equal(t, "watch", details.Type)
+	require.Empty(t, details.PubKey)
+
+	_, _, err = kb.Sign("alice", "", []byte("msg"))
+	require.Error(t, err)
+	_, err = kb.ExportPubKey("alice")
+	require.Error(t, err)
+	_, err = kb.ExportPrivateKeyObject("alice", "")
+	require.Error(t, err)
+}
@@ -0,0 +1,198 @@
+package keys
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	dbm "github.com/tendermint/tm-db"
+)
+
+const (
+	keystoreFileName = "keys.json"
+	backupFileName   = "keys.json.bak"
+	lockFileName     = "keys.lock"
+)
+
+// ErrKeystoreCorrupted is raised when the keystore file and its backup both fail the checksum
+var ErrKeystoreCorrupted = errors.New("keystore corrupted")
+
+var _ keystore = fileKeystore{}
+
+// keystoreEntry - structure of a record of the keystore file
+type keystoreEntry struct {
+	Key   []byte `json:"key"`
+	Value []byte `json:"value"`
+}
+
+// keystoreFile - structure of the keystore file, whose checksum of the entries detects the corruption
+type keystoreFile struct {
+	Entries  []keystoreEntry `json:"entries"`
+	Checksum string          `json:"checksum"`
+}
+
+// fileKeystore keeps the keys in a file of the dir shared by multiple processes. Every access takes the advisory lock
+// of the dir, shared for the reads and exclusive for the mutations, and loads the latest keys from the file. The
+// mutations are written to a temp file renamed over the keystore file, after the previous version is kept as the
+// backup, which is restored on load if the keystore file is corrupted
+type fileKeystore struct {
+	dir string
+}
+
+// NewFileKeybase creates a keybase on the keystore file in the dir, which is created if missing. A corrupted keystore
+// file is restored from its backup at once, and ErrKeystoreCorrupted is returned if the backup is corrupted as well
+func NewFileKeybase(dir string) (Keybase, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, fmt.Errorf("failed. create keystore dir error: %w", err)
+	}
+
+	ks := fileKeystore{dir}
+	if err := ks.update(func(dbKeybase) error { return nil }); err != nil {
+		return nil, err
+	}
+	return keystoreKeybase{ks}, nil
+}
+
+// view runs the read on the latest keys under the shared lock
+func (ks fileKeystore) view(fn func(dbKeybase) error) error {
+	return ks.withLock(false, func() error {
+		db, _, err := ks.load()
+		if err != nil {
+			return err
+		}
+		return fn(dbKeybase{db})
+	})
+}
+
+// update runs the mutation on the latest keys under the exclusive lock, and saves the keys if it succeeds
+func (ks fileKeystore) update(fn func(dbKeybase) error) error {
+	return ks.withLock(true, func() error {
+		db, prev, err := ks.load()
+		if err != nil {
+			return err
+		}
+		if err = fn(dbKeybase{db}); err != nil {
+			return err
+		}
+		return ks.save(db, prev)
+	})
+}
+
+func (ks fileKeystore) withLock(exclusive bool, fn func() error) error {
+	f, err := os.OpenFile(filepath.Join(ks.dir, lockFileName), os.O_CREATE|os.O_RDWR, 0600)
+	if err != nil {
+		return fmt.Errorf("failed. open keystore lock error: %w", err)
+	}
+	defer f.Close()
+
+	if err = lockFile(f, exclusive); err != nil {
+		return fmt.Errorf("failed. lock keystore error: %w", err)
+	}
+	defer unlockFile(f)
+	return fn()
+}
+
+// load reads the keys from the keystore file, or from its backup if the file is corrupted, and returns the valid
+// bytes read as well, nil if neither exists
+func (ks fileKeystore) load() (db dbm.DB, valid []byte, err error) {
+	entries, valid, err := readKeystoreFile(filepath.Join(ks.dir, keystoreFileName))
+	if err != nil && !os.IsNotExist(err) {
+		var backupErr error
+		if entries, valid, backupErr = readKeystoreFile(filepath.Join(ks.dir, backupFileName)); backupErr != nil {
+			return nil, nil, err
+		}
+	}
+
+	db = dbm.NewMemDB()
+	for _, entry := range entries {
+		db.Set(entry.Key, entry.Value)
+	}
+	return db, valid, nil
+}
+
+// save writes the keys to the keystore file, after the previous valid bytes are kept as the backup
+func (ks fileKeystore) save(db dbm.DB, prev []byte) error {
+	var file keystoreFile
+	iter := db.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		file.Entries = append(file.Entries, keystoreEntry{Key: iter.Key(), Value: iter.Value()})
+	}
+	iter.Close()
+
+	checksum, err := entriesChecksum(file.Entries)
+	if err != nil {
+		return err
+	}
+	file.Checksum = checksum
+	bz, err := json.Marshal(file)
+	if err != nil {
+		return fmt.Errorf("failed. marshal keystore error: %w", err)
+	}
+
+	if prev != nil {
+		if err = writeFileAtomic(filepath.Join(ks.dir, backupFileName), prev); err != nil {
+			return err
+		}
+	}
+	return writeFileAtomic(filepath.Join(ks.dir, keystoreFileName), bz)
+}
+
+// readKeystoreFile reads the entries of the keystore file, and verifies them by the checksum
+func readKeystoreFile(path string) ([]keystoreEntry, []byte, error) {
+	bz, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var file keystoreFile
+	if err = json.Unmarshal(bz, &file); err != nil {
+		return nil, nil, fmt.Errorf("failed. %s: %s: %w", path, err, ErrKeystoreCorrupted)
+	}
+	checksum, err := entriesChecksum(file.Entries)
+	if err != nil {
+		return nil, nil, err
+	}
+	if checksum != file.Checksum {
+		return nil, nil, fmt.Errorf("failed. %s: checksum mismatch: %w", path, ErrKeystoreCorrupted)
+	}
+	return file.Entries, bz, nil
+}
+
+func entriesChecksum(entries []keystoreEntry) (string, error) {
+	bz, err := json.Marshal(entries)
+	if err != nil {
+		return "", fmt.Errorf("failed. marshal keystore entries error: %w", err)
+	}
+	sum := sha256.Sum256(bz)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// writeFileAtomic writes the bytes to a synced temp file in the same dir, and renames it over the path
+func writeFileAtomic(path string, bz []byte) error {
+	f, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".tmp")
+	if err != nil {
+		return fmt.Errorf("failed. create temp keystore file error: %w", err)
+	}
+	tmpPath := f.Name()
+	defer os.Remove(tmpPath)
+
+	if _, err = f.Write(bz); err == nil {
+		err = f.Sync()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("failed. write temp keystore file error: %w", err)
+	}
+
+	if err = os.Rename(tmpPath, path); err != nil {
+		return fmt.Errorf("failed. rename temp keystore file error: %w", err)
+	}
+	return nil
+}
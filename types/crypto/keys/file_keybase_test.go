@@ -0,0 +1,102 @@
+package keys
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileKeybase(t *testing.T) {
+	dir, err := ioutil.TempDir("", "keystore")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	kb, err := NewFileKeybase(dir)
+	require.NoError(t, err)
+	info, mnemonic, err := kb.CreateMnemonic("alice", English, "12345678", Secp256k1)
+	require.NoError(t, err)
+
+	// shared by another keybase on the dir
+	otherKb, err := NewFileKeybase(dir)
+	require.NoError(t, err)
+	got, err := otherKb.Get("alice")
+	require.NoError(t, err)
+	require.Equal(t, info.GetAddress(), got.GetAddress())
+	got, err = otherKb.GetByAddress(info.GetAddress())
+	require.NoError(t, err)
+	require.Equal(t, "alice", got.GetName())
+	msg := []byte("file key message")
+	sig, pubKey, err := otherKb.Sign("alice", "12345678", msg)
+	require.NoError(t, err)
+	require.True(t, pubKey.VerifyBytes(msg, sig))
+
+	// the concurrent mutations are all kept
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, err := kb.CreateAccount(fmt.Sprintf("key%d", i), mnemonic, "", "12345678", 0, uint32(i))
+			require.NoError(t, err)
+		}(i)
+	}
+	wg.Wait()
+	infos, err := otherKb.List()
+	require.NoError(t, err)
+	require.Len(t, infos, 9)
+
+	// the failed mutations aren't saved
+	_, _, err = kb.CreateMnemonic("bob", Japanese, "12345678", Secp256k1)
+	require.Error(t, err)
+	require.NoError(t, kb.Delete("key0", "", true))
+	infos, err = kb.List()
+	require.NoError(t, err)
+	require.Len(t, infos, 8)
+}
+
+func TestFileKeybase_Corruption(t *testing.T) {
+	dir, err := ioutil.TempDir("", "keystore")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	kb, err := NewFileKeybase(dir)
+	require.NoError(t, err)
+	_, mnemonic, err := kb.CreateMnemonic("alice", English, "12345678", Secp256k1)
+	require.NoError(t, err)
+	_, err = kb.CreateAccount("bob", mnemonic, "", "12345678", 0, 1)
+	require.NoError(t, err)
+
+	// the checksum mismatched by a bit flipped
+	path := filepath.Join(dir, keystoreFileName)
+	bz, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	bz[len(bz)/2] ^= 1
+	require.NoError(t, ioutil.WriteFile(path, bz, 0600))
+
+	// read from the backup before the last mutation
+	_, err = kb.Get("alice")
+	require.NoError(t, err)
+	_, err = kb.Get("bob")
+	require.Error(t, err)
+
+	// restored on open
+	_, err = NewFileKeybase(dir)
+	require.NoError(t, err)
+	_, _, err = readKeystoreFile(path)
+	require.NoError(t, err)
+
+	// both corrupted
+	for _, name := range []string{keystoreFileName, backupFileName} {
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, name), []byte(`{"entries":[`), 0600))
+	}
+	_, err = NewFileKeybase(dir)
+	require.True(t, errors.Is(err, ErrKeystoreCorrupted))
+	_, err = kb.List()
+	require.True(t, errors.Is(err, ErrKeystoreCorrupted))
+}
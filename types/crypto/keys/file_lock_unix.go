@@ -0,0 +1,23 @@
+//go:build !windows
+// +build !windows
+
+package keys
+
+import (
+	"os"
+	"syscall"
+)
+
+// lockFile takes the advisory lock of the file, which blocks until it's available
+func lockFile(f *os.File, exclusive bool) error {
+	how := syscall.LOCK_SH
+	if exclusive {
+		how = syscall.LOCK_EX
+	}
+	return syscall.Flock(int(f.Fd()), how)
+}
+
+// unlockFile releases the advisory lock of the file
+func unlockFile(f *os.File) error {
+	return syscall.Flock(int(f.Fd()), syscall.LOCK_UN)
+}
@@ -26,6 +26,7 @@ import (
 )
 
 // BIP44Prefix is the parts of the BIP32 HD path that are fixed by what we used during the fundraiser.
+// The keybase derives the keys with the coin type in the sdk config which is 996 by default.
 const (
 	BIP44Prefix        = "44'/996'/"
 	FullFundraiserPath = BIP44Prefix + "0'/0/0"
@@ -62,6 +63,12 @@ func NewFundraiserParams(account uint32, addressIdx uint32) *BIP44Params {
 	return NewParams(44, 996, account, false, addressIdx)
 }
 
+// NewFundraiserParamsWithCoinType creates a BIP 44 parameter object from the params:
+// m / 44' / coin_type' / account' / 0 / address_index
+func NewFundraiserParamsWithCoinType(coinType, account, addressIdx uint32) *BIP44Params {
+	return NewParams(44, coinType, account, false, addressIdx)
+}
+
 // DerivationPath returns the BIP44 fields as an array.
 func (p BIP44Params) DerivationPath() []uint32 {
 	change := uint32(0)
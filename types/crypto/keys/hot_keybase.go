@@ -0,0 +1,201 @@
+package keys
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/cosmos/go-bip39"
+	"github.com/okex/okchain-go-sdk/types"
+	"github.com/okex/okchain-go-sdk/types/crypto/keys/hd"
+	tmcrypto "github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	dbm "github.com/tendermint/tm-db"
+)
+
+var _ HotKeybase = hotKeybase{}
+
+// hotKeybase keeps the private keys of the local keys unencrypted in memory for the process lifetime, so that signing
+// skips the costly decryption of the key armor. The passphrases of the local keys created by it are ignored
+type hotKeybase struct {
+	dbKeybase
+	mtx      *sync.RWMutex
+	privKeys map[string]tmcrypto.PrivKey
+}
+
+// NewHotInMemory creates a transient keybase for the hot keys of the server applications, e.g. the high-frequency
+// trading services, which never encrypts the private keys. The keys imported by armor are still encrypted
+// NOTE: the private keys are exposed to anyone able to read the memory of the process
+func NewHotInMemory() HotKeybase {
+	return hotKeybase{
+		dbKeybase: dbKeybase{dbm.NewMemDB()},
+		mtx:       new(sync.RWMutex),
+		privKeys:  make(map[string]tmcrypto.PrivKey),
+	}
+}
+
+// CreateMnemonic generates a new key and keeps it unencrypted in memory
+func (kb hotKeybase) CreateMnemonic(name string, language Language, _ string, algo SigningAlgo) (info Info,
+	mnemonic string, err error) {
+	if language != English {
+		return nil, "", ErrUnsupportedLanguage
+	}
+	if algo != Secp256k1 {
+		err = ErrUnsupportedSigningAlgo
+		return
+	}
+
+	entropy, err := bip39.NewEntropy(defaultEntropySize)
+	if err != nil {
+		return
+	}
+	mnemonic, err = bip39.NewMnemonic(entropy)
+	if err != nil {
+		return
+	}
+
+	seed := bip39.NewSeed(mnemonic, DefaultBIP39Passphrase)
+	info, err = kb.persistHotDerivedKey(seed, name, types.GetConfig().GetFullFundraiserPath())
+	return
+}
+
+// CreateAccount converts a mnemonic to a private key and keeps it unencrypted in memory
+func (kb hotKeybase) CreateAccount(name, mnemonic, bip39Passwd, encryptPasswd string, account uint32,
+	index uint32) (Info, error) {
+	hdPath := hd.NewFundraiserParamsWithCoinType(types.GetConfig().GetCoinType(), account, index)
+	return kb.Derive(name, mnemonic, bip39Passwd, encryptPasswd, *hdPath)
+}
+
+// Derive computes the private key by the BIP44 params from the mnemonic and keeps it unencrypted in memory
+func (kb hotKeybase) Derive(name, mnemonic, bip39Passphrase, _ string, params hd.BIP44Params) (info Info,
+	err error) {
+	seed, err := bip39.NewSeedWithErrorChecking(mnemonic, bip39Passphrase)
+	if err != nil {
+		return
+	}
+
+	return kb.persistHotDerivedKey(seed, name, params.String())
+}
+
+// ImportPrivKey keeps the private key unencrypted in memory with the name
+func (kb hotKeybase) ImportPrivKey(name string, priv tmcrypto.PrivKey) Info {
+	return kb.importPrivKey(name, priv, newKeyMetadata("", pubKeyAlgo(priv.PubKey())))
+}
+
+func (kb hotKeybase) importPrivKey(name string, priv tmcrypto.PrivKey, meta KeyMetadata) Info {
+	info := newLocalInfo(name, priv.PubKey(), "", meta)
+	kb.mtx.Lock()
+	defer kb.mtx.Unlock()
+	kb.writeInfo(name, info)
+	kb.privKeys[name] = priv
+	return info
+}
+
+// CreateLedger creates a new reference to a Ledger keypair
+func (kb hotKeybase) CreateLedger(name string, algo SigningAlgo, hrp string, account, index uint32) (Info, error) {
+	kb.dropPrivKey(name)
+	return kb.dbKeybase.CreateLedger(name, algo, hrp, account, index)
+}
+
+// CreateOffline creates a new reference to an offline keypair
+func (kb hotKeybase) CreateOffline(name string, pub tmcrypto.PubKey) (Info, error) {
+	kb.dropPrivKey(name)
+	return kb.dbKeybase.CreateOffline(name, pub)
+}
+
+// CreateWatchOnly creates a new reference to a watch-only account
+func (kb hotKeybase) CreateWatchOnly(name string, address types.AccAddress) (Info, error) {
+	kb.dropPrivKey(name)
+	return kb.dbKeybase.CreateWatchOnly(name, address)
+}
+
+// CreateMulti creates a new reference to a multisig (offline) keypair
+func (kb hotKeybase) CreateMulti(name string, pub tmcrypto.PubKey) (Info, error) {
+	kb.dropPrivKey(name)
+	return kb.dbKeybase.CreateMulti(name, pub)
+}
+
+// Import imports the encrypted key by its armor, which still requires the passphrase to sign
+func (kb hotKeybase) Import(name string, armor string) error {
+	kb.dropPrivKey(name)
+	return kb.dbKeybase.Import(name, armor)
+}
+
+// ImportPubKey imports the public key by its armor
+func (kb hotKeybase) ImportPubKey(name string, armor string) error {
+	kb.dropPrivKey(name)
+	return kb.dbKeybase.ImportPubKey(name, armor)
+}
+
+// Sign signs the msg with the named key without the passphrase if it's a hot key
+func (kb hotKeybase) Sign(name, passphrase string, msg []byte) ([]byte, tmcrypto.PubKey, error) {
+	priv, ok := kb.getPrivKey(name)
+	if !ok {
+		return kb.dbKeybase.Sign(name, passphrase, msg)
+	}
+
+	sig, err := priv.Sign(msg)
+	if err != nil {
+		return nil, nil, err
+	}
+	return sig, priv.PubKey(), nil
+}
+
+// ExportPrivateKeyObject returns the private key of the named key without the passphrase if it's a hot key
+func (kb hotKeybase) ExportPrivateKeyObject(name string, passphrase string) (tmcrypto.PrivKey, error) {
+	if priv, ok := kb.getPrivKey(name); ok {
+		return priv, nil
+	}
+	return kb.dbKeybase.ExportPrivateKeyObject(name, passphrase)
+}
+
+// Export is unsupported for the hot keys, which have no armor
+func (kb hotKeybase) Export(name string) (armor string, err error) {
+	if _, ok := kb.getPrivKey(name); ok {
+		return "", fmt.Errorf("failed. unable to export the unencrypted key %s by armor", name)
+	}
+	return kb.dbKeybase.Export(name)
+}
+
+// Update is unsupported for the hot keys, which have no passphrase
+func (kb hotKeybase) Update(name, oldpass string, getNewpass func() (string, error)) error {
+	if _, ok := kb.getPrivKey(name); ok {
+		return fmt.Errorf("failed. unable to update the passphrase of the unencrypted key %s", name)
+	}
+	return kb.dbKeybase.Update(name, oldpass, getNewpass)
+}
+
+// Delete removes the key forever. The passphrase is ignored for the hot keys
+func (kb hotKeybase) Delete(name, passphrase string, skipPass bool) error {
+	if _, ok := kb.getPrivKey(name); ok {
+		skipPass = true
+	}
+	if err := kb.dbKeybase.Delete(name, passphrase, skipPass); err != nil {
+		return err
+	}
+
+	kb.dropPrivKey(name)
+	return nil
+}
+
+func (kb hotKeybase) persistHotDerivedKey(seed []byte, name, fullHdPath string) (Info, error) {
+	masterPriv, ch := hd.ComputeMastersFromSeed(seed)
+	derivedPriv, err := hd.DerivePrivateKeyForPath(masterPriv, ch, fullHdPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return kb.importPrivKey(name, secp256k1.PrivKeySecp256k1(derivedPriv), newKeyMetadata(fullHdPath, Secp256k1)), nil
+}
+
+func (kb hotKeybase) getPrivKey(name string) (tmcrypto.PrivKey, bool) {
+	kb.mtx.RLock()
+	defer kb.mtx.RUnlock()
+	priv, ok := kb.privKeys[name]
+	return priv, ok
+}
+
+func (kb hotKeybase) dropPrivKey(name string) {
+	kb.mtx.Lock()
+	defer kb.mtx.Unlock()
+	delete(kb.privKeys, name)
+}
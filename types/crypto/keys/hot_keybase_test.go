@@ -0,0 +1,52 @@
+package keys
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+)
+
+func TestHotKeybase(t *testing.T) {
+	kb, coldKb := NewHotInMemory(), NewInMemory()
+	_, mnemonic, err := coldKb.CreateMnemonic("cold", English, "12345678", Secp256k1)
+	require.NoError(t, err)
+
+	// the same key as the encrypted one with no passphrase
+	coldInfo, err := coldKb.CreateAccount("alice", mnemonic, "", "12345678", 0, 0)
+	require.NoError(t, err)
+	info, err := kb.CreateAccount("alice", mnemonic, "", "", 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, TypeLocal, info.GetType())
+	require.Equal(t, coldInfo.GetAddress(), info.GetAddress())
+
+	msg := []byte("hot key message")
+	sig, pubKey, err := kb.Sign("alice", "", msg)
+	require.NoError(t, err)
+	require.True(t, coldInfo.GetPubKey().Equals(pubKey))
+	require.True(t, pubKey.VerifyBytes(msg, sig))
+
+	priv, err := kb.ExportPrivateKeyObject("alice", "wrong passphrase")
+	require.NoError(t, err)
+	require.True(t, priv.PubKey().Equals(pubKey))
+	_, err = kb.Export("alice")
+	require.Error(t, err)
+	require.Error(t, kb.Update("alice", "", func() (string, error) { return "12345678", nil }))
+
+	// overwritten by an offline key
+	_, err = kb.CreateOffline("alice", pubKey)
+	require.NoError(t, err)
+	_, err = kb.ExportPrivateKeyObject("alice", "")
+	require.Error(t, err)
+
+	bob := kb.ImportPrivKey("bob", secp256k1.GenPrivKey())
+	_, err = kb.GetByAddress(bob.GetAddress())
+	require.NoError(t, err)
+	require.NoError(t, kb.Delete("bob", "", false))
+	_, _, err = kb.Sign("bob", "", msg)
+	require.Error(t, err)
+
+	infos, err := kb.List()
+	require.NoError(t, err)
+	require.Len(t, infos, 1)
+}
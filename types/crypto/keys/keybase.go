@@ -19,6 +19,7 @@ import (
 
 	tmcrypto "github.com/tendermint/tendermint/crypto"
 	cryptoAmino "github.com/tendermint/tendermint/crypto/encoding/amino"
+	"github.com/tendermint/tendermint/crypto/ed25519"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
 	dbm "github.com/tendermint/tm-db"
 )
@@ -108,13 +109,13 @@ func (kb dbKeybase) CreateMnemonic(name string, language Language, passwd string
 	}
 
 	seed := bip39.NewSeed(mnemonic, DefaultBIP39Passphrase)
-	info, err = kb.persistDerivedKey(seed, passwd, name, hd.FullFundraiserPath)
+	info, err = kb.persistDerivedKey(seed, passwd, name, types.GetConfig().GetFullFundraiserPath())
 	return
 }
 
 // CreateAccount converts a mnemonic to a private key and persists it, encrypted with the given password.
 func (kb dbKeybase) CreateAccount(name, mnemonic, bip39Passwd, encryptPasswd string, account uint32, index uint32) (Info, error) {
-	hdPath := hd.NewFundraiserParams(account, index)
+	hdPath := hd.NewFundraiserParamsWithCoinType(types.GetConfig().GetCoinType(), account, index)
 	return kb.Derive(name, mnemonic, bip39Passwd, encryptPasswd, *hdPath)
 }
 
@@ -135,7 +136,7 @@ func (kb dbKeybase) CreateLedger(name string, algo SigningAlgo, hrp string, acco
 		return nil, ErrUnsupportedSigningAlgo
 	}
 
-	hdPath := hd.NewFundraiserParams(account, index)
+	hdPath := hd.NewFundraiserParamsWithCoinType(types.GetConfig().GetCoinType(), account, index)
 	priv, _, err := crypto.NewPrivKeyLedgerSecp256k1(*hdPath, hrp)
 	if err != nil {
 		return nil, err
@@ -143,19 +144,27 @@ func (kb dbKeybase) CreateLedger(name string, algo SigningAlgo, hrp string, acco
 	pub := priv.PubKey()
 
 	// Note: Once Cosmos App v1.3.1 is compulsory, it could be possible to check that pubkey and addr match
-	return kb.writeLedgerKey(name, pub, *hdPath), nil
+	return kb.writeLedgerKey(name, pub, *hdPath, newKeyMetadata(hdPath.String(), algo)), nil
 }
 
 // CreateOffline creates a new reference to an offline keypair. It returns the
 // created key info.
 func (kb dbKeybase) CreateOffline(name string, pub tmcrypto.PubKey) (Info, error) {
-	return kb.writeOfflineKey(name, pub), nil
+	return kb.writeOfflineKey(name, pub, newKeyMetadata("", pubKeyAlgo(pub))), nil
 }
 
 // CreateMulti creates a new reference to a multisig (offline) keypair. It
 // returns the created key info.
 func (kb dbKeybase) CreateMulti(name string, pub tmcrypto.PubKey) (Info, error) {
-	return kb.writeMultisigKey(name, pub), nil
+	return kb.writeMultisigKey(name, pub, newKeyMetadata("", "")), nil
+}
+
+// CreateWatchOnly creates a new reference to a watch-only account by its address. It returns the created key info.
+func (kb dbKeybase) CreateWatchOnly(name string, address types.AccAddress) (Info, error) {
+	if address.Empty() {
+		return nil, errors.New("failed. empty address of the watch-only key")
+	}
+	return kb.writeWatchKey(name, address, newKeyMetadata("", "")), nil
 }
 
 func (kb *dbKeybase) persistDerivedKey(seed []byte, passwd, name, fullHdPath string) (info Info, err error) {
@@ -168,11 +177,12 @@ func (kb *dbKeybase) persistDerivedKey(seed []byte, passwd, name, fullHdPath str
 
 	// if we have a password, use it to encrypt the private key and store it
 	// else store the public key only
+	meta := newKeyMetadata(fullHdPath, Secp256k1)
 	if passwd != "" {
-		info = kb.writeLocalKey(name, secp256k1.PrivKeySecp256k1(derivedPriv), passwd)
+		info = kb.writeLocalKey(name, secp256k1.PrivKeySecp256k1(derivedPriv), passwd, meta)
 	} else {
 		pubk := secp256k1.PrivKeySecp256k1(derivedPriv).PubKey()
-		info = kb.writeOfflineKey(name, pubk)
+		info = kb.writeOfflineKey(name, pubk, meta)
 	}
 	return
 }
@@ -268,6 +278,9 @@ func (kb dbKeybase) Sign(name, passphrase string, msg []byte) (sig []byte, pub t
 		}
 
 		return sig, info.GetPubKey(), nil
+
+	case watchInfo:
+		return nil, nil, fmt.Errorf("failed. key %s is watch-only without the public key to sign with", name)
 	}
 
 	sig, err = priv.Sign(msg)
@@ -299,7 +312,7 @@ func (kb dbKeybase) ExportPrivateKeyObject(name string, passphrase string) (tmcr
 			return nil, err
 		}
 
-	case ledgerInfo, offlineInfo, multiInfo:
+	case ledgerInfo, offlineInfo, multiInfo, watchInfo:
 		return nil, errors.New("only works on local private keys")
 	}
 
@@ -326,6 +339,9 @@ func (kb dbKeybase) ExportPubKey(name string) (armor string, err error) {
 	if err != nil {
 		return
 	}
+	if info.GetPubKey() == nil {
+		return "", fmt.Errorf("failed. the public key of the watch-only key %s is unknown", name)
+	}
 	return mintkey.ArmorPubKeyBytes(info.GetPubKey().Bytes()), nil
 }
 
@@ -358,7 +374,7 @@ func (kb dbKeybase) ImportPubKey(name string, armor string) (err error) {
 	if err != nil {
 		return
 	}
-	kb.writeOfflineKey(name, pubKey)
+	kb.writeOfflineKey(name, pubKey, newKeyMetadata("", pubKeyAlgo(pubKey)))
 	return
 }
 
@@ -406,46 +422,79 @@ func (kb dbKeybase) Update(name, oldpass string, getNewpass func() (string, erro
 		if err != nil {
 			return err
 		}
-		kb.writeLocalKey(name, key, newpass)
+		kb.writeLocalKey(name, key, newpass, linfo.Meta)
 		return nil
 	default:
 		return fmt.Errorf("locally stored key required. Received: %v", reflect.TypeOf(info).String())
 	}
 }
 
+// SetLabels replaces the user labels in the metadata of the key, e.g. the purposes of the key shown by a wallet
+func (kb dbKeybase) SetLabels(name string, labels ...string) (Info, error) {
+	info, err := kb.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	meta := info.GetMetadata()
+	meta.Labels = append([]string(nil), labels...)
+	if info, err = withMetadata(info, meta); err != nil {
+		return nil, err
+	}
+	kb.writeInfo(name, info)
+	return info, nil
+}
+
 // CloseDB releases the lock and closes the storage backend.
 func (kb dbKeybase) CloseDB() {
 	kb.db.Close()
 }
 
-func (kb dbKeybase) writeLocalKey(name string, priv tmcrypto.PrivKey, passphrase string) Info {
+func (kb dbKeybase) writeLocalKey(name string, priv tmcrypto.PrivKey, passphrase string, meta KeyMetadata) Info {
 	// encrypt private key using passphrase
 	privArmor := mintkey.EncryptArmorPrivKey(priv, passphrase)
 	// make Info
 	pub := priv.PubKey()
-	info := newLocalInfo(name, pub, privArmor)
+	info := newLocalInfo(name, pub, privArmor, meta)
 	kb.writeInfo(name, info)
 	return info
 }
 
-func (kb dbKeybase) writeLedgerKey(name string, pub tmcrypto.PubKey, path hd.BIP44Params) Info {
-	info := newLedgerInfo(name, pub, path)
+func (kb dbKeybase) writeLedgerKey(name string, pub tmcrypto.PubKey, path hd.BIP44Params, meta KeyMetadata) Info {
+	info := newLedgerInfo(name, pub, path, meta)
 	kb.writeInfo(name, info)
 	return info
 }
 
-func (kb dbKeybase) writeOfflineKey(name string, pub tmcrypto.PubKey) Info {
-	info := newOfflineInfo(name, pub)
+func (kb dbKeybase) writeOfflineKey(name string, pub tmcrypto.PubKey, meta KeyMetadata) Info {
+	info := newOfflineInfo(name, pub, meta)
 	kb.writeInfo(name, info)
 	return info
 }
 
-func (kb dbKeybase) writeMultisigKey(name string, pub tmcrypto.PubKey) Info {
-	info := newMultiInfo(name, pub)
+func (kb dbKeybase) writeWatchKey(name string, address types.AccAddress, meta KeyMetadata) Info {
+	info := newWatchInfo(name, address, meta)
 	kb.writeInfo(name, info)
 	return info
 }
 
+func (kb dbKeybase) writeMultisigKey(name string, pub tmcrypto.PubKey, meta KeyMetadata) Info {
+	info := newMultiInfo(name, pub, meta)
+	kb.writeInfo(name, info)
+	return info
+}
+
+// pubKeyAlgo tells the signing algo of the public key, empty if it's neither secp256k1 nor ed25519
+func pubKeyAlgo(pub tmcrypto.PubKey) SigningAlgo {
+	switch pub.(type) {
+	case secp256k1.PubKeySecp256k1:
+		return Secp256k1
+	case ed25519.PubKeyEd25519:
+		return Ed25519
+	default:
+		return ""
+	}
+}
+
 func (kb dbKeybase) writeInfo(name string, info Info) {
 	// write the info by key
 	key := infoKey(name)
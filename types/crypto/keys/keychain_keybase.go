@@ -0,0 +1,213 @@
+package keys
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"runtime"
+	"sync"
+
+	"github.com/pkg/errors"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// backends of the keybase selected by NewKeybase, which mirror the keyring backends of cosmos-sdk
+const (
+	// BackendMemory keeps the keys in memory for the process lifetime
+	BackendMemory = "memory"
+	// BackendFile keeps the keys in a keystore file of a dir
+	BackendFile = "file"
+	// BackendOS keeps the keys in the credential store of the OS, i.e. the macOS Keychain, the Windows Credential
+	// Manager or the secret service of Linux
+	BackendOS = "os"
+	// BackendKeychain keeps the keys in the macOS Keychain by the security tool
+	BackendKeychain = "keychain"
+	// BackendSecretService keeps the keys in the secret service of Linux, e.g. the GNOME Keyring or the KWallet, by
+	// the secret-tool of libsecret
+	BackendSecretService = "secret-service"
+	// BackendWinCred keeps the keys in the Windows Credential Manager
+	BackendWinCred = "wincred"
+
+	// DefaultKeychainService is the service name of the keys in the OS credential store by default
+	DefaultKeychainService = "okchain-go-sdk"
+)
+
+// keychainIndexAccount is the account of the item listing the accounts of the entries of a service
+const keychainIndexAccount = "keys.index"
+
+// errSecretNotFound is raised by a secret store when the item is missing
+var errSecretNotFound = errors.New("secret not found")
+
+// secretStore shows the expected behavior of an OS credential store keeping the secrets by the service and the account
+type secretStore interface {
+	// get returns errSecretNotFound if the item is missing
+	get(service, account string) ([]byte, error)
+	set(service, account string, secret []byte) error
+	// remove does nothing if the item is missing
+	remove(service, account string) error
+}
+
+// NewKeybase creates a keybase on the backend, where the service names the keys in the OS credential stores,
+// DefaultKeychainService if empty, and the dir holds the keystore file of the file backend
+func NewKeybase(backend, service, dir string) (Keybase, error) {
+	switch backend {
+	case BackendMemory:
+		return NewInMemory(), nil
+	case BackendFile:
+		return NewFileKeybase(dir)
+	default:
+		return NewKeychainKeybase(backend, service)
+	}
+}
+
+// NewKeychainKeybase creates a keybase on the OS credential store of the backend, i.e. BackendOS, BackendKeychain,
+// BackendSecretService or BackendWinCred, so that the desktop tools keep the keys encrypted by the password in the
+// store of the OS instead of a bespoke file. The keys are named by the service, DefaultKeychainService if empty
+func NewKeychainKeybase(backend, service string) (Keybase, error) {
+	if backend == BackendOS {
+		switch runtime.GOOS {
+		case "darwin":
+			backend = BackendKeychain
+		case "windows":
+			backend = BackendWinCred
+		default:
+			backend = BackendSecretService
+		}
+	}
+
+	var store secretStore
+	switch backend {
+	case BackendKeychain:
+		store = newMacKeychainStore()
+	case BackendSecretService:
+		store = newSecretServiceStore()
+	case BackendWinCred:
+		var err error
+		if store, err = newWinCredStore(); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("failed. unknown keybase backend: %s", backend)
+	}
+
+	if len(service) == 0 {
+		service = DefaultKeychainService
+	}
+	ks := &keychainKeystore{service: service, store: store}
+	if err := ks.view(func(dbKeybase) error { return nil }); err != nil {
+		return nil, err
+	}
+	return keystoreKeybase{ks}, nil
+}
+
+var _ keystore = (*keychainKeystore)(nil)
+
+// keychainKeystore keeps each record of the keys as an item of the service in the OS credential store, whose secret
+// is the record encoded in base64, and an index item listing the records in json encoded in base64 as well, since the
+// macOS keychain takes no quotes in the secrets. The mutations are serialized in the process only, since the credential
+// stores have no lock shared by the processes
+type keychainKeystore struct {
+	service string
+	store   secretStore
+	mtx     sync.Mutex
+}
+
+// view runs the read on the latest keys
+func (ks *keychainKeystore) view(fn func(dbKeybase) error) error {
+	ks.mtx.Lock()
+	defer ks.mtx.Unlock()
+
+	db, _, err := ks.load()
+	if err != nil {
+		return err
+	}
+	return fn(dbKeybase{db})
+}
+
+// update runs the mutation on the latest keys, and saves the records changed if it succeeds
+func (ks *keychainKeystore) update(fn func(dbKeybase) error) error {
+	ks.mtx.Lock()
+	defer ks.mtx.Unlock()
+
+	db, prev, err := ks.load()
+	if err != nil {
+		return err
+	}
+	if err = fn(dbKeybase{db}); err != nil {
+		return err
+	}
+	return ks.save(db, prev)
+}
+
+// load reads the records listed by the index, and returns the records read as well
+func (ks *keychainKeystore) load() (db dbm.DB, records map[string][]byte, err error) {
+	var accounts []string
+	switch bz, err := ks.store.get(ks.service, keychainIndexAccount); err {
+	case nil:
+		// the index of the earlier versions is the raw json, which is never valid in base64
+		if !json.Valid(bz) {
+			if bz, err = base64.StdEncoding.DecodeString(string(bz)); err != nil {
+				return nil, nil, fmt.Errorf("failed. decode keychain index error: %w", err)
+			}
+		}
+		if err = json.Unmarshal(bz, &accounts); err != nil {
+			return nil, nil, fmt.Errorf("failed. unmarshal keychain index error: %w", err)
+		}
+	case errSecretNotFound:
+	default:
+		return nil, nil, fmt.Errorf("failed. read keychain index error: %w", err)
+	}
+
+	db, records = dbm.NewMemDB(), make(map[string][]byte, len(accounts))
+	for _, account := range accounts {
+		secret, err := ks.store.get(ks.service, account)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed. read keychain item %s error: %w", account, err)
+		}
+		value, err := base64.StdEncoding.DecodeString(string(secret))
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed. decode keychain item %s error: %w", account, err)
+		}
+		db.Set([]byte(account), value)
+		records[account] = value
+	}
+	return db, records, nil
+}
+
+// save writes the records changed and the index before removing the records deleted, so that the index never lists a
+// missing record
+func (ks *keychainKeystore) save(db dbm.DB, prev map[string][]byte) error {
+	var accounts []string
+	iter := db.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		account, value := string(iter.Key()), iter.Value()
+		accounts = append(accounts, account)
+		if prevValue, ok := prev[account]; ok && bytes.Equal(prevValue, value) {
+			continue
+		}
+		secret := []byte(base64.StdEncoding.EncodeToString(value))
+		if err := ks.store.set(ks.service, account, secret); err != nil {
+			return fmt.Errorf("failed. write keychain item %s error: %w", account, err)
+		}
+	}
+
+	index, err := json.Marshal(accounts)
+	if err != nil {
+		return fmt.Errorf("failed. marshal keychain index error: %w", err)
+	}
+	secret := []byte(base64.StdEncoding.EncodeToString(index))
+	if err = ks.store.set(ks.service, keychainIndexAccount, secret); err != nil {
+		return fmt.Errorf("failed. write keychain index error: %w", err)
+	}
+
+	for account := range prev {
+		if !db.Has([]byte(account)) {
+			if err = ks.store.remove(ks.service, account); err != nil {
+				return fmt.Errorf("failed. remove keychain item %s error: %w", account, err)
+			}
+		}
+	}
+	return nil
+}
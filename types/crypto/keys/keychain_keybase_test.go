@@ -0,0 +1,205 @@
+package keys
+
+import (
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// memSecretStore keeps the secrets in memory like an OS credential store
+type memSecretStore map[string][]byte
+
+func (s memSecretStore) get(service, account string) ([]byte, error) {
+	secret, ok := s[service+":"+account]
+	if !ok {
+		return nil, errSecretNotFound
+	}
+	return secret, nil
+}
+
+func (s memSecretStore) set(service, account string, secret []byte) error {
+	s[service+":"+account] = secret
+	return nil
+}
+
+func (s memSecretStore) remove(service, account string) error {
+	delete(s, service+":"+account)
+	return nil
+}
+
+func TestKeychainKeybase(t *testing.T) {
+	store := make(memSecretStore)
+	kb := keystoreKeybase{&keychainKeystore{service: DefaultKeychainService, store: store}}
+	info, mnemonic, err := kb.CreateMnemonic("alice", English, "12345678", Secp256k1)
+	require.NoError(t, err)
+	_, err = kb.CreateAccount("bob", mnemonic, "", "12345678", 0, 1)
+	require.NoError(t, err)
+	// the info and address records of each key with the index
+	require.Len(t, store, 5)
+	require.Contains(t, store, DefaultKeychainService+":alice.info")
+
+	// shared by another keybase on the store
+	otherKb := keystoreKeybase{&keychainKeystore{service: DefaultKeychainService, store: store}}
+	got, err := otherKb.GetByAddress(info.GetAddress())
+	require.NoError(t, err)
+	require.Equal(t, "alice", got.GetName())
+	msg := []byte("keychain key message")
+	sig, pubKey, err := otherKb.Sign("alice", "12345678", msg)
+	require.NoError(t, err)
+	require.True(t, pubKey.VerifyBytes(msg, sig))
+
+	// the records of the key deleted are removed
+	require.NoError(t, otherKb.Delete("bob", "12345678", false))
+	require.Len(t, store, 3)
+	infos, err := kb.List()
+	require.NoError(t, err)
+	require.Len(t, infos, 1)
+
+	// isolated by the service
+	otherKb = keystoreKeybase{&keychainKeystore{service: "other", store: store}}
+	infos, err = otherKb.List()
+	require.NoError(t, err)
+	require.Empty(t, infos)
+
+	_, err = NewKeybase("unknown", "", "")
+	require.Error(t, err)
+}
+
+// fakeRunner records the commands run and serves the outputs by the command names
+type fakeRunner struct {
+	outputs map[string][]byte
+	codes   map[string]int
+	stdins  []string
+	cmds    []string
+}
+
+func (r *fakeRunner) run(stdin []byte, name string, args ...string) ([]byte, int, error) {
+	cmd := strings.Join(append([]string{name}, args...), " ")
+	r.cmds, r.stdins = append(r.cmds, cmd), append(r.stdins, string(stdin))
+	if code := r.codes[args[0]]; code != 0 {
+		return nil, code, errors.New("exit status")
+	}
+	return r.outputs[args[0]], 0, nil
+}
+
+func TestMacKeychainStore(t *testing.T) {
+	runner := &fakeRunner{
+		outputs: map[string][]byte{"find-generic-password": []byte("c2VjcmV0\n")},
+		codes:   map[string]int{"delete-generic-password": macKeychainItemNotFound},
+	}
+	store := macKeychainStore{runner.run}
+
+	secret, err := store.get("svc", "alice.info")
+	require.NoError(t, err)
+	require.Equal(t, "c2VjcmV0", string(secret))
+	require.Equal(t, "security find-generic-password -s svc -a alice.info -w", runner.cmds[0])
+
+	// the secret only in the stdin
+	require.NoError(t, store.set("svc", "alice.info", []byte("c2VjcmV0")))
+	require.Equal(t, "security -i", runner.cmds[1])
+	require.Equal(t, "add-generic-password -U -s \"svc\" -a \"alice.info\" -w \"c2VjcmV0\"\n", runner.stdins[1])
+	require.Error(t, store.set("svc", "\"alice\".info", []byte("c2VjcmV0")))
+
+	// missing items
+	require.NoError(t, store.remove("svc", "alice.info"))
+	runner.codes["find-generic-password"] = macKeychainItemNotFound
+	_, err = store.get("svc", "alice.info")
+	require.Equal(t, errSecretNotFound, err)
+}
+
+// fakeMacKeychain emulates the generic passwords of the login keychain by the security tool of macOS
+type fakeMacKeychain map[string]string
+
+func (k fakeMacKeychain) run(stdin []byte, name string, args ...string) ([]byte, int, error) {
+	switch args[0] {
+	case "find-generic-password":
+		secret, ok := k[args[2]+":"+args[4]]
+		if !ok {
+			return nil, macKeychainItemNotFound, errors.New("exit status 44")
+		}
+		return []byte(secret + "\n"), 0, nil
+	case "delete-generic-password":
+		if _, ok := k[args[2]+":"+args[4]]; !ok {
+			return nil, macKeychainItemNotFound, errors.New("exit status 44")
+		}
+		delete(k, args[2]+":"+args[4])
+		return nil, 0, nil
+	}
+
+	// add-generic-password -U -s "service" -a "account" -w "secret" by the interactive mode
+	parts := strings.Split(string(stdin), "\"")
+	if len(parts) != 7 {
+		return nil, 1, errors.New("exit status 1: invalid command")
+	}
+	k[parts[1]+":"+parts[3]] = parts[5]
+	return nil, 0, nil
+}
+
+func TestNewKeychainKeybase_MacKeychain(t *testing.T) {
+	keychain := make(fakeMacKeychain)
+	defaultRunner = keychain.run
+	defer func() { defaultRunner = runCommand }()
+
+	kb, err := NewKeychainKeybase(BackendKeychain, "svc")
+	require.NoError(t, err)
+	info, mnemonic, err := kb.CreateMnemonic("alice", English, "12345678", Secp256k1)
+	require.NoError(t, err)
+	_, err = kb.CreateAccount("bob", mnemonic, "", "12345678", 0, 1)
+	require.NoError(t, err)
+	// the index is encoded in base64 like the records
+	require.Len(t, keychain, 5)
+	require.NotContains(t, keychain["svc:"+keychainIndexAccount], "\"")
+
+	// read again by another keybase on the keychain
+	otherKb, err := NewKeychainKeybase(BackendKeychain, "svc")
+	require.NoError(t, err)
+	got, err := otherKb.GetByAddress(info.GetAddress())
+	require.NoError(t, err)
+	require.Equal(t, "alice", got.GetName())
+	msg := []byte("keychain key message")
+	sig, pubKey, err := otherKb.Sign("alice", "12345678", msg)
+	require.NoError(t, err)
+	require.True(t, pubKey.VerifyBytes(msg, sig))
+
+	require.NoError(t, otherKb.Delete("bob", "12345678", false))
+	require.Len(t, keychain, 3)
+	infos, err := kb.List()
+	require.NoError(t, err)
+	require.Len(t, infos, 1)
+}
+
+func TestKeychainKeybase_RawIndex(t *testing.T) {
+	store := make(memSecretStore)
+	ks := &keychainKeystore{service: DefaultKeychainService, store: store}
+	kb := keystoreKeybase{ks}
+	_, _, err := kb.CreateMnemonic("alice", English, "12345678", Secp256k1)
+	require.NoError(t, err)
+
+	// the raw json index of the earlier versions
+	index, err := base64.StdEncoding.DecodeString(string(store[DefaultKeychainService+":"+keychainIndexAccount]))
+	require.NoError(t, err)
+	store[DefaultKeychainService+":"+keychainIndexAccount] = index
+	infos, err := kb.List()
+	require.NoError(t, err)
+	require.Len(t, infos, 1)
+	require.Equal(t, "alice", infos[0].GetName())
+}
+
+func TestSecretServiceStore(t *testing.T) {
+	runner := &fakeRunner{codes: map[string]int{"lookup": secretServiceItemNotFound}}
+	store := secretServiceStore{runner.run}
+
+	_, err := store.get("svc", "alice.info")
+	require.Equal(t, errSecretNotFound, err)
+	require.Equal(t, "secret-tool lookup service svc account alice.info", runner.cmds[0])
+
+	require.NoError(t, store.set("svc", "alice.info", []byte("c2VjcmV0")))
+	require.Equal(t, "secret-tool store --label svc alice.info service svc account alice.info", runner.cmds[1])
+	require.Equal(t, "c2VjcmV0", runner.stdins[1])
+
+	require.NoError(t, store.remove("svc", "alice.info"))
+	require.Equal(t, "secret-tool clear service svc account alice.info", runner.cmds[2])
+}
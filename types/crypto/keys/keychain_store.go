@@ -0,0 +1,121 @@
+package keys
+
+import (
+	"bytes"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+const (
+	// macKeychainItemNotFound is the exit code of the security tool when the item is missing, i.e. errSecItemNotFound
+	macKeychainItemNotFound = 44
+	// secretServiceItemNotFound is the exit code of the secret-tool lookup when the item is missing
+	secretServiceItemNotFound = 1
+)
+
+// commandRunner runs the command with the stdin, and returns the stdout and the exit code. The error is only nil if
+// the command exits with 0
+type commandRunner func(stdin []byte, name string, args ...string) (stdout []byte, code int, err error)
+
+// defaultRunner runs the commands of the credential stores of the OS, which the tests replace by the fake ones
+var defaultRunner commandRunner = runCommand
+
+func runCommand(stdin []byte, name string, args ...string) ([]byte, int, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command(name, args...)
+	cmd.Stdin = bytes.NewReader(stdin)
+	cmd.Stdout, cmd.Stderr = &stdout, &stderr
+
+	if err := cmd.Run(); err != nil {
+		code := -1
+		if exitErr, ok := err.(*exec.ExitError); ok {
+			code = exitErr.ExitCode()
+		}
+		if msg := strings.TrimSpace(stderr.String()); len(msg) != 0 {
+			err = fmt.Errorf("%s: %s", err, msg)
+		}
+		return stdout.Bytes(), code, fmt.Errorf("failed. run %s error: %w", name, err)
+	}
+	return stdout.Bytes(), 0, nil
+}
+
+var _ secretStore = macKeychainStore{}
+
+// macKeychainStore keeps the secrets as the generic passwords of the login keychain by the security tool of macOS.
+// The passwords are written by the interactive mode reading the commands from the stdin, so that the secrets never
+// show in the command line
+type macKeychainStore struct {
+	run commandRunner
+}
+
+func newMacKeychainStore() macKeychainStore {
+	return macKeychainStore{defaultRunner}
+}
+
+func (s macKeychainStore) get(service, account string) ([]byte, error) {
+	stdout, code, err := s.run(nil, "security", "find-generic-password", "-s", service, "-a", account, "-w")
+	if code == macKeychainItemNotFound {
+		return nil, errSecretNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return bytes.TrimRight(stdout, "\n"), nil
+}
+
+func (s macKeychainStore) set(service, account string, secret []byte) error {
+	for _, arg := range []string{service, account, string(secret)} {
+		if strings.ContainsAny(arg, "\"\\\n") {
+			return fmt.Errorf("failed. quotes, backslashes or line breaks unsupported by the macOS keychain: %q", arg)
+		}
+	}
+
+	command := fmt.Sprintf("add-generic-password -U -s \"%s\" -a \"%s\" -w \"%s\"\n", service, account, secret)
+	_, _, err := s.run([]byte(command), "security", "-i")
+	return err
+}
+
+func (s macKeychainStore) remove(service, account string) error {
+	_, code, err := s.run(nil, "security", "delete-generic-password", "-s", service, "-a", account)
+	if code == macKeychainItemNotFound {
+		return nil
+	}
+	return err
+}
+
+var _ secretStore = secretServiceStore{}
+
+// secretServiceStore keeps the secrets in the default collection of the secret service of Linux by the secret-tool of
+// libsecret, with the attributes service and account. The secrets are written through the stdin
+type secretServiceStore struct {
+	run commandRunner
+}
+
+func newSecretServiceStore() secretServiceStore {
+	return secretServiceStore{defaultRunner}
+}
+
+func (s secretServiceStore) get(service, account string) ([]byte, error) {
+	stdout, code, err := s.run(nil, "secret-tool", "lookup", "service", service, "account", account)
+	// the lookup exits with 1 and prints nothing for a missing item
+	if code == secretServiceItemNotFound && len(stdout) == 0 {
+		return nil, errSecretNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return stdout, nil
+}
+
+func (s secretServiceStore) set(service, account string, secret []byte) error {
+	_, _, err := s.run(secret, "secret-tool", "store", "--label", service+" "+account, "service", service, "account",
+		account)
+	return err
+}
+
+func (s secretServiceStore) remove(service, account string) error {
+	// the clear exits with 0 for a missing item
+	_, _, err := s.run(nil, "secret-tool", "clear", "service", service, "account", account)
+	return err
+}
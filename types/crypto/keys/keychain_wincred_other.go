@@ -0,0 +1,11 @@
+//go:build !windows
+// +build !windows
+
+package keys
+
+import "errors"
+
+// newWinCredStore fails out of Windows
+func newWinCredStore() (secretStore, error) {
+	return nil, errors.New("failed. the Windows Credential Manager is only available on Windows")
+}
@@ -0,0 +1,119 @@
+//go:build windows
+// +build windows
+
+package keys
+
+import (
+	"syscall"
+	"unsafe"
+)
+
+const (
+	credTypeGeneric          = 1
+	credPersistLocalMachine  = 2
+	errorNotFound            = syscall.Errno(1168)
+	credMaxCredentialBlobLen = 5 * 512
+)
+
+var (
+	modadvapi32     = syscall.NewLazyDLL("advapi32.dll")
+	procCredReadW   = modadvapi32.NewProc("CredReadW")
+	procCredWriteW  = modadvapi32.NewProc("CredWriteW")
+	procCredDeleteW = modadvapi32.NewProc("CredDeleteW")
+	procCredFree    = modadvapi32.NewProc("CredFree")
+)
+
+// credential is the CREDENTIALW structure of wincred.h
+type credential struct {
+	Flags              uint32
+	Type               uint32
+	TargetName         *uint16
+	Comment            *uint16
+	LastWritten        syscall.Filetime
+	CredentialBlobSize uint32
+	CredentialBlob     *byte
+	Persist            uint32
+	AttributeCount     uint32
+	Attributes         uintptr
+	TargetAlias        *uint16
+	UserName           *uint16
+}
+
+var _ secretStore = winCredStore{}
+
+// winCredStore keeps the secrets as the generic credentials of the Windows Credential Manager, targeted by
+// "{service}:{account}"
+type winCredStore struct{}
+
+func newWinCredStore() (secretStore, error) {
+	if err := modadvapi32.Load(); err != nil {
+		return nil, err
+	}
+	return winCredStore{}, nil
+}
+
+func (winCredStore) get(service, account string) ([]byte, error) {
+	target, err := syscall.UTF16PtrFromString(service + ":" + account)
+	if err != nil {
+		return nil, err
+	}
+
+	var cred *credential
+	r, _, err := procCredReadW.Call(uintptr(unsafe.Pointer(target)), credTypeGeneric, 0,
+		uintptr(unsafe.Pointer(&cred)))
+	if r == 0 {
+		if err == errorNotFound {
+			return nil, errSecretNotFound
+		}
+		return nil, err
+	}
+	defer procCredFree.Call(uintptr(unsafe.Pointer(cred)))
+
+	secret := make([]byte, cred.CredentialBlobSize)
+	if cred.CredentialBlobSize != 0 {
+		copy(secret, (*[credMaxCredentialBlobLen]byte)(unsafe.Pointer(cred.CredentialBlob))[:cred.CredentialBlobSize])
+	}
+	return secret, nil
+}
+
+func (winCredStore) set(service, account string, secret []byte) error {
+	if len(secret) > credMaxCredentialBlobLen {
+		return syscall.Errno(syscall.ERROR_INSUFFICIENT_BUFFER)
+	}
+	target, err := syscall.UTF16PtrFromString(service + ":" + account)
+	if err != nil {
+		return err
+	}
+	userName, err := syscall.UTF16PtrFromString(account)
+	if err != nil {
+		return err
+	}
+
+	cred := credential{
+		Type:               credTypeGeneric,
+		TargetName:         target,
+		CredentialBlobSize: uint32(len(secret)),
+		Persist:            credPersistLocalMachine,
+		UserName:           userName,
+	}
+	if len(secret) != 0 {
+		cred.CredentialBlob = &secret[0]
+	}
+	if r, _, err := procCredWriteW.Call(uintptr(unsafe.Pointer(&cred)), 0); r == 0 {
+		return err
+	}
+	return nil
+}
+
+func (winCredStore) remove(service, account string) error {
+	target, err := syscall.UTF16PtrFromString(service + ":" + account)
+	if err != nil {
+		return err
+	}
+
+	if r, _, err := procCredDeleteW.Call(uintptr(unsafe.Pointer(target)), credTypeGeneric, 0); r == 0 &&
+		err != errorNotFound {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,193 @@
+package keys
+
+import (
+	"github.com/okex/okchain-go-sdk/types"
+	"github.com/okex/okchain-go-sdk/types/crypto/keys/hd"
+	tmcrypto "github.com/tendermint/tendermint/crypto"
+)
+
+var _ Keybase = keystoreKeybase{}
+
+// keystore shows the expected behavior of the persistent storage of the keys, which loads the latest keys for every
+// access and saves them after every mutation succeeded
+type keystore interface {
+	// view runs the read on the latest keys
+	view(fn func(dbKeybase) error) error
+	// update runs the mutation on the latest keys, and saves the keys if it succeeds
+	update(fn func(dbKeybase) error) error
+}
+
+// keystoreKeybase runs the operations of the keybase on the keys of a keystore, e.g. a keystore file or the OS keychain
+type keystoreKeybase struct {
+	keystore
+}
+
+// List returns the keys in the keystore
+func (kb keystoreKeybase) List() (infos []Info, err error) {
+	err = kb.view(func(db dbKeybase) error {
+		infos, err = db.List()
+		return err
+	})
+	return
+}
+
+// Get returns the key of the name in the keystore
+func (kb keystoreKeybase) Get(name string) (info Info, err error) {
+	err = kb.view(func(db dbKeybase) error {
+		info, err = db.Get(name)
+		return err
+	})
+	return
+}
+
+// GetByAddress returns the key of the address in the keystore
+func (kb keystoreKeybase) GetByAddress(address types.AccAddress) (info Info, err error) {
+	err = kb.view(func(db dbKeybase) error {
+		info, err = db.GetByAddress(address)
+		return err
+	})
+	return
+}
+
+// Delete removes the key from the keystore
+func (kb keystoreKeybase) Delete(name, passphrase string, skipPass bool) error {
+	return kb.update(func(db dbKeybase) error {
+		return db.Delete(name, passphrase, skipPass)
+	})
+}
+
+// Sign signs the msg with the key in the keystore
+func (kb keystoreKeybase) Sign(name, passphrase string, msg []byte) (sig []byte, pub tmcrypto.PubKey, err error) {
+	err = kb.view(func(db dbKeybase) error {
+		sig, pub, err = db.Sign(name, passphrase, msg)
+		return err
+	})
+	return
+}
+
+// CreateMnemonic generates a new key and writes it to the keystore
+func (kb keystoreKeybase) CreateMnemonic(name string, language Language, passwd string, algo SigningAlgo) (info Info,
+	mnemonic string, err error) {
+	err = kb.update(func(db dbKeybase) error {
+		info, mnemonic, err = db.CreateMnemonic(name, language, passwd, algo)
+		return err
+	})
+	return
+}
+
+// CreateAccount converts a mnemonic to a private key and writes it to the keystore
+func (kb keystoreKeybase) CreateAccount(name, mnemonic, bip39Passwd, encryptPasswd string, account uint32,
+	index uint32) (info Info, err error) {
+	err = kb.update(func(db dbKeybase) error {
+		info, err = db.CreateAccount(name, mnemonic, bip39Passwd, encryptPasswd, account, index)
+		return err
+	})
+	return
+}
+
+// Derive computes the private key by the BIP44 params from the mnemonic and writes it to the keystore
+func (kb keystoreKeybase) Derive(name, mnemonic, bip39Passwd, encryptPasswd string, params hd.BIP44Params) (info Info,
+	err error) {
+	err = kb.update(func(db dbKeybase) error {
+		info, err = db.Derive(name, mnemonic, bip39Passwd, encryptPasswd, params)
+		return err
+	})
+	return
+}
+
+// CreateLedger writes a new reference to a Ledger keypair to the keystore
+func (kb keystoreKeybase) CreateLedger(name string, algo SigningAlgo, hrp string, account, index uint32) (info Info,
+	err error) {
+	err = kb.update(func(db dbKeybase) error {
+		info, err = db.CreateLedger(name, algo, hrp, account, index)
+		return err
+	})
+	return
+}
+
+// CreateOffline writes a new reference to an offline keypair to the keystore
+func (kb keystoreKeybase) CreateOffline(name string, pubkey tmcrypto.PubKey) (info Info, err error) {
+	err = kb.update(func(db dbKeybase) error {
+		info, err = db.CreateOffline(name, pubkey)
+		return err
+	})
+	return
+}
+
+// CreateWatchOnly writes a new reference to a watch-only account to the keystore
+func (kb keystoreKeybase) CreateWatchOnly(name string, address types.AccAddress) (info Info, err error) {
+	err = kb.update(func(db dbKeybase) error {
+		info, err = db.CreateWatchOnly(name, address)
+		return err
+	})
+	return
+}
+
+// CreateMulti writes a new reference to a multisig (offline) keypair to the keystore
+func (kb keystoreKeybase) CreateMulti(name string, pubkey tmcrypto.PubKey) (info Info, err error) {
+	err = kb.update(func(db dbKeybase) error {
+		info, err = db.CreateMulti(name, pubkey)
+		return err
+	})
+	return
+}
+
+// Update changes the passphrase of the key in the keystore
+func (kb keystoreKeybase) Update(name, oldpass string, getNewpass func() (string, error)) error {
+	return kb.update(func(db dbKeybase) error {
+		return db.Update(name, oldpass, getNewpass)
+	})
+}
+
+// Import writes the encrypted key by its armor to the keystore
+func (kb keystoreKeybase) Import(name string, armor string) error {
+	return kb.update(func(db dbKeybase) error {
+		return db.Import(name, armor)
+	})
+}
+
+// ImportPubKey writes the public key by its armor to the keystore
+func (kb keystoreKeybase) ImportPubKey(name string, armor string) error {
+	return kb.update(func(db dbKeybase) error {
+		return db.ImportPubKey(name, armor)
+	})
+}
+
+// Export returns the armor of the key in the keystore
+func (kb keystoreKeybase) Export(name string) (armor string, err error) {
+	err = kb.view(func(db dbKeybase) error {
+		armor, err = db.Export(name)
+		return err
+	})
+	return
+}
+
+// ExportPubKey returns the armor of the public key in the keystore
+func (kb keystoreKeybase) ExportPubKey(name string) (armor string, err error) {
+	err = kb.view(func(db dbKeybase) error {
+		armor, err = db.ExportPubKey(name)
+		return err
+	})
+	return
+}
+
+// ExportPrivateKeyObject returns the private key in the keystore decrypted by the passphrase
+func (kb keystoreKeybase) ExportPrivateKeyObject(name string, passphrase string) (priv tmcrypto.PrivKey, err error) {
+	err = kb.view(func(db dbKeybase) error {
+		priv, err = db.ExportPrivateKeyObject(name, passphrase)
+		return err
+	})
+	return
+}
+
+// SetLabels replaces the user labels of the key in the keystore
+func (kb keystoreKeybase) SetLabels(name string, labels ...string) (info Info, err error) {
+	err = kb.update(func(db dbKeybase) error {
+		info, err = db.SetLabels(name, labels...)
+		return err
+	})
+	return
+}
+
+// CloseDB does nothing since the keystore is only accessed during each operation
+func (keystoreKeybase) CloseDB() {}
@@ -16,9 +16,10 @@ import (
 )
 
 const (
-	blockTypePrivKey = "TENDERMINT PRIVATE KEY"
-	blockTypeKeyInfo = "TENDERMINT KEY INFO"
-	blockTypePubKey  = "TENDERMINT PUBLIC KEY"
+	blockTypePrivKey  = "TENDERMINT PRIVATE KEY"
+	blockTypeKeyInfo  = "TENDERMINT KEY INFO"
+	blockTypePubKey   = "TENDERMINT PUBLIC KEY"
+	blockTypeMnemonic = "OKCHAIN MNEMONIC"
 )
 
 // BcryptSecurityParameter - Make bcrypt security parameter var, so it can be changed within the lcd test
@@ -153,3 +154,50 @@ func decryptPrivKey(saltBytes []byte, encBytes []byte, passphrase string) (privK
 	privKey, err = cryptoAmino.PrivKeyFromBytes(privKeyBytes)
 	return privKey, err
 }
+
+// EncryptArmorMnemonic encrypts and armors the mnemonic like the private key, whose key is derived from the passphrase
+// by bcrypt with a random salt
+func EncryptArmorMnemonic(mnemonic, passphrase string) (string, error) {
+	saltBytes := crypto.CRandBytes(16)
+	key, err := bcrypt.GenerateFromPassword(saltBytes, []byte(passphrase), BcryptSecurityParameter)
+	if err != nil {
+		return "", fmt.Errorf("error generating bcrypt key from passphrase: %v", err)
+	}
+	key = crypto.Sha256(key) // get 32 bytes
+	header := map[string]string{
+		"kdf":  "bcrypt",
+		"salt": fmt.Sprintf("%X", saltBytes),
+	}
+	return armor.EncodeArmor(blockTypeMnemonic, header, xsalsa20symmetric.EncryptSymmetric([]byte(mnemonic), key)), nil
+}
+
+// UnarmorDecryptMnemonic unarmors and decrypts the mnemonic
+func UnarmorDecryptMnemonic(armorStr, passphrase string) (string, error) {
+	blockType, header, encBytes, err := armor.DecodeArmor(armorStr)
+	if err != nil {
+		return "", err
+	}
+	if blockType != blockTypeMnemonic {
+		return "", fmt.Errorf("unrecognized armor type: %v", blockType)
+	}
+	if header["kdf"] != "bcrypt" {
+		return "", fmt.Errorf("unrecognized KDF type: %v", header["kdf"])
+	}
+	saltBytes, err := hex.DecodeString(header["salt"])
+	if err != nil || len(saltBytes) == 0 {
+		return "", fmt.Errorf("missing or invalid salt bytes: %s", header["salt"])
+	}
+
+	key, err := bcrypt.GenerateFromPassword(saltBytes, []byte(passphrase), BcryptSecurityParameter)
+	if err != nil {
+		return "", fmt.Errorf("error generating bcrypt key from passphrase: %v", err)
+	}
+	key = crypto.Sha256(key) // get 32 bytes
+	mnemonicBytes, err := xsalsa20symmetric.DecryptSymmetric(encBytes, key)
+	if err != nil && err.Error() == "Ciphertext decryption failed" {
+		return "", keyerror.NewErrWrongPassword()
+	} else if err != nil {
+		return "", err
+	}
+	return string(mnemonicBytes), nil
+}
@@ -0,0 +1,43 @@
+package keys
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/cosmos/go-bip39"
+	"github.com/okex/okchain-go-sdk/types/crypto/keys/mintkey"
+)
+
+// ErrInvalidMnemonic is raised when the mnemonic exported or imported fails the BIP39 checksum
+var ErrInvalidMnemonic = errors.New("invalid mnemonic")
+
+// ExportMnemonic encrypts the mnemonic by the passphrase and returns it ASCII-armored, so that a backup is produced
+// without writing the plaintext mnemonic anywhere
+func ExportMnemonic(mnemonic, passphrase string) (armor string, err error) {
+	mnemonic = normalizeMnemonic(mnemonic)
+	if !bip39.IsMnemonicValid(mnemonic) {
+		return "", ErrInvalidMnemonic
+	}
+	if len(passphrase) == 0 {
+		return "", errors.New("failed. empty passphrase to encrypt the mnemonic")
+	}
+
+	return mintkey.EncryptArmorMnemonic(mnemonic, passphrase)
+}
+
+// ImportMnemonic decrypts the mnemonic armored by ExportMnemonic with the passphrase, which is passed to
+// CreateAccount or Derive to restore the keys
+func ImportMnemonic(armor, passphrase string) (mnemonic string, err error) {
+	if mnemonic, err = mintkey.UnarmorDecryptMnemonic(armor, passphrase); err != nil {
+		return
+	}
+	if !bip39.IsMnemonicValid(mnemonic) {
+		return "", ErrInvalidMnemonic
+	}
+	return
+}
+
+// normalizeMnemonic joins the words of the mnemonic by single spaces
+func normalizeMnemonic(mnemonic string) string {
+	return strings.Join(strings.Fields(mnemonic), " ")
+}
@@ -0,0 +1,38 @@
+package keys
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/okex/okchain-go-sdk/types/crypto/keys/keyerror"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportImportMnemonic(t *testing.T) {
+	kb := NewInMemory()
+	info, mnemonic, err := kb.CreateMnemonic("alice", English, "12345678", Secp256k1)
+	require.NoError(t, err)
+
+	armor, err := ExportMnemonic(" "+strings.Replace(mnemonic, " ", "  ", 1)+"\n", "backup passphrase")
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(armor, "-----BEGIN OKCHAIN MNEMONIC-----"))
+	require.NotContains(t, armor, strings.Fields(mnemonic)[0]+" ")
+
+	// restored to the same key
+	imported, err := ImportMnemonic(armor, "backup passphrase")
+	require.NoError(t, err)
+	require.Equal(t, mnemonic, imported)
+	restored, err := NewInMemory().CreateAccount("alice", imported, "", "12345678", 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, info.GetAddress(), restored.GetAddress())
+
+	_, err = ImportMnemonic(armor, "wrong passphrase")
+	require.True(t, keyerror.IsErrWrongPassword(err))
+	_, err = ImportMnemonic("not an armor", "backup passphrase")
+	require.Error(t, err)
+
+	_, err = ExportMnemonic("invalid mnemonic words", "backup passphrase")
+	require.Equal(t, ErrInvalidMnemonic, err)
+	_, err = ExportMnemonic(mnemonic, "")
+	require.Error(t, err)
+}
@@ -2,6 +2,8 @@ package keys
 
 import (
 	"fmt"
+	"time"
+
 	"github.com/okex/okchain-go-sdk/types"
 	"github.com/okex/okchain-go-sdk/types/crypto/keys/hd"
 	"github.com/tendermint/tendermint/crypto"
@@ -41,6 +43,10 @@ type Keybase interface {
 	// CreateMulti creates, stores, and returns a new multsig (offline) key reference
 	CreateMulti(name string, pubkey crypto.PubKey) (info Info, err error)
 
+	// CreateWatchOnly creates, stores, and returns a new watch-only key reference of the address, whose public key is
+	// unknown. Use CreateOffline instead if the public key is known
+	CreateWatchOnly(name string, address types.AccAddress) (info Info, err error)
+
 	// The following operations will *only* work on locally-stored keys
 	Update(name, oldpass string, getNewpass func() (string, error)) error
 	Import(name string, armor string) (err error)
@@ -51,10 +57,21 @@ type Keybase interface {
 	// ExportPrivateKeyObject *only* works on locally-stored keys. Temporary method until we redo the exporting API
 	ExportPrivateKeyObject(name string, passphrase string) (crypto.PrivKey, error)
 
+	// SetLabels replaces the user labels in the metadata of the key
+	SetLabels(name string, labels ...string) (Info, error)
+
 	// CloseDB closes the database.
 	CloseDB()
 }
 
+// HotKeybase exposes the keybase keeping the private keys unencrypted in memory
+type HotKeybase interface {
+	Keybase
+
+	// ImportPrivKey keeps the private key unencrypted in memory with the name
+	ImportPrivKey(name string, priv crypto.PrivKey) Info
+}
+
 // KeyType reflects a human-readable type for key listing.
 type KeyType uint
 
@@ -64,6 +81,7 @@ const (
 	TypeLedger  KeyType = 1
 	TypeOffline KeyType = 2
 	TypeMulti   KeyType = 3
+	TypeWatch   KeyType = 4
 )
 
 var keyTypes = map[KeyType]string{
@@ -71,6 +89,7 @@ var keyTypes = map[KeyType]string{
 	TypeLedger:  "ledger",
 	TypeOffline: "offline",
 	TypeMulti:   "multi",
+	TypeWatch:   "watch",
 }
 
 // String implements the stringer interface for KeyType.
@@ -90,6 +109,35 @@ type Info interface {
 	GetAddress() types.AccAddress
 	// Bip44 Path
 	GetPath() (*hd.BIP44Params, error)
+	// Metadata of where the key came from, which is empty for the keys stored before it was kept
+	GetMetadata() KeyMetadata
+}
+
+// KeyMetadata - structure of the metadata persisted with a key
+type KeyMetadata struct {
+	// Path is the full BIP44 derivation path of the key, empty if it isn't derived, e.g. an imported one
+	Path string      `json:"path,omitempty"`
+	Algo SigningAlgo `json:"algo,omitempty"`
+	// CreatedAt is the unix time of the creation of the key in seconds, 0 if unknown
+	CreatedAt int64    `json:"created_at,omitempty"`
+	Labels    []string `json:"labels,omitempty"`
+}
+
+// newKeyMetadata creates the metadata of a key created now
+func newKeyMetadata(path string, algo SigningAlgo) KeyMetadata {
+	return KeyMetadata{
+		Path:      path,
+		Algo:      algo,
+		CreatedAt: time.Now().Unix(),
+	}
+}
+
+// CreationTime returns the time of the creation of the key, which is zero if unknown
+func (km KeyMetadata) CreationTime() time.Time {
+	if km.CreatedAt == 0 {
+		return time.Time{}
+	}
+	return time.Unix(km.CreatedAt, 0).UTC()
 }
 
 var (
@@ -97,6 +145,7 @@ var (
 	_ Info = &ledgerInfo{}
 	_ Info = &offlineInfo{}
 	_ Info = &multiInfo{}
+	_ Info = &watchInfo{}
 )
 
 // localInfo is the public information about a locally stored key
@@ -104,19 +153,21 @@ type localInfo struct {
 	Name         string        `json:"name"`
 	PubKey       crypto.PubKey `json:"pubkey"`
 	PrivKeyArmor string        `json:"privkey.armor"`
+	Meta         KeyMetadata   `json:"meta"`
 }
 
-func newLocalInfo(name string, pub crypto.PubKey, privArmor string) Info {
+func newLocalInfo(name string, pub crypto.PubKey, privArmor string, meta KeyMetadata) Info {
 	return &localInfo{
 		Name:         name,
 		PubKey:       pub,
 		PrivKeyArmor: privArmor,
+		Meta:         meta,
 	}
 }
 
 // NewLocalInfo creates a new instance of localInfo
 func NewLocalInfo(name string, pub crypto.PubKey, privArmor string) Info {
-	return newLocalInfo(name, pub, privArmor)
+	return newLocalInfo(name, pub, privArmor, KeyMetadata{})
 }
 
 func (i localInfo) GetType() KeyType {
@@ -135,6 +186,10 @@ func (i localInfo) GetAddress() types.AccAddress {
 	return i.PubKey.Address().Bytes()
 }
 
+func (i localInfo) GetMetadata() KeyMetadata {
+	return i.Meta
+}
+
 func (i localInfo) GetPath() (*hd.BIP44Params, error) {
 	return nil, fmt.Errorf("BIP44 Paths are not available for this type")
 }
@@ -144,13 +199,15 @@ type ledgerInfo struct {
 	Name   string         `json:"name"`
 	PubKey crypto.PubKey  `json:"pubkey"`
 	Path   hd.BIP44Params `json:"path"`
+	Meta   KeyMetadata    `json:"meta"`
 }
 
-func newLedgerInfo(name string, pub crypto.PubKey, path hd.BIP44Params) Info {
+func newLedgerInfo(name string, pub crypto.PubKey, path hd.BIP44Params, meta KeyMetadata) Info {
 	return &ledgerInfo{
 		Name:   name,
 		PubKey: pub,
 		Path:   path,
+		Meta:   meta,
 	}
 }
 
@@ -170,6 +227,10 @@ func (i ledgerInfo) GetAddress() types.AccAddress {
 	return i.PubKey.Address().Bytes()
 }
 
+func (i ledgerInfo) GetMetadata() KeyMetadata {
+	return i.Meta
+}
+
 func (i ledgerInfo) GetPath() (*hd.BIP44Params, error) {
 	tmp := i.Path
 	return &tmp, nil
@@ -179,12 +240,14 @@ func (i ledgerInfo) GetPath() (*hd.BIP44Params, error) {
 type offlineInfo struct {
 	Name   string        `json:"name"`
 	PubKey crypto.PubKey `json:"pubkey"`
+	Meta   KeyMetadata   `json:"meta"`
 }
 
-func newOfflineInfo(name string, pub crypto.PubKey) Info {
+func newOfflineInfo(name string, pub crypto.PubKey, meta KeyMetadata) Info {
 	return &offlineInfo{
 		Name:   name,
 		PubKey: pub,
+		Meta:   meta,
 	}
 }
 
@@ -204,6 +267,10 @@ func (i offlineInfo) GetAddress() types.AccAddress {
 	return i.PubKey.Address().Bytes()
 }
 
+func (i offlineInfo) GetMetadata() KeyMetadata {
+	return i.Meta
+}
+
 func (i offlineInfo) GetPath() (*hd.BIP44Params, error) {
 	return nil, fmt.Errorf("BIP44 Paths are not available for this type")
 }
@@ -217,9 +284,10 @@ type multiInfo struct {
 	PubKey    crypto.PubKey        `json:"pubkey"`
 	Threshold uint                 `json:"threshold"`
 	PubKeys   []multisigPubKeyInfo `json:"pubkeys"`
+	Meta      KeyMetadata          `json:"meta"`
 }
 
-func newMultiInfo(name string, pub crypto.PubKey) Info {
+func newMultiInfo(name string, pub crypto.PubKey, meta KeyMetadata) Info {
 	multiPK := pub.(multisig.PubKeyMultisigThreshold)
 
 	pubKeys := make([]multisigPubKeyInfo, len(multiPK.PubKeys))
@@ -233,6 +301,7 @@ func newMultiInfo(name string, pub crypto.PubKey) Info {
 		PubKey:    pub,
 		Threshold: multiPK.K,
 		PubKeys:   pubKeys,
+		Meta:      meta,
 	}
 }
 
@@ -252,10 +321,89 @@ func (i multiInfo) GetAddress() types.AccAddress {
 	return i.PubKey.Address().Bytes()
 }
 
+func (i multiInfo) GetMetadata() KeyMetadata {
+	return i.Meta
+}
+
 func (i multiInfo) GetPath() (*hd.BIP44Params, error) {
 	return nil, fmt.Errorf("BIP44 Paths are not available for this type")
 }
 
+// watchInfo is the public information about a watch-only account known by its address only, whose public key is
+// unknown until its first tx is on chain
+type watchInfo struct {
+	Name    string           `json:"name"`
+	Address types.AccAddress `json:"address"`
+	Meta    KeyMetadata      `json:"meta"`
+}
+
+func newWatchInfo(name string, address types.AccAddress, meta KeyMetadata) Info {
+	return &watchInfo{
+		Name:    name,
+		Address: address,
+		Meta:    meta,
+	}
+}
+
+func (i watchInfo) GetType() KeyType {
+	return TypeWatch
+}
+
+func (i watchInfo) GetName() string {
+	return i.Name
+}
+
+// GetPubKey returns nil for the public key is unknown
+func (i watchInfo) GetPubKey() crypto.PubKey {
+	return nil
+}
+
+func (i watchInfo) GetAddress() types.AccAddress {
+	return i.Address
+}
+
+func (i watchInfo) GetMetadata() KeyMetadata {
+	return i.Meta
+}
+
+func (i watchInfo) GetPath() (*hd.BIP44Params, error) {
+	return nil, fmt.Errorf("BIP44 Paths are not available for this type")
+}
+
+// IsWatchOnly tells whether the key is of a watch-only account holding no private key, i.e. an offline key known by
+// its public key or a watch key known by its address, whose txs are built unsigned for an external signer
+func IsWatchOnly(info Info) bool {
+	switch info.GetType() {
+	case TypeOffline, TypeWatch:
+		return true
+	default:
+		return false
+	}
+}
+
+// withMetadata returns a copy of the info decoded with the metadata replaced
+func withMetadata(info Info, meta KeyMetadata) (Info, error) {
+	switch i := info.(type) {
+	case localInfo:
+		i.Meta = meta
+		return i, nil
+	case ledgerInfo:
+		i.Meta = meta
+		return i, nil
+	case offlineInfo:
+		i.Meta = meta
+		return i, nil
+	case multiInfo:
+		i.Meta = meta
+		return i, nil
+	case watchInfo:
+		i.Meta = meta
+		return i, nil
+	default:
+		return nil, fmt.Errorf("failed. unknown key info type %T", info)
+	}
+}
+
 // encoding info
 func writeInfo(i Info) []byte {
 	return cdc.MustMarshalBinaryLengthPrefixed(i)
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"errors"
 	"fmt"
 	"regexp"
 	"sort"
@@ -59,6 +60,31 @@ func (coin DecCoin) Add(coinB DecCoin) DecCoin {
 	return DecCoin{coin.Denom, coin.Amount.Add(coinB.Amount)}
 }
 
+// Sub subtracts the amount of the coin with the same denom, which panics if the denoms differ or the result is negative
+func (coin DecCoin) Sub(coinB DecCoin) DecCoin {
+	if coin.Denom != coinB.Denom {
+		panic(fmt.Sprintf("coin denom different: %v %v\n", coin.Denom, coinB.Denom))
+	}
+	res := DecCoin{coin.Denom, coin.Amount.Sub(coinB.Amount)}
+	if res.IsNegative() {
+		panic("negative decimal coin amount")
+	}
+	return res
+}
+
+// IsLT returns true if the amount of the coin is less than the one of the coin with the same denom
+func (coin DecCoin) IsLT(coinB DecCoin) bool {
+	if coin.Denom != coinB.Denom {
+		panic(fmt.Sprintf("coin denom different: %v %v\n", coin.Denom, coinB.Denom))
+	}
+	return coin.Amount.LT(coinB.Amount)
+}
+
+// String returns the amount followed by the denom, e.g. "1.00000000okt"
+func (coin DecCoin) String() string {
+	return fmt.Sprintf("%v%v", coin.Amount, coin.Denom)
+}
+
 // DecCoins defines a slice of coins with decimal values
 type DecCoins []DecCoin
 
@@ -136,6 +162,155 @@ func (coins DecCoins) IsZero() bool {
 	return true
 }
 
+// IsEqual returns true if the two sets of DecCoins have the same denoms and amounts, ignoring the zero coins
+// NOTE: IsEqual operates under the invariant that coins are sorted by denominations
+func (coins DecCoins) IsEqual(coinsB DecCoins) bool {
+	nonZeroA, nonZeroB := coins.nonZeroCoins(), coinsB.nonZeroCoins()
+	if len(nonZeroA) != len(nonZeroB) {
+		return false
+	}
+
+	for i := range nonZeroA {
+		if nonZeroA[i].Denom != nonZeroB[i].Denom || !nonZeroA[i].Amount.Equal(nonZeroB[i].Amount) {
+			return false
+		}
+	}
+	return true
+}
+
+// AmountOf returns the amount of the denom in the coins, zero if absent
+func (coins DecCoins) AmountOf(denom string) Dec {
+	for _, coin := range coins {
+		if coin.Denom == denom {
+			return coin.Amount
+		}
+	}
+	return ZeroDec()
+}
+
+// String returns the coins joined by commas, e.g. "1.00000000btc,0.50000000okt"
+func (coins DecCoins) String() string {
+	if len(coins) == 0 {
+		return ""
+	}
+
+	strs := make([]string, len(coins))
+	for i, coin := range coins {
+		strs[i] = coin.String()
+	}
+	return strings.Join(strs, ",")
+}
+
+// Validate checks the DecCoins are sorted, have positive amount and valid denoms, with the reason of the failure
+func (coins DecCoins) Validate() error {
+	for i, coin := range coins {
+		if err := validateDenom(coin.Denom); err != nil {
+			return err
+		}
+		if !coin.IsPositive() {
+			return fmt.Errorf("coin %s amount is not positive", coin)
+		}
+		if i > 0 && coin.Denom <= coins[i-1].Denom {
+			if coin.Denom == coins[i-1].Denom {
+				return fmt.Errorf("duplicate denom: %s", coin.Denom)
+			}
+			return fmt.Errorf("denoms are not sorted: %s after %s", coin.Denom, coins[i-1].Denom)
+		}
+	}
+	return nil
+}
+
+// IsAnyNegative returns true if any coin of the set is negative
+func (coins DecCoins) IsAnyNegative() bool {
+	for _, coin := range coins {
+		if coin.IsNegative() {
+			return true
+		}
+	}
+	return false
+}
+
+// Denoms returns the denoms of the coins in order
+func (coins DecCoins) Denoms() []string {
+	denoms := make([]string, len(coins))
+	for i, coin := range coins {
+		denoms[i] = coin.Denom
+	}
+	return denoms
+}
+
+// FilterDenoms returns the coins of the denoms only, in the order of the coins
+func (coins DecCoins) FilterDenoms(denoms ...string) DecCoins {
+	denomSet := make(map[string]struct{}, len(denoms))
+	for _, denom := range denoms {
+		denomSet[denom] = struct{}{}
+	}
+
+	filtered := make(DecCoins, 0, len(coins))
+	for _, coin := range coins {
+		if _, ok := denomSet[coin.Denom]; ok {
+			filtered = append(filtered, coin)
+		}
+	}
+	return filtered
+}
+
+// Sub subtracts a set of DecCoins from another, which panics if any coin of the result is negative
+// NOTE: Sub operates under the invariant that coins are sorted by denominations
+func (coins DecCoins) Sub(coinsB DecCoins) DecCoins {
+	diff, hasNeg := coins.SafeSub(coinsB)
+	if hasNeg {
+		panic("negative coin amount")
+	}
+	return diff
+}
+
+// SafeSub subtracts a set of DecCoins from another without the zero coins left, and tells whether any coin of the
+// result is negative
+// NOTE: SafeSub operates under the invariant that coins are sorted by denominations
+func (coins DecCoins) SafeSub(coinsB DecCoins) (DecCoins, bool) {
+	negB := make(DecCoins, len(coinsB))
+	for i, coin := range coinsB {
+		negB[i] = DecCoin{coin.Denom, coin.Amount.Neg()}
+	}
+
+	diff := coins.safeAdd(negB)
+	return diff, diff.IsAnyNegative()
+}
+
+// MulDec multiplies the amounts of all the coins by d with the rounding of Dec, without the zero coins left
+func (coins DecCoins) MulDec(d Dec) DecCoins {
+	return coins.mulDec(d, Dec.Mul)
+}
+
+// MulDecTruncate multiplies the amounts of all the coins by d with the extra decimals truncated, without the zero
+// coins left, which never rounds up an amount to spend or to pay
+func (coins DecCoins) MulDecTruncate(d Dec) DecCoins {
+	return coins.mulDec(d, Dec.MulTruncate)
+}
+
+func (coins DecCoins) mulDec(d Dec, mul func(Dec, Dec) Dec) DecCoins {
+	res := make(DecCoins, 0, len(coins))
+	for _, coin := range coins {
+		product := DecCoin{coin.Denom, mul(coin.Amount, d)}
+		if !product.IsZero() {
+			res = append(res, product)
+		}
+	}
+	return res
+}
+
+// nonZeroCoins returns the non-zero coins without mutating the original set
+func (coins DecCoins) nonZeroCoins() DecCoins {
+	nonZeroCoins := make(DecCoins, 0, len(coins))
+	for _, coin := range coins {
+		if !coin.IsZero() {
+			nonZeroCoins = append(nonZeroCoins, coin)
+		}
+	}
+	return nonZeroCoins
+}
+
 // Add adds two sets of DecCoins
 // NOTE: Add operates under the invariant that coins are sorted by
 // denominations.
@@ -244,6 +419,36 @@ func ParseDecCoins(coinsStr string) (DecCoins, error) {
 	return coins, nil
 }
 
+// ParseDecCoinsStrict parses DecCoins from a string of the comma separated coins, which refuses the empty tokens, the
+// duplicate denoms and the zero amounts, and reports the exact offending token with its index in the string
+func ParseDecCoinsStrict(coinsStr string) (DecCoins, error) {
+	if len(strings.TrimSpace(coinsStr)) == 0 {
+		return nil, errors.New("empty decimal coins expression")
+	}
+
+	coinStrs := strings.Split(coinsStr, ",")
+	coins := make(DecCoins, len(coinStrs))
+	denomIndex := make(map[string]int, len(coinStrs))
+	for i, coinStr := range coinStrs {
+		coin, err := ParseDecCoin(coinStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid coin #%d %q: %s", i, coinStr, err)
+		}
+		if !coin.IsPositive() {
+			return nil, fmt.Errorf("invalid coin #%d %q: amount is not positive", i, coinStr)
+		}
+		if j, ok := denomIndex[coin.Denom]; ok {
+			return nil, fmt.Errorf("invalid coin #%d %q: duplicate denom of coin #%d %q", i, coinStr, j,
+				coinStrs[j])
+		}
+
+		denomIndex[coin.Denom] = i
+		coins[i] = coin
+	}
+
+	return coins.Sort(), nil
+}
+
 // ParseDecCoin parses a decimal coin from a string, returning an error if invalid
 // An empty string is considered invalid
 func ParseDecCoin(coinStr string) (coin DecCoin, err error) {
@@ -305,6 +510,11 @@ func mustValidateDenom(denom string) {
 	}
 }
 
+// ValidateDenom checks the denom against the format of the chain, e.g. okt or xxb-123
+func ValidateDenom(denom string) error {
+	return validateDenom(denom)
+}
+
 func validateDenom(denom string) error {
 	if !reDnm.MatchString(denom) {
 		return fmt.Errorf("invalid denom: %s", denom)
@@ -0,0 +1,79 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func mustParseDecCoins(t *testing.T, coinsStr string) DecCoins {
+	coins, err := ParseDecCoins(coinsStr)
+	require.NoError(t, err)
+	return coins
+}
+
+func TestDecCoin_Arithmetic(t *testing.T) {
+	coinA, coinB := NewDecCoinFromDec("okt", NewDecWithPrec(15, 1)), NewDecCoinFromDec("okt", OneDec())
+	require.Equal(t, "0.50000000okt", coinA.Sub(coinB).String())
+	require.True(t, coinB.IsLT(coinA))
+	require.False(t, coinA.IsLT(coinB))
+	require.Panics(t, func() { coinB.Sub(coinA) })
+	require.Panics(t, func() { coinA.Sub(NewDecCoinFromDec("btc", OneDec())) })
+	require.Panics(t, func() { coinA.IsLT(NewDecCoinFromDec("btc", OneDec())) })
+}
+
+func TestDecCoins_Arithmetic(t *testing.T) {
+	coinsA, coinsB := mustParseDecCoins(t, "1btc,2.5okt"), mustParseDecCoins(t, "1btc,0.5okt,3xxb")
+	require.Equal(t, "1.00000000btc,2.50000000okt", coinsA.String())
+	require.Equal(t, "", DecCoins{}.String())
+
+	require.Equal(t, "2.00000000btc,3.00000000okt,3.00000000xxb", coinsA.Add(coinsB).String())
+	require.Equal(t, "2.00000000okt", coinsA.Sub(mustParseDecCoins(t, "1btc,0.5okt")).String())
+
+	diff, hasNeg := coinsA.SafeSub(coinsB)
+	require.True(t, hasNeg)
+	require.Equal(t, "2.00000000okt", diff.FilterDenoms("okt").String())
+	require.Panics(t, func() { coinsA.Sub(coinsB) })
+
+	// rounding
+	third := NewDecWithPrec(33333333, 8)
+	require.Equal(t, "0.83333332okt", mustParseDecCoins(t, "2.5okt").MulDecTruncate(third).String())
+	half := NewDecWithPrec(5, 1)
+	require.Equal(t, "0.00000002okt", mustParseDecCoins(t, "0.00000003okt").MulDec(half).String())
+	require.Equal(t, "0.00000001okt", mustParseDecCoins(t, "0.00000003okt").MulDecTruncate(half).String())
+	require.Empty(t, coinsA.MulDec(ZeroDec()))
+
+	require.Equal(t, []string{"btc", "xxb"}, coinsB.FilterDenoms("xxb", "btc", "eth").Denoms())
+	require.Empty(t, coinsB.FilterDenoms())
+}
+
+func TestDecCoins_Validate(t *testing.T) {
+	require.NoError(t, mustParseDecCoins(t, "1btc,2.5okt").Validate())
+	require.NoError(t, DecCoins{}.Validate())
+
+	okt, btc := NewDecCoinFromDec("okt", OneDec()), NewDecCoinFromDec("btc", OneDec())
+	require.EqualError(t, DecCoins{okt, btc}.Validate(), "denoms are not sorted: btc after okt")
+	require.EqualError(t, DecCoins{okt, okt}.Validate(), "duplicate denom: okt")
+	require.EqualError(t, DecCoins{NewDecCoinFromDec("okt", ZeroDec())}.Validate(),
+		"coin 0.00000000okt amount is not positive")
+	require.Error(t, DecCoins{{Denom: "OKT", Amount: OneDec()}}.Validate())
+}
+
+func TestParseDecCoinsStrict(t *testing.T) {
+	coins, err := ParseDecCoinsStrict("2.5okt, 1btc")
+	require.NoError(t, err)
+	require.Equal(t, "1.00000000btc,2.50000000okt", coins.String())
+
+	for coinsStr, errStr := range map[string]string{
+		"":                 "empty decimal coins expression",
+		"1okt,,1btc":       `invalid coin #1 "": invalid decimal coin expression: `,
+		"1okt,1BTC":        `invalid coin #1 "1BTC": invalid decimal coin expression: 1BTC`,
+		"1okt,0btc":        `invalid coin #1 "0btc": amount is not positive`,
+		"1okt,1btc,2okt":   `invalid coin #2 "2okt": duplicate denom of coin #0 "1okt"`,
+		"1okt,1.2.3btc":    `invalid coin #1 "1.2.3btc": invalid decimal coin expression: 1.2.3btc`,
+		"1okt,1btc,1xxb-1": `invalid coin #2 "1xxb-1": invalid decimal coin expression: 1xxb-1`,
+	} {
+		_, err = ParseDecCoinsStrict(coinsStr)
+		require.EqualError(t, err, errStr, coinsStr)
+	}
+}
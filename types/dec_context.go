@@ -0,0 +1,168 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// RoundingMode decides how the digits beyond the precision are dropped
+type RoundingMode int
+
+// rounding modes, consistent with the operations of Dec
+const (
+	// RoundHalfEven is the bankers rounding, the same as Dec.Mul and Dec.Quo
+	RoundHalfEven RoundingMode = iota
+	// RoundTruncate rounds toward zero, the same as Dec.MulTruncate and Dec.QuoTruncate
+	RoundTruncate
+	// RoundCeiling rounds toward positive infinity, the same as Dec.QuoRoundUp
+	RoundCeiling
+)
+
+// MaxContextPrecision is the maximum intermediate decimal places of DecContext
+const MaxContextPrecision = 36
+
+// DecPrecision returns the fixed decimal places of Dec, which every result of DecContext is rounded to
+func DecPrecision() int64 {
+	return Precision
+}
+
+// DecContext - structure of the math context for the multiplicative chains of Dec, e.g. price × qty × fee rate
+// The intermediate results keep the higher precision of the context and are rounded to Dec only once at the end, which
+// prevents the cumulative rounding drift of the operations of Dec rounding at each step
+type DecContext struct {
+	precision int64
+	rounding  RoundingMode
+	// 10^(precision - Precision) to scale Dec up to the context
+	scale *big.Int
+	// 10^precision
+	unit *big.Int
+}
+
+// NewDecContext creates a new instance of DecContext with the intermediate decimal places in
+// [Precision, MaxContextPrecision] and the rounding mode of the final result
+func NewDecContext(precision int64, rounding RoundingMode) (ctx DecContext, err error) {
+	if precision < Precision || precision > MaxContextPrecision {
+		return ctx, fmt.Errorf("failed. precision of DecContext must be in the range of [%d, %d]", Precision,
+			MaxContextPrecision)
+	}
+	if rounding < RoundHalfEven || rounding > RoundCeiling {
+		return ctx, fmt.Errorf("failed. unknown rounding mode %d", rounding)
+	}
+
+	return DecContext{
+		precision: precision,
+		rounding:  rounding,
+		scale:     new(big.Int).Exp(tenInt, big.NewInt(precision-Precision), nil),
+		unit:      new(big.Int).Exp(tenInt, big.NewInt(precision), nil),
+	}, nil
+}
+
+// Precision returns the intermediate decimal places of the context
+func (ctx DecContext) Precision() int64 {
+	return ctx.precision
+}
+
+// NewDec starts a chain of operations from the Dec in the context
+func (ctx DecContext) NewDec(d Dec) ContextDec {
+	return ContextDec{
+		ctx: ctx,
+		i:   new(big.Int).Mul(d.Int, ctx.scale),
+	}
+}
+
+// Mul multiplies the decimals in the context and rounds the product to Dec once
+func (ctx DecContext) Mul(d Dec, ds ...Dec) Dec {
+	res := ctx.NewDec(d)
+	for _, d2 := range ds {
+		res = res.Mul(d2)
+	}
+	return res.Dec()
+}
+
+// ContextDec - structure of an intermediate decimal with the precision of its DecContext
+type ContextDec struct {
+	ctx DecContext
+	// the value scaled by 10^precision of the context
+	i *big.Int
+}
+
+// Add defines addition in the context
+func (cd ContextDec) Add(d Dec) ContextDec {
+	return cd.AddContextDec(cd.ctx.NewDec(d))
+}
+
+// AddContextDec defines addition with another intermediate decimal of the same context
+func (cd ContextDec) AddContextDec(cd2 ContextDec) ContextDec {
+	return ContextDec{cd.ctx, new(big.Int).Add(cd.i, cd2.i)}
+}
+
+// Sub defines subtraction in the context
+func (cd ContextDec) Sub(d Dec) ContextDec {
+	return ContextDec{cd.ctx, new(big.Int).Sub(cd.i, cd.ctx.NewDec(d).i)}
+}
+
+// Mul defines multiplication in the context, rounded half even at the precision of the context like Quo. The product
+// is exact only if its decimal places beyond the context are zeros, e.g. with an integer Dec
+func (cd ContextDec) Mul(d Dec) ContextDec {
+	return ContextDec{cd.ctx, quoRound(new(big.Int).Mul(cd.i, d.Int), precisionReuse, RoundHalfEven)}
+}
+
+// MulContextDec defines multiplication with another intermediate decimal of the same context
+func (cd ContextDec) MulContextDec(cd2 ContextDec) ContextDec {
+	return ContextDec{cd.ctx, quoRound(new(big.Int).Mul(cd.i, cd2.i), cd.ctx.unit, RoundHalfEven)}
+}
+
+// Quo defines quotient in the context, rounded at the precision of the context
+func (cd ContextDec) Quo(d Dec) ContextDec {
+	return ContextDec{cd.ctx, quoRound(new(big.Int).Mul(cd.i, precisionReuse), d.Int, RoundHalfEven)}
+}
+
+// Dec rounds the intermediate decimal to Dec by the rounding mode of the context
+func (cd ContextDec) Dec() Dec {
+	return Dec{quoRound(cd.i, cd.ctx.scale, cd.ctx.rounding)}
+}
+
+// String returns the intermediate decimal with all the decimal places of the context
+func (cd ContextDec) String() string {
+	return new(big.Rat).SetFrac(cd.i, cd.ctx.unit).FloatString(int(cd.ctx.precision))
+}
+
+// quoRound returns num / den rounded to an integer by the rounding mode, without mutating the inputs
+func quoRound(num, den *big.Int, rounding RoundingMode) *big.Int {
+	quo, rem := new(big.Int).QuoRem(num, den, new(big.Int))
+	if rem.Sign() == 0 {
+		return quo
+	}
+
+	// the sign of the exact quotient
+	positive := num.Sign() == den.Sign()
+	awayFromZero := func() *big.Int {
+		if positive {
+			return quo.Add(quo, oneInt)
+		}
+		return quo.Sub(quo, oneInt)
+	}
+
+	switch rounding {
+	case RoundTruncate:
+		return quo
+	case RoundCeiling:
+		if positive {
+			return awayFromZero()
+		}
+		return quo
+	default:
+		switch new(big.Int).Mul(new(big.Int).Abs(rem), big.NewInt(2)).CmpAbs(den) {
+		case -1:
+			return quo
+		case 1:
+			return awayFromZero()
+		default:
+			// always round to an even number
+			if quo.Bit(0) == 0 {
+				return quo
+			}
+			return awayFromZero()
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecContext_Mul(t *testing.T) {
+	ctx, err := NewDecContext(18, RoundHalfEven)
+	require.NoError(t, err)
+	require.Equal(t, int64(18), ctx.Precision())
+	require.Equal(t, int64(8), DecPrecision())
+
+	price, qty, rate := MustNewDecFromStr("0.00000001"), MustNewDecFromStr("0.5"), NewDec(3)
+	// the rounding at each step of Dec drifts
+	require.Equal(t, ZeroDec(), price.Mul(qty).Mul(rate))
+	require.Equal(t, MustNewDecFromStr("0.00000002"), ctx.Mul(price, qty, rate))
+
+	chain := ctx.NewDec(price).Mul(qty)
+	require.Equal(t, "0.000000005000000000", chain.String())
+	require.Equal(t, MustNewDecFromStr("0.00000002"), chain.Mul(rate).Dec())
+
+	// quotient keeps the precision of the context until the end
+	third := ctx.NewDec(OneDec()).Quo(NewDec(3))
+	require.Equal(t, "0.333333333333333333", third.String())
+	require.Equal(t, MustNewDecFromStr("1.00000000"), third.Mul(NewDec(3)).Dec())
+	require.Equal(t, MustNewDecFromStr("0.99999999"), OneDec().Quo(NewDec(3)).Mul(NewDec(3)))
+
+	sum := ctx.NewDec(OneDec()).Add(NewDec(2)).Sub(MustNewDecFromStr("0.5")).AddContextDec(third)
+	require.Equal(t, "2.833333333333333333", sum.String())
+	require.Equal(t, "3.666666666666666663", ctx.NewDec(NewDec(11)).MulContextDec(third).String())
+}
+
+func TestDecContext_Rounding(t *testing.T) {
+	halfEven, err := NewDecContext(10, RoundHalfEven)
+	require.NoError(t, err)
+	truncate, err := NewDecContext(10, RoundTruncate)
+	require.NoError(t, err)
+	ceiling, err := NewDecContext(10, RoundCeiling)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		d                           string
+		halfEven, truncate, ceiling string
+	}{
+		{"0.00000015", "0.00000002", "0.00000001", "0.00000002"},
+		{"0.00000025", "0.00000002", "0.00000002", "0.00000003"},
+		{"0.00000027", "0.00000003", "0.00000002", "0.00000003"},
+		{"-0.00000015", "-0.00000002", "-0.00000001", "-0.00000001"},
+		{"-0.00000027", "-0.00000003", "-0.00000002", "-0.00000002"},
+	}
+	tenth := MustNewDecFromStr("0.1")
+	for _, tc := range testCases {
+		d := MustNewDecFromStr(tc.d)
+		require.Equal(t, MustNewDecFromStr(tc.halfEven), halfEven.Mul(d, tenth), tc.d)
+		require.Equal(t, MustNewDecFromStr(tc.truncate), truncate.Mul(d, tenth), tc.d)
+		require.Equal(t, MustNewDecFromStr(tc.ceiling), ceiling.Mul(d, tenth), tc.d)
+	}
+}
+
+func TestNewDecContext(t *testing.T) {
+	_, err := NewDecContext(Precision-1, RoundHalfEven)
+	require.Error(t, err)
+	_, err = NewDecContext(MaxContextPrecision+1, RoundHalfEven)
+	require.Error(t, err)
+	_, err = NewDecContext(Precision, RoundCeiling+1)
+	require.Error(t, err)
+
+	// the same as Dec with the same precision
+	ctx, err := NewDecContext(Precision, RoundHalfEven)
+	require.NoError(t, err)
+	d1, d2 := MustNewDecFromStr("1.23456789"), MustNewDecFromStr("9.87654321")
+	require.Equal(t, d1.Mul(d2), ctx.Mul(d1, d2))
+	require.Equal(t, d1.Quo(d2), ctx.NewDec(d1).Quo(d2).Dec())
+}
@@ -0,0 +1,95 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultTokenHeader is the header of the auth token of an endpoint, sent as a bearer token
+	DefaultTokenHeader = "Authorization"
+	// DefaultTokenRefreshMargin is the time before the expiry of an auth token when it's refreshed
+	DefaultTokenRefreshMargin = 30 * time.Second
+)
+
+// TokenRefresher fetches a new auth token of an endpoint with its expiry, e.g. by the OAuth client credentials grant of
+// a hosted rpc provider. A zero expiry means the token never expires until it's rejected
+type TokenRefresher func() (token string, expiry time.Time, err error)
+
+// EndpointAuth - structure of the auth of the requests to an endpoint, e.g. the API key of a hosted rpc provider in
+// a header or a url param, or a bearer token refreshed before it expires
+type EndpointAuth struct {
+	// Headers are set on every request, e.g. {"x-api-key": "..."}
+	Headers map[string]string `json:"headers,omitempty"`
+	// QueryParams are added to the url of every request, e.g. {"apikey": "..."}, which the gRPC endpoints ignore
+	QueryParams map[string]string `json:"query_params,omitempty"`
+	// Token is a static auth token, sent in the TokenHeader
+	Token string `json:"token,omitempty"`
+	// Refresh fetches the auth token on the first request, before the token expires and after it's rejected by the
+	// endpoint, which overrides the static Token, optional
+	Refresh TokenRefresher `json:"-"`
+	// TokenHeader is the header of the token, DefaultTokenHeader by default, where the token is sent with the prefix
+	// "Bearer ". The token is sent as it is in any other header
+	TokenHeader string `json:"token_header,omitempty"`
+	// RefreshMargin is the time before the expiry of the token when it's refreshed, DefaultTokenRefreshMargin by default
+	RefreshMargin time.Duration `json:"refresh_margin,omitempty"`
+	// AllowPlaintext sends the auth over the plaintext http to a loopback node URI, e.g. a local node behind an
+	// authenticating proxy, for local use only. The auth is never sent over the plaintext connections to any other host
+	AllowPlaintext bool `json:"allow_plaintext,omitempty"`
+}
+
+// ValidateBasic gives a quick validity check for the endpoint auth
+func (ea EndpointAuth) ValidateBasic() error {
+	for name := range ea.Headers {
+		if len(strings.TrimSpace(name)) == 0 {
+			return errors.New("failed. empty header name of the endpoint auth")
+		}
+	}
+	for name := range ea.QueryParams {
+		if len(name) == 0 {
+			return errors.New("failed. empty query param name of the endpoint auth")
+		}
+	}
+	if ea.RefreshMargin < 0 {
+		return fmt.Errorf("failed. negative refresh margin of the endpoint auth: %s", ea.RefreshMargin)
+	}
+	return nil
+}
+
+// TokenHeaderValue returns the header of the token and its value with the token
+func (ea EndpointAuth) TokenHeaderValue(token string) (header, value string) {
+	header = ea.TokenHeader
+	if len(header) == 0 {
+		header = DefaultTokenHeader
+	}
+	if http.CanonicalHeaderKey(header) == DefaultTokenHeader {
+		return header, "Bearer " + token
+	}
+	return header, token
+}
+
+// GetRefreshMargin returns the time before the expiry of the token when it's refreshed
+func (ea EndpointAuth) GetRefreshMargin() time.Duration {
+	if ea.RefreshMargin == 0 {
+		return DefaultTokenRefreshMargin
+	}
+	return ea.RefreshMargin
+}
+
+// resolve resolves the secret references in the headers, the query params and the token
+func (ea *EndpointAuth) resolve(resolvers SecretResolvers) (err error) {
+	for _, values := range []map[string]string{ea.Headers, ea.QueryParams} {
+		for name, value := range values {
+			if values[name], err = resolvers.Resolve(value); err != nil {
+				return fmt.Errorf("%s: %w", name, err)
+			}
+		}
+	}
+	if ea.Token, err = resolvers.Resolve(ea.Token); err != nil {
+		return fmt.Errorf("token: %w", err)
+	}
+	return nil
+}
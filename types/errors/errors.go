@@ -0,0 +1,180 @@
+package errors
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+)
+
+// the codes below are the root codes in the codespace "sdk" of okchain
+const (
+	codespaceRoot = "sdk"
+
+	codeInvalidSequence   uint32 = 3
+	codeUnknownRequest    uint32 = 6
+	codeUnauthorized      uint32 = 4
+	codeInsufficientFunds uint32 = 5
+	codeInvalidAddress    uint32 = 7
+	codeInsufficientCoins uint32 = 10
+	codeInvalidCoins      uint32 = 11
+	codeOutOfGas          uint32 = 12
+	codeInsufficientFee   uint32 = 14
+)
+
+// sentinel errors for callers to branch with errors.Is
+var (
+	ErrInvalidAddress      = errors.New("invalid address")
+	ErrInvalidCoins        = errors.New("invalid coins")
+	ErrSequenceMismatch    = errors.New("sequence mismatch")
+	ErrUnauthorized        = errors.New("signature verification failed")
+	ErrInsufficientFunds   = errors.New("insufficient funds")
+	ErrInsufficientFee     = errors.New("insufficient fee")
+	ErrOutOfGas            = errors.New("out of gas")
+	ErrTxTimeout           = errors.New("timed out waiting for tx to be committed")
+	ErrAddressScreened     = errors.New("address blocked by screening")
+	ErrFeeUnderFloor       = errors.New("fees under the floor")
+	ErrSequenceReused      = errors.New("sequence taken by a pending signed tx")
+	ErrAddressPrefix       = errors.New("unexpected address prefix")
+	ErrMemoTooLarge        = errors.New("memo too large")
+	ErrTxTooLarge          = errors.New("tx too large")
+	ErrInvalidMsg          = errors.New("invalid msg")
+	ErrSignerBusy          = errors.New("signer busy with another tx")
+	ErrTimeoutHeight       = errors.New("timeout height reached")
+	ErrMsgOrder            = errors.New("msgs out of order")
+	ErrModuleUnavailable   = errors.New("module unavailable on the node")
+	ErrWatchOnly           = errors.New("watch-only account without private key")
+	ErrRateLimited         = errors.New("rate limited by the client")
+	ErrChainIDMismatch     = errors.New("chain ID mismatch")
+	ErrProductNotListed    = errors.New("product not listed")
+	ErrSignVetoed          = errors.New("signing vetoed by the pre-sign hook")
+	ErrFeePayerUnsupported = errors.New("fee payer unsupported by the chain")
+)
+
+// unknownRouteLogs are the logs of the unknown request telling the route of a query isn't on the node, i.e. its module
+// is absent from the node build, rather than a bad query of a module present
+var unknownRouteLogs = []string{"no custom querier found for route", "no such store", "unknown query path"}
+
+// sdkError - structure of an error message that is classified by a sentinel error
+type sdkError struct {
+	kind error
+	msg  string
+}
+
+// Error returns the message only, which keeps the error output of the sdk unchanged
+func (e *sdkError) Error() string {
+	return e.msg
+}
+
+// Unwrap returns the sentinel error for errors.Is
+func (e *sdkError) Unwrap() error {
+	return e.kind
+}
+
+// Wrap classifies the message by a sentinel error
+func Wrap(kind error, msg string) error {
+	return &sdkError{
+		kind: kind,
+		msg:  msg,
+	}
+}
+
+// Wrapf classifies the formatted message by a sentinel error
+func Wrapf(kind error, format string, args ...interface{}) error {
+	return Wrap(kind, fmt.Sprintf(format, args...))
+}
+
+// causedError - structure of an error message that is classified by a sentinel error and keeps its cause, e.g. an
+// error returned by a hook of the caller
+type causedError struct {
+	sdkError
+	cause error
+}
+
+// Unwrap returns both the sentinel error and the cause for errors.Is and errors.As
+func (e *causedError) Unwrap() []error {
+	return []error{e.kind, e.cause}
+}
+
+// WrapCause classifies the formatted message of the cause by a sentinel error, which keeps the cause in the error
+// chain
+func WrapCause(kind, cause error, format string, args ...interface{}) error {
+	return &causedError{
+		sdkError: sdkError{
+			kind: kind,
+			msg:  fmt.Sprintf(format, args...),
+		},
+		cause: cause,
+	}
+}
+
+// ABCIError - structure of a failed ABCI response from the node
+type ABCIError struct {
+	Codespace string
+	Code      uint32
+	Log       string
+}
+
+// Error returns the raw log of the ABCI response
+func (e *ABCIError) Error() string {
+	return e.Log
+}
+
+// Unwrap returns the sentinel error that the ABCI code maps to
+func (e *ABCIError) Unwrap() error {
+	if e.Codespace != codespaceRoot && len(e.Codespace) != 0 {
+		return nil
+	}
+
+	switch e.Code {
+	case codeInvalidSequence:
+		return ErrSequenceMismatch
+	case codeUnknownRequest:
+		for _, log := range unknownRouteLogs {
+			if strings.Contains(e.Log, log) {
+				return ErrModuleUnavailable
+			}
+		}
+		return nil
+	case codeUnauthorized:
+		return ErrUnauthorized
+	case codeInsufficientFunds, codeInsufficientCoins:
+		return ErrInsufficientFunds
+	case codeInvalidAddress:
+		return ErrInvalidAddress
+	case codeInvalidCoins:
+		return ErrInvalidCoins
+	case codeOutOfGas:
+		return ErrOutOfGas
+	case codeInsufficientFee:
+		return ErrInsufficientFee
+	default:
+		return nil
+	}
+}
+
+// FromABCI converts the code and log of a failed ABCI response into an error
+func FromABCI(codespace string, code uint32, log string) error {
+	return &ABCIError{
+		Codespace: codespace,
+		Code:      code,
+		Log:       log,
+	}
+}
+
+// FromRPC classifies the error returned by the rpc client, e.g. the timeout of the tx commit on the node
+func FromRPC(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	netErr, ok := err.(net.Error)
+	if (ok && netErr.Timeout()) || strings.Contains(strings.ToLower(err.Error()), "timed out waiting for tx") {
+		return &sdkError{
+			kind: ErrTxTimeout,
+			msg:  err.Error(),
+		}
+	}
+
+	return err
+}
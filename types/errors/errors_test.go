@@ -0,0 +1,82 @@
+package errors
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const errMsg = "default error message"
+
+func TestWrap(t *testing.T) {
+	err := Wrapf(ErrInvalidCoins, "failed : parse Coins [%s] error: %s", "1okt", errMsg)
+	require.True(t, errors.Is(err, ErrInvalidCoins))
+	require.False(t, errors.Is(err, ErrInvalidAddress))
+	require.Equal(t, fmt.Sprintf("failed : parse Coins [1okt] error: %s", errMsg), err.Error())
+
+	wrappedErr := fmt.Errorf("failed. build stdTx error: %w", Wrap(ErrInvalidAddress, errMsg))
+	require.True(t, errors.Is(wrappedErr, ErrInvalidAddress))
+}
+
+func TestFromABCI(t *testing.T) {
+	testCases := []struct {
+		codespace string
+		code      uint32
+		expected  error
+	}{
+		{"sdk", 3, ErrSequenceMismatch},
+		{"sdk", 4, ErrUnauthorized},
+		{"sdk", 5, ErrInsufficientFunds},
+		{"sdk", 10, ErrInsufficientFunds},
+		{"sdk", 7, ErrInvalidAddress},
+		{"", 11, ErrInvalidCoins},
+		{"sdk", 12, ErrOutOfGas},
+		{"sdk", 14, ErrInsufficientFee},
+	}
+
+	for _, tc := range testCases {
+		err := FromABCI(tc.codespace, tc.code, errMsg)
+		require.True(t, errors.Is(err, tc.expected))
+		require.Equal(t, errMsg, err.Error())
+
+		var abciErr *ABCIError
+		require.True(t, errors.As(err, &abciErr))
+		require.Equal(t, tc.code, abciErr.Code)
+	}
+
+	// the unknown routes of the modules absent from the node
+	for _, log := range []string{"no custom querier found for route farm", "no such store: evm",
+		"unknown query path: custom/farm/pools"} {
+		require.True(t, errors.Is(FromABCI("sdk", 6, log), ErrModuleUnavailable))
+	}
+	require.False(t, errors.Is(FromABCI("sdk", 6, "unknown token query endpoint"), ErrModuleUnavailable))
+
+	// codes in other codespaces aren't mapped
+	err := FromABCI("token", 5, errMsg)
+	require.False(t, errors.Is(err, ErrInsufficientFunds))
+	require.Nil(t, errors.Unwrap(err))
+}
+
+func TestFromRPC(t *testing.T) {
+	require.Nil(t, FromRPC(nil))
+
+	err := FromRPC(errors.New("Timed out waiting for tx to be included in a block"))
+	require.True(t, errors.Is(err, ErrTxTimeout))
+
+	err = FromRPC(errors.New(errMsg))
+	require.False(t, errors.Is(err, ErrTxTimeout))
+	require.Equal(t, errMsg, err.Error())
+}
+
+func TestWrapCause(t *testing.T) {
+	cause := &ABCIError{Codespace: "sdk", Code: 5, Log: errMsg}
+	err := WrapCause(ErrSignVetoed, cause, "failed. vetoed: %s", cause)
+	require.Equal(t, "failed. vetoed: "+errMsg, err.Error())
+	require.True(t, errors.Is(err, ErrSignVetoed))
+	require.True(t, errors.Is(err, ErrInsufficientFunds))
+	var abciErr *ABCIError
+	require.True(t, errors.As(err, &abciErr))
+	require.Equal(t, cause, abciErr)
+}
@@ -0,0 +1,93 @@
+package types
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	sdkerrors "github.com/okex/okchain-go-sdk/types/errors"
+)
+
+// FeeAuditLog shows the expected behavior of the audit log recording the overrides of the fee floor
+type FeeAuditLog interface {
+	RecordFeeFloorOverride(override FeeFloorOverride) error
+}
+
+// FeeFloorOverride - structure of the audit record of a tx built with the fees under the floor by an explicit override
+type FeeFloorOverride struct {
+	Time    time.Time
+	ChainID string
+	Signer  AccAddress
+	Fees    DecCoins
+	MinFees DecCoins
+	Reason  string
+}
+
+// String returns the human readable audit record
+func (o FeeFloorOverride) String() string {
+	return fmt.Sprintf("fee floor overridden at %s on chain %s by %s: fees [%s] under the floor [%s], reason: %s",
+		o.Time.UTC().Format(time.RFC3339), o.ChainID, o.Signer, o.Fees, o.MinFees,
+		o.Reason)
+}
+
+// CheckFeeFloor checks that the fees reach the floor in every denom of the floor
+func CheckFeeFloor(fees, minFees DecCoins) error {
+	for _, minFee := range minFees {
+		if fees.AmountOf(minFee.Denom).LT(minFee.Amount) {
+			return sdkerrors.Wrapf(sdkerrors.ErrFeeUnderFloor, "failed. fees [%s] are under the floor [%s]",
+				fees, minFees)
+		}
+	}
+	return nil
+}
+
+var _ FeeAuditLog = (*LoggerFeeAuditLog)(nil)
+
+// LoggerFeeAuditLog - structure of the fee audit log writing the records to a logger
+type LoggerFeeAuditLog struct {
+	logger *log.Logger
+}
+
+// NewLoggerFeeAuditLog creates a new instance of LoggerFeeAuditLog, on the standard logger if the logger is nil
+func NewLoggerFeeAuditLog(logger *log.Logger) *LoggerFeeAuditLog {
+	return &LoggerFeeAuditLog{
+		logger: logger,
+	}
+}
+
+// RecordFeeFloorOverride implements the FeeAuditLog interface
+func (l *LoggerFeeAuditLog) RecordFeeFloorOverride(override FeeFloorOverride) error {
+	if l.logger == nil {
+		return log.Output(2, override.String())
+	}
+	return l.logger.Output(2, override.String())
+}
+
+// EnforceFeeFloor refuses the fees under the floor of the config, unless the override reason is given and recorded in
+// the fee audit log of the config
+func EnforceFeeFloor(config ClientConfig, signer AccAddress, fees DecCoins, overrideReason string) error {
+	err := CheckFeeFloor(fees, config.MinFees)
+	if err == nil {
+		return nil
+	}
+	if len(strings.TrimSpace(overrideReason)) == 0 {
+		return err
+	}
+
+	auditLog := config.FeeAuditLog
+	if auditLog == nil {
+		auditLog = NewLoggerFeeAuditLog(nil)
+	}
+	if err = auditLog.RecordFeeFloorOverride(FeeFloorOverride{
+		Time:    time.Now(),
+		ChainID: config.ChainID,
+		Signer:  signer,
+		Fees:    fees,
+		MinFees: config.MinFees,
+		Reason:  overrideReason,
+	}); err != nil {
+		return fmt.Errorf("failed. record the override of the fee floor error: %w", err)
+	}
+	return nil
+}
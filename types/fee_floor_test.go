@@ -0,0 +1,48 @@
+package types
+
+import (
+	"bytes"
+	"errors"
+	"log"
+	"testing"
+	"time"
+
+	sdkerrors "github.com/okex/okchain-go-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckFeeFloor(t *testing.T) {
+	minFees, err := ParseDecCoins("0.02okt,1btc")
+	require.NoError(t, err)
+
+	for _, feesStr := range []string{"0.02okt,1btc", "1okt,2btc,1eth"} {
+		fees, err := ParseDecCoins(feesStr)
+		require.NoError(t, err)
+		require.NoError(t, CheckFeeFloor(fees, minFees))
+	}
+	for _, feesStr := range []string{"0.01okt,1btc", "1okt", ""} {
+		fees, err := ParseDecCoins(feesStr)
+		require.NoError(t, err)
+		require.True(t, errors.Is(CheckFeeFloor(fees, minFees), sdkerrors.ErrFeeUnderFloor))
+	}
+	require.NoError(t, CheckFeeFloor(nil, nil))
+}
+
+func TestLoggerFeeAuditLog(t *testing.T) {
+	buf := new(bytes.Buffer)
+	fees, err := ParseDecCoins("0.01okt")
+	require.NoError(t, err)
+	minFees, err := ParseDecCoins("0.02okt")
+	require.NoError(t, err)
+
+	err = NewLoggerFeeAuditLog(log.New(buf, "", 0)).RecordFeeFloorOverride(FeeFloorOverride{
+		Time:    time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC),
+		ChainID: "okchain",
+		Fees:    fees,
+		MinFees: minFees,
+		Reason:  "manual rescue",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "fee floor overridden at 2020-06-01T00:00:00Z on chain okchain by : fees [0.01000000okt] "+
+		"under the floor [0.02000000okt], reason: manual rescue\n", buf.String())
+}
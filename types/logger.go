@@ -0,0 +1,10 @@
+package types
+
+// Logger shows the expected behavior of the structured logger receiving the events of the client operations, with the
+// fields in the key-value pairs. The tendermint loggers fit in directly, and zap or logrus are plugged in by an adapter,
+// e.g. Info(msg, keyvals...) calling zap.SugaredLogger.Infow(msg, keyvals...)
+type Logger interface {
+	Debug(msg string, keyvals ...interface{})
+	Info(msg string, keyvals ...interface{})
+	Error(msg string, keyvals ...interface{})
+}
@@ -0,0 +1,77 @@
+package types
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"sync"
+)
+
+// gauge names of the chain health, which follow the naming of the Prometheus metrics
+const (
+	GaugeLatestHeight          = "okchain_latest_block_height"
+	GaugeSecondsSinceLastBlock = "okchain_seconds_since_last_block"
+	GaugePeers                 = "okchain_peers"
+	GaugeMempoolTxs            = "okchain_mempool_txs"
+)
+
+// MetricsCollector shows the expected behavior of the collector of the metrics published by gosdk, which a Prometheus
+// registry fits in by a thin adapter setting the gauges registered by their names
+type MetricsCollector interface {
+	SetGauge(name string, value float64)
+}
+
+var _ MetricsCollector = (*GaugeSet)(nil)
+
+// GaugeSet - structure of the in-memory collector of the gauges, which writes them in the Prometheus text format for a
+// metrics endpoint without any Prometheus client
+type GaugeSet struct {
+	mtx    sync.RWMutex
+	gauges map[string]float64
+}
+
+// NewGaugeSet creates a new instance of GaugeSet
+func NewGaugeSet() *GaugeSet {
+	return &GaugeSet{
+		gauges: make(map[string]float64),
+	}
+}
+
+// SetGauge implements the MetricsCollector interface
+func (gs *GaugeSet) SetGauge(name string, value float64) {
+	gs.mtx.Lock()
+	defer gs.mtx.Unlock()
+	gs.gauges[name] = value
+}
+
+// Gauge returns the value of the gauge, false if it's never set
+func (gs *GaugeSet) Gauge(name string) (float64, bool) {
+	gs.mtx.RLock()
+	defer gs.mtx.RUnlock()
+	value, ok := gs.gauges[name]
+	return value, ok
+}
+
+// WritePrometheus writes the gauges sorted by their names in the Prometheus text exposition format
+func (gs *GaugeSet) WritePrometheus(w io.Writer) error {
+	gs.mtx.RLock()
+	names := make([]string, 0, len(gs.gauges))
+	for name := range gs.gauges {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	values := make([]float64, len(names))
+	for i, name := range names {
+		values[i] = gs.gauges[name]
+	}
+	gs.mtx.RUnlock()
+
+	for i, name := range names {
+		if _, err := fmt.Fprintf(w, "# TYPE %s gauge\n%s %s\n", name, name,
+			strconv.FormatFloat(values[i], 'g', -1, 64)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
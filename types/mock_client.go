@@ -1,10 +1,14 @@
+//go:build !js
+// +build !js
+
 // Code generated by MockGen. DO NOT EDIT.
-// Source: client.go
+// Source: base_client.go
 
 // Package types is a generated GoMock package.
 package types
 
 import (
+	context "context"
 	gomock "github.com/golang/mock/gomock"
 	common "github.com/tendermint/tendermint/libs/common"
 	client "github.com/tendermint/tendermint/rpc/client"
@@ -171,6 +175,51 @@ func (mr *MockBaseClientMockRecorder) QuerySubspace(subspace, storeName interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuerySubspace", reflect.TypeOf((*MockBaseClient)(nil).QuerySubspace), subspace, storeName)
 }
 
+// QueryStoreWithProof mocks base method
+func (m *MockBaseClient) QueryStoreWithProof(key common.HexBytes, storeName, endPath string, height int64) (StoreQueryResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryStoreWithProof", key, storeName, endPath, height)
+	ret0, _ := ret[0].(StoreQueryResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryStoreWithProof indicates an expected call of QueryStoreWithProof
+func (mr *MockBaseClientMockRecorder) QueryStoreWithProof(key, storeName, endPath, height interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryStoreWithProof", reflect.TypeOf((*MockBaseClient)(nil).QueryStoreWithProof), key, storeName, endPath, height)
+}
+
+// Status mocks base method
+func (m *MockBaseClient) Status() (*core_types.ResultStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Status")
+	ret0, _ := ret[0].(*core_types.ResultStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Status indicates an expected call of Status
+func (mr *MockBaseClientMockRecorder) Status() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Status", reflect.TypeOf((*MockBaseClient)(nil).Status))
+}
+
+// UnconfirmedTxs mocks base method
+func (m *MockBaseClient) UnconfirmedTxs(limit int) (*core_types.ResultUnconfirmedTxs, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnconfirmedTxs", limit)
+	ret0, _ := ret[0].(*core_types.ResultUnconfirmedTxs)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnconfirmedTxs indicates an expected call of UnconfirmedTxs
+func (mr *MockBaseClientMockRecorder) UnconfirmedTxs(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnconfirmedTxs", reflect.TypeOf((*MockBaseClient)(nil).UnconfirmedTxs), limit)
+}
+
 // Broadcast mocks base method
 func (m *MockBaseClient) Broadcast(txBytes []byte, broadcastMode BroadcastMode) (TxResponse, error) {
 	m.ctrl.T.Helper()
@@ -186,34 +235,94 @@ func (mr *MockBaseClientMockRecorder) Broadcast(txBytes, broadcastMode interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Broadcast", reflect.TypeOf((*MockBaseClient)(nil).Broadcast), txBytes, broadcastMode)
 }
 
+// BroadcastRawTx mocks base method
+func (m *MockBaseClient) BroadcastRawTx(txBytes []byte, broadcastMode BroadcastMode) (TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BroadcastRawTx", txBytes, broadcastMode)
+	ret0, _ := ret[0].(TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BroadcastRawTx indicates an expected call of BroadcastRawTx
+func (mr *MockBaseClientMockRecorder) BroadcastRawTx(txBytes, broadcastMode interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BroadcastRawTx", reflect.TypeOf((*MockBaseClient)(nil).BroadcastRawTx), txBytes, broadcastMode)
+}
+
+// Subscribe mocks base method
+func (m *MockBaseClient) Subscribe(ctx context.Context, subscriber, query string) (<-chan core_types.ResultEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", ctx, subscriber, query)
+	ret0, _ := ret[0].(<-chan core_types.ResultEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Subscribe indicates an expected call of Subscribe
+func (mr *MockBaseClientMockRecorder) Subscribe(ctx, subscriber, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockBaseClient)(nil).Subscribe), ctx, subscriber, query)
+}
+
 // BuildAndBroadcast mocks base method
-func (m *MockBaseClient) BuildAndBroadcast(fromName, passphrase, memo string, msgs []Msg, accNumber, seqNumber uint64) (TxResponse, error) {
+func (m *MockBaseClient) BuildAndBroadcast(fromName, passphrase, memo string, msgs []Msg, accNumber, seqNumber uint64, opts ...TxOption) (TxResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "BuildAndBroadcast", fromName, passphrase, memo, msgs, accNumber, seqNumber)
+	varargs := []interface{}{fromName, passphrase, memo, msgs, accNumber, seqNumber}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BuildAndBroadcast", varargs...)
 	ret0, _ := ret[0].(TxResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // BuildAndBroadcast indicates an expected call of BuildAndBroadcast
-func (mr *MockBaseClientMockRecorder) BuildAndBroadcast(fromName, passphrase, memo, msgs, accNumber, seqNumber interface{}) *gomock.Call {
+func (mr *MockBaseClientMockRecorder) BuildAndBroadcast(fromName, passphrase, memo, msgs, accNumber, seqNumber interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildAndBroadcast", reflect.TypeOf((*MockBaseClient)(nil).BuildAndBroadcast), fromName, passphrase, memo, msgs, accNumber, seqNumber)
+	varargs := append([]interface{}{fromName, passphrase, memo, msgs, accNumber, seqNumber}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildAndBroadcast", reflect.TypeOf((*MockBaseClient)(nil).BuildAndBroadcast), varargs...)
 }
 
 // BuildStdTx mocks base method
-func (m *MockBaseClient) BuildStdTx(fromName, passphrase, memo string, msgs []Msg, accNumber, seqNumber uint64) (StdTx, error) {
+func (m *MockBaseClient) BuildStdTx(fromName, passphrase, memo string, msgs []Msg, accNumber, seqNumber uint64, opts ...TxOption) (StdTx, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "BuildStdTx", fromName, passphrase, memo, msgs, accNumber, seqNumber)
+	varargs := []interface{}{fromName, passphrase, memo, msgs, accNumber, seqNumber}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BuildStdTx", varargs...)
 	ret0, _ := ret[0].(StdTx)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // BuildStdTx indicates an expected call of BuildStdTx
-func (mr *MockBaseClientMockRecorder) BuildStdTx(fromName, passphrase, memo, msgs, accNumber, seqNumber interface{}) *gomock.Call {
+func (mr *MockBaseClientMockRecorder) BuildStdTx(fromName, passphrase, memo, msgs, accNumber, seqNumber interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{fromName, passphrase, memo, msgs, accNumber, seqNumber}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildStdTx", reflect.TypeOf((*MockBaseClient)(nil).BuildStdTx), varargs...)
+}
+
+// BuildSignedTx mocks base method
+func (m *MockBaseClient) BuildSignedTx(fromName, passphrase, memo string, msgs []Msg, accNumber, seqNumber uint64, opts ...TxOption) (SignedTx, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{fromName, passphrase, memo, msgs, accNumber, seqNumber}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BuildSignedTx", varargs...)
+	ret0, _ := ret[0].(SignedTx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BuildSignedTx indicates an expected call of BuildSignedTx
+func (mr *MockBaseClientMockRecorder) BuildSignedTx(fromName, passphrase, memo, msgs, accNumber, seqNumber interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildStdTx", reflect.TypeOf((*MockBaseClient)(nil).BuildStdTx), fromName, passphrase, memo, msgs, accNumber, seqNumber)
+	varargs := append([]interface{}{fromName, passphrase, memo, msgs, accNumber, seqNumber}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildSignedTx", reflect.TypeOf((*MockBaseClient)(nil).BuildSignedTx), varargs...)
 }
 
 // BuildUnsignedStdTxOffline mocks base method
@@ -230,6 +339,86 @@ func (mr *MockBaseClientMockRecorder) BuildUnsignedStdTxOffline(msgs, memo inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildUnsignedStdTxOffline", reflect.TypeOf((*MockBaseClient)(nil).BuildUnsignedStdTxOffline), msgs, memo)
 }
 
+// BuildAndBroadcastWithSigner mocks base method
+func (m *MockBaseClient) BuildAndBroadcastWithSigner(signer Signer, memo string, msgs []Msg, accNumber, seqNumber uint64, opts ...TxOption) (TxResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{signer, memo, msgs, accNumber, seqNumber}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BuildAndBroadcastWithSigner", varargs...)
+	ret0, _ := ret[0].(TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BuildAndBroadcastWithSigner indicates an expected call of BuildAndBroadcastWithSigner
+func (mr *MockBaseClientMockRecorder) BuildAndBroadcastWithSigner(signer, memo, msgs, accNumber, seqNumber interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{signer, memo, msgs, accNumber, seqNumber}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildAndBroadcastWithSigner", reflect.TypeOf((*MockBaseClient)(nil).BuildAndBroadcastWithSigner), varargs...)
+}
+
+// BuildStdTxWithSigner mocks base method
+func (m *MockBaseClient) BuildStdTxWithSigner(signer Signer, memo string, msgs []Msg, accNumber, seqNumber uint64, opts ...TxOption) (StdTx, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{signer, memo, msgs, accNumber, seqNumber}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BuildStdTxWithSigner", varargs...)
+	ret0, _ := ret[0].(StdTx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BuildStdTxWithSigner indicates an expected call of BuildStdTxWithSigner
+func (mr *MockBaseClientMockRecorder) BuildStdTxWithSigner(signer, memo, msgs, accNumber, seqNumber interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{signer, memo, msgs, accNumber, seqNumber}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildStdTxWithSigner", reflect.TypeOf((*MockBaseClient)(nil).BuildStdTxWithSigner), varargs...)
+}
+
+// BuildSignedTxWithSigner mocks base method
+func (m *MockBaseClient) BuildSignedTxWithSigner(signer Signer, memo string, msgs []Msg, accNumber, seqNumber uint64, opts ...TxOption) (SignedTx, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{signer, memo, msgs, accNumber, seqNumber}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BuildSignedTxWithSigner", varargs...)
+	ret0, _ := ret[0].(SignedTx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BuildSignedTxWithSigner indicates an expected call of BuildSignedTxWithSigner
+func (mr *MockBaseClientMockRecorder) BuildSignedTxWithSigner(signer, memo, msgs, accNumber, seqNumber interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{signer, memo, msgs, accNumber, seqNumber}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildSignedTxWithSigner", reflect.TypeOf((*MockBaseClient)(nil).BuildSignedTxWithSigner), varargs...)
+}
+
+// BuildSignDoc mocks base method
+func (m *MockBaseClient) BuildSignDoc(signer Signer, memo string, msgs []Msg, accNumber, seqNumber uint64, opts ...TxOption) (SignDoc, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{signer, memo, msgs, accNumber, seqNumber}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BuildSignDoc", varargs...)
+	ret0, _ := ret[0].(SignDoc)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BuildSignDoc indicates an expected call of BuildSignDoc
+func (mr *MockBaseClientMockRecorder) BuildSignDoc(signer, memo, msgs, accNumber, seqNumber interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{signer, memo, msgs, accNumber, seqNumber}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildSignDoc", reflect.TypeOf((*MockBaseClient)(nil).BuildSignDoc), varargs...)
+}
+
 // CalculateGas mocks base method
 func (m *MockBaseClient) CalculateGas(txBytes []byte) (StdFee, error) {
 	m.ctrl.T.Helper()
@@ -288,6 +477,133 @@ func (mr *MockBaseClientMockRecorder) GetConfig() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConfig", reflect.TypeOf((*MockBaseClient)(nil).GetConfig))
 }
 
+// GetClientContext mocks base method
+func (m *MockBaseClient) GetClientContext() ClientContext {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClientContext")
+	ret0, _ := ret[0].(ClientContext)
+	return ret0
+}
+
+// GetClientContext indicates an expected call of GetClientContext
+func (mr *MockBaseClientMockRecorder) GetClientContext() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClientContext", reflect.TypeOf((*MockBaseClient)(nil).GetClientContext))
+}
+
+// WithConsistency mocks base method
+func (m *MockBaseClient) WithConsistency(consistency Consistency) (BaseClient, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithConsistency", consistency)
+	ret0, _ := ret[0].(BaseClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WithConsistency indicates an expected call of WithConsistency
+func (mr *MockBaseClientMockRecorder) WithConsistency(consistency interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithConsistency", reflect.TypeOf((*MockBaseClient)(nil).WithConsistency), consistency)
+}
+
+// WithProofVerification mocks base method
+func (m *MockBaseClient) WithProofVerification(verifier HeaderVerifier) BaseClient {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithProofVerification", verifier)
+	ret0, _ := ret[0].(BaseClient)
+	return ret0
+}
+
+// WithProofVerification indicates an expected call of WithProofVerification
+func (mr *MockBaseClientMockRecorder) WithProofVerification(verifier interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithProofVerification", reflect.TypeOf((*MockBaseClient)(nil).WithProofVerification), verifier)
+}
+
+// WithResponseRecorder mocks base method
+func (m *MockBaseClient) WithResponseRecorder(recorder ResponseRecorder) BaseClient {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithResponseRecorder", recorder)
+	ret0, _ := ret[0].(BaseClient)
+	return ret0
+}
+
+// WithResponseRecorder indicates an expected call of WithResponseRecorder
+func (mr *MockBaseClientMockRecorder) WithResponseRecorder(recorder interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithResponseRecorder", reflect.TypeOf((*MockBaseClient)(nil).WithResponseRecorder), recorder)
+}
+
+// WithQueryCache mocks base method
+func (m *MockBaseClient) WithQueryCache(cache *QueryCache) BaseClient {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithQueryCache", cache)
+	ret0, _ := ret[0].(BaseClient)
+	return ret0
+}
+
+// WithQueryCache indicates an expected call of WithQueryCache
+func (mr *MockBaseClientMockRecorder) WithQueryCache(cache interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithQueryCache", reflect.TypeOf((*MockBaseClient)(nil).WithQueryCache), cache)
+}
+
+// WithChainID mocks base method
+func (m *MockBaseClient) WithChainID(chainID string) BaseClient {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithChainID", chainID)
+	ret0, _ := ret[0].(BaseClient)
+	return ret0
+}
+
+// WithChainID indicates an expected call of WithChainID
+func (mr *MockBaseClientMockRecorder) WithChainID(chainID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithChainID", reflect.TypeOf((*MockBaseClient)(nil).WithChainID), chainID)
+}
+
+// WithDryRun mocks base method
+func (m *MockBaseClient) WithDryRun() BaseClient {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithDryRun")
+	ret0, _ := ret[0].(BaseClient)
+	return ret0
+}
+
+// WithDryRun indicates an expected call of WithDryRun
+func (mr *MockBaseClientMockRecorder) WithDryRun() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithDryRun", reflect.TypeOf((*MockBaseClient)(nil).WithDryRun))
+}
+
+// IsDryRun mocks base method
+func (m *MockBaseClient) IsDryRun() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsDryRun")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsDryRun indicates an expected call of IsDryRun
+func (mr *MockBaseClientMockRecorder) IsDryRun() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsDryRun", reflect.TypeOf((*MockBaseClient)(nil).IsDryRun))
+}
+
+// QueryHeight mocks base method
+func (m *MockBaseClient) QueryHeight() int64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryHeight")
+	ret0, _ := ret[0].(int64)
+	return ret0
+}
+
+// QueryHeight indicates an expected call of QueryHeight
+func (mr *MockBaseClientMockRecorder) QueryHeight() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryHeight", reflect.TypeOf((*MockBaseClient)(nil).QueryHeight))
+}
+
 // MockTxHandler is a mock of TxHandler interface
 type MockTxHandler struct {
 	ctrl     *gomock.Controller
@@ -312,33 +628,63 @@ func (m *MockTxHandler) EXPECT() *MockTxHandlerMockRecorder {
 }
 
 // BuildAndBroadcast mocks base method
-func (m *MockTxHandler) BuildAndBroadcast(fromName, passphrase, memo string, msgs []Msg, accNumber, seqNumber uint64) (TxResponse, error) {
+func (m *MockTxHandler) BuildAndBroadcast(fromName, passphrase, memo string, msgs []Msg, accNumber, seqNumber uint64, opts ...TxOption) (TxResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "BuildAndBroadcast", fromName, passphrase, memo, msgs, accNumber, seqNumber)
+	varargs := []interface{}{fromName, passphrase, memo, msgs, accNumber, seqNumber}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BuildAndBroadcast", varargs...)
 	ret0, _ := ret[0].(TxResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // BuildAndBroadcast indicates an expected call of BuildAndBroadcast
-func (mr *MockTxHandlerMockRecorder) BuildAndBroadcast(fromName, passphrase, memo, msgs, accNumber, seqNumber interface{}) *gomock.Call {
+func (mr *MockTxHandlerMockRecorder) BuildAndBroadcast(fromName, passphrase, memo, msgs, accNumber, seqNumber interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildAndBroadcast", reflect.TypeOf((*MockTxHandler)(nil).BuildAndBroadcast), fromName, passphrase, memo, msgs, accNumber, seqNumber)
+	varargs := append([]interface{}{fromName, passphrase, memo, msgs, accNumber, seqNumber}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildAndBroadcast", reflect.TypeOf((*MockTxHandler)(nil).BuildAndBroadcast), varargs...)
 }
 
 // BuildStdTx mocks base method
-func (m *MockTxHandler) BuildStdTx(fromName, passphrase, memo string, msgs []Msg, accNumber, seqNumber uint64) (StdTx, error) {
+func (m *MockTxHandler) BuildStdTx(fromName, passphrase, memo string, msgs []Msg, accNumber, seqNumber uint64, opts ...TxOption) (StdTx, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "BuildStdTx", fromName, passphrase, memo, msgs, accNumber, seqNumber)
+	varargs := []interface{}{fromName, passphrase, memo, msgs, accNumber, seqNumber}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BuildStdTx", varargs...)
 	ret0, _ := ret[0].(StdTx)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // BuildStdTx indicates an expected call of BuildStdTx
-func (mr *MockTxHandlerMockRecorder) BuildStdTx(fromName, passphrase, memo, msgs, accNumber, seqNumber interface{}) *gomock.Call {
+func (mr *MockTxHandlerMockRecorder) BuildStdTx(fromName, passphrase, memo, msgs, accNumber, seqNumber interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildStdTx", reflect.TypeOf((*MockTxHandler)(nil).BuildStdTx), fromName, passphrase, memo, msgs, accNumber, seqNumber)
+	varargs := append([]interface{}{fromName, passphrase, memo, msgs, accNumber, seqNumber}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildStdTx", reflect.TypeOf((*MockTxHandler)(nil).BuildStdTx), varargs...)
+}
+
+// BuildSignedTx mocks base method
+func (m *MockTxHandler) BuildSignedTx(fromName, passphrase, memo string, msgs []Msg, accNumber, seqNumber uint64, opts ...TxOption) (SignedTx, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{fromName, passphrase, memo, msgs, accNumber, seqNumber}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BuildSignedTx", varargs...)
+	ret0, _ := ret[0].(SignedTx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BuildSignedTx indicates an expected call of BuildSignedTx
+func (mr *MockTxHandlerMockRecorder) BuildSignedTx(fromName, passphrase, memo, msgs, accNumber, seqNumber interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{fromName, passphrase, memo, msgs, accNumber, seqNumber}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildSignedTx", reflect.TypeOf((*MockTxHandler)(nil).BuildSignedTx), varargs...)
 }
 
 // BuildUnsignedStdTxOffline mocks base method
@@ -355,6 +701,86 @@ func (mr *MockTxHandlerMockRecorder) BuildUnsignedStdTxOffline(msgs, memo interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildUnsignedStdTxOffline", reflect.TypeOf((*MockTxHandler)(nil).BuildUnsignedStdTxOffline), msgs, memo)
 }
 
+// BuildAndBroadcastWithSigner mocks base method
+func (m *MockTxHandler) BuildAndBroadcastWithSigner(signer Signer, memo string, msgs []Msg, accNumber, seqNumber uint64, opts ...TxOption) (TxResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{signer, memo, msgs, accNumber, seqNumber}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BuildAndBroadcastWithSigner", varargs...)
+	ret0, _ := ret[0].(TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BuildAndBroadcastWithSigner indicates an expected call of BuildAndBroadcastWithSigner
+func (mr *MockTxHandlerMockRecorder) BuildAndBroadcastWithSigner(signer, memo, msgs, accNumber, seqNumber interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{signer, memo, msgs, accNumber, seqNumber}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildAndBroadcastWithSigner", reflect.TypeOf((*MockTxHandler)(nil).BuildAndBroadcastWithSigner), varargs...)
+}
+
+// BuildStdTxWithSigner mocks base method
+func (m *MockTxHandler) BuildStdTxWithSigner(signer Signer, memo string, msgs []Msg, accNumber, seqNumber uint64, opts ...TxOption) (StdTx, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{signer, memo, msgs, accNumber, seqNumber}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BuildStdTxWithSigner", varargs...)
+	ret0, _ := ret[0].(StdTx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BuildStdTxWithSigner indicates an expected call of BuildStdTxWithSigner
+func (mr *MockTxHandlerMockRecorder) BuildStdTxWithSigner(signer, memo, msgs, accNumber, seqNumber interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{signer, memo, msgs, accNumber, seqNumber}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildStdTxWithSigner", reflect.TypeOf((*MockTxHandler)(nil).BuildStdTxWithSigner), varargs...)
+}
+
+// BuildSignedTxWithSigner mocks base method
+func (m *MockTxHandler) BuildSignedTxWithSigner(signer Signer, memo string, msgs []Msg, accNumber, seqNumber uint64, opts ...TxOption) (SignedTx, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{signer, memo, msgs, accNumber, seqNumber}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BuildSignedTxWithSigner", varargs...)
+	ret0, _ := ret[0].(SignedTx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BuildSignedTxWithSigner indicates an expected call of BuildSignedTxWithSigner
+func (mr *MockTxHandlerMockRecorder) BuildSignedTxWithSigner(signer, memo, msgs, accNumber, seqNumber interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{signer, memo, msgs, accNumber, seqNumber}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildSignedTxWithSigner", reflect.TypeOf((*MockTxHandler)(nil).BuildSignedTxWithSigner), varargs...)
+}
+
+// BuildSignDoc mocks base method
+func (m *MockTxHandler) BuildSignDoc(signer Signer, memo string, msgs []Msg, accNumber, seqNumber uint64, opts ...TxOption) (SignDoc, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{signer, memo, msgs, accNumber, seqNumber}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BuildSignDoc", varargs...)
+	ret0, _ := ret[0].(SignDoc)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BuildSignDoc indicates an expected call of BuildSignDoc
+func (mr *MockTxHandlerMockRecorder) BuildSignDoc(signer, memo, msgs, accNumber, seqNumber interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{signer, memo, msgs, accNumber, seqNumber}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildSignDoc", reflect.TypeOf((*MockTxHandler)(nil).BuildSignDoc), varargs...)
+}
+
 // MockSimulationHandler is a mock of SimulationHandler interface
 type MockSimulationHandler struct {
 	ctrl     *gomock.Controller
@@ -566,6 +992,51 @@ func (mr *MockClientQueryMockRecorder) QuerySubspace(subspace, storeName interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuerySubspace", reflect.TypeOf((*MockClientQuery)(nil).QuerySubspace), subspace, storeName)
 }
 
+// QueryStoreWithProof mocks base method
+func (m *MockClientQuery) QueryStoreWithProof(key common.HexBytes, storeName, endPath string, height int64) (StoreQueryResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryStoreWithProof", key, storeName, endPath, height)
+	ret0, _ := ret[0].(StoreQueryResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryStoreWithProof indicates an expected call of QueryStoreWithProof
+func (mr *MockClientQueryMockRecorder) QueryStoreWithProof(key, storeName, endPath, height interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryStoreWithProof", reflect.TypeOf((*MockClientQuery)(nil).QueryStoreWithProof), key, storeName, endPath, height)
+}
+
+// Status mocks base method
+func (m *MockClientQuery) Status() (*core_types.ResultStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Status")
+	ret0, _ := ret[0].(*core_types.ResultStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Status indicates an expected call of Status
+func (mr *MockClientQueryMockRecorder) Status() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Status", reflect.TypeOf((*MockClientQuery)(nil).Status))
+}
+
+// UnconfirmedTxs mocks base method
+func (m *MockClientQuery) UnconfirmedTxs(limit int) (*core_types.ResultUnconfirmedTxs, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnconfirmedTxs", limit)
+	ret0, _ := ret[0].(*core_types.ResultUnconfirmedTxs)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnconfirmedTxs indicates an expected call of UnconfirmedTxs
+func (mr *MockClientQueryMockRecorder) UnconfirmedTxs(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnconfirmedTxs", reflect.TypeOf((*MockClientQuery)(nil).UnconfirmedTxs), limit)
+}
+
 // MockClientTx is a mock of ClientTx interface
 type MockClientTx struct {
 	ctrl     *gomock.Controller
@@ -604,6 +1075,59 @@ func (mr *MockClientTxMockRecorder) Broadcast(txBytes, broadcastMode interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Broadcast", reflect.TypeOf((*MockClientTx)(nil).Broadcast), txBytes, broadcastMode)
 }
 
+// BroadcastRawTx mocks base method
+func (m *MockClientTx) BroadcastRawTx(txBytes []byte, broadcastMode BroadcastMode) (TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BroadcastRawTx", txBytes, broadcastMode)
+	ret0, _ := ret[0].(TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BroadcastRawTx indicates an expected call of BroadcastRawTx
+func (mr *MockClientTxMockRecorder) BroadcastRawTx(txBytes, broadcastMode interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BroadcastRawTx", reflect.TypeOf((*MockClientTx)(nil).BroadcastRawTx), txBytes, broadcastMode)
+}
+
+// MockClientSubscription is a mock of ClientSubscription interface
+type MockClientSubscription struct {
+	ctrl     *gomock.Controller
+	recorder *MockClientSubscriptionMockRecorder
+}
+
+// MockClientSubscriptionMockRecorder is the mock recorder for MockClientSubscription
+type MockClientSubscriptionMockRecorder struct {
+	mock *MockClientSubscription
+}
+
+// NewMockClientSubscription creates a new mock instance
+func NewMockClientSubscription(ctrl *gomock.Controller) *MockClientSubscription {
+	mock := &MockClientSubscription{ctrl: ctrl}
+	mock.recorder = &MockClientSubscriptionMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockClientSubscription) EXPECT() *MockClientSubscriptionMockRecorder {
+	return m.recorder
+}
+
+// Subscribe mocks base method
+func (m *MockClientSubscription) Subscribe(ctx context.Context, subscriber, query string) (<-chan core_types.ResultEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", ctx, subscriber, query)
+	ret0, _ := ret[0].(<-chan core_types.ResultEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Subscribe indicates an expected call of Subscribe
+func (mr *MockClientSubscriptionMockRecorder) Subscribe(ctx, subscriber, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockClientSubscription)(nil).Subscribe), ctx, subscriber, query)
+}
+
 // MockRPCClient is a mock of RPCClient interface
 type MockRPCClient struct {
 	ctrl     *gomock.Controller
@@ -0,0 +1,49 @@
+//go:build !js
+// +build !js
+
+package types
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// ModuleCreator creates the client of a module on the base client
+type ModuleCreator func(baseClient BaseClient) Module
+
+var (
+	moduleCreatorsMtx sync.RWMutex
+	moduleCreators    = make(map[string]ModuleCreator)
+)
+
+// RegisterModule registers the creator of a module by its name, which is called by the init of the module package.
+// So the modules are registered by importing their packages only, and the ones never imported are never linked
+func RegisterModule(name string, creator ModuleCreator) {
+	moduleCreatorsMtx.Lock()
+	defer moduleCreatorsMtx.Unlock()
+	if _, ok := moduleCreators[name]; ok {
+		panic(fmt.Sprintf("duplicated module: %s", name))
+	}
+	moduleCreators[name] = creator
+}
+
+// GetModuleCreator gets the creator of a module registered by its name
+func GetModuleCreator(name string) (ModuleCreator, bool) {
+	moduleCreatorsMtx.RLock()
+	defer moduleCreatorsMtx.RUnlock()
+	creator, ok := moduleCreators[name]
+	return creator, ok
+}
+
+// RegisteredModuleNames returns the names of all the modules registered in order
+func RegisteredModuleNames() []string {
+	moduleCreatorsMtx.RLock()
+	defer moduleCreatorsMtx.RUnlock()
+	names := make([]string, 0, len(moduleCreators))
+	for name := range moduleCreators {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -9,6 +9,12 @@ type Msg interface {
 	GetSigners() []AccAddress
 }
 
+// MsgWithRecipients shows the expected behavior of the msgs that send assets to the recipient addresses
+type MsgWithRecipients interface {
+	Msg
+	GetRecipients() []AccAddress
+}
+
 // Tx shows the expected behavior of any txs of OKChain
 type Tx interface {
 	GetMsgs() []Msg
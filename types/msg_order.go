@@ -0,0 +1,89 @@
+package types
+
+import (
+	"fmt"
+
+	sdkerrors "github.com/okex/okchain-go-sdk/types/errors"
+)
+
+// The msgs of a tx are signed and executed in the exact order given to the builder, which keeps a copy of the slice
+// once called. The order is only changed explicitly by ReorderMsgs or the tx option WithMsgOrder, and the compositions
+// sensitive to the order are checked by the rules registered before the tx is signed
+
+// MsgOrderRule - structure of a rule on the order of two kinds of msgs in a tx, where every msg matched by After must
+// follow all the msgs matched by Before, e.g. the delegation of the rewards withdrawn in the same tx
+type MsgOrderRule struct {
+	Name   string
+	Reason string
+	Before func(msg Msg) bool
+	After  func(msg Msg) bool
+}
+
+var msgOrderRules []MsgOrderRule
+
+// RegisterMsgOrderRule adds a rule checked on the order of the msgs of every tx. It should be called in the init of
+// the package providing the msgs
+func RegisterMsgOrderRule(rule MsgOrderRule) {
+	for _, registered := range msgOrderRules {
+		if registered.Name == rule.Name {
+			panic(fmt.Sprintf("duplicated msg order rule: %s", rule.Name))
+		}
+	}
+	msgOrderRules = append(msgOrderRules, rule)
+}
+
+// MsgOrderRules returns the rules registered on the order of the msgs
+func MsgOrderRules() []MsgOrderRule {
+	return append([]MsgOrderRule(nil), msgOrderRules...)
+}
+
+// ValidateMsgOrder checks the msgs of a tx against the rules registered on their order
+func ValidateMsgOrder(msgs []Msg) error {
+	for _, rule := range msgOrderRules {
+		if err := rule.check(msgs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (rule MsgOrderRule) check(msgs []Msg) error {
+	lastBefore := -1
+	for i, msg := range msgs {
+		if rule.Before(msg) {
+			lastBefore = i
+		}
+	}
+
+	for i := 0; i < lastBefore; i++ {
+		if rule.After(msgs[i]) {
+			return sdkerrors.Wrapf(sdkerrors.ErrMsgOrder, "failed. msg %d of %T must follow msg %d of %T by rule %s: %s",
+				i, msgs[i], lastBefore, msgs[lastBefore], rule.Name, rule.Reason)
+		}
+	}
+	return nil
+}
+
+// ReorderMsgs returns a new slice of the msgs in the order of their indexes given, which must be a permutation of the
+// indexes of the msgs
+func ReorderMsgs(msgs []Msg, order ...int) ([]Msg, error) {
+	if len(order) != len(msgs) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrMsgOrder, "failed. %d indexes given for %d msgs", len(order),
+			len(msgs))
+	}
+
+	reordered := make([]Msg, len(msgs))
+	taken := make([]bool, len(msgs))
+	for i, index := range order {
+		if index < 0 || index >= len(msgs) {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrMsgOrder, "failed. index %d out of range [0, %d)", index,
+				len(msgs))
+		}
+		if taken[index] {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrMsgOrder, "failed. duplicated index %d", index)
+		}
+		taken[index] = true
+		reordered[i] = msgs[index]
+	}
+	return reordered, nil
+}
@@ -0,0 +1,47 @@
+package types
+
+import (
+	"errors"
+	"testing"
+
+	sdkerrors "github.com/okex/okchain-go-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+type orderMsg struct {
+	Msg
+	kind string
+}
+
+func TestMsgOrderRule(t *testing.T) {
+	rule := MsgOrderRule{
+		Name:   "withdraw-before-delegate",
+		Before: func(msg Msg) bool { return msg.(orderMsg).kind == "withdraw" },
+		After:  func(msg Msg) bool { return msg.(orderMsg).kind == "delegate" },
+	}
+	withdraw, delegate, send := orderMsg{kind: "withdraw"}, orderMsg{kind: "delegate"}, orderMsg{kind: "send"}
+
+	require.NoError(t, rule.check([]Msg{withdraw, send, delegate}))
+	require.NoError(t, rule.check([]Msg{delegate, send}))
+	require.NoError(t, rule.check([]Msg{withdraw, withdraw}))
+
+	err := rule.check([]Msg{withdraw, delegate, send, withdraw})
+	require.True(t, errors.Is(err, sdkerrors.ErrMsgOrder))
+	require.Contains(t, err.Error(), "msg 1")
+	require.Contains(t, err.Error(), "msg 3")
+}
+
+func TestReorderMsgs(t *testing.T) {
+	a, b, c := orderMsg{kind: "a"}, orderMsg{kind: "b"}, orderMsg{kind: "c"}
+	msgs := []Msg{a, b, c}
+
+	reordered, err := ReorderMsgs(msgs, 2, 0, 1)
+	require.NoError(t, err)
+	require.Equal(t, []Msg{c, a, b}, reordered)
+	require.Equal(t, []Msg{a, b, c}, msgs)
+
+	for _, order := range [][]int{{0, 1}, {0, 1, 3}, {0, 1, -1}, {0, 1, 1}} {
+		_, err = ReorderMsgs(msgs, order...)
+		require.True(t, errors.Is(err, sdkerrors.ErrMsgOrder), "%v", order)
+	}
+}
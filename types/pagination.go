@@ -0,0 +1,164 @@
+package types
+
+import (
+	"errors"
+)
+
+const (
+	defaultPageLimit = 50
+	maxPageLimit     = 200
+
+	// TotalUnknown is the total count of a paged result whose node doesn't report it
+	TotalUnknown = -1
+)
+
+// ParamPage - structure of the page params in the list responses of the backend module
+type ParamPage struct {
+	Page    int `json:"page"`
+	PerPage int `json:"per_page"`
+	Total   int `json:"total"`
+}
+
+// PageRequest - structure of the paging params for a list query
+type PageRequest struct {
+	Page  int
+	Limit int
+}
+
+// NewPageRequest creates a new instance of PageRequest
+// The default page 1 and limit 50 will be filled in if they are zero
+func NewPageRequest(page, limit int) PageRequest {
+	if page == 0 {
+		page = 1
+	}
+	if limit == 0 {
+		limit = defaultPageLimit
+	}
+	return PageRequest{
+		Page:  page,
+		Limit: limit,
+	}
+}
+
+// ValidateBasic gives a quick validity check for the page request
+func (pr PageRequest) ValidateBasic() error {
+	if pr.Page <= 0 {
+		return errors.New("failed. page must be greater than 0")
+	}
+	if pr.Limit <= 0 || pr.Limit > maxPageLimit {
+		return errors.New("failed. limit must be in the range of (0, 200]")
+	}
+	return nil
+}
+
+// Offset returns the index of the first item on the page
+func (pr PageRequest) Offset() int {
+	return (pr.Page - 1) * pr.Limit
+}
+
+// Next returns the page request of the subsequent page
+func (pr PageRequest) Next() PageRequest {
+	return PageRequest{
+		Page:  pr.Page + 1,
+		Limit: pr.Limit,
+	}
+}
+
+// PagedResult - structure of the paging info attached to the result of a list query
+type PagedResult struct {
+	Page    int
+	Limit   int
+	Total   int
+	HasNext bool
+}
+
+// NewPagedResult creates a new instance of PagedResult with the count of items fetched on the page
+// If the total is unknown, it's regarded as a subsequent page existing when the page is full
+func NewPagedResult(pr PageRequest, count, total int) PagedResult {
+	hasNext := count >= pr.Limit
+	if total != TotalUnknown {
+		hasNext = pr.Offset()+count < total
+	}
+
+	return PagedResult{
+		Page:    pr.Page,
+		Limit:   pr.Limit,
+		Total:   total,
+		HasNext: hasNext,
+	}
+}
+
+// PaginateSlice returns the index range [start, end) on a slice with the length total for the page request, which is
+// useful for the pagination on the client side
+func PaginateSlice(pr PageRequest, total int) (start, end int) {
+	start = pr.Offset()
+	if start > total {
+		start = total
+	}
+
+	end = start + pr.Limit
+	if end > total {
+		end = total
+	}
+	return
+}
+
+// PageFetcher fetches the items on a page and keeps them by itself
+type PageFetcher func(pr PageRequest) (PagedResult, error)
+
+// PageIterator - structure of the iterator that fetches the subsequent pages automatically
+type PageIterator struct {
+	fetcher PageFetcher
+	next    PageRequest
+	done    bool
+	err     error
+	current PagedResult
+}
+
+// NewPageIterator creates a new instance of PageIterator from the first page request
+func NewPageIterator(first PageRequest, fetcher PageFetcher) *PageIterator {
+	return &PageIterator{
+		fetcher: fetcher,
+		next:    first,
+	}
+}
+
+// Next fetches the subsequent page and returns false when there's no more pages or an error occurs
+func (pi *PageIterator) Next() bool {
+	if pi.done {
+		return false
+	}
+
+	if pi.err = pi.next.ValidateBasic(); pi.err != nil {
+		pi.done = true
+		return false
+	}
+
+	pi.current, pi.err = pi.fetcher(pi.next)
+	if pi.err != nil {
+		pi.done = true
+		return false
+	}
+
+	pi.done = !pi.current.HasNext
+	pi.next = pi.next.Next()
+	return true
+}
+
+// Current returns the paging info of the page fetched by the latest Next
+func (pi *PageIterator) Current() PagedResult {
+	return pi.current
+}
+
+// Err returns the error occurred during the iteration
+func (pi *PageIterator) Err() error {
+	return pi.err
+}
+
+// FetchAllPages iterates all the pages from the first page request
+func FetchAllPages(first PageRequest, fetcher PageFetcher) error {
+	iterator := NewPageIterator(first, fetcher)
+	for iterator.Next() {
+	}
+	return iterator.Err()
+}
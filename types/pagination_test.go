@@ -0,0 +1,60 @@
+package types
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPageRequest(t *testing.T) {
+	pageReq := NewPageRequest(0, 0)
+	require.Equal(t, PageRequest{1, defaultPageLimit}, pageReq)
+	require.NoError(t, pageReq.ValidateBasic())
+	require.Equal(t, 0, pageReq.Offset())
+	require.Equal(t, PageRequest{2, defaultPageLimit}, pageReq.Next())
+
+	require.Error(t, PageRequest{0, 10}.ValidateBasic())
+	require.Error(t, PageRequest{1, -1}.ValidateBasic())
+	require.Error(t, PageRequest{1, maxPageLimit + 1}.ValidateBasic())
+
+	start, end := PaginateSlice(NewPageRequest(2, 10), 15)
+	require.Equal(t, 10, start)
+	require.Equal(t, 15, end)
+	start, end = PaginateSlice(NewPageRequest(3, 10), 15)
+	require.Equal(t, 15, start)
+	require.Equal(t, 15, end)
+}
+
+func TestPagedResult(t *testing.T) {
+	require.True(t, NewPagedResult(NewPageRequest(1, 10), 10, 11).HasNext)
+	require.False(t, NewPagedResult(NewPageRequest(2, 10), 1, 11).HasNext)
+	require.True(t, NewPagedResult(NewPageRequest(1, 10), 10, TotalUnknown).HasNext)
+	require.False(t, NewPagedResult(NewPageRequest(1, 10), 9, TotalUnknown).HasNext)
+}
+
+func TestPageIterator(t *testing.T) {
+	items := []int{1, 2, 3, 4, 5, 6, 7}
+	var fetched []int
+	fetcher := func(pr PageRequest) (PagedResult, error) {
+		start, end := PaginateSlice(pr, len(items))
+		fetched = append(fetched, items[start:end]...)
+		return NewPagedResult(pr, end-start, len(items)), nil
+	}
+
+	require.NoError(t, FetchAllPages(NewPageRequest(1, 3), fetcher))
+	require.Equal(t, items, fetched)
+
+	// stop on the error
+	iterator := NewPageIterator(NewPageRequest(1, 3), func(pr PageRequest) (PagedResult, error) {
+		if pr.Page == 2 {
+			return PagedResult{}, errors.New("default error")
+		}
+		return NewPagedResult(pr, 3, TotalUnknown), nil
+	})
+	require.True(t, iterator.Next())
+	require.Equal(t, 1, iterator.Current().Page)
+	require.False(t, iterator.Next())
+	require.Error(t, iterator.Err())
+	require.False(t, iterator.Next())
+}
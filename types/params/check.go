@@ -6,7 +6,7 @@ import (
 	"regexp"
 	"strings"
 
-	tokentypes "github.com/okex/okchain-go-sdk/module/token/types"
+	sdk "github.com/okex/okchain-go-sdk/types"
 	"github.com/okex/okchain-go-sdk/types/crypto/keys"
 )
 
@@ -118,7 +118,7 @@ func CheckTokenIssueParams(fromInfo keys.Info, passWd, orgSymbol, wholeName, tok
 }
 
 // CheckTransferUnitsParams gives a quick validity check for the input params of multi-send
-func CheckTransferUnitsParams(fromInfo keys.Info, passWd string, transfers []tokentypes.TransferUnit) error {
+func CheckTransferUnitsParams(fromInfo keys.Info, passWd string, transfers []sdk.TransferUnit) error {
 	if err := CheckKeyParams(fromInfo, passWd); err != nil {
 		return err
 	}
@@ -278,8 +278,9 @@ func CheckQueryOrdersParams(addrStr, product, side string, start, end, page, per
 		return perPageRet, errors.New("failed. empty product")
 	}
 
-	if !isValidSide(side) {
-		return perPageRet, errors.New(`failed. "side" must only be "BUY" or "SELL"`)
+	// empty side for the orders on both sides
+	if len(side) != 0 && !isValidSide(side) {
+		return perPageRet, errors.New(`failed. "side" must only be "BUY", "SELL" or empty`)
 
 	}
 
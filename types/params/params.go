@@ -230,3 +230,15 @@ func NewQueryDexInfoParams(owner string, page, perPage int) (QueryDexInfoParams,
 		PerPage: perPage,
 	}, nil
 }
+
+// QueryProposalParams defines query params of a proposal
+type QueryProposalParams struct {
+	ProposalID uint64
+}
+
+// NewQueryProposalParams creates a new instance of QueryProposalParams
+func NewQueryProposalParams(proposalID uint64) QueryProposalParams {
+	return QueryProposalParams{
+		ProposalID: proposalID,
+	}
+}
@@ -0,0 +1,62 @@
+package types
+
+import (
+	sdkerrors "github.com/okex/okchain-go-sdk/types/errors"
+)
+
+// PreSignHook shows the expected behavior of the policy engine approving or vetoing every tx before it's signed, e.g.
+// by the rules on the amounts sent and the recipient addresses
+type PreSignHook interface {
+	BeforeSign(req PreSignRequest) error
+}
+
+// PreSignRequest - structure of the tx about to be signed, which is passed to the pre-sign hook
+type PreSignRequest struct {
+	ChainID       string
+	Signer        AccAddress
+	AccountNumber uint64
+	Sequence      uint64
+	// Msgs are the concrete msgs of the modules
+	Msgs []Msg
+	Fee  StdFee
+	Memo string
+}
+
+var _ PreSignHook = PreSignHookFunc(nil)
+
+// PreSignHookFunc adapts a function to the PreSignHook interface
+type PreSignHookFunc func(req PreSignRequest) error
+
+// BeforeSign implements the PreSignHook interface
+func (f PreSignHookFunc) BeforeSign(req PreSignRequest) error {
+	return f(req)
+}
+
+var _ PreSignHook = PreSignHooks(nil)
+
+// PreSignHooks combines multiple pre-sign hooks, which vetoes the tx by the first hook vetoing it
+type PreSignHooks []PreSignHook
+
+// BeforeSign implements the PreSignHook interface
+func (hooks PreSignHooks) BeforeSign(req PreSignRequest) error {
+	for _, hook := range hooks {
+		if err := hook.BeforeSign(req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// EnforcePreSignHook refuses the tx vetoed by the pre-sign hook of the config with ErrSignVetoed, which keeps the error
+// of the hook in the chain
+func EnforcePreSignHook(config ClientConfig, req PreSignRequest) error {
+	if config.PreSignHook == nil {
+		return nil
+	}
+
+	if err := config.PreSignHook.BeforeSign(req); err != nil {
+		return sdkerrors.WrapCause(sdkerrors.ErrSignVetoed, err, "failed. the tx of %s with sequence %d is vetoed: %s",
+			req.Signer, req.Sequence, err)
+	}
+	return nil
+}
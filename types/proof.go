@@ -0,0 +1,12 @@
+package types
+
+import (
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// HeaderVerifier shows the expected behavior to provide the verified headers, whose app hashes the merkle proofs of the
+// store queries are verified against
+type HeaderVerifier interface {
+	// VerifiedHeader returns the header at the height once it's verified, e.g. by a trusted hash or a light client
+	VerifiedHeader(height int64) (tmtypes.Header, error)
+}
@@ -0,0 +1,174 @@
+package proof
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/tendermint/go-amino"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	cmn "github.com/tendermint/tendermint/libs/common"
+)
+
+// ProofOpIAVLValue is the type of the proof op of the existence of a key in the iavl store
+const ProofOpIAVLValue = "iavl:v"
+
+var _ merkle.ProofOperator = IAVLValueOp{}
+
+// IAVLValueOp - structure of the proof op of the existence of a key in the iavl store, in the same amino layout as the
+// value op of the iavl on the node
+type IAVLValueOp struct {
+	// encoded in the key of the proof op
+	key []byte
+	// encoded in the data of the proof op
+	Proof *RangeProof `json:"proof"`
+}
+
+// NewIAVLValueOp creates a new instance of IAVLValueOp
+func NewIAVLValueOp(key []byte, proof *RangeProof) IAVLValueOp {
+	return IAVLValueOp{
+		key:   key,
+		Proof: proof,
+	}
+}
+
+// IAVLValueOpDecoder decodes the iavl value op from the proof op
+func IAVLValueOpDecoder(pop merkle.ProofOp) (merkle.ProofOperator, error) {
+	if pop.Type != ProofOpIAVLValue {
+		return nil, fmt.Errorf("failed. unexpected proof op type %s instead of %s", pop.Type, ProofOpIAVLValue)
+	}
+
+	var op IAVLValueOp
+	if err := cdc.UnmarshalBinaryLengthPrefixed(pop.Data, &op); err != nil {
+		return nil, fmt.Errorf("failed. decode the iavl value op error: %w", err)
+	}
+	if op.Proof == nil {
+		return nil, errors.New("failed. empty range proof in the iavl value op")
+	}
+	return NewIAVLValueOp(pop.Key, op.Proof), nil
+}
+
+// ProofOp encodes the iavl value op to the proof op
+func (op IAVLValueOp) ProofOp() merkle.ProofOp {
+	return merkle.ProofOp{
+		Type: ProofOpIAVLValue,
+		Key:  op.key,
+		Data: cdc.MustMarshalBinaryLengthPrefixed(op),
+	}
+}
+
+// GetKey returns the key in the iavl store
+func (op IAVLValueOp) GetKey() []byte {
+	return op.key
+}
+
+// Run verifies the value against the leaf of the key and returns the root hash of the iavl store
+func (op IAVLValueOp) Run(args [][]byte) ([][]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("failed. expect 1 value but got %d", len(args))
+	}
+
+	root, err := op.Proof.ComputeRootHash()
+	if err != nil {
+		return nil, err
+	}
+	if err = op.Proof.VerifyItem(op.key, args[0]); err != nil {
+		return nil, err
+	}
+	return [][]byte{root}, nil
+}
+
+// RangeProof - structure of the range proof of the iavl store. Only the existence proof of a single key is supported,
+// which is the proof of the store query of a key
+type RangeProof struct {
+	LeftPath   PathToLeaf      `json:"left_path"`
+	InnerNodes []PathToLeaf    `json:"inner_nodes"`
+	Leaves     []ProofLeafNode `json:"leaves"`
+}
+
+// ComputeRootHash computes the root hash of the iavl store from the leaf by the path
+func (rp *RangeProof) ComputeRootHash() ([]byte, error) {
+	if len(rp.Leaves) != 1 || len(rp.InnerNodes) != 0 {
+		return nil, errors.New("failed. only the existence proof of a single key is supported")
+	}
+
+	return rp.LeftPath.computeRootHash(rp.Leaves[0].Hash()), nil
+}
+
+// VerifyItem verifies the key and the hash of the value in the leaf
+func (rp *RangeProof) VerifyItem(key, value []byte) error {
+	if len(rp.Leaves) != 1 {
+		return errors.New("failed. only the existence proof of a single key is supported")
+	}
+
+	leaf := rp.Leaves[0]
+	if !bytes.Equal(leaf.Key, key) {
+		return fmt.Errorf("failed. the leaf key %X doesn't match the key %X", []byte(leaf.Key), key)
+	}
+	if !bytes.Equal(leaf.ValueHash, tmhash.Sum(value)) {
+		return fmt.Errorf("failed. the leaf value hash doesn't match the value of the key %X", key)
+	}
+	return nil
+}
+
+// PathToLeaf - the inner nodes from the root to the leaf
+type PathToLeaf []ProofInnerNode
+
+func (pl PathToLeaf) computeRootHash(leafHash []byte) []byte {
+	hash := leafHash
+	for i := len(pl) - 1; i >= 0; i-- {
+		hash = pl[i].Hash(hash)
+	}
+	return hash
+}
+
+// ProofInnerNode - structure of an inner node on the path to the leaf, with the hash of the child off the path
+type ProofInnerNode struct {
+	Height  int8   `json:"height"`
+	Size    int64  `json:"size"`
+	Version int64  `json:"version"`
+	Left    []byte `json:"left"`
+	Right   []byte `json:"right"`
+}
+
+// Hash computes the hash of the inner node with the hash of the child on the path
+func (pin ProofInnerNode) Hash(childHash []byte) []byte {
+	buf := new(bytes.Buffer)
+	mustEncode(amino.EncodeInt8(buf, pin.Height))
+	mustEncode(amino.EncodeVarint(buf, pin.Size))
+	mustEncode(amino.EncodeVarint(buf, pin.Version))
+	if len(pin.Left) == 0 {
+		mustEncode(amino.EncodeByteSlice(buf, childHash))
+		mustEncode(amino.EncodeByteSlice(buf, pin.Right))
+	} else {
+		mustEncode(amino.EncodeByteSlice(buf, pin.Left))
+		mustEncode(amino.EncodeByteSlice(buf, childHash))
+	}
+	return tmhash.Sum(buf.Bytes())
+}
+
+// ProofLeafNode - structure of the leaf of the key with the hash of its value
+type ProofLeafNode struct {
+	Key       cmn.HexBytes `json:"key"`
+	ValueHash cmn.HexBytes `json:"value"`
+	Version   int64        `json:"version"`
+}
+
+// Hash computes the hash of the leaf node
+func (pln ProofLeafNode) Hash() []byte {
+	buf := new(bytes.Buffer)
+	mustEncode(amino.EncodeInt8(buf, 0))
+	mustEncode(amino.EncodeVarint(buf, 1))
+	mustEncode(amino.EncodeVarint(buf, pln.Version))
+	mustEncode(amino.EncodeByteSlice(buf, pln.Key))
+	mustEncode(amino.EncodeByteSlice(buf, pln.ValueHash))
+	return tmhash.Sum(buf.Bytes())
+}
+
+// mustEncode panics on the error of writing to the bytes buffer, which never happens
+func mustEncode(err error) {
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,117 @@
+package proof
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/tendermint/tendermint/crypto/merkle"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+)
+
+// ProofOpMultiStore is the type of the proof op of the root hash of a store in the multistore
+const ProofOpMultiStore = "multistore"
+
+var _ merkle.ProofOperator = MultiStoreProofOp{}
+
+// MultiStoreProofOp - structure of the proof op of the root hash of a store in the multistore, in the same amino layout
+// as the multistore proof op on the node
+type MultiStoreProofOp struct {
+	// the store name encoded in the key of the proof op
+	key []byte
+	// encoded in the data of the proof op
+	Proof *MultiStoreProof `json:"proof"`
+}
+
+// NewMultiStoreProofOp creates a new instance of MultiStoreProofOp
+func NewMultiStoreProofOp(storeName []byte, proof *MultiStoreProof) MultiStoreProofOp {
+	return MultiStoreProofOp{
+		key:   storeName,
+		Proof: proof,
+	}
+}
+
+// MultiStoreProofOpDecoder decodes the multistore proof op from the proof op
+func MultiStoreProofOpDecoder(pop merkle.ProofOp) (merkle.ProofOperator, error) {
+	if pop.Type != ProofOpMultiStore {
+		return nil, fmt.Errorf("failed. unexpected proof op type %s instead of %s", pop.Type, ProofOpMultiStore)
+	}
+
+	var op MultiStoreProofOp
+	if err := cdc.UnmarshalBinaryLengthPrefixed(pop.Data, &op); err != nil {
+		return nil, fmt.Errorf("failed. decode the multistore proof op error: %w", err)
+	}
+	if op.Proof == nil {
+		return nil, errors.New("failed. empty proof in the multistore proof op")
+	}
+	return NewMultiStoreProofOp(pop.Key, op.Proof), nil
+}
+
+// ProofOp encodes the multistore proof op to the proof op
+func (op MultiStoreProofOp) ProofOp() merkle.ProofOp {
+	return merkle.ProofOp{
+		Type: ProofOpMultiStore,
+		Key:  op.key,
+		Data: cdc.MustMarshalBinaryLengthPrefixed(op),
+	}
+}
+
+// GetKey returns the store name
+func (op MultiStoreProofOp) GetKey() []byte {
+	return op.key
+}
+
+// Run verifies the root hash of the store in the multistore and returns the app hash
+func (op MultiStoreProofOp) Run(args [][]byte) ([][]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("failed. expect 1 root hash but got %d", len(args))
+	}
+
+	for _, si := range op.Proof.StoreInfos {
+		if si.Name != string(op.key) {
+			continue
+		}
+		if !bytes.Equal(si.Core.CommitID.Hash, args[0]) {
+			return nil, fmt.Errorf("failed. the root hash of the store %s mismatches: %X vs %X", si.Name,
+				si.Core.CommitID.Hash, args[0])
+		}
+		return [][]byte{op.Proof.ComputeRootHash()}, nil
+	}
+	return nil, fmt.Errorf("failed. store %s not found in the multistore proof", op.key)
+}
+
+// MultiStoreProof - structure of the commit info of all the stores in the multistore
+type MultiStoreProof struct {
+	StoreInfos []StoreInfo
+}
+
+// ComputeRootHash computes the app hash from the commit info of all the stores
+func (proof *MultiStoreProof) ComputeRootHash() []byte {
+	m := make(map[string][]byte, len(proof.StoreInfos))
+	for _, si := range proof.StoreInfos {
+		m[si.Name] = si.Hash()
+	}
+	return merkle.SimpleHashFromMap(m)
+}
+
+// StoreInfo - structure of the commit info of a store
+type StoreInfo struct {
+	Name string
+	Core StoreCore
+}
+
+// Hash computes the hash of the commit info of the store. The name is excluded, which is the key in the simple map
+func (si StoreInfo) Hash() []byte {
+	return tmhash.Sum(cdc.MustMarshalBinaryLengthPrefixed(si.Core))
+}
+
+// StoreCore - structure of the commit of a store
+type StoreCore struct {
+	CommitID CommitID
+}
+
+// CommitID - structure of the version and the root hash of a store
+type CommitID struct {
+	Version int64
+	Hash    []byte
+}
@@ -0,0 +1,54 @@
+// Package proof provides the verification of the merkle proofs of the store queries against the app hashes of the
+// verified headers, so that the query results from the untrusted public rpc nodes are validated locally as a light
+// client does.
+package proof
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/tendermint/go-amino"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/merkle"
+)
+
+// cdc is the codec to decode the proof ops, which are amino encoded structs without registration
+var cdc = amino.NewCodec()
+
+// runtime is the proof runtime with the proof ops of the store queries registered
+var runtime = DefaultProofRuntime()
+
+// DefaultProofRuntime returns the proof runtime with the proof ops of the iavl stores in the multistore registered
+func DefaultProofRuntime() *merkle.ProofRuntime {
+	prt := merkle.DefaultProofRuntime()
+	prt.RegisterOpDecoder(ProofOpIAVLValue, IAVLValueOpDecoder)
+	prt.RegisterOpDecoder(ProofOpMultiStore, MultiStoreProofOpDecoder)
+	return prt
+}
+
+// KeyPath returns the key path of the key in the store, which the proof ops are verified along
+func KeyPath(storeName string, key []byte) string {
+	return merkle.KeyPath{}.
+		AppendKey([]byte(storeName), merkle.KeyEncodingURL).
+		AppendKey(key, merkle.KeyEncodingHex).
+		String()
+}
+
+// VerifyStoreValue verifies the value of the key in the response of the store query by its merkle proof against the app
+// hash. The state at the height of the response is committed by the app hash in the header of the next block
+func VerifyStoreValue(storeName string, key []byte, resp abci.ResponseQuery, appHash []byte) error {
+	if resp.Proof == nil || len(resp.Proof.Ops) == 0 {
+		return errors.New("failed. no merkle proof in the query response")
+	}
+	if len(resp.Value) == 0 {
+		return fmt.Errorf("failed. the absence of the key %X in the store %s is unverifiable", key, storeName)
+	}
+	if len(appHash) == 0 {
+		return errors.New("failed. empty app hash to verify against")
+	}
+
+	if err := runtime.VerifyValue(resp.Proof, appHash, KeyPath(storeName, key), resp.Value); err != nil {
+		return fmt.Errorf("failed. invalid merkle proof of the key %X in the store %s: %w", key, storeName, err)
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package proof
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+)
+
+func newStoreProof(storeName string, key, value []byte) (*merkle.Proof, []byte) {
+	rangeProof := &RangeProof{
+		LeftPath: PathToLeaf{
+			{Height: 2, Size: 3, Version: 10, Left: tmhash.Sum([]byte("left"))},
+			{Height: 1, Size: 2, Version: 10, Right: tmhash.Sum([]byte("right"))},
+		},
+		Leaves: []ProofLeafNode{{Key: key, ValueHash: tmhash.Sum(value), Version: 10}},
+	}
+	storeRoot, _ := rangeProof.ComputeRootHash()
+	multiStoreProof := &MultiStoreProof{StoreInfos: []StoreInfo{
+		{Name: "acc", Core: StoreCore{CommitID: CommitID{Version: 10, Hash: tmhash.Sum([]byte("acc"))}}},
+		{Name: storeName, Core: StoreCore{CommitID: CommitID{Version: 10, Hash: storeRoot}}},
+	}}
+
+	return &merkle.Proof{Ops: []merkle.ProofOp{
+		NewIAVLValueOp(key, rangeProof).ProofOp(),
+		NewMultiStoreProofOp([]byte(storeName), multiStoreProof).ProofOp(),
+	}}, multiStoreProof.ComputeRootHash()
+}
+
+func TestVerifyStoreValue(t *testing.T) {
+	key, value := []byte{0x01, 0x2f, 0xff}, []byte("value")
+	storeProof, appHash := newStoreProof("token", key, value)
+	resp := abci.ResponseQuery{Key: key, Value: value, Height: 10, Proof: storeProof}
+	require.NoError(t, VerifyStoreValue("token", key, resp, appHash))
+
+	// tampered value
+	tampered := resp
+	tampered.Value = []byte("tampered")
+	require.Error(t, VerifyStoreValue("token", key, tampered, appHash))
+
+	// another key or store
+	require.Error(t, VerifyStoreValue("token", []byte{0x01}, resp, appHash))
+	require.Error(t, VerifyStoreValue("acc", key, resp, appHash))
+
+	// untrusted app hash
+	require.Error(t, VerifyStoreValue("token", key, resp, tmhash.Sum([]byte("app hash"))))
+	require.Error(t, VerifyStoreValue("token", key, resp, nil))
+
+	// no proof or absence
+	require.Error(t, VerifyStoreValue("token", key, abci.ResponseQuery{Key: key, Value: value}, appHash))
+	absence := resp
+	absence.Value = nil
+	require.Error(t, VerifyStoreValue("token", key, absence, appHash))
+
+	// unknown proof op
+	unknown := resp
+	unknown.Proof = &merkle.Proof{Ops: []merkle.ProofOp{{Type: "iavl:a", Key: key}, storeProof.Ops[1]}}
+	require.Error(t, VerifyStoreValue("token", key, unknown, appHash))
+}
+
+func TestIAVLValueOpDecoder(t *testing.T) {
+	key, value := []byte("key"), []byte("value")
+	storeProof, _ := newStoreProof("token", key, value)
+
+	op, err := IAVLValueOpDecoder(storeProof.Ops[0])
+	require.NoError(t, err)
+	require.Equal(t, key, op.GetKey())
+	require.Equal(t, storeProof.Ops[0], op.(IAVLValueOp).ProofOp())
+
+	_, err = IAVLValueOpDecoder(storeProof.Ops[1])
+	require.Error(t, err)
+	_, err = IAVLValueOpDecoder(merkle.ProofOp{Type: ProofOpIAVLValue, Data: []byte("invalid")})
+	require.Error(t, err)
+
+	// range proofs of multiple keys
+	rangeProof := op.(IAVLValueOp).Proof
+	rangeProof.Leaves = append(rangeProof.Leaves, rangeProof.Leaves[0])
+	_, err = op.Run([][]byte{value})
+	require.Error(t, err)
+}
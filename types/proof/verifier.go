@@ -0,0 +1,93 @@
+package proof
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	sdk "github.com/okex/okchain-go-sdk/types"
+	"github.com/tendermint/tendermint/lite"
+	rpc "github.com/tendermint/tendermint/rpc/client"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+var (
+	_ sdk.HeaderVerifier = (*TrustedHeaderVerifier)(nil)
+	_ sdk.HeaderVerifier = (*LiteHeaderVerifier)(nil)
+)
+
+// TrustedHeaderVerifier - structure of the header verifier trusting a single header by its height and hash supplied by
+// the user, e.g. from a block explorer or an own node
+type TrustedHeaderVerifier struct {
+	client rpc.SignClient
+	height int64
+	hash   []byte
+}
+
+// NewTrustedHeaderVerifier creates a new instance of TrustedHeaderVerifier
+func NewTrustedHeaderVerifier(client rpc.SignClient, height int64, hash []byte) (*TrustedHeaderVerifier, error) {
+	if height <= 0 {
+		return nil, fmt.Errorf("failed. invalid trusted height %d", height)
+	}
+	if len(hash) == 0 {
+		return nil, errors.New("failed. empty trusted hash")
+	}
+
+	return &TrustedHeaderVerifier{
+		client: client,
+		height: height,
+		hash:   hash,
+	}, nil
+}
+
+// VerifiedHeader returns the header at the trusted height once its hash matches the trusted one
+// Only the state at the height before the trusted height is verifiable by it
+func (v *TrustedHeaderVerifier) VerifiedHeader(height int64) (header tmtypes.Header, err error) {
+	if height != v.height {
+		return header, fmt.Errorf("failed. only the header at the trusted height %d is verifiable instead of %d",
+			v.height, height)
+	}
+
+	res, err := v.client.Commit(&height)
+	if err != nil {
+		return header, fmt.Errorf("failed. query commit error: %w", err)
+	}
+	if res.Header == nil {
+		return header, fmt.Errorf("failed. no header at height %d", height)
+	}
+	if !bytes.Equal(res.Header.Hash(), v.hash) {
+		return header, fmt.Errorf("failed. the header hash %X at height %d doesn't match the trusted hash %X",
+			res.Header.Hash(), height, v.hash)
+	}
+	return *res.Header, nil
+}
+
+// LiteHeaderVerifier - structure of the header verifier by a light client verifier of tendermint, which verifies the
+// commit signatures of the headers by the trusted validator sets
+type LiteHeaderVerifier struct {
+	client   rpc.SignClient
+	verifier lite.Verifier
+}
+
+// NewLiteHeaderVerifier creates a new instance of LiteHeaderVerifier
+func NewLiteHeaderVerifier(client rpc.SignClient, verifier lite.Verifier) *LiteHeaderVerifier {
+	return &LiteHeaderVerifier{
+		client:   client,
+		verifier: verifier,
+	}
+}
+
+// VerifiedHeader returns the header at the height once the light client verifier verifies its signed header
+func (v *LiteHeaderVerifier) VerifiedHeader(height int64) (header tmtypes.Header, err error) {
+	res, err := v.client.Commit(&height)
+	if err != nil {
+		return header, fmt.Errorf("failed. query commit error: %w", err)
+	}
+	if res.Header == nil || res.Header.Height != height {
+		return header, fmt.Errorf("failed. no header at height %d", height)
+	}
+	if err = v.verifier.Verify(res.SignedHeader); err != nil {
+		return header, fmt.Errorf("failed. verify the header at height %d error: %w", height, err)
+	}
+	return *res.Header, nil
+}
@@ -0,0 +1,166 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// QueryCacheConfig - structure of the config of the query cache
+type QueryCacheConfig struct {
+	// DefaultTTL is the time the responses of the queries out of TTLs are cached for, not cached if 0
+	DefaultTTL time.Duration
+	// TTLs are the times the responses are cached for by the path prefixes of the queries, where the longest prefix
+	// matched wins and 0 disables the caching, e.g.
+	//
+	//	map[string]time.Duration{
+	//		"custom/staking/":        time.Minute,
+	//		"custom/token/":          time.Hour,
+	//		"custom/gov/proposals":   10 * time.Second,
+	//		"custom/order/depthbook": 0,
+	//	}
+	TTLs map[string]time.Duration
+	// MaxEntries is the max number of the responses cached, unlimited if 0. The ones expiring first are evicted for
+	// the new ones
+	MaxEntries int
+}
+
+// ValidateBasic gives a quick validity check for the query cache config
+func (qcc QueryCacheConfig) ValidateBasic() error {
+	if qcc.DefaultTTL < 0 || qcc.MaxEntries < 0 {
+		return errors.New("failed. default ttl and max entries of the query cache must not be negative")
+	}
+	for prefix, ttl := range qcc.TTLs {
+		if ttl < 0 {
+			return fmt.Errorf("failed. ttl of the queries of path %s must not be negative: %s", prefix, ttl)
+		}
+	}
+	return nil
+}
+
+// TTL returns the time the responses of the query of the path are cached for
+func (qcc QueryCacheConfig) TTL(path string) time.Duration {
+	ttl, matched := qcc.DefaultTTL, -1
+	for prefix, prefixTTL := range qcc.TTLs {
+		if len(prefix) > matched && strings.HasPrefix(path, prefix) {
+			ttl, matched = prefixTTL, len(prefix)
+		}
+	}
+	return ttl
+}
+
+type queryCacheKey struct {
+	path   string
+	data   string
+	height int64
+}
+
+type queryCacheEntry struct {
+	value  []byte
+	expiry time.Time
+}
+
+// QueryCache - structure of the cache of the responses of the idempotent queries with the TTLs by path, which is safe
+// for concurrent use and could be shared by the views of the client, e.g. for the dashboards rendering the same mostly
+// static validators, tokens, params and proposals on every page view
+type QueryCache struct {
+	config  QueryCacheConfig
+	mtx     sync.Mutex
+	entries map[queryCacheKey]queryCacheEntry
+	now     func() time.Time
+}
+
+// NewQueryCache creates a new instance of QueryCache
+func NewQueryCache(config QueryCacheConfig) (*QueryCache, error) {
+	if err := config.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return &QueryCache{
+		config:  config,
+		entries: make(map[queryCacheKey]queryCacheEntry),
+		now:     time.Now,
+	}, nil
+}
+
+// Get returns the response cached of the query of the path and the data at the height, 0 for the latest
+func (qc *QueryCache) Get(path string, data []byte, height int64) ([]byte, bool) {
+	key := queryCacheKey{path: path, data: string(data), height: height}
+	qc.mtx.Lock()
+	defer qc.mtx.Unlock()
+	entry, ok := qc.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if !qc.now().Before(entry.expiry) {
+		delete(qc.entries, key)
+		return nil, false
+	}
+	return entry.value, true
+}
+
+// Set caches the response of the query of the path and the data at the height by the TTL of the path
+func (qc *QueryCache) Set(path string, data []byte, height int64, value []byte) {
+	ttl := qc.config.TTL(path)
+	if ttl == 0 {
+		return
+	}
+
+	key := queryCacheKey{path: path, data: string(data), height: height}
+	qc.mtx.Lock()
+	defer qc.mtx.Unlock()
+	now := qc.now()
+	if _, ok := qc.entries[key]; !ok && qc.config.MaxEntries > 0 && len(qc.entries) >= qc.config.MaxEntries {
+		qc.evict(now)
+	}
+	qc.entries[key] = queryCacheEntry{
+		value:  value,
+		expiry: now.Add(ttl),
+	}
+}
+
+// evict removes the expired entries, or the one expiring first if none
+func (qc *QueryCache) evict(now time.Time) {
+	var first queryCacheKey
+	var firstExpiry time.Time
+	for key, entry := range qc.entries {
+		if !now.Before(entry.expiry) {
+			delete(qc.entries, key)
+			continue
+		}
+		if firstExpiry.IsZero() || entry.expiry.Before(firstExpiry) {
+			first, firstExpiry = key, entry.expiry
+		}
+	}
+	if len(qc.entries) >= qc.config.MaxEntries {
+		delete(qc.entries, first)
+	}
+}
+
+// Invalidate removes the responses cached of the queries by the path prefix, e.g. "custom/gov/" after a proposal
+// submitted
+func (qc *QueryCache) Invalidate(pathPrefix string) {
+	qc.mtx.Lock()
+	defer qc.mtx.Unlock()
+	for key := range qc.entries {
+		if strings.HasPrefix(key.path, pathPrefix) {
+			delete(qc.entries, key)
+		}
+	}
+}
+
+// InvalidateAll removes all the responses cached
+func (qc *QueryCache) InvalidateAll() {
+	qc.mtx.Lock()
+	defer qc.mtx.Unlock()
+	qc.entries = make(map[queryCacheKey]queryCacheEntry)
+}
+
+// Len returns the number of the responses cached, including the expired ones not evicted yet
+func (qc *QueryCache) Len() int {
+	qc.mtx.Lock()
+	defer qc.mtx.Unlock()
+	return len(qc.entries)
+}
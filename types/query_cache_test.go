@@ -0,0 +1,76 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryCacheConfig_TTL(t *testing.T) {
+	config := QueryCacheConfig{
+		DefaultTTL: time.Second,
+		TTLs: map[string]time.Duration{
+			"custom/staking/":           time.Minute,
+			"custom/staking/validators": time.Hour,
+			"custom/order/":             0,
+		},
+	}
+	require.NoError(t, config.ValidateBasic())
+	require.Equal(t, time.Hour, config.TTL("custom/staking/validators"))
+	require.Equal(t, time.Minute, config.TTL("custom/staking/parameters"))
+	require.Zero(t, config.TTL("custom/order/depthbook"))
+	require.Equal(t, time.Second, config.TTL("custom/token/info"))
+
+	config.TTLs["custom/gov/"] = -time.Second
+	require.Error(t, config.ValidateBasic())
+	_, err := NewQueryCache(config)
+	require.Error(t, err)
+}
+
+func TestQueryCache(t *testing.T) {
+	now := time.Unix(0, 0)
+	cache, err := NewQueryCache(QueryCacheConfig{
+		TTLs: map[string]time.Duration{
+			"custom/staking/": time.Minute,
+			"custom/token/":   time.Hour,
+		},
+		MaxEntries: 2,
+	})
+	require.NoError(t, err)
+	cache.now = func() time.Time { return now }
+
+	// not cached out of the TTLs
+	cache.Set("custom/order/depthbook", nil, 0, []byte("depthbook"))
+	_, ok := cache.Get("custom/order/depthbook", nil, 0)
+	require.False(t, ok)
+
+	// cached by the path, the data and the height
+	cache.Set("custom/staking/validators", nil, 0, []byte("validators"))
+	cache.Set("custom/token/info", []byte("okt"), 0, []byte("okt info"))
+	value, ok := cache.Get("custom/staking/validators", nil, 0)
+	require.True(t, ok)
+	require.Equal(t, []byte("validators"), value)
+	_, ok = cache.Get("custom/staking/validators", nil, 1024)
+	require.False(t, ok)
+	_, ok = cache.Get("custom/token/info", []byte("btc"), 0)
+	require.False(t, ok)
+
+	// the one expiring first is evicted
+	cache.Set("custom/token/info", []byte("btc"), 0, []byte("btc info"))
+	require.Equal(t, 2, cache.Len())
+	_, ok = cache.Get("custom/staking/validators", nil, 0)
+	require.False(t, ok)
+
+	// expired
+	now = now.Add(time.Hour)
+	_, ok = cache.Get("custom/token/info", []byte("okt"), 0)
+	require.False(t, ok)
+
+	cache.Set("custom/staking/validators", nil, 0, []byte("validators"))
+	cache.Invalidate("custom/staking/")
+	_, ok = cache.Get("custom/staking/validators", nil, 0)
+	require.False(t, ok)
+	cache.InvalidateAll()
+	require.Zero(t, cache.Len())
+}
@@ -0,0 +1,131 @@
+package types
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	sdkerrors "github.com/okex/okchain-go-sdk/types/errors"
+)
+
+// RateLimit - structure of the client-side rate limit of the calls to an endpoint, which keeps the heavy query
+// workloads under the limits of the public node providers instead of getting the API key or the IP banned
+type RateLimit struct {
+	// RequestsPerSecond is the sustained rate of the requests
+	RequestsPerSecond float64 `json:"requests_per_second"`
+	// Burst is the max number of the requests sent at once after an idle period, 1 by default
+	Burst int `json:"burst,omitempty"`
+	// Weights are the costs of the heavy methods in requests, 1 by default, e.g. {"tx_search": 5}. The methods are
+	// named by the tendermint rpc, i.e. abci_info, abci_query, broadcast_tx_commit, broadcast_tx_sync,
+	// broadcast_tx_async, block, block_results, commit, validators, tx and tx_search
+	Weights map[string]float64 `json:"weights,omitempty"`
+	// MaxWait is the max time a call waits for its turn, over which it fails by ErrRateLimited at once, unlimited
+	// if 0. It's in nanoseconds in the json
+	MaxWait time.Duration `json:"max_wait,omitempty"`
+}
+
+// ValidateBasic gives a quick validity check for the rate limit
+func (rl RateLimit) ValidateBasic() error {
+	if rl.RequestsPerSecond <= 0 {
+		return fmt.Errorf("failed. requests per second of the rate limit must be positive: %v", rl.RequestsPerSecond)
+	}
+	if rl.Burst < 0 || rl.MaxWait < 0 {
+		return errors.New("failed. burst and max wait of the rate limit must not be negative")
+	}
+	for method, weight := range rl.Weights {
+		if weight <= 0 {
+			return fmt.Errorf("failed. weight of method %s must be positive: %v", method, weight)
+		}
+	}
+	return nil
+}
+
+// Weight returns the cost of the method in requests
+func (rl RateLimit) Weight(method string) float64 {
+	if weight, ok := rl.Weights[method]; ok {
+		return weight
+	}
+	return 1
+}
+
+// RateLimiter - structure of the token bucket limiting the rate of the calls, which is safe for concurrent use and
+// could be shared by the clients calling the same endpoint
+type RateLimiter struct {
+	limit RateLimit
+	mtx   sync.Mutex
+	// tokens are the requests available at the time last, negative for the ones reserved by the calls waiting
+	tokens float64
+	last   time.Time
+	now    func() time.Time
+}
+
+// NewRateLimiter creates a new instance of RateLimiter, which starts with the full burst
+func NewRateLimiter(limit RateLimit) (*RateLimiter, error) {
+	if err := limit.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if limit.Burst == 0 {
+		limit.Burst = 1
+	}
+
+	return &RateLimiter{
+		limit:  limit,
+		tokens: float64(limit.Burst),
+		now:    time.Now,
+	}, nil
+}
+
+// Wait waits until the method could be called by its weight, or fails by ErrRateLimited if the wait is over the max
+// wait. The calls are served in order of arrival
+func (rl *RateLimiter) Wait(ctx context.Context, method string) error {
+	weight := rl.limit.Weight(method)
+	delay, err := rl.reserve(weight)
+	if err != nil || delay == 0 {
+		return err
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		rl.cancel(weight)
+		return ctx.Err()
+	}
+}
+
+// reserve takes the tokens of the weight, and returns the time to wait for them
+func (rl *RateLimiter) reserve(weight float64) (time.Duration, error) {
+	rl.mtx.Lock()
+	defer rl.mtx.Unlock()
+	now := rl.now()
+	if !rl.last.IsZero() {
+		rl.tokens += now.Sub(rl.last).Seconds() * rl.limit.RequestsPerSecond
+		if burst := float64(rl.limit.Burst); rl.tokens > burst {
+			rl.tokens = burst
+		}
+	}
+	rl.last = now
+
+	tokens := rl.tokens - weight
+	var delay time.Duration
+	if tokens < 0 {
+		delay = time.Duration(-tokens / rl.limit.RequestsPerSecond * float64(time.Second))
+	}
+	if rl.limit.MaxWait > 0 && delay > rl.limit.MaxWait {
+		return 0, sdkerrors.Wrapf(sdkerrors.ErrRateLimited, "failed. wait %s for the rate limit over %s", delay,
+			rl.limit.MaxWait)
+	}
+	rl.tokens = tokens
+	return delay, nil
+}
+
+// cancel returns the tokens reserved by a call given up
+func (rl *RateLimiter) cancel(weight float64) {
+	rl.mtx.Lock()
+	defer rl.mtx.Unlock()
+	rl.tokens += weight
+}
@@ -0,0 +1,81 @@
+package types
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	sdkerrors "github.com/okex/okchain-go-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimit_ValidateBasic(t *testing.T) {
+	require.Error(t, RateLimit{}.ValidateBasic())
+	require.Error(t, RateLimit{RequestsPerSecond: 10, Burst: -1}.ValidateBasic())
+	require.Error(t, RateLimit{RequestsPerSecond: 10, Weights: map[string]float64{"tx_search": 0}}.ValidateBasic())
+	rl := RateLimit{RequestsPerSecond: 10, Weights: map[string]float64{"tx_search": 5}}
+	require.NoError(t, rl.ValidateBasic())
+	require.Equal(t, float64(5), rl.Weight("tx_search"))
+	require.Equal(t, float64(1), rl.Weight("abci_query"))
+
+	_, err := NewRateLimiter(RateLimit{})
+	require.Error(t, err)
+}
+
+func TestRateLimiter(t *testing.T) {
+	now := time.Unix(0, 0)
+	limiter, err := NewRateLimiter(RateLimit{
+		RequestsPerSecond: 10,
+		Burst:             2,
+		Weights:           map[string]float64{"tx_search": 5},
+		MaxWait:           time.Second,
+	})
+	require.NoError(t, err)
+	limiter.now = func() time.Time { return now }
+
+	// the burst goes at once
+	for i := 0; i < 2; i++ {
+		delay, err := limiter.reserve(1)
+		require.NoError(t, err)
+		require.Zero(t, delay)
+	}
+	// then at the rate
+	delay, err := limiter.reserve(1)
+	require.NoError(t, err)
+	require.Equal(t, 100*time.Millisecond, delay)
+	// the heavy method waits by its weight after the calls reserved
+	delay, err = limiter.reserve(limiter.limit.Weight("tx_search"))
+	require.NoError(t, err)
+	require.Equal(t, 600*time.Millisecond, delay)
+	// over the max wait without taking the tokens
+	_, err = limiter.reserve(5)
+	require.True(t, errors.Is(err, sdkerrors.ErrRateLimited))
+
+	// the tokens refill up to the burst only
+	now = now.Add(time.Hour)
+	delay, err = limiter.reserve(2)
+	require.NoError(t, err)
+	require.Zero(t, delay)
+	delay, err = limiter.reserve(1)
+	require.NoError(t, err)
+	require.Equal(t, 100*time.Millisecond, delay)
+
+	// the tokens of the wait given up are returned
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.True(t, errors.Is(limiter.Wait(ctx, "abci_query"), context.Canceled))
+	delay, err = limiter.reserve(1)
+	require.NoError(t, err)
+	require.Equal(t, 200*time.Millisecond, delay)
+}
+
+func TestRateLimiter_Wait(t *testing.T) {
+	limiter, err := NewRateLimiter(RateLimit{RequestsPerSecond: 100})
+	require.NoError(t, err)
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		require.NoError(t, limiter.Wait(context.Background(), "abci_query"))
+	}
+	require.True(t, time.Since(start) >= 20*time.Millisecond)
+}
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"sync"
+	"time"
+
+	"github.com/tendermint/tendermint/crypto/merkle"
+)
+
+// RawResponse - structure of the raw response of an ABCI query exactly as returned by the node, along with its height
+// and proof metadata, which is archived by the auditors
+type RawResponse struct {
+	Path string
+	// Data is the key or the params of the query
+	Data      []byte
+	Value     []byte
+	Height    int64
+	Code      uint32
+	Codespace string
+	Log       string
+	// Proof is the merkle proof of the store query, only requested with the proof verification
+	Proof *merkle.Proof
+	// ProofVerified tells the proof is verified against the app hash of a verified header
+	ProofVerified bool
+	// ReceivedAt is the local time the response was received
+	ReceivedAt time.Time
+}
+
+// ResponseRecorder receives the raw responses of the queries
+type ResponseRecorder interface {
+	RecordResponse(resp RawResponse)
+}
+
+// ResponseCollector - structure of the recorder collecting the raw responses in memory in order, which is safe for
+// the concurrent queries
+type ResponseCollector struct {
+	mtx   sync.Mutex
+	resps []RawResponse
+}
+
+var _ ResponseRecorder = (*ResponseCollector)(nil)
+
+// NewResponseCollector creates a new instance of ResponseCollector
+func NewResponseCollector() *ResponseCollector {
+	return new(ResponseCollector)
+}
+
+// RecordResponse collects the raw response
+func (rc *ResponseCollector) RecordResponse(resp RawResponse) {
+	rc.mtx.Lock()
+	defer rc.mtx.Unlock()
+	rc.resps = append(rc.resps, resp)
+}
+
+// Responses returns the raw responses collected in order
+func (rc *ResponseCollector) Responses() []RawResponse {
+	rc.mtx.Lock()
+	defer rc.mtx.Unlock()
+	return append([]RawResponse{}, rc.resps...)
+}
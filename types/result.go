@@ -7,7 +7,7 @@ import (
 	"log"
 	"strings"
 
-	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	abci "github.com/tendermint/tendermint/abci/types"
 )
 
 // Result is the union of ResponseFormat and ResponseCheckTx
@@ -87,87 +87,26 @@ type TxResponse struct {
 	Events StringEvents `json:"events,omitempty"`
 }
 
-// NewResponseFormatBroadcastTxCommit returns a TxResponse given a ResultBroadcastTxCommit from tendermint
-func NewResponseFormatBroadcastTxCommit(res *ctypes.ResultBroadcastTxCommit) TxResponse {
-	if res == nil {
-		return TxResponse{}
-	}
-
-	if !res.CheckTx.IsOK() {
-		return newTxResponseCheckTx(res)
-	}
-
-	return newTxResponseDeliverTx(res)
-}
-
-func newTxResponseCheckTx(res *ctypes.ResultBroadcastTxCommit) TxResponse {
-	if res == nil {
-		return TxResponse{}
-	}
-
-	var txHash string
-	if res.Hash != nil {
-		txHash = res.Hash.String()
-	}
-
-	parsedLogs, _ := ParseABCILogs(res.CheckTx.Log)
-
-	return TxResponse{
-		Height:    res.Height,
-		TxHash:    txHash,
-		Code:      res.CheckTx.Code,
-		Data:      strings.ToUpper(hex.EncodeToString(res.CheckTx.Data)),
-		RawLog:    res.CheckTx.Log,
-		Logs:      parsedLogs,
-		Info:      res.CheckTx.Info,
-		GasWanted: res.CheckTx.GasWanted,
-		GasUsed:   res.CheckTx.GasUsed,
-		Events:    StringifyEvents(res.CheckTx.Events),
-		Codespace: res.CheckTx.Codespace,
-	}
-}
-
-func newTxResponseDeliverTx(res *ctypes.ResultBroadcastTxCommit) TxResponse {
-	if res == nil {
-		return TxResponse{}
-	}
+// NewResponseFormatSimulation returns a TxResponse given the Result of the simulation of a tx, which is never committed
+// and carries no height
+func NewResponseFormatSimulation(txHash string, res Result) TxResponse {
+	parsedLogs, _ := ParseABCILogs(res.Log)
 
-	var txHash string
-	if res.Hash != nil {
-		txHash = res.Hash.String()
+	events := make([]abci.Event, len(res.Events))
+	for i, event := range res.Events {
+		events[i] = abci.Event(event)
 	}
 
-	parsedLogs, _ := ParseABCILogs(res.DeliverTx.Log)
-
 	return TxResponse{
-		Height:    res.Height,
 		TxHash:    txHash,
-		Code:      res.DeliverTx.Code,
-		Data:      strings.ToUpper(hex.EncodeToString(res.DeliverTx.Data)),
-		RawLog:    res.DeliverTx.Log,
+		Code:      uint32(res.Code),
+		Data:      strings.ToUpper(hex.EncodeToString(res.Data)),
+		RawLog:    res.Log,
 		Logs:      parsedLogs,
-		Info:      res.DeliverTx.Info,
-		GasWanted: res.DeliverTx.GasWanted,
-		GasUsed:   res.DeliverTx.GasUsed,
-		Events:    StringifyEvents(res.DeliverTx.Events),
-		Codespace: res.DeliverTx.Codespace,
-	}
-}
-
-// NewResponseFormatBroadcastTx returns a TxResponse given a ResultBroadcastTx from tendermint
-func NewResponseFormatBroadcastTx(res *ctypes.ResultBroadcastTx) TxResponse {
-	if res == nil {
-		return TxResponse{}
-	}
-
-	parsedLogs, _ := ParseABCILogs(res.Log)
-
-	return TxResponse{
-		Code:   res.Code,
-		Data:   res.Data.String(),
-		RawLog: res.Log,
-		Logs:   parsedLogs,
-		TxHash: res.Hash.String(),
+		GasWanted: int64(res.GasWanted),
+		GasUsed:   int64(res.GasUsed),
+		Events:    StringifyEvents(events),
+		Codespace: string(res.Codespace),
 	}
 }
 
@@ -0,0 +1,118 @@
+//go:build !js
+// +build !js
+
+package types
+
+import (
+	"encoding/hex"
+	"strings"
+
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+)
+
+// NewResponseFormatBroadcastTxCommit returns a TxResponse given a ResultBroadcastTxCommit from tendermint
+func NewResponseFormatBroadcastTxCommit(res *ctypes.ResultBroadcastTxCommit) TxResponse {
+	if res == nil {
+		return TxResponse{}
+	}
+
+	if !res.CheckTx.IsOK() {
+		return newTxResponseCheckTx(res)
+	}
+
+	return newTxResponseDeliverTx(res)
+}
+
+func newTxResponseCheckTx(res *ctypes.ResultBroadcastTxCommit) TxResponse {
+	if res == nil {
+		return TxResponse{}
+	}
+
+	var txHash string
+	if res.Hash != nil {
+		txHash = res.Hash.String()
+	}
+
+	parsedLogs, _ := ParseABCILogs(res.CheckTx.Log)
+
+	return TxResponse{
+		Height:    res.Height,
+		TxHash:    txHash,
+		Code:      res.CheckTx.Code,
+		Data:      strings.ToUpper(hex.EncodeToString(res.CheckTx.Data)),
+		RawLog:    res.CheckTx.Log,
+		Logs:      parsedLogs,
+		Info:      res.CheckTx.Info,
+		GasWanted: res.CheckTx.GasWanted,
+		GasUsed:   res.CheckTx.GasUsed,
+		Events:    StringifyEvents(res.CheckTx.Events),
+		Codespace: res.CheckTx.Codespace,
+	}
+}
+
+func newTxResponseDeliverTx(res *ctypes.ResultBroadcastTxCommit) TxResponse {
+	if res == nil {
+		return TxResponse{}
+	}
+
+	var txHash string
+	if res.Hash != nil {
+		txHash = res.Hash.String()
+	}
+
+	parsedLogs, _ := ParseABCILogs(res.DeliverTx.Log)
+
+	return TxResponse{
+		Height:    res.Height,
+		TxHash:    txHash,
+		Code:      res.DeliverTx.Code,
+		Data:      strings.ToUpper(hex.EncodeToString(res.DeliverTx.Data)),
+		RawLog:    res.DeliverTx.Log,
+		Logs:      parsedLogs,
+		Info:      res.DeliverTx.Info,
+		GasWanted: res.DeliverTx.GasWanted,
+		GasUsed:   res.DeliverTx.GasUsed,
+		Events:    StringifyEvents(res.DeliverTx.Events),
+		Codespace: res.DeliverTx.Codespace,
+	}
+}
+
+// NewResponseFormatBroadcastTx returns a TxResponse given a ResultBroadcastTx from tendermint
+func NewResponseFormatBroadcastTx(res *ctypes.ResultBroadcastTx) TxResponse {
+	if res == nil {
+		return TxResponse{}
+	}
+
+	parsedLogs, _ := ParseABCILogs(res.Log)
+
+	return TxResponse{
+		Code:   res.Code,
+		Data:   res.Data.String(),
+		RawLog: res.Log,
+		Logs:   parsedLogs,
+		TxHash: res.Hash.String(),
+	}
+}
+
+// NewResponseFormatResultTx returns a TxResponse given a ResultTx of a committed tx from tendermint
+func NewResponseFormatResultTx(res *ctypes.ResultTx) TxResponse {
+	if res == nil {
+		return TxResponse{}
+	}
+
+	parsedLogs, _ := ParseABCILogs(res.TxResult.Log)
+
+	return TxResponse{
+		Height:    res.Height,
+		TxHash:    res.Hash.String(),
+		Code:      res.TxResult.Code,
+		Data:      strings.ToUpper(hex.EncodeToString(res.TxResult.Data)),
+		RawLog:    res.TxResult.Log,
+		Logs:      parsedLogs,
+		Info:      res.TxResult.Info,
+		GasWanted: res.TxResult.GasWanted,
+		GasUsed:   res.TxResult.GasUsed,
+		Events:    StringifyEvents(res.TxResult.Events),
+		Codespace: res.TxResult.Codespace,
+	}
+}
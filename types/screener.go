@@ -0,0 +1,68 @@
+package types
+
+import (
+	sdkerrors "github.com/okex/okchain-go-sdk/types/errors"
+)
+
+// AddressScreener shows the expected behavior of the compliance screening on the recipient addresses in a tx
+type AddressScreener interface {
+	Screen(recipients []AccAddress) error
+}
+
+var _ AddressScreener = (*ListScreener)(nil)
+
+// ListScreener - structure of the address screener by an allowlist or a denylist
+type ListScreener struct {
+	allowlist map[string]struct{}
+	denylist  map[string]struct{}
+}
+
+// NewAllowlistScreener creates a new instance of ListScreener that only passes the addresses in the allowlist
+func NewAllowlistScreener(addrs ...AccAddress) *ListScreener {
+	return &ListScreener{
+		allowlist: buildAddrSet(addrs),
+	}
+}
+
+// NewDenylistScreener creates a new instance of ListScreener that blocks the addresses in the denylist
+func NewDenylistScreener(addrs ...AccAddress) *ListScreener {
+	return &ListScreener{
+		denylist: buildAddrSet(addrs),
+	}
+}
+
+// Screen implements the AddressScreener interface
+func (ls *ListScreener) Screen(recipients []AccAddress) error {
+	for _, recipient := range recipients {
+		if _, ok := ls.denylist[recipient.String()]; ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrAddressScreened, "failed. address %s is in the denylist", recipient)
+		}
+
+		if ls.allowlist == nil {
+			continue
+		}
+		if _, ok := ls.allowlist[recipient.String()]; !ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrAddressScreened, "failed. address %s isn't in the allowlist", recipient)
+		}
+	}
+
+	return nil
+}
+
+// GetRecipients collects all the recipient addresses from the msgs
+func GetRecipients(msgs []Msg) (recipients []AccAddress) {
+	for _, msg := range msgs {
+		if msgWithRecipients, ok := msg.(MsgWithRecipients); ok {
+			recipients = append(recipients, msgWithRecipients.GetRecipients()...)
+		}
+	}
+	return
+}
+
+func buildAddrSet(addrs []AccAddress) map[string]struct{} {
+	addrSet := make(map[string]struct{}, len(addrs))
+	for _, addr := range addrs {
+		addrSet[addr.String()] = struct{}{}
+	}
+	return addrSet
+}
@@ -0,0 +1,129 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+// schemes of the secret references
+const (
+	SecretSchemeEnv   = "env"
+	SecretSchemeFile  = "file"
+	SecretSchemeVault = "vault"
+)
+
+// SecretResolver resolves the secret references of a scheme, e.g. the name of the env var of "env:OKCHAIN_PASSPHRASE"
+type SecretResolver interface {
+	Resolve(ref string) (string, error)
+}
+
+// SecretResolverFunc adapts a function to SecretResolver
+type SecretResolverFunc func(ref string) (string, error)
+
+// Resolve calls the function
+func (f SecretResolverFunc) Resolve(ref string) (string, error) {
+	return f(ref)
+}
+
+// SecretResolvers maps the schemes to their resolvers
+type SecretResolvers map[string]SecretResolver
+
+// DefaultSecretResolvers returns the resolvers of the env vars and the files. The vault references are refused until
+// the resolver of NewVaultSecretResolver is set, rather than taken as the plain values
+func DefaultSecretResolvers() SecretResolvers {
+	return SecretResolvers{
+		SecretSchemeEnv:  SecretResolverFunc(resolveEnvSecret),
+		SecretSchemeFile: SecretResolverFunc(resolveFileSecret),
+		SecretSchemeVault: SecretResolverFunc(func(string) (string, error) {
+			return "", errors.New("no vault resolver is set")
+		}),
+	}
+}
+
+// Resolve resolves the value if it's a secret reference of a scheme with a resolver, e.g. "env:OKCHAIN_PASSPHRASE" or
+// "file:/run/secrets/passphrase". Otherwise it's returned as it is, e.g. "tcp://127.0.0.1:26657"
+func (rs SecretResolvers) Resolve(value string) (string, error) {
+	i := strings.Index(value, ":")
+	if i <= 0 {
+		return value, nil
+	}
+	resolver, ok := rs[value[:i]]
+	if !ok {
+		return value, nil
+	}
+
+	secret, err := resolver.Resolve(value[i+1:])
+	if err != nil {
+		return "", fmt.Errorf("failed. resolve %s secret error: %w", value[:i], err)
+	}
+	return secret, nil
+}
+
+func resolveEnvSecret(ref string) (string, error) {
+	secret, ok := os.LookupEnv(ref)
+	if !ok {
+		return "", fmt.Errorf("env var %s is not set", ref)
+	}
+	return secret, nil
+}
+
+// resolveFileSecret reads the file without the trailing newline, e.g. a docker or kubernetes secret
+func resolveFileSecret(ref string) (string, error) {
+	bz, err := ioutil.ReadFile(ref)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(string(bz), "\r\n"), nil
+}
+
+// NewVaultSecretResolver creates the resolver of the references to the fields of the HashiCorp Vault secrets in the
+// form of "path#field", e.g. "vault:secret/data/okchain#passphrase", which reads the KV secrets engine of both the
+// versions through the HTTP API at the addr with the token
+func NewVaultSecretResolver(addr, token string) SecretResolver {
+	cli := &http.Client{Timeout: 10 * time.Second}
+	return SecretResolverFunc(func(ref string) (string, error) {
+		i := strings.LastIndex(ref, "#")
+		if i <= 0 || i == len(ref)-1 {
+			return "", fmt.Errorf("vault reference %s is not in the form of path#field", ref)
+		}
+		path, field := ref[:i], ref[i+1:]
+
+		req, err := http.NewRequest(http.MethodGet, strings.TrimRight(addr, "/")+"/v1/"+strings.TrimLeft(path, "/"),
+			nil)
+		if err != nil {
+			return "", err
+		}
+		req.Header.Set("X-Vault-Token", token)
+		resp, err := cli.Do(req)
+		if err != nil {
+			return "", err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return "", fmt.Errorf("vault responded %s to %s", resp.Status, path)
+		}
+
+		var body struct {
+			Data map[string]interface{} `json:"data"`
+		}
+		if err = json.NewDecoder(resp.Body).Decode(&body); err != nil {
+			return "", fmt.Errorf("decode vault response error: %w", err)
+		}
+		// the fields are nested in data of the KV version 2
+		fields := body.Data
+		if nested, ok := body.Data["data"].(map[string]interface{}); ok {
+			fields = nested
+		}
+		secret, ok := fields[field].(string)
+		if !ok {
+			return "", fmt.Errorf("string field %s is missing in vault secret %s", field, path)
+		}
+		return secret, nil
+	})
+}
@@ -0,0 +1,169 @@
+package types
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	sdkerrors "github.com/okex/okchain-go-sdk/types/errors"
+)
+
+// QueuePolicy - structure of the queueing behavior of the txs of a signer while another one is in progress
+type QueuePolicy struct {
+	// FailFast refuses a tx at once with ErrSignerBusy if another tx of the signer is in progress
+	FailFast bool
+	// MaxQueued is the max number of the txs waiting for a signer, beyond which a tx is refused with ErrSignerBusy,
+	// unlimited if 0
+	MaxQueued int
+	// Timeout is the max wait of a tx queued, after which it's refused with ErrSignerBusy, unlimited if 0
+	Timeout time.Duration
+}
+
+// signerSequence - structure of the state of the txs of a signer
+type signerSequence struct {
+	// sem holds the token of the tx of the signer in progress
+	sem     chan struct{}
+	waiting int
+	// next is the sequence after the last tx accepted by the node, 0 if unknown
+	next uint64
+}
+
+// SequenceManager - structure of the manager serializing the txs of each signer, which makes the concurrent broadcasts
+// from one key safe. A tx takes the sequence after the last one accepted if the sequence given is lower, e.g. both
+// queried before the last one was broadcast, and then the next tx of the signer waits until it's broadcast
+type SequenceManager struct {
+	mtx     sync.Mutex
+	policy  QueuePolicy
+	signers map[string]*signerSequence
+}
+
+// NewSequenceManager creates a new instance of SequenceManager with the queueing policy
+func NewSequenceManager(policy QueuePolicy) *SequenceManager {
+	return &SequenceManager{
+		policy:  policy,
+		signers: make(map[string]*signerSequence),
+	}
+}
+
+// SequenceLease - structure of the exclusive right of a signer to broadcast a tx with the sequence reserved
+type SequenceLease struct {
+	// Sequence is the sequence reserved for the tx
+	Sequence uint64
+	manager  *SequenceManager
+	signer   *signerSequence
+	once     sync.Once
+}
+
+// Acquire waits for the turn of the signer by the queueing policy or until the ctx is done, and reserves the sequence
+// of the tx, which is the given one or the one after the last tx accepted, whichever is higher. The lease must be
+// released after the tx is broadcast
+func (sm *SequenceManager) Acquire(ctx context.Context, signer AccAddress, sequence uint64) (*SequenceLease, error) {
+	sm.mtx.Lock()
+	ss, ok := sm.signers[signer.String()]
+	if !ok {
+		ss = &signerSequence{
+			sem: make(chan struct{}, 1),
+		}
+		sm.signers[signer.String()] = ss
+	}
+
+	select {
+	case ss.sem <- struct{}{}:
+		defer sm.mtx.Unlock()
+		return sm.lease(ss, sequence), nil
+	default:
+	}
+
+	if sm.policy.FailFast {
+		sm.mtx.Unlock()
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrSignerBusy, "failed. another tx of %s is in progress", signer)
+	}
+	if sm.policy.MaxQueued > 0 && ss.waiting >= sm.policy.MaxQueued {
+		sm.mtx.Unlock()
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrSignerBusy, "failed. %d txs of %s are queued already", ss.waiting,
+			signer)
+	}
+	ss.waiting++
+	sm.mtx.Unlock()
+
+	var timeout <-chan time.Time
+	if sm.policy.Timeout > 0 {
+		timer := time.NewTimer(sm.policy.Timeout)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+
+	var err error
+	select {
+	case ss.sem <- struct{}{}:
+	case <-timeout:
+		err = sdkerrors.Wrapf(sdkerrors.ErrSignerBusy, "failed. tx of %s queued over %s", signer, sm.policy.Timeout)
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+
+	sm.mtx.Lock()
+	defer sm.mtx.Unlock()
+	ss.waiting--
+	if err != nil {
+		return nil, err
+	}
+	return sm.lease(ss, sequence), nil
+}
+
+// lease reserves the sequence for the signer holding its token
+func (sm *SequenceManager) lease(ss *signerSequence, sequence uint64) *SequenceLease {
+	if ss.next > sequence {
+		sequence = ss.next
+	}
+	return &SequenceLease{
+		Sequence: sequence,
+		manager:  sm,
+		signer:   ss,
+	}
+}
+
+// Next returns the sequence after the last tx of the signer accepted, false if unknown
+func (sm *SequenceManager) Next(signer AccAddress) (uint64, bool) {
+	sm.mtx.Lock()
+	defer sm.mtx.Unlock()
+	ss, ok := sm.signers[signer.String()]
+	if !ok || ss.next == 0 {
+		return 0, false
+	}
+	return ss.next, true
+}
+
+// Reset forgets the sequence of the signer, e.g. after its txs are broadcast by another process, so that the next tx
+// takes the sequence given
+func (sm *SequenceManager) Reset(signer AccAddress) {
+	sm.mtx.Lock()
+	defer sm.mtx.Unlock()
+	if ss, ok := sm.signers[signer.String()]; ok {
+		ss.next = 0
+	}
+}
+
+// Release ends the lease with the result of the broadcast, which passes the turn to the next tx of the signer. The
+// sequence is taken once the tx is accepted by the node, including a tx timed out waiting for the commit, and
+// forgotten on a sequence mismatch so that the next tx takes the sequence given. Otherwise, e.g. the tx failed to build
+// or was rejected, the sequence is left for the next tx. Only the first release of a lease takes effect
+func (l *SequenceLease) Release(resp TxResponse, err error) {
+	l.once.Do(func() {
+		l.manager.mtx.Lock()
+		// the codespace is missing in the responses of the sync and async broadcasts
+		rootCodespace := len(resp.Codespace) == 0 || resp.Codespace == string(CodespaceRoot)
+		switch {
+		case errors.Is(err, sdkerrors.ErrSequenceMismatch) ||
+			(resp.Code == uint32(CodeInvalidSequence) && rootCodespace):
+			l.signer.next = 0
+		case resp.Height > 0 || (err == nil && resp.Code == 0) || errors.Is(err, sdkerrors.ErrTxTimeout):
+			// committed by the block even if failed in the delivery, or accepted by the mempool but not committed in
+			// time
+			l.signer.next = l.Sequence + 1
+		}
+		l.manager.mtx.Unlock()
+		<-l.signer.sem
+	})
+}
@@ -0,0 +1,135 @@
+package types
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	sdkerrors "github.com/okex/okchain-go-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSequenceManager(t *testing.T) {
+	signer := AccAddress([]byte("signer______________"))
+	sm := NewSequenceManager(QueuePolicy{})
+	_, ok := sm.Next(signer)
+	require.False(t, ok)
+
+	lease, err := sm.Acquire(context.Background(), signer, 5)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), lease.Sequence)
+	lease.Release(TxResponse{TxHash: "hash"}, nil)
+	next, ok := sm.Next(signer)
+	require.True(t, ok)
+	require.Equal(t, uint64(6), next)
+
+	// the stale sequence given is raised to the reserved one
+	lease, err = sm.Acquire(context.Background(), signer, 5)
+	require.NoError(t, err)
+	require.Equal(t, uint64(6), lease.Sequence)
+	// rejected without taking the sequence
+	lease.Release(TxResponse{Code: uint32(CodeInsufficientFunds), Codespace: string(CodespaceRoot)}, nil)
+	lease, err = sm.Acquire(context.Background(), signer, 5)
+	require.NoError(t, err)
+	require.Equal(t, uint64(6), lease.Sequence)
+	// committed even if failed in the delivery
+	lease.Release(TxResponse{Height: 1024, Code: uint32(CodeInsufficientFunds)},
+		sdkerrors.Wrap(sdkerrors.ErrInsufficientFunds, "insufficient funds"))
+	lease, err = sm.Acquire(context.Background(), signer, 5)
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), lease.Sequence)
+	// accepted by the mempool but not committed in time
+	lease.Release(TxResponse{TxHash: "hash"}, sdkerrors.Wrap(sdkerrors.ErrTxTimeout, "timed out"))
+	lease, err = sm.Acquire(context.Background(), signer, 5)
+	require.NoError(t, err)
+	require.Equal(t, uint64(8), lease.Sequence)
+	// forgotten on the sequence mismatch
+	lease.Release(TxResponse{Code: uint32(CodeInvalidSequence), Codespace: string(CodespaceRoot)}, nil)
+	_, ok = sm.Next(signer)
+	require.False(t, ok)
+	lease, err = sm.Acquire(context.Background(), signer, 5)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), lease.Sequence)
+	lease.Release(TxResponse{}, nil)
+	// released only once
+	lease.Release(TxResponse{}, nil)
+
+	sm.Reset(signer)
+	_, ok = sm.Next(signer)
+	require.False(t, ok)
+}
+
+func TestSequenceManager_Queue(t *testing.T) {
+	signer := AccAddress([]byte("signer______________"))
+	sm := NewSequenceManager(QueuePolicy{})
+	lease, err := sm.Acquire(context.Background(), signer, 1)
+	require.NoError(t, err)
+
+	// waiting for the turn of the signer
+	acquired := make(chan *SequenceLease)
+	go func() {
+		next, err := sm.Acquire(context.Background(), signer, 1)
+		require.NoError(t, err)
+		acquired <- next
+	}()
+	select {
+	case <-acquired:
+		t.Fatal("acquired during the lease of another tx")
+	case <-time.After(50 * time.Millisecond):
+	}
+	// the other signers aren't blocked
+	other, err := sm.Acquire(context.Background(), AccAddress([]byte("other_______________")), 1)
+	require.NoError(t, err)
+	other.Release(TxResponse{}, nil)
+
+	lease.Release(TxResponse{}, nil)
+	next := <-acquired
+	require.Equal(t, uint64(2), next.Sequence)
+
+	// done ctx
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = sm.Acquire(ctx, signer, 1)
+	require.True(t, errors.Is(err, context.Canceled))
+	next.Release(TxResponse{}, nil)
+}
+
+func TestSequenceManager_QueuePolicy(t *testing.T) {
+	signer := AccAddress([]byte("signer______________"))
+
+	sm := NewSequenceManager(QueuePolicy{FailFast: true})
+	lease, err := sm.Acquire(context.Background(), signer, 1)
+	require.NoError(t, err)
+	_, err = sm.Acquire(context.Background(), signer, 1)
+	require.True(t, errors.Is(err, sdkerrors.ErrSignerBusy))
+	lease.Release(TxResponse{}, nil)
+	lease, err = sm.Acquire(context.Background(), signer, 1)
+	require.NoError(t, err)
+	lease.Release(TxResponse{}, nil)
+
+	sm = NewSequenceManager(QueuePolicy{Timeout: 10 * time.Millisecond})
+	lease, err = sm.Acquire(context.Background(), signer, 1)
+	require.NoError(t, err)
+	_, err = sm.Acquire(context.Background(), signer, 1)
+	require.True(t, errors.Is(err, sdkerrors.ErrSignerBusy))
+	lease.Release(TxResponse{}, nil)
+
+	sm = NewSequenceManager(QueuePolicy{MaxQueued: 1})
+	lease, err = sm.Acquire(context.Background(), signer, 1)
+	require.NoError(t, err)
+	go func() {
+		queued, err := sm.Acquire(context.Background(), signer, 1)
+		if err == nil {
+			queued.Release(TxResponse{}, nil)
+		}
+	}()
+	require.Eventually(t, func() bool {
+		sm.mtx.Lock()
+		defer sm.mtx.Unlock()
+		return sm.signers[signer.String()].waiting == 1
+	}, time.Second, 10*time.Millisecond)
+	_, err = sm.Acquire(context.Background(), signer, 1)
+	require.True(t, errors.Is(err, sdkerrors.ErrSignerBusy))
+	lease.Release(TxResponse{}, nil)
+}
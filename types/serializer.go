@@ -0,0 +1,104 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+)
+
+// serialization formats supported by gosdk
+const (
+	// SerializationJSON is the amino JSON, the same as the output of the node
+	SerializationJSON = "json"
+	// SerializationAmino is the amino binary, the same as the txs and the store of the node. It's not protobuf, e.g. the
+	// interfaces are prefixed by the amino type, so the consumers decode it by amino with the types registered
+	SerializationAmino = "amino"
+	// SerializationMsgpack is the amino JSON in msgpack, e.g. the decimals as strings and the interfaces as the objects
+	// of their amino types and values, which the consumers decode by any msgpack library
+	SerializationMsgpack = "msgpack"
+)
+
+var serializerFactories = map[string]SerializerFactory{
+	SerializationJSON:    newJSONSerializer,
+	SerializationAmino:   newAminoSerializer,
+	SerializationMsgpack: newMsgpackSerializer,
+}
+
+// Serializer shows the expected behavior to serialize the public types of gosdk, e.g. TokenPair and TxResponse, onto
+// wire or queues
+type Serializer interface {
+	Format() string
+	Marshal(o interface{}) ([]byte, error)
+	Unmarshal(bytes []byte, ptr interface{}) error
+}
+
+// SerializerFactory creates a Serializer on the codec with the types of all the modules registered
+type SerializerFactory func(cdc SDKCodec) Serializer
+
+// RegisterSerializer plugs in the serializer of a new format, e.g. cbor, which could be created by NewSerializer
+// after that. It should be called in the init of the package providing the serializer
+func RegisterSerializer(format string, factory SerializerFactory) {
+	if _, ok := serializerFactories[format]; ok {
+		panic(fmt.Sprintf("duplicated serializer: %s", format))
+	}
+	serializerFactories[format] = factory
+}
+
+// NewSerializer creates a new Serializer of the format on the codec
+func NewSerializer(format string, cdc SDKCodec) (Serializer, error) {
+	factory, ok := serializerFactories[format]
+	if !ok {
+		return nil, fmt.Errorf("failed. unsupported serialization format %s; supported formats: %v", format,
+			SerializationFormats())
+	}
+	return factory(cdc), nil
+}
+
+// SerializationFormats returns all the serialization formats registered
+func SerializationFormats() []string {
+	formats := make([]string, 0, len(serializerFactories))
+	for format := range serializerFactories {
+		formats = append(formats, format)
+	}
+	sort.Strings(formats)
+	return formats
+}
+
+type jsonSerializer struct {
+	cdc SDKCodec
+}
+
+func newJSONSerializer(cdc SDKCodec) Serializer {
+	return jsonSerializer{cdc}
+}
+
+func (js jsonSerializer) Format() string {
+	return SerializationJSON
+}
+
+func (js jsonSerializer) Marshal(o interface{}) ([]byte, error) {
+	return js.cdc.MarshalJSON(o)
+}
+
+func (js jsonSerializer) Unmarshal(bytes []byte, ptr interface{}) error {
+	return js.cdc.UnmarshalJSON(bytes, ptr)
+}
+
+type aminoSerializer struct {
+	cdc SDKCodec
+}
+
+func newAminoSerializer(cdc SDKCodec) Serializer {
+	return aminoSerializer{cdc}
+}
+
+func (as aminoSerializer) Format() string {
+	return SerializationAmino
+}
+
+func (as aminoSerializer) Marshal(o interface{}) ([]byte, error) {
+	return as.cdc.MarshalBinaryBare(o)
+}
+
+func (as aminoSerializer) Unmarshal(bytes []byte, ptr interface{}) error {
+	return as.cdc.UnmarshalBinaryBare(bytes, ptr)
+}
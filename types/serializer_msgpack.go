@@ -0,0 +1,293 @@
+package types
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+)
+
+// msgpackSerializer serializes the amino JSON of the types into msgpack, so that the interfaces keep their amino type
+// names and the decimals keep their strings, e.g. {"type":"okchain/TextProposal","value":{...}}, which any msgpack
+// consumer decodes without amino
+type msgpackSerializer struct {
+	cdc SDKCodec
+}
+
+func newMsgpackSerializer(cdc SDKCodec) Serializer {
+	return msgpackSerializer{cdc}
+}
+
+func (ms msgpackSerializer) Format() string {
+	return SerializationMsgpack
+}
+
+func (ms msgpackSerializer) Marshal(o interface{}) ([]byte, error) {
+	jsonBytes, err := ms.cdc.MarshalJSON(o)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonBytes))
+	decoder.UseNumber()
+	var value interface{}
+	if err = decoder.Decode(&value); err != nil {
+		return nil, fmt.Errorf("failed. decode amino json error: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if err = encodeMsgpack(&buf, value); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (ms msgpackSerializer) Unmarshal(bz []byte, ptr interface{}) error {
+	d := msgpackDecoder{bz: bz}
+	value, err := d.decode()
+	if err != nil {
+		return err
+	}
+	if d.pos != len(bz) {
+		return fmt.Errorf("failed. %d trailing bytes after the msgpack value", len(bz)-d.pos)
+	}
+
+	jsonBytes, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("failed. encode amino json error: %w", err)
+	}
+	return ms.cdc.UnmarshalJSON(jsonBytes, ptr)
+}
+
+// encodeMsgpack encodes the value decoded from the json, with the keys of the maps sorted for the stable output
+func encodeMsgpack(buf *bytes.Buffer, value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		buf.WriteByte(0xc0)
+	case bool:
+		if v {
+			buf.WriteByte(0xc3)
+		} else {
+			buf.WriteByte(0xc2)
+		}
+	case json.Number:
+		return encodeMsgpackNumber(buf, v)
+	case string:
+		writeMsgpackHeader(buf, len(v), 0xa0, 32, 0xd9, 0xda, 0xdb)
+		buf.WriteString(v)
+	case []interface{}:
+		writeMsgpackHeader(buf, len(v), 0x90, 16, 0, 0xdc, 0xdd)
+		for _, elem := range v {
+			if err := encodeMsgpack(buf, elem); err != nil {
+				return err
+			}
+		}
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		writeMsgpackHeader(buf, len(v), 0x80, 16, 0, 0xde, 0xdf)
+		for _, key := range keys {
+			if err := encodeMsgpack(buf, key); err != nil {
+				return err
+			}
+			if err := encodeMsgpack(buf, v[key]); err != nil {
+				return err
+			}
+		}
+	default:
+		return fmt.Errorf("failed. unsupported json value %T for msgpack", value)
+	}
+	return nil
+}
+
+func encodeMsgpackNumber(buf *bytes.Buffer, number json.Number) error {
+	var bz [9]byte
+	if i, err := number.Int64(); err == nil {
+		switch {
+		case i >= 0 && i < 128:
+			buf.WriteByte(byte(i))
+		case i < 0 && i >= -32:
+			buf.WriteByte(byte(int8(i)))
+		default:
+			bz[0] = 0xd3
+			binary.BigEndian.PutUint64(bz[1:], uint64(i))
+			buf.Write(bz[:])
+		}
+		return nil
+	}
+
+	f, err := number.Float64()
+	if err != nil {
+		return fmt.Errorf("failed. invalid json number %s: %w", number, err)
+	}
+	bz[0] = 0xcb
+	binary.BigEndian.PutUint64(bz[1:], math.Float64bits(f))
+	buf.Write(bz[:])
+	return nil
+}
+
+// writeMsgpackHeader writes the header of a str, an array or a map with the length, in the fix format under fixLimit,
+// or the 8-bit (if any), 16-bit or 32-bit format
+func writeMsgpackHeader(buf *bytes.Buffer, length int, fixPrefix byte, fixLimit int, prefix8, prefix16,
+	prefix32 byte) {
+	switch {
+	case length < fixLimit:
+		buf.WriteByte(fixPrefix | byte(length))
+	case prefix8 != 0 && length <= math.MaxUint8:
+		buf.WriteByte(prefix8)
+		buf.WriteByte(byte(length))
+	case length <= math.MaxUint16:
+		buf.WriteByte(prefix16)
+		_ = binary.Write(buf, binary.BigEndian, uint16(length))
+	default:
+		buf.WriteByte(prefix32)
+		_ = binary.Write(buf, binary.BigEndian, uint32(length))
+	}
+}
+
+var errMsgpackTruncated = errors.New("failed. truncated msgpack")
+
+// msgpackDecoder decodes msgpack into the values encoded by encoding/json, with the integers as int64 or uint64
+type msgpackDecoder struct {
+	bz  []byte
+	pos int
+}
+
+func (d *msgpackDecoder) next(n int) ([]byte, error) {
+	if n < 0 || len(d.bz)-d.pos < n {
+		return nil, errMsgpackTruncated
+	}
+	bz := d.bz[d.pos : d.pos+n]
+	d.pos += n
+	return bz, nil
+}
+
+// uint reads the big-endian unsigned integer of n bytes
+func (d *msgpackDecoder) uint(n int) (uint64, error) {
+	bz, err := d.next(n)
+	if err != nil {
+		return 0, err
+	}
+	var u uint64
+	for _, b := range bz {
+		u = u<<8 | uint64(b)
+	}
+	return u, nil
+}
+
+func (d *msgpackDecoder) decode() (interface{}, error) {
+	bz, err := d.next(1)
+	if err != nil {
+		return nil, err
+	}
+
+	switch prefix := bz[0]; {
+	case prefix <= 0x7f:
+		return int64(prefix), nil
+	case prefix >= 0xe0:
+		return int64(int8(prefix)), nil
+	case prefix&0xe0 == 0xa0:
+		return d.decodeString(int(prefix & 0x1f))
+	case prefix&0xf0 == 0x90:
+		return d.decodeArray(int(prefix & 0x0f))
+	case prefix&0xf0 == 0x80:
+		return d.decodeMap(int(prefix & 0x0f))
+	}
+
+	switch prefix := bz[0]; prefix {
+	case 0xc0:
+		return nil, nil
+	case 0xc2:
+		return false, nil
+	case 0xc3:
+		return true, nil
+	case 0xcc, 0xcd, 0xce, 0xcf:
+		return d.uint(1 << (prefix - 0xcc))
+	case 0xd0, 0xd1, 0xd2, 0xd3:
+		size := 1 << (prefix - 0xd0)
+		u, err := d.uint(size)
+		if err != nil {
+			return nil, err
+		}
+		// sign-extended from the size
+		shift := uint(64 - 8*size)
+		return int64(u<<shift) >> shift, nil
+	case 0xca:
+		u, err := d.uint(4)
+		return float64(math.Float32frombits(uint32(u))), err
+	case 0xcb:
+		u, err := d.uint(8)
+		return math.Float64frombits(u), err
+	case 0xd9, 0xda, 0xdb:
+		length, err := d.uint(1 << (prefix - 0xd9))
+		if err != nil {
+			return nil, err
+		}
+		return d.decodeString(int(length))
+	case 0xdc, 0xdd:
+		length, err := d.uint(2 << (prefix - 0xdc))
+		if err != nil {
+			return nil, err
+		}
+		return d.decodeArray(int(length))
+	case 0xde, 0xdf:
+		length, err := d.uint(2 << (prefix - 0xde))
+		if err != nil {
+			return nil, err
+		}
+		return d.decodeMap(int(length))
+	default:
+		return nil, fmt.Errorf("failed. unsupported msgpack type 0x%x", prefix)
+	}
+}
+
+func (d *msgpackDecoder) decodeString(length int) (interface{}, error) {
+	bz, err := d.next(length)
+	if err != nil {
+		return nil, err
+	}
+	return string(bz), nil
+}
+
+func (d *msgpackDecoder) decodeArray(length int) (interface{}, error) {
+	// each element takes one byte at least
+	if length > len(d.bz)-d.pos {
+		return nil, errMsgpackTruncated
+	}
+	array := make([]interface{}, length)
+	for i := range array {
+		elem, err := d.decode()
+		if err != nil {
+			return nil, err
+		}
+		array[i] = elem
+	}
+	return array, nil
+}
+
+func (d *msgpackDecoder) decodeMap(length int) (interface{}, error) {
+	if length > len(d.bz)-d.pos {
+		return nil, errMsgpackTruncated
+	}
+	m := make(map[string]interface{}, length)
+	for i := 0; i < length; i++ {
+		key, err := d.decode()
+		if err != nil {
+			return nil, err
+		}
+		keyStr, ok := key.(string)
+		if !ok {
+			return nil, fmt.Errorf("failed. msgpack map key %v must be a string", key)
+		}
+		if m[keyStr], err = d.decode(); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
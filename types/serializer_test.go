@@ -0,0 +1,65 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type stdJSONSerializer struct{}
+
+func (stdJSONSerializer) Format() string                        { return "std-json" }
+func (stdJSONSerializer) Marshal(o interface{}) ([]byte, error) { return json.Marshal(o) }
+func (stdJSONSerializer) Unmarshal(bytes []byte, ptr interface{}) error {
+	return json.Unmarshal(bytes, ptr)
+}
+
+func TestRegisterSerializer(t *testing.T) {
+	defer delete(serializerFactories, "std-json")
+
+	_, err := NewSerializer("std-json", Cdc)
+	require.Error(t, err)
+
+	RegisterSerializer("std-json", func(SDKCodec) Serializer { return stdJSONSerializer{} })
+	require.Equal(t, []string{SerializationAmino, SerializationJSON, SerializationMsgpack, "std-json"}, SerializationFormats())
+	serializer, err := NewSerializer("std-json", Cdc)
+	require.NoError(t, err)
+
+	bytes, err := serializer.Marshal(testMsg{"1"})
+	require.NoError(t, err)
+	var msg testMsg
+	require.NoError(t, serializer.Unmarshal(bytes, &msg))
+	require.Equal(t, testMsg{"1"}, msg)
+
+	require.Panics(t, func() {
+		RegisterSerializer(SerializationAmino, func(SDKCodec) Serializer { return stdJSONSerializer{} })
+	})
+}
+
+func TestMsgpackSerializer(t *testing.T) {
+	serializer, err := NewSerializer(SerializationMsgpack, Cdc)
+	require.NoError(t, err)
+
+	// the amino json {"amount":"1.00000000","denom":"okt"} in msgpack
+	bytes, err := serializer.Marshal(NewDecCoinFromDec("okt", OneDec()))
+	require.NoError(t, err)
+	expected := append([]byte{0x82}, fixStr("amount")...)
+	expected = append(append(append(expected, fixStr("1.00000000")...), fixStr("denom")...), fixStr("okt")...)
+	require.Equal(t, expected, bytes)
+
+	// the integers of the other encoders in any size, e.g. uint16
+	bytes = append(append([]byte{0x83}, fixStr("msg_index")...), 0xcd, 0x01, 0x00)
+	bytes = append(append(append(append(bytes, fixStr("success")...), 0xc3), fixStr("log")...), fixStr("")...)
+	var log ABCIMessageLog
+	require.NoError(t, serializer.Unmarshal(bytes, &log))
+	require.Equal(t, ABCIMessageLog{MsgIndex: 256, Success: true}, log)
+
+	require.Error(t, serializer.Unmarshal(bytes[:len(bytes)-1], &log))
+	require.Error(t, serializer.Unmarshal(append(bytes, 0xc0), &log))
+}
+
+// fixStr encodes a string shorter than 32 bytes in msgpack
+func fixStr(s string) []byte {
+	return append([]byte{0xa0 | byte(len(s))}, s...)
+}
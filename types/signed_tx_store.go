@@ -0,0 +1,80 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdkerrors "github.com/okex/okchain-go-sdk/types/errors"
+)
+
+// DefaultSignedTxTTL is the time a signed tx keeps its sequence taken without a TTL in the client config
+const DefaultSignedTxTTL = 10 * time.Minute
+
+// SignedTxRecord - structure of a tx signed locally, whose sequence is taken until it's committed, rejected or expired
+type SignedTxRecord struct {
+	Hash     string     `json:"hash"`
+	ChainID  string     `json:"chain_id"`
+	Signer   AccAddress `json:"signer"`
+	Sequence uint64     `json:"sequence"`
+	Expiry   time.Time  `json:"expiry"`
+}
+
+// SignedTxStore shows the expected behavior of the store of the txs signed locally but not broadcast or committed yet
+type SignedTxStore interface {
+	// Put records a signed tx
+	Put(record SignedTxRecord) error
+	// Pending returns the records of the signer at the sequence unexpired at the time
+	Pending(chainID string, signer AccAddress, sequence uint64, now time.Time) ([]SignedTxRecord, error)
+	// Delete releases the sequence of the signed tx with the hash, e.g. a tx dropped by the user
+	Delete(hash string) error
+}
+
+// CheckSequenceReuse refuses the sequence of the signer taken by a signed tx still pending in the signed tx store of
+// the config, unless the reuse is allowed, which is only logged by the logger of the config if it's set
+func CheckSequenceReuse(config ClientConfig, signer AccAddress, sequence uint64, allowReuse bool) error {
+	if config.SignedTxStore == nil {
+		return nil
+	}
+
+	records, err := config.SignedTxStore.Pending(config.ChainID, signer, sequence, time.Now())
+	if err != nil {
+		return fmt.Errorf("failed. query the pending signed txs error: %w", err)
+	}
+	if len(records) == 0 {
+		return nil
+	}
+
+	if allowReuse {
+		if config.Logger != nil {
+			config.Logger.Info("sequence reused", "signer", signer.String(), "sequence", sequence,
+				"pending_tx_hash", records[0].Hash)
+		}
+		return nil
+	}
+	return sdkerrors.Wrapf(sdkerrors.ErrSequenceReused,
+		"failed. sequence %d of %s is taken by the pending signed tx %s until %s", sequence, signer, records[0].Hash,
+		records[0].Expiry.UTC().Format(time.RFC3339))
+}
+
+// RecordSignedTx records the signed tx in the signed tx store of the config, which takes the sequence of the signer
+// until the TTL of the config
+func RecordSignedTx(config ClientConfig, signer AccAddress, sequence uint64, hash string) error {
+	if config.SignedTxStore == nil {
+		return nil
+	}
+
+	ttl := config.SignedTxTTL
+	if ttl <= 0 {
+		ttl = DefaultSignedTxTTL
+	}
+	if err := config.SignedTxStore.Put(SignedTxRecord{
+		Hash:     hash,
+		ChainID:  config.ChainID,
+		Signer:   signer,
+		Sequence: sequence,
+		Expiry:   time.Now().Add(ttl),
+	}); err != nil {
+		return fmt.Errorf("failed. record the signed tx error: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+//go:build !js
+// +build !js
+
+package types
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	dbm "github.com/tendermint/tm-db"
+)
+
+const (
+	signedTxPrefix    = "tx/"
+	signedTxSeqPrefix = "seq/"
+)
+
+var _ SignedTxStore = (*DBSignedTxStore)(nil)
+
+// DBSignedTxStore - structure of the signed tx store on a tendermint db, which survives the restarts of the process on
+// a persistent db, e.g. shared by the cold and hot sides of a signing workflow
+type DBSignedTxStore struct {
+	mtx sync.Mutex
+	db  dbm.DB
+}
+
+// NewSignedTxStore creates a new instance of DBSignedTxStore on the db
+func NewSignedTxStore(db dbm.DB) *DBSignedTxStore {
+	return &DBSignedTxStore{
+		db: db,
+	}
+}
+
+// NewInMemorySignedTxStore creates a transient signed tx store in memory
+func NewInMemorySignedTxStore() *DBSignedTxStore {
+	return NewSignedTxStore(dbm.NewMemDB())
+}
+
+// Put implements the SignedTxStore interface
+func (s *DBSignedTxStore) Put(record SignedTxRecord) error {
+	bz, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("failed. encode the signed tx record error: %w", err)
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	batch := s.db.NewBatch()
+	defer batch.Close()
+	batch.Set(signedTxKey(record.Hash), bz)
+	batch.Set(signedTxSeqKey(record.ChainID, record.Signer, record.Sequence, record.Hash), []byte{})
+	batch.WriteSync()
+	return nil
+}
+
+// Pending implements the SignedTxStore interface, where the expired records met are pruned
+func (s *DBSignedTxStore) Pending(chainID string, signer AccAddress, sequence uint64, now time.Time) (
+	records []SignedTxRecord, err error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	prefix := signedTxSeqKey(chainID, signer, sequence, "")
+	var hashes []string
+	iter := dbm.IteratePrefix(s.db, prefix)
+	for ; iter.Valid(); iter.Next() {
+		hashes = append(hashes, string(iter.Key()[len(prefix):]))
+	}
+	iter.Close()
+
+	for _, hash := range hashes {
+		record, ok, err := s.get(hash)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+		if !now.Before(record.Expiry) {
+			s.delete(record)
+			continue
+		}
+		records = append(records, record)
+	}
+	return
+}
+
+// Delete implements the SignedTxStore interface
+func (s *DBSignedTxStore) Delete(hash string) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	record, ok, err := s.get(hash)
+	if err != nil || !ok {
+		return err
+	}
+	s.delete(record)
+	return nil
+}
+
+func (s *DBSignedTxStore) get(hash string) (record SignedTxRecord, ok bool, err error) {
+	bz := s.db.Get(signedTxKey(hash))
+	if bz == nil {
+		return
+	}
+	if err = json.Unmarshal(bz, &record); err != nil {
+		return record, false, fmt.Errorf("failed. decode the signed tx record %s error: %w", hash, err)
+	}
+	return record, true, nil
+}
+
+func (s *DBSignedTxStore) delete(record SignedTxRecord) {
+	batch := s.db.NewBatch()
+	defer batch.Close()
+	batch.Delete(signedTxKey(record.Hash))
+	batch.Delete(signedTxSeqKey(record.ChainID, record.Signer, record.Sequence, record.Hash))
+	batch.WriteSync()
+}
+
+func signedTxKey(hash string) []byte {
+	return []byte(signedTxPrefix + hash)
+}
+
+// signedTxSeqKey indexes the hash by the chain ID, the signer and the big endian sequence
+func signedTxSeqKey(chainID string, signer AccAddress, sequence uint64, hash string) []byte {
+	seqBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(seqBytes, sequence)
+	key := append([]byte(signedTxSeqPrefix+chainID+"/"), signer...)
+	key = append(key, seqBytes...)
+	return append(key, hash...)
+}
@@ -0,0 +1,75 @@
+package types
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	sdkerrors "github.com/okex/okchain-go-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+)
+
+func TestDBSignedTxStore(t *testing.T) {
+	store := NewInMemorySignedTxStore()
+	signer := AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	now := time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	require.NoError(t, store.Put(SignedTxRecord{Hash: "A", ChainID: "okchain", Signer: signer, Sequence: 1,
+		Expiry: now.Add(time.Minute)}))
+	require.NoError(t, store.Put(SignedTxRecord{Hash: "B", ChainID: "okchain", Signer: signer, Sequence: 1,
+		Expiry: now.Add(time.Hour)}))
+	require.NoError(t, store.Put(SignedTxRecord{Hash: "C", ChainID: "okchain", Signer: signer, Sequence: 256,
+		Expiry: now.Add(time.Hour)}))
+
+	records, err := store.Pending("okchain", signer, 1, now)
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	require.True(t, signer.Equals(records[0].Signer))
+	records, err = store.Pending("testchain", signer, 1, now)
+	require.NoError(t, err)
+	require.Empty(t, records)
+
+	// the expired record is pruned
+	records, err = store.Pending("okchain", signer, 1, now.Add(time.Minute))
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	require.Equal(t, "B", records[0].Hash)
+	records, err = store.Pending("okchain", signer, 1, now)
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+
+	require.NoError(t, store.Delete("B"))
+	require.NoError(t, store.Delete("unknown"))
+	records, err = store.Pending("okchain", signer, 1, now)
+	require.NoError(t, err)
+	require.Empty(t, records)
+	records, err = store.Pending("okchain", signer, 256, now)
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+}
+
+// infoLogger records the messages logged at the info level
+type infoLogger struct {
+	msgs []string
+}
+
+func (il *infoLogger) Debug(string, ...interface{})            {}
+func (il *infoLogger) Info(msg string, keyvals ...interface{}) { il.msgs = append(il.msgs, msg) }
+func (il *infoLogger) Error(string, ...interface{})            {}
+
+func TestCheckSequenceReuse(t *testing.T) {
+	config := ClientConfig{ChainID: "okchain", SignedTxStore: NewInMemorySignedTxStore()}
+	signer := AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	require.NoError(t, RecordSignedTx(config, signer, 1, "A"))
+
+	require.NoError(t, CheckSequenceReuse(config, signer, 2, false))
+	require.True(t, errors.Is(CheckSequenceReuse(config, signer, 1, false), sdkerrors.ErrSequenceReused))
+
+	// the reuse allowed is silent without a logger
+	require.NoError(t, CheckSequenceReuse(config, signer, 1, true))
+	logger := new(infoLogger)
+	config.Logger = logger
+	require.NoError(t, CheckSequenceReuse(config, signer, 1, true))
+	require.Equal(t, []string{"sequence reused"}, logger.msgs)
+}
@@ -0,0 +1,35 @@
+package types
+
+import (
+	"github.com/tendermint/tendermint/crypto"
+)
+
+// Signer shows the expected behavior of a tx signer, which is able to be plugged in by the local keys, HSMs, KMS or any
+// remote signer holding the private key out of gosdk
+type Signer interface {
+	PubKey() crypto.PubKey
+	// Sign signs the sign bytes of a tx and returns the signature
+	Sign(msg []byte) ([]byte, error)
+}
+
+// WatchOnlySigner shows the expected behavior of the signer of a watch-only account holding no private key, whose
+// txs are built unsigned to be signed by an external signer. Its public key is nil if unknown
+type WatchOnlySigner interface {
+	Signer
+	// WatchOnlyAddress returns the account address, which is known without the public key
+	WatchOnlyAddress() AccAddress
+}
+
+// GetSignerAddress returns the account address of the signer
+func GetSignerAddress(signer Signer) AccAddress {
+	if watchOnly, ok := signer.(WatchOnlySigner); ok {
+		return watchOnly.WatchOnlyAddress()
+	}
+	return AccAddress(signer.PubKey().Address())
+}
+
+// IsWatchOnlySigner tells whether the signer is of a watch-only account, unable to sign
+func IsWatchOnlySigner(signer Signer) bool {
+	_, ok := signer.(WatchOnlySigner)
+	return ok
+}
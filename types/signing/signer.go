@@ -0,0 +1,85 @@
+// Package signing provides the signers in memory and the encoding of the signed txs without any keybase, which depends
+// on neither the keystores nor the tendermint db, so that it's able to be built for the constrained platforms, e.g. by
+// gomobile and for js/wasm
+package signing
+
+import (
+	"fmt"
+
+	"github.com/okex/okchain-go-sdk/types"
+	sdkerrors "github.com/okex/okchain-go-sdk/types/errors"
+	"github.com/tendermint/tendermint/crypto"
+)
+
+var (
+	_ types.Signer          = privKeySigner{}
+	_ types.WatchOnlySigner = watchOnlySigner{}
+)
+
+// privKeySigner signs by the private key in memory
+type privKeySigner struct {
+	privKey crypto.PrivKey
+}
+
+// NewPrivKeySigner creates a signer of the private key in memory
+func NewPrivKeySigner(privKey crypto.PrivKey) types.Signer {
+	return privKeySigner{privKey}
+}
+
+func (ps privKeySigner) PubKey() crypto.PubKey {
+	return ps.privKey.PubKey()
+}
+
+func (ps privKeySigner) Sign(msg []byte) ([]byte, error) {
+	return ps.privKey.Sign(msg)
+}
+
+// watchOnlySigner stands for a watch-only account without the private key
+type watchOnlySigner struct {
+	address types.AccAddress
+	pubKey  crypto.PubKey
+}
+
+// NewWatchOnlySigner creates the signer of a watch-only account, whose txs are built unsigned to be signed by an
+// external signer. The public key is nil if unknown
+func NewWatchOnlySigner(address types.AccAddress, pubKey crypto.PubKey) types.Signer {
+	return watchOnlySigner{
+		address: address,
+		pubKey:  pubKey,
+	}
+}
+
+func (ws watchOnlySigner) PubKey() crypto.PubKey {
+	return ws.pubKey
+}
+
+func (ws watchOnlySigner) Sign([]byte) ([]byte, error) {
+	return nil, sdkerrors.Wrapf(sdkerrors.ErrWatchOnly, "failed. %s is unable to sign", ws.address)
+}
+
+func (ws watchOnlySigner) WatchOnlyAddress() types.AccAddress {
+	return ws.address
+}
+
+// MakeSignatureWithSigner completes the signature by the signer
+func MakeSignatureWithSigner(signer types.Signer, msg types.StdSignMsg) (sig types.StdSignature, err error) {
+	sigBytes, err := signer.Sign(msg.Bytes())
+	if err != nil {
+		return
+	}
+
+	return types.StdSignature{
+		PubKey:    signer.PubKey(),
+		Signature: sigBytes,
+	}, nil
+}
+
+// EncodeStdTx encodes the signed tx by the codec with the msgs registered into the bytes to broadcast with its hash
+func EncodeStdTx(cdc types.SDKCodec, stdTx types.StdTx) (signedTx types.SignedTx, err error) {
+	bytes, err := cdc.MarshalBinaryLengthPrefixed(stdTx)
+	if err != nil {
+		return signedTx, fmt.Errorf("failed. encoded stdTx error: %s", err)
+	}
+
+	return types.NewSignedTx(stdTx, bytes), nil
+}
@@ -3,6 +3,8 @@ package types
 import (
 	"encoding/json"
 	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	cmn "github.com/tendermint/tendermint/libs/common"
 )
 
 // const
@@ -25,6 +27,8 @@ type StdTx struct {
 	Fee        StdFee         `json:"fee"`
 	Signatures []StdSignature `json:"signatures"`
 	Memo       string         `json:"memo"`
+	// TimeoutHeight is the last height to commit the tx on the chains supporting it, no timeout if 0
+	TimeoutHeight uint64 `json:"timeout_height,omitempty"`
 }
 
 // NewStdTx creates a new instance of StdTx
@@ -41,10 +45,32 @@ func NewStdTx(msgs []Msg, fee StdFee, sigs []StdSignature, memo string) StdTx {
 func (st StdTx) GetMsgs() []Msg       { return nil }
 func (st StdTx) ValidateBasic() Error { return nil }
 
+// SignedTx - structure of a signed tx encoded without broadcasting, which could be queued, inspected or broadcast
+// through a different channel
+type SignedTx struct {
+	StdTx StdTx
+	// Bytes is the amino encoded tx to broadcast
+	Bytes []byte
+	// Hash is the hash of the tx in the same format as TxResponse.TxHash
+	Hash string
+}
+
+// NewSignedTx creates a new instance of SignedTx with the encoded bytes of the std tx
+func NewSignedTx(stdTx StdTx, txBytes []byte) SignedTx {
+	return SignedTx{
+		StdTx: stdTx,
+		Bytes: txBytes,
+		Hash:  cmn.HexBytes(tmhash.Sum(txBytes)).String(),
+	}
+}
+
 // StdFee includes the amount of coins paid in fees and the maximum gas to be used by the transaction
+// The fees are deducted from the payer instead of the first signer if it's set
 type StdFee struct {
-	Amount DecCoins `json:"amount"`
-	Gas    uint64   `json:"gas"`
+	Amount  DecCoins   `json:"amount"`
+	Gas     uint64     `json:"gas"`
+	Payer   AccAddress `json:"payer,omitempty"`
+	Granter AccAddress `json:"granter,omitempty"`
 }
 
 // NewStdFee creates a new instance of StdFee
@@ -91,10 +117,12 @@ type StdSignDoc struct {
 	Memo          string            `json:"memo"`
 	Msgs          []json.RawMessage `json:"msgs"`
 	Sequence      uint64            `json:"sequence"`
+	TimeoutHeight uint64            `json:"timeout_height,omitempty"`
 }
 
 // stdSignBytes returns the bytes to sign for a transaction
-func stdSignBytes(chainID string, accnum uint64, sequence uint64, fee StdFee, msgs []Msg, memo string) []byte {
+func stdSignBytes(chainID string, accnum uint64, sequence uint64, fee StdFee, msgs []Msg, memo string,
+	timeoutHeight uint64) []byte {
 	var msgsBytes []json.RawMessage
 	for _, msg := range msgs {
 		msgsBytes = append(msgsBytes, json.RawMessage(msg.GetSignBytes()))
@@ -106,6 +134,7 @@ func stdSignBytes(chainID string, accnum uint64, sequence uint64, fee StdFee, ms
 		Memo:          memo,
 		Msgs:          msgsBytes,
 		Sequence:      sequence,
+		TimeoutHeight: timeoutHeight,
 	})
 	if err != nil {
 		panic(err)
@@ -9,9 +9,27 @@ type StdSignMsg struct {
 	Fee           StdFee `json:"fee"`
 	Msgs          []Msg  `json:"msgs"`
 	Memo          string `json:"memo"`
+	TimeoutHeight uint64 `json:"timeout_height,omitempty"`
 }
 
 // Bytes gets message bytes
 func (msg StdSignMsg) Bytes() []byte {
-	return stdSignBytes(msg.ChainID, msg.AccountNumber, msg.Sequence, msg.Fee, msg.Msgs, msg.Memo)
+	return stdSignBytes(msg.ChainID, msg.AccountNumber, msg.Sequence, msg.Fee, msg.Msgs, msg.Memo,
+		msg.TimeoutHeight)
+}
+
+// SignDoc - structure of the sign doc of a tx previewed before it's signed, e.g. for the approval workflows
+type SignDoc struct {
+	SignMsg StdSignMsg
+	// SignBytes is the canonical JSON of the chain ID, the account number and sequence, the fee, the msgs and the memo,
+	// which is exactly what the signer signs
+	SignBytes []byte
+}
+
+// NewSignDoc creates a new instance of SignDoc
+func NewSignDoc(signMsg StdSignMsg) SignDoc {
+	return SignDoc{
+		SignMsg:   signMsg,
+		SignBytes: signMsg.Bytes(),
+	}
 }
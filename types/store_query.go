@@ -0,0 +1,12 @@
+package types
+
+import "github.com/tendermint/tendermint/crypto/merkle"
+
+// StoreQueryResult - structure of the raw result of a direct query to the store of a module
+type StoreQueryResult struct {
+	Value []byte `json:"value"`
+	// Height is the height of the state the value is read from
+	Height int64 `json:"height"`
+	// Proof is the merkle proof of the value, nil if the node serves none
+	Proof *merkle.Proof `json:"proof,omitempty"`
+}
@@ -0,0 +1,92 @@
+package types
+
+import (
+	"context"
+	"path"
+	"reflect"
+	"strings"
+)
+
+// span names of the tx lifecycle
+const (
+	// SpanTxLifecycle covers building, signing and broadcasting a tx by BuildAndBroadcast
+	SpanTxLifecycle = "tx.lifecycle"
+	// SpanTxBuild covers building a std tx, including the gas simulation and the signing
+	SpanTxBuild = "tx.build"
+	// SpanTxSign covers the signing by the signer and the fee payer
+	SpanTxSign = "tx.sign"
+	// SpanTxBroadcast covers broadcasting a tx, which lasts until the tx is committed in BroadcastBlock mode
+	SpanTxBroadcast = "tx.broadcast"
+	// SpanTxConfirm covers waiting for a tx broadcast in sync or async mode to be committed, with WithConfirmation
+	SpanTxConfirm = "tx.confirm"
+)
+
+// span attribute keys of the tx lifecycle
+const (
+	AttributeKeyTxHash        = "tx.hash"
+	AttributeKeyMsgModules    = "tx.msg_modules"
+	AttributeKeyMsgTypes      = "tx.msg_types"
+	AttributeKeySigner        = "tx.signer"
+	AttributeKeySequence      = "tx.sequence"
+	AttributeKeyBroadcastMode = "tx.broadcast_mode"
+	AttributeKeyCode          = "tx.code"
+	AttributeKeyHeight        = "tx.height"
+)
+
+// Tracer shows the expected behavior of the tracer of the tx lifecycle. An OpenTelemetry tracer is plugged in by the
+// adapter of the module tracing/otel, which keeps this package free of the OpenTelemetry dependency
+type Tracer interface {
+	// Start starts a span as a child of the span in the ctx, and returns the ctx with the new span
+	Start(ctx context.Context, spanName string, attrs ...SpanAttribute) (context.Context, Span)
+}
+
+// Span shows the expected behavior of a span started by the Tracer
+type Span interface {
+	SetAttributes(attrs ...SpanAttribute)
+	RecordError(err error)
+	End()
+}
+
+// SpanAttribute - structure of a key-value pair annotating a span
+type SpanAttribute struct {
+	Key   string
+	Value interface{}
+}
+
+// NewSpanAttribute creates a new instance of SpanAttribute
+func NewSpanAttribute(key string, value interface{}) SpanAttribute {
+	return SpanAttribute{
+		Key:   key,
+		Value: value,
+	}
+}
+
+// MsgSpanAttributes returns the attributes of the modules and the types of the msgs, joined by commas in order
+func MsgSpanAttributes(msgs []Msg) []SpanAttribute {
+	modules, msgTypes := make([]string, len(msgs)), make([]string, len(msgs))
+	for i, msg := range msgs {
+		modules[i], msgTypes[i] = msgModuleAndType(msg)
+	}
+
+	return []SpanAttribute{
+		NewSpanAttribute(AttributeKeyMsgModules, strings.Join(modules, ",")),
+		NewSpanAttribute(AttributeKeyMsgTypes, strings.Join(msgTypes, ",")),
+	}
+}
+
+// msgModuleAndType returns the route and the type of the msg, or the module by the package path and the Go type name
+// if the msg leaves them empty, e.g. "token" and "MsgSend" of module/token/types.MsgSend
+func msgModuleAndType(msg Msg) (module, msgType string) {
+	module, msgType = msg.Route(), msg.Type()
+	t := reflect.Indirect(reflect.ValueOf(msg)).Type()
+	if len(module) == 0 {
+		module = path.Base(t.PkgPath())
+		if module == "types" {
+			module = path.Base(path.Dir(t.PkgPath()))
+		}
+	}
+	if len(msgType) == 0 {
+		msgType = t.Name()
+	}
+	return
+}
@@ -0,0 +1,7 @@
+package types
+
+// TransferUnit - amount part for multi-send
+type TransferUnit struct {
+	To    AccAddress `json:"to"`
+	Coins DecCoins   `json:"coins"`
+}
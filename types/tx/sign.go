@@ -14,6 +14,36 @@ func init() {
 	Kb = keys.NewInMemory()
 }
 
+// UseHotKeybase replaces the global keybase with a hot one keeping the private keys unencrypted in memory, so that the
+// txs are signed without decrypting the key each time. The keys in the previous global keybase are dropped
+func UseHotKeybase() keys.HotKeybase {
+	hotKb := keys.NewHotInMemory()
+	Kb = hotKb
+	return hotKb
+}
+
+// UseFileKeybase replaces the global keybase with one on the keystore file in the dir, which is safe to be shared by
+// multiple processes. The keys in the previous global keybase are dropped
+func UseFileKeybase(dir string) (keys.Keybase, error) {
+	fileKb, err := keys.NewFileKeybase(dir)
+	if err != nil {
+		return nil, err
+	}
+	Kb = fileKb
+	return fileKb, nil
+}
+
+// UseKeychainKeybase replaces the global keybase with one on the OS credential store of the backend, e.g.
+// keys.BackendOS, with the keys named by the service. The keys in the previous global keybase are dropped
+func UseKeychainKeybase(backend, service string) (keys.Keybase, error) {
+	keychainKb, err := keys.NewKeychainKeybase(backend, service)
+	if err != nil {
+		return nil, err
+	}
+	Kb = keychainKb
+	return keychainKb, nil
+}
+
 // MakeSignature completes the signature
 func MakeSignature(name, passphrase string, msg types.StdSignMsg) (sig types.StdSignature, err error) {
 	sigBytes, pubkey, err := Kb.Sign(name, passphrase, msg.Bytes())
@@ -0,0 +1,62 @@
+package tx
+
+import (
+	"fmt"
+
+	"github.com/okex/okchain-go-sdk/types"
+	"github.com/okex/okchain-go-sdk/types/crypto/keys"
+	"github.com/okex/okchain-go-sdk/types/signing"
+	"github.com/tendermint/tendermint/crypto"
+)
+
+var _ types.Signer = keybaseSigner{}
+
+// keybaseSigner signs by the key in the global keybase
+type keybaseSigner struct {
+	name       string
+	passphrase string
+	pubKey     crypto.PubKey
+}
+
+// NewKeybaseSigner creates a signer of the key with the name in the global keybase, which is the default one of the
+// txs built by the name and passphrase
+func NewKeybaseSigner(name, passphrase string) (types.Signer, error) {
+	info, err := Kb.Get(name)
+	if err != nil {
+		return nil, fmt.Errorf("failed. get the key info of %s error: %w", name, err)
+	}
+	if keys.IsWatchOnly(info) {
+		return NewWatchOnlySigner(info.GetAddress(), info.GetPubKey()), nil
+	}
+
+	return keybaseSigner{
+		name:       name,
+		passphrase: passphrase,
+		pubKey:     info.GetPubKey(),
+	}, nil
+}
+
+func (ks keybaseSigner) PubKey() crypto.PubKey {
+	return ks.pubKey
+}
+
+func (ks keybaseSigner) Sign(msg []byte) ([]byte, error) {
+	sigBytes, _, err := Kb.Sign(ks.name, ks.passphrase, msg)
+	return sigBytes, err
+}
+
+// NewPrivKeySigner creates a signer of the private key in memory
+func NewPrivKeySigner(privKey crypto.PrivKey) types.Signer {
+	return signing.NewPrivKeySigner(privKey)
+}
+
+// NewWatchOnlySigner creates the signer of a watch-only account, whose txs are built unsigned to be signed by an
+// external signer. The public key is nil if unknown
+func NewWatchOnlySigner(address types.AccAddress, pubKey crypto.PubKey) types.Signer {
+	return signing.NewWatchOnlySigner(address, pubKey)
+}
+
+// MakeSignatureWithSigner completes the signature by the signer
+func MakeSignatureWithSigner(signer types.Signer, msg types.StdSignMsg) (sig types.StdSignature, err error) {
+	return signing.MakeSignatureWithSigner(signer, msg)
+}
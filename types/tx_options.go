@@ -0,0 +1,138 @@
+package types
+
+import (
+	"context"
+	"time"
+)
+
+// TxOption configures the optional settings of a tx to build
+type TxOption func(*TxOptions)
+
+// TxOptions - structure of the optional settings of a tx to build
+type TxOptions struct {
+	// FeePayer covers the fees of the tx instead of the signer of the msgs, optional
+	FeePayer *FeePayer
+	// FeeGranter is the account that granted the allowance to pay the fees of the tx, optional
+	FeeGranter AccAddress
+	// FeeFloorOverrideReason allows the fees under the floor of the client config, which is recorded in the fee audit log
+	FeeFloorOverrideReason string
+	// Fee fixes the fee of the tx instead of the fees or the gas calculation of the client config, optional
+	Fee *StdFee
+	// Context carries the parent span of the tx lifecycle spans, optional
+	Context context.Context
+	// AllowSequenceReuse signs the tx with a sequence taken by a pending signed tx, with a warning only
+	AllowSequenceReuse bool
+	// TimeoutHeight is the last height to commit the tx, no timeout if 0
+	TimeoutHeight uint64
+	// MsgOrder is the indexes of the msgs in the order to sign, the order given if empty
+	MsgOrder []int
+	// ConfirmTimeout is the longest to wait for the tx broadcast in sync or async mode to be committed, no waiting if 0
+	ConfirmTimeout time.Duration
+}
+
+// FeePayer - structure of the key info of the account paying the fees for the tx, which signs the tx after the signer
+// of the msgs with its own account number and sequence
+// The key in the keybase with the Name and Passphrase is used if the Signer is nil
+type FeePayer struct {
+	Name          string
+	Passphrase    string
+	Signer        Signer
+	AccountNumber uint64
+	Sequence      uint64
+}
+
+// NewTxOptions creates a new instance of TxOptions with the options applied
+func NewTxOptions(opts ...TxOption) TxOptions {
+	var options TxOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+	return options
+}
+
+// WithFeePayer sets a separate account in the keybase to pay the fees, e.g. a service account covering the fees for
+// the user txs
+func WithFeePayer(name, passphrase string, accNumber, seqNumber uint64) TxOption {
+	return func(options *TxOptions) {
+		options.FeePayer = &FeePayer{
+			Name:          name,
+			Passphrase:    passphrase,
+			AccountNumber: accNumber,
+			Sequence:      seqNumber,
+		}
+	}
+}
+
+// WithFeePayerSigner sets a separate account to pay the fees by its signer, e.g. a service account held by a KMS
+func WithFeePayerSigner(signer Signer, accNumber, seqNumber uint64) TxOption {
+	return func(options *TxOptions) {
+		options.FeePayer = &FeePayer{
+			Signer:        signer,
+			AccountNumber: accNumber,
+			Sequence:      seqNumber,
+		}
+	}
+}
+
+// WithFeeGranter sets the account which granted the fee allowance to the signer
+func WithFeeGranter(granter AccAddress) TxOption {
+	return func(options *TxOptions) {
+		options.FeeGranter = granter
+	}
+}
+
+// WithFeeFloorOverride allows the fees of the tx under the fee floor of the client config, e.g. for a manual rescue tx
+// on an idle chain. The reason is required and recorded in the fee audit log
+func WithFeeFloorOverride(reason string) TxOption {
+	return func(options *TxOptions) {
+		options.FeeFloorOverrideReason = reason
+	}
+}
+
+// WithSequenceReuse allows the tx to reuse a sequence taken by a pending signed tx in the signed tx store of the client
+// config, e.g. to replace a tx signed but lost before broadcasting
+func WithSequenceReuse() TxOption {
+	return func(options *TxOptions) {
+		options.AllowSequenceReuse = true
+	}
+}
+
+// WithFee fixes the fee of the tx, e.g. estimated in advance to spend the exact balance of the signer
+func WithFee(fee StdFee) TxOption {
+	return func(options *TxOptions) {
+		options.Fee = &fee
+	}
+}
+
+// WithContext sets the ctx of the tx, whose span is the parent of the tx lifecycle spans with the tracer of the client
+// config
+func WithContext(ctx context.Context) TxOption {
+	return func(options *TxOptions) {
+		options.Context = ctx
+	}
+}
+
+// WithTimeoutHeight sets the last height to commit the tx, e.g. for a time-sensitive order not to land later at a stale
+// price. The client refuses to build the tx once the latest height reaches it, and the chain rejects the tx after the
+// height if the client config tells it supports the timeout heights
+func WithTimeoutHeight(height uint64) TxOption {
+	return func(options *TxOptions) {
+		options.TimeoutHeight = height
+	}
+}
+
+// WithMsgOrder signs the msgs in the order of their indexes given, e.g. [1, 0] swaps a pair of msgs, which must be a
+// permutation of the indexes of the msgs
+func WithMsgOrder(order ...int) TxOption {
+	return func(options *TxOptions) {
+		options.MsgOrder = order
+	}
+}
+
+// WithConfirmation waits for the tx broadcast in sync or async mode to be committed, up to the timeout, and returns its
+// result in the block instead of the one of CheckTx. The waiting is traced by the span of the confirmation
+func WithConfirmation(timeout time.Duration) TxOption {
+	return func(options *TxOptions) {
+		options.ConfirmTimeout = timeout
+	}
+}
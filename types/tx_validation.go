@@ -0,0 +1,47 @@
+package types
+
+import (
+	sdkerrors "github.com/okex/okchain-go-sdk/types/errors"
+)
+
+const (
+	// MaxMemoCharacters is the limit of the memo length in bytes on OKChain
+	MaxMemoCharacters = 256
+	// DefaultMaxTxBytes is the limit of the encoded tx size of the tendermint mempool by default
+	DefaultMaxTxBytes = 1024 * 1024
+)
+
+// ValidateTxBasic checks the memo length and the msgs by their ValidateBasic before a tx is signed, which rejects the
+// tx the node would reject without taking a sequence
+func ValidateTxBasic(memo string, msgs []Msg) error {
+	if len(memo) > MaxMemoCharacters {
+		return sdkerrors.Wrapf(sdkerrors.ErrMemoTooLarge, "failed. memo of %d bytes exceeds the limit %d", len(memo),
+			MaxMemoCharacters)
+	}
+	if len(msgs) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidMsg, "failed. no msg in the tx")
+	}
+	for i, msg := range msgs {
+		if msg == nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidMsg, "failed. msg %d is nil", i)
+		}
+		if err := msg.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidMsg, "failed. msg %d of %T is invalid: %s", i, msg,
+				err.ABCILog())
+		}
+	}
+	return nil
+}
+
+// CheckTxSize checks the size of the encoded tx against the MaxTxBytes of the config, or DefaultMaxTxBytes if unset
+func CheckTxSize(config ClientConfig, size int) error {
+	maxTxBytes := config.MaxTxBytes
+	if maxTxBytes <= 0 {
+		maxTxBytes = DefaultMaxTxBytes
+	}
+	if size > maxTxBytes {
+		return sdkerrors.Wrapf(sdkerrors.ErrTxTooLarge, "failed. tx of %d bytes exceeds the limit %d", size,
+			maxTxBytes)
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package types
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	sdkerrors "github.com/okex/okchain-go-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+type validationMsg struct {
+	Msg
+	err Error
+}
+
+func (msg validationMsg) ValidateBasic() Error { return msg.err }
+
+func TestValidateTxBasic(t *testing.T) {
+	msgs := []Msg{validationMsg{}, validationMsg{}}
+	require.NoError(t, ValidateTxBasic(strings.Repeat("m", MaxMemoCharacters), msgs))
+
+	err := ValidateTxBasic(strings.Repeat("m", MaxMemoCharacters+1), msgs)
+	require.True(t, errors.Is(err, sdkerrors.ErrMemoTooLarge))
+
+	require.True(t, errors.Is(ValidateTxBasic("", nil), sdkerrors.ErrInvalidMsg))
+	require.True(t, errors.Is(ValidateTxBasic("", []Msg{validationMsg{}, nil}), sdkerrors.ErrInvalidMsg))
+
+	err = ValidateTxBasic("", []Msg{validationMsg{}, validationMsg{err: ErrUnknownRequest("empty recipient")}})
+	require.True(t, errors.Is(err, sdkerrors.ErrInvalidMsg))
+	require.Contains(t, err.Error(), "msg 1")
+	require.Contains(t, err.Error(), "empty recipient")
+}
+
+func TestCheckTxSize(t *testing.T) {
+	var config ClientConfig
+	require.NoError(t, CheckTxSize(config, DefaultMaxTxBytes))
+	require.True(t, errors.Is(CheckTxSize(config, DefaultMaxTxBytes+1), sdkerrors.ErrTxTooLarge))
+
+	config.MaxTxBytes = 1024
+	require.NoError(t, CheckTxSize(config, 1024))
+	require.True(t, errors.Is(CheckTxSize(config, 1025), sdkerrors.ErrTxTooLarge))
+}
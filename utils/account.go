@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"github.com/cosmos/go-bip39"
+	"github.com/okex/okchain-go-sdk/types"
 	"github.com/okex/okchain-go-sdk/types/crypto/keys"
 	"github.com/okex/okchain-go-sdk/types/crypto/keys/mintkey"
 	"github.com/okex/okchain-go-sdk/types/tx"
@@ -103,3 +104,30 @@ func GenerateMnemonic() (mnemo string, err error) {
 
 	return
 }
+
+// ListKeysDetailed returns the keys in the keybase with their derivation paths, algos, creation times and labels
+func ListKeysDetailed() ([]keys.KeyDetails, error) {
+	return keys.ListKeysDetailed(tx.Kb)
+}
+
+// CreateWatchOnlyAccount adds a watch-only account to the keybase by its bech32 address or account public key, without
+// the private key. Its balances are queried as any other account, and its txs are built unsigned by BuildStdTx to be
+// signed by an external signer
+func CreateWatchOnlyAccount(name, addrOrPubKey string) (keys.Info, error) {
+	if len(name) == 0 {
+		return nil, errors.New("failed. empty name of the watch-only account")
+	}
+	if addr, err := types.AccAddressFromBech32(addrOrPubKey); err == nil {
+		return tx.Kb.CreateWatchOnly(name, addr)
+	}
+	pubKey, err := types.GetAccPubKeyBech32(addrOrPubKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed. %s is neither an address nor an account public key", addrOrPubKey)
+	}
+	return tx.Kb.CreateOffline(name, pubKey)
+}
+
+// SetKeyLabels replaces the labels of the key in the keybase
+func SetKeyLabels(name string, labels ...string) (keys.Info, error) {
+	return tx.Kb.SetLabels(name, labels...)
+}
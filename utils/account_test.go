@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"testing"
 
+	sdk "github.com/okex/okchain-go-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -42,6 +43,44 @@ func TestCreateAccountWithMnemo(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestListKeysDetailed(t *testing.T) {
+	_, _, err := CreateAccountWithMnemo(defaultMnemonic, "labelled", defaultPassWd)
+	require.NoError(t, err)
+	_, err = SetKeyLabels("labelled", "cold")
+	require.NoError(t, err)
+
+	details, err := ListKeysDetailed()
+	require.NoError(t, err)
+	var found bool
+	for _, detail := range details {
+		if detail.Name == "labelled" {
+			found = true
+			require.Equal(t, []string{"cold"}, detail.Labels)
+			require.NotEmpty(t, detail.Path)
+		}
+	}
+	require.True(t, found)
+}
+
+func TestCreateAccountWithMnemo_CoinType(t *testing.T) {
+	config := sdk.GetConfig()
+	defer config.SetCoinType(sdk.CoinTypeOKChain)
+
+	info, _, err := CreateAccountWithMnemo(defaultMnemonic, defaultName, defaultPassWd)
+	require.NoError(t, err)
+	privKey, err := GeneratePrivateKeyFromMnemo(defaultMnemonic)
+	require.NoError(t, err)
+
+	config.SetCoinType(sdk.CoinTypeCosmos)
+	require.Equal(t, "44'/118'/0'/0/0", config.GetFullFundraiserPath())
+	cosmosInfo, _, err := CreateAccountWithMnemo(defaultMnemonic, defaultName, defaultPassWd)
+	require.NoError(t, err)
+	require.NotEqual(t, info.GetAddress(), cosmosInfo.GetAddress())
+	cosmosPrivKey, err := GeneratePrivateKeyFromMnemo(defaultMnemonic)
+	require.NoError(t, err)
+	require.NotEqual(t, privKey, cosmosPrivKey)
+}
+
 func TestCreateAccountWithPrivateKey(t *testing.T) {
 	privateKeyStr, err := GeneratePrivateKeyFromMnemo(defaultMnemonic)
 	require.NoError(t, err)
@@ -0,0 +1,256 @@
+package utils
+
+import (
+	"encoding/hex"
+	"strings"
+
+	sdk "github.com/okex/okchain-go-sdk/types"
+	sdkerrors "github.com/okex/okchain-go-sdk/types/errors"
+	"github.com/tendermint/tendermint/libs/bech32"
+	"golang.org/x/crypto/sha3"
+)
+
+// AddressType defines the kind of a bech32 address told by its prefix
+type AddressType int
+
+// kinds of the bech32 addresses
+const (
+	AddressTypeUnknown AddressType = iota
+	AddressTypeAccount
+	AddressTypeValidator
+	AddressTypeConsensus
+)
+
+// String returns the name of the address type
+func (at AddressType) String() string {
+	switch at {
+	case AddressTypeAccount:
+		return "account"
+	case AddressTypeValidator:
+		return "validator"
+	case AddressTypeConsensus:
+		return "consensus"
+	default:
+		return "unknown"
+	}
+}
+
+// prefix returns the bech32 prefix of the address type in the sdk config
+func (at AddressType) prefix() string {
+	config := sdk.GetConfig()
+	switch at {
+	case AddressTypeAccount:
+		return config.GetBech32AccountAddrPrefix()
+	case AddressTypeValidator:
+		return config.GetBech32ValidatorAddrPrefix()
+	case AddressTypeConsensus:
+		return config.GetBech32ConsensusAddrPrefix()
+	default:
+		return ""
+	}
+}
+
+// ValidateAddress validates an okchain bech32 address of any kind and returns its type. The error is classified by
+// sdkerrors.ErrAddressPrefix if the prefix is none of okchain, or by sdkerrors.ErrInvalidAddress otherwise
+func ValidateAddress(addrStr string) (AddressType, error) {
+	addrType, _, err := decodeAddress(addrStr)
+	return addrType, err
+}
+
+// ConvertAddress converts a bech32 address into the encoding of another type with the same bytes, e.g. an account
+// address into the validator operator address of the account. The consensus address of a validator is derived from
+// its consensus public key instead, which never shares the bytes with its account
+func ConvertAddress(addrStr string, to AddressType) (string, error) {
+	prefix := to.prefix()
+	if len(prefix) == 0 {
+		return "", sdkerrors.Wrapf(sdkerrors.ErrAddressPrefix, "failed. unknown address type to convert into: %d", to)
+	}
+
+	_, bz, err := decodeAddress(addrStr)
+	if err != nil {
+		return "", err
+	}
+	return bech32.ConvertAndEncode(prefix, bz)
+}
+
+// ValidateAddressType validates an okchain bech32 address of the expected type. The error is classified by
+// sdkerrors.ErrAddressPrefix if it's an address of another type or none of okchain, or by sdkerrors.ErrInvalidAddress
+// otherwise
+func ValidateAddressType(addrStr string, expected AddressType) error {
+	_, err := parseAddress(addrStr, expected)
+	return err
+}
+
+// ParseAccAddress parses an account address, which refuses the empty one and the addresses of the other types, e.g. a
+// validator operator address passed by mistake
+func ParseAccAddress(addrStr string) (sdk.AccAddress, error) {
+	bz, err := parseAddress(addrStr, AddressTypeAccount)
+	if err != nil {
+		return nil, err
+	}
+	return sdk.AccAddress(bz), nil
+}
+
+// ParseValAddress parses a validator operator address, which refuses the empty one and the addresses of the other
+// types
+func ParseValAddress(addrStr string) (sdk.ValAddress, error) {
+	bz, err := parseAddress(addrStr, AddressTypeValidator)
+	if err != nil {
+		return nil, err
+	}
+	return sdk.ValAddress(bz), nil
+}
+
+// ParseConsAddress parses a consensus address, which refuses the empty one and the addresses of the other types
+func ParseConsAddress(addrStr string) (sdk.ConsAddress, error) {
+	bz, err := parseAddress(addrStr, AddressTypeConsensus)
+	if err != nil {
+		return nil, err
+	}
+	return sdk.ConsAddress(bz), nil
+}
+
+// ParseAccAddresses parses the account addresses, and fails at the first one invalid
+func ParseAccAddresses(addrStrs []string) ([]sdk.AccAddress, error) {
+	addrs := make([]sdk.AccAddress, len(addrStrs))
+	for i, addrStr := range addrStrs {
+		addr, err := ParseAccAddress(addrStr)
+		if err != nil {
+			return nil, err
+		}
+		addrs[i] = addr
+	}
+	return addrs, nil
+}
+
+// ParseConsAddresses parses the consensus addresses, and fails at the first one invalid
+func ParseConsAddresses(addrStrs []string) ([]sdk.ConsAddress, error) {
+	addrs := make([]sdk.ConsAddress, len(addrStrs))
+	for i, addrStr := range addrStrs {
+		addr, err := ParseConsAddress(addrStr)
+		if err != nil {
+			return nil, err
+		}
+		addrs[i] = addr
+	}
+	return addrs, nil
+}
+
+// ValAddressFromAccAddress derives the validator operator address of the account, which the validator created by the
+// account is addressed by
+func ValAddressFromAccAddress(accAddrStr string) (sdk.ValAddress, error) {
+	accAddr, err := sdk.AccAddressFromBech32(accAddrStr)
+	if err != nil {
+		return nil, classifyAddressError(accAddrStr, AddressTypeAccount, err)
+	}
+	return sdk.ValAddress(accAddr), nil
+}
+
+// AccAddressFromValAddress derives the account address of the validator operator
+func AccAddressFromValAddress(valAddrStr string) (sdk.AccAddress, error) {
+	valAddr, err := sdk.ValAddressFromBech32(valAddrStr)
+	if err != nil {
+		return nil, classifyAddressError(valAddrStr, AddressTypeValidator, err)
+	}
+	return sdk.AccAddress(valAddr), nil
+}
+
+// ToHexAddress converts a bech32 address of any kind into the 0x hex representation with the EIP-55 checksum, e.g. to
+// show it in the EVM tools
+func ToHexAddress(addrStr string) (string, error) {
+	_, bz, err := decodeAddress(addrStr)
+	if err != nil {
+		return "", err
+	}
+	return checksumHex(bz), nil
+}
+
+// AccAddressFromHex converts the hex representation with or without 0x into an account address. The mixed case hex
+// must carry the valid EIP-55 checksum
+func AccAddressFromHex(hexStr string) (sdk.AccAddress, error) {
+	raw := strings.TrimPrefix(strings.TrimPrefix(hexStr, "0x"), "0X")
+	bz, err := hex.DecodeString(raw)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "failed. invalid hex address %s: %s", hexStr, err)
+	}
+	if err = sdk.VerifyAddressFormat(bz); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "failed. invalid hex address %s: %s", hexStr, err)
+	}
+	if raw != strings.ToLower(raw) && raw != strings.ToUpper(raw) && "0x"+raw != checksumHex(bz) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "failed. invalid EIP-55 checksum of hex address %s",
+			hexStr)
+	}
+	return sdk.AccAddress(bz), nil
+}
+
+// decodeAddress decodes a bech32 address of any kind by its prefix
+func decodeAddress(addrStr string) (addrType AddressType, bz []byte, err error) {
+	if len(strings.TrimSpace(addrStr)) == 0 {
+		return addrType, nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "failed. empty address")
+	}
+
+	hrp, bz, err := bech32.DecodeAndConvert(addrStr)
+	if err != nil {
+		return addrType, nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "failed. invalid bech32 address %s: %s",
+			addrStr, err)
+	}
+
+	for _, at := range []AddressType{AddressTypeAccount, AddressTypeValidator, AddressTypeConsensus} {
+		if hrp == at.prefix() {
+			addrType = at
+			break
+		}
+	}
+	if addrType == AddressTypeUnknown {
+		return addrType, nil, sdkerrors.Wrapf(sdkerrors.ErrAddressPrefix, "failed. prefix %s of address %s isn't okchain",
+			hrp, addrStr)
+	}
+
+	if err = sdk.VerifyAddressFormat(bz); err != nil {
+		return addrType, nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "failed. invalid %s address %s: %s", addrType,
+			addrStr, err)
+	}
+	return addrType, bz, nil
+}
+
+// parseAddress decodes a bech32 address of the expected type
+func parseAddress(addrStr string, expected AddressType) ([]byte, error) {
+	addrType, bz, err := decodeAddress(addrStr)
+	if err != nil {
+		return nil, err
+	}
+	if addrType != expected {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrAddressPrefix, "failed. %s is a %s address, but a %s address is expected",
+			addrStr, addrType, expected)
+	}
+	return bz, nil
+}
+
+// classifyAddressError classifies the error of decoding an address of the expected type
+func classifyAddressError(addrStr string, expected AddressType, err error) error {
+	if _, parseErr := parseAddress(addrStr, expected); parseErr != nil {
+		return parseErr
+	}
+	return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "failed. invalid %s address %s: %s", expected, addrStr, err)
+}
+
+// checksumHex encodes the bytes into the 0x hex with the EIP-55 checksum
+func checksumHex(bz []byte) string {
+	lower := hex.EncodeToString(bz)
+	hasher := sha3.NewLegacyKeccak256()
+	hasher.Write([]byte(lower))
+	hash := hasher.Sum(nil)
+
+	checksummed := []byte(lower)
+	for i, c := range checksummed {
+		// upper the letter if the nibble of the hash at the same index is 8 or above
+		nibble := hash[i/2]
+		if i%2 == 0 {
+			nibble >>= 4
+		}
+		if c >= 'a' && c <= 'f' && nibble&0xf >= 8 {
+			checksummed[i] = c - 'a' + 'A'
+		}
+	}
+	return "0x" + string(checksummed)
+}
@@ -0,0 +1,168 @@
+package utils
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	sdk "github.com/okex/okchain-go-sdk/types"
+	sdkerrors "github.com/okex/okchain-go-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/libs/bech32"
+)
+
+const (
+	accAddrStr = "okchain1dcsxvxgj374dv3wt9szflf9nz6342juzzkjnlz"
+)
+
+func TestValidateAddress(t *testing.T) {
+	addrType, err := ValidateAddress(accAddrStr)
+	require.NoError(t, err)
+	require.Equal(t, AddressTypeAccount, addrType)
+
+	valAddrStr, err := ConvertAddress(accAddrStr, AddressTypeValidator)
+	require.NoError(t, err)
+	addrType, err = ValidateAddress(valAddrStr)
+	require.NoError(t, err)
+	require.Equal(t, AddressTypeValidator, addrType)
+	require.Equal(t, "validator", addrType.String())
+
+	consAddrStr, err := ConvertAddress(valAddrStr, AddressTypeConsensus)
+	require.NoError(t, err)
+	addrType, err = ValidateAddress(consAddrStr)
+	require.NoError(t, err)
+	require.Equal(t, AddressTypeConsensus, addrType)
+	addrStr, err := ConvertAddress(consAddrStr, AddressTypeAccount)
+	require.NoError(t, err)
+	require.Equal(t, accAddrStr, addrStr)
+
+	_, err = ConvertAddress(accAddrStr, AddressTypeUnknown)
+	require.True(t, errors.Is(err, sdkerrors.ErrAddressPrefix))
+	_, err = ValidateAddress("")
+	require.True(t, errors.Is(err, sdkerrors.ErrInvalidAddress))
+	// bad checksum
+	_, err = ValidateAddress("okchain1dcsxvxgj374dv3wt9szflf9nz6342juzzkjnla")
+	require.True(t, errors.Is(err, sdkerrors.ErrInvalidAddress))
+	cosmosAddrStr, err := bech32.ConvertAndEncode("cosmos", make([]byte, 20))
+	require.NoError(t, err)
+	_, err = ValidateAddress(cosmosAddrStr)
+	require.True(t, errors.Is(err, sdkerrors.ErrAddressPrefix))
+}
+
+func TestOperatorAddress(t *testing.T) {
+	valAddr, err := ValAddressFromAccAddress(accAddrStr)
+	require.NoError(t, err)
+	valAddrStr, err := ConvertAddress(accAddrStr, AddressTypeValidator)
+	require.NoError(t, err)
+	require.Equal(t, valAddrStr, valAddr.String())
+
+	accAddr, err := AccAddressFromValAddress(valAddrStr)
+	require.NoError(t, err)
+	require.Equal(t, accAddrStr, accAddr.String())
+
+	_, err = ValAddressFromAccAddress(valAddrStr)
+	require.True(t, errors.Is(err, sdkerrors.ErrAddressPrefix))
+	_, err = AccAddressFromValAddress(accAddrStr)
+	require.True(t, errors.Is(err, sdkerrors.ErrAddressPrefix))
+	_, err = AccAddressFromValAddress("okchainvaloper1")
+	require.True(t, errors.Is(err, sdkerrors.ErrInvalidAddress))
+}
+
+func TestHexAddress(t *testing.T) {
+	// the EIP-55 test vector
+	accAddr, err := AccAddressFromHex("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed")
+	require.NoError(t, err)
+	hexStr, err := ToHexAddress(accAddr.String())
+	require.NoError(t, err)
+	require.Equal(t, "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", hexStr)
+
+	// the single case without checksum
+	_, err = AccAddressFromHex("5aaeb6053f3e94c9b9a09f33669435e7ef1beaed")
+	require.NoError(t, err)
+	_, err = AccAddressFromHex("0x5AAEB6053F3E94C9B9A09F33669435E7EF1BEAED")
+	require.NoError(t, err)
+
+	_, err = AccAddressFromHex("0x5aaeb6053F3E94C9b9A09f33669435E7Ef1BeAed")
+	require.True(t, errors.Is(err, sdkerrors.ErrInvalidAddress))
+	_, err = AccAddressFromHex("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeA")
+	require.True(t, errors.Is(err, sdkerrors.ErrInvalidAddress))
+	_, err = AccAddressFromHex("0xzz")
+	require.True(t, errors.Is(err, sdkerrors.ErrInvalidAddress))
+}
+
+func TestParseAddresses(t *testing.T) {
+	valAddrStr, err := ConvertAddress(accAddrStr, AddressTypeValidator)
+	require.NoError(t, err)
+	consPubKey := ed25519.GenPrivKey().PubKey()
+	consAddr := sdk.GetConsAddress(consPubKey)
+	consAddrStr := consAddr.String()
+
+	accAddr, err := ParseAccAddress(accAddrStr)
+	require.NoError(t, err)
+	require.Equal(t, accAddrStr, accAddr.String())
+	valAddr, err := ParseValAddress(valAddrStr)
+	require.NoError(t, err)
+	require.Equal(t, valAddrStr, valAddr.String())
+	parsedConsAddr, err := ParseConsAddress(consAddrStr)
+	require.NoError(t, err)
+	require.Equal(t, consAddr, parsedConsAddr)
+	require.Equal(t, sdk.ConsAddress(consPubKey.Address()), parsedConsAddr)
+
+	// the addresses of the other types are refused
+	_, err = ParseAccAddress(valAddrStr)
+	require.True(t, errors.Is(err, sdkerrors.ErrAddressPrefix))
+	_, err = ParseValAddress(consAddrStr)
+	require.True(t, errors.Is(err, sdkerrors.ErrAddressPrefix))
+	_, err = ParseConsAddress(accAddrStr)
+	require.True(t, errors.Is(err, sdkerrors.ErrAddressPrefix))
+	require.True(t, errors.Is(ValidateAddressType(accAddrStr, AddressTypeValidator), sdkerrors.ErrAddressPrefix))
+	require.NoError(t, ValidateAddressType(valAddrStr, AddressTypeValidator))
+	// empty
+	for _, parse := range []func(string) error{
+		func(s string) (err error) { _, err = ParseAccAddress(s); return },
+		func(s string) (err error) { _, err = ParseValAddress(s); return },
+		func(s string) (err error) { _, err = ParseConsAddress(s); return },
+	} {
+		require.True(t, errors.Is(parse(" "), sdkerrors.ErrInvalidAddress))
+	}
+
+	accAddrs, err := ParseAccAddresses([]string{accAddrStr, accAddrStr})
+	require.NoError(t, err)
+	require.Len(t, accAddrs, 2)
+	valAddrs, err := ParseValAddresses([]string{valAddrStr})
+	require.NoError(t, err)
+	require.Equal(t, []sdk.ValAddress{valAddr}, valAddrs)
+	consAddrs, err := ParseConsAddresses([]string{consAddrStr})
+	require.NoError(t, err)
+	require.Equal(t, []sdk.ConsAddress{consAddr}, consAddrs)
+	_, err = ParseAccAddresses([]string{accAddrStr, valAddrStr})
+	require.Error(t, err)
+	_, err = ParseValAddresses([]string{valAddrStr, ""})
+	require.Error(t, err)
+	_, err = ParseConsAddresses([]string{accAddrStr})
+	require.Error(t, err)
+
+	// the conversions between the account and the operator
+	require.Equal(t, valAddr, accAddr.ToValAddress())
+	require.Equal(t, accAddr, valAddr.ToAccAddress())
+	// never equal across the types with the same bytes
+	require.True(t, accAddr.Equals(valAddr.ToAccAddress()))
+	require.False(t, accAddr.Equals(valAddr))
+	require.False(t, valAddr.Equals(accAddr))
+	require.False(t, sdk.ConsAddress(accAddr).Equals(accAddr))
+	require.False(t, sdk.AccAddress{}.Equals(sdk.ValAddress{}))
+
+	// JSON in bech32 of each type
+	bz, err := json.Marshal(struct {
+		Acc  sdk.AccAddress  `json:"acc"`
+		Val  sdk.ValAddress  `json:"val"`
+		Cons sdk.ConsAddress `json:"cons"`
+	}{accAddr, valAddr, consAddr})
+	require.NoError(t, err)
+	require.Equal(t, `{"acc":"`+accAddrStr+`","val":"`+valAddrStr+`","cons":"`+consAddrStr+`"}`, string(bz))
+	var decodedValAddr sdk.ValAddress
+	require.NoError(t, json.Unmarshal([]byte(`"`+valAddrStr+`"`), &decodedValAddr))
+	require.Equal(t, valAddr, decodedValAddr)
+	require.Error(t, json.Unmarshal([]byte(`"`+accAddrStr+`"`), &decodedValAddr))
+}
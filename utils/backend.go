@@ -5,25 +5,36 @@ import (
 	"encoding/json"
 	"errors"
 
-	bkdtypes "github.com/okex/okchain-go-sdk/module/backend/types"
+	sdk "github.com/okex/okchain-go-sdk/types"
 )
 
 // UnmarshalListResponse unmarshals the list response from data bytes
 func UnmarshalListResponse(bz []byte, ptr interface{}) error {
-	var lr bkdtypes.ListResponse
-	if err := json.Unmarshal(bz, &lr); err != nil {
-		return err
-	}
+	_, err := UnmarshalListResponseWithPage(bz, ptr)
+	return err
+}
 
-	jsonBytes, err := json.Marshal(lr.Data.Data)
-	if err != nil {
-		return err
+// UnmarshalListResponseWithPage unmarshals the list response from data bytes and returns the page params in it
+func UnmarshalListResponseWithPage(bz []byte, ptr interface{}) (paramPage sdk.ParamPage, err error) {
+	// the list response of the backend module
+	var lr struct {
+		Data struct {
+			Data      json.RawMessage `json:"data"`
+			ParamPage sdk.ParamPage   `json:"param_page"`
+		} `json:"data"`
+	}
+	if err = json.Unmarshal(bz, &lr); err != nil {
+		return
 	}
 
-	if err := json.Unmarshal(jsonBytes, ptr); err != nil {
-		return err
+	data := lr.Data.Data
+	if len(data) == 0 {
+		data = []byte("null")
 	}
-	return nil
+	if err = json.Unmarshal(data, ptr); err != nil {
+		return
+	}
+	return lr.Data.ParamPage, nil
 }
 
 // GetDataFromBaseResponse gets the detail data from the base response bytes
@@ -0,0 +1,137 @@
+package utils
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	sdk "github.com/okex/okchain-go-sdk/types"
+	"github.com/tendermint/go-amino"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// rpcCdc decodes the JSON of the tendermint rpc responses, e.g. the evidences of the blocks
+var rpcCdc = amino.NewCodec()
+
+func init() {
+	ctypes.RegisterAmino(rpcCdc)
+}
+
+// DecodedTx - structure of a tx decoded from the raw block data, with the msgs resolved to the concrete types of the
+// modules registered in the codec
+type DecodedTx struct {
+	// Index is the index of the tx in the block
+	Index int
+	Hash  string
+	Bytes []byte
+	Tx    sdk.StdTx
+	// Err is the error of decoding the tx, e.g. the msgs unknown to the codec, whose Tx is empty
+	Err error
+}
+
+// Msgs returns the msgs of the tx, which are able to be type-switched to the msgs of the modules
+func (dt DecodedTx) Msgs() []sdk.Msg {
+	return dt.Tx.Msgs
+}
+
+// DecodedBlock - structure of a block decoded from the raw block data
+type DecodedBlock struct {
+	BlockID tmtypes.BlockID
+	Header  tmtypes.Header
+	Txs     []DecodedTx
+}
+
+// DecodeTxs decodes the raw txs of a block, where the txs undecodable are kept with their errors instead of failing
+// the whole block, so that an indexer skips or records them
+func DecodeTxs(cdc sdk.SDKCodec, txs [][]byte) []DecodedTx {
+	decodedTxs := make([]DecodedTx, len(txs))
+	for i, txBytes := range txs {
+		decodedTxs[i] = DecodedTx{
+			Index: i,
+			Hash:  cmn.HexBytes(tmtypes.Tx(txBytes).Hash()).String(),
+			Bytes: txBytes,
+		}
+		decodedTxs[i].Tx, decodedTxs[i].Err = DecodeStdTx(cdc, txBytes)
+	}
+	return decodedTxs
+}
+
+// DecodeBase64Txs decodes the base64 txs, e.g. the txs in the JSON of the /block rpc
+func DecodeBase64Txs(cdc sdk.SDKCodec, txStrs []string) ([]DecodedTx, error) {
+	txs := make([][]byte, len(txStrs))
+	for i, txStr := range txStrs {
+		txBytes, err := base64.StdEncoding.DecodeString(txStr)
+		if err != nil {
+			return nil, fmt.Errorf("failed. decode base64 tx %d error: %w", i, err)
+		}
+		txs[i] = txBytes
+	}
+	return DecodeTxs(cdc, txs), nil
+}
+
+// DecodeBlockJSON decodes the JSON of the /block rpc of tendermint, either the whole JSON-RPC response or its result,
+// into the block with the txs decoded
+func DecodeBlockJSON(cdc sdk.SDKCodec, bz []byte) (block DecodedBlock, err error) {
+	var envelope struct {
+		Result json.RawMessage `json:"result"`
+		Error  json.RawMessage `json:"error"`
+	}
+	if err = json.Unmarshal(bz, &envelope); err != nil {
+		return block, fmt.Errorf("failed. invalid block JSON: %w", err)
+	}
+	if len(envelope.Error) != 0 && string(envelope.Error) != "null" {
+		return block, fmt.Errorf("failed. rpc error of the block JSON: %s", envelope.Error)
+	}
+	if len(envelope.Result) != 0 {
+		bz = envelope.Result
+	}
+
+	// the block id is in the block meta of the nodes of okchain, and at the top in the later versions of tendermint
+	var resultBlock struct {
+		BlockMeta *tmtypes.BlockMeta `json:"block_meta"`
+		BlockID   *tmtypes.BlockID   `json:"block_id"`
+		Block     *tmtypes.Block     `json:"block"`
+	}
+	if err = rpcCdc.UnmarshalJSON(bz, &resultBlock); err != nil {
+		return block, fmt.Errorf("failed. decode block JSON error: %w", err)
+	}
+	if resultBlock.Block == nil {
+		return block, errors.New("failed. no block in the block JSON")
+	}
+
+	var blockID tmtypes.BlockID
+	if resultBlock.BlockMeta != nil {
+		blockID = resultBlock.BlockMeta.BlockID
+	} else if resultBlock.BlockID != nil {
+		blockID = *resultBlock.BlockID
+	}
+	return newDecodedBlock(cdc, blockID, resultBlock.Block), nil
+}
+
+// DecodeResultBlock decodes the block queried by the rpc client of tendermint into the block with the txs decoded
+func DecodeResultBlock(cdc sdk.SDKCodec, resultBlock *ctypes.ResultBlock) (DecodedBlock, error) {
+	if resultBlock == nil || resultBlock.Block == nil {
+		return DecodedBlock{}, errors.New("failed. no block in the result")
+	}
+
+	var blockID tmtypes.BlockID
+	if resultBlock.BlockMeta != nil {
+		blockID = resultBlock.BlockMeta.BlockID
+	}
+	return newDecodedBlock(cdc, blockID, resultBlock.Block), nil
+}
+
+func newDecodedBlock(cdc sdk.SDKCodec, blockID tmtypes.BlockID, block *tmtypes.Block) DecodedBlock {
+	txs := make([][]byte, len(block.Txs))
+	for i, tx := range block.Txs {
+		txs[i] = tx
+	}
+	return DecodedBlock{
+		BlockID: blockID,
+		Header:  block.Header,
+		Txs:     DecodeTxs(cdc, txs),
+	}
+}
@@ -0,0 +1,66 @@
+package utils
+
+import (
+	"encoding/base64"
+	"fmt"
+	"testing"
+
+	sdk "github.com/okex/okchain-go-sdk/types"
+	"github.com/stretchr/testify/require"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+func TestDecodeBlockJSON(t *testing.T) {
+	addr, err := sdk.AccAddressFromBech32(accAddr1)
+	require.NoError(t, err)
+	feeCoins, err := sdk.ParseDecCoins("0.01okt")
+	require.NoError(t, err)
+	stdTx := sdk.NewStdTx([]sdk.Msg{TestMsg{addr}}, sdk.NewStdFee(200000, feeCoins), nil, "memo")
+	txBytes, err := testCdc.MarshalBinaryLengthPrefixed(stdTx)
+	require.NoError(t, err)
+
+	block := tmtypes.MakeBlock(1024, []tmtypes.Tx{txBytes, []byte("unknown tx")}, &tmtypes.Commit{}, nil)
+	blockID := tmtypes.BlockID{Hash: cmn.HexBytes("block hash")}
+	result, err := rpcCdc.MarshalJSON(ctypes.ResultBlock{
+		BlockMeta: &tmtypes.BlockMeta{BlockID: blockID, Header: block.Header},
+		Block:     block,
+	})
+	require.NoError(t, err)
+
+	// the result and the whole JSON-RPC response
+	for _, bz := range [][]byte{
+		result,
+		[]byte(fmt.Sprintf(`{"jsonrpc":"2.0","id":"","result":%s}`, result)),
+	} {
+		decoded, err := DecodeBlockJSON(testCdc, bz)
+		require.NoError(t, err)
+		require.Equal(t, blockID.Hash, decoded.BlockID.Hash)
+		require.Equal(t, int64(1024), decoded.Header.Height)
+		require.Len(t, decoded.Txs, 2)
+		require.NoError(t, decoded.Txs[0].Err)
+		require.Equal(t, sdk.NewSignedTx(stdTx, txBytes).Hash, decoded.Txs[0].Hash)
+		require.Equal(t, []sdk.Msg{TestMsg{addr}}, decoded.Txs[0].Msgs())
+		require.Equal(t, "memo", decoded.Txs[0].Tx.Memo)
+		require.Equal(t, 1, decoded.Txs[1].Index)
+		require.Error(t, decoded.Txs[1].Err)
+	}
+
+	for _, invalid := range []string{
+		"not json",
+		`{"jsonrpc":"2.0","id":"","error":{"code":-32603,"message":"height 1025 must be less than or equal to 1024"}}`,
+		`{"block_meta":null,"block":null}`,
+	} {
+		_, err = DecodeBlockJSON(testCdc, []byte(invalid))
+		require.Error(t, err, invalid)
+	}
+
+	// the base64 txs
+	decodedTxs, err := DecodeBase64Txs(testCdc, []string{base64.StdEncoding.EncodeToString(txBytes)})
+	require.NoError(t, err)
+	require.Len(t, decodedTxs, 1)
+	require.Equal(t, stdTx.Memo, decodedTxs[0].Tx.Memo)
+	_, err = DecodeBase64Txs(testCdc, []string{"not base64!"})
+	require.Error(t, err)
+}
@@ -0,0 +1,127 @@
+package utils
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	"github.com/okex/okchain-go-sdk/types/tx"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+)
+
+const (
+	// EncryptedMemoPrefix marks a memo encrypted by EncryptMemo
+	EncryptedMemoPrefix = "enc:"
+	// MaxMemoCharacters is the limit of the memo length on OKChain
+	MaxMemoCharacters = sdk.MaxMemoCharacters
+
+	compressedPubKeyLen = 33
+)
+
+// EncryptMemo encrypts the memo to the secp256k1 public key of the recipient by ECIES, whose output is encoded
+// compactly as "enc:" + base64url(ephemeral compressed pubkey || AES-256-GCM ciphertext with tag)
+// The public key of the recipient is available by its account info queried on chain
+func EncryptMemo(recipientPubKey crypto.PubKey, memo string) (string, error) {
+	pubKeySecp256k1, ok := recipientPubKey.(secp256k1.PubKeySecp256k1)
+	if !ok {
+		return "", errors.New("failed. only the secp256k1 public key is supported for the memo encryption")
+	}
+
+	pubKey, err := btcec.ParsePubKey(pubKeySecp256k1[:], btcec.S256())
+	if err != nil {
+		return "", fmt.Errorf("failed. parse the public key of recipient error: %s", err)
+	}
+
+	ephemeralKey, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		return "", err
+	}
+	ephemeralPubKey := ephemeralKey.PubKey().SerializeCompressed()
+
+	aead, err := newMemoAEAD(btcec.GenerateSharedSecret(ephemeralKey, pubKey), ephemeralPubKey)
+	if err != nil {
+		return "", err
+	}
+
+	// the nonce is fixed because the key is derived from a fresh ephemeral key each time
+	cipherText := aead.Seal(nil, make([]byte, aead.NonceSize()), []byte(memo), nil)
+	encryptedMemo := EncryptedMemoPrefix + base64.RawURLEncoding.EncodeToString(append(ephemeralPubKey, cipherText...))
+	if len(encryptedMemo) > MaxMemoCharacters {
+		return "", fmt.Errorf("failed. the encrypted memo has %d characters which exceeds the limit %d",
+			len(encryptedMemo), MaxMemoCharacters)
+	}
+
+	return encryptedMemo, nil
+}
+
+// DecryptMemo decrypts the memo encrypted by EncryptMemo with the secp256k1 private key of the recipient
+func DecryptMemo(privKey crypto.PrivKey, encryptedMemo string) (string, error) {
+	privKeySecp256k1, ok := privKey.(secp256k1.PrivKeySecp256k1)
+	if !ok {
+		return "", errors.New("failed. only the secp256k1 private key is supported for the memo decryption")
+	}
+
+	if !IsEncryptedMemo(encryptedMemo) {
+		return "", errors.New("failed. the memo isn't encrypted")
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimPrefix(encryptedMemo, EncryptedMemoPrefix))
+	if err != nil {
+		return "", fmt.Errorf("failed. decode the encrypted memo error: %s", err)
+	}
+	if len(payload) < compressedPubKeyLen {
+		return "", errors.New("failed. the encrypted memo is too short")
+	}
+
+	ephemeralPubKeyBytes := payload[:compressedPubKeyLen]
+	ephemeralPubKey, err := btcec.ParsePubKey(ephemeralPubKeyBytes, btcec.S256())
+	if err != nil {
+		return "", fmt.Errorf("failed. parse the ephemeral public key error: %s", err)
+	}
+
+	recipientKey, _ := btcec.PrivKeyFromBytes(btcec.S256(), privKeySecp256k1[:])
+	aead, err := newMemoAEAD(btcec.GenerateSharedSecret(recipientKey, ephemeralPubKey), ephemeralPubKeyBytes)
+	if err != nil {
+		return "", err
+	}
+
+	memo, err := aead.Open(nil, make([]byte, aead.NonceSize()), payload[compressedPubKeyLen:], nil)
+	if err != nil {
+		return "", fmt.Errorf("failed. decrypt the memo error: %s", err)
+	}
+
+	return string(memo), nil
+}
+
+// DecryptMemoWithKey decrypts the memo with the private key of a specific key info in the keybase
+func DecryptMemoWithKey(name, passWd, encryptedMemo string) (string, error) {
+	privKey, err := tx.Kb.ExportPrivateKeyObject(name, passWd)
+	if err != nil {
+		return "", err
+	}
+
+	return DecryptMemo(privKey, encryptedMemo)
+}
+
+// IsEncryptedMemo tells whether the memo is encrypted by EncryptMemo
+func IsEncryptedMemo(memo string) bool {
+	return strings.HasPrefix(memo, EncryptedMemoPrefix)
+}
+
+func newMemoAEAD(sharedSecret, ephemeralPubKey []byte) (cipher.AEAD, error) {
+	// KDF: sha256(shared secret || ephemeral public key)
+	key := sha256.Sum256(append(sharedSecret, ephemeralPubKey...))
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,64 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+)
+
+func TestEncryptMemo(t *testing.T) {
+	privKey := secp256k1.GenPrivKey()
+	encryptedMemo, err := EncryptMemo(privKey.PubKey(), defaultMemo)
+	require.NoError(t, err)
+	require.True(t, IsEncryptedMemo(encryptedMemo))
+	require.False(t, strings.Contains(encryptedMemo, defaultMemo))
+
+	memo, err := DecryptMemo(privKey, encryptedMemo)
+	require.NoError(t, err)
+	require.Equal(t, defaultMemo, memo)
+
+	// fresh ephemeral key each time
+	encryptedMemo2, err := EncryptMemo(privKey.PubKey(), defaultMemo)
+	require.NoError(t, err)
+	require.NotEqual(t, encryptedMemo, encryptedMemo2)
+
+	// wrong key
+	_, err = DecryptMemo(secp256k1.GenPrivKey(), encryptedMemo)
+	require.Error(t, err)
+
+	// tampered
+	_, err = DecryptMemo(privKey, encryptedMemo[:len(encryptedMemo)-2])
+	require.Error(t, err)
+	_, err = DecryptMemo(privKey, defaultMemo)
+	require.Error(t, err)
+	_, err = DecryptMemo(privKey, EncryptedMemoPrefix+"AAAA")
+	require.Error(t, err)
+
+	// unsupported key type
+	_, err = EncryptMemo(ed25519.GenPrivKey().PubKey(), defaultMemo)
+	require.Error(t, err)
+	_, err = DecryptMemo(ed25519.GenPrivKey(), encryptedMemo)
+	require.Error(t, err)
+
+	// too long
+	_, err = EncryptMemo(privKey.PubKey(), strings.Repeat("a", MaxMemoCharacters))
+	require.Error(t, err)
+}
+
+func TestDecryptMemoWithKey(t *testing.T) {
+	info, _, err := CreateAccountWithMnemo(defaultMnemonic, defaultName, defaultPassWd)
+	require.NoError(t, err)
+
+	encryptedMemo, err := EncryptMemo(info.GetPubKey(), defaultMemo)
+	require.NoError(t, err)
+
+	memo, err := DecryptMemoWithKey(defaultName, defaultPassWd, encryptedMemo)
+	require.NoError(t, err)
+	require.Equal(t, defaultMemo, memo)
+
+	_, err = DecryptMemoWithKey(defaultName, "wrong password", encryptedMemo)
+	require.Error(t, err)
+}
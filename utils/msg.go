@@ -0,0 +1,36 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/okex/okchain-go-sdk/types"
+)
+
+// MarshalMsgJSON encodes the msg into the canonical amino JSON by the codec with the msgs of the modules registered,
+// i.e. with the type of the msg and the keys sorted, which is exactly the msg in the sign bytes of the tx, e.g. for the
+// review UIs and the approval workflows to inspect what will be signed
+func MarshalMsgJSON(cdc sdk.SDKCodec, msg sdk.Msg) ([]byte, error) {
+	if msg == nil {
+		return nil, errors.New("failed. nil msg to encode")
+	}
+
+	bz, err := cdc.MarshalJSON(msg)
+	if err != nil {
+		return nil, fmt.Errorf("failed. encode the msg error: %w", err)
+	}
+	return sdk.SortJSON(bz)
+}
+
+// UnmarshalMsgJSON decodes the amino JSON of a msg, e.g. encoded by MarshalMsgJSON, into the concrete msg of the
+// modules registered in the codec
+func UnmarshalMsgJSON(cdc sdk.SDKCodec, bz []byte) (msg sdk.Msg, err error) {
+	if len(bz) == 0 {
+		return nil, errors.New("failed. empty msg JSON")
+	}
+
+	if err = cdc.UnmarshalJSON(bz, &msg); err != nil {
+		return nil, fmt.Errorf("failed. decode the msg error: %w", err)
+	}
+	return
+}
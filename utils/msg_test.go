@@ -0,0 +1,46 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/okex/okchain-go-sdk/module/token/types"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+)
+
+func TestMarshalMsgJSON(t *testing.T) {
+	cdc := sdk.NewCodec()
+	types.RegisterCodec(cdc)
+	sdk.RegisterBasicCodec(cdc)
+
+	fromAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	toAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	coins, err := sdk.ParseDecCoins(coinsStr1)
+	require.NoError(t, err)
+	msg := types.NewMsgTokenSend(fromAddr, toAddr, coins)
+
+	bz, err := MarshalMsgJSON(cdc, msg)
+	require.NoError(t, err)
+	// the same as the msg signed
+	require.Equal(t, msg.GetSignBytes(), bz)
+
+	decoded, err := UnmarshalMsgJSON(cdc, bz)
+	require.NoError(t, err)
+	require.Equal(t, msg, decoded)
+
+	// round trip
+	reencoded, err := MarshalMsgJSON(cdc, decoded)
+	require.NoError(t, err)
+	require.Equal(t, bz, reencoded)
+
+	_, err = MarshalMsgJSON(cdc, nil)
+	require.Error(t, err)
+	_, err = UnmarshalMsgJSON(cdc, nil)
+	require.Error(t, err)
+	_, err = UnmarshalMsgJSON(cdc, []byte(`{"type":"unknown/msg","value":{}}`))
+	require.Error(t, err)
+	// unregistered msg
+	_, err = UnmarshalMsgJSON(sdk.NewCodec(), bz)
+	require.Error(t, err)
+}
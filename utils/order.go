@@ -2,7 +2,6 @@ package utils
 
 import (
 	"encoding/json"
-	"github.com/okex/okchain-go-sdk/module/order/types"
 	sdk "github.com/okex/okchain-go-sdk/types"
 	"log"
 )
@@ -14,7 +13,10 @@ func GetOrderIDsFromResponse(txResp *sdk.TxResponse) (orderIDs []string) {
 		if event.Type == "message" {
 			for _, attribute := range event.Attributes {
 				if attribute.Key == "orders" {
-					var orderRes []types.OrderResult
+					// the results of the orders placed, i.e. order.OrderResult
+					var orderRes []struct {
+						OrderID string `json:"orderid"`
+					}
 					if err := json.Unmarshal([]byte(attribute.Value), &orderRes); err != nil {
 						log.Println(ErrUnmarshalJSON(err.Error()).Error())
 						continue
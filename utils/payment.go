@@ -0,0 +1,168 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	sdk "github.com/okex/okchain-go-sdk/types"
+)
+
+// PaymentURIScheme is the scheme of the payment URIs of OKChain
+const PaymentURIScheme = "okchain"
+
+// the params of the payment URIs
+const (
+	paymentParamAmount  = "amount"
+	paymentParamDenom   = "denom"
+	paymentParamMemo    = "memo"
+	paymentParamLabel   = "label"
+	paymentParamMessage = "message"
+	// requiredParamPrefix marks the params that the parsers not knowing them must refuse, as in BIP21
+	requiredParamPrefix = "req-"
+)
+
+// PaymentRequest - structure of a payment request shared by the wallets and the points of sale in the payment URI
+//
+//	okchain:okchain1...?amount=10.5&denom=okt&memo=order%2042&label=Coffee%20Shop
+type PaymentRequest struct {
+	Address sdk.AccAddress
+	// Amount is nil if it's left to the payer
+	Amount sdk.Dec
+	// Denom is empty if it's left to the payer, which is required with the amount
+	Denom string
+	// Memo is the memo of the transfer, e.g. the order ID telling the payment of the merchant
+	Memo string
+	// Label is the name of the recipient and Message describes the payment, both for display only
+	Label   string
+	Message string
+}
+
+// ValidateBasic gives a quick validity check for the payment request
+func (pr PaymentRequest) ValidateBasic() error {
+	if pr.Address.Empty() {
+		return errors.New("failed. empty address of the payment request")
+	}
+	if !pr.Amount.IsNil() {
+		if !pr.Amount.IsPositive() {
+			return fmt.Errorf("failed. amount %s of the payment request must be positive", pr.Amount)
+		}
+		if len(pr.Denom) == 0 {
+			return errors.New("failed. empty denom of the amount of the payment request")
+		}
+	}
+	if len(pr.Denom) != 0 {
+		if err := sdk.ValidateDenom(pr.Denom); err != nil {
+			return fmt.Errorf("failed. payment request: %w", err)
+		}
+	}
+	if len(pr.Memo) > MaxMemoCharacters {
+		return fmt.Errorf("failed. memo of the payment request has %d characters which exceeds the limit %d",
+			len(pr.Memo), MaxMemoCharacters)
+	}
+	return nil
+}
+
+// URI returns the payment URI of the request, with the params in a fixed order
+func (pr PaymentRequest) URI() (string, error) {
+	if err := pr.ValidateBasic(); err != nil {
+		return "", err
+	}
+
+	var params []string
+	addParam := func(name, value string) {
+		if len(value) != 0 {
+			// the spaces are escaped as %20 instead of + for the parsers of the other platforms
+			params = append(params, name+"="+strings.ReplaceAll(url.QueryEscape(value), "+", "%20"))
+		}
+	}
+	if !pr.Amount.IsNil() {
+		addParam(paymentParamAmount, formatPaymentAmount(pr.Amount))
+	}
+	addParam(paymentParamDenom, pr.Denom)
+	addParam(paymentParamMemo, pr.Memo)
+	addParam(paymentParamLabel, pr.Label)
+	addParam(paymentParamMessage, pr.Message)
+
+	uri := PaymentURIScheme + ":" + pr.Address.String()
+	if len(params) != 0 {
+		uri += "?" + strings.Join(params, "&")
+	}
+	return uri, nil
+}
+
+// QRPayload returns the payload of the QR code of the request, which is the payment URI. The URI of an address only is
+// in upper case to be encoded in the compact alphanumeric mode of the QR codes
+func (pr PaymentRequest) QRPayload() (string, error) {
+	uri, err := pr.URI()
+	if err != nil {
+		return "", err
+	}
+	if !strings.Contains(uri, "?") {
+		return strings.ToUpper(uri), nil
+	}
+	return uri, nil
+}
+
+// ParsePaymentURI parses the payment URI or the QR payload of a payment request. The unknown params are ignored except
+// the ones prefixed with "req-", which are refused
+func ParsePaymentURI(uri string) (pr PaymentRequest, err error) {
+	uri = strings.TrimSpace(uri)
+	i := strings.Index(uri, ":")
+	if i < 0 || !strings.EqualFold(uri[:i], PaymentURIScheme) {
+		return pr, fmt.Errorf("failed. payment URI %s should start with %s:", uri, PaymentURIScheme)
+	}
+
+	addrStr, rawQuery := strings.TrimPrefix(uri[i+1:], "//"), ""
+	if j := strings.Index(addrStr, "?"); j >= 0 {
+		addrStr, rawQuery = addrStr[:j], addrStr[j+1:]
+	}
+	// bech32 is case insensitive as a whole, e.g. in the QR payloads
+	if addrStr == strings.ToUpper(addrStr) {
+		addrStr = strings.ToLower(addrStr)
+	}
+	if pr.Address, err = sdk.AccAddressFromBech32(addrStr); err != nil {
+		return pr, fmt.Errorf("failed. invalid address %s of the payment URI: %w", addrStr, err)
+	}
+
+	params, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return pr, fmt.Errorf("failed. invalid params of the payment URI: %w", err)
+	}
+	for name, values := range params {
+		if len(values) > 1 {
+			return pr, fmt.Errorf("failed. duplicate param %s of the payment URI", name)
+		}
+		value := values[0]
+		switch name {
+		case paymentParamAmount:
+			if pr.Amount, err = sdk.NewDecFromStr(value); err != nil {
+				return pr, fmt.Errorf("failed. invalid amount %s of the payment URI: %w", value, err)
+			}
+		case paymentParamDenom:
+			pr.Denom = value
+		case paymentParamMemo:
+			pr.Memo = value
+		case paymentParamLabel:
+			pr.Label = value
+		case paymentParamMessage:
+			pr.Message = value
+		default:
+			if strings.HasPrefix(name, requiredParamPrefix) {
+				return pr, fmt.Errorf("failed. unsupported required param %s of the payment URI", name)
+			}
+		}
+	}
+
+	return pr, pr.ValidateBasic()
+}
+
+// formatPaymentAmount formats the amount without the trailing zeros, e.g. 10.5 instead of 10.50000000
+func formatPaymentAmount(amount sdk.Dec) string {
+	str := amount.String()
+	if strings.Contains(str, ".") {
+		str = strings.TrimRight(strings.TrimRight(str, "0"), ".")
+	}
+	return str
+}
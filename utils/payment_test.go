@@ -0,0 +1,66 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/okex/okchain-go-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPaymentRequest_URI(t *testing.T) {
+	addr, err := sdk.AccAddressFromBech32(accAddrStr)
+	require.NoError(t, err)
+	amount, err := sdk.NewDecFromStr("10.5")
+	require.NoError(t, err)
+	pr := PaymentRequest{
+		Address: addr,
+		Amount:  amount,
+		Denom:   "okt",
+		Memo:    "order #42&more",
+		Label:   "Coffee Shop",
+	}
+
+	uri, err := pr.URI()
+	require.NoError(t, err)
+	require.Equal(t, "okchain:"+accAddrStr+"?amount=10.5&denom=okt&memo=order%20%2342%26more&label=Coffee%20Shop", uri)
+	payload, err := pr.QRPayload()
+	require.NoError(t, err)
+	require.Equal(t, uri, payload)
+	parsed, err := ParsePaymentURI(uri)
+	require.NoError(t, err)
+	require.Equal(t, pr, parsed)
+
+	// an address only in upper case for the alphanumeric QR codes
+	payload, err = PaymentRequest{Address: addr}.QRPayload()
+	require.NoError(t, err)
+	require.Equal(t, strings.ToUpper("okchain:"+accAddrStr), payload)
+	parsed, err = ParsePaymentURI(payload)
+	require.NoError(t, err)
+	require.Equal(t, PaymentRequest{Address: addr}, parsed)
+
+	// the other forms accepted
+	parsed, err = ParsePaymentURI(" okchain://" + accAddrStr + "?denom=xxb-123&message=thanks+a+lot&foo=bar ")
+	require.NoError(t, err)
+	require.True(t, parsed.Amount.IsNil())
+	require.Equal(t, "xxb-123", parsed.Denom)
+	require.Equal(t, "thanks a lot", parsed.Message)
+
+	for _, invalid := range []string{
+		"bitcoin:" + accAddrStr,
+		accAddrStr,
+		"okchain:okchain1invalid",
+		"okchain:" + accAddrStr + "?amount=10",
+		"okchain:" + accAddrStr + "?amount=-1&denom=okt",
+		"okchain:" + accAddrStr + "?amount=ten&denom=okt",
+		"okchain:" + accAddrStr + "?denom=OKT",
+		"okchain:" + accAddrStr + "?memo=a&memo=b",
+		"okchain:" + accAddrStr + "?req-expiry=1600000000",
+		"okchain:" + accAddrStr + "?memo=" + strings.Repeat("m", MaxMemoCharacters+1),
+	} {
+		_, err = ParsePaymentURI(invalid)
+		require.Error(t, err, invalid)
+	}
+	_, err = PaymentRequest{}.URI()
+	require.Error(t, err)
+}
@@ -0,0 +1,195 @@
+package utils
+
+import (
+	"encoding/json"
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+
+	sdk "github.com/okex/okchain-go-sdk/types"
+	sdkerrors "github.com/okex/okchain-go-sdk/types/errors"
+)
+
+// RejectionCause is a likely cause of the rejection of a tx
+type RejectionCause int
+
+// likely causes of the rejection of a tx
+const (
+	CauseUnknown RejectionCause = iota
+	// CauseWrongSequence means the sequence of the signer is stale or used ahead, e.g. a tx of the account is pending
+	CauseWrongSequence
+	// CauseInvalidSignature means the signature mismatches, e.g. a wrong chain ID or account number
+	CauseInvalidSignature
+	// CauseInsufficientFunds means the balance of the account can't afford the amount or the fees
+	CauseInsufficientFunds
+	// CauseInsufficientFee means the fees are lower than the min gas prices of the node
+	CauseInsufficientFee
+	// CauseBadPrecision means the amount exceeds the precision of the decimals, which is 8 on okchain
+	CauseBadPrecision
+	// CauseInvalidCoins means the coins are malformed, e.g. an unknown denom or a non-positive amount
+	CauseInvalidCoins
+	// CauseOutOfGas means the gas limit is too low to run the msgs
+	CauseOutOfGas
+	// CauseInvalidAddress means an address in the msgs is malformed
+	CauseInvalidAddress
+)
+
+var rejectionCauseNames = map[RejectionCause]string{
+	CauseUnknown:           "unknown",
+	CauseWrongSequence:     "wrong sequence",
+	CauseInvalidSignature:  "invalid signature",
+	CauseInsufficientFunds: "insufficient funds",
+	CauseInsufficientFee:   "insufficient fee",
+	CauseBadPrecision:      "bad precision",
+	CauseInvalidCoins:      "invalid coins",
+	CauseOutOfGas:          "out of gas",
+	CauseInvalidAddress:    "invalid address",
+}
+
+// String returns the readable name of the cause
+func (c RejectionCause) String() string {
+	if name, ok := rejectionCauseNames[c]; ok {
+		return name
+	}
+	return rejectionCauseNames[CauseUnknown]
+}
+
+// the sentinel errors and the keywords in the lowercase messages of the causes, in the order of inference
+var rejectionCauseRules = []struct {
+	cause    RejectionCause
+	kind     error
+	keywords []string
+}{
+	{CauseWrongSequence, sdkerrors.ErrSequenceMismatch, []string{"sequence"}},
+	{CauseInvalidSignature, sdkerrors.ErrUnauthorized, []string{"signature verification failed", "unauthorized"}},
+	{CauseInsufficientFee, sdkerrors.ErrInsufficientFee, []string{"insufficient fee"}},
+	{CauseInsufficientFunds, sdkerrors.ErrInsufficientFunds, []string{"insufficient account funds",
+		"insufficient funds", "insufficient coins", "insufficient balance"}},
+	{CauseBadPrecision, nil, []string{"precision", "decimal"}},
+	{CauseInvalidCoins, sdkerrors.ErrInvalidCoins, []string{"invalid coins", "invalid denom"}},
+	{CauseOutOfGas, sdkerrors.ErrOutOfGas, []string{"out of gas"}},
+	{CauseInvalidAddress, sdkerrors.ErrInvalidAddress, []string{"invalid address"}},
+}
+
+// sequenceMismatchRegexp matches the sequence mismatch message of the ante handler, e.g.
+// "Invalid sequence. Got 3, expected 5"
+var sequenceMismatchRegexp = regexp.MustCompile(`(?i)got (\d+), expected (\d+)`)
+
+// RejectedTx - structure of the forensic result of a rejected tx
+type RejectedTx struct {
+	Codespace string
+	Code      uint32
+	// Err is the typed error of the ABCI code, which works with errors.Is on the sentinel errors of gosdk
+	Err error
+	// MsgIndex is the index of the failing msg, -1 if the tx fails before running the msgs, e.g. in the ante handler
+	MsgIndex int
+	// Msg is the failing msg if the tx is carried by the response
+	Msg sdk.Msg
+	// Message is the decoded message of the failure
+	Message string
+	// Causes are the likely causes inferred from the code and the message, with the most likely first
+	Causes []RejectionCause
+	// ExpectedSequence is the sequence that the chain expects on CauseWrongSequence, -1 if unknown
+	ExpectedSequence int64
+}
+
+// HasCause tells whether the cause is one of the likely causes
+func (rt RejectedTx) HasCause(cause RejectionCause) bool {
+	for _, c := range rt.Causes {
+		if c == cause {
+			return true
+		}
+	}
+	return false
+}
+
+// abciErrorLog - structure of the json error log of a failed ABCI response
+type abciErrorLog struct {
+	Codespace string `json:"codespace"`
+	Code      uint32 `json:"code"`
+	Message   string `json:"message"`
+}
+
+// DecodeRejectedTx decodes the raw log of a rejected tx response into the typed error, the failing msg and the likely
+// causes, for the callers to react programmatically, e.g. resyncing the sequence or topping up the fees
+func DecodeRejectedTx(txResp sdk.TxResponse) (rt RejectedTx, err error) {
+	if txResp.Code == 0 {
+		return rt, errors.New("failed. the tx is not rejected")
+	}
+
+	rt = RejectedTx{
+		Codespace:        txResp.Codespace,
+		Code:             txResp.Code,
+		MsgIndex:         -1,
+		Message:          txResp.RawLog,
+		ExpectedSequence: -1,
+	}
+
+	// the logs of the msgs run until the failing one
+	logs := txResp.Logs
+	if len(logs) == 0 {
+		logs, _ = sdk.ParseABCILogs(txResp.RawLog)
+	}
+	for _, msgLog := range logs {
+		if !msgLog.Success {
+			rt.MsgIndex, rt.Message = int(msgLog.MsgIndex), msgLog.Log
+			break
+		}
+	}
+
+	var errLog abciErrorLog
+	if json.Unmarshal([]byte(rt.Message), &errLog) == nil && len(errLog.Message) != 0 {
+		rt.Message = errLog.Message
+		if len(rt.Codespace) == 0 {
+			rt.Codespace = errLog.Codespace
+		}
+	}
+
+	// the msgs of sdk.StdTx are not exposed by GetMsgs
+	if stdTx, ok := txResp.Tx.(sdk.StdTx); ok && rt.MsgIndex >= 0 && rt.MsgIndex < len(stdTx.Msgs) {
+		rt.Msg = stdTx.Msgs[rt.MsgIndex]
+	}
+
+	rt.Err = sdkerrors.FromABCI(rt.Codespace, rt.Code, rt.Message)
+	rt.Causes = inferRejectionCauses(rt.Err, rt.Message)
+	if rt.HasCause(CauseWrongSequence) {
+		if matches := sequenceMismatchRegexp.FindStringSubmatch(rt.Message); matches != nil {
+			rt.ExpectedSequence, _ = strconv.ParseInt(matches[2], 10, 64)
+		}
+	}
+	return
+}
+
+func inferRejectionCauses(err error, message string) (causes []RejectionCause) {
+	// the message is more specific than the code, e.g. the bad precision of the invalid coins
+	lowerMsg := strings.ToLower(message)
+	for _, rule := range rejectionCauseRules {
+		for _, keyword := range rule.keywords {
+			if strings.Contains(lowerMsg, keyword) {
+				causes = append(causes, rule.cause)
+				break
+			}
+		}
+	}
+
+	for _, rule := range rejectionCauseRules {
+		if rule.kind != nil && errors.Is(err, rule.kind) {
+			causes = appendCause(causes, rule.cause)
+		}
+	}
+
+	if len(causes) == 0 {
+		causes = append(causes, CauseUnknown)
+	}
+	return
+}
+
+func appendCause(causes []RejectionCause, cause RejectionCause) []RejectionCause {
+	for _, c := range causes {
+		if c == cause {
+			return causes
+		}
+	}
+	return append(causes, cause)
+}
@@ -0,0 +1,71 @@
+package utils
+
+import (
+	"errors"
+	"testing"
+
+	token "github.com/okex/okchain-go-sdk/module/token/types"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	sdkerrors "github.com/okex/okchain-go-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+)
+
+func TestDecodeRejectedTx(t *testing.T) {
+	// rejected by the ante handler
+	rt, err := DecodeRejectedTx(sdk.TxResponse{
+		Code:   3,
+		RawLog: `{"codespace":"sdk","code":3,"message":"Invalid sequence. Got 3, expected 5"}`,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "sdk", rt.Codespace)
+	require.Equal(t, -1, rt.MsgIndex)
+	require.Nil(t, rt.Msg)
+	require.Equal(t, "Invalid sequence. Got 3, expected 5", rt.Message)
+	require.True(t, errors.Is(rt.Err, sdkerrors.ErrSequenceMismatch))
+	require.Equal(t, []RejectionCause{CauseWrongSequence}, rt.Causes)
+	require.Equal(t, int64(5), rt.ExpectedSequence)
+
+	// the second msg fails in a multi-msg tx
+	fromAddr, toAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()),
+		sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	msgs := []sdk.Msg{
+		token.NewMsgTokenSend(fromAddr, toAddr, sdk.NewDecCoins(sdk.NewDecCoinFromDec("okt", sdk.NewDec(1)))),
+		token.NewMsgTokenSend(fromAddr, toAddr, sdk.NewDecCoins(sdk.NewDecCoinFromDec("okt", sdk.NewDec(2)))),
+	}
+	stdTx := sdk.NewStdTx(msgs, sdk.NewStdFee(200000, nil), nil, "")
+	rt, err = DecodeRejectedTx(sdk.TxResponse{
+		Code: 10,
+		RawLog: `[{"msg_index":0,"success":true,"log":"","events":[]},{"msg_index":1,"success":false,` +
+			`"log":"{\"codespace\":\"sdk\",\"code\":10,\"message\":\"insufficient coins: need 2okt\"}","events":[]}]`,
+		Tx: stdTx,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, rt.MsgIndex)
+	require.Equal(t, msgs[1], rt.Msg)
+	require.Equal(t, "insufficient coins: need 2okt", rt.Message)
+	require.True(t, errors.Is(rt.Err, sdkerrors.ErrInsufficientFunds))
+	require.Equal(t, []RejectionCause{CauseInsufficientFunds}, rt.Causes)
+	require.Equal(t, int64(-1), rt.ExpectedSequence)
+
+	// the message goes before the code
+	rt, err = DecodeRejectedTx(sdk.TxResponse{
+		Code:      11,
+		Codespace: "sdk",
+		RawLog:    `{"codespace":"sdk","code":11,"message":"invalid coins: 0.000000001okt exceeds the precision"}`,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []RejectionCause{CauseBadPrecision, CauseInvalidCoins}, rt.Causes)
+	require.True(t, rt.HasCause(CauseInvalidCoins))
+	require.Equal(t, "bad precision", rt.Causes[0].String())
+
+	// plain log in the module codespace
+	rt, err = DecodeRejectedTx(sdk.TxResponse{Code: 1, Codespace: "token", RawLog: "unexpected failure"})
+	require.NoError(t, err)
+	require.Equal(t, "unexpected failure", rt.Message)
+	require.Nil(t, errors.Unwrap(rt.Err))
+	require.Equal(t, []RejectionCause{CauseUnknown}, rt.Causes)
+
+	_, err = DecodeRejectedTx(sdk.TxResponse{})
+	require.Error(t, err)
+}
@@ -54,6 +54,21 @@ func ParseCommitResult(pTmCommitResult *ctypes.ResultCommit) types.ResultCommit
 	}
 }
 
+// ParseStatusResult converts raw tendermint status result type to the one gosdk requires
+func ParseStatusResult(pTmStatusResult *ctypes.ResultStatus) types.ResultStatus {
+	return types.ResultStatus{
+		NodeID:            string(pTmStatusResult.NodeInfo.ID()),
+		Moniker:           pTmStatusResult.NodeInfo.Moniker,
+		Network:           pTmStatusResult.NodeInfo.Network,
+		Version:           pTmStatusResult.NodeInfo.Version,
+		LatestBlockHash:   pTmStatusResult.SyncInfo.LatestBlockHash,
+		LatestAppHash:     pTmStatusResult.SyncInfo.LatestAppHash,
+		LatestBlockHeight: pTmStatusResult.SyncInfo.LatestBlockHeight,
+		LatestBlockTime:   pTmStatusResult.SyncInfo.LatestBlockTime,
+		CatchingUp:        pTmStatusResult.SyncInfo.CatchingUp,
+	}
+}
+
 // ParseValidatorsResult converts raw tendermint validators result type to the one gosdk requires
 func ParseValidatorsResult(pTmValsResult *ctypes.ResultValidators) types.ResultValidators {
 	return types.ResultValidators{
@@ -2,7 +2,6 @@ package utils
 
 import (
 	"errors"
-	"github.com/okex/okchain-go-sdk/module/token/types"
 	sdk "github.com/okex/okchain-go-sdk/types"
 	"strings"
 )
@@ -11,10 +10,10 @@ import (
 // Example:
 // `addr1 1okt
 // 	addr2 2okt`
-func ParseTransfersStr(str string) ([]types.TransferUnit, error) {
+func ParseTransfersStr(str string) ([]sdk.TransferUnit, error) {
 	strs := strings.Split(strings.TrimSpace(str), "\n")
 	transLen := len(strs)
-	transfers := make([]types.TransferUnit, transLen)
+	transfers := make([]sdk.TransferUnit, transLen)
 
 	for i := 0; i < transLen; i++ {
 		s := strings.Split(strs[i], " ")
@@ -33,7 +32,7 @@ func ParseTransfersStr(str string) ([]types.TransferUnit, error) {
 			return nil, err
 		}
 
-		transfers[i] = types.NewTransferUnit(to, coins)
+		transfers[i] = sdk.TransferUnit{To: to, Coins: coins}
 	}
 
 	return transfers, nil
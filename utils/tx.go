@@ -0,0 +1,92 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/okex/okchain-go-sdk/types"
+)
+
+// SignerAccount - structure of the account number and sequence which a signer signed a tx with
+type SignerAccount struct {
+	AccountNumber uint64
+	Sequence      uint64
+}
+
+// DecodeStdTx decodes the amino encoded tx bytes into StdTx by the codec with the msgs of the modules registered
+func DecodeStdTx(cdc sdk.SDKCodec, txBytes []byte) (stdTx sdk.StdTx, err error) {
+	if len(txBytes) == 0 {
+		return stdTx, errors.New("failed. empty tx bytes")
+	}
+
+	if err = cdc.UnmarshalBinaryLengthPrefixed(txBytes, &stdTx); err != nil {
+		return stdTx, fmt.Errorf("failed. decode the tx error: %w", err)
+	}
+	return
+}
+
+// GetStdTxSigners returns the addresses of the signers of the tx in the order of their signatures. The fee payer signs
+// after the signer of the msgs if it's a separate account
+// NOTE: the msgs of gosdk don't declare their signers, so the signers are recognized by the public keys of signatures
+func GetStdTxSigners(stdTx sdk.StdTx) []sdk.AccAddress {
+	signers := make([]sdk.AccAddress, 0, len(stdTx.Signatures))
+	for _, sig := range stdTx.Signatures {
+		if sig.PubKey == nil {
+			continue
+		}
+		signers = append(signers, sdk.AccAddress(sig.PubKey.Address()))
+	}
+	return signers
+}
+
+// VerifyStdTxSignatures verifies the signatures of the tx offline against the chain id and the account number and
+// sequence of each signer, in the same order as the signatures. Besides the signatures themselves, it checks that the
+// fee payer signs the tx last and the signers declared by the msgs are all among the signers
+func VerifyStdTxSignatures(stdTx sdk.StdTx, chainID string, signerAccs []SignerAccount) error {
+	if len(stdTx.Signatures) == 0 {
+		return errors.New("failed. the tx is unsigned")
+	}
+	if len(signerAccs) != len(stdTx.Signatures) {
+		return fmt.Errorf("failed. %d signer accounts are expected, but got %d", len(stdTx.Signatures),
+			len(signerAccs))
+	}
+
+	signers := make(map[string]bool, len(stdTx.Signatures))
+	for i, sig := range stdTx.Signatures {
+		if sig.PubKey == nil {
+			return fmt.Errorf("failed. missing the public key of the signature %d", i)
+		}
+		signer := sdk.AccAddress(sig.PubKey.Address())
+		signers[signer.String()] = true
+
+		signMsg := sdk.StdSignMsg{
+			ChainID:       chainID,
+			AccountNumber: signerAccs[i].AccountNumber,
+			Sequence:      signerAccs[i].Sequence,
+			Fee:           stdTx.Fee,
+			Msgs:          stdTx.Msgs,
+			Memo:          stdTx.Memo,
+			TimeoutHeight: stdTx.TimeoutHeight,
+		}
+		if !sig.PubKey.VerifyBytes(signMsg.Bytes(), sig.Signature) {
+			return fmt.Errorf("failed. invalid signature of %s on the chain %s with account number %d and sequence %d",
+				signer, chainID, signerAccs[i].AccountNumber, signerAccs[i].Sequence)
+		}
+	}
+
+	if payer := stdTx.Fee.Payer; !payer.Empty() {
+		lastSigner := sdk.AccAddress(stdTx.Signatures[len(stdTx.Signatures)-1].PubKey.Address())
+		if len(stdTx.Signatures) < 2 || !lastSigner.Equals(payer) {
+			return fmt.Errorf("failed. the fee payer %s must sign the tx after the signer of the msgs", payer)
+		}
+	}
+
+	for _, msg := range stdTx.Msgs {
+		for _, addr := range msg.GetSigners() {
+			if !signers[addr.String()] {
+				return fmt.Errorf("failed. missing the signature of %s required by the msg %s", addr, msg.Type())
+			}
+		}
+	}
+	return nil
+}
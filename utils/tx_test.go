@@ -0,0 +1,75 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/okex/okchain-go-sdk/module/token/types"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+)
+
+func signTestStdTx(t *testing.T, chainID string, msgs []sdk.Msg, fee sdk.StdFee, privKeys []secp256k1.PrivKeySecp256k1,
+	signerAccs []SignerAccount) sdk.StdTx {
+	sigs := make([]sdk.StdSignature, len(privKeys))
+	for i, privKey := range privKeys {
+		signMsg := sdk.StdSignMsg{
+			ChainID:       chainID,
+			AccountNumber: signerAccs[i].AccountNumber,
+			Sequence:      signerAccs[i].Sequence,
+			Fee:           fee,
+			Msgs:          msgs,
+			Memo:          "memo",
+		}
+		sigBytes, err := privKey.Sign(signMsg.Bytes())
+		require.NoError(t, err)
+		sigs[i] = sdk.NewStdSignature(privKey.PubKey(), sigBytes)
+	}
+	return sdk.NewStdTx(msgs, fee, sigs, "memo")
+}
+
+func TestVerifyStdTxSignatures(t *testing.T) {
+	fromKey, payerKey := secp256k1.GenPrivKey(), secp256k1.GenPrivKey()
+	fromAddr, payerAddr := sdk.AccAddress(fromKey.PubKey().Address()), sdk.AccAddress(payerKey.PubKey().Address())
+	coins, err := sdk.ParseDecCoins(coinsStr1)
+	require.NoError(t, err)
+	msgs := []sdk.Msg{
+		types.NewMsgTokenSend(fromAddr, payerAddr, coins),
+		types.NewMsgTokenSend(fromAddr, payerAddr, coins),
+	}
+	fee := sdk.NewStdFee(200000, coins)
+	fee.Payer = payerAddr
+	signerAccs := []SignerAccount{{AccountNumber: 1, Sequence: 2}, {AccountNumber: 3, Sequence: 4}}
+	stdTx := signTestStdTx(t, "okchain", msgs, fee, []secp256k1.PrivKeySecp256k1{fromKey, payerKey}, signerAccs)
+
+	// round trip of the encoded tx
+	cdc := sdk.NewCodec()
+	types.RegisterCodec(cdc)
+	sdk.RegisterBasicCodec(cdc)
+	txBytes, err := cdc.MarshalBinaryLengthPrefixed(stdTx)
+	require.NoError(t, err)
+	decodedTx, err := DecodeStdTx(cdc, txBytes)
+	require.NoError(t, err)
+	require.Len(t, decodedTx.Msgs, 2)
+
+	require.Equal(t, []sdk.AccAddress{fromAddr, payerAddr}, GetStdTxSigners(decodedTx))
+	require.NoError(t, VerifyStdTxSignatures(decodedTx, "okchain", signerAccs))
+
+	// replay on another chain or with other accounts
+	require.Error(t, VerifyStdTxSignatures(decodedTx, "testchain", signerAccs))
+	require.Error(t, VerifyStdTxSignatures(decodedTx, "okchain", []SignerAccount{signerAccs[0], {3, 5}}))
+	require.Error(t, VerifyStdTxSignatures(decodedTx, "okchain", signerAccs[:1]))
+
+	// signatures in the wrong order or missing
+	decodedTx.Signatures[0], decodedTx.Signatures[1] = decodedTx.Signatures[1], decodedTx.Signatures[0]
+	require.Error(t, VerifyStdTxSignatures(decodedTx, "okchain", []SignerAccount{signerAccs[1], signerAccs[0]}))
+	decodedTx.Signatures = decodedTx.Signatures[1:]
+	require.Error(t, VerifyStdTxSignatures(decodedTx, "okchain", signerAccs[:1]))
+	decodedTx.Signatures = nil
+	require.Error(t, VerifyStdTxSignatures(decodedTx, "okchain", nil))
+
+	_, err = DecodeStdTx(cdc, nil)
+	require.Error(t, err)
+	_, err = DecodeStdTx(cdc, []byte("invalid tx bytes"))
+	require.Error(t, err)
+}
@@ -3,7 +3,6 @@ package utils
 import (
 	"encoding/hex"
 	"errors"
-	"fmt"
 	"github.com/cosmos/go-bip39"
 	sdk "github.com/okex/okchain-go-sdk/types"
 	"github.com/okex/okchain-go-sdk/types/crypto/keys/hd"
@@ -21,23 +20,22 @@ func GetStdTxFromFile(codec sdk.SDKCodec, filePath string) (stdTx sdk.StdTx, err
 	return
 }
 
-// ParseValAddresses parses validator address string to types.ValAddress
+// ParseValAddresses parses the validator operator addresses, and fails at the first one invalid
 func ParseValAddresses(valAddrsStr []string) ([]sdk.ValAddress, error) {
-	valLen := len(valAddrsStr)
-	valAddrs := make([]sdk.ValAddress, valLen)
-	var err error
-	for i := 0; i < valLen; i++ {
-		valAddrs[i], err = sdk.ValAddressFromBech32(valAddrsStr[i])
+	valAddrs := make([]sdk.ValAddress, len(valAddrsStr))
+	for i, valAddrStr := range valAddrsStr {
+		valAddr, err := ParseValAddress(valAddrStr)
 		if err != nil {
-			return nil, fmt.Errorf("invalid validator address: %s", valAddrsStr[i])
+			return nil, err
 		}
+		valAddrs[i] = valAddr
 	}
 	return valAddrs, nil
 }
 
 // GeneratePrivateKeyFromMnemo converts mnemonic to private key
 func GeneratePrivateKeyFromMnemo(mnemo string) (privKey string, err error) {
-	hdPath := hd.NewFundraiserParams(0, 0)
+	hdPath := hd.NewFundraiserParamsWithCoinType(sdk.GetConfig().GetCoinType(), 0, 0)
 	seed, err := bip39.NewSeedWithErrorChecking(mnemo, "")
 	if err != nil {
 		return
@@ -0,0 +1,96 @@
+package gosdk
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	sdk "github.com/okex/okchain-go-sdk/types"
+)
+
+// WarmupReport - structure of the result of the warm-up of a client
+type WarmupReport struct {
+	// ChainID and Height are of the latest block of the node
+	ChainID string
+	Height  int64
+	// ParamsModules are the modules whose params were fetched
+	ParamsModules []string
+	Duration      time.Duration
+}
+
+// Warmup makes the first calls of a client in advance, so that a latency-sensitive service doesn't pay for them on
+// its first real tx. It establishes the connection to the node by a query of the latest block, which tells the chain
+// ID and the height. The chain ID is checked against the one configured, or configures the client if unset, like
+// DetectChainID. Then it fetches the params of the staking, governance, dex and order modules registered, and primes
+// the codec by encoding and decoding a tx. It stops at the first failure or once the ctx is done
+func (cli *Client) Warmup(ctx context.Context) (report WarmupReport, err error) {
+	start := time.Now()
+	defer func() { report.Duration = time.Since(start) }()
+
+	err = runWithContext(ctx, func() error {
+		commit, err := cli.Tendermint().QueryLatestCommitResult()
+		if err != nil {
+			return fmt.Errorf("failed. query latest block error: %w", err)
+		}
+		report.ChainID, report.Height = commit.ChainID, commit.Height
+		return nil
+	})
+	if err != nil {
+		return
+	}
+	if err = cli.applyChainID(report.ChainID); err != nil {
+		return
+	}
+
+	fetchers := cli.paramsFetchers()
+	for name := range fetchers {
+		report.ParamsModules = append(report.ParamsModules, name)
+	}
+	sort.Strings(report.ParamsModules)
+	for _, name := range report.ParamsModules {
+		fetch := fetchers[name]
+		err = runWithContext(ctx, func() error {
+			if _, err := fetch(); err != nil {
+				return fmt.Errorf("failed. query %s params error: %w", name, err)
+			}
+			return nil
+		})
+		if err != nil {
+			return
+		}
+	}
+
+	err = runWithContext(ctx, cli.primeCodec)
+	return
+}
+
+// primeCodec encodes and decodes a tx with the sign bytes, which builds the reflection info of the codec
+func (cli *Client) primeCodec() error {
+	stdTx := sdk.NewStdTx(nil, sdk.NewStdFee(cli.config.Gas, cli.config.Fees), nil, "")
+	bytes, err := cli.cdc.MarshalBinaryLengthPrefixed(stdTx)
+	if err != nil {
+		return fmt.Errorf("failed. prime codec error: %w", err)
+	}
+	if _, err = cli.DecodeStdTx(bytes); err != nil {
+		return fmt.Errorf("failed. prime codec error: %w", err)
+	}
+	sdk.StdSignMsg{ChainID: cli.config.ChainID, Fee: stdTx.Fee}.Bytes()
+	return nil
+}
+
+// runWithContext runs the call, which returns at once if the ctx is done before the call ends
+func runWithContext(ctx context.Context, call func() error) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	done := make(chan error, 1)
+	go func() { done <- call() }()
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,65 @@
+package gosdk
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/okex/okchain-go-sdk/mocks"
+	"github.com/okex/okchain-go-sdk/module/staking"
+	stakingtypes "github.com/okex/okchain-go-sdk/module/staking/types"
+	"github.com/okex/okchain-go-sdk/module/tendermint"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// commitBackend serves the latest block on the conformance backend
+type commitBackend struct {
+	*mocks.ConformanceBackend
+	blocked chan struct{}
+}
+
+func (cb commitBackend) Commit(*int64) (*ctypes.ResultCommit, error) {
+	if cb.blocked != nil {
+		<-cb.blocked
+	}
+	var res ctypes.ResultCommit
+	res.Header = &tmtypes.Header{ChainID: "okchain", Height: 1024}
+	res.Commit = &tmtypes.Commit{}
+	return &res, nil
+}
+
+func TestClient_Warmup(t *testing.T) {
+	config, err := sdk.NewClientConfig("testURL", "okchain", sdk.BroadcastBlock, "0.01okt", 200000,
+		0, "")
+	require.NoError(t, err)
+	backend := commitBackend{ConformanceBackend: mocks.NewConformanceBackend()}
+	cli := newClientWithRPC(config, []string{tendermint.ModuleName, staking.ModuleName}, backend)
+
+	// the staking params unavailable
+	_, err = cli.Warmup(context.Background())
+	require.Error(t, err)
+
+	backend.SetResponse(stakingtypes.ParamsPath, nil, abci.ResponseQuery{
+		Value: []byte(`{"unbonding_time":"1209600000000000","max_bonded_validators":21,"epoch":252,` +
+			`"max_validators_to_add_shares":30,"bond_denom":"okt","min_delegation":"0.0001",` +
+			`"min_self_delegation":"10000"}`),
+	})
+	report, err := cli.Warmup(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "okchain", report.ChainID)
+	require.Equal(t, int64(1024), report.Height)
+	require.Equal(t, []string{staking.ModuleName}, report.ParamsModules)
+
+	// stopped once the ctx is done
+	backend.blocked = make(chan struct{})
+	defer close(backend.blocked)
+	cli = newClientWithRPC(config, []string{tendermint.ModuleName}, backend)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = cli.Warmup(ctx)
+	require.Equal(t, context.DeadlineExceeded, err)
+}
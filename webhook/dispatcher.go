@@ -0,0 +1,307 @@
+// Package webhook provides the dispatcher mapping the chain events to the outbound HTTP webhooks, e.g. the transfers
+// to the watched addresses, the proposal status changes, the jailed validators and the filled orders, so that a team
+// integrates with okchain without running its own indexer. Every payload is signed by HMAC-SHA256 with the secret of
+// its endpoint, and the failed deliveries are retried with an exponential backoff.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/okex/okchain-go-sdk/exposed"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// headers of the webhook requests
+const (
+	HeaderEvent     = "X-Okchain-Event"
+	HeaderDelivery  = "X-Okchain-Delivery"
+	HeaderSignature = "X-Okchain-Signature"
+
+	// signaturePrefix prefixes the hex HMAC-SHA256 of the body in the signature header
+	signaturePrefix = "sha256="
+)
+
+const (
+	newBlockHeaderQuery = "tm.event='NewBlockHeader'"
+	defaultMaxRetries   = 3
+	defaultRetryBackoff = time.Second
+	defaultTimeout      = 10 * time.Second
+)
+
+// Endpoint - structure of a receiver of the webhooks
+type Endpoint struct {
+	URL string
+	// Secret is the key of the HMAC signatures of the payloads, optional
+	Secret string
+	// Events are the names of the events to receive, all of them if empty
+	Events []string
+}
+
+// accepts tells whether the endpoint receives the event
+func (e Endpoint) accepts(event string) bool {
+	if len(e.Events) == 0 {
+		return true
+	}
+	for _, name := range e.Events {
+		if name == event {
+			return true
+		}
+	}
+	return false
+}
+
+// Config - structure of the config of the webhook dispatcher
+type Config struct {
+	Endpoints []Endpoint
+	Triggers  []Trigger
+	// MaxRetries is the max number of the retries of a failed delivery, 3 by default
+	MaxRetries int
+	// RetryBackoff is the pause before the first retry, which is doubled on each retry, 1s by default
+	RetryBackoff time.Duration
+	// Timeout is the timeout of each request, 10s by default
+	Timeout time.Duration
+	// HTTPClient sends the requests, http.DefaultClient by default
+	HTTPClient *http.Client
+	// OnResult is called with the result of each delivery, optional
+	OnResult func(Result)
+}
+
+// ValidateBasic gives a quick validity check for the webhook dispatcher config
+func (c Config) ValidateBasic() error {
+	if len(c.Endpoints) == 0 {
+		return errors.New("failed. no endpoint to dispatch to")
+	}
+	if len(c.Triggers) == 0 {
+		return errors.New("failed. no trigger to dispatch")
+	}
+	for _, endpoint := range c.Endpoints {
+		if len(endpoint.URL) == 0 {
+			return errors.New("failed. empty endpoint URL")
+		}
+	}
+	if c.MaxRetries < 0 || c.RetryBackoff < 0 || c.Timeout < 0 {
+		return errors.New("failed. max retries, retry backoff and timeout must not be negative")
+	}
+	return nil
+}
+
+// Notification - structure of the payload of a webhook
+type Notification struct {
+	// ID is unique for each notification, which the receiver could deduplicate the retries by
+	ID     string      `json:"id"`
+	Event  string      `json:"event"`
+	Height int64       `json:"height"`
+	Data   interface{} `json:"data"`
+}
+
+// Result - structure of the result of delivering a notification to an endpoint
+type Result struct {
+	Notification Notification
+	URL          string
+	// Attempts is the number of the requests sent
+	Attempts int
+	// StatusCode is the status of the last response, 0 if none
+	StatusCode int
+	Err        error
+}
+
+// Dispatcher - structure of the dispatcher of the webhooks on the new blocks
+type Dispatcher struct {
+	tm         exposed.TendermintQuery
+	subscriber sdk.ClientSubscription
+	config     Config
+	// the last height processed
+	lastHeight int64
+}
+
+// NewDispatcher creates a new instance of Dispatcher
+func NewDispatcher(tm exposed.TendermintQuery, subscriber sdk.ClientSubscription, config Config) (*Dispatcher,
+	error) {
+	if err := config.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if config.MaxRetries == 0 {
+		config.MaxRetries = defaultMaxRetries
+	}
+	if config.RetryBackoff == 0 {
+		config.RetryBackoff = defaultRetryBackoff
+	}
+	if config.Timeout == 0 {
+		config.Timeout = defaultTimeout
+	}
+	if config.HTTPClient == nil {
+		config.HTTPClient = http.DefaultClient
+	}
+
+	return &Dispatcher{
+		tm:         tm,
+		subscriber: subscriber,
+		config:     config,
+	}, nil
+}
+
+// Run dispatches the webhooks of every new block until the ctx is done. The blocks missed between two new blocks are
+// caught up in order, while the blocks before the first one are never dispatched
+func (d *Dispatcher) Run(ctx context.Context) error {
+	subscriber := fmt.Sprintf("gosdk-webhook-%d", time.Now().UnixNano())
+	events, err := d.subscriber.Subscribe(ctx, subscriber, newBlockHeaderQuery)
+	if err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case event, ok := <-events:
+			if !ok {
+				return errors.New("failed. subscription of the new blocks closed")
+			}
+			header, ok := event.Data.(tmtypes.EventDataNewBlockHeader)
+			if !ok {
+				continue
+			}
+			d.catchUp(ctx, header.Header.Height)
+		}
+	}
+}
+
+// catchUp dispatches the blocks since the last height processed up to the height
+func (d *Dispatcher) catchUp(ctx context.Context, height int64) {
+	from := d.lastHeight + 1
+	if d.lastHeight == 0 {
+		from = height
+	}
+	for h := from; h <= height && ctx.Err() == nil; h++ {
+		if _, err := d.DispatchBlock(ctx, h); err != nil {
+			// retried on the next block
+			return
+		}
+	}
+}
+
+// DispatchBlock fires all the triggers on the block at the height and delivers the notifications to the endpoints.
+// The failure of a trigger is returned after the notifications of the others are delivered, and the results of the
+// deliveries are returned in order
+func (d *Dispatcher) DispatchBlock(ctx context.Context, height int64) (results []Result, err error) {
+	blockResults, err := d.tm.QueryBlockResults(height)
+	if err != nil {
+		return nil, fmt.Errorf("failed. query block results at height %d error: %w", height, err)
+	}
+	// the failed triggers catch up on the next block by themselves, e.g. the orders queried again
+	d.lastHeight = height
+
+	var notifications []Notification
+	for _, trigger := range d.config.Triggers {
+		data, fireErr := trigger.Fire(blockResults)
+		if fireErr != nil && err == nil {
+			err = fmt.Errorf("failed. fire trigger %s at height %d error: %w", trigger.Name(), height, fireErr)
+		}
+		for i, datum := range data {
+			notifications = append(notifications, Notification{
+				ID:     fmt.Sprintf("%d-%s-%d", height, trigger.Name(), i),
+				Event:  trigger.Name(),
+				Height: height,
+				Data:   datum,
+			})
+		}
+	}
+
+	for _, notification := range notifications {
+		for _, endpoint := range d.config.Endpoints {
+			if !endpoint.accepts(notification.Event) {
+				continue
+			}
+			result := d.deliver(ctx, endpoint, notification)
+			if d.config.OnResult != nil {
+				d.config.OnResult(result)
+			}
+			results = append(results, result)
+		}
+	}
+	return
+}
+
+// deliver posts the notification to the endpoint with the retries
+func (d *Dispatcher) deliver(ctx context.Context, endpoint Endpoint, notification Notification) (result Result) {
+	result.Notification, result.URL = notification, endpoint.URL
+	body, err := json.Marshal(notification)
+	if err != nil {
+		result.Err = fmt.Errorf("failed. marshal notification %s error: %w", notification.ID, err)
+		return
+	}
+
+	backoff := d.config.RetryBackoff
+	for {
+		result.Attempts++
+		var retryable bool
+		result.StatusCode, retryable, result.Err = d.post(ctx, endpoint, notification, body)
+		if result.Err == nil || !retryable || result.Attempts > d.config.MaxRetries {
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+			backoff *= 2
+		}
+	}
+}
+
+// post sends a request of the notification, which is retryable on the network errors, 429 and 5xx
+func (d *Dispatcher) post(ctx context.Context, endpoint Endpoint, notification Notification, body []byte) (
+	statusCode int, retryable bool, err error) {
+	ctx, cancel := context.WithTimeout(ctx, d.config.Timeout)
+	defer cancel()
+
+	req, err := http.NewRequest(http.MethodPost, endpoint.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, false, fmt.Errorf("failed. build request to %s error: %w", endpoint.URL, err)
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEvent, notification.Event)
+	req.Header.Set(HeaderDelivery, notification.ID)
+	if len(endpoint.Secret) != 0 {
+		req.Header.Set(HeaderSignature, Sign(endpoint.Secret, body))
+	}
+
+	resp, err := d.config.HTTPClient.Do(req)
+	if err != nil {
+		return 0, true, fmt.Errorf("failed. post to %s error: %w", endpoint.URL, err)
+	}
+	defer resp.Body.Close()
+	// drain the body to reuse the connection
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return resp.StatusCode, false, nil
+	}
+	retryable = resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500
+	return resp.StatusCode, retryable, fmt.Errorf("failed. post to %s with status %d", endpoint.URL, resp.StatusCode)
+}
+
+// Sign returns the signature header value of the body by the secret, i.e. "sha256=" with the hex HMAC-SHA256
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify tells whether the signature header value matches the body by the secret, for the receivers
+func Verify(secret string, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, body)), []byte(signature))
+}
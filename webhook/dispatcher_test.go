@@ -0,0 +1,236 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/okex/okchain-go-sdk/exposed"
+	ordertypes "github.com/okex/okchain-go-sdk/module/order/types"
+	tmtypes "github.com/okex/okchain-go-sdk/module/tendermint/types"
+	sdk "github.com/okex/okchain-go-sdk/types"
+	"github.com/stretchr/testify/require"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmcore "github.com/tendermint/tendermint/types"
+)
+
+const (
+	watchedAddr = "okchain1dcsxvxgj374dv3wt9szflf9nz6342juzzkjnlz"
+	otherAddr   = "okchain1wux20ku36ntgtxpgm7my9863xy3fqs0xgh66d7"
+	secret      = "webhook secret"
+)
+
+// fakeChain serves the block results and the orders from memory
+type fakeChain struct {
+	exposed.TendermintQuery
+	exposed.OrderQuery
+	sdk.ClientSubscription
+	mtx     sync.Mutex
+	results map[int64]tmtypes.BlockResults
+	orders  map[string]ordertypes.OrderDetail
+	queried []int64
+	events  chan ctypes.ResultEvent
+}
+
+func (fc *fakeChain) QueryBlockResults(height int64) (tmtypes.BlockResults, error) {
+	fc.mtx.Lock()
+	defer fc.mtx.Unlock()
+	fc.queried = append(fc.queried, height)
+	return fc.results[height], nil
+}
+
+func (fc *fakeChain) QueryOrderDetail(orderID string) (ordertypes.OrderDetail, error) {
+	order, ok := fc.orders[orderID]
+	if !ok {
+		return order, errors.New("failed. order doesn't exist")
+	}
+	return order, nil
+}
+
+func (fc *fakeChain) Subscribe(context.Context, string, string) (<-chan ctypes.ResultEvent, error) {
+	return fc.events, nil
+}
+
+func newEvent(eventType string, kvs ...string) tmtypes.Event {
+	event := tmtypes.Event{Type: eventType}
+	for i := 0; i < len(kvs); i += 2 {
+		event.Attributes = append(event.Attributes, tmtypes.KVPair{Key: []byte(kvs[i]), Value: []byte(kvs[i+1])})
+	}
+	return event
+}
+
+// receiver records the webhooks received, failing the first ones by the status
+type receiver struct {
+	mtx      sync.Mutex
+	failures []int
+	bodies   [][]byte
+	headers  []http.Header
+}
+
+func (r *receiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	if len(r.failures) != 0 {
+		w.WriteHeader(r.failures[0])
+		r.failures = r.failures[1:]
+		return
+	}
+	body, _ := ioutil.ReadAll(req.Body)
+	r.bodies = append(r.bodies, body)
+	r.headers = append(r.headers, req.Header)
+}
+
+func TestDispatcher_DispatchBlock(t *testing.T) {
+	chain := &fakeChain{
+		results: map[int64]tmtypes.BlockResults{
+			1024: {
+				Height: 1024,
+				Results: tmtypes.ABCIResponses{
+					BeginBlock: tmtypes.ResponseBeginBlock{Events: []tmtypes.Event{
+						newEvent("slash", "address", "okchainvalcons1", "jailed", "okchainvalcons1"),
+						newEvent("slash", "address", "okchainvalcons2", "reason", "double_sign"),
+					}},
+					DeliverTx: []tmtypes.ResponseDeliverTx{
+						{Events: []tmtypes.Event{newEvent("transfer", "recipient", watchedAddr, "amount", "1okt")}},
+						// failed tx
+						{Code: 5, Events: []tmtypes.Event{newEvent("transfer", "recipient", watchedAddr)}},
+						{Events: []tmtypes.Event{newEvent("transfer", "recipient", otherAddr)}},
+					},
+					EndBlock: tmtypes.ResponseEndBlock{Events: []tmtypes.Event{
+						newEvent("active_proposal", "proposal_id", "1", "proposal_result", "proposal_passed"),
+					}},
+				},
+			},
+		},
+		orders: map[string]ordertypes.OrderDetail{
+			"ID0000000001-1": {OrderID: "ID0000000001-1", Status: orderStatusFilled},
+			"ID0000000001-2": {OrderID: "ID0000000001-2", Status: orderStatusOpen},
+			"ID0000000001-3": {OrderID: "ID0000000001-3", Status: 2},
+		},
+	}
+
+	all, transfers := new(receiver), &receiver{failures: []int{http.StatusServiceUnavailable}}
+	allServer, transfersServer := httptest.NewServer(all), httptest.NewServer(transfers)
+	defer allServer.Close()
+	defer transfersServer.Close()
+
+	orderTrigger := OrderFilled(chain, "ID0000000001-1", "ID0000000001-2", "ID0000000001-3")
+	dispatcher, err := NewDispatcher(chain, chain, Config{
+		Endpoints: []Endpoint{
+			{URL: allServer.URL},
+			{URL: transfersServer.URL, Secret: secret, Events: []string{EventTransfer}},
+		},
+		Triggers:     []Trigger{TransferTo(watchedAddr), ProposalStatusChanged(), ValidatorJailed(), orderTrigger},
+		RetryBackoff: time.Millisecond,
+	})
+	require.NoError(t, err)
+
+	results, err := dispatcher.DispatchBlock(context.Background(), 1024)
+	require.NoError(t, err)
+	require.Len(t, results, 5)
+	for _, result := range results {
+		require.NoError(t, result.Err)
+	}
+	require.Equal(t, []string{"ID0000000001-2"}, orderTrigger.Pending())
+
+	var events []string
+	for _, body := range all.bodies {
+		var notification Notification
+		require.NoError(t, json.Unmarshal(body, &notification))
+		require.Equal(t, int64(1024), notification.Height)
+		events = append(events, notification.Event)
+	}
+	require.Equal(t, []string{EventTransfer, EventProposalStatus, EventValidatorJail, EventOrderFilled}, events)
+
+	// retried after 503 and signed
+	require.Len(t, transfers.bodies, 1)
+	require.Equal(t, 2, results[1].Attempts)
+	require.Equal(t, http.StatusOK, results[1].StatusCode)
+	require.True(t, Verify(secret, transfers.bodies[0], transfers.headers[0].Get(HeaderSignature)))
+	require.False(t, Verify("another secret", transfers.bodies[0], transfers.headers[0].Get(HeaderSignature)))
+	require.Equal(t, "1024-transfer-0", transfers.headers[0].Get(HeaderDelivery))
+	require.Equal(t, EventTransfer, transfers.headers[0].Get(HeaderEvent))
+	var notification struct {
+		Data EventData `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(transfers.bodies[0], &notification))
+	require.Equal(t, 0, notification.Data.TxIndex)
+	require.Equal(t, "1okt", notification.Data.Attributes["amount"])
+}
+
+func TestDispatcher_Retries(t *testing.T) {
+	chain := &fakeChain{
+		results: map[int64]tmtypes.BlockResults{
+			1: {Results: tmtypes.ABCIResponses{DeliverTx: []tmtypes.ResponseDeliverTx{
+				{Events: []tmtypes.Event{newEvent("transfer", "recipient", watchedAddr)}},
+			}}},
+		},
+	}
+
+	rec := &receiver{failures: []int{http.StatusInternalServerError, http.StatusTooManyRequests,
+		http.StatusBadGateway}}
+	server := httptest.NewServer(rec)
+	defer server.Close()
+	dispatcher, err := NewDispatcher(chain, chain, Config{
+		Endpoints:    []Endpoint{{URL: server.URL}},
+		Triggers:     []Trigger{TransferTo(watchedAddr)},
+		MaxRetries:   2,
+		RetryBackoff: time.Millisecond,
+	})
+	require.NoError(t, err)
+
+	// given up after the max retries
+	results, err := dispatcher.DispatchBlock(context.Background(), 1)
+	require.NoError(t, err)
+	require.Error(t, results[0].Err)
+	require.Equal(t, 3, results[0].Attempts)
+	require.Equal(t, http.StatusBadGateway, results[0].StatusCode)
+
+	// never retried on 4xx
+	rec.failures = []int{http.StatusBadRequest}
+	results, err = dispatcher.DispatchBlock(context.Background(), 1)
+	require.NoError(t, err)
+	require.Error(t, results[0].Err)
+	require.Equal(t, 1, results[0].Attempts)
+	require.Empty(t, rec.bodies)
+
+	_, err = NewDispatcher(chain, chain, Config{Triggers: []Trigger{TransferTo(watchedAddr)}})
+	require.Error(t, err)
+	_, err = NewDispatcher(chain, chain, Config{Endpoints: []Endpoint{{URL: server.URL}}})
+	require.Error(t, err)
+}
+
+func TestDispatcher_Run(t *testing.T) {
+	chain := &fakeChain{
+		events: make(chan ctypes.ResultEvent, 2),
+	}
+	server := httptest.NewServer(new(receiver))
+	defer server.Close()
+	dispatcher, err := NewDispatcher(chain, chain, Config{
+		Endpoints: []Endpoint{{URL: server.URL}},
+		Triggers:  []Trigger{TransferTo(watchedAddr)},
+	})
+	require.NoError(t, err)
+
+	// the blocks missed are caught up
+	for _, height := range []int64{10, 13} {
+		chain.events <- ctypes.ResultEvent{Data: tmcore.EventDataNewBlockHeader{Header: tmcore.Header{Height: height}}}
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- dispatcher.Run(ctx) }()
+	require.Eventually(t, func() bool {
+		chain.mtx.Lock()
+		defer chain.mtx.Unlock()
+		return len(chain.queried) == 4
+	}, time.Second, time.Millisecond)
+	cancel()
+	require.Error(t, <-done)
+	require.Equal(t, []int64{10, 11, 12, 13}, chain.queried)
+}
@@ -0,0 +1,189 @@
+package webhook
+
+import (
+	"fmt"
+
+	"github.com/okex/okchain-go-sdk/exposed"
+	ordertypes "github.com/okex/okchain-go-sdk/module/order/types"
+	tmtypes "github.com/okex/okchain-go-sdk/module/tendermint/types"
+)
+
+// names of the preset triggers, which are the event names in the payloads
+const (
+	EventTransfer       = "transfer"
+	EventProposalStatus = "proposal_status"
+	EventValidatorJail  = "validator_jailed"
+	EventOrderFilled    = "order_filled"
+)
+
+// ABCI event types and attribute keys emitted by okchain, which the preset triggers match
+const (
+	eventTypeTransfer         = "transfer"
+	eventTypeActiveProposal   = "active_proposal"
+	eventTypeInactiveProposal = "inactive_proposal"
+	eventTypeProposalDeposit  = "proposal_deposit"
+	eventTypeSlash            = "slash"
+
+	attributeKeyRecipient         = "recipient"
+	attributeKeyVotingPeriodStart = "voting_period_start"
+	attributeKeyProposalResult    = "proposal_result"
+	attributeKeyJailed            = "jailed"
+
+	// statuses of the orders, where the open orders include the partially filled ones
+	orderStatusOpen   = 0
+	orderStatusFilled = 1
+)
+
+// Trigger shows the expected behavior of a source of the notifications, which is fired on every new block
+type Trigger interface {
+	// Name returns the event name of the notifications
+	Name() string
+	// Fire returns the payload data of the notifications triggered by the block
+	Fire(results tmtypes.BlockResults) ([]interface{}, error)
+}
+
+// EventData - structure of the payload data of a notification triggered by an ABCI event
+type EventData struct {
+	Type string `json:"type"`
+	// TxIndex is the index of the tx emitting the event in the block, -1 for the begin and end block
+	TxIndex    int               `json:"tx_index"`
+	Attributes map[string]string `json:"attributes"`
+}
+
+// EventTrigger - structure of a trigger matching the ABCI events of the block
+type EventTrigger struct {
+	name  string
+	types map[string]bool
+	match func(attrs map[string]string) bool
+}
+
+// NewEventTrigger creates a new instance of EventTrigger firing on the events of the types whose attributes satisfy
+// the match, or all of them if the match is nil
+func NewEventTrigger(name string, match func(attrs map[string]string) bool, eventTypes ...string) *EventTrigger {
+	types := make(map[string]bool, len(eventTypes))
+	for _, eventType := range eventTypes {
+		types[eventType] = true
+	}
+	return &EventTrigger{
+		name:  name,
+		types: types,
+		match: match,
+	}
+}
+
+// TransferTo creates a trigger firing on the transfers to the watched addresses
+func TransferTo(addrStrs ...string) *EventTrigger {
+	watched := make(map[string]bool, len(addrStrs))
+	for _, addrStr := range addrStrs {
+		watched[addrStr] = true
+	}
+	return NewEventTrigger(EventTransfer, func(attrs map[string]string) bool {
+		return watched[attrs[attributeKeyRecipient]]
+	}, eventTypeTransfer)
+}
+
+// ProposalStatusChanged creates a trigger firing when a proposal enters the voting period, or it's passed, rejected
+// or dropped at the end of a period
+func ProposalStatusChanged() *EventTrigger {
+	return NewEventTrigger(EventProposalStatus, func(attrs map[string]string) bool {
+		if _, ok := attrs[attributeKeyVotingPeriodStart]; ok {
+			return true
+		}
+		_, ok := attrs[attributeKeyProposalResult]
+		return ok
+	}, eventTypeActiveProposal, eventTypeInactiveProposal, eventTypeProposalDeposit)
+}
+
+// ValidatorJailed creates a trigger firing when a validator is jailed by the slashing
+func ValidatorJailed() *EventTrigger {
+	return NewEventTrigger(EventValidatorJail, func(attrs map[string]string) bool {
+		_, ok := attrs[attributeKeyJailed]
+		return ok
+	}, eventTypeSlash)
+}
+
+// Name implements the Trigger interface
+func (et *EventTrigger) Name() string {
+	return et.name
+}
+
+// Fire implements the Trigger interface
+func (et *EventTrigger) Fire(results tmtypes.BlockResults) (data []interface{}, err error) {
+	data = append(data, et.matchEvents(-1, results.Results.BeginBlock.Events)...)
+	for i, deliverTx := range results.Results.DeliverTx {
+		// the events of the failed txs are never committed
+		if deliverTx.Code != 0 {
+			continue
+		}
+		data = append(data, et.matchEvents(i, deliverTx.Events)...)
+	}
+	return append(data, et.matchEvents(-1, results.Results.EndBlock.Events)...), nil
+}
+
+func (et *EventTrigger) matchEvents(txIndex int, events []tmtypes.Event) (data []interface{}) {
+	for _, event := range events {
+		if !et.types[event.Type] {
+			continue
+		}
+		attrs := make(map[string]string, len(event.Attributes))
+		for _, attr := range event.Attributes {
+			attrs[string(attr.Key)] = string(attr.Value)
+		}
+		if et.match == nil || et.match(attrs) {
+			data = append(data, EventData{
+				Type:       event.Type,
+				TxIndex:    txIndex,
+				Attributes: attrs,
+			})
+		}
+	}
+	return
+}
+
+// OrderFilledTrigger - structure of a trigger firing once the watched orders are fully filled, which queries the
+// orders on each block since the order module reports no fills by the events
+type OrderFilledTrigger struct {
+	orders  exposed.OrderQuery
+	pending []string
+}
+
+// OrderFilled creates a trigger firing once the watched orders are fully filled. The orders closed otherwise, e.g.
+// cancelled or expired, are no longer watched
+func OrderFilled(orders exposed.OrderQuery, orderIDs ...string) *OrderFilledTrigger {
+	return &OrderFilledTrigger{
+		orders:  orders,
+		pending: append([]string{}, orderIDs...),
+	}
+}
+
+// Name implements the Trigger interface
+func (ot *OrderFilledTrigger) Name() string {
+	return EventOrderFilled
+}
+
+// Fire implements the Trigger interface
+func (ot *OrderFilledTrigger) Fire(tmtypes.BlockResults) (data []interface{}, err error) {
+	var pending []string
+	for i, orderID := range ot.pending {
+		var order ordertypes.OrderDetail
+		if order, err = ot.orders.QueryOrderDetail(orderID); err != nil {
+			// retried on the next block
+			ot.pending = append(pending, ot.pending[i:]...)
+			return data, fmt.Errorf("failed. query order %s error: %w", orderID, err)
+		}
+
+		switch order.Status {
+		case orderStatusFilled:
+			data = append(data, order)
+		case orderStatusOpen:
+			pending = append(pending, orderID)
+		}
+	}
+	ot.pending = pending
+	return
+}
+
+// Pending returns the IDs of the orders still watched
+func (ot *OrderFilledTrigger) Pending() []string {
+	return append([]string{}, ot.pending...)
+}